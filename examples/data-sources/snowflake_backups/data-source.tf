@@ -0,0 +1,19 @@
+# Simple usage
+data "snowflake_backups" "simple" {
+  in_backup_set = snowflake_backup_set.example.fully_qualified_name
+}
+
+output "simple_output" {
+  value = data.snowflake_backups.simple.backups
+}
+
+# Ensure the backup set contains at least one backup (with the use of postcondition)
+data "snowflake_backups" "assert_with_postcondition" {
+  in_backup_set = snowflake_backup_set.example.fully_qualified_name
+  lifecycle {
+    postcondition {
+      condition     = length(self.backups) > 0
+      error_message = "there should be at least one backup"
+    }
+  }
+}
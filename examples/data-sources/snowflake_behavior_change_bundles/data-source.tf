@@ -0,0 +1,11 @@
+data "snowflake_behavior_change_bundles" "all" {
+}
+
+output "behavior_change_bundles" {
+  value = data.snowflake_behavior_change_bundles.all.behavior_change_bundles
+}
+
+# Names of the bundles that are not enabled yet
+output "disabled_bundles" {
+  value = [for bundle in data.snowflake_behavior_change_bundles.all.behavior_change_bundles : bundle.name if !bundle.is_enabled]
+}
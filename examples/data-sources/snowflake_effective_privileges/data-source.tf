@@ -0,0 +1,32 @@
+# All the effective privileges of the user (through all the roles granted to the user, directly or indirectly)
+data "snowflake_effective_privileges" "user" {
+  user = "USER_NAME"
+}
+
+# Effective privileges of the account role on the given database, without ownership
+data "snowflake_effective_privileges" "role_on_database" {
+  account_role      = "ROLE_NAME"
+  include_ownership = false
+
+  on {
+    object_type = "DATABASE"
+    object_name = "DATABASE_NAME"
+  }
+}
+
+# Effective privileges of the database role on all the tables (including future grants)
+data "snowflake_effective_privileges" "database_role_on_tables" {
+  database_role = "\"DATABASE_NAME\".\"DATABASE_ROLE_NAME\""
+
+  on {
+    object_type = "TABLE"
+  }
+}
+
+# Roles through which the user can select from the table
+output "select_paths" {
+  value = [
+    for privilege in data.snowflake_effective_privileges.user.effective_privileges : join(" -> ", privilege.path)
+    if privilege.privilege == "SELECT" && privilege.name == "\"DATABASE_NAME\".\"SCHEMA_NAME\".\"TABLE_NAME\""
+  ]
+}
@@ -0,0 +1,28 @@
+# Grants on the database, its schemas, and its objects that are not managed by this configuration
+data "snowflake_grant_drift_report" "database" {
+  database = "DATABASE_NAME"
+  managed_grant_ids = [
+    snowflake_grant_privileges_to_account_role.usage_on_database.id,
+    snowflake_grant_privileges_to_account_role.select_on_future_tables.id,
+  ]
+}
+
+# All the grants to the account role, without the future grants
+data "snowflake_grant_drift_report" "role" {
+  account_role          = "ROLE_NAME"
+  include_future_grants = false
+  managed_grant_ids     = [for grant in snowflake_grant_privileges_to_account_role.role : grant.id]
+}
+
+# Import identifiers of the unmanaged grants, e.g. to bring them under Terraform management with import blocks
+output "unmanaged_grant_import_ids" {
+  value = [for grant in data.snowflake_grant_drift_report.database.unmanaged_grants : grant.grant_id]
+}
+
+# Fail the plan when a grant was made outside of Terraform
+check "no_unmanaged_grants" {
+  assert {
+    condition     = length(data.snowflake_grant_drift_report.database.unmanaged_grants) == 0
+    error_message = "Unmanaged grants found: ${join(", ", [for grant in data.snowflake_grant_drift_report.database.unmanaged_grants : grant.grant_id])}"
+  }
+}
@@ -0,0 +1,81 @@
+# Simple usage
+data "snowflake_join_policies" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_join_policies.simple.join_policies
+}
+
+# Filtering (like)
+data "snowflake_join_policies" "like" {
+  like = "join-policy-name"
+}
+
+output "like_output" {
+  value = data.snowflake_join_policies.like.join_policies
+}
+
+# Filtering by prefix (like)
+data "snowflake_join_policies" "like_prefix" {
+  like = "prefix%"
+}
+
+output "like_prefix_output" {
+  value = data.snowflake_join_policies.like_prefix.join_policies
+}
+
+# Filtering (limit)
+data "snowflake_join_policies" "limit" {
+  limit {
+    rows = 10
+    from = "prefix-"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_join_policies.limit.join_policies
+}
+
+# Filtering (in)
+data "snowflake_join_policies" "in" {
+  in {
+    database = "database"
+  }
+}
+
+output "in_output" {
+  value = data.snowflake_join_policies.in.join_policies
+}
+
+# Without additional data (to limit the number of calls make for every found join policy)
+data "snowflake_join_policies" "only_show" {
+  # with_describe is turned on by default and it calls DESCRIBE JOIN POLICY for every join policy found and attaches its output to join_policies.*.describe_output field
+  with_describe = false
+}
+
+output "only_show_output" {
+  value = data.snowflake_join_policies.only_show.join_policies
+}
+
+# Ensure the number of join policies is equal to at least one element (with the use of postcondition)
+data "snowflake_join_policies" "assert_with_postcondition" {
+  like = "join-policy-name%"
+  lifecycle {
+    postcondition {
+      condition     = length(self.join_policies) > 0
+      error_message = "there should be at least one join policy"
+    }
+  }
+}
+
+# Ensure the number of join policies is equal to exactly one element (with the use of check block)
+check "join_policy_check" {
+  data "snowflake_join_policies" "assert_with_check_block" {
+    like = "join-policy-name"
+  }
+
+  assert {
+    condition     = length(data.snowflake_join_policies.assert_with_check_block.join_policies) == 1
+    error_message = "join policies filtered by '${data.snowflake_join_policies.assert_with_check_block.like}' returned ${length(data.snowflake_join_policies.assert_with_check_block.join_policies)} join policies where one was expected"
+  }
+}
@@ -0,0 +1,41 @@
+# Simple usage
+data "snowflake_packages_policies" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_packages_policies.simple.packages_policies
+}
+
+# Filtering (in)
+data "snowflake_packages_policies" "in" {
+  in {
+    database = "database"
+  }
+}
+
+output "in_output" {
+  value = data.snowflake_packages_policies.in.packages_policies
+}
+
+# Without additional data (to limit the number of calls make for every found packages policy)
+data "snowflake_packages_policies" "only_show" {
+  # with_describe is turned on by default and it calls DESCRIBE PACKAGES POLICY for every packages policy found and attaches its output to packages_policies.*.describe_output field
+  with_describe = false
+}
+
+output "only_show_output" {
+  value = data.snowflake_packages_policies.only_show.packages_policies
+}
+
+# Ensure the number of packages policies is equal to at least one element (with the use of postcondition)
+data "snowflake_packages_policies" "assert_with_postcondition" {
+  in {
+    schema = "\"database\".\"schema\""
+  }
+  lifecycle {
+    postcondition {
+      condition     = length(self.packages_policies) > 0
+      error_message = "there should be at least one packages policy"
+    }
+  }
+}
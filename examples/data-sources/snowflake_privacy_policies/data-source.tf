@@ -0,0 +1,81 @@
+# Simple usage
+data "snowflake_privacy_policies" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_privacy_policies.simple.privacy_policies
+}
+
+# Filtering (like)
+data "snowflake_privacy_policies" "like" {
+  like = "privacy-policy-name"
+}
+
+output "like_output" {
+  value = data.snowflake_privacy_policies.like.privacy_policies
+}
+
+# Filtering by prefix (like)
+data "snowflake_privacy_policies" "like_prefix" {
+  like = "prefix%"
+}
+
+output "like_prefix_output" {
+  value = data.snowflake_privacy_policies.like_prefix.privacy_policies
+}
+
+# Filtering (limit)
+data "snowflake_privacy_policies" "limit" {
+  limit {
+    rows = 10
+    from = "prefix-"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_privacy_policies.limit.privacy_policies
+}
+
+# Filtering (in)
+data "snowflake_privacy_policies" "in" {
+  in {
+    database = "database"
+  }
+}
+
+output "in_output" {
+  value = data.snowflake_privacy_policies.in.privacy_policies
+}
+
+# Without additional data (to limit the number of calls make for every found privacy policy)
+data "snowflake_privacy_policies" "only_show" {
+  # with_describe is turned on by default and it calls DESCRIBE PRIVACY POLICY for every privacy policy found and attaches its output to privacy_policies.*.describe_output field
+  with_describe = false
+}
+
+output "only_show_output" {
+  value = data.snowflake_privacy_policies.only_show.privacy_policies
+}
+
+# Ensure the number of privacy policies is equal to at least one element (with the use of postcondition)
+data "snowflake_privacy_policies" "assert_with_postcondition" {
+  like = "privacy-policy-name%"
+  lifecycle {
+    postcondition {
+      condition     = length(self.privacy_policies) > 0
+      error_message = "there should be at least one privacy policy"
+    }
+  }
+}
+
+# Ensure the number of privacy policies is equal to exactly one element (with the use of check block)
+check "privacy_policy_check" {
+  data "snowflake_privacy_policies" "assert_with_check_block" {
+    like = "privacy-policy-name"
+  }
+
+  assert {
+    condition     = length(data.snowflake_privacy_policies.assert_with_check_block.privacy_policies) == 1
+    error_message = "privacy policies filtered by '${data.snowflake_privacy_policies.assert_with_check_block.like}' returned ${length(data.snowflake_privacy_policies.assert_with_check_block.privacy_policies)} privacy policies where one was expected"
+  }
+}
@@ -0,0 +1,29 @@
+# The whole role hierarchy of the account, with the roles not reachable from SYSADMIN reported in orphan_roles
+data "snowflake_role_hierarchy" "all" {}
+
+# Enforce the RBAC model: all the custom roles must be reachable from SYSADMIN and only the functional roles can be granted to users
+data "snowflake_role_hierarchy" "enforced" {
+  functional_role_pattern    = "^FR_"
+  functional_roles           = ["ANALYST"]
+  fail_on_orphan_roles       = true
+  fail_on_direct_user_grants = true
+  fail_on_cycles             = true
+}
+
+# Account roles only, rooted in a custom role
+data "snowflake_role_hierarchy" "account_roles" {
+  root_role                 = "PLATFORM_ADMIN"
+  include_users             = false
+  include_database_roles    = false
+  include_application_roles = false
+}
+
+# Render the hierarchy with Graphviz (e.g. `terraform output -raw role_hierarchy_dot | dot -Tsvg -o role_hierarchy.svg`)
+output "role_hierarchy_dot" {
+  value = data.snowflake_role_hierarchy.all.dot
+}
+
+# Export the hierarchy for the policy checks (e.g. OPA)
+output "role_hierarchy_json" {
+  value = data.snowflake_role_hierarchy.all.json
+}
@@ -0,0 +1 @@
+terraform import snowflake_access_profile.example '"<account_role_name>"'
@@ -0,0 +1,45 @@
+# read-only access to all analytics databases, including objects created in the future
+resource "snowflake_access_profile" "analyst" {
+  account_role_name = snowflake_account_role.analyst.name
+
+  privilege_set {
+    object_type = "DATABASE"
+    privileges  = ["USAGE"]
+  }
+  privilege_set {
+    object_type = "SCHEMA"
+    privileges  = ["USAGE"]
+  }
+  privilege_set {
+    object_type = "TABLE"
+    privileges  = ["SELECT", "REFERENCES"]
+  }
+  privilege_set {
+    object_type = "VIEW"
+    privileges  = ["SELECT"]
+  }
+
+  object_selector {
+    database_pattern = "^ANALYTICS_"
+  }
+}
+
+# access limited to staging schemas in a single database, without future grants
+resource "snowflake_access_profile" "loader" {
+  account_role_name = snowflake_account_role.loader.name
+
+  privilege_set {
+    object_type = "SCHEMA"
+    privileges  = ["USAGE"]
+  }
+  privilege_set {
+    object_type = "TABLE"
+    privileges  = ["SELECT", "INSERT"]
+  }
+
+  object_selector {
+    database       = "RAW"
+    schema_pattern = "^STG_"
+    include_future = false
+  }
+}
@@ -0,0 +1 @@
+terraform import snowflake_account_privatelink_authorization.example '<endpoint_id>'
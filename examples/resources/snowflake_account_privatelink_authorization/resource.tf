@@ -0,0 +1,11 @@
+# AWS PrivateLink
+resource "snowflake_account_privatelink_authorization" "aws" {
+  endpoint_id = "123456789012"
+  token       = var.aws_federated_token
+}
+
+# Azure Private Link
+resource "snowflake_account_privatelink_authorization" "azure" {
+  endpoint_id = "/subscriptions/<subscription_id>/resourceGroups/<resource_group>/providers/Microsoft.Network/privateEndpoints/<private_endpoint>"
+  token       = var.azure_access_token
+}
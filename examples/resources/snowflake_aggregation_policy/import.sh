@@ -0,0 +1 @@
+terraform import snowflake_aggregation_policy.example '"<database_name>"."<schema_name>"."<aggregation_policy_name>"'
@@ -0,0 +1,28 @@
+# basic resource
+resource "snowflake_aggregation_policy" "basic" {
+  database = "database"
+  schema   = "schema"
+  name     = "aggregation_policy"
+  body     = "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"
+}
+
+# complete resource
+resource "snowflake_aggregation_policy" "complete" {
+  database = "database"
+  schema   = "schema"
+  name     = "aggregation_policy"
+  body     = "CASE WHEN CURRENT_ROLE() = 'ADMIN' THEN NO_AGGREGATION_CONSTRAINT() ELSE AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5) END"
+  comment  = "comment"
+}
+
+# the entity key is specified when the policy is set on a table or a view
+resource "snowflake_view" "view" {
+  database  = "database"
+  schema    = "schema"
+  name      = "view"
+  statement = "SELECT * FROM \"database\".\"schema\".\"table\""
+  aggregation_policy {
+    policy_name = snowflake_aggregation_policy.basic.fully_qualified_name
+    entity_key  = ["ID"]
+  }
+}
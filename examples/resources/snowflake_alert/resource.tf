@@ -11,3 +11,16 @@ resource "snowflake_alert" "alert" {
   enabled   = true
   comment   = "my alert"
 }
+
+# serverless alert triggered on new data
+resource "snowflake_alert" "serverless_alert" {
+  database  = "database"
+  schema    = "schema"
+  name      = "serverless_alert"
+  condition = "select * from \"database\".\"schema\".\"table\" where value > 100"
+  action    = "select 1 as c"
+  enabled   = true
+
+  # change the value to run the alert on demand
+  execute_trigger = "1"
+}
@@ -0,0 +1 @@
+terraform import snowflake_backup_policy.example '"<database_name>"."<schema_name>"."<backup_policy_name>"'
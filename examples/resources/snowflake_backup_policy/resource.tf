@@ -0,0 +1,17 @@
+# basic resource
+resource "snowflake_backup_policy" "basic" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_BACKUP_POLICY"
+}
+
+# resource with all fields set
+resource "snowflake_backup_policy" "complete" {
+  database            = "EXAMPLE_DB"
+  schema              = "EXAMPLE_SCHEMA"
+  name                = "EXAMPLE_BACKUP_POLICY"
+  with_retention_lock = true
+  schedule            = "USING CRON 0 3 * * * UTC"
+  expire_after_days   = 30
+  comment             = "Daily backups kept for 30 days."
+}
@@ -0,0 +1,17 @@
+data "snowflake_backups" "example" {
+  in_backup_set = snowflake_backup_set.example.fully_qualified_name
+}
+
+# restore a table from the first listed backup
+resource "snowflake_backup_restore" "table" {
+  backup_set     = snowflake_backup_set.example.fully_qualified_name
+  backup_id      = data.snowflake_backups.example.backups[0].show_output[0].backup_id
+  restored_table = "\"EXAMPLE_DB\".\"EXAMPLE_SCHEMA\".\"RESTORED_TABLE\""
+}
+
+# restore a database
+resource "snowflake_backup_restore" "database" {
+  backup_set        = "\"EXAMPLE_DB\".\"EXAMPLE_SCHEMA\".\"DATABASE_BACKUP_SET\""
+  backup_id         = "<backup_id>"
+  restored_database = "RESTORED_DATABASE"
+}
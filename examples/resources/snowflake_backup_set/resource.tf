@@ -0,0 +1,17 @@
+# basic resource
+resource "snowflake_backup_set" "basic" {
+  database  = "EXAMPLE_DB"
+  schema    = "EXAMPLE_SCHEMA"
+  name      = "EXAMPLE_BACKUP_SET"
+  for_table = snowflake_table.example.fully_qualified_name
+}
+
+# resource with all fields set
+resource "snowflake_backup_set" "complete" {
+  database      = "EXAMPLE_DB"
+  schema        = "EXAMPLE_SCHEMA"
+  name          = "EXAMPLE_BACKUP_SET"
+  for_database  = snowflake_database.example.fully_qualified_name
+  backup_policy = snowflake_backup_policy.example.fully_qualified_name
+  comment       = "Backups of the whole database."
+}
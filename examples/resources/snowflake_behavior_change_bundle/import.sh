@@ -0,0 +1 @@
+terraform import snowflake_behavior_change_bundle.example '<bundle_name>'
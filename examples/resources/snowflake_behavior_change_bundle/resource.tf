@@ -0,0 +1,11 @@
+# Enable an upcoming bundle
+resource "snowflake_behavior_change_bundle" "enabled" {
+  name    = "2025_03"
+  enabled = true
+}
+
+# Disable a bundle enabled by default
+resource "snowflake_behavior_change_bundle" "disabled" {
+  name    = "2025_02"
+  enabled = false
+}
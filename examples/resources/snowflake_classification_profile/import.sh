@@ -0,0 +1 @@
+terraform import snowflake_classification_profile.example '"<database_name>"."<schema_name>"."<classification_profile_name>"'
@@ -0,0 +1,39 @@
+# basic resource
+resource "snowflake_classification_profile" "basic" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_CLASSIFICATION_PROFILE"
+}
+
+# resource with all fields set
+resource "snowflake_classification_profile" "complete" {
+  database                                   = "EXAMPLE_DB"
+  schema                                     = "EXAMPLE_SCHEMA"
+  name                                       = "EXAMPLE_CLASSIFICATION_PROFILE"
+  minimum_object_age_for_classification_days = 1
+  maximum_classification_validity_days       = 30
+  auto_tag                                   = true
+  tag_map {
+    column_tag_map {
+      tag_name            = snowflake_tag.pii.fully_qualified_name
+      tag_value           = "highly sensitive"
+      semantic_categories = ["NAME", "NATIONAL_IDENTIFIER"]
+    }
+  }
+  custom_classifiers {
+    label      = "medical_codes"
+    classifier = "\"EXAMPLE_DB\".\"EXAMPLE_SCHEMA\".\"MEDICAL_CODES\""
+  }
+}
+
+# set the classification profile on a database and a schema
+resource "snowflake_database" "example" {
+  name                   = "EXAMPLE_DB"
+  classification_profile = snowflake_classification_profile.complete.fully_qualified_name
+}
+
+resource "snowflake_schema" "example" {
+  database               = snowflake_database.example.name
+  name                   = "EXAMPLE_SCHEMA"
+  classification_profile = snowflake_classification_profile.complete.fully_qualified_name
+}
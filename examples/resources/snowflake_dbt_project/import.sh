@@ -0,0 +1 @@
+terraform import snowflake_dbt_project.example '"<database_name>"."<schema_name>"."<dbt_project_name>"'
@@ -0,0 +1,40 @@
+# basic resource - from a Git repository
+resource "snowflake_dbt_project" "basic" {
+  database = "database"
+  schema   = "schema"
+  name     = "dbt_project"
+  from {
+    stage = snowflake_git_repository.example.fully_qualified_name
+    path  = "branches/main/dbt"
+  }
+}
+
+# resource adding a new version whenever the sources in the Git repository change
+resource "snowflake_dbt_project" "with_version_trigger" {
+  database = "database"
+  schema   = "schema"
+  name     = "dbt_project"
+  from {
+    stage           = snowflake_git_repository.example.fully_qualified_name
+    path            = "branches/main/dbt"
+    version_trigger = var.dbt_sources_commit_sha
+  }
+}
+
+# complete resource - from a stage
+resource "snowflake_dbt_project" "complete" {
+  database = "database"
+  schema   = "schema"
+  name     = "dbt_project"
+  from {
+    stage         = snowflake_stage.example.fully_qualified_name
+    path          = "dbt"
+    version_alias = "release_1"
+  }
+  default_args                 = "--select tag:daily"
+  default_target               = "prod"
+  default_version              = "LAST"
+  dbt_version                  = "1.9.4"
+  external_access_integrations = [snowflake_external_access_integration.example.name]
+  comment                      = "comment"
+}
@@ -0,0 +1,16 @@
+## Minimal
+resource "snowflake_external_access_integration" "basic" {
+  name                  = "external_access_integration_name"
+  allowed_network_rules = [snowflake_network_rule.egress.fully_qualified_name]
+  enabled               = true
+}
+
+## Complete (with every optional set)
+resource "snowflake_external_access_integration" "complete" {
+  name                                    = "external_access_integration_name"
+  allowed_network_rules                   = [snowflake_network_rule.egress.fully_qualified_name]
+  allowed_api_authentication_integrations = [snowflake_api_authentication_integration_with_client_credentials.example.name]
+  allowed_authentication_secrets          = [snowflake_secret_with_generic_string.example.fully_qualified_name]
+  enabled                                 = true
+  comment                                 = "my external access integration"
+}
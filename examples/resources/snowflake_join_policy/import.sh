@@ -0,0 +1 @@
+terraform import snowflake_join_policy.example '"<database_name>"."<schema_name>"."<join_policy_name>"'
@@ -0,0 +1,28 @@
+# basic resource
+resource "snowflake_join_policy" "basic" {
+  database = "database"
+  schema   = "schema"
+  name     = "join_policy"
+  body     = "JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)"
+}
+
+# complete resource
+resource "snowflake_join_policy" "complete" {
+  database = "database"
+  schema   = "schema"
+  name     = "join_policy"
+  body     = "CASE WHEN CURRENT_ROLE() = 'ADMIN' THEN JOIN_CONSTRAINT(JOIN_REQUIRED => FALSE) ELSE JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE) END"
+  comment  = "comment"
+}
+
+# the allowed join keys are specified when the policy is set on a table or a view
+resource "snowflake_view" "view" {
+  database  = "database"
+  schema    = "schema"
+  name      = "view"
+  statement = "SELECT * FROM \"database\".\"schema\".\"table\""
+  join_policy {
+    policy_name       = snowflake_join_policy.basic.fully_qualified_name
+    allowed_join_keys = ["ID"]
+  }
+}
@@ -0,0 +1 @@
+terraform import snowflake_packages_policy.example '"<database_name>"."<schema_name>"."<packages_policy_name>"'
@@ -0,0 +1,28 @@
+# basic resource
+resource "snowflake_privacy_policy" "basic" {
+  database = "database"
+  schema   = "schema"
+  name     = "privacy_policy"
+  body     = "PRIVACY_BUDGET(BUDGET_NAME => 'analysts')"
+}
+
+# complete resource
+resource "snowflake_privacy_policy" "complete" {
+  database = "database"
+  schema   = "schema"
+  name     = "privacy_policy"
+  body     = "CASE WHEN CURRENT_ROLE() = 'ADMIN' THEN NO_PRIVACY_POLICY() ELSE PRIVACY_BUDGET(BUDGET_NAME => 'analysts', BUDGET_LIMIT => 233, MAX_BUDGET_PER_AGGREGATE => 1, BUDGET_WINDOW => 'WEEKLY') END"
+  comment  = "comment"
+}
+
+# the entity key is specified when the policy is set on a table or a view
+resource "snowflake_view" "view" {
+  database  = "database"
+  schema    = "schema"
+  name      = "view"
+  statement = "SELECT * FROM \"database\".\"schema\".\"table\""
+  privacy_policy {
+    policy_name = snowflake_privacy_policy.basic.fully_qualified_name
+    entity_key  = ["ID"]
+  }
+}
@@ -0,0 +1 @@
+terraform import snowflake_privatelink_endpoint.example '<provider_resource_id>'
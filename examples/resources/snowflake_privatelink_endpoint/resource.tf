@@ -0,0 +1,12 @@
+# AWS service
+resource "snowflake_privatelink_endpoint" "aws" {
+  provider_resource_id = "com.amazonaws.us-west-2.s3"
+  host                 = "*.s3.us-west-2.amazonaws.com"
+}
+
+# Microsoft Azure resource
+resource "snowflake_privatelink_endpoint" "azure" {
+  provider_resource_id = "/subscriptions/<subscription_id>/resourceGroups/<resource_group>/providers/Microsoft.Storage/storageAccounts/<storage_account>"
+  host                 = "<storage_account>.blob.core.windows.net"
+  subresource          = "blob"
+}
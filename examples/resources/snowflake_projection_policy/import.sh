@@ -0,0 +1 @@
+terraform import snowflake_projection_policy.example '"<database_name>"."<schema_name>"."<projection_policy_name>"'
@@ -0,0 +1,16 @@
+# basic resource
+resource "snowflake_projection_policy" "basic" {
+  database = "database"
+  schema   = "schema"
+  name     = "projection_policy"
+  body     = "PROJECTION_CONSTRAINT(ALLOW => false)"
+}
+
+# complete resource
+resource "snowflake_projection_policy" "complete" {
+  database = "database"
+  schema   = "schema"
+  name     = "projection_policy"
+  body     = "CASE WHEN CURRENT_ROLE() = 'ADMIN' THEN PROJECTION_CONSTRAINT(ALLOW => true) ELSE PROJECTION_CONSTRAINT(ALLOW => false) END"
+  comment  = "comment"
+}
@@ -88,3 +88,20 @@ resource "snowflake_compute_pool" "complete" {
   query_warehouse     = snowflake_warehouse.test.name
   comment             = "A service."
 }
+
+# resource with volumes restored from a snapshot
+resource "snowflake_service" "restored" {
+  database        = snowflake_database.test.name
+  schema          = snowflake_schema.test.name
+  name            = "SERVICE"
+  in_compute_pool = snowflake_compute_pool.test.name
+  from_specification {
+    stage = snowflake_stage.complete.fully_qualified_name
+    file  = "spec.yaml"
+  }
+  restore_from_snapshot {
+    volume    = "block-volume"
+    instances = [0]
+    snapshot  = snowflake_snapshot.basic.fully_qualified_name
+  }
+}
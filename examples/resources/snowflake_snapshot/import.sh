@@ -0,0 +1 @@
+terraform import snowflake_snapshot.example '"<database_name>"."<schema_name>"."<snapshot_name>"'
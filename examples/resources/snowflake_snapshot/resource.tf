@@ -0,0 +1,20 @@
+# basic resource
+resource "snowflake_snapshot" "basic" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_SNAPSHOT"
+  service  = snowflake_service.example.fully_qualified_name
+  volume   = "block-volume"
+  instance = 0
+}
+
+# resource with all fields set
+resource "snowflake_snapshot" "complete" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_SNAPSHOT"
+  service  = snowflake_service.example.fully_qualified_name
+  volume   = "block-volume"
+  instance = 0
+  comment  = "Snapshot of the block-volume of the first service instance."
+}
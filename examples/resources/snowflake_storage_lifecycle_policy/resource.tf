@@ -0,0 +1,49 @@
+# basic resource
+resource "snowflake_storage_lifecycle_policy" "basic" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_STORAGE_LIFECYCLE_POLICY"
+  argument {
+    name = "EVENT_TS"
+    type = "TIMESTAMP_NTZ"
+  }
+  body = "EVENT_TS < DATEADD(day, -365, CURRENT_TIMESTAMP())"
+}
+
+# resource with all fields set
+resource "snowflake_storage_lifecycle_policy" "complete" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_STORAGE_LIFECYCLE_POLICY"
+  argument {
+    name = "EVENT_TS"
+    type = "TIMESTAMP_NTZ"
+  }
+  argument {
+    name = "REGION"
+    type = "VARCHAR"
+  }
+  body             = "EVENT_TS < DATEADD(day, -365, CURRENT_TIMESTAMP()) AND REGION = 'EU'"
+  archive_tier     = "COOL"
+  archive_for_days = 2555
+  comment          = "Archive EU events older than one year and expire them after seven years."
+}
+
+# adding the policy to a table
+resource "snowflake_table" "events" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EVENTS"
+  column {
+    name = "EVENT_TS"
+    type = "TIMESTAMP_NTZ"
+  }
+  column {
+    name = "REGION"
+    type = "VARCHAR"
+  }
+  storage_lifecycle_policy {
+    policy_name = snowflake_storage_lifecycle_policy.complete.fully_qualified_name
+    on          = ["EVENT_TS", "REGION"]
+  }
+}
@@ -42,6 +42,11 @@ func (d *DatabaseResourceAssert) HasCatalog(expected string) *DatabaseResourceAs
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasClassificationProfile(expected string) *DatabaseResourceAssert {
+	d.StringValueSet("classification_profile", expected)
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasComment(expected string) *DatabaseResourceAssert {
 	d.StringValueSet("comment", expected)
 	return d
@@ -97,6 +102,11 @@ func (d *DatabaseResourceAssert) HasQuotedIdentifiersIgnoreCase(expected bool) *
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasRecoverIfDropped(expected bool) *DatabaseResourceAssert {
+	d.BoolValueSet("recover_if_dropped", expected)
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasReplaceInvalidCharacters(expected bool) *DatabaseResourceAssert {
 	d.BoolValueSet("replace_invalid_characters", expected)
 	return d
@@ -153,6 +163,11 @@ func (d *DatabaseResourceAssert) HasCatalogString(expected string) *DatabaseReso
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasClassificationProfileString(expected string) *DatabaseResourceAssert {
+	d.AddAssertion(assert.ValueSet("classification_profile", expected))
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasCommentString(expected string) *DatabaseResourceAssert {
 	d.AddAssertion(assert.ValueSet("comment", expected))
 	return d
@@ -208,6 +223,11 @@ func (d *DatabaseResourceAssert) HasQuotedIdentifiersIgnoreCaseString(expected s
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasRecoverIfDroppedString(expected string) *DatabaseResourceAssert {
+	d.AddAssertion(assert.ValueSet("recover_if_dropped", expected))
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasReplaceInvalidCharactersString(expected string) *DatabaseResourceAssert {
 	d.AddAssertion(assert.ValueSet("replace_invalid_characters", expected))
 	return d
@@ -262,6 +282,11 @@ func (d *DatabaseResourceAssert) HasNoCatalog() *DatabaseResourceAssert {
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasNoClassificationProfile() *DatabaseResourceAssert {
+	d.AddAssertion(assert.ValueNotSet("classification_profile"))
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasNoComment() *DatabaseResourceAssert {
 	d.AddAssertion(assert.ValueNotSet("comment"))
 	return d
@@ -317,6 +342,11 @@ func (d *DatabaseResourceAssert) HasNoQuotedIdentifiersIgnoreCase() *DatabaseRes
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasNoRecoverIfDropped() *DatabaseResourceAssert {
+	d.AddAssertion(assert.ValueNotSet("recover_if_dropped"))
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasNoReplaceInvalidCharacters() *DatabaseResourceAssert {
 	d.AddAssertion(assert.ValueNotSet("replace_invalid_characters"))
 	return d
@@ -366,6 +396,11 @@ func (d *DatabaseResourceAssert) HasCatalogEmpty() *DatabaseResourceAssert {
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasClassificationProfileEmpty() *DatabaseResourceAssert {
+	d.AddAssertion(assert.ValueSet("classification_profile", ""))
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasCommentEmpty() *DatabaseResourceAssert {
 	d.AddAssertion(assert.ValueSet("comment", ""))
 	return d
@@ -421,6 +456,11 @@ func (d *DatabaseResourceAssert) HasQuotedIdentifiersIgnoreCaseEmpty() *Database
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasRecoverIfDroppedEmpty() *DatabaseResourceAssert {
+	d.AddAssertion(assert.ValueSet("recover_if_dropped", ""))
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasReplaceInvalidCharactersEmpty() *DatabaseResourceAssert {
 	d.AddAssertion(assert.ValueSet("replace_invalid_characters", ""))
 	return d
@@ -480,6 +520,11 @@ func (d *DatabaseResourceAssert) HasCatalogNotEmpty() *DatabaseResourceAssert {
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasClassificationProfileNotEmpty() *DatabaseResourceAssert {
+	d.AddAssertion(assert.ValuePresent("classification_profile"))
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasCommentNotEmpty() *DatabaseResourceAssert {
 	d.AddAssertion(assert.ValuePresent("comment"))
 	return d
@@ -535,6 +580,11 @@ func (d *DatabaseResourceAssert) HasQuotedIdentifiersIgnoreCaseNotEmpty() *Datab
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasRecoverIfDroppedNotEmpty() *DatabaseResourceAssert {
+	d.AddAssertion(assert.ValuePresent("recover_if_dropped"))
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasReplaceInvalidCharactersNotEmpty() *DatabaseResourceAssert {
 	d.AddAssertion(assert.ValuePresent("replace_invalid_characters"))
 	return d
@@ -21,6 +21,10 @@ func GetResourceSchemaDetails() []genhelpers.ResourceSchemaDetails {
 }
 
 var allResourceSchemaDefs = []ResourceSchemaDef{
+	{
+		name:   "AccessProfile",
+		schema: resources.AccessProfile().Schema,
+	},
 	{
 		name:   "Account",
 		schema: resources.Account().Schema,
@@ -29,6 +33,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "AccountParameter",
 		schema: resources.AccountParameter().Schema,
 	},
+	{
+		name:   "AccountPrivatelinkAuthorization",
+		schema: resources.AccountPrivatelinkAuthorization().Schema,
+	},
 	{
 		name:   "AccountRole",
 		schema: resources.AccountRole().Schema,
@@ -37,6 +45,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "AccountSessionPolicyAttachment",
 		schema: resources.AccountSessionPolicyAttachment().Schema,
 	},
+	{
+		name:   "AggregationPolicy",
+		schema: resources.AggregationPolicy().Schema,
+	},
 	{
 		name:   "ApiAuthenticationIntegrationWithAuthorizationCodeGrant",
 		schema: resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant().Schema,
@@ -53,6 +65,22 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "AuthenticationPolicy",
 		schema: resources.AuthenticationPolicy().Schema,
 	},
+	{
+		name:   "BackupPolicy",
+		schema: resources.BackupPolicy().Schema,
+	},
+	{
+		name:   "BackupRestore",
+		schema: resources.BackupRestore().Schema,
+	},
+	{
+		name:   "BackupSet",
+		schema: resources.BackupSet().Schema,
+	},
+	{
+		name:   "BehaviorChangeBundle",
+		schema: resources.BehaviorChangeBundle().Schema,
+	},
 	{
 		name:   "CatalogIntegrationAwsGlue",
 		schema: resources.CatalogIntegrationAwsGlue().Schema,
@@ -69,6 +97,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "CatalogIntegrationIcebergRest",
 		schema: resources.CatalogIntegrationIcebergRest().Schema,
 	},
+	{
+		name:   "ClassificationProfile",
+		schema: resources.ClassificationProfile().Schema,
+	},
 	{
 		name:   "ComputePool",
 		schema: resources.ComputePool().Schema,
@@ -93,10 +125,18 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "DatabaseRole",
 		schema: resources.DatabaseRole().Schema,
 	},
+	{
+		name:   "DbtProject",
+		schema: resources.DbtProject().Schema,
+	},
 	{
 		name:   "Execute",
 		schema: resources.Execute().Schema,
 	},
+	{
+		name:   "ExternalAccessIntegration",
+		schema: resources.ExternalAccessIntegration().Schema,
+	},
 	{
 		name:   "ExternalAzureStage",
 		schema: resources.ExternalAzureStage().Schema,
@@ -121,6 +161,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "ExternalOauthSecurityIntegration",
 		schema: resources.ExternalOauthIntegration().Schema,
 	},
+	{
+		name:   "FileFormat",
+		schema: resources.FileFormat().Schema,
+	},
 	{
 		name:   "FunctionJava",
 		schema: resources.FunctionJava().Schema,
@@ -157,6 +201,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "JobService",
 		schema: resources.JobService().Schema,
 	},
+	{
+		name:   "JoinPolicy",
+		schema: resources.JoinPolicy().Schema,
+	},
 	{
 		name:   "LegacyServiceUser",
 		schema: resources.LegacyServiceUser().Schema,
@@ -185,6 +233,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "Notebook",
 		schema: resources.Notebook().Schema,
 	},
+	{
+		name:   "PackagesPolicy",
+		schema: resources.PackagesPolicy().Schema,
+	},
 	{
 		name:   "Pipe",
 		schema: resources.Pipe().Schema,
@@ -205,6 +257,14 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "PrimaryConnection",
 		schema: resources.PrimaryConnection().Schema,
 	},
+	{
+		name:   "PrivacyPolicy",
+		schema: resources.PrivacyPolicy().Schema,
+	},
+	{
+		name:   "PrivatelinkEndpoint",
+		schema: resources.PrivatelinkEndpoint().Schema,
+	},
 	{
 		name:   "ProcedureJava",
 		schema: resources.ProcedureJava().Schema,
@@ -225,6 +285,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "ProcedureSql",
 		schema: resources.ProcedureSql().Schema,
 	},
+	{
+		name:   "ProjectionPolicy",
+		schema: resources.ProjectionPolicy().Schema,
+	},
 	{
 		name:   "ResourceMonitor",
 		schema: resources.ResourceMonitor().Schema,
@@ -297,6 +361,14 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "Share",
 		schema: resources.Share().Schema,
 	},
+	{
+		name:   "Snapshot",
+		schema: resources.Snapshot().Schema,
+	},
+	{
+		name:   "StorageLifecyclePolicy",
+		schema: resources.StorageLifecyclePolicy().Schema,
+	},
 	{
 		name:   "Streamlit",
 		schema: resources.Streamlit().Schema,
@@ -47,6 +47,11 @@ func (s *SchemaResourceAssert) HasCatalog(expected string) *SchemaResourceAssert
 	return s
 }
 
+func (s *SchemaResourceAssert) HasClassificationProfile(expected string) *SchemaResourceAssert {
+	s.StringValueSet("classification_profile", expected)
+	return s
+}
+
 func (s *SchemaResourceAssert) HasComment(expected string) *SchemaResourceAssert {
 	s.StringValueSet("comment", expected)
 	return s
@@ -102,6 +107,11 @@ func (s *SchemaResourceAssert) HasQuotedIdentifiersIgnoreCase(expected bool) *Sc
 	return s
 }
 
+func (s *SchemaResourceAssert) HasRecoverIfDropped(expected bool) *SchemaResourceAssert {
+	s.BoolValueSet("recover_if_dropped", expected)
+	return s
+}
+
 func (s *SchemaResourceAssert) HasReplaceInvalidCharacters(expected bool) *SchemaResourceAssert {
 	s.BoolValueSet("replace_invalid_characters", expected)
 	return s
@@ -166,6 +176,11 @@ func (s *SchemaResourceAssert) HasCatalogString(expected string) *SchemaResource
 	return s
 }
 
+func (s *SchemaResourceAssert) HasClassificationProfileString(expected string) *SchemaResourceAssert {
+	s.AddAssertion(assert.ValueSet("classification_profile", expected))
+	return s
+}
+
 func (s *SchemaResourceAssert) HasCommentString(expected string) *SchemaResourceAssert {
 	s.AddAssertion(assert.ValueSet("comment", expected))
 	return s
@@ -221,6 +236,11 @@ func (s *SchemaResourceAssert) HasQuotedIdentifiersIgnoreCaseString(expected str
 	return s
 }
 
+func (s *SchemaResourceAssert) HasRecoverIfDroppedString(expected string) *SchemaResourceAssert {
+	s.AddAssertion(assert.ValueSet("recover_if_dropped", expected))
+	return s
+}
+
 func (s *SchemaResourceAssert) HasReplaceInvalidCharactersString(expected string) *SchemaResourceAssert {
 	s.AddAssertion(assert.ValueSet("replace_invalid_characters", expected))
 	return s
@@ -285,6 +305,11 @@ func (s *SchemaResourceAssert) HasNoCatalog() *SchemaResourceAssert {
 	return s
 }
 
+func (s *SchemaResourceAssert) HasNoClassificationProfile() *SchemaResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("classification_profile"))
+	return s
+}
+
 func (s *SchemaResourceAssert) HasNoComment() *SchemaResourceAssert {
 	s.AddAssertion(assert.ValueNotSet("comment"))
 	return s
@@ -340,6 +365,11 @@ func (s *SchemaResourceAssert) HasNoQuotedIdentifiersIgnoreCase() *SchemaResourc
 	return s
 }
 
+func (s *SchemaResourceAssert) HasNoRecoverIfDropped() *SchemaResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("recover_if_dropped"))
+	return s
+}
+
 func (s *SchemaResourceAssert) HasNoReplaceInvalidCharacters() *SchemaResourceAssert {
 	s.AddAssertion(assert.ValueNotSet("replace_invalid_characters"))
 	return s
@@ -394,6 +424,11 @@ func (s *SchemaResourceAssert) HasCatalogEmpty() *SchemaResourceAssert {
 	return s
 }
 
+func (s *SchemaResourceAssert) HasClassificationProfileEmpty() *SchemaResourceAssert {
+	s.AddAssertion(assert.ValueSet("classification_profile", ""))
+	return s
+}
+
 func (s *SchemaResourceAssert) HasCommentEmpty() *SchemaResourceAssert {
 	s.AddAssertion(assert.ValueSet("comment", ""))
 	return s
@@ -449,6 +484,11 @@ func (s *SchemaResourceAssert) HasQuotedIdentifiersIgnoreCaseEmpty() *SchemaReso
 	return s
 }
 
+func (s *SchemaResourceAssert) HasRecoverIfDroppedEmpty() *SchemaResourceAssert {
+	s.AddAssertion(assert.ValueSet("recover_if_dropped", ""))
+	return s
+}
+
 func (s *SchemaResourceAssert) HasReplaceInvalidCharactersEmpty() *SchemaResourceAssert {
 	s.AddAssertion(assert.ValueSet("replace_invalid_characters", ""))
 	return s
@@ -513,6 +553,11 @@ func (s *SchemaResourceAssert) HasCatalogNotEmpty() *SchemaResourceAssert {
 	return s
 }
 
+func (s *SchemaResourceAssert) HasClassificationProfileNotEmpty() *SchemaResourceAssert {
+	s.AddAssertion(assert.ValuePresent("classification_profile"))
+	return s
+}
+
 func (s *SchemaResourceAssert) HasCommentNotEmpty() *SchemaResourceAssert {
 	s.AddAssertion(assert.ValuePresent("comment"))
 	return s
@@ -568,6 +613,11 @@ func (s *SchemaResourceAssert) HasQuotedIdentifiersIgnoreCaseNotEmpty() *SchemaR
 	return s
 }
 
+func (s *SchemaResourceAssert) HasRecoverIfDroppedNotEmpty() *SchemaResourceAssert {
+	s.AddAssertion(assert.ValuePresent("recover_if_dropped"))
+	return s
+}
+
 func (s *SchemaResourceAssert) HasReplaceInvalidCharactersNotEmpty() *SchemaResourceAssert {
 	s.AddAssertion(assert.ValuePresent("replace_invalid_characters"))
 	return s
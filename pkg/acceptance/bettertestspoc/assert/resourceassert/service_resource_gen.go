@@ -101,6 +101,8 @@ func (s *ServiceResourceAssert) HasQueryWarehouse(expected string) *ServiceResou
 	return s
 }
 
+// typed assert for "restore_from_snapshot" (type: List, subtype: Map) is not currently supported
+
 func (s *ServiceResourceAssert) HasServiceType(expected string) *ServiceResourceAssert {
 	s.StringValueSet("service_type", expected)
 	return s
@@ -303,6 +305,11 @@ func (s *ServiceResourceAssert) HasQueryWarehouseEmpty() *ServiceResourceAssert
 	return s
 }
 
+func (s *ServiceResourceAssert) HasRestoreFromSnapshotEmpty() *ServiceResourceAssert {
+	s.AddAssertion(assert.ValueSet("restore_from_snapshot.#", "0"))
+	return s
+}
+
 func (s *ServiceResourceAssert) HasServiceTypeEmpty() *ServiceResourceAssert {
 	s.AddAssertion(assert.ValueSet("service_type", ""))
 	return s
@@ -62,6 +62,11 @@ func (s *StreamOnDirectoryTableResourceAssert) HasFullyQualifiedName(expected st
 	return s
 }
 
+func (s *StreamOnDirectoryTableResourceAssert) HasRecreateWhenStale(expected bool) *StreamOnDirectoryTableResourceAssert {
+	s.BoolValueSet("recreate_when_stale", expected)
+	return s
+}
+
 func (s *StreamOnDirectoryTableResourceAssert) HasStage(expected string) *StreamOnDirectoryTableResourceAssert {
 	s.StringValueSet("stage", expected)
 	return s
@@ -111,6 +116,11 @@ func (s *StreamOnDirectoryTableResourceAssert) HasFullyQualifiedNameString(expec
 	return s
 }
 
+func (s *StreamOnDirectoryTableResourceAssert) HasRecreateWhenStaleString(expected string) *StreamOnDirectoryTableResourceAssert {
+	s.AddAssertion(assert.ValueSet("recreate_when_stale", expected))
+	return s
+}
+
 func (s *StreamOnDirectoryTableResourceAssert) HasStageString(expected string) *StreamOnDirectoryTableResourceAssert {
 	s.AddAssertion(assert.ValueSet("stage", expected))
 	return s
@@ -160,6 +170,11 @@ func (s *StreamOnDirectoryTableResourceAssert) HasNoFullyQualifiedName() *Stream
 	return s
 }
 
+func (s *StreamOnDirectoryTableResourceAssert) HasNoRecreateWhenStale() *StreamOnDirectoryTableResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("recreate_when_stale"))
+	return s
+}
+
 func (s *StreamOnDirectoryTableResourceAssert) HasNoStage() *StreamOnDirectoryTableResourceAssert {
 	s.AddAssertion(assert.ValueNotSet("stage"))
 	return s
@@ -194,6 +209,11 @@ func (s *StreamOnDirectoryTableResourceAssert) HasFullyQualifiedNameEmpty() *Str
 	return s
 }
 
+func (s *StreamOnDirectoryTableResourceAssert) HasRecreateWhenStaleEmpty() *StreamOnDirectoryTableResourceAssert {
+	s.AddAssertion(assert.ValueSet("recreate_when_stale", ""))
+	return s
+}
+
 func (s *StreamOnDirectoryTableResourceAssert) HasStaleEmpty() *StreamOnDirectoryTableResourceAssert {
 	s.AddAssertion(assert.ValueSet("stale", ""))
 	return s
@@ -238,6 +258,11 @@ func (s *StreamOnDirectoryTableResourceAssert) HasFullyQualifiedNameNotEmpty() *
 	return s
 }
 
+func (s *StreamOnDirectoryTableResourceAssert) HasRecreateWhenStaleNotEmpty() *StreamOnDirectoryTableResourceAssert {
+	s.AddAssertion(assert.ValuePresent("recreate_when_stale"))
+	return s
+}
+
 func (s *StreamOnDirectoryTableResourceAssert) HasStageNotEmpty() *StreamOnDirectoryTableResourceAssert {
 	s.AddAssertion(assert.ValuePresent("stage"))
 	return s
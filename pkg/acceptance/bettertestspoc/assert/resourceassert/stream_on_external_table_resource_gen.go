@@ -76,6 +76,11 @@ func (s *StreamOnExternalTableResourceAssert) HasInsertOnly(expected string) *St
 	return s
 }
 
+func (s *StreamOnExternalTableResourceAssert) HasRecreateWhenStale(expected bool) *StreamOnExternalTableResourceAssert {
+	s.BoolValueSet("recreate_when_stale", expected)
+	return s
+}
+
 func (s *StreamOnExternalTableResourceAssert) HasStale(expected bool) *StreamOnExternalTableResourceAssert {
 	s.BoolValueSet("stale", expected)
 	return s
@@ -130,6 +135,11 @@ func (s *StreamOnExternalTableResourceAssert) HasInsertOnlyString(expected strin
 	return s
 }
 
+func (s *StreamOnExternalTableResourceAssert) HasRecreateWhenStaleString(expected string) *StreamOnExternalTableResourceAssert {
+	s.AddAssertion(assert.ValueSet("recreate_when_stale", expected))
+	return s
+}
+
 func (s *StreamOnExternalTableResourceAssert) HasStaleString(expected string) *StreamOnExternalTableResourceAssert {
 	s.AddAssertion(assert.ValueSet("stale", expected))
 	return s
@@ -184,6 +194,11 @@ func (s *StreamOnExternalTableResourceAssert) HasNoInsertOnly() *StreamOnExterna
 	return s
 }
 
+func (s *StreamOnExternalTableResourceAssert) HasNoRecreateWhenStale() *StreamOnExternalTableResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("recreate_when_stale"))
+	return s
+}
+
 func (s *StreamOnExternalTableResourceAssert) HasNoStale() *StreamOnExternalTableResourceAssert {
 	s.AddAssertion(assert.ValueNotSet("stale"))
 	return s
@@ -228,6 +243,11 @@ func (s *StreamOnExternalTableResourceAssert) HasInsertOnlyEmpty() *StreamOnExte
 	return s
 }
 
+func (s *StreamOnExternalTableResourceAssert) HasRecreateWhenStaleEmpty() *StreamOnExternalTableResourceAssert {
+	s.AddAssertion(assert.ValueSet("recreate_when_stale", ""))
+	return s
+}
+
 func (s *StreamOnExternalTableResourceAssert) HasStaleEmpty() *StreamOnExternalTableResourceAssert {
 	s.AddAssertion(assert.ValueSet("stale", ""))
 	return s
@@ -282,6 +302,11 @@ func (s *StreamOnExternalTableResourceAssert) HasInsertOnlyNotEmpty() *StreamOnE
 	return s
 }
 
+func (s *StreamOnExternalTableResourceAssert) HasRecreateWhenStaleNotEmpty() *StreamOnExternalTableResourceAssert {
+	s.AddAssertion(assert.ValuePresent("recreate_when_stale"))
+	return s
+}
+
 func (s *StreamOnExternalTableResourceAssert) HasStaleNotEmpty() *StreamOnExternalTableResourceAssert {
 	s.AddAssertion(assert.ValuePresent("stale"))
 	return s
@@ -71,6 +71,11 @@ func (s *StreamOnTableResourceAssert) HasFullyQualifiedName(expected string) *St
 	return s
 }
 
+func (s *StreamOnTableResourceAssert) HasRecreateWhenStale(expected bool) *StreamOnTableResourceAssert {
+	s.BoolValueSet("recreate_when_stale", expected)
+	return s
+}
+
 func (s *StreamOnTableResourceAssert) HasShowInitialRows(expected string) *StreamOnTableResourceAssert {
 	s.StringValueSet("show_initial_rows", expected)
 	return s
@@ -130,6 +135,11 @@ func (s *StreamOnTableResourceAssert) HasFullyQualifiedNameString(expected strin
 	return s
 }
 
+func (s *StreamOnTableResourceAssert) HasRecreateWhenStaleString(expected string) *StreamOnTableResourceAssert {
+	s.AddAssertion(assert.ValueSet("recreate_when_stale", expected))
+	return s
+}
+
 func (s *StreamOnTableResourceAssert) HasShowInitialRowsString(expected string) *StreamOnTableResourceAssert {
 	s.AddAssertion(assert.ValueSet("show_initial_rows", expected))
 	return s
@@ -189,6 +199,11 @@ func (s *StreamOnTableResourceAssert) HasNoFullyQualifiedName() *StreamOnTableRe
 	return s
 }
 
+func (s *StreamOnTableResourceAssert) HasNoRecreateWhenStale() *StreamOnTableResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("recreate_when_stale"))
+	return s
+}
+
 func (s *StreamOnTableResourceAssert) HasNoShowInitialRows() *StreamOnTableResourceAssert {
 	s.AddAssertion(assert.ValueNotSet("show_initial_rows"))
 	return s
@@ -243,6 +258,11 @@ func (s *StreamOnTableResourceAssert) HasFullyQualifiedNameEmpty() *StreamOnTabl
 	return s
 }
 
+func (s *StreamOnTableResourceAssert) HasRecreateWhenStaleEmpty() *StreamOnTableResourceAssert {
+	s.AddAssertion(assert.ValueSet("recreate_when_stale", ""))
+	return s
+}
+
 func (s *StreamOnTableResourceAssert) HasShowInitialRowsEmpty() *StreamOnTableResourceAssert {
 	s.AddAssertion(assert.ValueSet("show_initial_rows", ""))
 	return s
@@ -297,6 +317,11 @@ func (s *StreamOnTableResourceAssert) HasFullyQualifiedNameNotEmpty() *StreamOnT
 	return s
 }
 
+func (s *StreamOnTableResourceAssert) HasRecreateWhenStaleNotEmpty() *StreamOnTableResourceAssert {
+	s.AddAssertion(assert.ValuePresent("recreate_when_stale"))
+	return s
+}
+
 func (s *StreamOnTableResourceAssert) HasShowInitialRowsNotEmpty() *StreamOnTableResourceAssert {
 	s.AddAssertion(assert.ValuePresent("show_initial_rows"))
 	return s
@@ -71,6 +71,11 @@ func (s *StreamOnViewResourceAssert) HasFullyQualifiedName(expected string) *Str
 	return s
 }
 
+func (s *StreamOnViewResourceAssert) HasRecreateWhenStale(expected bool) *StreamOnViewResourceAssert {
+	s.BoolValueSet("recreate_when_stale", expected)
+	return s
+}
+
 func (s *StreamOnViewResourceAssert) HasShowInitialRows(expected string) *StreamOnViewResourceAssert {
 	s.StringValueSet("show_initial_rows", expected)
 	return s
@@ -130,6 +135,11 @@ func (s *StreamOnViewResourceAssert) HasFullyQualifiedNameString(expected string
 	return s
 }
 
+func (s *StreamOnViewResourceAssert) HasRecreateWhenStaleString(expected string) *StreamOnViewResourceAssert {
+	s.AddAssertion(assert.ValueSet("recreate_when_stale", expected))
+	return s
+}
+
 func (s *StreamOnViewResourceAssert) HasShowInitialRowsString(expected string) *StreamOnViewResourceAssert {
 	s.AddAssertion(assert.ValueSet("show_initial_rows", expected))
 	return s
@@ -189,6 +199,11 @@ func (s *StreamOnViewResourceAssert) HasNoFullyQualifiedName() *StreamOnViewReso
 	return s
 }
 
+func (s *StreamOnViewResourceAssert) HasNoRecreateWhenStale() *StreamOnViewResourceAssert {
+	s.AddAssertion(assert.ValueNotSet("recreate_when_stale"))
+	return s
+}
+
 func (s *StreamOnViewResourceAssert) HasNoShowInitialRows() *StreamOnViewResourceAssert {
 	s.AddAssertion(assert.ValueNotSet("show_initial_rows"))
 	return s
@@ -243,6 +258,11 @@ func (s *StreamOnViewResourceAssert) HasFullyQualifiedNameEmpty() *StreamOnViewR
 	return s
 }
 
+func (s *StreamOnViewResourceAssert) HasRecreateWhenStaleEmpty() *StreamOnViewResourceAssert {
+	s.AddAssertion(assert.ValueSet("recreate_when_stale", ""))
+	return s
+}
+
 func (s *StreamOnViewResourceAssert) HasShowInitialRowsEmpty() *StreamOnViewResourceAssert {
 	s.AddAssertion(assert.ValueSet("show_initial_rows", ""))
 	return s
@@ -297,6 +317,11 @@ func (s *StreamOnViewResourceAssert) HasFullyQualifiedNameNotEmpty() *StreamOnVi
 	return s
 }
 
+func (s *StreamOnViewResourceAssert) HasRecreateWhenStaleNotEmpty() *StreamOnViewResourceAssert {
+	s.AddAssertion(assert.ValuePresent("recreate_when_stale"))
+	return s
+}
+
 func (s *StreamOnViewResourceAssert) HasShowInitialRowsNotEmpty() *StreamOnViewResourceAssert {
 	s.AddAssertion(assert.ValuePresent("show_initial_rows"))
 	return s
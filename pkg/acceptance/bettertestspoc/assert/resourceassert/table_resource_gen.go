@@ -47,6 +47,8 @@ func (t *TableResourceAssert) HasName(expected string) *TableResourceAssert {
 	return t
 }
 
+// typed assert for "aggregation_policy" (type: List, subtype: Map) is not currently supported
+
 func (t *TableResourceAssert) HasChangeTracking(expected bool) *TableResourceAssert {
 	t.BoolValueSet("change_tracking", expected)
 	return t
@@ -69,11 +71,23 @@ func (t *TableResourceAssert) HasDataRetentionTimeInDays(expected int) *TableRes
 	return t
 }
 
+func (t *TableResourceAssert) HasEnableSchemaEvolution(expected bool) *TableResourceAssert {
+	t.BoolValueSet("enable_schema_evolution", expected)
+	return t
+}
+
 func (t *TableResourceAssert) HasFullyQualifiedName(expected string) *TableResourceAssert {
 	t.StringValueSet("fully_qualified_name", expected)
 	return t
 }
 
+func (t *TableResourceAssert) HasIsTransient(expected bool) *TableResourceAssert {
+	t.BoolValueSet("is_transient", expected)
+	return t
+}
+
+// typed assert for "join_policy" (type: List, subtype: Map) is not currently supported
+
 func (t *TableResourceAssert) HasOwner(expected string) *TableResourceAssert {
 	t.StringValueSet("owner", expected)
 	return t
@@ -81,6 +95,22 @@ func (t *TableResourceAssert) HasOwner(expected string) *TableResourceAssert {
 
 // typed assert for "primary_key" (type: List, subtype: Map) is not currently supported
 
+// typed assert for "privacy_policy" (type: List, subtype: Map) is not currently supported
+
+func (t *TableResourceAssert) HasRecoverIfDropped(expected bool) *TableResourceAssert {
+	t.BoolValueSet("recover_if_dropped", expected)
+	return t
+}
+
+// typed assert for "row_access_policy" (type: List, subtype: Map) is not currently supported
+
+func (t *TableResourceAssert) HasSearchOptimization(expected bool) *TableResourceAssert {
+	t.BoolValueSet("search_optimization", expected)
+	return t
+}
+
+// typed assert for "storage_lifecycle_policy" (type: List, subtype: Map) is not currently supported
+
 // typed assert for "tag" (type: List, subtype: Map) is not currently supported
 
 ///////////////////////////////////
@@ -117,16 +147,36 @@ func (t *TableResourceAssert) HasDataRetentionTimeInDaysString(expected string)
 	return t
 }
 
+func (t *TableResourceAssert) HasEnableSchemaEvolutionString(expected string) *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("enable_schema_evolution", expected))
+	return t
+}
+
 func (t *TableResourceAssert) HasFullyQualifiedNameString(expected string) *TableResourceAssert {
 	t.AddAssertion(assert.ValueSet("fully_qualified_name", expected))
 	return t
 }
 
+func (t *TableResourceAssert) HasIsTransientString(expected string) *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("is_transient", expected))
+	return t
+}
+
 func (t *TableResourceAssert) HasOwnerString(expected string) *TableResourceAssert {
 	t.AddAssertion(assert.ValueSet("owner", expected))
 	return t
 }
 
+func (t *TableResourceAssert) HasRecoverIfDroppedString(expected string) *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("recover_if_dropped", expected))
+	return t
+}
+
+func (t *TableResourceAssert) HasSearchOptimizationString(expected string) *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("search_optimization", expected))
+	return t
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -161,20 +211,45 @@ func (t *TableResourceAssert) HasNoDataRetentionTimeInDays() *TableResourceAsser
 	return t
 }
 
+func (t *TableResourceAssert) HasNoEnableSchemaEvolution() *TableResourceAssert {
+	t.AddAssertion(assert.ValueNotSet("enable_schema_evolution"))
+	return t
+}
+
 func (t *TableResourceAssert) HasNoFullyQualifiedName() *TableResourceAssert {
 	t.AddAssertion(assert.ValueNotSet("fully_qualified_name"))
 	return t
 }
 
+func (t *TableResourceAssert) HasNoIsTransient() *TableResourceAssert {
+	t.AddAssertion(assert.ValueNotSet("is_transient"))
+	return t
+}
+
 func (t *TableResourceAssert) HasNoOwner() *TableResourceAssert {
 	t.AddAssertion(assert.ValueNotSet("owner"))
 	return t
 }
 
+func (t *TableResourceAssert) HasNoRecoverIfDropped() *TableResourceAssert {
+	t.AddAssertion(assert.ValueNotSet("recover_if_dropped"))
+	return t
+}
+
+func (t *TableResourceAssert) HasNoSearchOptimization() *TableResourceAssert {
+	t.AddAssertion(assert.ValueNotSet("search_optimization"))
+	return t
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
 
+func (t *TableResourceAssert) HasAggregationPolicyEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("aggregation_policy.#", "0"))
+	return t
+}
+
 func (t *TableResourceAssert) HasChangeTrackingEmpty() *TableResourceAssert {
 	t.AddAssertion(assert.ValueSet("change_tracking", ""))
 	return t
@@ -195,11 +270,26 @@ func (t *TableResourceAssert) HasDataRetentionTimeInDaysEmpty() *TableResourceAs
 	return t
 }
 
+func (t *TableResourceAssert) HasEnableSchemaEvolutionEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("enable_schema_evolution", ""))
+	return t
+}
+
 func (t *TableResourceAssert) HasFullyQualifiedNameEmpty() *TableResourceAssert {
 	t.AddAssertion(assert.ValueSet("fully_qualified_name", ""))
 	return t
 }
 
+func (t *TableResourceAssert) HasIsTransientEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("is_transient", ""))
+	return t
+}
+
+func (t *TableResourceAssert) HasJoinPolicyEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("join_policy.#", "0"))
+	return t
+}
+
 func (t *TableResourceAssert) HasOwnerEmpty() *TableResourceAssert {
 	t.AddAssertion(assert.ValueSet("owner", ""))
 	return t
@@ -210,6 +300,31 @@ func (t *TableResourceAssert) HasPrimaryKeyEmpty() *TableResourceAssert {
 	return t
 }
 
+func (t *TableResourceAssert) HasPrivacyPolicyEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("privacy_policy.#", "0"))
+	return t
+}
+
+func (t *TableResourceAssert) HasRecoverIfDroppedEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("recover_if_dropped", ""))
+	return t
+}
+
+func (t *TableResourceAssert) HasRowAccessPolicyEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("row_access_policy.#", "0"))
+	return t
+}
+
+func (t *TableResourceAssert) HasSearchOptimizationEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("search_optimization", ""))
+	return t
+}
+
+func (t *TableResourceAssert) HasStorageLifecyclePolicyEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValueSet("storage_lifecycle_policy.#", "0"))
+	return t
+}
+
 func (t *TableResourceAssert) HasTagEmpty() *TableResourceAssert {
 	t.AddAssertion(assert.ValueSet("tag.#", "0"))
 	return t
@@ -249,12 +364,32 @@ func (t *TableResourceAssert) HasDataRetentionTimeInDaysNotEmpty() *TableResourc
 	return t
 }
 
+func (t *TableResourceAssert) HasEnableSchemaEvolutionNotEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValuePresent("enable_schema_evolution"))
+	return t
+}
+
 func (t *TableResourceAssert) HasFullyQualifiedNameNotEmpty() *TableResourceAssert {
 	t.AddAssertion(assert.ValuePresent("fully_qualified_name"))
 	return t
 }
 
+func (t *TableResourceAssert) HasIsTransientNotEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValuePresent("is_transient"))
+	return t
+}
+
 func (t *TableResourceAssert) HasOwnerNotEmpty() *TableResourceAssert {
 	t.AddAssertion(assert.ValuePresent("owner"))
 	return t
 }
+
+func (t *TableResourceAssert) HasRecoverIfDroppedNotEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValuePresent("recover_if_dropped"))
+	return t
+}
+
+func (t *TableResourceAssert) HasSearchOptimizationNotEmpty() *TableResourceAssert {
+	t.AddAssertion(assert.ValuePresent("search_optimization"))
+	return t
+}
@@ -84,6 +84,11 @@ func (t *TagResourceAssert) HasPropagate(expected string) *TagResourceAssert {
 	return t
 }
 
+func (t *TagResourceAssert) HasRecoverIfDropped(expected bool) *TagResourceAssert {
+	t.BoolValueSet("recover_if_dropped", expected)
+	return t
+}
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -123,6 +128,11 @@ func (t *TagResourceAssert) HasPropagateString(expected string) *TagResourceAsse
 	return t
 }
 
+func (t *TagResourceAssert) HasRecoverIfDroppedString(expected string) *TagResourceAssert {
+	t.AddAssertion(assert.ValueSet("recover_if_dropped", expected))
+	return t
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -162,6 +172,11 @@ func (t *TagResourceAssert) HasNoPropagate() *TagResourceAssert {
 	return t
 }
 
+func (t *TagResourceAssert) HasNoRecoverIfDropped() *TagResourceAssert {
+	t.AddAssertion(assert.ValueNotSet("recover_if_dropped"))
+	return t
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -206,6 +221,11 @@ func (t *TagResourceAssert) HasPropagateEmpty() *TagResourceAssert {
 	return t
 }
 
+func (t *TagResourceAssert) HasRecoverIfDroppedEmpty() *TagResourceAssert {
+	t.AddAssertion(assert.ValueSet("recover_if_dropped", ""))
+	return t
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -244,3 +264,8 @@ func (t *TagResourceAssert) HasPropagateNotEmpty() *TagResourceAssert {
 	t.AddAssertion(assert.ValuePresent("propagate"))
 	return t
 }
+
+func (t *TagResourceAssert) HasRecoverIfDroppedNotEmpty() *TagResourceAssert {
+	t.AddAssertion(assert.ValuePresent("recover_if_dropped"))
+	return t
+}
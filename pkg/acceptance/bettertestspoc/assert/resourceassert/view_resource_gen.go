@@ -90,6 +90,10 @@ func (v *ViewResourceAssert) HasIsTemporary(expected string) *ViewResourceAssert
 	return v
 }
 
+// typed assert for "join_policy" (type: List, subtype: Map) is not currently supported
+
+// typed assert for "privacy_policy" (type: List, subtype: Map) is not currently supported
+
 // typed assert for "row_access_policy" (type: List, subtype: Map) is not currently supported
 
 func (v *ViewResourceAssert) HasStatement(expected string) *ViewResourceAssert {
@@ -274,6 +278,16 @@ func (v *ViewResourceAssert) HasIsTemporaryEmpty() *ViewResourceAssert {
 	return v
 }
 
+func (v *ViewResourceAssert) HasJoinPolicyEmpty() *ViewResourceAssert {
+	v.AddAssertion(assert.ValueSet("join_policy.#", "0"))
+	return v
+}
+
+func (v *ViewResourceAssert) HasPrivacyPolicyEmpty() *ViewResourceAssert {
+	v.AddAssertion(assert.ValueSet("privacy_policy.#", "0"))
+	return v
+}
+
 func (v *ViewResourceAssert) HasRowAccessPolicyEmpty() *ViewResourceAssert {
 	v.AddAssertion(assert.ValueSet("row_access_policy.#", "0"))
 	return v
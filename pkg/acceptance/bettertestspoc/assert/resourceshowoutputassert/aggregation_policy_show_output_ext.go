@@ -0,0 +1,17 @@
+package resourceshowoutputassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+func AggregationPoliciesDatasourceShowOutput(t *testing.T, name string) *AggregationPolicyShowOutputAssert {
+	t.Helper()
+
+	a := AggregationPolicyShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceAssert("data."+name, "show_output", "aggregation_policies.0."),
+	}
+	a.AddAssertion(assert.ValueSet("show_output.#", "1"))
+	return &a
+}
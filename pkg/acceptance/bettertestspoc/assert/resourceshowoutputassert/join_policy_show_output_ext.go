@@ -0,0 +1,17 @@
+package resourceshowoutputassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+func JoinPoliciesDatasourceShowOutput(t *testing.T, name string) *JoinPolicyShowOutputAssert {
+	t.Helper()
+
+	a := JoinPolicyShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceAssert("data."+name, "show_output", "join_policies.0."),
+	}
+	a.AddAssertion(assert.ValueSet("show_output.#", "1"))
+	return &a
+}
@@ -0,0 +1,17 @@
+package resourceshowoutputassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+func PackagesPoliciesDatasourceShowOutput(t *testing.T, name string) *PackagesPolicyShowOutputAssert {
+	t.Helper()
+
+	a := PackagesPolicyShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceAssert("data."+name, "show_output", "packages_policies.0."),
+	}
+	a.AddAssertion(assert.ValueSet("show_output.#", "1"))
+	return &a
+}
@@ -0,0 +1,17 @@
+package resourceshowoutputassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+func PrivacyPoliciesDatasourceShowOutput(t *testing.T, name string) *PrivacyPolicyShowOutputAssert {
+	t.Helper()
+
+	a := PrivacyPolicyShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceAssert("data."+name, "show_output", "privacy_policies.0."),
+	}
+	a.AddAssertion(assert.ValueSet("show_output.#", "1"))
+	return &a
+}
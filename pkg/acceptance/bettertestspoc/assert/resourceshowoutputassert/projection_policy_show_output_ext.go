@@ -0,0 +1,17 @@
+package resourceshowoutputassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+func ProjectionPoliciesDatasourceShowOutput(t *testing.T, name string) *ProjectionPolicyShowOutputAssert {
+	t.Helper()
+
+	a := ProjectionPolicyShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceAssert("data."+name, "show_output", "projection_policies.0."),
+	}
+	a.AddAssertion(assert.ValueSet("show_output.#", "1"))
+	return &a
+}
@@ -0,0 +1,30 @@
+package datasourcemodel
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (r *AggregationPoliciesModel) WithInDatabase(databaseId sdk.AccountObjectIdentifier) *AggregationPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"database": tfconfig.StringVariable(databaseId.Name()),
+		}),
+	)
+}
+
+func (r *AggregationPoliciesModel) WithInSchema(schemaId sdk.DatabaseObjectIdentifier) *AggregationPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"schema": tfconfig.StringVariable(schemaId.FullyQualifiedName()),
+		}),
+	)
+}
+
+func (r *AggregationPoliciesModel) WithInAccount() *AggregationPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"account": tfconfig.BoolVariable(true),
+		}),
+	)
+}
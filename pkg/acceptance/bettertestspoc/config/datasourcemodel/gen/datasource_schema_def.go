@@ -30,10 +30,22 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "AccountRoles",
 		schema: datasources.AccountRoles().Schema,
 	},
+	{
+		name:   "AggregationPolicies",
+		schema: datasources.AggregationPolicies().Schema,
+	},
 	{
 		name:   "AuthenticationPolicies",
 		schema: datasources.AuthenticationPolicies().Schema,
 	},
+	{
+		name:   "Backups",
+		schema: datasources.Backups().Schema,
+	},
+	{
+		name:   "BehaviorChangeBundles",
+		schema: datasources.BehaviorChangeBundles().Schema,
+	},
 	{
 		name:   "CatalogIntegrations",
 		schema: datasources.CatalogIntegrations().Schema,
@@ -62,6 +74,14 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "Databases",
 		schema: datasources.Databases().Schema,
 	},
+	{
+		name:   "EffectivePrivileges",
+		schema: datasources.EffectivePrivileges().Schema,
+	},
+	{
+		name:   "ExternalAccessIntegrations",
+		schema: datasources.ExternalAccessIntegrations().Schema,
+	},
 	{
 		name:   "ExternalVolumes",
 		schema: datasources.ExternalVolumes().Schema,
@@ -74,6 +94,10 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "GitRepositories",
 		schema: datasources.GitRepositories().Schema,
 	},
+	{
+		name:   "GrantDriftReport",
+		schema: datasources.GrantDriftReport().Schema,
+	},
 	{
 		name:   "Grants",
 		schema: datasources.Grants().Schema,
@@ -82,6 +106,10 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "ImageRepositories",
 		schema: datasources.ImageRepositories().Schema,
 	},
+	{
+		name:   "JoinPolicies",
+		schema: datasources.JoinPolicies().Schema,
+	},
 	{
 		name:   "Listings",
 		schema: datasources.Listings().Schema,
@@ -94,6 +122,10 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "NetworkPolicies",
 		schema: datasources.NetworkPolicies().Schema,
 	},
+	{
+		name:   "PackagesPolicies",
+		schema: datasources.PackagesPolicies().Schema,
+	},
 	{
 		name:   "PasswordPolicies",
 		schema: datasources.PasswordPolicies().Schema,
@@ -106,14 +138,26 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "Notebooks",
 		schema: datasources.Notebooks().Schema,
 	},
+	{
+		name:   "PrivacyPolicies",
+		schema: datasources.PrivacyPolicies().Schema,
+	},
 	{
 		name:   "Procedures",
 		schema: datasources.Procedures().Schema,
 	},
+	{
+		name:   "ProjectionPolicies",
+		schema: datasources.ProjectionPolicies().Schema,
+	},
 	{
 		name:   "ResourceMonitors",
 		schema: datasources.ResourceMonitors().Schema,
 	},
+	{
+		name:   "RoleHierarchy",
+		schema: datasources.RoleHierarchy().Schema,
+	},
 	{
 		name:   "RowAccessPolicies",
 		schema: datasources.RowAccessPolicies().Schema,
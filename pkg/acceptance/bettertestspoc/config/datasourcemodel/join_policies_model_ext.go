@@ -0,0 +1,30 @@
+package datasourcemodel
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (r *JoinPoliciesModel) WithInDatabase(databaseId sdk.AccountObjectIdentifier) *JoinPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"database": tfconfig.StringVariable(databaseId.Name()),
+		}),
+	)
+}
+
+func (r *JoinPoliciesModel) WithInSchema(schemaId sdk.DatabaseObjectIdentifier) *JoinPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"schema": tfconfig.StringVariable(schemaId.FullyQualifiedName()),
+		}),
+	)
+}
+
+func (r *JoinPoliciesModel) WithInAccount() *JoinPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"account": tfconfig.BoolVariable(true),
+		}),
+	)
+}
@@ -0,0 +1,30 @@
+package datasourcemodel
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (r *PackagesPoliciesModel) WithInDatabase(databaseId sdk.AccountObjectIdentifier) *PackagesPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"database": tfconfig.StringVariable(databaseId.Name()),
+		}),
+	)
+}
+
+func (r *PackagesPoliciesModel) WithInSchema(schemaId sdk.DatabaseObjectIdentifier) *PackagesPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"schema": tfconfig.StringVariable(schemaId.FullyQualifiedName()),
+		}),
+	)
+}
+
+func (r *PackagesPoliciesModel) WithInAccount() *PackagesPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"account": tfconfig.BoolVariable(true),
+		}),
+	)
+}
@@ -0,0 +1,30 @@
+package datasourcemodel
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (r *PrivacyPoliciesModel) WithInDatabase(databaseId sdk.AccountObjectIdentifier) *PrivacyPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"database": tfconfig.StringVariable(databaseId.Name()),
+		}),
+	)
+}
+
+func (r *PrivacyPoliciesModel) WithInSchema(schemaId sdk.DatabaseObjectIdentifier) *PrivacyPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"schema": tfconfig.StringVariable(schemaId.FullyQualifiedName()),
+		}),
+	)
+}
+
+func (r *PrivacyPoliciesModel) WithInAccount() *PrivacyPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"account": tfconfig.BoolVariable(true),
+		}),
+	)
+}
@@ -0,0 +1,30 @@
+package datasourcemodel
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (r *ProjectionPoliciesModel) WithInDatabase(databaseId sdk.AccountObjectIdentifier) *ProjectionPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"database": tfconfig.StringVariable(databaseId.Name()),
+		}),
+	)
+}
+
+func (r *ProjectionPoliciesModel) WithInSchema(schemaId sdk.DatabaseObjectIdentifier) *ProjectionPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"schema": tfconfig.StringVariable(schemaId.FullyQualifiedName()),
+		}),
+	)
+}
+
+func (r *ProjectionPoliciesModel) WithInAccount() *ProjectionPoliciesModel {
+	return r.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"account": tfconfig.BoolVariable(true),
+		}),
+	)
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+type AccessProfilePrivilegeSet struct {
+	ObjectType string
+	Privileges []string
+}
+
+type AccessProfileObjectSelector struct {
+	Database        string
+	DatabasePattern string
+	Schema          string
+	SchemaPattern   string
+	IncludeFuture   *bool
+}
+
+func (a *AccessProfileModel) WithPrivilegeSet(privilegeSets []AccessProfilePrivilegeSet) *AccessProfileModel {
+	maps := collections.Map(privilegeSets, func(p AccessProfilePrivilegeSet) tfconfig.Variable {
+		return tfconfig.MapVariable(map[string]tfconfig.Variable{
+			"object_type": tfconfig.StringVariable(p.ObjectType),
+			"privileges":  tfconfig.SetVariable(collections.Map(p.Privileges, func(privilege string) tfconfig.Variable { return tfconfig.StringVariable(privilege) })...),
+		})
+	})
+	a.PrivilegeSet = tfconfig.SetVariable(maps...)
+	return a
+}
+
+func (a *AccessProfileModel) WithObjectSelector(selectors []AccessProfileObjectSelector) *AccessProfileModel {
+	maps := collections.Map(selectors, func(s AccessProfileObjectSelector) tfconfig.Variable {
+		selector := make(map[string]tfconfig.Variable)
+		for key, value := range map[string]string{
+			"database":         s.Database,
+			"database_pattern": s.DatabasePattern,
+			"schema":           s.Schema,
+			"schema_pattern":   s.SchemaPattern,
+		} {
+			if value != "" {
+				selector[key] = tfconfig.StringVariable(value)
+			}
+		}
+		if s.IncludeFuture != nil {
+			selector["include_future"] = tfconfig.BoolVariable(*s.IncludeFuture)
+		}
+		return tfconfig.MapVariable(selector)
+	})
+	a.ObjectSelector = tfconfig.SetVariable(maps...)
+	return a
+}
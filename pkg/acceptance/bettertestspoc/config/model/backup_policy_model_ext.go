@@ -0,0 +1,12 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func BackupPolicyFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+) *BackupPolicyModel {
+	return BackupPolicy(resourceName, id.DatabaseName(), id.SchemaName(), id.Name())
+}
@@ -0,0 +1,12 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func BackupSetFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+) *BackupSetModel {
+	return BackupSet(resourceName, id.DatabaseName(), id.SchemaName(), id.Name())
+}
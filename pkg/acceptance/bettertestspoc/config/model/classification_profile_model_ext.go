@@ -0,0 +1,50 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func ClassificationProfileFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+) *ClassificationProfileModel {
+	return ClassificationProfile(resourceName, id.DatabaseName(), id.SchemaName(), id.Name())
+}
+
+func (c *ClassificationProfileModel) WithTagMap(entries ...sdk.ClassificationProfileColumnTagMapEntry) *ClassificationProfileModel {
+	columnTagMap := make([]tfconfig.Variable, len(entries))
+	for i, e := range entries {
+		semanticCategories := make([]tfconfig.Variable, len(e.SemanticCategories))
+		for j, category := range e.SemanticCategories {
+			semanticCategories[j] = tfconfig.StringVariable(category)
+		}
+		entry := map[string]tfconfig.Variable{
+			"tag_name":            tfconfig.StringVariable(e.TagName.FullyQualifiedName()),
+			"semantic_categories": tfconfig.SetVariable(semanticCategories...),
+		}
+		if e.TagValue != nil {
+			entry["tag_value"] = tfconfig.StringVariable(*e.TagValue)
+		}
+		columnTagMap[i] = tfconfig.MapVariable(entry)
+	}
+	c.TagMap = tfconfig.ListVariable(
+		tfconfig.MapVariable(map[string]tfconfig.Variable{
+			"column_tag_map": tfconfig.ListVariable(columnTagMap...),
+		}),
+	)
+	return c
+}
+
+func (c *ClassificationProfileModel) WithCustomClassifiers(customClassifiers ...sdk.ClassificationProfileCustomClassifier) *ClassificationProfileModel {
+	maps := make([]tfconfig.Variable, len(customClassifiers))
+	for i, v := range customClassifiers {
+		maps[i] = tfconfig.MapVariable(map[string]tfconfig.Variable{
+			"label":      tfconfig.StringVariable(v.Label),
+			"classifier": tfconfig.StringVariable(v.Classifier.FullyQualifiedName()),
+		})
+	}
+	c.CustomClassifiers = tfconfig.SetVariable(maps...)
+	return c
+}
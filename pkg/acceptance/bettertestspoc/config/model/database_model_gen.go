@@ -13,6 +13,7 @@ import (
 type DatabaseModel struct {
 	Name                                    tfconfig.Variable `json:"name,omitempty"`
 	Catalog                                 tfconfig.Variable `json:"catalog,omitempty"`
+	ClassificationProfile                   tfconfig.Variable `json:"classification_profile,omitempty"`
 	Comment                                 tfconfig.Variable `json:"comment,omitempty"`
 	DataRetentionTimeInDays                 tfconfig.Variable `json:"data_retention_time_in_days,omitempty"`
 	DefaultDdlCollation                     tfconfig.Variable `json:"default_ddl_collation,omitempty"`
@@ -24,6 +25,7 @@ type DatabaseModel struct {
 	LogLevel                                tfconfig.Variable `json:"log_level,omitempty"`
 	MaxDataExtensionTimeInDays              tfconfig.Variable `json:"max_data_extension_time_in_days,omitempty"`
 	QuotedIdentifiersIgnoreCase             tfconfig.Variable `json:"quoted_identifiers_ignore_case,omitempty"`
+	RecoverIfDropped                        tfconfig.Variable `json:"recover_if_dropped,omitempty"`
 	ReplaceInvalidCharacters                tfconfig.Variable `json:"replace_invalid_characters,omitempty"`
 	Replication                             tfconfig.Variable `json:"replication,omitempty"`
 	StorageSerializationPolicy              tfconfig.Variable `json:"storage_serialization_policy,omitempty"`
@@ -106,6 +108,11 @@ func (d *DatabaseModel) WithCatalog(catalog string) *DatabaseModel {
 	return d
 }
 
+func (d *DatabaseModel) WithClassificationProfile(classificationProfile string) *DatabaseModel {
+	d.ClassificationProfile = tfconfig.StringVariable(classificationProfile)
+	return d
+}
+
 func (d *DatabaseModel) WithComment(comment string) *DatabaseModel {
 	d.Comment = tfconfig.StringVariable(comment)
 	return d
@@ -161,6 +168,11 @@ func (d *DatabaseModel) WithQuotedIdentifiersIgnoreCase(quotedIdentifiersIgnoreC
 	return d
 }
 
+func (d *DatabaseModel) WithRecoverIfDropped(recoverIfDropped bool) *DatabaseModel {
+	d.RecoverIfDropped = tfconfig.BoolVariable(recoverIfDropped)
+	return d
+}
+
 func (d *DatabaseModel) WithReplaceInvalidCharacters(replaceInvalidCharacters bool) *DatabaseModel {
 	d.ReplaceInvalidCharacters = tfconfig.BoolVariable(replaceInvalidCharacters)
 	return d
@@ -217,6 +229,11 @@ func (d *DatabaseModel) WithCatalogValue(value tfconfig.Variable) *DatabaseModel
 	return d
 }
 
+func (d *DatabaseModel) WithClassificationProfileValue(value tfconfig.Variable) *DatabaseModel {
+	d.ClassificationProfile = value
+	return d
+}
+
 func (d *DatabaseModel) WithCommentValue(value tfconfig.Variable) *DatabaseModel {
 	d.Comment = value
 	return d
@@ -272,6 +289,11 @@ func (d *DatabaseModel) WithQuotedIdentifiersIgnoreCaseValue(value tfconfig.Vari
 	return d
 }
 
+func (d *DatabaseModel) WithRecoverIfDroppedValue(value tfconfig.Variable) *DatabaseModel {
+	d.RecoverIfDropped = value
+	return d
+}
+
 func (d *DatabaseModel) WithReplaceInvalidCharactersValue(value tfconfig.Variable) *DatabaseModel {
 	d.ReplaceInvalidCharacters = value
 	return d
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"log"
+
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func DbtProjectFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+	from sdk.StageLocation,
+) *DbtProjectModel {
+	d := &DbtProjectModel{ResourceModelMeta: config.Meta(resourceName, resources.DbtProject)}
+	d.WithDatabase(id.DatabaseName())
+	d.WithSchema(id.SchemaName())
+	d.WithName(id.Name())
+	d.WithFrom([]sdk.StageLocation{from})
+	return d
+}
+
+// TODO(SNOW-1501905): Remove after complex non-list type overrides are handled
+func (d *DbtProjectModel) WithFrom(locations []sdk.StageLocation) *DbtProjectModel {
+	if len(locations) != 1 {
+		log.Panicf("expected exactly one location for from, got %d", len(locations))
+	}
+
+	return d.WithFromValue(tfconfig.ListVariable(
+		tfconfig.MapVariable(map[string]tfconfig.Variable{
+			"stage": tfconfig.StringVariable(locations[0].GetStageId().FullyQualifiedName()),
+			"path":  tfconfig.StringVariable(locations[0].GetPath()),
+		}),
+	))
+}
+
+func (d *DbtProjectModel) WithFromWithVersionAlias(location sdk.StageLocation, versionAlias string) *DbtProjectModel {
+	return d.WithFromValue(tfconfig.ListVariable(
+		tfconfig.MapVariable(map[string]tfconfig.Variable{
+			"stage":         tfconfig.StringVariable(location.GetStageId().FullyQualifiedName()),
+			"path":          tfconfig.StringVariable(location.GetPath()),
+			"version_alias": tfconfig.StringVariable(versionAlias),
+		}),
+	))
+}
+
+func (d *DbtProjectModel) WithFromWithVersionTrigger(location sdk.StageLocation, versionTrigger string) *DbtProjectModel {
+	return d.WithFromValue(tfconfig.ListVariable(
+		tfconfig.MapVariable(map[string]tfconfig.Variable{
+			"stage":           tfconfig.StringVariable(location.GetStageId().FullyQualifiedName()),
+			"path":            tfconfig.StringVariable(location.GetPath()),
+			"version_trigger": tfconfig.StringVariable(versionTrigger),
+		}),
+	))
+}
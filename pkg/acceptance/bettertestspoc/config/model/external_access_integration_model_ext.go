@@ -0,0 +1,47 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func ExternalAccessIntegrationFromId(
+	id sdk.AccountObjectIdentifier,
+	networkRules []sdk.SchemaObjectIdentifier,
+	enabled bool,
+) *ExternalAccessIntegrationModel {
+	e := &ExternalAccessIntegrationModel{ResourceModelMeta: config.Meta("test", resources.ExternalAccessIntegration)}
+	e.WithName(id.Name())
+	e.WithAllowedNetworkRuleIds(networkRules...)
+	e.WithEnabled(enabled)
+	return e
+}
+
+func (e *ExternalAccessIntegrationModel) WithAllowedNetworkRules(networkRules []string) *ExternalAccessIntegrationModel {
+	return e.WithAllowedNetworkRulesValue(stringSetVariable(networkRules))
+}
+
+func (e *ExternalAccessIntegrationModel) WithAllowedNetworkRuleIds(networkRules ...sdk.SchemaObjectIdentifier) *ExternalAccessIntegrationModel {
+	return e.WithAllowedNetworkRules(collections.Map(networkRules, sdk.SchemaObjectIdentifier.FullyQualifiedName))
+}
+
+func (e *ExternalAccessIntegrationModel) WithAllowedApiAuthenticationIntegrations(integrations ...sdk.AccountObjectIdentifier) *ExternalAccessIntegrationModel {
+	return e.WithAllowedApiAuthenticationIntegrationsValue(stringSetVariable(collections.Map(integrations, sdk.AccountObjectIdentifier.Name)))
+}
+
+func (e *ExternalAccessIntegrationModel) WithAllowedAuthenticationSecrets(secrets ...sdk.SchemaObjectIdentifier) *ExternalAccessIntegrationModel {
+	return e.WithAllowedAuthenticationSecretsValue(stringSetVariable(collections.Map(secrets, sdk.SchemaObjectIdentifier.FullyQualifiedName)))
+}
+
+func stringSetVariable(values []string) tfconfig.Variable {
+	if len(values) == 0 {
+		return config.EmptyListVariable()
+	}
+	return tfconfig.SetVariable(
+		collections.Map(values, func(v string) tfconfig.Variable { return tfconfig.StringVariable(v) })...,
+	)
+}
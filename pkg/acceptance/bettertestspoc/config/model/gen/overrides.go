@@ -24,6 +24,7 @@ var multilineAttributesOverrides = map[string][]string{
 }
 
 var complexListAttributesOverrides = map[string]map[string]string{
+	"AccessProfile":                 {"privilege_set": "AccessProfilePrivilegeSet", "object_selector": "AccessProfileObjectSelector"},
 	"GrantOwnership":                {"on": "sdk.OwnershipGrantOn"},
 	"CatalogIntegrationOpenCatalog": {"rest_config": "sdk.OpenCatalogRestConfigRequest", "rest_authentication": "sdk.OAuthRestAuthenticationRequest"},
 	"CatalogIntegrationIcebergRest": {"rest_config": "sdk.IcebergRestRestConfigRequest", "oauth_rest_authentication": "sdk.OAuthRestAuthenticationRequest", "bearer_rest_authentication": "sdk.BearerRestAuthenticationRequest", "sigv4_rest_authentication": "sdk.SigV4RestAuthenticationRequest"}, //nolint:gosec // field-name mapping, not a credential
@@ -31,10 +32,12 @@ var complexListAttributesOverrides = map[string]map[string]string{
 	"Listing":                       {"manifest": "sdk.StageLocation"},
 	"MaskingPolicy":                 {"argument": "sdk.TableColumnSignature"},
 	"RowAccessPolicy":               {"argument": "sdk.TableColumnSignature"},
+	"StorageLifecyclePolicy":        {"argument": "sdk.TableColumnSignature"},
 	"TagAssociation":                {"object_identifiers": "sdk.ObjectIdentifier"},
 	// TODO [SNOW-1348114]: use better type for override (not null and default are currently not supported)
 	"Table":                   {"column": "sdk.TableColumnSignature"},
 	"SemanticView":            {"tables": "sdk.LogicalTable", "metrics": "sdk.MetricDefinition", "facts": "sdk.SemanticExpression", "dimensions": "sdk.SemanticExpression", "relationships": "sdk.SemanticViewRelationship"},
+	"DbtProject":              {"from": "sdk.StageLocation"},
 	"DynamicTable":            {"target_lag": "sdk.TargetLag"},
 	"StorageIntegrationAws":   {"storage_allowed_locations": "sdk.StorageLocation"},
 	"StorageIntegrationAzure": {"storage_allowed_locations": "sdk.StorageLocation"},
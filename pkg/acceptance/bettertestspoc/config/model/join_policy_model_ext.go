@@ -0,0 +1,20 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func JoinPolicyFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+	body string,
+) *JoinPolicyModel {
+	m := &JoinPolicyModel{ResourceModelMeta: config.Meta(resourceName, resources.JoinPolicy)}
+	m.WithDatabase(id.DatabaseName())
+	m.WithSchema(id.SchemaName())
+	m.WithName(id.Name())
+	m.WithBody(body)
+	return m
+}
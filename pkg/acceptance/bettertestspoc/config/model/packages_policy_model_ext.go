@@ -0,0 +1,36 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func PackagesPolicyFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+) *PackagesPolicyModel {
+	p := &PackagesPolicyModel{ResourceModelMeta: config.Meta(resourceName, resources.PackagesPolicy)}
+	p.WithDatabase(id.DatabaseName())
+	p.WithSchema(id.SchemaName())
+	p.WithName(id.Name())
+	return p
+}
+
+func (p *PackagesPolicyModel) WithAllowlist(packageSpecs ...string) *PackagesPolicyModel {
+	return p.WithAllowlistValue(packageSpecsVariable(packageSpecs))
+}
+
+func (p *PackagesPolicyModel) WithBlocklist(packageSpecs ...string) *PackagesPolicyModel {
+	return p.WithBlocklistValue(packageSpecsVariable(packageSpecs))
+}
+
+func (p *PackagesPolicyModel) WithAdditionalCreationBlocklist(packageSpecs ...string) *PackagesPolicyModel {
+	return p.WithAdditionalCreationBlocklistValue(packageSpecsVariable(packageSpecs))
+}
+
+func packageSpecsVariable(packageSpecs []string) tfconfig.Variable {
+	return tfconfig.SetVariable(collections.Map(packageSpecs, func(packageSpec string) tfconfig.Variable { return tfconfig.StringVariable(packageSpec) })...)
+}
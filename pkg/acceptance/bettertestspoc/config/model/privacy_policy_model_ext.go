@@ -0,0 +1,20 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func PrivacyPolicyFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+	body string,
+) *PrivacyPolicyModel {
+	m := &PrivacyPolicyModel{ResourceModelMeta: config.Meta(resourceName, resources.PrivacyPolicy)}
+	m.WithDatabase(id.DatabaseName())
+	m.WithSchema(id.SchemaName())
+	m.WithName(id.Name())
+	m.WithBody(body)
+	return m
+}
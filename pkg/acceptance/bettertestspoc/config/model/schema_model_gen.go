@@ -14,6 +14,7 @@ type SchemaModel struct {
 	Database                                tfconfig.Variable `json:"database,omitempty"`
 	Name                                    tfconfig.Variable `json:"name,omitempty"`
 	Catalog                                 tfconfig.Variable `json:"catalog,omitempty"`
+	ClassificationProfile                   tfconfig.Variable `json:"classification_profile,omitempty"`
 	Comment                                 tfconfig.Variable `json:"comment,omitempty"`
 	DataRetentionTimeInDays                 tfconfig.Variable `json:"data_retention_time_in_days,omitempty"`
 	DefaultDdlCollation                     tfconfig.Variable `json:"default_ddl_collation,omitempty"`
@@ -25,6 +26,7 @@ type SchemaModel struct {
 	MaxDataExtensionTimeInDays              tfconfig.Variable `json:"max_data_extension_time_in_days,omitempty"`
 	PipeExecutionPaused                     tfconfig.Variable `json:"pipe_execution_paused,omitempty"`
 	QuotedIdentifiersIgnoreCase             tfconfig.Variable `json:"quoted_identifiers_ignore_case,omitempty"`
+	RecoverIfDropped                        tfconfig.Variable `json:"recover_if_dropped,omitempty"`
 	ReplaceInvalidCharacters                tfconfig.Variable `json:"replace_invalid_characters,omitempty"`
 	StorageSerializationPolicy              tfconfig.Variable `json:"storage_serialization_policy,omitempty"`
 	SuspendTaskAfterNumFailures             tfconfig.Variable `json:"suspend_task_after_num_failures,omitempty"`
@@ -116,6 +118,11 @@ func (s *SchemaModel) WithCatalog(catalog string) *SchemaModel {
 	return s
 }
 
+func (s *SchemaModel) WithClassificationProfile(classificationProfile string) *SchemaModel {
+	s.ClassificationProfile = tfconfig.StringVariable(classificationProfile)
+	return s
+}
+
 func (s *SchemaModel) WithComment(comment string) *SchemaModel {
 	s.Comment = tfconfig.StringVariable(comment)
 	return s
@@ -171,6 +178,11 @@ func (s *SchemaModel) WithQuotedIdentifiersIgnoreCase(quotedIdentifiersIgnoreCas
 	return s
 }
 
+func (s *SchemaModel) WithRecoverIfDropped(recoverIfDropped bool) *SchemaModel {
+	s.RecoverIfDropped = tfconfig.BoolVariable(recoverIfDropped)
+	return s
+}
+
 func (s *SchemaModel) WithReplaceInvalidCharacters(replaceInvalidCharacters bool) *SchemaModel {
 	s.ReplaceInvalidCharacters = tfconfig.BoolVariable(replaceInvalidCharacters)
 	return s
@@ -235,6 +247,11 @@ func (s *SchemaModel) WithCatalogValue(value tfconfig.Variable) *SchemaModel {
 	return s
 }
 
+func (s *SchemaModel) WithClassificationProfileValue(value tfconfig.Variable) *SchemaModel {
+	s.ClassificationProfile = value
+	return s
+}
+
 func (s *SchemaModel) WithCommentValue(value tfconfig.Variable) *SchemaModel {
 	s.Comment = value
 	return s
@@ -290,6 +307,11 @@ func (s *SchemaModel) WithQuotedIdentifiersIgnoreCaseValue(value tfconfig.Variab
 	return s
 }
 
+func (s *SchemaModel) WithRecoverIfDroppedValue(value tfconfig.Variable) *SchemaModel {
+	s.RecoverIfDropped = value
+	return s
+}
+
 func (s *SchemaModel) WithReplaceInvalidCharactersValue(value tfconfig.Variable) *SchemaModel {
 	s.ReplaceInvalidCharacters = value
 	return s
@@ -126,3 +126,13 @@ func (f *ServiceModel) WithExternalAccessIntegrations(ids ...sdk.AccountObjectId
 		),
 	)
 }
+
+func (s *ServiceModel) WithRestoreFromSnapshot(volume string, snapshotId sdk.SchemaObjectIdentifier, instances ...int) *ServiceModel {
+	return s.WithRestoreFromSnapshotValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		"volume": tfconfig.StringVariable(volume),
+		"instances": tfconfig.ListVariable(
+			collections.Map(instances, func(instance int) tfconfig.Variable { return tfconfig.IntegerVariable(instance) })...,
+		),
+		"snapshot": tfconfig.StringVariable(snapshotId.FullyQualifiedName()),
+	}))
+}
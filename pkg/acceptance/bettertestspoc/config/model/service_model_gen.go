@@ -26,6 +26,7 @@ type ServiceModel struct {
 	MinInstances               tfconfig.Variable `json:"min_instances,omitempty"`
 	MinReadyInstances          tfconfig.Variable `json:"min_ready_instances,omitempty"`
 	QueryWarehouse             tfconfig.Variable `json:"query_warehouse,omitempty"`
+	RestoreFromSnapshot        tfconfig.Variable `json:"restore_from_snapshot,omitempty"`
 	ServiceType                tfconfig.Variable `json:"service_type,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -168,6 +169,8 @@ func (s *ServiceModel) WithQueryWarehouse(queryWarehouse string) *ServiceModel {
 	return s
 }
 
+// restore_from_snapshot attribute type is not yet supported, so WithRestoreFromSnapshot can't be generated
+
 func (s *ServiceModel) WithServiceType(serviceType string) *ServiceModel {
 	s.ServiceType = tfconfig.StringVariable(serviceType)
 	return s
@@ -252,6 +255,11 @@ func (s *ServiceModel) WithQueryWarehouseValue(value tfconfig.Variable) *Service
 	return s
 }
 
+func (s *ServiceModel) WithRestoreFromSnapshotValue(value tfconfig.Variable) *ServiceModel {
+	s.RestoreFromSnapshot = value
+	return s
+}
+
 func (s *ServiceModel) WithServiceTypeValue(value tfconfig.Variable) *ServiceModel {
 	s.ServiceType = value
 	return s
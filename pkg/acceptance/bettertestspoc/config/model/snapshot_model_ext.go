@@ -0,0 +1,15 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func SnapshotFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+	serviceId sdk.SchemaObjectIdentifier,
+	volume string,
+	instance int,
+) *SnapshotModel {
+	return Snapshot(resourceName, id.DatabaseName(), id.SchemaName(), id.Name(), instance, serviceId.FullyQualifiedName(), volume)
+}
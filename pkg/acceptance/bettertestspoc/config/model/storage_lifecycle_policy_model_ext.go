@@ -0,0 +1,36 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func StorageLifecyclePolicyFromId(
+	resourceName string,
+	id sdk.SchemaObjectIdentifier,
+	argument []sdk.TableColumnSignature,
+	body string,
+) *StorageLifecyclePolicyModel {
+	m := &StorageLifecyclePolicyModel{ResourceModelMeta: config.Meta(resourceName, resources.StorageLifecyclePolicy)}
+	m.WithDatabase(id.DatabaseName())
+	m.WithSchema(id.SchemaName())
+	m.WithName(id.Name())
+	m.WithArgument(argument)
+	m.WithBody(body)
+	return m
+}
+
+func (s *StorageLifecyclePolicyModel) WithArgument(argument []sdk.TableColumnSignature) *StorageLifecyclePolicyModel {
+	maps := make([]tfconfig.Variable, len(argument))
+	for i, v := range argument {
+		maps[i] = tfconfig.MapVariable(map[string]tfconfig.Variable{
+			"name": tfconfig.StringVariable(v.Name),
+			"type": tfconfig.StringVariable(v.Type.ToSql()),
+		})
+	}
+	s.Argument = tfconfig.ListVariable(maps...)
+	return s
+}
@@ -17,6 +17,7 @@ type StreamOnDirectoryTableModel struct {
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
 	CopyGrants         tfconfig.Variable `json:"copy_grants,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	RecreateWhenStale  tfconfig.Variable `json:"recreate_when_stale,omitempty"`
 	Stage              tfconfig.Variable `json:"stage,omitempty"`
 	Stale              tfconfig.Variable `json:"stale,omitempty"`
 	StreamType         tfconfig.Variable `json:"stream_type,omitempty"`
@@ -125,6 +126,11 @@ func (s *StreamOnDirectoryTableModel) WithFullyQualifiedName(fullyQualifiedName
 	return s
 }
 
+func (s *StreamOnDirectoryTableModel) WithRecreateWhenStale(recreateWhenStale bool) *StreamOnDirectoryTableModel {
+	s.RecreateWhenStale = tfconfig.BoolVariable(recreateWhenStale)
+	return s
+}
+
 func (s *StreamOnDirectoryTableModel) WithStage(stage string) *StreamOnDirectoryTableModel {
 	s.Stage = tfconfig.StringVariable(stage)
 	return s
@@ -174,6 +180,11 @@ func (s *StreamOnDirectoryTableModel) WithFullyQualifiedNameValue(value tfconfig
 	return s
 }
 
+func (s *StreamOnDirectoryTableModel) WithRecreateWhenStaleValue(value tfconfig.Variable) *StreamOnDirectoryTableModel {
+	s.RecreateWhenStale = value
+	return s
+}
+
 func (s *StreamOnDirectoryTableModel) WithStageValue(value tfconfig.Variable) *StreamOnDirectoryTableModel {
 	s.Stage = value
 	return s
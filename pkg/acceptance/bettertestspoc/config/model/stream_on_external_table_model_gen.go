@@ -21,6 +21,7 @@ type StreamOnExternalTableModel struct {
 	ExternalTable      tfconfig.Variable `json:"external_table,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	InsertOnly         tfconfig.Variable `json:"insert_only,omitempty"`
+	RecreateWhenStale  tfconfig.Variable `json:"recreate_when_stale,omitempty"`
 	Stale              tfconfig.Variable `json:"stale,omitempty"`
 	StreamType         tfconfig.Variable `json:"stream_type,omitempty"`
 
@@ -142,6 +143,11 @@ func (s *StreamOnExternalTableModel) WithInsertOnly(insertOnly string) *StreamOn
 	return s
 }
 
+func (s *StreamOnExternalTableModel) WithRecreateWhenStale(recreateWhenStale bool) *StreamOnExternalTableModel {
+	s.RecreateWhenStale = tfconfig.BoolVariable(recreateWhenStale)
+	return s
+}
+
 func (s *StreamOnExternalTableModel) WithStale(stale bool) *StreamOnExternalTableModel {
 	s.Stale = tfconfig.BoolVariable(stale)
 	return s
@@ -206,6 +212,11 @@ func (s *StreamOnExternalTableModel) WithInsertOnlyValue(value tfconfig.Variable
 	return s
 }
 
+func (s *StreamOnExternalTableModel) WithRecreateWhenStaleValue(value tfconfig.Variable) *StreamOnExternalTableModel {
+	s.RecreateWhenStale = value
+	return s
+}
+
 func (s *StreamOnExternalTableModel) WithStaleValue(value tfconfig.Variable) *StreamOnExternalTableModel {
 	s.Stale = value
 	return s
@@ -20,6 +20,7 @@ type StreamOnTableModel struct {
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
 	CopyGrants         tfconfig.Variable `json:"copy_grants,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	RecreateWhenStale  tfconfig.Variable `json:"recreate_when_stale,omitempty"`
 	ShowInitialRows    tfconfig.Variable `json:"show_initial_rows,omitempty"`
 	Stale              tfconfig.Variable `json:"stale,omitempty"`
 	StreamType         tfconfig.Variable `json:"stream_type,omitempty"`
@@ -138,6 +139,11 @@ func (s *StreamOnTableModel) WithFullyQualifiedName(fullyQualifiedName string) *
 	return s
 }
 
+func (s *StreamOnTableModel) WithRecreateWhenStale(recreateWhenStale bool) *StreamOnTableModel {
+	s.RecreateWhenStale = tfconfig.BoolVariable(recreateWhenStale)
+	return s
+}
+
 func (s *StreamOnTableModel) WithShowInitialRows(showInitialRows string) *StreamOnTableModel {
 	s.ShowInitialRows = tfconfig.StringVariable(showInitialRows)
 	return s
@@ -207,6 +213,11 @@ func (s *StreamOnTableModel) WithFullyQualifiedNameValue(value tfconfig.Variable
 	return s
 }
 
+func (s *StreamOnTableModel) WithRecreateWhenStaleValue(value tfconfig.Variable) *StreamOnTableModel {
+	s.RecreateWhenStale = value
+	return s
+}
+
 func (s *StreamOnTableModel) WithShowInitialRowsValue(value tfconfig.Variable) *StreamOnTableModel {
 	s.ShowInitialRows = value
 	return s
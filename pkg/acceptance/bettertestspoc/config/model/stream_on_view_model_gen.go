@@ -20,6 +20,7 @@ type StreamOnViewModel struct {
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
 	CopyGrants         tfconfig.Variable `json:"copy_grants,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	RecreateWhenStale  tfconfig.Variable `json:"recreate_when_stale,omitempty"`
 	ShowInitialRows    tfconfig.Variable `json:"show_initial_rows,omitempty"`
 	Stale              tfconfig.Variable `json:"stale,omitempty"`
 	StreamType         tfconfig.Variable `json:"stream_type,omitempty"`
@@ -138,6 +139,11 @@ func (s *StreamOnViewModel) WithFullyQualifiedName(fullyQualifiedName string) *S
 	return s
 }
 
+func (s *StreamOnViewModel) WithRecreateWhenStale(recreateWhenStale bool) *StreamOnViewModel {
+	s.RecreateWhenStale = tfconfig.BoolVariable(recreateWhenStale)
+	return s
+}
+
 func (s *StreamOnViewModel) WithShowInitialRows(showInitialRows string) *StreamOnViewModel {
 	s.ShowInitialRows = tfconfig.StringVariable(showInitialRows)
 	return s
@@ -207,6 +213,11 @@ func (s *StreamOnViewModel) WithFullyQualifiedNameValue(value tfconfig.Variable)
 	return s
 }
 
+func (s *StreamOnViewModel) WithRecreateWhenStaleValue(value tfconfig.Variable) *StreamOnViewModel {
+	s.RecreateWhenStale = value
+	return s
+}
+
 func (s *StreamOnViewModel) WithShowInitialRowsValue(value tfconfig.Variable) *StreamOnViewModel {
 	s.ShowInitialRows = value
 	return s
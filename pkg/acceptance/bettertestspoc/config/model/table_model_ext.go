@@ -25,3 +25,48 @@ func (t *TableModel) WithColumn(column []sdk.TableColumnSignature) *TableModel {
 	t.Column = tfconfig.SetVariable(maps...)
 	return t
 }
+
+func (t *TableModel) WithStorageLifecyclePolicy(policyId sdk.SchemaObjectIdentifier, on ...string) *TableModel {
+	onVariables := make([]tfconfig.Variable, len(on))
+	for i, v := range on {
+		onVariables[i] = tfconfig.StringVariable(v)
+	}
+	return t.WithStorageLifecyclePolicyValue(
+		tfconfig.ObjectVariable(
+			map[string]tfconfig.Variable{
+				"policy_name": tfconfig.StringVariable(policyId.FullyQualifiedName()),
+				"on":          tfconfig.SetVariable(onVariables...),
+			},
+		),
+	)
+}
+
+func (t *TableModel) WithJoinPolicy(policyId sdk.SchemaObjectIdentifier, allowedJoinKeys ...string) *TableModel {
+	keys := make([]tfconfig.Variable, len(allowedJoinKeys))
+	for i, v := range allowedJoinKeys {
+		keys[i] = tfconfig.StringVariable(v)
+	}
+	return t.WithJoinPolicyValue(
+		tfconfig.ObjectVariable(
+			map[string]tfconfig.Variable{
+				"policy_name":       tfconfig.StringVariable(policyId.FullyQualifiedName()),
+				"allowed_join_keys": tfconfig.SetVariable(keys...),
+			},
+		),
+	)
+}
+
+func (t *TableModel) WithPrivacyPolicy(policyId sdk.SchemaObjectIdentifier, entityKey ...string) *TableModel {
+	keys := make([]tfconfig.Variable, len(entityKey))
+	for i, v := range entityKey {
+		keys[i] = tfconfig.StringVariable(v)
+	}
+	return t.WithPrivacyPolicyValue(
+		tfconfig.ObjectVariable(
+			map[string]tfconfig.Variable{
+				"policy_name": tfconfig.StringVariable(policyId.FullyQualifiedName()),
+				"entity_key":  tfconfig.SetVariable(keys...),
+			},
+		),
+	)
+}
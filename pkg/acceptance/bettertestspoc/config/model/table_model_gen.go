@@ -15,14 +15,23 @@ type TableModel struct {
 	Database                tfconfig.Variable `json:"database,omitempty"`
 	Schema                  tfconfig.Variable `json:"schema,omitempty"`
 	Name                    tfconfig.Variable `json:"name,omitempty"`
+	AggregationPolicy       tfconfig.Variable `json:"aggregation_policy,omitempty"`
 	ChangeTracking          tfconfig.Variable `json:"change_tracking,omitempty"`
 	ClusterBy               tfconfig.Variable `json:"cluster_by,omitempty"`
 	Column                  tfconfig.Variable `json:"column,omitempty"`
 	Comment                 tfconfig.Variable `json:"comment,omitempty"`
 	DataRetentionTimeInDays tfconfig.Variable `json:"data_retention_time_in_days,omitempty"`
+	EnableSchemaEvolution   tfconfig.Variable `json:"enable_schema_evolution,omitempty"`
 	FullyQualifiedName      tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	IsTransient             tfconfig.Variable `json:"is_transient,omitempty"`
+	JoinPolicy              tfconfig.Variable `json:"join_policy,omitempty"`
 	Owner                   tfconfig.Variable `json:"owner,omitempty"`
 	PrimaryKey              tfconfig.Variable `json:"primary_key,omitempty"`
+	PrivacyPolicy           tfconfig.Variable `json:"privacy_policy,omitempty"`
+	RecoverIfDropped        tfconfig.Variable `json:"recover_if_dropped,omitempty"`
+	RowAccessPolicy         tfconfig.Variable `json:"row_access_policy,omitempty"`
+	SearchOptimization      tfconfig.Variable `json:"search_optimization,omitempty"`
+	StorageLifecyclePolicy  tfconfig.Variable `json:"storage_lifecycle_policy,omitempty"`
 	Tag                     tfconfig.Variable `json:"tag,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -114,6 +123,8 @@ func (t *TableModel) WithName(name string) *TableModel {
 	return t
 }
 
+// aggregation_policy attribute type is not yet supported, so WithAggregationPolicy can't be generated
+
 func (t *TableModel) WithChangeTracking(changeTracking bool) *TableModel {
 	t.ChangeTracking = tfconfig.BoolVariable(changeTracking)
 	return t
@@ -133,11 +144,23 @@ func (t *TableModel) WithDataRetentionTimeInDays(dataRetentionTimeInDays int) *T
 	return t
 }
 
+func (t *TableModel) WithEnableSchemaEvolution(enableSchemaEvolution bool) *TableModel {
+	t.EnableSchemaEvolution = tfconfig.BoolVariable(enableSchemaEvolution)
+	return t
+}
+
 func (t *TableModel) WithFullyQualifiedName(fullyQualifiedName string) *TableModel {
 	t.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
 	return t
 }
 
+func (t *TableModel) WithIsTransient(isTransient bool) *TableModel {
+	t.IsTransient = tfconfig.BoolVariable(isTransient)
+	return t
+}
+
+// join_policy attribute type is not yet supported, so WithJoinPolicy can't be generated
+
 func (t *TableModel) WithOwner(owner string) *TableModel {
 	t.Owner = tfconfig.StringVariable(owner)
 	return t
@@ -145,6 +168,22 @@ func (t *TableModel) WithOwner(owner string) *TableModel {
 
 // primary_key attribute type is not yet supported, so WithPrimaryKey can't be generated
 
+// privacy_policy attribute type is not yet supported, so WithPrivacyPolicy can't be generated
+
+func (t *TableModel) WithRecoverIfDropped(recoverIfDropped bool) *TableModel {
+	t.RecoverIfDropped = tfconfig.BoolVariable(recoverIfDropped)
+	return t
+}
+
+// row_access_policy attribute type is not yet supported, so WithRowAccessPolicy can't be generated
+
+func (t *TableModel) WithSearchOptimization(searchOptimization bool) *TableModel {
+	t.SearchOptimization = tfconfig.BoolVariable(searchOptimization)
+	return t
+}
+
+// storage_lifecycle_policy attribute type is not yet supported, so WithStorageLifecyclePolicy can't be generated
+
 // tag attribute type is not yet supported, so WithTag can't be generated
 
 //////////////////////////////////////////
@@ -166,6 +205,11 @@ func (t *TableModel) WithNameValue(value tfconfig.Variable) *TableModel {
 	return t
 }
 
+func (t *TableModel) WithAggregationPolicyValue(value tfconfig.Variable) *TableModel {
+	t.AggregationPolicy = value
+	return t
+}
+
 func (t *TableModel) WithChangeTrackingValue(value tfconfig.Variable) *TableModel {
 	t.ChangeTracking = value
 	return t
@@ -191,11 +235,26 @@ func (t *TableModel) WithDataRetentionTimeInDaysValue(value tfconfig.Variable) *
 	return t
 }
 
+func (t *TableModel) WithEnableSchemaEvolutionValue(value tfconfig.Variable) *TableModel {
+	t.EnableSchemaEvolution = value
+	return t
+}
+
 func (t *TableModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *TableModel {
 	t.FullyQualifiedName = value
 	return t
 }
 
+func (t *TableModel) WithIsTransientValue(value tfconfig.Variable) *TableModel {
+	t.IsTransient = value
+	return t
+}
+
+func (t *TableModel) WithJoinPolicyValue(value tfconfig.Variable) *TableModel {
+	t.JoinPolicy = value
+	return t
+}
+
 func (t *TableModel) WithOwnerValue(value tfconfig.Variable) *TableModel {
 	t.Owner = value
 	return t
@@ -206,6 +265,31 @@ func (t *TableModel) WithPrimaryKeyValue(value tfconfig.Variable) *TableModel {
 	return t
 }
 
+func (t *TableModel) WithPrivacyPolicyValue(value tfconfig.Variable) *TableModel {
+	t.PrivacyPolicy = value
+	return t
+}
+
+func (t *TableModel) WithRecoverIfDroppedValue(value tfconfig.Variable) *TableModel {
+	t.RecoverIfDropped = value
+	return t
+}
+
+func (t *TableModel) WithRowAccessPolicyValue(value tfconfig.Variable) *TableModel {
+	t.RowAccessPolicy = value
+	return t
+}
+
+func (t *TableModel) WithSearchOptimizationValue(value tfconfig.Variable) *TableModel {
+	t.SearchOptimization = value
+	return t
+}
+
+func (t *TableModel) WithStorageLifecyclePolicyValue(value tfconfig.Variable) *TableModel {
+	t.StorageLifecyclePolicy = value
+	return t
+}
+
 func (t *TableModel) WithTagValue(value tfconfig.Variable) *TableModel {
 	t.Tag = value
 	return t
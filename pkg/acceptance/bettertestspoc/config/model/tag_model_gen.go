@@ -22,6 +22,7 @@ type TagModel struct {
 	OnConflict           tfconfig.Variable `json:"on_conflict,omitempty"`
 	OrderedAllowedValues tfconfig.Variable `json:"ordered_allowed_values,omitempty"`
 	Propagate            tfconfig.Variable `json:"propagate,omitempty"`
+	RecoverIfDropped     tfconfig.Variable `json:"recover_if_dropped,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -136,6 +137,11 @@ func (t *TagModel) WithPropagate(propagate string) *TagModel {
 	return t
 }
 
+func (t *TagModel) WithRecoverIfDropped(recoverIfDropped bool) *TagModel {
+	t.RecoverIfDropped = tfconfig.BoolVariable(recoverIfDropped)
+	return t
+}
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -194,3 +200,8 @@ func (t *TagModel) WithPropagateValue(value tfconfig.Variable) *TagModel {
 	t.Propagate = value
 	return t
 }
+
+func (t *TagModel) WithRecoverIfDroppedValue(value tfconfig.Variable) *TagModel {
+	t.RecoverIfDropped = value
+	return t
+}
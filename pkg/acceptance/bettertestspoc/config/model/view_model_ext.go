@@ -40,6 +40,28 @@ func (v *ViewModel) WithAggregationPolicy(ap sdk.SchemaObjectIdentifier, key str
 	)
 }
 
+func (v *ViewModel) WithJoinPolicy(jp sdk.SchemaObjectIdentifier, allowedJoinKey string) *ViewModel {
+	return v.WithJoinPolicyValue(
+		config.ObjectVariable(
+			map[string]config.Variable{
+				"policy_name":       config.StringVariable(jp.FullyQualifiedName()),
+				"allowed_join_keys": config.ListVariable(config.StringVariable(allowedJoinKey)),
+			},
+		),
+	)
+}
+
+func (v *ViewModel) WithPrivacyPolicy(pp sdk.SchemaObjectIdentifier, key string) *ViewModel {
+	return v.WithPrivacyPolicyValue(
+		config.ObjectVariable(
+			map[string]config.Variable{
+				"policy_name": config.StringVariable(pp.FullyQualifiedName()),
+				"entity_key":  config.ListVariable(config.StringVariable(key)),
+			},
+		),
+	)
+}
+
 func (v *ViewModel) WithDataMetricFunction(functionId sdk.SchemaObjectIdentifier, on string, scheduleStatus sdk.DataMetricScheduleStatusOption) *ViewModel {
 	return v.WithDataMetricFunctionValue(
 		config.ObjectVariable(
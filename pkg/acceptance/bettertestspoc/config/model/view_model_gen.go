@@ -25,6 +25,8 @@ type ViewModel struct {
 	IsRecursive        tfconfig.Variable `json:"is_recursive,omitempty"`
 	IsSecure           tfconfig.Variable `json:"is_secure,omitempty"`
 	IsTemporary        tfconfig.Variable `json:"is_temporary,omitempty"`
+	JoinPolicy         tfconfig.Variable `json:"join_policy,omitempty"`
+	PrivacyPolicy      tfconfig.Variable `json:"privacy_policy,omitempty"`
 	RowAccessPolicy    tfconfig.Variable `json:"row_access_policy,omitempty"`
 	Statement          tfconfig.Variable `json:"statement,omitempty"`
 
@@ -160,6 +162,10 @@ func (v *ViewModel) WithIsTemporary(isTemporary string) *ViewModel {
 	return v
 }
 
+// join_policy attribute type is not yet supported, so WithJoinPolicy can't be generated
+
+// privacy_policy attribute type is not yet supported, so WithPrivacyPolicy can't be generated
+
 // row_access_policy attribute type is not yet supported, so WithRowAccessPolicy can't be generated
 
 func (v *ViewModel) WithStatement(statement string) *ViewModel {
@@ -241,6 +247,16 @@ func (v *ViewModel) WithIsTemporaryValue(value tfconfig.Variable) *ViewModel {
 	return v
 }
 
+func (v *ViewModel) WithJoinPolicyValue(value tfconfig.Variable) *ViewModel {
+	v.JoinPolicy = value
+	return v
+}
+
+func (v *ViewModel) WithPrivacyPolicyValue(value tfconfig.Variable) *ViewModel {
+	v.PrivacyPolicy = value
+	return v
+}
+
 func (v *ViewModel) WithRowAccessPolicyValue(value tfconfig.Variable) *ViewModel {
 	v.RowAccessPolicy = value
 	return v
@@ -0,0 +1,39 @@
+// Package fakeprovider allows running the provider against the in-memory fake client (see sdk.NewFakeClient),
+// so that resource.UnitTest can verify create/read/update/delete/import cycles without a Snowflake account.
+// The fake client is available only with the fake_client_tests build tag (e.g. go test -tags fake_client_tests ./...).
+package fakeprovider
+
+import (
+	"context"
+
+	internalprovider "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderFactories returns provider factories with the provider configured to use the given client.
+// All preview features are enabled, so that every resource can be used in the tests.
+// The provider configuration block is ignored, no connection is established.
+func ProviderFactories(client *sdk.Client) map[string]func() (tfprotov6.ProviderServer, error) {
+	p := provider.Provider()
+	p.ConfigureContextFunc = func(_ context.Context, _ *schema.ResourceData) (any, diag.Diagnostics) {
+		return &internalprovider.Context{
+			Client:          client,
+			EnabledFeatures: previewfeatures.AllPreviewFeatures,
+		}, nil
+	}
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"snowflake": func() (tfprotov6.ProviderServer, error) {
+			return tf5to6server.UpgradeServer(
+				context.Background(),
+				p.GRPCProvider,
+			)
+		},
+	}
+}
@@ -0,0 +1,281 @@
+//go:build fake_client_tests
+
+package fakeprovider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/fakeprovider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/importchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeProvider_DatabaseSchemaWarehouseAndRole(t *testing.T) {
+	client := sdk.NewFakeClient(sdk.NewFakeCatalog())
+
+	config := func(comment string) string {
+		return `
+resource "snowflake_database" "test" {
+  name    = "DB"
+  comment = "` + comment + `"
+}
+
+resource "snowflake_schema" "test" {
+  database            = snowflake_database.test.name
+  name                = "SCHEMA"
+  with_managed_access = "true"
+  comment             = "` + comment + `"
+}
+
+resource "snowflake_warehouse" "test" {
+  name           = "WH"
+  warehouse_size = "SMALL"
+  auto_suspend   = 120
+  comment        = "` + comment + `"
+}
+
+resource "snowflake_account_role" "test" {
+  name    = "ROLE"
+  comment = "` + comment + `"
+}
+
+resource "snowflake_grant_account_role" "test" {
+  role_name        = snowflake_account_role.test.name
+  parent_role_name = "SYSADMIN"
+}
+
+resource "snowflake_grant_privileges_to_account_role" "test" {
+  account_role_name = snowflake_account_role.test.name
+  privileges        = ["USAGE", "MONITOR"]
+  on_account_object {
+    object_type = "DATABASE"
+    object_name = snowflake_database.test.name
+  }
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: fakeprovider.ProviderFactories(client),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			ctx := context.Background()
+			for _, check := range []func() error{
+				func() error {
+					_, err := client.Databases.ShowByID(ctx, sdk.NewAccountObjectIdentifier("DB"))
+					return err
+				},
+				func() error {
+					_, err := client.Warehouses.ShowByID(ctx, sdk.NewAccountObjectIdentifier("WH"))
+					return err
+				},
+				func() error { _, err := client.Roles.ShowByID(ctx, sdk.NewAccountObjectIdentifier("ROLE")); return err },
+			} {
+				require.ErrorIs(t, check(), sdk.ErrObjectNotFound)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_database.test", "comment", "first"),
+					resource.TestCheckResourceAttr("snowflake_schema.test", "fully_qualified_name", `"DB"."SCHEMA"`),
+					resource.TestCheckResourceAttr("snowflake_schema.test", "show_output.0.options", "MANAGED ACCESS"),
+					resource.TestCheckResourceAttr("snowflake_warehouse.test", "show_output.0.size", "SMALL"),
+					resource.TestCheckResourceAttr("snowflake_warehouse.test", "show_output.0.auto_suspend", "120"),
+					resource.TestCheckResourceAttr("snowflake_grant_privileges_to_account_role.test", "privileges.#", "2"),
+				),
+			},
+			{
+				Config: config("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_database.test", "comment", "second"),
+					resource.TestCheckResourceAttr("snowflake_schema.test", "comment", "second"),
+					resource.TestCheckResourceAttr("snowflake_warehouse.test", "show_output.0.comment", "second"),
+					resource.TestCheckResourceAttr("snowflake_account_role.test", "show_output.0.comment", "second"),
+					resource.TestCheckResourceAttr("snowflake_account_role.test", "show_output.0.granted_to_roles", "1"),
+					resource.TestCheckResourceAttr("snowflake_account_role.test", "show_output.0.owner", sdk.FakeRole),
+				),
+			},
+			{
+				ResourceName:      "snowflake_database.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "snowflake_schema.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"is_transient"},
+			},
+			{
+				ResourceName: "snowflake_warehouse.test",
+				ImportState:  true,
+				ImportStateCheck: importchecks.ComposeAggregateImportStateCheck(
+					importchecks.TestCheckResourceAttrInstanceState("WH", "name", "WH"),
+					importchecks.TestCheckResourceAttrInstanceState("WH", "warehouse_size", "SMALL"),
+					importchecks.TestCheckResourceAttrInstanceState("WH", "auto_suspend", "120"),
+					importchecks.TestCheckResourceAttrInstanceState("WH", "comment", "second"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_account_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestFakeProvider_TableAndUser(t *testing.T) {
+	client := sdk.NewFakeClient(sdk.NewFakeCatalog())
+
+	config := func(comment string) string {
+		return `
+resource "snowflake_database" "test" {
+  name = "DB"
+}
+
+resource "snowflake_table" "test" {
+  database = snowflake_database.test.name
+  schema   = "PUBLIC"
+  name     = "TABLE"
+  comment  = "` + comment + `"
+
+  column {
+    name     = "ID"
+    type     = "NUMBER(38,0)"
+    nullable = false
+  }
+}
+
+resource "snowflake_user" "test" {
+  name    = "USER"
+  comment = "` + comment + `"
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: fakeprovider.ProviderFactories(client),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test", "comment", "first"),
+					resource.TestCheckResourceAttr("snowflake_table.test", "column.0.name", "ID"),
+					resource.TestCheckResourceAttr("snowflake_user.test", "show_output.0.comment", "first"),
+				),
+			},
+			{
+				Config: config("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test", "comment", "second"),
+					resource.TestCheckResourceAttr("snowflake_user.test", "show_output.0.comment", "second"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_table.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// -1 (inherited from the schema) cannot be distinguished from the explicitly set value during import
+				ImportStateVerifyIgnore: []string{"data_retention_time_in_days"},
+			},
+			{
+				ResourceName: "snowflake_user.test",
+				ImportState:  true,
+				ImportStateCheck: importchecks.ComposeAggregateImportStateCheck(
+					importchecks.TestCheckResourceAttrInstanceState("USER", "name", "USER"),
+					importchecks.TestCheckResourceAttrInstanceState("USER", "login_name", "USER"),
+					importchecks.TestCheckResourceAttrInstanceState("USER", "comment", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestFakeProvider_AccessProfile(t *testing.T) {
+	client := sdk.NewFakeClient(sdk.NewFakeCatalog())
+	roleId := sdk.NewAccountObjectIdentifier("FR_ANALYST")
+	databaseId := sdk.NewAccountObjectIdentifier("ANALYTICS_B")
+
+	config := `
+resource "snowflake_account_role" "test" {
+  name = "FR_ANALYST"
+}
+
+resource "snowflake_database" "a" {
+  name = "ANALYTICS_A"
+}
+
+resource "snowflake_database" "b" {
+  name = "ANALYTICS_B"
+}
+
+resource "snowflake_access_profile" "test" {
+  account_role_name = snowflake_account_role.test.name
+
+  privilege_set {
+    object_type = "DATABASE"
+    privileges  = ["USAGE"]
+  }
+  privilege_set {
+    object_type = "TABLE"
+    privileges  = ["SELECT"]
+  }
+
+  object_selector {
+    database_pattern = "^ANALYTICS_"
+    include_future   = false
+  }
+
+  depends_on = [snowflake_database.a, snowflake_database.b]
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: fakeprovider.ProviderFactories(client),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_access_profile.test", "expanded_grants.#", "4"),
+					resource.TestCheckTypeSetElemAttr("snowflake_access_profile.test", "expanded_grants.*", `USAGE ON DATABASE "ANALYTICS_B"`),
+					resource.TestCheckTypeSetElemAttr("snowflake_access_profile.test", "expanded_grants.*", `SELECT ON ALL TABLES IN DATABASE "ANALYTICS_A"`),
+				),
+			},
+			// the grant revoked outside of Terraform is detected and granted again
+			{
+				PreConfig: func() {
+					require.NoError(t, client.Grants.RevokePrivilegesFromAccountRole(context.Background(),
+						&sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeUsage}},
+						&sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Database: &databaseId}},
+						roleId, nil,
+					))
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_access_profile.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckTypeSetElemAttr("snowflake_access_profile.test", "expanded_grants.*", `USAGE ON DATABASE "ANALYTICS_B"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,69 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type BackupPolicyClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewBackupPolicyClient(context *TestClientContext, idsGenerator *IdsGenerator) *BackupPolicyClient {
+	return &BackupPolicyClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *BackupPolicyClient) client() sdk.BackupPolicies {
+	return c.context.client.BackupPolicies
+}
+
+func (c *BackupPolicyClient) Create(t *testing.T) (*sdk.BackupPolicy, func()) {
+	t.Helper()
+
+	return c.CreateWithRequest(t, sdk.NewCreateBackupPolicyRequest(c.ids.RandomSchemaObjectIdentifier()).WithExpireAfterDays(1))
+}
+
+func (c *BackupPolicyClient) CreateWithRequest(t *testing.T, request *sdk.CreateBackupPolicyRequest) (*sdk.BackupPolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	backupPolicy, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return backupPolicy, c.DropFunc(t, request.GetName())
+}
+
+func (c *BackupPolicyClient) Alter(t *testing.T, request *sdk.AlterBackupPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
+
+func (c *BackupPolicyClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().DropSafely(ctx, id)
+		require.NoError(t, err)
+	}
+}
+
+func (c *BackupPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.BackupPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
@@ -0,0 +1,99 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type BackupSetClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewBackupSetClient(context *TestClientContext, idsGenerator *IdsGenerator) *BackupSetClient {
+	return &BackupSetClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *BackupSetClient) client() sdk.BackupSets {
+	return c.context.client.BackupSets
+}
+
+func (c *BackupSetClient) CreateForTable(t *testing.T, tableId sdk.SchemaObjectIdentifier) (*sdk.BackupSet, func()) {
+	t.Helper()
+
+	return c.CreateWithRequest(t, sdk.NewCreateBackupSetRequest(c.ids.RandomSchemaObjectIdentifier()).WithForTable(tableId))
+}
+
+func (c *BackupSetClient) CreateWithRequest(t *testing.T, request *sdk.CreateBackupSetRequest) (*sdk.BackupSet, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	backupSet, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return backupSet, c.DropFunc(t, request.GetName())
+}
+
+func (c *BackupSetClient) Alter(t *testing.T, request *sdk.AlterBackupSetRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
+
+// AddBackup creates a new backup in the given backup set and returns it.
+func (c *BackupSetClient) AddBackup(t *testing.T, id sdk.SchemaObjectIdentifier) sdk.Backup {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, sdk.NewAlterBackupSetRequest(id).WithAddBackup(true))
+	require.NoError(t, err)
+
+	backups := c.ShowBackups(t, id)
+	require.NotEmpty(t, backups)
+
+	latest := backups[0]
+	for _, backup := range backups[1:] {
+		if backup.CreatedOn.After(latest.CreatedOn) {
+			latest = backup
+		}
+	}
+	return latest
+}
+
+func (c *BackupSetClient) ShowBackups(t *testing.T, id sdk.SchemaObjectIdentifier) []sdk.Backup {
+	t.Helper()
+	ctx := context.Background()
+
+	backups, err := c.client().ShowBackups(ctx, sdk.NewShowBackupsBackupSetRequest(id))
+	require.NoError(t, err)
+
+	return backups
+}
+
+func (c *BackupSetClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().DropSafely(ctx, id)
+		require.NoError(t, err)
+	}
+}
+
+func (c *BackupSetClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.BackupSet, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
@@ -0,0 +1,87 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type ClassificationProfileClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewClassificationProfileClient(context *TestClientContext, idsGenerator *IdsGenerator) *ClassificationProfileClient {
+	return &ClassificationProfileClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *ClassificationProfileClient) client() sdk.ClassificationProfiles {
+	return c.context.client.ClassificationProfiles
+}
+
+func (c *ClassificationProfileClient) Create(t *testing.T) (*sdk.ClassificationProfile, func()) {
+	t.Helper()
+
+	return c.CreateWithConfig(t, sdk.ClassificationProfileConfig{
+		MaximumClassificationValidityDays: sdk.Int(30),
+	})
+}
+
+func (c *ClassificationProfileClient) CreateWithConfig(t *testing.T, config sdk.ClassificationProfileConfig) (*sdk.ClassificationProfile, func()) {
+	t.Helper()
+
+	return c.CreateWithRequest(t, sdk.NewCreateClassificationProfileRequestWithConfig(c.ids.RandomSchemaObjectIdentifier(), config))
+}
+
+func (c *ClassificationProfileClient) CreateWithRequest(t *testing.T, request *sdk.CreateClassificationProfileRequest) (*sdk.ClassificationProfile, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	classificationProfile, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return classificationProfile, c.DropFunc(t, request.GetName())
+}
+
+func (c *ClassificationProfileClient) SetAutoTag(t *testing.T, id sdk.SchemaObjectIdentifier, autoTag bool) {
+	t.Helper()
+	ctx := context.Background()
+
+	_, err := c.client().SetAutoTag(ctx, sdk.NewSetAutoTagClassificationProfileRequest(id, *sdk.NewClassificationProfileSetAutoTagArgsRequest(autoTag)))
+	require.NoError(t, err)
+}
+
+func (c *ClassificationProfileClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().DropSafely(ctx, id)
+		require.NoError(t, err)
+	}
+}
+
+func (c *ClassificationProfileClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.ClassificationProfile, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *ClassificationProfileClient) DescribeDetails(t *testing.T, id sdk.SchemaObjectIdentifier) *sdk.ClassificationProfileDetails {
+	t.Helper()
+	ctx := context.Background()
+
+	details, err := c.client().DescribeDetails(ctx, id)
+	require.NoError(t, err)
+
+	return details
+}
@@ -0,0 +1,135 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testfiles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sampleDbtProjectFile = `name: 'sample_project'
+version: '1.0.0'
+profile: 'sample_project'
+model-paths: ["models"]
+`
+	sampleDbtProfilesFile = `sample_project:
+  target: dev
+  outputs:
+    dev:
+      type: snowflake
+      account: 'not needed'
+      user: 'not needed'
+      role: 'not needed'
+      database: 'not needed'
+      schema: 'not needed'
+      warehouse: 'not needed'
+    prod:
+      type: snowflake
+      account: 'not needed'
+      user: 'not needed'
+      role: 'not needed'
+      database: 'not needed'
+      schema: 'not needed'
+      warehouse: 'not needed'
+`
+	sampleDbtModelFile = `select 1 as id`
+)
+
+type DbtProjectClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewDbtProjectClient(context *TestClientContext, idsGenerator *IdsGenerator) *DbtProjectClient {
+	return &DbtProjectClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *DbtProjectClient) client() sdk.DbtProjects {
+	return c.context.client.DbtProjects
+}
+
+func (c *DbtProjectClient) Create(t *testing.T, from sdk.Location) (*sdk.DbtProject, func()) {
+	t.Helper()
+
+	return c.CreateWithRequest(t, sdk.NewCreateDbtProjectRequest(c.ids.RandomSchemaObjectIdentifier()).WithFrom(from))
+}
+
+func (c *DbtProjectClient) CreateWithRequest(t *testing.T, request *sdk.CreateDbtProjectRequest) (*sdk.DbtProject, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	dbtProject, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return dbtProject, c.DropFunc(t, request.GetName())
+}
+
+func (c *DbtProjectClient) Alter(t *testing.T, request *sdk.AlterDbtProjectRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
+
+func (c *DbtProjectClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().DropSafely(ctx, id)
+		require.NoError(t, err)
+	}
+}
+
+func (c *DbtProjectClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.DbtProject, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *DbtProjectClient) ShowVersions(t *testing.T, id sdk.SchemaObjectIdentifier) []sdk.DbtProjectVersion {
+	t.Helper()
+	ctx := context.Background()
+
+	versions, err := c.client().ShowVersions(ctx, sdk.NewShowVersionsDbtProjectRequest(id))
+	require.NoError(t, err)
+	return versions
+}
+
+// PutSampleProjectOnStage puts a minimal dbt project (with dev and prod targets) in the given stage location, e.g. `@"db"."schema"."stage"/dbt`.
+func (c *DbtProjectClient) PutSampleProjectOnStage(t *testing.T, stageLocation string) {
+	t.Helper()
+
+	c.putInLocation(t, stageLocation, "dbt_project.yml", sampleDbtProjectFile)
+	c.putInLocation(t, stageLocation, "profiles.yml", sampleDbtProfilesFile)
+	c.putInLocation(t, stageLocation+"/models", "sample_model.sql", sampleDbtModelFile)
+}
+
+func (c *DbtProjectClient) putInLocation(t *testing.T, location string, filename string, content string) {
+	t.Helper()
+	ctx := context.Background()
+
+	filePath := testfiles.TestFile(t, filename, []byte(content))
+
+	_, err := c.context.client.ExecForTests(ctx, fmt.Sprintf(`PUT file://%s %s AUTO_COMPRESS = FALSE OVERWRITE = TRUE`, filePath, location))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err = c.context.client.ExecForTests(ctx, fmt.Sprintf(`REMOVE %s/%s`, location, filename))
+		if !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			require.NoError(t, err)
+		}
+	})
+}
@@ -2,14 +2,12 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/stretchr/testify/require"
 )
 
-// TODO(SNOW-1325215): change raw sqls to proper client
 type ExternalAccessIntegrationClient struct {
 	context *TestClientContext
 	ids     *IdsGenerator
@@ -22,36 +20,69 @@ func NewExternalAccessIntegrationClient(context *TestClientContext, idsGenerator
 	}
 }
 
-func (c *ExternalAccessIntegrationClient) client() *sdk.Client {
-	return c.context.client
+func (c *ExternalAccessIntegrationClient) client() sdk.ExternalAccessIntegrations {
+	return c.context.client.ExternalAccessIntegrations
 }
 
 func (c *ExternalAccessIntegrationClient) CreateExternalAccessIntegration(t *testing.T, networkRuleId sdk.SchemaObjectIdentifier) (sdk.AccountObjectIdentifier, func()) {
 	t.Helper()
-	ctx := context.Background()
 
 	id := c.ids.RandomAccountObjectIdentifier()
-	_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`CREATE EXTERNAL ACCESS INTEGRATION %s ALLOWED_NETWORK_RULES = (%s) ENABLED = TRUE`, id.FullyQualifiedName(), networkRuleId.FullyQualifiedName()))
-	require.NoError(t, err)
+	c.CreateWithRequest(t, sdk.NewCreateExternalAccessIntegrationRequest(id, []sdk.SchemaObjectIdentifier{networkRuleId}, true))
 	return id, c.DropExternalAccessIntegrationFunc(t, id)
 }
 
 func (c *ExternalAccessIntegrationClient) CreateExternalAccessIntegrationWithNetworkRuleAndSecret(t *testing.T, networkRuleId sdk.SchemaObjectIdentifier, secretId sdk.SchemaObjectIdentifier) (sdk.AccountObjectIdentifier, func()) {
 	t.Helper()
-	ctx := context.Background()
 
 	id := c.ids.RandomAccountObjectIdentifier()
-	_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`CREATE EXTERNAL ACCESS INTEGRATION %s ALLOWED_NETWORK_RULES = (%s) ALLOWED_AUTHENTICATION_SECRETS = (%s) ENABLED = TRUE`, id.FullyQualifiedName(), networkRuleId.FullyQualifiedName(), secretId.FullyQualifiedName()))
-	require.NoError(t, err)
+	c.CreateWithRequest(t, sdk.NewCreateExternalAccessIntegrationRequest(id, []sdk.SchemaObjectIdentifier{networkRuleId}, true).
+		WithAllowedAuthenticationSecrets([]sdk.SchemaObjectIdentifier{secretId}),
+	)
 	return id, c.DropExternalAccessIntegrationFunc(t, id)
 }
 
+func (c *ExternalAccessIntegrationClient) CreateWithRequest(t *testing.T, request *sdk.CreateExternalAccessIntegrationRequest) (*sdk.ExternalAccessIntegration, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	integration, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return integration, c.DropExternalAccessIntegrationFunc(t, request.GetName())
+}
+
 func (c *ExternalAccessIntegrationClient) DropExternalAccessIntegrationFunc(t *testing.T, id sdk.AccountObjectIdentifier) func() {
 	t.Helper()
 	ctx := context.Background()
 
 	return func() {
-		_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`DROP EXTERNAL ACCESS INTEGRATION IF EXISTS %s`, id.FullyQualifiedName()))
+		err := c.client().DropSafely(ctx, id)
 		require.NoError(t, err)
 	}
 }
+
+func (c *ExternalAccessIntegrationClient) Alter(t *testing.T, request *sdk.AlterExternalAccessIntegrationRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
+
+func (c *ExternalAccessIntegrationClient) Show(t *testing.T, id sdk.AccountObjectIdentifier) (*sdk.ExternalAccessIntegration, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *ExternalAccessIntegrationClient) DescribeDetails(t *testing.T, id sdk.AccountObjectIdentifier) (*sdk.ExternalAccessIntegrationDetails, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().DescribeDetails(ctx, id)
+}
@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type JoinPolicyClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewJoinPolicyClient(context *TestClientContext, idsGenerator *IdsGenerator) *JoinPolicyClient {
+	return &JoinPolicyClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *JoinPolicyClient) client() sdk.JoinPolicies {
+	return c.context.client.JoinPolicies
+}
+
+func (c *JoinPolicyClient) CreateJoinPolicy(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
+	t.Helper()
+
+	policy, cleanup := c.CreateWithRequest(t, sdk.NewCreateJoinPolicyRequest(c.ids.RandomSchemaObjectIdentifier(), "JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)"))
+	return policy.ID(), cleanup
+}
+
+func (c *JoinPolicyClient) CreateWithRequest(t *testing.T, request *sdk.CreateJoinPolicyRequest) (*sdk.JoinPolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	policy, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return policy, c.DropJoinPolicyFunc(t, request.GetName())
+}
+
+func (c *JoinPolicyClient) Alter(t *testing.T, request *sdk.AlterJoinPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
+
+func (c *JoinPolicyClient) DropJoinPolicyFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().DropSafely(ctx, id)
+		require.NoError(t, err)
+	}
+}
+
+func (c *JoinPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.JoinPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *JoinPolicyClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.JoinPolicyDescription, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().Describe(ctx, id)
+}
@@ -2,7 +2,6 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -21,17 +20,58 @@ func NewPackagesPolicyClient(context *TestClientContext, idsGenerator *IdsGenera
 	}
 }
 
+func (c *PackagesPolicyClient) client() sdk.PackagesPolicies {
+	return c.context.client.PackagesPolicies
+}
+
 func (c *PackagesPolicyClient) Create(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
 	t.Helper()
 
-	// TODO(SNOW-1348357): Replace raw SQL with SDK
+	policy, cleanup := c.CreateWithRequest(t, sdk.NewCreatePackagesPolicyRequest(c.ids.RandomSchemaObjectIdentifier(), sdk.PackagesPolicyLanguagePython))
+	return policy.ID(), cleanup
+}
+
+func (c *PackagesPolicyClient) CreateWithRequest(t *testing.T, request *sdk.CreatePackagesPolicyRequest) (*sdk.PackagesPolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	policy, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return policy, c.DropFunc(t, request.GetName())
+}
+
+func (c *PackagesPolicyClient) Alter(t *testing.T, request *sdk.AlterPackagesPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
 
-	id := c.ids.RandomSchemaObjectIdentifier()
-	_, err := c.context.client.ExecForTests(context.Background(), fmt.Sprintf("CREATE PACKAGES POLICY %s LANGUAGE PYTHON", id.FullyQualifiedName()))
+	err := c.client().Alter(ctx, request)
 	require.NoError(t, err)
+}
+
+func (c *PackagesPolicyClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
 
-	return id, func() {
-		_, err = c.context.client.ExecForTests(context.Background(), fmt.Sprintf("DROP PACKAGES POLICY IF EXISTS %s", id.FullyQualifiedName()))
+	return func() {
+		err := c.client().DropSafely(ctx, id)
 		require.NoError(t, err)
 	}
 }
+
+func (c *PackagesPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.PackagesPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *PackagesPolicyClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.PackagesPolicyDescription, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().Describe(ctx, id)
+}
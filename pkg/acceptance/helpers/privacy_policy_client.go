@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type PrivacyPolicyClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewPrivacyPolicyClient(context *TestClientContext, idsGenerator *IdsGenerator) *PrivacyPolicyClient {
+	return &PrivacyPolicyClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *PrivacyPolicyClient) client() sdk.PrivacyPolicies {
+	return c.context.client.PrivacyPolicies
+}
+
+func (c *PrivacyPolicyClient) CreatePrivacyPolicy(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
+	t.Helper()
+
+	policy, cleanup := c.CreateWithRequest(t, sdk.NewCreatePrivacyPolicyRequest(c.ids.RandomSchemaObjectIdentifier(), "PRIVACY_BUDGET(BUDGET_NAME => 'analysts')"))
+	return policy.ID(), cleanup
+}
+
+func (c *PrivacyPolicyClient) CreateWithRequest(t *testing.T, request *sdk.CreatePrivacyPolicyRequest) (*sdk.PrivacyPolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	policy, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return policy, c.DropPrivacyPolicyFunc(t, request.GetName())
+}
+
+func (c *PrivacyPolicyClient) Alter(t *testing.T, request *sdk.AlterPrivacyPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
+
+func (c *PrivacyPolicyClient) DropPrivacyPolicyFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().DropSafely(ctx, id)
+		require.NoError(t, err)
+	}
+}
+
+func (c *PrivacyPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.PrivacyPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *PrivacyPolicyClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.PrivacyPolicyDescription, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().Describe(ctx, id)
+}
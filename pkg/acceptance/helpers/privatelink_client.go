@@ -0,0 +1,106 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type PrivatelinkClient struct {
+	context *TestClientContext
+}
+
+func NewPrivatelinkClient(context *TestClientContext) *PrivatelinkClient {
+	return &PrivatelinkClient{
+		context: context,
+	}
+}
+
+func (c *PrivatelinkClient) client() sdk.SystemFunctions {
+	return c.context.client.SystemFunctions
+}
+
+func (c *PrivatelinkClient) ProvisionEndpoint(t *testing.T, request sdk.ProvisionPrivatelinkEndpointRequest) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().ProvisionPrivatelinkEndpoint(ctx, request)
+	require.NoError(t, err)
+
+	return c.DeprovisionEndpointFunc(t, request.ProviderResourceId)
+}
+
+func (c *PrivatelinkClient) DeprovisionEndpointFunc(t *testing.T, providerResourceId string) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		if _, found := c.EndpointInfo(t, providerResourceId); !found {
+			return
+		}
+		err := c.client().DeprovisionPrivatelinkEndpoint(ctx, providerResourceId)
+		require.NoError(t, err)
+	}
+}
+
+// EndpointInfo returns the info of the provisioned endpoint. Deprovisioned endpoints are treated as not found.
+func (c *PrivatelinkClient) EndpointInfo(t *testing.T, providerResourceId string) (*sdk.PrivatelinkEndpointInfo, bool) {
+	t.Helper()
+	ctx := context.Background()
+
+	endpoints, err := c.client().GetPrivatelinkEndpointsInfo(ctx)
+	require.NoError(t, err)
+
+	endpoint, err := collections.FindFirst(endpoints, func(e sdk.PrivatelinkEndpointInfo) bool {
+		return e.ProviderResourceId == providerResourceId && !sdk.IsPrivatelinkEndpointDeprovisioned(e)
+	})
+	if errors.Is(err, collections.ErrObjectNotFound) {
+		return nil, false
+	}
+	require.NoError(t, err)
+	return endpoint, true
+}
+
+func (c *PrivatelinkClient) Authorize(t *testing.T, endpointId string, token string) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().AuthorizePrivatelink(ctx, endpointId, token)
+	require.NoError(t, err)
+
+	return c.RevokeFunc(t, endpointId, token)
+}
+
+func (c *PrivatelinkClient) RevokeFunc(t *testing.T, endpointId string, token string) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		if _, found := c.AuthorizedEndpoint(t, endpointId); !found {
+			return
+		}
+		err := c.client().RevokePrivatelink(ctx, endpointId, token)
+		require.NoError(t, err)
+	}
+}
+
+func (c *PrivatelinkClient) AuthorizedEndpoint(t *testing.T, endpointId string) (*sdk.PrivatelinkAuthorizedEndpoint, bool) {
+	t.Helper()
+	ctx := context.Background()
+
+	endpoints, err := c.client().GetPrivatelinkAuthorizedEndpoints(ctx)
+	require.NoError(t, err)
+
+	endpoint, err := collections.FindFirst(endpoints, func(e sdk.PrivatelinkAuthorizedEndpoint) bool {
+		return e.EndpointId == endpointId
+	})
+	if errors.Is(err, collections.ErrObjectNotFound) {
+		return nil, false
+	}
+	require.NoError(t, err)
+	return endpoint, true
+}
@@ -15,6 +15,12 @@ import (
 // It always starts with a letter and contains only letters.
 var generatedRandomValue string
 
+// fixedSeed is used when the SQL cassettes are enabled (see sqlcassette package). The cassettes are matched by the statement text,
+// so the generated identifiers have to be the same during the recording and the replay.
+const fixedSeed = 1234567890
+
+var seeded bool
+
 func init() {
 	generatedRandomValue = os.Getenv(string(testenvs.GeneratedRandomValue))
 	requireGeneratedRandomValue := os.Getenv(string(testenvs.RequireGeneratedRandomValue))
@@ -22,9 +28,22 @@ func init() {
 		log.Printf("Generated random value is required for tests to run. Set %s env.", testenvs.GeneratedRandomValue)
 		os.Exit(1)
 	}
+	if os.Getenv(string(testenvs.SqlCassetteMode)) != "" {
+		UseFixedSeed()
+	}
+}
+
+// UseFixedSeed makes all the values generated afterward (including UUID) the same in every run.
+// The values still depend on the order in which they are generated, so the runs have to execute the same tests sequentially.
+func UseFixedSeed() {
+	gofakeit.Seed(fixedSeed)
+	seeded = true
 }
 
 func UUID() string {
+	if seeded {
+		return gofakeit.UUID()
+	}
 	v, _ := uuid.GenerateUUID()
 	return v
 }
@@ -2,14 +2,12 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/stretchr/testify/require"
 )
 
-// TODO(SNOW-2129584): change raw sqls to proper client
 type SnapshotClient struct {
 	context *TestClientContext
 	ids     *IdsGenerator
@@ -22,19 +20,35 @@ func NewSnapshotClient(context *TestClientContext, idsGenerator *IdsGenerator) *
 	}
 }
 
-func (c *SnapshotClient) client() *sdk.Client {
-	return c.context.client
+func (c *SnapshotClient) client() sdk.Snapshots {
+	return c.context.client.Snapshots
 }
 
-func (c *SnapshotClient) Create(t *testing.T, serviceId sdk.SchemaObjectIdentifier, volume string) (sdk.SchemaObjectIdentifier, func()) {
+func (c *SnapshotClient) Create(t *testing.T, serviceId sdk.SchemaObjectIdentifier, volume string) (*sdk.Snapshot, func()) {
+	t.Helper()
+
+	return c.CreateWithRequest(t, sdk.NewCreateSnapshotRequest(c.ids.RandomSchemaObjectIdentifier(), serviceId, volume, 0))
+}
+
+func (c *SnapshotClient) CreateWithRequest(t *testing.T, request *sdk.CreateSnapshotRequest) (*sdk.Snapshot, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	snapshot, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return snapshot, c.DropFunc(t, request.GetName())
+}
+
+func (c *SnapshotClient) Alter(t *testing.T, request *sdk.AlterSnapshotRequest) {
 	t.Helper()
 	ctx := context.Background()
 
-	id := c.ids.RandomSchemaObjectIdentifier()
-	query := fmt.Sprintf(`CREATE SNAPSHOT %s FROM SERVICE %s VOLUME "%s" INSTANCE 0`, id.FullyQualifiedName(), serviceId.FullyQualifiedName(), volume)
-	_, err := c.client().ExecForTests(ctx, query)
+	err := c.client().Alter(ctx, request)
 	require.NoError(t, err)
-	return id, c.DropFunc(t, id)
 }
 
 func (c *SnapshotClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
@@ -42,7 +56,14 @@ func (c *SnapshotClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) f
 	ctx := context.Background()
 
 	return func() {
-		_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`DROP SNAPSHOT IF EXISTS %s`, id.FullyQualifiedName()))
+		err := c.client().DropSafely(ctx, id)
 		require.NoError(t, err)
 	}
 }
+
+func (c *SnapshotClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.Snapshot, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
@@ -0,0 +1,71 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type StorageLifecyclePolicyClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewStorageLifecyclePolicyClient(context *TestClientContext, idsGenerator *IdsGenerator) *StorageLifecyclePolicyClient {
+	return &StorageLifecyclePolicyClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *StorageLifecyclePolicyClient) client() sdk.StorageLifecyclePolicies {
+	return c.context.client.StorageLifecyclePolicies
+}
+
+func (c *StorageLifecyclePolicyClient) Create(t *testing.T) (*sdk.StorageLifecyclePolicy, func()) {
+	t.Helper()
+
+	args := []sdk.CreateStorageLifecyclePolicyArgsRequest{*sdk.NewCreateStorageLifecyclePolicyArgsRequest("A", testdatatypes.DataTypeNumber)}
+	return c.CreateWithRequest(t, sdk.NewCreateStorageLifecyclePolicyRequest(c.ids.RandomSchemaObjectIdentifier(), args, "A > 10"))
+}
+
+func (c *StorageLifecyclePolicyClient) CreateWithRequest(t *testing.T, request *sdk.CreateStorageLifecyclePolicyRequest) (*sdk.StorageLifecyclePolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	storageLifecyclePolicy, err := c.client().ShowByID(ctx, request.GetName())
+	require.NoError(t, err)
+
+	return storageLifecyclePolicy, c.DropFunc(t, request.GetName())
+}
+
+func (c *StorageLifecyclePolicyClient) Alter(t *testing.T, request *sdk.AlterStorageLifecyclePolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
+
+func (c *StorageLifecyclePolicyClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().DropSafely(ctx, id)
+		require.NoError(t, err)
+	}
+}
+
+func (c *StorageLifecyclePolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.StorageLifecyclePolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
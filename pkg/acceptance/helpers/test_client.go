@@ -22,8 +22,11 @@ type TestClient struct {
 	Application                  *ApplicationClient
 	ApplicationPackage           *ApplicationPackageClient
 	AuthenticationPolicy         *AuthenticationPolicyClient
+	BackupPolicy                 *BackupPolicyClient
+	BackupSet                    *BackupSetClient
 	BcrBundles                   *BcrBundlesClient
 	Budget                       *BudgetClient
+	ClassificationProfile        *ClassificationProfileClient
 	ComputePool                  *ComputePoolClient
 	Connection                   *ConnectionClient
 	Context                      *ContextClient
@@ -34,6 +37,7 @@ type TestClient struct {
 	DatabaseRole                 *DatabaseRoleClient
 	DataMetricFunctionClient     *DataMetricFunctionClient
 	DataMetricFunctionReferences *DataMetricFunctionReferencesClient
+	DbtProject                   *DbtProjectClient
 	DynamicTable                 *DynamicTableClient
 	EventTable                   *EventTableClient
 	ExternalAccessIntegration    *ExternalAccessIntegrationClient
@@ -48,6 +52,7 @@ type TestClient struct {
 	Grant                        *GrantClient
 	HybridTable                  *HybridTableClient
 	ImageRepository              *ImageRepositoryClient
+	JoinPolicy                   *JoinPolicyClient
 	InformationSchema            *InformationSchemaClient
 	Listing                      *ListingClient
 	MaskingPolicy                *MaskingPolicyClient
@@ -63,6 +68,8 @@ type TestClient struct {
 	PasswordPolicy               *PasswordPolicyClient
 	Pipe                         *PipeClient
 	PostgresInstance             *PostgresInstanceClient
+	PrivacyPolicy                *PrivacyPolicyClient
+	Privatelink                  *PrivatelinkClient
 	Procedure                    *ProcedureClient
 	ProjectionPolicy             *ProjectionPolicyClient
 	PolicyReferences             *PolicyReferencesClient
@@ -81,6 +88,7 @@ type TestClient struct {
 	SnowflakeDefaults            *SnowflakeDefaultsClient
 	Stage                        *StageClient
 	StorageIntegration           *StorageIntegrationClient
+	StorageLifecyclePolicy       *StorageLifecyclePolicyClient
 	Stream                       *StreamClient
 	Streamlit                    *StreamlitClient
 	Table                        *TableClient
@@ -122,8 +130,11 @@ func NewTestClient(
 		Application:                  NewApplicationClient(context, idsGenerator),
 		ApplicationPackage:           NewApplicationPackageClient(context, idsGenerator),
 		AuthenticationPolicy:         NewAuthenticationPolicyClient(context, idsGenerator),
+		BackupPolicy:                 NewBackupPolicyClient(context, idsGenerator),
+		BackupSet:                    NewBackupSetClient(context, idsGenerator),
 		BcrBundles:                   NewBcrBundlesClient(context),
 		Budget:                       NewBudgetClient(context, idsGenerator),
+		ClassificationProfile:        NewClassificationProfileClient(context, idsGenerator),
 		ComputePool:                  NewComputePoolClient(context, idsGenerator),
 		Connection:                   NewConnectionClient(context, idsGenerator),
 		Context:                      NewContextClient(context),
@@ -134,6 +145,7 @@ func NewTestClient(
 		DatabaseRole:                 NewDatabaseRoleClient(context, idsGenerator),
 		DataMetricFunctionClient:     NewDataMetricFunctionClient(context, idsGenerator),
 		DataMetricFunctionReferences: NewDataMetricFunctionReferencesClient(context),
+		DbtProject:                   NewDbtProjectClient(context, idsGenerator),
 		DynamicTable:                 NewDynamicTableClient(context, idsGenerator),
 		EventTable:                   NewEventTableClient(context, idsGenerator),
 		ExternalAccessIntegration:    NewExternalAccessIntegrationClient(context, idsGenerator),
@@ -148,6 +160,7 @@ func NewTestClient(
 		Grant:                        NewGrantClient(context, idsGenerator),
 		HybridTable:                  NewHybridTableClient(context, idsGenerator),
 		ImageRepository:              NewImageRepositoryClient(context, idsGenerator),
+		JoinPolicy:                   NewJoinPolicyClient(context, idsGenerator),
 		InformationSchema:            NewInformationSchemaClient(context, idsGenerator),
 		Listing:                      NewListingClient(context, idsGenerator),
 		MaskingPolicy:                NewMaskingPolicyClient(context, idsGenerator),
@@ -163,6 +176,8 @@ func NewTestClient(
 		PasswordPolicy:               NewPasswordPolicyClient(context, idsGenerator),
 		Pipe:                         NewPipeClient(context, idsGenerator),
 		PostgresInstance:             NewPostgresInstanceClient(context, idsGenerator),
+		PrivacyPolicy:                NewPrivacyPolicyClient(context, idsGenerator),
+		Privatelink:                  NewPrivatelinkClient(context),
 		Procedure:                    NewProcedureClient(context, idsGenerator),
 		ProjectionPolicy:             NewProjectionPolicyClient(context, idsGenerator),
 		PolicyReferences:             NewPolicyReferencesClient(context),
@@ -181,6 +196,7 @@ func NewTestClient(
 		Share:                        NewShareClient(context, idsGenerator),
 		Stage:                        NewStageClient(context, idsGenerator),
 		StorageIntegration:           NewStorageIntegrationClient(context, idsGenerator),
+		StorageLifecyclePolicy:       NewStorageLifecyclePolicyClient(context, idsGenerator),
 		Stream:                       NewStreamClient(context, idsGenerator),
 		Streamlit:                    NewStreamlitClient(context, idsGenerator),
 		Table:                        NewTableClient(context, idsGenerator),
@@ -0,0 +1,150 @@
+// Package sqlcassette allows recording the SQL traffic between the SDK client and Snowflake into cassette files
+// and replaying it later without a Snowflake connection.
+//
+// The recording connector wraps the real driver connector and stores every executed statement together with its result
+// (or error) in the cassette. The replaying connector serves the stored results back. Statements are matched by their text
+// with the usage tracking metadata removed (see tracking.TrimMetadata), so the cassettes do not depend on the provider version.
+// Each recorded interaction can be replayed only once; identical statements are replayed in the order they were recorded.
+// Statements that were not recorded result in ErrInteractionNotFound, which makes the regressions in the generated SQL visible.
+// Because the statements are matched by their text, the random values used in the tests are generated with a fixed seed
+// when the cassettes are enabled (see random.UseFixedSeed), so the same tests run in the same order generate the same statements.
+package sqlcassette
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
+)
+
+var ErrInteractionNotFound = errors.New("no matching interaction found in the cassette")
+
+type Mode string
+
+const (
+	ModeRecord Mode = "record"
+	ModeReplay Mode = "replay"
+)
+
+func ToMode(s string) (Mode, error) {
+	switch mode := Mode(s); mode {
+	case ModeRecord, ModeReplay:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid cassette mode: %s", s)
+	}
+}
+
+type InteractionKind string
+
+const (
+	InteractionKindExec  InteractionKind = "exec"
+	InteractionKindQuery InteractionKind = "query"
+)
+
+// Interaction is a single statement sent to Snowflake with its outcome.
+type Interaction struct {
+	Kind         InteractionKind `json:"kind"`
+	Query        string          `json:"query"`
+	Columns      []string        `json:"columns,omitempty"`
+	Rows         [][]Value       `json:"rows,omitempty"`
+	RowsAffected int64           `json:"rows_affected,omitempty"`
+	Error        string          `json:"error,omitempty"`
+}
+
+// Cassette holds the recorded interactions. It is safe for concurrent use.
+type Cassette struct {
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+type cassetteFile struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+func NewCassette() *Cassette {
+	return &Cassette{}
+}
+
+// Load reads the cassette from the given file.
+func Load(path string) (*Cassette, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette %s: %w", path, err)
+	}
+	var file cassetteFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("parsing cassette %s: %w", path, err)
+	}
+	return &Cassette{
+		interactions: file.Interactions,
+		used:         make([]bool, len(file.Interactions)),
+	}, nil
+}
+
+// Save writes the cassette to the given file, creating the missing directories.
+func (c *Cassette) Save(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	content, err := json.MarshalIndent(cassetteFile{Interactions: c.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0o600)
+}
+
+// Interactions returns a copy of the recorded interactions.
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	interactions := make([]Interaction, len(c.interactions))
+	copy(interactions, c.interactions)
+	return interactions
+}
+
+// Unused returns the interactions that were not replayed yet.
+func (c *Cassette) Unused() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	unused := make([]Interaction, 0)
+	for i, interaction := range c.interactions {
+		if !c.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
+
+func (c *Cassette) add(interaction Interaction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	interaction.Query = tracking.TrimMetadata(interaction.Query)
+	c.interactions = append(c.interactions, interaction)
+	c.used = append(c.used, true)
+}
+
+func (c *Cassette) next(kind InteractionKind, query string) (Interaction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	query = tracking.TrimMetadata(query)
+	for i, interaction := range c.interactions {
+		if !c.used[i] && interaction.Kind == kind && interaction.Query == query {
+			c.used[i] = true
+			return interaction, nil
+		}
+	}
+	return Interaction{}, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, kind, query)
+}
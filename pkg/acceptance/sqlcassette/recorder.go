@@ -0,0 +1,133 @@
+package sqlcassette
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+)
+
+var (
+	_ driver.Connector      = (*recordingConnector)(nil)
+	_ driver.ExecerContext  = (*recordingConn)(nil)
+	_ driver.QueryerContext = (*recordingConn)(nil)
+	_ driver.Pinger         = (*recordingConn)(nil)
+)
+
+// NewRecordingConnector returns a connector that passes all the statements to the inner connector and stores them,
+// together with their results, in the cassette. The rows are read eagerly, so that they can be recorded before being returned.
+func NewRecordingConnector(inner driver.Connector, cassette *Cassette) driver.Connector {
+	return &recordingConnector{inner: inner, cassette: cassette}
+}
+
+type recordingConnector struct {
+	inner    driver.Connector
+	cassette *Cassette
+}
+
+func (c *recordingConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.inner.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &recordingConn{inner: conn, cassette: c.cassette}, nil
+}
+
+func (c *recordingConnector) Driver() driver.Driver {
+	return c.inner.Driver()
+}
+
+type recordingConn struct {
+	inner    driver.Conn
+	cassette *Cassette
+}
+
+func (c *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return c.inner.Prepare(query)
+}
+
+func (c *recordingConn) Close() error {
+	return c.inner.Close()
+}
+
+func (c *recordingConn) Begin() (driver.Tx, error) {
+	return c.inner.Begin() //nolint:staticcheck
+}
+
+func (c *recordingConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.inner.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *recordingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.inner.(driver.ExecerContext)
+	if !ok || len(args) > 0 {
+		return nil, driver.ErrSkip
+	}
+	result, err := execer.ExecContext(ctx, query, args)
+	if errors.Is(err, driver.ErrSkip) {
+		return nil, err
+	}
+
+	interaction := Interaction{Kind: InteractionKindExec, Query: query}
+	if err != nil {
+		interaction.Error = err.Error()
+	} else if rowsAffected, rowsAffectedErr := result.RowsAffected(); rowsAffectedErr == nil {
+		interaction.RowsAffected = rowsAffected
+	}
+	c.cassette.add(interaction)
+
+	return result, err
+}
+
+func (c *recordingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.inner.(driver.QueryerContext)
+	if !ok || len(args) > 0 {
+		return nil, driver.ErrSkip
+	}
+	rows, err := queryer.QueryContext(ctx, query, args)
+	if errors.Is(err, driver.ErrSkip) {
+		return nil, err
+	}
+
+	interaction := Interaction{Kind: InteractionKindQuery, Query: query}
+	if err != nil {
+		interaction.Error = err.Error()
+		c.cassette.add(interaction)
+		return nil, err
+	}
+	defer rows.Close()
+
+	interaction.Columns = rows.Columns()
+	interaction.Rows, err = readRows(rows, len(interaction.Columns))
+	if err != nil {
+		return nil, err
+	}
+	c.cassette.add(interaction)
+
+	return &replayedRows{columns: interaction.Columns, rows: interaction.Rows}, nil
+}
+
+func readRows(rows driver.Rows, columns int) ([][]Value, error) {
+	result := make([][]Value, 0)
+	dest := make([]driver.Value, columns)
+	for {
+		if err := rows.Next(dest); err != nil {
+			if errors.Is(err, io.EOF) {
+				return result, nil
+			}
+			return nil, err
+		}
+		row := make([]Value, columns)
+		for i, v := range dest {
+			value, err := toValue(v)
+			if err != nil {
+				return nil, err
+			}
+			row[i] = value
+		}
+		result = append(result, row)
+	}
+}
@@ -0,0 +1,125 @@
+package sqlcassette
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+)
+
+var errNotSupportedInReplay = errors.New("prepared statements and transactions are not supported by the replaying connector")
+
+var (
+	_ driver.Connector      = (*replayingConnector)(nil)
+	_ driver.ExecerContext  = (*replayingConn)(nil)
+	_ driver.QueryerContext = (*replayingConn)(nil)
+	_ driver.Pinger         = (*replayingConn)(nil)
+	_ driver.Rows           = (*replayedRows)(nil)
+)
+
+// NewReplayingConnector returns a connector serving the results stored in the cassette. It does not connect to Snowflake.
+func NewReplayingConnector(cassette *Cassette) driver.Connector {
+	return &replayingConnector{cassette: cassette}
+}
+
+type replayingConnector struct {
+	cassette *Cassette
+}
+
+func (c *replayingConnector) Connect(_ context.Context) (driver.Conn, error) {
+	return &replayingConn{cassette: c.cassette}, nil
+}
+
+func (c *replayingConnector) Driver() driver.Driver {
+	return replayingDriver{connector: c}
+}
+
+type replayingDriver struct {
+	connector *replayingConnector
+}
+
+func (d replayingDriver) Open(_ string) (driver.Conn, error) {
+	return d.connector.Connect(context.Background())
+}
+
+type replayingConn struct {
+	cassette *Cassette
+}
+
+func (c *replayingConn) Prepare(_ string) (driver.Stmt, error) {
+	return nil, errNotSupportedInReplay
+}
+
+func (c *replayingConn) Close() error {
+	return nil
+}
+
+func (c *replayingConn) Begin() (driver.Tx, error) {
+	return nil, errNotSupportedInReplay
+}
+
+func (c *replayingConn) Ping(_ context.Context) error {
+	return nil
+}
+
+func (c *replayingConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if len(args) > 0 {
+		return nil, driver.ErrSkip
+	}
+	interaction, err := c.cassette.next(InteractionKindExec, query)
+	if err != nil {
+		return nil, err
+	}
+	if interaction.Error != "" {
+		return nil, errors.New(interaction.Error)
+	}
+	return driver.RowsAffected(interaction.RowsAffected), nil
+}
+
+func (c *replayingConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if len(args) > 0 {
+		return nil, driver.ErrSkip
+	}
+	interaction, err := c.cassette.next(InteractionKindQuery, query)
+	if err != nil {
+		return nil, err
+	}
+	if interaction.Error != "" {
+		return nil, errors.New(interaction.Error)
+	}
+	return &replayedRows{columns: interaction.Columns, rows: interaction.Rows}, nil
+}
+
+type replayedRows struct {
+	columns []string
+	rows    [][]Value
+	next    int
+}
+
+func (r *replayedRows) Columns() []string {
+	return r.columns
+}
+
+func (r *replayedRows) Close() error {
+	return nil
+}
+
+func (r *replayedRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+	row := r.rows[r.next]
+	r.next++
+	for i := range dest {
+		if i >= len(row) {
+			dest[i] = nil
+			continue
+		}
+		v, err := row[i].toDriverValue()
+		if err != nil {
+			return err
+		}
+		dest[i] = v
+	}
+	return nil
+}
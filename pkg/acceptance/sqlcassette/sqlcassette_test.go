@@ -0,0 +1,205 @@
+package sqlcassette_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/sqlcassette"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testprofiles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/snowflakedb/gosnowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassette_RecordAndReplay(t *testing.T) {
+	cassettePath := filepath.Join(t.TempDir(), "cassettes", "databases.json")
+	id := sdk.NewAccountObjectIdentifier("DB")
+	missingId := sdk.NewAccountObjectIdentifier("MISSING")
+
+	scenario := func(t *testing.T, client *sdk.Client, operation tracking.Operation) *sdk.Database {
+		t.Helper()
+		ctx := tracking.NewContext(context.Background(), tracking.NewVersionedResourceMetadata(resources.Database, operation))
+
+		require.NoError(t, client.Databases.Create(ctx, id, &sdk.CreateDatabaseOptions{Comment: sdk.String("comment")}))
+		require.ErrorIs(t, client.Databases.Drop(ctx, missingId, &sdk.DropDatabaseOptions{}), sdk.ErrObjectNotExistOrAuthorized)
+		database, err := client.Databases.ShowByID(ctx, id)
+		require.NoError(t, err)
+		return database
+	}
+
+	// record
+	cassette := sqlcassette.NewCassette()
+	client, err := sdk.NewClientWithConnector(&gosnowflake.Config{}, sqlcassette.NewRecordingConnector(&scriptedConnector{}, cassette))
+	require.NoError(t, err)
+	recorded := scenario(t, client, tracking.CreateOperation)
+	require.NoError(t, cassette.Save(cassettePath))
+
+	interactions := cassette.Interactions()
+	require.Len(t, interactions, 5)
+	for _, interaction := range interactions {
+		assert.NotContains(t, interaction.Query, tracking.MetadataPrefix)
+	}
+	assert.Equal(t, `CREATE DATABASE "DB" COMMENT = 'comment'`, interactions[2].Query)
+	assert.Contains(t, interactions[3].Error, "does not exist or not authorized")
+
+	// replay (the tracking metadata differs, but it is not taken into account)
+	loaded, err := sqlcassette.Load(cassettePath)
+	require.NoError(t, err)
+	client, err = sdk.NewClientWithConnector(&gosnowflake.Config{}, sqlcassette.NewReplayingConnector(loaded))
+	require.NoError(t, err)
+	replayed := scenario(t, client, tracking.UpdateOperation)
+
+	assert.Equal(t, recorded, replayed)
+	assert.Equal(t, "FAKE_ACCOUNT", client.GetAccountLocator())
+	assert.Empty(t, loaded.Unused())
+
+	// statements that were not recorded are reported
+	err = client.Databases.Create(context.Background(), id, &sdk.CreateDatabaseOptions{Comment: sdk.String("changed")})
+	require.ErrorIs(t, err, sqlcassette.ErrInteractionNotFound)
+	assert.ErrorContains(t, err, `CREATE DATABASE "DB" COMMENT = 'changed'`)
+}
+
+// TestCassette_ReplayTestClient replays the committed cassette through the test client helpers used by the acceptance tests.
+// The identifiers are generated randomly, like in the acceptance tests, but with the fixed seed, so they match the recorded statements.
+// To record the cassette again, run the test with TEST_SF_TF_SQL_CASSETTE_MODE=record; it connects using the default test profile.
+func TestCassette_ReplayTestClient(t *testing.T) {
+	cassettePath := filepath.Join("testdata", "test_client.json")
+	random.UseFixedSeed()
+	suffix := "AT_" + random.ObjectSuffix()
+
+	var cassette *sqlcassette.Cassette
+	var connector driver.Connector
+	if os.Getenv(string(testenvs.SqlCassetteMode)) == string(sqlcassette.ModeRecord) {
+		conf, err := sdk.ProfileConfig(testprofiles.Default)
+		require.NoError(t, err)
+		require.NotNil(t, conf)
+		dsn, err := gosnowflake.DSN(conf)
+		require.NoError(t, err)
+		snowflakeConnector, err := gosnowflake.SnowflakeDriver{}.OpenConnector(dsn)
+		require.NoError(t, err)
+		cassette = sqlcassette.NewCassette()
+		connector = sqlcassette.NewRecordingConnector(snowflakeConnector, cassette)
+		defer func() { require.NoError(t, cassette.Save(cassettePath)) }()
+	} else {
+		loaded, err := sqlcassette.Load(cassettePath)
+		require.NoError(t, err)
+		cassette = loaded
+		connector = sqlcassette.NewReplayingConnector(cassette)
+		defer func() { assert.Empty(t, cassette.Unused()) }()
+	}
+
+	client, err := sdk.NewClientWithConnector(&gosnowflake.Config{}, connector)
+	require.NoError(t, err)
+	testClient := helpers.NewTestClient(client, "SQL_CASSETTE_DB", "SQL_CASSETTE_SC", "SQL_CASSETTE_WH", suffix, testenvs.SnowflakeProdEnvironment)
+
+	// the cleanups of the test database and schema switch back to the test schema, so the test database is dropped directly at the end
+	testDatabase, _ := testClient.Database.CreateDatabaseWithOptions(t, testClient.Ids.DatabaseId(), &sdk.CreateDatabaseOptions{})
+	testSchema, _ := testClient.Schema.CreateSchemaWithIdentifier(t, testClient.Ids.SchemaId())
+	database, databaseCleanup := testClient.Database.CreateDatabase(t)
+	schema, schemaCleanup := testClient.Schema.CreateSchema(t)
+
+	assert.Equal(t, testClient.Ids.DatabaseId().Name(), testDatabase.Name)
+	assert.Equal(t, testClient.Ids.SchemaId().Name(), testSchema.Name)
+	assert.True(t, strings.HasSuffix(database.Name, suffix))
+	assert.True(t, strings.HasSuffix(schema.Name, suffix))
+	assert.Equal(t, testDatabase.Name, schema.DatabaseName)
+
+	schemaCleanup()
+	databaseCleanup()
+	require.NoError(t, client.Databases.Drop(context.Background(), testClient.Ids.DatabaseId(), &sdk.DropDatabaseOptions{IfExists: sdk.Bool(true)}))
+}
+
+func TestToMode(t *testing.T) {
+	mode, err := sqlcassette.ToMode("replay")
+	require.NoError(t, err)
+	assert.Equal(t, sqlcassette.ModeReplay, mode)
+
+	_, err = sqlcassette.ToMode("rewind")
+	require.ErrorContains(t, err, "invalid cassette mode: rewind")
+}
+
+// scriptedConnector simulates the Snowflake driver by answering the statements used in the test.
+type scriptedConnector struct{}
+
+func (c *scriptedConnector) Connect(_ context.Context) (driver.Conn, error) {
+	return &scriptedConn{}, nil
+}
+
+func (c *scriptedConnector) Driver() driver.Driver {
+	return nil
+}
+
+type scriptedConn struct{}
+
+func (c *scriptedConn) Prepare(_ string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *scriptedConn) Close() error {
+	return nil
+}
+
+func (c *scriptedConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *scriptedConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if strings.HasPrefix(query, `DROP DATABASE "MISSING"`) {
+		return nil, errors.New("002003 (02000): SQL compilation error:\nDatabase 'MISSING' does not exist or not authorized.")
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (c *scriptedConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	switch {
+	case strings.HasPrefix(query, "SELECT CURRENT_ACCOUNT()"):
+		return &scriptedRows{columns: []string{"CURRENT_ACCOUNT"}, rows: [][]driver.Value{{"FAKE_ACCOUNT"}}}, nil
+	case strings.HasPrefix(query, "SELECT CURRENT_SESSION()"):
+		return &scriptedRows{columns: []string{"CURRENT_SESSION"}, rows: [][]driver.Value{{"123"}}}, nil
+	case strings.HasPrefix(query, "SHOW DATABASES"):
+		return &scriptedRows{
+			columns: []string{"created_on", "name", "is_default", "owner", "comment", "retention_time", "dropped_on"},
+			rows: [][]driver.Value{
+				{time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC), "DB", "N", "ACCOUNTADMIN", "comment", "1", nil},
+			},
+		}, nil
+	default:
+		return nil, errors.New("unexpected query: " + query)
+	}
+}
+
+type scriptedRows struct {
+	columns []string
+	rows    [][]driver.Value
+	next    int
+}
+
+func (r *scriptedRows) Columns() []string {
+	return r.columns
+}
+
+func (r *scriptedRows) Close() error {
+	return nil
+}
+
+func (r *scriptedRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.next])
+	r.next++
+	return nil
+}
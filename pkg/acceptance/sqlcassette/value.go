@@ -0,0 +1,69 @@
+package sqlcassette
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type ValueType string
+
+const (
+	ValueTypeNull    ValueType = "null"
+	ValueTypeString  ValueType = "string"
+	ValueTypeInt64   ValueType = "int64"
+	ValueTypeFloat64 ValueType = "float64"
+	ValueTypeBool    ValueType = "bool"
+	ValueTypeBytes   ValueType = "bytes"
+	ValueTypeTime    ValueType = "time"
+)
+
+// Value is a serializable form of driver.Value. The type is kept, so that the replayed rows are scanned the same way as the recorded ones.
+type Value struct {
+	Type  ValueType `json:"type"`
+	Value string    `json:"value,omitempty"`
+}
+
+func toValue(v driver.Value) (Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return Value{Type: ValueTypeNull}, nil
+	case string:
+		return Value{Type: ValueTypeString, Value: v}, nil
+	case int64:
+		return Value{Type: ValueTypeInt64, Value: strconv.FormatInt(v, 10)}, nil
+	case float64:
+		return Value{Type: ValueTypeFloat64, Value: strconv.FormatFloat(v, 'g', -1, 64)}, nil
+	case bool:
+		return Value{Type: ValueTypeBool, Value: strconv.FormatBool(v)}, nil
+	case []byte:
+		return Value{Type: ValueTypeBytes, Value: base64.StdEncoding.EncodeToString(v)}, nil
+	case time.Time:
+		return Value{Type: ValueTypeTime, Value: v.Format(time.RFC3339Nano)}, nil
+	default:
+		return Value{}, fmt.Errorf("unsupported driver value type %T", v)
+	}
+}
+
+func (v Value) toDriverValue() (driver.Value, error) {
+	switch v.Type {
+	case ValueTypeNull:
+		return nil, nil
+	case ValueTypeString:
+		return v.Value, nil
+	case ValueTypeInt64:
+		return strconv.ParseInt(v.Value, 10, 64)
+	case ValueTypeFloat64:
+		return strconv.ParseFloat(v.Value, 64)
+	case ValueTypeBool:
+		return strconv.ParseBool(v.Value)
+	case ValueTypeBytes:
+		return base64.StdEncoding.DecodeString(v.Value)
+	case ValueTypeTime:
+		return time.Parse(time.RFC3339Nano, v.Value)
+	default:
+		return nil, fmt.Errorf("unsupported cassette value type %s", v.Type)
+	}
+}
@@ -29,6 +29,13 @@ const (
 	AzureExternalSasToken  env = "TEST_SF_TF_AZURE_EXTERNAL_SAS_TOKEN" // #nosec G101
 	GcsExternalBucketUrl   env = "TEST_SF_TF_GCS_EXTERNAL_BUCKET_URL"
 
+	// Private connectivity tests require a Business Critical account; the endpoints are provisioned with SYSTEM$PROVISION_PRIVATELINK_ENDPOINT
+	// and the inbound authorization is done with SYSTEM$AUTHORIZE_PRIVATELINK.
+	PrivatelinkEndpointProviderResourceId env = "TEST_SF_TF_PRIVATELINK_ENDPOINT_PROVIDER_RESOURCE_ID"
+	PrivatelinkEndpointHost               env = "TEST_SF_TF_PRIVATELINK_ENDPOINT_HOST"
+	PrivatelinkAuthorizationEndpointId    env = "TEST_SF_TF_PRIVATELINK_AUTHORIZATION_ENDPOINT_ID"
+	PrivatelinkAuthorizationToken         env = "TEST_SF_TF_PRIVATELINK_AUTHORIZATION_TOKEN" // #nosec G101
+
 	EnableObjectRenamingTest env = "TEST_SF_TF_ENABLE_OBJECT_RENAMING"
 	SkipManagedAccountTest   env = "TEST_SF_TF_SKIP_MANAGED_ACCOUNT_TEST"
 	SkipSamlIntegrationTest  env = "TEST_SF_TF_SKIP_SAML_INTEGRATION_TEST"
@@ -45,6 +52,10 @@ const (
 
 	SimplifiedIntegrationTestsSetup env = "TEST_SF_TF_SIMPLIFIED_INTEGRATION_TESTS_SETUP"
 
+	// SqlCassetteMode enables recording (record) or replaying (replay) the SQL traffic of the acceptance tests (see sqlcassette package).
+	SqlCassetteMode env = "TEST_SF_TF_SQL_CASSETTE_MODE"
+	SqlCassetteDir  env = "TEST_SF_TF_SQL_CASSETTE_DIR"
+
 	TestResourceNullListHandlingEnv     env = "TEST_SF_TF_TEST_RESOURCE_DATA_NULL_LIST_HANDLING_ENV"
 	TestResourceDataTypeDiffHandlingEnv env = "TEST_SF_TF_TEST_RESOURCE_DATA_DIFF_HANDLING_ENV"
 
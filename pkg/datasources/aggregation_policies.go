@@ -0,0 +1,33 @@
+package datasources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var aggregationPoliciesDefinition = bodyPoliciesDefinition[sdk.ShowAggregationPolicyRequest, sdk.AggregationPolicy, sdk.AggregationPolicyDescription]{
+	datasource:        datasources.AggregationPolicies,
+	previewFeature:    string(previewfeatures.AggregationPoliciesDatasource),
+	objectType:        sdk.ObjectTypeAggregationPolicy,
+	documentationLink: "https://docs.snowflake.com/en/sql-reference/sql/show-aggregation-policies",
+
+	showOutputSchema:     schemas.ShowAggregationPolicySchema,
+	describeOutputSchema: schemas.ShowAggregationPolicyDescriptionSchema,
+	toSchema:             schemas.AggregationPolicyToSchema,
+	descriptionToSchema:  schemas.AggregationPolicyDescriptionToSchema,
+
+	client: func(client *sdk.Client) bodyPoliciesClient[sdk.ShowAggregationPolicyRequest, sdk.AggregationPolicy, sdk.AggregationPolicyDescription] {
+		return client.AggregationPolicies
+	},
+	newShowRequest: func(like *sdk.Like, in *sdk.ExtendedIn, limit *sdk.LimitFrom) *sdk.ShowAggregationPolicyRequest {
+		return &sdk.ShowAggregationPolicyRequest{Like: like, In: in, Limit: limit}
+	},
+	id: (*sdk.AggregationPolicy).ID,
+}
+
+func AggregationPolicies() *schema.Resource {
+	return bodyPoliciesDatasource(aggregationPoliciesDefinition)
+}
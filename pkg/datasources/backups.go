@@ -0,0 +1,73 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var backupsSchema = map[string]*schema.Schema{
+	"in_backup_set": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Returns backups available in the specified backup set.",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"backups": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all backups details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW BACKUPS IN BACKUP SET.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowBackupSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func Backups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.BackupsDatasource), TrackingReadWrapper(datasources.Backups, ReadBackups)),
+		Schema:      backupsSchema,
+		Description: "Data source used to list the backups available in a backup set. The results of [SHOW BACKUPS IN BACKUP SET](https://docs.snowflake.com/en/sql-reference/sql/show-backups) are encapsulated in one output collection `backups`.",
+	}
+}
+
+func ReadBackups(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	backupSetId, err := sdk.ParseSchemaObjectIdentifier(d.Get("in_backup_set").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backups, err := client.BackupSets.ShowBackups(ctx, sdk.NewShowBackupsBackupSetRequest(backupSetId))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("backups_read")
+
+	flattenedBackups := make([]map[string]any, len(backups))
+	for i, backup := range backups {
+		flattenedBackups[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.BackupToSchema(&backup)},
+		}
+	}
+	if err := d.Set("backups", flattenedBackups); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var behaviorChangeBundlesSchema = map[string]*schema.Schema{
+	"behavior_change_bundles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of `SYSTEM$SHOW_ACTIVE_BEHAVIOR_CHANGE_BUNDLES`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the behavior change bundle.",
+				},
+				"is_default": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Specifies whether the behavior change bundle is enabled by default.",
+				},
+				"is_enabled": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Specifies whether the behavior change bundle is currently enabled in the account.",
+				},
+			},
+		},
+	},
+}
+
+func BehaviorChangeBundles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.BehaviorChangeBundlesDatasource), TrackingReadWrapper(datasources.BehaviorChangeBundles, ReadBehaviorChangeBundles)),
+		Schema:      behaviorChangeBundlesSchema,
+		Description: "Data source used to get details of active behavior change bundles in the current account. The results of [SYSTEM$SHOW_ACTIVE_BEHAVIOR_CHANGE_BUNDLES](https://docs.snowflake.com/en/sql-reference/functions/system_show_active_behavior_change_bundles) are encapsulated in one output collection `behavior_change_bundles`.",
+	}
+}
+
+func ReadBehaviorChangeBundles(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	bundles, err := client.SystemFunctions.ShowActiveBehaviorChangeBundles(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("behavior_change_bundles_read")
+
+	flattenedBundles := make([]map[string]any, len(bundles))
+	for i, bundle := range bundles {
+		flattenedBundles[i] = map[string]any{
+			"name":       bundle.Name,
+			"is_default": bundle.IsDefault,
+			"is_enabled": bundle.IsEnabled,
+		}
+	}
+	if err := d.Set("behavior_change_bundles", flattenedBundles); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// bodyPoliciesClient is the part of the SDK interfaces shared by the policies consisting only of a body and a comment
+// (aggregation, join, privacy, and projection policies).
+type bodyPoliciesClient[ShowRequest, Policy, Description any] interface {
+	Show(ctx context.Context, request *ShowRequest) ([]Policy, error)
+	Describe(ctx context.Context, id sdk.SchemaObjectIdentifier) (*Description, error)
+}
+
+// bodyPoliciesDefinition holds everything that differs between the data sources of the policies consisting only of a body and a comment.
+type bodyPoliciesDefinition[ShowRequest, Policy, Description any] struct {
+	datasource     datasources.Datasource
+	previewFeature string
+	objectType     sdk.ObjectType
+	// documentationLink points to the documentation of the SHOW command
+	documentationLink string
+
+	showOutputSchema     map[string]*schema.Schema
+	describeOutputSchema map[string]*schema.Schema
+	toSchema             func(*Policy) map[string]any
+	descriptionToSchema  func(*Description) map[string]any
+
+	client         func(*sdk.Client) bodyPoliciesClient[ShowRequest, Policy, Description]
+	newShowRequest func(like *sdk.Like, in *sdk.ExtendedIn, limit *sdk.LimitFrom) *ShowRequest
+	id             func(*Policy) sdk.SchemaObjectIdentifier
+}
+
+// outputAttributeName returns the name of the attribute holding the results, e.g. "aggregation_policies".
+func (def bodyPoliciesDefinition[ShowRequest, Policy, Description]) outputAttributeName() string {
+	return strings.ReplaceAll(strings.ToLower(string(def.objectType.Plural())), " ", "_")
+}
+
+func (def bodyPoliciesDefinition[ShowRequest, Policy, Description]) schema() map[string]*schema.Schema {
+	objectName := strings.ToLower(def.objectType.String())
+	return map[string]*schema.Schema{
+		"with_describe": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: fmt.Sprintf("Runs DESC %s for each %s returned by SHOW %s. The output of describe is saved to the describe_output field. By default this value is set to true.", def.objectType, objectName, def.objectType.Plural()),
+		},
+		"like":  likeSchema,
+		"in":    extendedInSchema,
+		"limit": limitFromSchema,
+		def.outputAttributeName(): {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("Holds the aggregated output of all %s details queries.", objectName),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					resources.ShowOutputAttributeName: {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: fmt.Sprintf("Holds the output of SHOW %s.", def.objectType.Plural()),
+						Elem: &schema.Resource{
+							Schema: def.showOutputSchema,
+						},
+					},
+					resources.DescribeOutputAttributeName: {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: fmt.Sprintf("Holds the output of DESCRIBE %s.", def.objectType),
+						Elem: &schema.Resource{
+							Schema: def.describeOutputSchema,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// bodyPoliciesDatasource builds the data source of one of the policies consisting only of a body and a comment.
+func bodyPoliciesDatasource[ShowRequest, Policy, Description any](def bodyPoliciesDefinition[ShowRequest, Policy, Description]) *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(def.previewFeature, TrackingReadWrapper(def.datasource, def.read)),
+		Schema:      def.schema(),
+		Description: fmt.Sprintf("Data source used to get details of filtered %s. Filtering is aligned with the current possibilities for [SHOW %s](%s) query.", strings.ToLower(string(def.objectType.Plural())), def.objectType.Plural(), def.documentationLink) +
+			fmt.Sprintf(" The results of SHOW and DESCRIBE are encapsulated in one output collection `%s`.", def.outputAttributeName()),
+	}
+}
+
+func (def bodyPoliciesDefinition[ShowRequest, Policy, Description]) read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := def.client(meta.(*provider.Context).Client)
+
+	var like *sdk.Like
+	var in *sdk.ExtendedIn
+	var limit *sdk.LimitFrom
+	handleLike(d, &like)
+	handleLimitFrom(d, &limit)
+	if err := handleExtendedIn(d, &in); err != nil {
+		return diag.FromErr(err)
+	}
+
+	policies, err := client.Show(ctx, def.newShowRequest(like, in, limit))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s_read", def.outputAttributeName()))
+
+	flattenedPolicies := make([]map[string]any, len(policies))
+	for i := range policies {
+		policy := policies[i]
+		var policyDescription []map[string]any
+		if d.Get("with_describe").(bool) {
+			describeOutput, err := client.Describe(ctx, def.id(&policy))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			policyDescription = []map[string]any{def.descriptionToSchema(describeOutput)}
+		}
+		flattenedPolicies[i] = map[string]any{
+			resources.ShowOutputAttributeName:     []map[string]any{def.toSchema(&policy)},
+			resources.DescribeOutputAttributeName: policyDescription,
+		}
+	}
+	if err := d.Set(def.outputAttributeName(), flattenedPolicies); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
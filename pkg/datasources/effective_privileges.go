@@ -0,0 +1,305 @@
+package datasources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var effectivePrivilegesPrincipalAttributes = []string{"account_role", "database_role", "user"}
+
+var effectivePrivilegesSchema = map[string]*schema.Schema{
+	"account_role": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Lists the effective privileges of the account role (including the privileges of all the roles granted to it).",
+		ExactlyOneOf:     effectivePrivilegesPrincipalAttributes,
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.AccountObjectIdentifier](),
+	},
+	"database_role": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Lists the effective privileges of the database role (including the privileges of all the database roles granted to it). Must be a fully qualified name (\"&lt;db_name&gt;\".\"&lt;database_role_name&gt;\").",
+		ExactlyOneOf:     effectivePrivilegesPrincipalAttributes,
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+	},
+	"user": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Lists the effective privileges of the user (the privileges of all the roles granted to the user, directly or through other roles).",
+		ExactlyOneOf:     effectivePrivilegesPrincipalAttributes,
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.AccountObjectIdentifier](),
+	},
+	"on": {
+		Type:        schema.TypeList,
+		MaxItems:    1,
+		Optional:    true,
+		Description: "Limits the results to the privileges on the given object or on all the objects of the given type.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Type of the object, e.g. `DATABASE` or `TABLE`. For future grants, it is compared with the type of the future objects.",
+				},
+				"object_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Fully qualified name of the object. When set, the future grants are not returned, because they are not granted on the existing objects.",
+				},
+			},
+		},
+	},
+	"include_future_grants": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Includes the future grants (`SHOW FUTURE GRANTS TO ...`) of all the roles in the hierarchy.",
+	},
+	"include_ownership": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Includes the `OWNERSHIP` privileges.",
+	},
+	"include_public_role": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Includes the privileges of the PUBLIC role, which is implicitly granted to every user and role (Snowflake does not list it in the grants). Not applicable to database roles.",
+	},
+	"effective_roles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Fully qualified names of all the roles (account, database, and application roles) reachable from the principal, including the principal role itself.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"effective_privileges": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The list of the effective privileges.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"privilege": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The privilege granted.",
+				},
+				"granted_on": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the object on which the privilege was granted (for future grants, the type of the future objects).",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the object on which the privilege was granted (for future grants, the name of the database or schema with the object type placeholder).",
+				},
+				"grant_option": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the grantee can grant the privilege to others.",
+				},
+				"granted_by": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The role that granted the privilege.",
+				},
+				"is_future": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the privilege comes from a future grant.",
+				},
+				"grantee_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the role holding the privilege directly (`ROLE`, `DATABASE ROLE`, or `APPLICATION ROLE`).",
+				},
+				"grantee_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The fully qualified name of the role holding the privilege directly.",
+				},
+				"path": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The chain of grantees through which the privilege is inherited, starting with the principal and ending with the role holding the privilege directly.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	},
+}
+
+func EffectivePrivileges() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.EffectivePrivilegesDatasource), TrackingReadWrapper(datasources.EffectivePrivileges, ReadEffectivePrivileges)),
+		Schema:      effectivePrivilegesSchema,
+		Description: "Data source used to get the effective privileges of a user, an account role, or a database role. Unlike `snowflake_grants`, which lists only the direct grants, it walks the role hierarchy transitively (with `SHOW GRANTS TO` and `SHOW FUTURE GRANTS TO` for every reachable role) and reports each privilege with the path of roles it was inherited through. The roles granted to a user are resolved with `SHOW GRANTS OF ROLE` for every account role, so querying a user may be slow in accounts with many roles.",
+	}
+}
+
+// effectivePrivilege is a privilege held directly by one of the roles reachable from the principal.
+type effectivePrivilege struct {
+	sdk.Grant
+	IsFuture bool
+	Grantee  roleGraphNode
+	Path     []string
+}
+
+// effectivePrivilegesFilter limits the collected privileges to the ones requested in the configuration.
+type effectivePrivilegesFilter struct {
+	ObjectType       sdk.ObjectType
+	ObjectName       string
+	WithFutureGrants bool
+	WithOwnership    bool
+	WithPublicRole   bool
+}
+
+func ReadEffectivePrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	principal, err := effectivePrivilegesPrincipal(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	filter := effectivePrivilegesFilter{
+		WithFutureGrants: d.Get("include_future_grants").(bool),
+		WithOwnership:    d.Get("include_ownership").(bool),
+		WithPublicRole:   d.Get("include_public_role").(bool),
+	}
+	if v, ok := d.GetOk("on"); ok && len(v.([]any)) > 0 {
+		on := v.([]any)[0].(map[string]any)
+		filter.ObjectType = sdk.ObjectType(strings.ToUpper(strings.ReplaceAll(on["object_type"].(string), "_", " ")))
+		if objectName := on["object_name"].(string); objectName != "" {
+			objectId, err := effectivePrivilegesObjectId(filter.ObjectType, objectName)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			filter.ObjectName = objectId.FullyQualifiedName()
+		}
+	}
+
+	roles, privileges, err := collectEffectivePrivileges(ctx, client, principal, filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattened := make([]map[string]any, len(privileges))
+	for i, p := range privileges {
+		grantedOn := p.GrantedOn
+		if p.IsFuture {
+			grantedOn = p.GrantOn
+		}
+		var name string
+		if p.Name != nil {
+			name = p.Name.FullyQualifiedName()
+		}
+		flattened[i] = map[string]any{
+			"privilege":    p.Privilege,
+			"granted_on":   grantedOn.String(),
+			"name":         name,
+			"grant_option": p.GrantOption,
+			"granted_by":   p.GrantedBy.Name(),
+			"is_future":    p.IsFuture,
+			"grantee_type": p.Grantee.ObjectType.String(),
+			"grantee_name": p.Grantee.Id.FullyQualifiedName(),
+			"path":         p.Path,
+		}
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(principal.ObjectType.String(), principal.Id.FullyQualifiedName()))
+	if err := d.Set("effective_roles", roles); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("effective_privileges", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func effectivePrivilegesPrincipal(d *schema.ResourceData) (roleGraphNode, error) {
+	if v, ok := d.GetOk("database_role"); ok {
+		id, err := sdk.ParseDatabaseObjectIdentifier(v.(string))
+		return roleGraphNode{ObjectType: sdk.ObjectTypeDatabaseRole, Id: id}, err
+	}
+	if v, ok := d.GetOk("user"); ok {
+		id, err := sdk.ParseAccountObjectIdentifier(v.(string))
+		return roleGraphNode{ObjectType: sdk.ObjectTypeUser, Id: id}, err
+	}
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("account_role").(string))
+	return roleGraphNode{ObjectType: sdk.ObjectTypeRole, Id: id}, err
+}
+
+// effectivePrivilegesObjectId parses the object name the same way as the snowflake_grants data source does.
+func effectivePrivilegesObjectId(objectType sdk.ObjectType, objectName string) (sdk.ObjectIdentifier, error) {
+	if objectType.IsWithArguments() {
+		return sdk.ParseSchemaObjectIdentifierWithArguments(objectName)
+	}
+	return helpers.DecodeSnowflakeParameterID(objectName)
+}
+
+// collectEffectivePrivileges walks the role hierarchy of the principal and returns the reachable roles and their privileges.
+// The USAGE grants on roles are the hierarchy edges, so they are not reported as privileges.
+func collectEffectivePrivileges(ctx context.Context, client *sdk.Client, principal roleGraphNode, filter effectivePrivilegesFilter) ([]string, []effectivePrivilege, error) {
+	roles := make([]string, 0)
+	privileges := make([]effectivePrivilege, 0)
+
+	err := walkRoleGraph(ctx, client, principal, filter.WithPublicRole && principal.ObjectType != sdk.ObjectTypeDatabaseRole, func(node roleGraphNode, path []string, grants []sdk.Grant) error {
+		if node.ObjectType == sdk.ObjectTypeUser {
+			return nil
+		}
+		roles = append(roles, node.Id.FullyQualifiedName())
+
+		for _, grant := range grants {
+			if _, isEdge := roleGraphChild(grant); isEdge {
+				continue
+			}
+			p := effectivePrivilege{Grant: grant, Grantee: node, Path: path}
+			if filter.matches(p) {
+				privileges = append(privileges, p)
+			}
+		}
+
+		if !filter.WithFutureGrants || filter.ObjectName != "" {
+			return nil
+		}
+		futureGrants, err := showFutureGrantsToNode(ctx, client, node)
+		if err != nil {
+			return err
+		}
+		for _, grant := range futureGrants {
+			p := effectivePrivilege{Grant: grant, IsFuture: true, Grantee: node, Path: path}
+			if filter.matches(p) {
+				privileges = append(privileges, p)
+			}
+		}
+		return nil
+	})
+	return roles, privileges, err
+}
+
+func (f effectivePrivilegesFilter) matches(p effectivePrivilege) bool {
+	if !f.WithOwnership && p.Privilege == "OWNERSHIP" {
+		return false
+	}
+	objectType := p.GrantedOn
+	if p.IsFuture {
+		objectType = p.GrantOn
+	}
+	if f.ObjectType != "" && objectType != f.ObjectType {
+		return false
+	}
+	if f.ObjectName != "" && (p.IsFuture || p.Name == nil || p.Name.FullyQualifiedName() != f.ObjectName) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+//go:build fake_client_tests
+
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CollectEffectivePrivileges(t *testing.T) {
+	ctx := context.Background()
+	client := sdk.NewFakeClient(sdk.NewFakeCatalog())
+
+	userId := sdk.NewAccountObjectIdentifier("USER")
+	databaseId := sdk.NewAccountObjectIdentifier("DB")
+	roleA, roleB, roleC, roleD := sdk.NewAccountObjectIdentifier("A"), sdk.NewAccountObjectIdentifier("B"), sdk.NewAccountObjectIdentifier("C"), sdk.NewAccountObjectIdentifier("D")
+
+	require.NoError(t, client.Users.Create(ctx, userId, nil))
+	require.NoError(t, client.Databases.Create(ctx, databaseId, nil))
+	for _, id := range []sdk.AccountObjectIdentifier{roleA, roleB, roleC, roleD} {
+		require.NoError(t, client.Roles.Create(ctx, sdk.NewCreateRoleRequest(id)))
+	}
+	// USER -> A -> (B, C) -> D; D is reachable through two paths
+	require.NoError(t, client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(roleA, sdk.GrantRole{User: &userId})))
+	require.NoError(t, client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(roleB, sdk.GrantRole{Role: &roleA})))
+	require.NoError(t, client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(roleC, sdk.GrantRole{Role: &roleA})))
+	require.NoError(t, client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(roleD, sdk.GrantRole{Role: &roleB})))
+	require.NoError(t, client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(roleD, sdk.GrantRole{Role: &roleC})))
+
+	require.NoError(t, client.Grants.GrantPrivilegesToAccountRole(ctx,
+		&sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeUsage}},
+		&sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Database: &databaseId}},
+		roleD, nil,
+	))
+	require.NoError(t, client.Grants.GrantPrivilegesToAccountRole(ctx,
+		&sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeMonitor}},
+		&sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Database: &databaseId}},
+		roleA, &sdk.GrantPrivilegesToAccountRoleOptions{WithGrantOption: sdk.Bool(true)},
+	))
+	require.NoError(t, client.Grants.GrantPrivilegesToAccountRole(ctx,
+		&sdk.AccountRoleGrantPrivileges{SchemaObjectPrivileges: []sdk.SchemaObjectPrivilege{sdk.SchemaObjectPrivilegeSelect}},
+		&sdk.AccountRoleGrantOn{SchemaObject: &sdk.GrantOnSchemaObject{Future: &sdk.GrantOnSchemaObjectIn{PluralObjectType: sdk.PluralObjectTypeTables, InDatabase: &databaseId}}},
+		roleC, nil,
+	))
+
+	user := roleGraphNode{ObjectType: sdk.ObjectTypeUser, Id: userId}
+
+	t.Run("user with all privileges", func(t *testing.T) {
+		roles, privileges, err := collectEffectivePrivileges(ctx, client, user, effectivePrivilegesFilter{WithFutureGrants: true, WithOwnership: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{`"A"`, `"B"`, `"C"`, `"D"`}, roles)
+		require.Len(t, privileges, 3)
+
+		assert.Equal(t, "MONITOR", privileges[0].Privilege)
+		assert.True(t, privileges[0].GrantOption)
+		assert.Equal(t, []string{`"USER"`, `"A"`}, privileges[0].Path)
+
+		assert.Equal(t, "SELECT", privileges[1].Privilege)
+		assert.True(t, privileges[1].IsFuture)
+		assert.Equal(t, sdk.ObjectTypeTable, privileges[1].GrantOn)
+		assert.Equal(t, []string{`"USER"`, `"A"`, `"C"`}, privileges[1].Path)
+
+		assert.Equal(t, "USAGE", privileges[2].Privilege)
+		assert.Equal(t, sdk.ObjectTypeDatabase, privileges[2].GrantedOn)
+		assert.Equal(t, roleD.FullyQualifiedName(), privileges[2].Grantee.Id.FullyQualifiedName())
+		assert.Equal(t, []string{`"USER"`, `"A"`, `"B"`, `"D"`}, privileges[2].Path)
+	})
+
+	t.Run("role without future grants", func(t *testing.T) {
+		roles, privileges, err := collectEffectivePrivileges(ctx, client, roleGraphNode{ObjectType: sdk.ObjectTypeRole, Id: roleC}, effectivePrivilegesFilter{WithOwnership: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{`"C"`, `"D"`}, roles)
+		require.Len(t, privileges, 1)
+		assert.Equal(t, "USAGE", privileges[0].Privilege)
+		assert.Equal(t, []string{`"C"`, `"D"`}, privileges[0].Path)
+	})
+
+	t.Run("filtered by object", func(t *testing.T) {
+		_, privileges, err := collectEffectivePrivileges(ctx, client, user, effectivePrivilegesFilter{
+			ObjectType:       sdk.ObjectTypeDatabase,
+			ObjectName:       databaseId.FullyQualifiedName(),
+			WithFutureGrants: true,
+			WithOwnership:    true,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, privileges, 2)
+		assert.Equal(t, "MONITOR", privileges[0].Privilege)
+		assert.Equal(t, "USAGE", privileges[1].Privilege)
+	})
+
+	t.Run("filtered by future object type", func(t *testing.T) {
+		_, privileges, err := collectEffectivePrivileges(ctx, client, user, effectivePrivilegesFilter{ObjectType: sdk.ObjectTypeTable, WithFutureGrants: true})
+		require.NoError(t, err)
+
+		require.Len(t, privileges, 1)
+		assert.Equal(t, "SELECT", privileges[0].Privilege)
+	})
+
+	t.Run("non-existing user", func(t *testing.T) {
+		_, _, err := collectEffectivePrivileges(ctx, client, roleGraphNode{ObjectType: sdk.ObjectTypeUser, Id: sdk.NewAccountObjectIdentifier("MISSING")}, effectivePrivilegesFilter{})
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+
+	t.Run("non-existing role", func(t *testing.T) {
+		_, _, err := collectEffectivePrivileges(ctx, client, roleGraphNode{ObjectType: sdk.ObjectTypeRole, Id: sdk.NewAccountObjectIdentifier("MISSING")}, effectivePrivilegesFilter{})
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
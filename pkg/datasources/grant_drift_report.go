@@ -0,0 +1,388 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var grantDriftReportScopeAttributes = []string{"account_role", "database", "schema"}
+
+var grantDriftReportGrantSchema = map[string]*schema.Schema{
+	"privilege": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The privilege granted.",
+	},
+	"granted_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The type of the object on which the privilege was granted (for future grants, the type of the future objects).",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The fully qualified name of the object on which the privilege was granted (for future grants, the name of the database or schema with the object type placeholder).",
+	},
+	"grantee_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The fully qualified name of the account role holding the privilege.",
+	},
+	"grant_option": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the grantee can grant the privilege to others.",
+	},
+	"is_future": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the privilege comes from a future grant.",
+	},
+	"grant_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "For the managed grants, the identifier from `managed_grant_ids` matching the grant. For the unmanaged grants, the import identifier of a `snowflake_grant_privileges_to_account_role` resource that would manage the grant (empty when the object type cannot be expressed in the identifier).",
+	},
+}
+
+var grantDriftReportSchema = map[string]*schema.Schema{
+	"account_role": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Reports all the grants to the given account role.",
+		ExactlyOneOf:     grantDriftReportScopeAttributes,
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.AccountObjectIdentifier](),
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Reports the grants to all the account roles on the given database, its schemas, and its schema objects (including the future grants in the database and its schemas).",
+		ExactlyOneOf:     grantDriftReportScopeAttributes,
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.AccountObjectIdentifier](),
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Reports the grants to all the account roles on the given schema and its schema objects (including the future grants in the schema). Must be a fully qualified name (\"&lt;db_name&gt;\".\"&lt;schema_name&gt;\").",
+		ExactlyOneOf:     grantDriftReportScopeAttributes,
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+	},
+	"managed_grant_ids": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Identifiers of the `snowflake_grant_privileges_to_account_role` resources managed by this configuration (e.g. `snowflake_grant_privileges_to_account_role.example.id`). The grants matching any of them are reported as managed.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"include_future_grants": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Includes the future grants (`SHOW FUTURE GRANTS TO ROLE ...`).",
+	},
+	"managed_grants": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The grants in scope matching one of `managed_grant_ids`.",
+		Elem:        &schema.Resource{Schema: grantDriftReportGrantSchema},
+	},
+	"unmanaged_grants": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The grants in scope not matching any of `managed_grant_ids`, e.g. the grants made manually.",
+		Elem:        &schema.Resource{Schema: grantDriftReportGrantSchema},
+	},
+}
+
+func GrantDriftReport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.GrantDriftReportDatasource), TrackingReadWrapper(datasources.GrantDriftReport, ReadGrantDriftReport)),
+		Schema:      grantDriftReportSchema,
+		Description: "Data source used to audit the grants to account roles in a scope (an account role, a database, or a schema). Every grant in scope is classified as managed (matching one of the given `snowflake_grant_privileges_to_account_role` identifiers) or unmanaged, so the grants made outside of Terraform can be found. The `OWNERSHIP` privileges and the role hierarchy (`USAGE` on roles) are not reported; they are managed by other resources.",
+	}
+}
+
+// grantDriftReportScope limits the reported grants; exactly one of the fields is set.
+type grantDriftReportScope struct {
+	Role     *sdk.AccountObjectIdentifier
+	Database *sdk.AccountObjectIdentifier
+	Schema   *sdk.DatabaseObjectIdentifier
+}
+
+// grantDriftReportGrant is a single privilege granted to an account role, together with the database and schema it belongs to.
+type grantDriftReportGrant struct {
+	sdk.Grant
+	Role     sdk.AccountObjectIdentifier
+	IsFuture bool
+	Database string
+	Schema   string
+}
+
+// grantDriftReport is the result of comparing the grants in scope with the managed grant identifiers.
+type grantDriftReport struct {
+	Managed   []grantDriftReportEntry
+	Unmanaged []grantDriftReportEntry
+}
+
+type grantDriftReportEntry struct {
+	grantDriftReportGrant
+	GrantId string
+}
+
+func ReadGrantDriftReport(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	scope, scopeId, err := grantDriftReportScopeFromData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	managedIds := make([]resources.GrantPrivilegesToAccountRoleId, 0)
+	for _, raw := range d.Get("managed_grant_ids").(*schema.Set).List() {
+		id, err := resources.ParseGrantPrivilegesToAccountRoleId(raw.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("invalid managed grant identifier %q: %w", raw.(string), err))
+		}
+		managedIds = append(managedIds, id)
+	}
+
+	grants, err := collectGrantDriftReportGrants(ctx, client, scope, d.Get("include_future_grants").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	report := buildGrantDriftReport(grants, managedIds)
+
+	d.SetId(scopeId)
+	if err := d.Set("managed_grants", flattenGrantDriftReportEntries(report.Managed)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("unmanaged_grants", flattenGrantDriftReportEntries(report.Unmanaged)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func grantDriftReportScopeFromData(d *schema.ResourceData) (grantDriftReportScope, string, error) {
+	if v, ok := d.GetOk("database"); ok {
+		id, err := sdk.ParseAccountObjectIdentifier(v.(string))
+		return grantDriftReportScope{Database: &id}, helpers.EncodeResourceIdentifier(sdk.ObjectTypeDatabase.String(), id.FullyQualifiedName()), err
+	}
+	if v, ok := d.GetOk("schema"); ok {
+		id, err := sdk.ParseDatabaseObjectIdentifier(v.(string))
+		return grantDriftReportScope{Schema: &id}, helpers.EncodeResourceIdentifier(sdk.ObjectTypeSchema.String(), id.FullyQualifiedName()), err
+	}
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("account_role").(string))
+	return grantDriftReportScope{Role: &id}, helpers.EncodeResourceIdentifier(sdk.ObjectTypeRole.String(), id.FullyQualifiedName()), err
+}
+
+// collectGrantDriftReportGrants lists the grants to the account role in scope, or to all the account roles when the scope is a database or a schema.
+// Snowflake cannot list the grants on all the objects in a database at once, so the grants of every role are listed and filtered instead.
+func collectGrantDriftReportGrants(ctx context.Context, client *sdk.Client, scope grantDriftReportScope, withFutureGrants bool) ([]grantDriftReportGrant, error) {
+	var roleIds []sdk.AccountObjectIdentifier
+	if scope.Role != nil {
+		roleIds = []sdk.AccountObjectIdentifier{*scope.Role}
+	} else {
+		roles, err := client.Roles.Show(ctx, sdk.NewShowRoleRequest())
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range roles {
+			roleIds = append(roleIds, role.ID())
+		}
+	}
+
+	result := make([]grantDriftReportGrant, 0)
+	add := func(roleId sdk.AccountObjectIdentifier, grants []sdk.Grant, isFuture bool) {
+		for _, grant := range grants {
+			if _, isEdge := roleGraphChild(grant); isEdge || grant.Privilege == "OWNERSHIP" {
+				continue
+			}
+			if g := newGrantDriftReportGrant(roleId, grant, isFuture); scope.contains(g) {
+				result = append(result, g)
+			}
+		}
+	}
+	for _, roleId := range roleIds {
+		grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: roleId}})
+		if err != nil {
+			return nil, err
+		}
+		add(roleId, grants, false)
+		if !withFutureGrants {
+			continue
+		}
+		futureGrants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{Future: sdk.Bool(true), To: &sdk.ShowGrantsTo{Role: roleId}})
+		if err != nil {
+			return nil, err
+		}
+		add(roleId, futureGrants, true)
+	}
+	return result, nil
+}
+
+// newGrantDriftReportGrant resolves the database and schema of the grant from its name.
+func newGrantDriftReportGrant(roleId sdk.AccountObjectIdentifier, grant sdk.Grant, isFuture bool) grantDriftReportGrant {
+	g := grantDriftReportGrant{Grant: grant, Role: roleId, IsFuture: isFuture}
+	switch name := grant.Name.(type) {
+	case sdk.AccountObjectIdentifier:
+		if grant.GrantedOn == sdk.ObjectTypeDatabase {
+			g.Database = name.Name()
+		}
+	case sdk.DatabaseObjectIdentifier:
+		// DB.<SCHEMA>, DB.<TABLE> for the future grants in a database
+		if g.IsFuture {
+			g.Database = name.DatabaseName()
+		} else if grant.GrantedOn == sdk.ObjectTypeSchema {
+			g.Database, g.Schema = name.DatabaseName(), name.Name()
+		}
+	case sdk.SchemaObjectIdentifier:
+		g.Database, g.Schema = name.DatabaseName(), name.SchemaName()
+	case sdk.SchemaObjectIdentifierWithArguments:
+		g.Database, g.Schema = name.DatabaseName(), name.SchemaName()
+	}
+	return g
+}
+
+func (s grantDriftReportScope) contains(g grantDriftReportGrant) bool {
+	switch {
+	case s.Database != nil:
+		return g.Database == s.Database.Name()
+	case s.Schema != nil:
+		return g.Database == s.Schema.DatabaseName() && g.Schema == s.Schema.Name()
+	default:
+		return true
+	}
+}
+
+func buildGrantDriftReport(grants []grantDriftReportGrant, managedIds []resources.GrantPrivilegesToAccountRoleId) grantDriftReport {
+	report := grantDriftReport{Managed: make([]grantDriftReportEntry, 0), Unmanaged: make([]grantDriftReportEntry, 0)}
+	for _, g := range grants {
+		index := slices.IndexFunc(managedIds, func(id resources.GrantPrivilegesToAccountRoleId) bool { return grantDriftReportMatches(id, g) })
+		if index >= 0 {
+			report.Managed = append(report.Managed, grantDriftReportEntry{grantDriftReportGrant: g, GrantId: managedIds[index].String()})
+		} else {
+			report.Unmanaged = append(report.Unmanaged, grantDriftReportEntry{grantDriftReportGrant: g, GrantId: grantDriftReportImportId(g)})
+		}
+	}
+	return report
+}
+
+// grantDriftReportMatches checks if the grant is one of the privileges granted by the resource with the given identifier.
+// The grant option is not compared, so a privilege granted with a different grant option is not mistaken for a manual grant.
+func grantDriftReportMatches(id resources.GrantPrivilegesToAccountRoleId, g grantDriftReportGrant) bool {
+	if id.RoleName.Name() != g.Role.Name() {
+		return false
+	}
+	if !id.AllPrivileges && !slices.ContainsFunc(id.Privileges, func(privilege string) bool { return strings.EqualFold(privilege, g.Privilege) }) {
+		return false
+	}
+
+	switch data := id.Data.(type) {
+	case *resources.OnAccountGrantData:
+		return !g.IsFuture && g.GrantedOn == sdk.ObjectTypeAccount
+	case *resources.OnAccountObjectGrantData:
+		return !g.IsFuture && g.GrantedOn == data.ObjectType && g.Name.FullyQualifiedName() == data.ObjectName.FullyQualifiedName()
+	case *resources.OnSchemaGrantData:
+		switch data.Kind {
+		case resources.OnSchemaSchemaGrantKind:
+			return !g.IsFuture && g.GrantedOn == sdk.ObjectTypeSchema && g.Database == data.SchemaName.DatabaseName() && g.Schema == data.SchemaName.Name()
+		case resources.OnAllSchemasInDatabaseSchemaGrantKind:
+			return !g.IsFuture && g.GrantedOn == sdk.ObjectTypeSchema && g.Database == data.DatabaseName.Name()
+		case resources.OnFutureSchemasInDatabaseSchemaGrantKind:
+			return g.IsFuture && g.GrantOn == sdk.ObjectTypeSchema && g.Database == data.DatabaseName.Name() && g.Schema == ""
+		}
+	case *resources.OnSchemaObjectGrantData:
+		switch data.Kind {
+		case resources.OnObjectSchemaObjectGrantKind:
+			return !g.IsFuture && g.GrantedOn == data.Object.ObjectType && g.Name.FullyQualifiedName() == data.Object.Name.FullyQualifiedName()
+		case resources.OnAllSchemaObjectGrantKind:
+			return !g.IsFuture && g.GrantedOn == data.OnAllOrFuture.ObjectNamePlural.Singular() && grantDriftReportInBulkScope(data.OnAllOrFuture, g)
+		case resources.OnFutureSchemaObjectGrantKind:
+			return g.IsFuture && g.GrantOn == data.OnAllOrFuture.ObjectNamePlural.Singular() && grantDriftReportInBulkScope(data.OnAllOrFuture, g)
+		}
+	}
+	return false
+}
+
+func grantDriftReportInBulkScope(data *resources.BulkOperationGrantData, g grantDriftReportGrant) bool {
+	switch data.Kind {
+	case resources.InDatabaseBulkOperationGrantKind:
+		// future grants in a database are not the ones in its schemas
+		return g.Database == data.Database.Name() && (!g.IsFuture || g.Schema == "")
+	case resources.InSchemaBulkOperationGrantKind:
+		return g.Database == data.Schema.DatabaseName() && g.Schema == data.Schema.Name()
+	default:
+		return true
+	}
+}
+
+// grantDriftReportImportId returns the identifier of a snowflake_grant_privileges_to_account_role resource granting the single privilege.
+func grantDriftReportImportId(g grantDriftReportGrant) string {
+	id := resources.GrantPrivilegesToAccountRoleId{
+		RoleName:        g.Role,
+		WithGrantOption: g.GrantOption,
+		Privileges:      []string{g.Privilege},
+	}
+	switch {
+	case g.IsFuture && g.GrantOn == sdk.ObjectTypeSchema:
+		id.Kind = resources.OnSchemaAccountRoleGrantKind
+		id.Data = &resources.OnSchemaGrantData{Kind: resources.OnFutureSchemasInDatabaseSchemaGrantKind, DatabaseName: sdk.Pointer(sdk.NewAccountObjectIdentifier(g.Database))}
+	case g.IsFuture:
+		bulk := &resources.BulkOperationGrantData{ObjectNamePlural: g.GrantOn.Plural(), Kind: resources.InDatabaseBulkOperationGrantKind, Database: sdk.Pointer(sdk.NewAccountObjectIdentifier(g.Database))}
+		if g.Schema != "" {
+			bulk = &resources.BulkOperationGrantData{ObjectNamePlural: g.GrantOn.Plural(), Kind: resources.InSchemaBulkOperationGrantKind, Schema: sdk.Pointer(sdk.NewDatabaseObjectIdentifier(g.Database, g.Schema))}
+		}
+		id.Kind = resources.OnSchemaObjectAccountRoleGrantKind
+		id.Data = &resources.OnSchemaObjectGrantData{Kind: resources.OnFutureSchemaObjectGrantKind, OnAllOrFuture: bulk}
+	case g.GrantedOn == sdk.ObjectTypeAccount:
+		id.Kind = resources.OnAccountAccountRoleGrantKind
+		id.Data = new(resources.OnAccountGrantData)
+	case g.GrantedOn == sdk.ObjectTypeSchema:
+		id.Kind = resources.OnSchemaAccountRoleGrantKind
+		id.Data = &resources.OnSchemaGrantData{Kind: resources.OnSchemaSchemaGrantKind, SchemaName: sdk.Pointer(sdk.NewDatabaseObjectIdentifier(g.Database, g.Schema))}
+	case g.Schema != "":
+		id.Kind = resources.OnSchemaObjectAccountRoleGrantKind
+		id.Data = &resources.OnSchemaObjectGrantData{Kind: resources.OnObjectSchemaObjectGrantKind, Object: &sdk.Object{ObjectType: g.GrantedOn, Name: g.Name}}
+	default:
+		accountObjectId, ok := g.Name.(sdk.AccountObjectIdentifier)
+		if !ok {
+			return ""
+		}
+		id.Kind = resources.OnAccountObjectAccountRoleGrantKind
+		id.Data = &resources.OnAccountObjectGrantData{ObjectType: g.GrantedOn, ObjectName: accountObjectId}
+	}
+	return id.String()
+}
+
+func flattenGrantDriftReportEntries(entries []grantDriftReportEntry) []map[string]any {
+	flattened := make([]map[string]any, len(entries))
+	for i, e := range entries {
+		grantedOn := e.GrantedOn
+		if e.IsFuture {
+			grantedOn = e.GrantOn
+		}
+		var name string
+		if e.Name != nil {
+			name = e.Name.FullyQualifiedName()
+		}
+		flattened[i] = map[string]any{
+			"privilege":    e.Privilege,
+			"granted_on":   grantedOn.String(),
+			"name":         name,
+			"grantee_name": e.Role.FullyQualifiedName(),
+			"grant_option": e.GrantOption,
+			"is_future":    e.IsFuture,
+			"grant_id":     e.GrantId,
+		}
+	}
+	return flattened
+}
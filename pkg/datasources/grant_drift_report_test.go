@@ -0,0 +1,110 @@
+//go:build fake_client_tests
+
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GrantDriftReport(t *testing.T) {
+	ctx := context.Background()
+	client := sdk.NewFakeClient(sdk.NewFakeCatalog())
+
+	roleId, otherRoleId := sdk.NewAccountObjectIdentifier("FR_ANALYST"), sdk.NewAccountObjectIdentifier("FR_LOADER")
+	databaseId, warehouseId := sdk.NewAccountObjectIdentifier("DB"), sdk.NewAccountObjectIdentifier("WH")
+	schemaId := sdk.NewDatabaseObjectIdentifier("DB", "S")
+	for _, id := range []sdk.AccountObjectIdentifier{roleId, otherRoleId} {
+		require.NoError(t, client.Roles.Create(ctx, sdk.NewCreateRoleRequest(id)))
+	}
+	require.NoError(t, client.Databases.Create(ctx, databaseId, nil))
+	require.NoError(t, client.Schemas.Create(ctx, schemaId, nil))
+	require.NoError(t, client.Warehouses.Create(ctx, warehouseId, nil))
+	require.NoError(t, client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(otherRoleId, sdk.GrantRole{Role: &roleId})))
+
+	grant := func(role sdk.AccountObjectIdentifier, privileges *sdk.AccountRoleGrantPrivileges, on *sdk.AccountRoleGrantOn) {
+		t.Helper()
+		require.NoError(t, client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, on, role, nil))
+	}
+	usage := &sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeUsage}}
+	grant(roleId, usage, &sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Database: &databaseId}})
+	grant(roleId, usage, &sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Warehouse: &warehouseId}})
+	grant(otherRoleId, usage, &sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Database: &databaseId}})
+	grant(roleId, &sdk.AccountRoleGrantPrivileges{SchemaPrivileges: []sdk.SchemaPrivilege{sdk.SchemaPrivilegeUsage}}, &sdk.AccountRoleGrantOn{Schema: &sdk.GrantOnSchema{Schema: &schemaId}})
+	selectPrivilege := &sdk.AccountRoleGrantPrivileges{SchemaObjectPrivileges: []sdk.SchemaObjectPrivilege{sdk.SchemaObjectPrivilegeSelect}}
+	grant(roleId, selectPrivilege, &sdk.AccountRoleGrantOn{SchemaObject: &sdk.GrantOnSchemaObject{Future: &sdk.GrantOnSchemaObjectIn{PluralObjectType: sdk.PluralObjectTypeTables, InSchema: &schemaId}}})
+	grant(roleId, selectPrivilege, &sdk.AccountRoleGrantOn{SchemaObject: &sdk.GrantOnSchemaObject{Future: &sdk.GrantOnSchemaObjectIn{PluralObjectType: sdk.PluralObjectTypeTables, InDatabase: &databaseId}}})
+
+	managedIds := []resources.GrantPrivilegesToAccountRoleId{
+		resources.NewGrantPrivilegesToAccountRoleIdOnAccountObject(roleId, false, false, false, sdk.ObjectTypeDatabase, databaseId, "USAGE"),
+		{
+			RoleName:   roleId,
+			Privileges: []string{"select"},
+			Kind:       resources.OnSchemaObjectAccountRoleGrantKind,
+			Data: &resources.OnSchemaObjectGrantData{
+				Kind:          resources.OnFutureSchemaObjectGrantKind,
+				OnAllOrFuture: &resources.BulkOperationGrantData{ObjectNamePlural: sdk.PluralObjectTypeTables, Kind: resources.InSchemaBulkOperationGrantKind, Schema: &schemaId},
+			},
+		},
+	}
+	grantIds := func(entries []grantDriftReportEntry) []string {
+		return collections.Map(entries, func(e grantDriftReportEntry) string { return e.GrantId })
+	}
+
+	t.Run("account role scope", func(t *testing.T) {
+		grants, err := collectGrantDriftReportGrants(ctx, client, grantDriftReportScope{Role: &roleId}, true)
+		require.NoError(t, err)
+
+		report := buildGrantDriftReport(grants, managedIds)
+
+		assert.ElementsMatch(t, []string{managedIds[0].String(), managedIds[1].String()}, grantIds(report.Managed))
+		assert.ElementsMatch(t, []string{
+			`"FR_ANALYST"|false|false|USAGE|OnAccountObject|WAREHOUSE|"WH"`,
+			`"FR_ANALYST"|false|false|USAGE|OnSchema|OnSchema|"DB"."S"`,
+			`"FR_ANALYST"|false|false|SELECT|OnSchemaObject|OnFuture|TABLES|InDatabase|"DB"`,
+		}, grantIds(report.Unmanaged))
+	})
+
+	t.Run("database scope", func(t *testing.T) {
+		grants, err := collectGrantDriftReportGrants(ctx, client, grantDriftReportScope{Database: &databaseId}, false)
+		require.NoError(t, err)
+
+		report := buildGrantDriftReport(grants, managedIds)
+
+		assert.Equal(t, []string{managedIds[0].String()}, grantIds(report.Managed))
+		assert.ElementsMatch(t, []string{
+			`"FR_ANALYST"|false|false|USAGE|OnSchema|OnSchema|"DB"."S"`,
+			`"FR_LOADER"|false|false|USAGE|OnAccountObject|DATABASE|"DB"`,
+		}, grantIds(report.Unmanaged))
+	})
+
+	t.Run("schema scope", func(t *testing.T) {
+		grants, err := collectGrantDriftReportGrants(ctx, client, grantDriftReportScope{Schema: &schemaId}, true)
+		require.NoError(t, err)
+
+		report := buildGrantDriftReport(grants, managedIds)
+
+		assert.Equal(t, []string{managedIds[1].String()}, grantIds(report.Managed))
+		assert.Equal(t, []string{`"FR_ANALYST"|false|false|USAGE|OnSchema|OnSchema|"DB"."S"`}, grantIds(report.Unmanaged))
+	})
+
+	t.Run("import identifiers of the unmanaged grants match them", func(t *testing.T) {
+		grants, err := collectGrantDriftReportGrants(ctx, client, grantDriftReportScope{Role: &roleId}, true)
+		require.NoError(t, err)
+
+		importIds := make([]resources.GrantPrivilegesToAccountRoleId, 0)
+		for _, entry := range buildGrantDriftReport(grants, managedIds).Unmanaged {
+			id, err := resources.ParseGrantPrivilegesToAccountRoleId(entry.GrantId)
+			require.NoError(t, err)
+			importIds = append(importIds, id)
+		}
+
+		assert.Empty(t, buildGrantDriftReport(grants, append(managedIds, importIds...)).Unmanaged)
+	})
+}
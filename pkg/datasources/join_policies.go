@@ -0,0 +1,33 @@
+package datasources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var joinPoliciesDefinition = bodyPoliciesDefinition[sdk.ShowJoinPolicyRequest, sdk.JoinPolicy, sdk.JoinPolicyDescription]{
+	datasource:        datasources.JoinPolicies,
+	previewFeature:    string(previewfeatures.JoinPoliciesDatasource),
+	objectType:        sdk.ObjectTypeJoinPolicy,
+	documentationLink: "https://docs.snowflake.com/en/sql-reference/sql/show-join-policies",
+
+	showOutputSchema:     schemas.ShowJoinPolicySchema,
+	describeOutputSchema: schemas.ShowJoinPolicyDescriptionSchema,
+	toSchema:             schemas.JoinPolicyToSchema,
+	descriptionToSchema:  schemas.JoinPolicyDescriptionToSchema,
+
+	client: func(client *sdk.Client) bodyPoliciesClient[sdk.ShowJoinPolicyRequest, sdk.JoinPolicy, sdk.JoinPolicyDescription] {
+		return client.JoinPolicies
+	},
+	newShowRequest: func(like *sdk.Like, in *sdk.ExtendedIn, limit *sdk.LimitFrom) *sdk.ShowJoinPolicyRequest {
+		return &sdk.ShowJoinPolicyRequest{Like: like, In: in, Limit: limit}
+	},
+	id: (*sdk.JoinPolicy).ID,
+}
+
+func JoinPolicies() *schema.Resource {
+	return bodyPoliciesDatasource(joinPoliciesDefinition)
+}
@@ -0,0 +1,33 @@
+package datasources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var privacyPoliciesDefinition = bodyPoliciesDefinition[sdk.ShowPrivacyPolicyRequest, sdk.PrivacyPolicy, sdk.PrivacyPolicyDescription]{
+	datasource:        datasources.PrivacyPolicies,
+	previewFeature:    string(previewfeatures.PrivacyPoliciesDatasource),
+	objectType:        sdk.ObjectTypePrivacyPolicy,
+	documentationLink: "https://docs.snowflake.com/en/sql-reference/sql/show-privacy-policies",
+
+	showOutputSchema:     schemas.ShowPrivacyPolicySchema,
+	describeOutputSchema: schemas.ShowPrivacyPolicyDescriptionSchema,
+	toSchema:             schemas.PrivacyPolicyToSchema,
+	descriptionToSchema:  schemas.PrivacyPolicyDescriptionToSchema,
+
+	client: func(client *sdk.Client) bodyPoliciesClient[sdk.ShowPrivacyPolicyRequest, sdk.PrivacyPolicy, sdk.PrivacyPolicyDescription] {
+		return client.PrivacyPolicies
+	},
+	newShowRequest: func(like *sdk.Like, in *sdk.ExtendedIn, limit *sdk.LimitFrom) *sdk.ShowPrivacyPolicyRequest {
+		return &sdk.ShowPrivacyPolicyRequest{Like: like, In: in, Limit: limit}
+	},
+	id: (*sdk.PrivacyPolicy).ID,
+}
+
+func PrivacyPolicies() *schema.Resource {
+	return bodyPoliciesDatasource(privacyPoliciesDefinition)
+}
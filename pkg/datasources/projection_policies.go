@@ -0,0 +1,33 @@
+package datasources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var projectionPoliciesDefinition = bodyPoliciesDefinition[sdk.ShowProjectionPolicyRequest, sdk.ProjectionPolicy, sdk.ProjectionPolicyDescription]{
+	datasource:        datasources.ProjectionPolicies,
+	previewFeature:    string(previewfeatures.ProjectionPoliciesDatasource),
+	objectType:        sdk.ObjectTypeProjectionPolicy,
+	documentationLink: "https://docs.snowflake.com/en/sql-reference/sql/show-projection-policies",
+
+	showOutputSchema:     schemas.ShowProjectionPolicySchema,
+	describeOutputSchema: schemas.ShowProjectionPolicyDescriptionSchema,
+	toSchema:             schemas.ProjectionPolicyToSchema,
+	descriptionToSchema:  schemas.ProjectionPolicyDescriptionToSchema,
+
+	client: func(client *sdk.Client) bodyPoliciesClient[sdk.ShowProjectionPolicyRequest, sdk.ProjectionPolicy, sdk.ProjectionPolicyDescription] {
+		return client.ProjectionPolicies
+	},
+	newShowRequest: func(like *sdk.Like, in *sdk.ExtendedIn, limit *sdk.LimitFrom) *sdk.ShowProjectionPolicyRequest {
+		return &sdk.ShowProjectionPolicyRequest{Like: like, In: in, Limit: limit}
+	},
+	id: (*sdk.ProjectionPolicy).ID,
+}
+
+func ProjectionPolicies() *schema.Resource {
+	return bodyPoliciesDatasource(projectionPoliciesDefinition)
+}
@@ -0,0 +1,145 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// roleGraphNode is a grantee in the role hierarchy: a user, an account role, a database role, or an application role.
+type roleGraphNode struct {
+	ObjectType sdk.ObjectType
+	Id         sdk.ObjectIdentifier
+}
+
+func (n roleGraphNode) key() string {
+	return fmt.Sprintf("%s|%s", n.ObjectType, n.Id.FullyQualifiedName())
+}
+
+// roleGraphVisitFunc is called once for every node reachable from the starting node.
+// The path contains the fully qualified names of the nodes from the starting node to the visited one (inclusive).
+type roleGraphVisitFunc func(node roleGraphNode, path []string, grants []sdk.Grant) error
+
+// walkRoleGraph expands the role hierarchy transitively (breadth-first) starting from the given node.
+// The grants of each node are listed with SHOW GRANTS TO; the USAGE grants on roles, database roles, and application roles are followed as the edges.
+// The roles granted to a user are resolved with rolesGrantedToUser instead, and no grants are passed to visit for users.
+// Every node is visited only once, so the cycles and the diamonds in the hierarchy are handled, and the shortest path is reported.
+// The PUBLIC role is implicitly granted to every user and role, but it is not listed by Snowflake; set withPublicRole to add it to the starting node.
+func walkRoleGraph(ctx context.Context, client *sdk.Client, start roleGraphNode, withPublicRole bool, visit roleGraphVisitFunc) error {
+	type queued struct {
+		node roleGraphNode
+		path []string
+	}
+	queue := []queued{{node: start, path: []string{start.Id.FullyQualifiedName()}}}
+	visited := map[string]bool{start.key(): true}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		grants := make([]sdk.Grant, 0)
+		children := make([]roleGraphNode, 0)
+		if current.node.ObjectType == sdk.ObjectTypeUser {
+			roles, err := rolesGrantedToUser(ctx, client, current.node)
+			if err != nil {
+				return err
+			}
+			children = append(children, roles...)
+		} else {
+			var err error
+			grants, err = client.Grants.Show(ctx, showGrantsToNodeOptions(current.node, false))
+			if err != nil {
+				return err
+			}
+			for _, grant := range grants {
+				if child, ok := roleGraphChild(grant); ok {
+					children = append(children, child)
+				}
+			}
+		}
+		if err := visit(current.node, current.path, grants); err != nil {
+			return err
+		}
+
+		if withPublicRole && current.node.key() == start.key() {
+			children = append(children, roleGraphNode{ObjectType: sdk.ObjectTypeRole, Id: sdk.NewAccountObjectIdentifier("PUBLIC")})
+		}
+		for _, child := range children {
+			if visited[child.key()] {
+				continue
+			}
+			visited[child.key()] = true
+			queue = append(queue, queued{node: child, path: append(slices.Clone(current.path), child.Id.FullyQualifiedName())})
+		}
+	}
+	return nil
+}
+
+// rolesGrantedToUser lists the account roles granted to the user.
+// SHOW GRANTS TO USER returns the granted role only in the role column, which is not mapped to sdk.Grant,
+// so the grants are reversed from SHOW GRANTS OF ROLE for every account role (the same way as in the role hierarchy).
+func rolesGrantedToUser(ctx context.Context, client *sdk.Client, user roleGraphNode) ([]roleGraphNode, error) {
+	if _, err := client.Users.ShowByID(ctx, sdk.NewAccountObjectIdentifier(user.Id.Name())); err != nil {
+		return nil, err
+	}
+	roles, err := client.Roles.Show(ctx, sdk.NewShowRoleRequest())
+	if err != nil {
+		return nil, err
+	}
+	grantedRoles := make([]roleGraphNode, 0)
+	for _, role := range roles {
+		grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{Of: &sdk.ShowGrantsOf{Role: role.ID()}})
+		if err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(grants, func(grant sdk.Grant) bool {
+			return grant.GrantedTo == sdk.ObjectTypeUser && grant.GranteeName.Name() == user.Id.Name()
+		}) {
+			grantedRoles = append(grantedRoles, roleGraphNode{ObjectType: sdk.ObjectTypeRole, Id: role.ID()})
+		}
+	}
+	return grantedRoles, nil
+}
+
+// showFutureGrantsToNode lists the future grants of the role or database role; users and application roles cannot have future grants.
+func showFutureGrantsToNode(ctx context.Context, client *sdk.Client, node roleGraphNode) ([]sdk.Grant, error) {
+	if node.ObjectType == sdk.ObjectTypeUser || node.ObjectType == sdk.ObjectTypeApplicationRole {
+		return []sdk.Grant{}, nil
+	}
+	return client.Grants.Show(ctx, showGrantsToNodeOptions(node, true))
+}
+
+func showGrantsToNodeOptions(node roleGraphNode, future bool) *sdk.ShowGrantOptions {
+	opts := &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{}}
+	switch node.ObjectType {
+	case sdk.ObjectTypeUser:
+		opts.To.User = sdk.NewAccountObjectIdentifier(node.Id.Name())
+	case sdk.ObjectTypeDatabaseRole:
+		opts.To.DatabaseRole = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(node.Id.FullyQualifiedName())
+	case sdk.ObjectTypeApplicationRole:
+		opts.To.ApplicationRole = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(node.Id.FullyQualifiedName())
+	default:
+		opts.To.Role = sdk.NewAccountObjectIdentifier(node.Id.Name())
+	}
+	if future {
+		opts.Future = sdk.Bool(true)
+	}
+	return opts
+}
+
+// roleGraphChild returns the role inherited through the given grant (USAGE on a role, a database role, or an application role).
+func roleGraphChild(grant sdk.Grant) (roleGraphNode, bool) {
+	if grant.Privilege != "USAGE" || grant.Name == nil {
+		return roleGraphNode{}, false
+	}
+	switch grant.GrantedOn {
+	case sdk.ObjectTypeRole:
+		return roleGraphNode{ObjectType: sdk.ObjectTypeRole, Id: sdk.NewAccountObjectIdentifier(grant.Name.Name())}, true
+	case sdk.ObjectTypeDatabaseRole, sdk.ObjectTypeApplicationRole:
+		return roleGraphNode{ObjectType: grant.GrantedOn, Id: sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(grant.Name.FullyQualifiedName())}, true
+	default:
+		return roleGraphNode{}, false
+	}
+}
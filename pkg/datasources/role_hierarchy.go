@@ -0,0 +1,536 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// roleHierarchySystemRoles are never reported as orphaned, because they are not supposed to be granted to the root role.
+var roleHierarchySystemRoles = []string{"ORGADMIN", "GLOBALORGADMIN", "ACCOUNTADMIN", "SECURITYADMIN", "USERADMIN", "SYSADMIN", "PUBLIC"}
+
+var roleHierarchySchema = map[string]*schema.Schema{
+	"root_role": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "SYSADMIN",
+		Description: "The account role from which all the custom roles should be reachable. The roles not reachable from it are reported as orphaned.",
+	},
+	"functional_roles": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Names of the account roles which are allowed to be granted to users. The other roles granted to users are reported in `direct_user_grants`. When neither `functional_roles` nor `functional_role_pattern` is set, the direct user grants are not reported.",
+	},
+	"functional_role_pattern": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+		Description:      "Regular expression matching the names of the account roles which are allowed to be granted to users (e.g. `^FR_`). Used together with `functional_roles`.",
+	},
+	"include_users": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Includes the users (with `SHOW GRANTS OF ROLE` for every account role).",
+	},
+	"include_database_roles": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Includes the database roles granted to the account roles and to other database roles.",
+	},
+	"include_application_roles": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Includes the application roles granted to the account roles. The grants of the application roles are not expanded.",
+	},
+	"fail_on_orphan_roles": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Fails the read when any role is not reachable from `root_role`.",
+	},
+	"fail_on_direct_user_grants": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Fails the read when any role other than the functional roles is granted to a user.",
+	},
+	"fail_on_cycles": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Fails the read when the role hierarchy contains a cycle.",
+	},
+	"nodes": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The nodes of the hierarchy: account roles, database roles, application roles, and users.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Type of the node: `ROLE`, `DATABASE ROLE`, `APPLICATION ROLE`, or `USER`.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Fully qualified name of the node.",
+				},
+				"is_reachable_from_root": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the role is `root_role` or is inherited by it, directly or through other roles. Always false for users.",
+				},
+				"is_orphan": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the role is not reachable from `root_role` (system roles are never orphaned).",
+				},
+				"is_granted_directly_to_users": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the role is granted to at least one user without being a functional role.",
+				},
+			},
+		},
+	},
+	"edges": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The edges of the hierarchy; every edge means that the `from` node inherits the `to` role.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Type of the grantee.",
+				},
+				"from": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Fully qualified name of the grantee.",
+				},
+				"to_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Type of the granted role.",
+				},
+				"to": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Fully qualified name of the granted role.",
+				},
+			},
+		},
+	},
+	"orphan_roles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Fully qualified names of the roles not reachable from `root_role`.",
+	},
+	"direct_user_grants": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Grants of non-functional roles to users in the `<user> -> <role>` format.",
+	},
+	"cycles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Cycles found in the hierarchy in the `<role> -> ... -> <role>` format.",
+	},
+	"dot": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The hierarchy in the [DOT](https://graphviz.org/doc/info/lang.html) format. The orphaned roles are marked red and the direct user grants are dashed.",
+	},
+	"json": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The hierarchy in the JSON format (an object with `nodes` and `edges` lists, using the same attribute names as the data source).",
+	},
+}
+
+func RoleHierarchy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.RoleHierarchyDatasource), TrackingReadWrapper(datasources.RoleHierarchy, ReadRoleHierarchy)),
+		Schema:      roleHierarchySchema,
+		Description: "Data source used to get the role hierarchy of the account as a graph. It is built from `SHOW ROLES`, `SHOW GRANTS TO` (for account and database roles), and `SHOW GRANTS OF ROLE` (for users). It reports the roles not reachable from the root role, the roles granted to users bypassing the functional roles, and the cycles; it can also fail on any of them, so that the RBAC model can be enforced in policy checks.",
+	}
+}
+
+type roleHierarchyNode struct {
+	Type                     string `json:"type"`
+	Name                     string `json:"name"`
+	IsReachableFromRoot      bool   `json:"is_reachable_from_root"`
+	IsOrphan                 bool   `json:"is_orphan"`
+	IsGrantedDirectlyToUsers bool   `json:"is_granted_directly_to_users"`
+
+	key string
+}
+
+type roleHierarchyEdge struct {
+	FromType string `json:"from_type"`
+	From     string `json:"from"`
+	ToType   string `json:"to_type"`
+	To       string `json:"to"`
+
+	fromKey string
+	toKey   string
+}
+
+type roleHierarchy struct {
+	Nodes []*roleHierarchyNode `json:"nodes"`
+	Edges []roleHierarchyEdge  `json:"edges"`
+
+	nodesByKey map[string]*roleHierarchyNode
+	// edgesByKey indexes the edges by the from and to node keys
+	edgesByKey map[string]map[string]struct{}
+}
+
+type roleHierarchyOptions struct {
+	IncludeUsers            bool
+	IncludeDatabaseRoles    bool
+	IncludeApplicationRoles bool
+}
+
+func ReadRoleHierarchy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	rootRoleId, err := sdk.ParseAccountObjectIdentifier(d.Get("root_role").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	isFunctional, err := roleHierarchyFunctionalRoleMatcher(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hierarchy, err := buildRoleHierarchy(ctx, client, roleHierarchyOptions{
+		IncludeUsers:            d.Get("include_users").(bool),
+		IncludeDatabaseRoles:    d.Get("include_database_roles").(bool),
+		IncludeApplicationRoles: d.Get("include_application_roles").(bool),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	orphans, err := hierarchy.markOrphans(roleGraphNode{ObjectType: sdk.ObjectTypeRole, Id: rootRoleId})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	directUserGrants := hierarchy.markDirectUserGrants(isFunctional)
+	cycles := hierarchy.cycles()
+
+	dot := hierarchy.dot()
+	jsonBytes, err := json.Marshal(hierarchy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nodes := make([]map[string]any, len(hierarchy.Nodes))
+	for i, n := range hierarchy.Nodes {
+		nodes[i] = map[string]any{
+			"type":                         n.Type,
+			"name":                         n.Name,
+			"is_reachable_from_root":       n.IsReachableFromRoot,
+			"is_orphan":                    n.IsOrphan,
+			"is_granted_directly_to_users": n.IsGrantedDirectlyToUsers,
+		}
+	}
+	edges := make([]map[string]any, len(hierarchy.Edges))
+	for i, e := range hierarchy.Edges {
+		edges[i] = map[string]any{
+			"from_type": e.FromType,
+			"from":      e.From,
+			"to_type":   e.ToType,
+			"to":        e.To,
+		}
+	}
+
+	d.SetId("role_hierarchy_read")
+	errs := errors.Join(
+		d.Set("nodes", nodes),
+		d.Set("edges", edges),
+		d.Set("orphan_roles", orphans),
+		d.Set("direct_user_grants", directUserGrants),
+		d.Set("cycles", cycles),
+		d.Set("dot", dot),
+		d.Set("json", string(jsonBytes)),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	var violations []error
+	if d.Get("fail_on_orphan_roles").(bool) && len(orphans) > 0 {
+		violations = append(violations, fmt.Errorf("roles not reachable from %s: %s", rootRoleId.FullyQualifiedName(), strings.Join(orphans, ", ")))
+	}
+	if d.Get("fail_on_direct_user_grants").(bool) && len(directUserGrants) > 0 {
+		violations = append(violations, fmt.Errorf("non-functional roles granted directly to users: %s", strings.Join(directUserGrants, ", ")))
+	}
+	if d.Get("fail_on_cycles").(bool) && len(cycles) > 0 {
+		violations = append(violations, fmt.Errorf("cycles in the role hierarchy: %s", strings.Join(cycles, ", ")))
+	}
+	if len(violations) > 0 {
+		return diag.FromErr(errors.Join(violations...))
+	}
+	return nil
+}
+
+func roleHierarchyFunctionalRoleMatcher(d *schema.ResourceData) (func(name string) bool, error) {
+	functionalRoles := make([]string, 0)
+	for _, name := range d.Get("functional_roles").(*schema.Set).List() {
+		id, err := sdk.ParseAccountObjectIdentifier(name.(string))
+		if err != nil {
+			return nil, err
+		}
+		functionalRoles = append(functionalRoles, id.Name())
+	}
+	var pattern *regexp.Regexp
+	if v := d.Get("functional_role_pattern").(string); v != "" {
+		compiled, err := regexp.Compile(v)
+		if err != nil {
+			return nil, err
+		}
+		pattern = compiled
+	}
+	if len(functionalRoles) == 0 && pattern == nil {
+		return nil, nil
+	}
+	return func(name string) bool {
+		return slices.Contains(functionalRoles, name) || (pattern != nil && pattern.MatchString(name))
+	}, nil
+}
+
+// buildRoleHierarchy lists all the account roles and expands their grants; the database roles are expanded transitively.
+func buildRoleHierarchy(ctx context.Context, client *sdk.Client, opts roleHierarchyOptions) (*roleHierarchy, error) {
+	hierarchy := &roleHierarchy{
+		Nodes:      make([]*roleHierarchyNode, 0),
+		Edges:      make([]roleHierarchyEdge, 0),
+		nodesByKey: make(map[string]*roleHierarchyNode),
+		edgesByKey: make(map[string]map[string]struct{}),
+	}
+
+	roles, err := client.Roles.Show(ctx, sdk.NewShowRoleRequest())
+	if err != nil {
+		return nil, err
+	}
+	accountRoles := make([]roleGraphNode, len(roles))
+	for i, role := range roles {
+		accountRoles[i] = roleGraphNode{ObjectType: sdk.ObjectTypeRole, Id: role.ID()}
+		hierarchy.addNode(accountRoles[i])
+	}
+
+	queue := slices.Clone(accountRoles)
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		grants, err := client.Grants.Show(ctx, showGrantsToNodeOptions(current, false))
+		if err != nil {
+			return nil, err
+		}
+		for _, grant := range grants {
+			child, ok := roleGraphChild(grant)
+			if !ok ||
+				(child.ObjectType == sdk.ObjectTypeDatabaseRole && !opts.IncludeDatabaseRoles) ||
+				(child.ObjectType == sdk.ObjectTypeApplicationRole && !opts.IncludeApplicationRoles) {
+				continue
+			}
+			// the account roles are all listed upfront; the database roles are expanded when seen for the first time
+			if hierarchy.addNode(child) && child.ObjectType == sdk.ObjectTypeDatabaseRole {
+				queue = append(queue, child)
+			}
+			hierarchy.addEdge(current, child)
+		}
+	}
+
+	if opts.IncludeUsers {
+		for _, role := range accountRoles {
+			grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{Of: &sdk.ShowGrantsOf{Role: sdk.NewAccountObjectIdentifier(role.Id.Name())}})
+			if err != nil {
+				return nil, err
+			}
+			for _, grant := range grants {
+				if grant.GrantedTo != sdk.ObjectTypeUser {
+					continue
+				}
+				user := roleGraphNode{ObjectType: sdk.ObjectTypeUser, Id: sdk.NewAccountObjectIdentifier(grant.GranteeName.Name())}
+				hierarchy.addNode(user)
+				hierarchy.addEdge(user, role)
+			}
+		}
+	}
+	return hierarchy, nil
+}
+
+// addNode returns true if the node was not present before.
+func (h *roleHierarchy) addNode(node roleGraphNode) bool {
+	if _, ok := h.nodesByKey[node.key()]; ok {
+		return false
+	}
+	n := &roleHierarchyNode{Type: node.ObjectType.String(), Name: node.Id.FullyQualifiedName(), key: node.key()}
+	h.Nodes = append(h.Nodes, n)
+	h.nodesByKey[n.key] = n
+	return true
+}
+
+func (h *roleHierarchy) addEdge(from roleGraphNode, to roleGraphNode) {
+	if _, ok := h.edgesByKey[from.key()][to.key()]; ok {
+		return
+	}
+	if h.edgesByKey[from.key()] == nil {
+		h.edgesByKey[from.key()] = make(map[string]struct{})
+	}
+	h.edgesByKey[from.key()][to.key()] = struct{}{}
+	h.Edges = append(h.Edges, roleHierarchyEdge{
+		FromType: from.ObjectType.String(),
+		From:     from.Id.FullyQualifiedName(),
+		ToType:   to.ObjectType.String(),
+		To:       to.Id.FullyQualifiedName(),
+		fromKey:  from.key(),
+		toKey:    to.key(),
+	})
+}
+
+// children returns the sorted keys of the direct children, so the traversals are deterministic.
+func (h *roleHierarchy) children(key string) []string {
+	return slices.Sorted(maps.Keys(h.edgesByKey[key]))
+}
+
+// markOrphans marks the roles reachable from the root and returns the names of the orphaned ones.
+func (h *roleHierarchy) markOrphans(root roleGraphNode) ([]string, error) {
+	if _, ok := h.nodesByKey[root.key()]; !ok {
+		return nil, fmt.Errorf("root role %s does not exist or is not authorized", root.Id.FullyQualifiedName())
+	}
+	queue := []string{root.key()}
+	h.nodesByKey[root.key()].IsReachableFromRoot = true
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, child := range h.children(current) {
+			if node := h.nodesByKey[child]; !node.IsReachableFromRoot {
+				node.IsReachableFromRoot = true
+				queue = append(queue, child)
+			}
+		}
+	}
+
+	orphans := make([]string, 0)
+	for _, n := range h.Nodes {
+		if n.Type == sdk.ObjectTypeUser.String() || n.IsReachableFromRoot {
+			continue
+		}
+		if n.Type == sdk.ObjectTypeRole.String() && slices.Contains(roleHierarchySystemRoles, sdk.NewAccountObjectIdentifierFromFullyQualifiedName(n.Name).Name()) {
+			continue
+		}
+		n.IsOrphan = true
+		orphans = append(orphans, n.Name)
+	}
+	return orphans, nil
+}
+
+// markDirectUserGrants marks the non-functional account roles granted to users; it does nothing if the functional roles are not configured.
+func (h *roleHierarchy) markDirectUserGrants(isFunctional func(name string) bool) []string {
+	directUserGrants := make([]string, 0)
+	if isFunctional == nil {
+		return directUserGrants
+	}
+	for _, e := range h.Edges {
+		if e.FromType != sdk.ObjectTypeUser.String() || isFunctional(sdk.NewAccountObjectIdentifierFromFullyQualifiedName(e.To).Name()) {
+			continue
+		}
+		h.nodesByKey[e.toKey].IsGrantedDirectlyToUsers = true
+		directUserGrants = append(directUserGrants, fmt.Sprintf("%s -> %s", e.From, e.To))
+	}
+	return directUserGrants
+}
+
+// cycles returns the cycles found with the depth-first search; Snowflake rejects the grants creating cycles, so they are not expected.
+func (h *roleHierarchy) cycles() []string {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := make(map[string]int)
+	stack := make([]string, 0)
+	cycles := make([]string, 0)
+
+	var visit func(key string)
+	visit = func(key string) {
+		state[key] = inProgress
+		stack = append(stack, key)
+		for _, child := range h.children(key) {
+			switch state[child] {
+			case unvisited:
+				visit(child)
+			case inProgress:
+				start := slices.Index(stack, child)
+				names := make([]string, 0)
+				for _, k := range stack[start:] {
+					names = append(names, h.nodesByKey[k].Name)
+				}
+				cycles = append(cycles, strings.Join(append(names, h.nodesByKey[child].Name), " -> "))
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[key] = done
+	}
+	for _, n := range h.Nodes {
+		if state[n.key] == unvisited {
+			visit(n.key)
+		}
+	}
+	return cycles
+}
+
+func (h *roleHierarchy) dot() string {
+	ids := make(map[string]string)
+	var b strings.Builder
+	b.WriteString("digraph role_hierarchy {\n")
+	for i, n := range h.Nodes {
+		ids[n.key] = fmt.Sprintf("n%d", i)
+		attributes := []string{fmt.Sprintf("label=%q", n.Name)}
+		switch n.Type {
+		case sdk.ObjectTypeUser.String():
+			attributes = append(attributes, "shape=ellipse")
+		case sdk.ObjectTypeRole.String():
+			attributes = append(attributes, "shape=box")
+		default:
+			attributes = append(attributes, "shape=box", "style=rounded")
+		}
+		if n.IsOrphan {
+			attributes = append(attributes, "color=red")
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", ids[n.key], strings.Join(attributes, ", "))
+	}
+	for _, e := range h.Edges {
+		style := ""
+		if e.FromType == sdk.ObjectTypeUser.String() && h.nodesByKey[e.toKey].IsGrantedDirectlyToUsers {
+			style = " [style=dashed]"
+		}
+		fmt.Fprintf(&b, "  %s -> %s%s;\n", ids[e.fromKey], ids[e.toKey], style)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
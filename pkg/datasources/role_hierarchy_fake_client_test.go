@@ -0,0 +1,101 @@
+//go:build fake_client_tests
+
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BuildRoleHierarchy(t *testing.T) {
+	ctx := context.Background()
+	client := sdk.NewFakeClient(sdk.NewFakeCatalog())
+
+	userId := sdk.NewAccountObjectIdentifier("USER")
+	sysadmin := sdk.NewAccountObjectIdentifier("SYSADMIN")
+	functionalRole, accessRole, orphanRole := sdk.NewAccountObjectIdentifier("FR_ANALYST"), sdk.NewAccountObjectIdentifier("AR_READ"), sdk.NewAccountObjectIdentifier("ORPHAN")
+
+	require.NoError(t, client.Users.Create(ctx, userId, nil))
+	for _, id := range []sdk.AccountObjectIdentifier{functionalRole, accessRole, orphanRole} {
+		require.NoError(t, client.Roles.Create(ctx, sdk.NewCreateRoleRequest(id)))
+	}
+	// SYSADMIN -> FR_ANALYST -> AR_READ; USER -> FR_ANALYST, USER -> ORPHAN
+	require.NoError(t, client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(functionalRole, sdk.GrantRole{Role: &sysadmin})))
+	require.NoError(t, client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(accessRole, sdk.GrantRole{Role: &functionalRole})))
+	require.NoError(t, client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(functionalRole, sdk.GrantRole{User: &userId})))
+	require.NoError(t, client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(orphanRole, sdk.GrantRole{User: &userId})))
+
+	node := func(objectType sdk.ObjectType, id sdk.AccountObjectIdentifier) string {
+		return roleGraphNode{ObjectType: objectType, Id: id}.key()
+	}
+	isFunctional := func(name string) bool { return name == functionalRole.Name() }
+
+	t.Run("with users", func(t *testing.T) {
+		hierarchy, err := buildRoleHierarchy(ctx, client, roleHierarchyOptions{IncludeUsers: true, IncludeDatabaseRoles: true, IncludeApplicationRoles: true})
+		require.NoError(t, err)
+
+		require.Contains(t, hierarchy.nodesByKey, node(sdk.ObjectTypeUser, userId))
+		assert.ElementsMatch(t, []string{node(sdk.ObjectTypeRole, functionalRole)}, hierarchy.children(node(sdk.ObjectTypeRole, sysadmin)))
+		assert.ElementsMatch(t, []string{node(sdk.ObjectTypeRole, accessRole)}, hierarchy.children(node(sdk.ObjectTypeRole, functionalRole)))
+		assert.ElementsMatch(t, []string{node(sdk.ObjectTypeRole, functionalRole), node(sdk.ObjectTypeRole, orphanRole)}, hierarchy.children(node(sdk.ObjectTypeUser, userId)))
+
+		orphans, err := hierarchy.markOrphans(roleGraphNode{ObjectType: sdk.ObjectTypeRole, Id: sysadmin})
+		require.NoError(t, err)
+		assert.Equal(t, []string{orphanRole.FullyQualifiedName()}, orphans)
+		assert.True(t, hierarchy.nodesByKey[node(sdk.ObjectTypeRole, accessRole)].IsReachableFromRoot)
+		assert.True(t, hierarchy.nodesByKey[node(sdk.ObjectTypeRole, orphanRole)].IsOrphan)
+		assert.False(t, hierarchy.nodesByKey[node(sdk.ObjectTypeUser, userId)].IsOrphan)
+
+		directUserGrants := hierarchy.markDirectUserGrants(isFunctional)
+		assert.Equal(t, []string{`"USER" -> "ORPHAN"`}, directUserGrants)
+		assert.True(t, hierarchy.nodesByKey[node(sdk.ObjectTypeRole, orphanRole)].IsGrantedDirectlyToUsers)
+		assert.False(t, hierarchy.nodesByKey[node(sdk.ObjectTypeRole, functionalRole)].IsGrantedDirectlyToUsers)
+
+		assert.Empty(t, hierarchy.cycles())
+
+		dot := hierarchy.dot()
+		assert.Contains(t, dot, "digraph role_hierarchy {")
+		assert.Contains(t, dot, `label="\"ORPHAN\"", shape=box, color=red`)
+		assert.Contains(t, dot, `label="\"USER\"", shape=ellipse`)
+		assert.Contains(t, dot, "[style=dashed]")
+
+		jsonBytes, err := json.Marshal(hierarchy)
+		require.NoError(t, err)
+		var decoded struct {
+			Nodes []map[string]any `json:"nodes"`
+			Edges []map[string]any `json:"edges"`
+		}
+		require.NoError(t, json.Unmarshal(jsonBytes, &decoded))
+		assert.Len(t, decoded.Nodes, len(hierarchy.Nodes))
+		assert.Len(t, decoded.Edges, len(hierarchy.Edges))
+		assert.Contains(t, decoded.Edges, map[string]any{"from_type": "USER", "from": `"USER"`, "to_type": "ROLE", "to": `"ORPHAN"`})
+	})
+
+	t.Run("without users", func(t *testing.T) {
+		hierarchy, err := buildRoleHierarchy(ctx, client, roleHierarchyOptions{})
+		require.NoError(t, err)
+
+		assert.NotContains(t, hierarchy.nodesByKey, node(sdk.ObjectTypeUser, userId))
+		assert.Empty(t, hierarchy.markDirectUserGrants(isFunctional))
+	})
+
+	t.Run("direct user grants without functional roles", func(t *testing.T) {
+		hierarchy, err := buildRoleHierarchy(ctx, client, roleHierarchyOptions{IncludeUsers: true})
+		require.NoError(t, err)
+
+		assert.Empty(t, hierarchy.markDirectUserGrants(nil))
+	})
+
+	t.Run("non-existing root role", func(t *testing.T) {
+		hierarchy, err := buildRoleHierarchy(ctx, client, roleHierarchyOptions{})
+		require.NoError(t, err)
+
+		_, err = hierarchy.markOrphans(roleGraphNode{ObjectType: sdk.ObjectTypeRole, Id: sdk.NewAccountObjectIdentifier("MISSING")})
+		require.ErrorContains(t, err, `root role "MISSING" does not exist or is not authorized`)
+	})
+}
@@ -0,0 +1,23 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RoleHierarchy_Cycles(t *testing.T) {
+	hierarchy := &roleHierarchy{nodesByKey: make(map[string]*roleHierarchyNode), edgesByKey: make(map[string]map[string]struct{})}
+	a, b, c := roleGraphNode{ObjectType: sdk.ObjectTypeRole, Id: sdk.NewAccountObjectIdentifier("A")}, roleGraphNode{ObjectType: sdk.ObjectTypeRole, Id: sdk.NewAccountObjectIdentifier("B")}, roleGraphNode{ObjectType: sdk.ObjectTypeRole, Id: sdk.NewAccountObjectIdentifier("C")}
+	for _, n := range []roleGraphNode{a, b, c} {
+		hierarchy.addNode(n)
+	}
+	hierarchy.addEdge(a, b)
+	hierarchy.addEdge(b, c)
+	hierarchy.addEdge(c, a)
+	hierarchy.addEdge(c, a)
+
+	assert.Len(t, hierarchy.Edges, 3)
+	assert.Equal(t, []string{`"A" -> "B" -> "C" -> "A"`}, hierarchy.cycles())
+}
@@ -32,6 +32,7 @@ type Generator[T ObjectNameProvider, M HasPreambleModel] struct {
 	additionalObjectDebugLogProviders []func([]T)
 	objectFilters                     []func(T) bool
 	generationPartFilters             []func(GenerationPart[T, M]) bool
+	afterSaveHooks                    []func([]T) error
 
 	description         string
 	makefileCommandPart string
@@ -78,6 +79,7 @@ func NewGenerator[T ObjectNameProvider, M HasPreambleModel](preamble *PreambleMo
 		additionalObjectDebugLogProviders: make([]func([]T), 0),
 		objectFilters:                     make([]func(T) bool, 0),
 		generationPartFilters:             make([]func(GenerationPart[T, M]) bool, 0),
+		afterSaveHooks:                    make([]func([]T) error, 0),
 
 		description:         defaultDescription,
 		makefileCommandPart: defaultMakefileCommandPart,
@@ -115,6 +117,12 @@ func (g *Generator[T, M]) WithAdditionalGenerationPartFilter(generationPartFilte
 	return g
 }
 
+// WithAfterSaveHook registers a hook run with the generated objects after the output is saved (it is not run in the dry-run mode).
+func (g *Generator[T, M]) WithAfterSaveHook(hook func([]T) error) *Generator[T, M] {
+	g.afterSaveHooks = append(g.afterSaveHooks, hook)
+	return g
+}
+
 // TODO [SNOW-2324252]: Probably remove later; it should be a part of the constructor
 func (g *Generator[T, M]) WithDescription(description string) *Generator[T, M] {
 	g.description = description
@@ -226,6 +234,11 @@ usage: make [clean-%[2]s] generate-%[2]s SF_TF_GENERATOR_ARGS='<args>'
 		if err := g.generateAndSave(objects, parts); err != nil {
 			return err
 		}
+		for _, hook := range g.afterSaveHooks {
+			if err := hook(objects); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -0,0 +1,160 @@
+package sqlvalidation
+
+import (
+	"strings"
+)
+
+// Reference is an object referenced by the SQL body, e.g., a table in the FROM clause or a called procedure.
+type Reference struct {
+	// Name is the object name as written in the body, e.g., "DB"."SCHEMA"."TABLE" or just TABLE.
+	Name     string
+	Position Position
+}
+
+// IsFullyQualified returns true if the reference contains the database and schema names.
+func (r Reference) IsFullyQualified() bool {
+	return len(splitReferenceName(r.Name)) == 3
+}
+
+// referenceKeywords are the keywords followed by the referenced object names.
+var referenceKeywords = []string{"CALL", "FROM", "INTO", "JOIN", "UPDATE", "USING"}
+
+// extractReferences returns the objects referenced in the FROM, JOIN, INTO, UPDATE, USING, and CALL clauses.
+// Subqueries, table functions, variables, stages, common table expressions, and the FROM keywords that do not start a clause
+// (e.g., in EXTRACT(YEAR FROM column) or a IS DISTINCT FROM b) are skipped.
+func extractReferences(tokens []token) []Reference {
+	commonTableExpressions := extractCommonTableExpressions(tokens)
+	references := make([]Reference, 0)
+	seen := make(map[string]bool)
+	addReference := func(reference Reference) {
+		key := normalizeReferenceName(reference.Name)
+		if seen[key] || commonTableExpressions[key] {
+			return
+		}
+		seen[key] = true
+		references = append(references, reference)
+	}
+
+	// functionCalls tracks whether the currently opened parentheses belong to a function call,
+	// so the FROM keyword in e.g. EXTRACT(YEAR FROM column) or TRIM(BOTH FROM column) is skipped.
+	functionCalls := make([]bool, 0)
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		switch {
+		case t.isPunctuation("("):
+			functionCalls = append(functionCalls, i > 0 && tokens[i-1].isIdentifier())
+		case t.isPunctuation(")"):
+			if len(functionCalls) > 0 {
+				functionCalls = functionCalls[:len(functionCalls)-1]
+			}
+		case t.isKeyword(referenceKeywords...):
+			if len(functionCalls) > 0 && functionCalls[len(functionCalls)-1] {
+				continue
+			}
+			// The FROM keyword is a part of the IS [ NOT ] DISTINCT FROM comparison
+			if i >= 2 && tokens[i-1].isKeyword("DISTINCT") && tokens[i-2].isKeyword("IS", "NOT") {
+				continue
+			}
+			isFrom := t.isKeyword("FROM")
+			// Table functions can be used in these clauses, e.g., FROM TABLE_FUNCTION(...); in other ones, the parentheses contain e.g. procedure arguments or a column list
+			allowsTableFunctions := t.isKeyword("FROM", "JOIN", "USING")
+			for j := i + 1; j < len(tokens); {
+				reference, next, ok := parseReference(tokens, j)
+				if !ok {
+					break
+				}
+				if next >= len(tokens) || !tokens[next].isPunctuation("(") || !allowsTableFunctions {
+					addReference(reference)
+				}
+				if !isFrom {
+					break
+				}
+				// FROM a [AS] alias, b [AS] alias, ...
+				next = skipAlias(tokens, next)
+				if next+1 >= len(tokens) || !tokens[next].isPunctuation(",") {
+					break
+				}
+				j = next + 1
+			}
+		}
+	}
+	return references
+}
+
+// parseReference parses an object name (up to three dot-separated identifiers) starting at the given token.
+// It returns the reference and the index of the first token after it.
+func parseReference(tokens []token, start int) (Reference, int, bool) {
+	if start >= len(tokens) || !tokens[start].isIdentifier() {
+		return Reference{}, start, false
+	}
+	parts := []string{tokens[start].text}
+	i := start + 1
+	for len(parts) < 3 && i+1 < len(tokens) && tokens[i].isPunctuation(".") && tokens[i+1].isIdentifier() {
+		parts = append(parts, tokens[i+1].text)
+		i += 2
+	}
+	return Reference{Name: strings.Join(parts, "."), Position: tokens[start].position}, i, true
+}
+
+func skipAlias(tokens []token, i int) int {
+	if i < len(tokens) && tokens[i].isKeyword("AS") {
+		i++
+	}
+	if i < len(tokens) && tokens[i].isIdentifier() {
+		i++
+	}
+	return i
+}
+
+// extractCommonTableExpressions returns the names defined in the WITH clauses, i.e., the identifiers followed by AS ( or by the column list and AS (.
+func extractCommonTableExpressions(tokens []token) map[string]bool {
+	names := make(map[string]bool)
+	for i := 0; i+2 < len(tokens); i++ {
+		if !tokens[i].isIdentifier() {
+			continue
+		}
+		next := i + 1
+		if tokens[next].isPunctuation("(") {
+			for next < len(tokens) && !tokens[next].isPunctuation(")") {
+				next++
+			}
+			next++
+		}
+		if next+1 < len(tokens) && tokens[next].isKeyword("AS") && tokens[next+1].isPunctuation("(") {
+			names[normalizeReferenceName(tokens[i].text)] = true
+		}
+	}
+	return names
+}
+
+// normalizeReferenceName uppercases the unquoted parts of the name, as Snowflake does when resolving identifiers.
+func normalizeReferenceName(name string) string {
+	parts := splitReferenceName(name)
+	for i, part := range parts {
+		if !strings.HasPrefix(part, `"`) {
+			parts[i] = strings.ToUpper(part)
+		} else {
+			parts[i] = strings.ReplaceAll(strings.Trim(part, `"`), `""`, `"`)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+func splitReferenceName(name string) []string {
+	parts := make([]string, 0)
+	current := strings.Builder{}
+	quoted := false
+	for _, r := range name {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			current.WriteRune(r)
+		case r == '.' && !quoted:
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	return append(parts, current.String())
+}
@@ -0,0 +1,241 @@
+package sqlvalidation
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenKindWord tokenKind = iota
+	tokenKindQuotedIdentifier
+	tokenKindString
+	tokenKindNumber
+	tokenKindVariable
+	tokenKindStageReference
+	tokenKindPunctuation
+	tokenKindOperator
+)
+
+// Position is a 1-based line and column in the validated SQL text.
+type Position struct {
+	Line   int
+	Column int
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("line %d, column %d", p.Line, p.Column)
+}
+
+type token struct {
+	kind     tokenKind
+	text     string
+	position Position
+}
+
+// isKeyword returns true for unquoted words equal (case-insensitively) to any of the given keywords.
+func (t token) isKeyword(keywords ...string) bool {
+	if t.kind != tokenKindWord {
+		return false
+	}
+	for _, keyword := range keywords {
+		if strings.EqualFold(t.text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t token) isPunctuation(punctuation string) bool {
+	return t.kind == tokenKindPunctuation && t.text == punctuation
+}
+
+func (t token) isIdentifier() bool {
+	return t.kind == tokenKindQuotedIdentifier || (t.kind == tokenKindWord && !isReservedKeyword(t.text))
+}
+
+// describe returns the token in the form used in the error messages.
+func (t token) describe() string {
+	switch t.kind {
+	case tokenKindWord:
+		if isReservedKeyword(t.text) {
+			return strings.ToUpper(t.text)
+		}
+		return fmt.Sprintf("identifier %s", t.text)
+	case tokenKindString:
+		return "string literal"
+	default:
+		return fmt.Sprintf("'%s'", t.text)
+	}
+}
+
+// tokenizer splits the SQL text into tokens skipping the whitespaces and comments.
+// It supports the Snowflake lexical structure: single-quoted and dollar-quoted strings, double-quoted identifiers,
+// session and bind variables, stage references, and the --, //, and /* */ comments.
+type tokenizer struct {
+	input    []rune
+	offset   int
+	position Position
+}
+
+func tokenize(sql string) ([]token, error) {
+	t := &tokenizer{input: []rune(sql), position: Position{Line: 1, Column: 1}}
+	tokens := make([]token, 0)
+	for {
+		if err := t.skipWhitespacesAndComments(); err != nil {
+			return nil, err
+		}
+		if t.offset >= len(t.input) {
+			return tokens, nil
+		}
+		next, err := t.next()
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, next)
+	}
+}
+
+func (t *tokenizer) peek(n int) rune {
+	if t.offset+n >= len(t.input) {
+		return 0
+	}
+	return t.input[t.offset+n]
+}
+
+func (t *tokenizer) advance() rune {
+	r := t.input[t.offset]
+	t.offset++
+	if r == '\n' {
+		t.position.Line++
+		t.position.Column = 1
+	} else {
+		t.position.Column++
+	}
+	return r
+}
+
+func (t *tokenizer) skipWhitespacesAndComments() error {
+	for t.offset < len(t.input) {
+		switch r := t.peek(0); {
+		case unicode.IsSpace(r):
+			t.advance()
+		case r == '-' && t.peek(1) == '-', r == '/' && t.peek(1) == '/':
+			for t.offset < len(t.input) && t.peek(0) != '\n' {
+				t.advance()
+			}
+		case r == '/' && t.peek(1) == '*':
+			start := t.position
+			t.advance()
+			t.advance()
+			for !(t.peek(0) == '*' && t.peek(1) == '/') {
+				if t.offset >= len(t.input) {
+					return newSyntaxError(start, "unterminated comment")
+				}
+				t.advance()
+			}
+			t.advance()
+			t.advance()
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+func (t *tokenizer) next() (token, error) {
+	start := t.position
+	startOffset := t.offset
+	newToken := func(kind tokenKind) token {
+		return token{kind: kind, text: string(t.input[startOffset:t.offset]), position: start}
+	}
+
+	switch r := t.peek(0); {
+	case r == '\'':
+		if err := t.skipQuoted('\'', true); err != nil {
+			return token{}, newSyntaxError(start, "unterminated string literal")
+		}
+		return newToken(tokenKindString), nil
+	case r == '"':
+		if err := t.skipQuoted('"', false); err != nil {
+			return token{}, newSyntaxError(start, "unterminated quoted identifier")
+		}
+		return newToken(tokenKindQuotedIdentifier), nil
+	case r == '$' && t.peek(1) == '$':
+		t.advance()
+		t.advance()
+		for !(t.peek(0) == '$' && t.peek(1) == '$') {
+			if t.offset >= len(t.input) {
+				return token{}, newSyntaxError(start, "unterminated $$ string literal")
+			}
+			t.advance()
+		}
+		t.advance()
+		t.advance()
+		return newToken(tokenKindString), nil
+	case r == '?':
+		t.advance()
+		return newToken(tokenKindVariable), nil
+	case r == '$' || (r == ':' && isWordStart(t.peek(1))):
+		t.advance()
+		for isWordPart(t.peek(0)) {
+			t.advance()
+		}
+		return newToken(tokenKindVariable), nil
+	case r == '@':
+		t.advance()
+		for isWordPart(t.peek(0)) || strings.ContainsRune(`."~%/`, t.peek(0)) {
+			t.advance()
+		}
+		return newToken(tokenKindStageReference), nil
+	case isWordStart(r):
+		for isWordPart(t.peek(0)) {
+			t.advance()
+		}
+		return newToken(tokenKindWord), nil
+	case unicode.IsDigit(r) || (r == '.' && unicode.IsDigit(t.peek(1))):
+		for unicode.IsDigit(t.peek(0)) || unicode.IsLetter(t.peek(0)) || t.peek(0) == '.' ||
+			((t.peek(0) == '+' || t.peek(0) == '-') && (t.peek(-1) == 'e' || t.peek(-1) == 'E')) {
+			t.advance()
+		}
+		return newToken(tokenKindNumber), nil
+	case strings.ContainsRune("(),;.[]{}", r):
+		t.advance()
+		return newToken(tokenKindPunctuation), nil
+	case strings.ContainsRune("+-*/%=<>!|:^&~", r):
+		t.advance()
+		for t.peek(0) != 0 && strings.ContainsRune("=<>|:", t.peek(0)) {
+			t.advance()
+		}
+		return newToken(tokenKindOperator), nil
+	default:
+		return token{}, newSyntaxError(start, fmt.Sprintf("unexpected character '%c'", r))
+	}
+}
+
+// skipQuoted skips the quoted text, where the quote character is escaped by doubling it (or by a backslash for strings).
+func (t *tokenizer) skipQuoted(quote rune, backslashEscapes bool) error {
+	t.advance()
+	for t.offset < len(t.input) {
+		r := t.advance()
+		switch {
+		case backslashEscapes && r == '\\' && t.offset < len(t.input):
+			t.advance()
+		case r == quote && t.peek(0) == quote:
+			t.advance()
+		case r == quote:
+			return nil
+		}
+	}
+	return fmt.Errorf("unterminated")
+}
+
+func isWordStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_'
+}
+
+func isWordPart(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$'
+}
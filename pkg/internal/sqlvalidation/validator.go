@@ -0,0 +1,289 @@
+// Package sqlvalidation provides an offline lexical and structural check of SQL bodies (e.g., view statements or procedure definitions).
+// It is not a validation against the Snowflake SQL grammar; based on the tokens, it checks the lexical structure, balanced brackets and blocks,
+// the statement kind expected in the given context, and the most common clause errors, reporting them with line and column.
+// It also extracts (on a best-effort basis) the objects referenced by the body.
+package sqlvalidation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// BodyKind determines what kind of SQL body is expected.
+type BodyKind string
+
+const (
+	// BodyKindQuery is a single query, e.g., a view statement.
+	BodyKindQuery BodyKind = "query"
+	// BodyKindStatement is a single statement or a Snowflake Scripting block, e.g., a task definition.
+	BodyKindStatement BodyKind = "statement"
+	// BodyKindExpression is an expression or a query, e.g., a SQL function definition.
+	BodyKindExpression BodyKind = "expression"
+	// BodyKindScriptingBlock is a Snowflake Scripting block, e.g., a SQL procedure definition.
+	BodyKindScriptingBlock BodyKind = "scripting block"
+)
+
+// SyntaxError is returned for invalid SQL bodies.
+type SyntaxError struct {
+	Position Position
+	Message  string
+}
+
+func newSyntaxError(position Position, message string) *SyntaxError {
+	return &SyntaxError{Position: position, Message: message}
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Position, e.Message)
+}
+
+// Result contains the details extracted from a valid SQL body.
+type Result struct {
+	References []Reference
+}
+
+// Validate checks the SQL body of the given kind. It returns a *SyntaxError for invalid bodies.
+func Validate(sql string, kind BodyKind) (*Result, error) {
+	tokens, err := tokenize(sql)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, newSyntaxError(Position{Line: 1, Column: 1}, fmt.Sprintf("expected %s, got an empty body", kind))
+	}
+	if err := validateBrackets(tokens); err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case BodyKindQuery:
+		err = validateSingleStatement(tokens, func(first token) error {
+			if !first.isKeyword("SELECT", "WITH") && !first.isPunctuation("(") {
+				return newSyntaxError(first.position, fmt.Sprintf("expected SELECT or WITH, got %s", first.describe()))
+			}
+			return nil
+		})
+	case BodyKindExpression:
+		err = validateSingleStatement(tokens, func(first token) error {
+			// Some of the statement keywords are also function names (e.g., GET)
+			isFunctionCall := len(tokens) > 1 && tokens[1].isPunctuation("(")
+			if first.isKeyword(statementKeywords...) && !first.isKeyword("SELECT", "WITH") && !isFunctionCall {
+				return newSyntaxError(first.position, fmt.Sprintf("expected an expression or a query, got %s", first.describe()))
+			}
+			return nil
+		})
+	case BodyKindStatement:
+		if startsScriptingBlock(tokens) {
+			err = validateScriptingBlock(tokens)
+		} else {
+			err = validateSingleStatement(tokens, func(first token) error {
+				if !first.isKeyword(statementKeywords...) && !first.isPunctuation("(") {
+					return newSyntaxError(first.position, fmt.Sprintf("expected a statement, got %s", first.describe()))
+				}
+				return nil
+			})
+		}
+	case BodyKindScriptingBlock:
+		if !startsScriptingBlock(tokens) {
+			return nil, newSyntaxError(tokens[0].position, fmt.Sprintf("expected DECLARE or BEGIN, got %s", tokens[0].describe()))
+		}
+		err = validateScriptingBlock(tokens)
+	default:
+		return nil, fmt.Errorf("unsupported body kind: %s", kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := validateClauses(tokens); err != nil {
+		return nil, err
+	}
+
+	return &Result{References: extractReferences(tokens)}, nil
+}
+
+// statementKeywords are the keywords that start the statements allowed in the task definition.
+var statementKeywords = []string{
+	"ALTER", "BEGIN", "CALL", "COMMENT", "COMMIT", "COPY", "CREATE", "DECLARE", "DELETE", "DESC", "DESCRIBE", "DROP",
+	"EXECUTE", "EXPLAIN", "GET", "GRANT", "INSERT", "LIST", "LS", "MERGE", "PUT", "REMOVE", "REVOKE", "RM", "ROLLBACK",
+	"SELECT", "SET", "SHOW", "START", "TRUNCATE", "UNDROP", "UNSET", "UPDATE", "USE", "WITH",
+}
+
+func validateBrackets(tokens []token) error {
+	closing := map[string]string{")": "(", "]": "[", "}": "{"}
+	opened := make([]token, 0)
+	for _, t := range tokens {
+		if t.kind != tokenKindPunctuation {
+			continue
+		}
+		switch t.text {
+		case "(", "[", "{":
+			opened = append(opened, t)
+		case ")", "]", "}":
+			if len(opened) == 0 || opened[len(opened)-1].text != closing[t.text] {
+				return newSyntaxError(t.position, fmt.Sprintf("unexpected '%s'", t.text))
+			}
+			opened = opened[:len(opened)-1]
+		}
+	}
+	if len(opened) > 0 {
+		unclosed := opened[len(opened)-1]
+		return newSyntaxError(unclosed.position, fmt.Sprintf("'%s' is never closed", unclosed.text))
+	}
+	return nil
+}
+
+// validateSingleStatement checks that the body contains exactly one statement (optionally terminated with a semicolon).
+func validateSingleStatement(tokens []token, validateFirst func(first token) error) error {
+	depth := 0
+	for i, t := range tokens {
+		switch {
+		case t.isPunctuation("("):
+			depth++
+		case t.isPunctuation(")"):
+			depth--
+		case t.isPunctuation(";") && depth == 0:
+			if i == 0 {
+				return newSyntaxError(t.position, "unexpected ';'")
+			}
+			if i+1 < len(tokens) {
+				return newSyntaxError(tokens[i+1].position, fmt.Sprintf("only a single statement is allowed, got %s after ';'", tokens[i+1].describe()))
+			}
+		}
+	}
+	return validateFirst(tokens[0])
+}
+
+func startsScriptingBlock(tokens []token) bool {
+	first := tokens[0]
+	return first.isKeyword("DECLARE") || (first.isKeyword("BEGIN") && !isTransactionBegin(tokens, 0))
+}
+
+// isTransactionBegin distinguishes BEGIN [ TRANSACTION | WORK ] statements from the Snowflake Scripting blocks.
+func isTransactionBegin(tokens []token, i int) bool {
+	return i+1 >= len(tokens) || tokens[i+1].isPunctuation(";") || tokens[i+1].isKeyword("TRANSACTION", "WORK", "NAME")
+}
+
+// validateScriptingBlock checks that the BEGIN ... END and CASE ... END pairs are balanced and nothing follows the outermost block.
+// Other compound statements (IF, FOR, LOOP, REPEAT, WHILE) are closed with END followed by their keyword, so they are skipped.
+func validateScriptingBlock(tokens []token) error {
+	opened := make([]token, 0)
+	blockStarted := false
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		if blockStarted && len(opened) == 0 {
+			if t.isPunctuation(";") && i+1 < len(tokens) {
+				t = tokens[i+1]
+			} else if t.isPunctuation(";") {
+				return nil
+			}
+			return newSyntaxError(t.position, fmt.Sprintf("unexpected %s after the end of the block", t.describe()))
+		}
+		switch {
+		case t.isKeyword("BEGIN") && !isTransactionBegin(tokens, i):
+			opened = append(opened, t)
+			blockStarted = true
+		case t.isKeyword("CASE"):
+			opened = append(opened, t)
+		case t.isKeyword("END"):
+			if i+1 < len(tokens) && tokens[i+1].isKeyword("IF", "FOR", "LOOP", "REPEAT", "WHILE") {
+				i++
+				continue
+			}
+			if len(opened) == 0 {
+				return newSyntaxError(t.position, "unexpected END without matching BEGIN")
+			}
+			if i+1 < len(tokens) && tokens[i+1].isKeyword("CASE") {
+				i++
+			}
+			opened = opened[:len(opened)-1]
+		}
+	}
+	if len(opened) > 0 {
+		unclosed := opened[len(opened)-1]
+		return newSyntaxError(unclosed.position, fmt.Sprintf("%s without matching END", strings.ToUpper(unclosed.text)))
+	}
+	if !blockStarted {
+		return newSyntaxError(tokens[len(tokens)-1].position, "expected BEGIN after the DECLARE section")
+	}
+	return nil
+}
+
+// operandKeywords are the keywords that have to be followed by an operand (e.g., a table, a condition, or an expression).
+var operandKeywords = []string{
+	"AND", "AS", "BY", "ELSE", "EXCEPT", "FROM", "HAVING", "IN", "INTERSECT", "INTO", "JOIN", "LIKE", "MINUS", "OR",
+	"SELECT", "THEN", "UNION", "VALUES", "WHEN", "WHERE",
+}
+
+// clauseKeywords are the keywords starting the query clauses; they cannot directly follow the operandKeywords.
+var clauseKeywords = []string{"FROM", "GROUP", "HAVING", "LIMIT", "ORDER", "QUALIFY", "WHERE"}
+
+// validateClauses checks for missing operands, e.g., WHERE without a condition or a trailing operator.
+// The row patterns of MATCH_RECOGNIZE are skipped, as their operators are quantifiers (e.g., PATTERN (A+ B*)) that do not take operands.
+func validateClauses(tokens []token) error {
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		if t.isKeyword("PATTERN") && i+1 < len(tokens) && tokens[i+1].isPunctuation("(") {
+			i = closingParenthesis(tokens, i+1)
+			continue
+		}
+		var next *token
+		if i+1 < len(tokens) {
+			next = &tokens[i+1]
+		}
+		missingOperand := next == nil || next.isPunctuation(";") || next.isPunctuation(")") || next.isPunctuation(",")
+
+		switch {
+		case t.isKeyword(operandKeywords...):
+			if missingOperand || next.isKeyword(clauseKeywords...) {
+				return unexpectedAfter(t, next)
+			}
+		case t.kind == tokenKindOperator && t.text != "*":
+			if missingOperand {
+				return unexpectedAfter(t, next)
+			}
+		case t.isPunctuation(","), t.isPunctuation("("):
+			if next != nil && (next.isPunctuation(",") || (t.isPunctuation(",") && next.isPunctuation(")"))) {
+				return unexpectedAfter(t, next)
+			}
+		}
+	}
+	return nil
+}
+
+// closingParenthesis returns the index of the parenthesis closing the one at the given index; the brackets are already validated to be balanced.
+func closingParenthesis(tokens []token, opening int) int {
+	depth := 0
+	for i := opening; i < len(tokens); i++ {
+		switch {
+		case tokens[i].isPunctuation("("):
+			depth++
+		case tokens[i].isPunctuation(")"):
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(tokens)
+}
+
+func unexpectedAfter(t token, next *token) error {
+	if next == nil {
+		return newSyntaxError(t.position, fmt.Sprintf("unexpected end of the body after %s", t.describe()))
+	}
+	return newSyntaxError(next.position, fmt.Sprintf("unexpected %s after %s", next.describe(), t.describe()))
+}
+
+// reservedKeywords are the keywords that cannot be used as unquoted identifiers (a subset of the Snowflake reserved keywords used by this package).
+var reservedKeywords = []string{
+	"ALL", "ALTER", "AND", "AS", "BEGIN", "BETWEEN", "BY", "CALL", "CASE", "CREATE", "CROSS", "DECLARE", "DELETE", "DISTINCT",
+	"DROP", "ELSE", "END", "EXCEPT", "EXISTS", "FALSE", "FOR", "FROM", "FULL", "GROUP", "HAVING", "IF", "ILIKE", "IN",
+	"INNER", "INSERT", "INTERSECT", "INTO", "IS", "JOIN", "LATERAL", "LEFT", "LIKE", "LIMIT", "LOOP", "MERGE", "MINUS",
+	"NATURAL", "NOT", "NULL", "ON", "OR", "ORDER", "OUTER", "QUALIFY", "REPEAT", "RETURN", "RIGHT", "SELECT", "SET",
+	"TABLE", "THEN", "TRUE", "UNION", "UPDATE", "USING", "VALUES", "WHEN", "WHERE", "WHILE", "WITH",
+}
+
+func isReservedKeyword(word string) bool {
+	return slices.Contains(reservedKeywords, strings.ToUpper(word))
+}
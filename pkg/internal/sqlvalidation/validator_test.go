@@ -0,0 +1,185 @@
+package sqlvalidation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Validate_Valid(t *testing.T) {
+	type test struct {
+		name               string
+		sql                string
+		kind               BodyKind
+		expectedReferences []string
+	}
+
+	valid := []test{
+		{name: "simple query", kind: BodyKindQuery, sql: "SELECT id, name FROM users", expectedReferences: []string{"users"}},
+		{name: "query with a trailing semicolon", kind: BodyKindQuery, sql: "select 1;", expectedReferences: []string{}},
+		{name: "query with comments", kind: BodyKindQuery, sql: "-- comment\nSELECT /* inline ; comment */ 1 // other comment", expectedReferences: []string{}},
+		{name: "query in parentheses", kind: BodyKindQuery, sql: "(SELECT 1 FROM a) UNION ALL (SELECT 2 FROM b)", expectedReferences: []string{"a", "b"}},
+		{
+			name: "query with joins and fully qualified names",
+			kind: BodyKindQuery,
+			sql: `SELECT o.id, c."name"
+FROM "DB"."SCHEMA"."ORDERS" o
+LEFT JOIN db.schema.customers AS c ON o.customer_id = c.id
+WHERE o.created_on > '2024-01-01'`,
+			expectedReferences: []string{`"DB"."SCHEMA"."ORDERS"`, "db.schema.customers"},
+		},
+		{name: "comma-separated tables", kind: BodyKindQuery, sql: "SELECT * FROM a x, b AS y, c WHERE x.id = y.id", expectedReferences: []string{"a", "b", "c"}},
+		{
+			name:               "common table expressions are skipped",
+			kind:               BodyKindQuery,
+			sql:                "WITH recent (id) AS (SELECT id FROM orders), other AS (SELECT 1) SELECT * FROM recent JOIN other ON TRUE",
+			expectedReferences: []string{"orders"},
+		},
+		{
+			name:               "subqueries, table functions, stages, and function calls with FROM are skipped",
+			kind:               BodyKindQuery,
+			sql:                "SELECT EXTRACT(YEAR FROM created_on), TRIM(BOTH FROM name) FROM (SELECT * FROM t), TABLE(FLATTEN(input => t.v)), LATERAL FLATTEN(t.v), @stage/path, IDENTIFIER($table)",
+			expectedReferences: []string{"t"},
+		},
+		{name: "references are deduplicated", kind: BodyKindQuery, sql: `SELECT * FROM t JOIN T ON TRUE JOIN "T" ON TRUE`, expectedReferences: []string{"t"}},
+		{name: "strings with special characters", kind: BodyKindQuery, sql: `SELECT 'it''s (' || 'a\'b' || $$multi;line$$ FROM t`, expectedReferences: []string{"t"}},
+		{
+			name: "match recognize with quantifiers in the pattern",
+			kind: BodyKindQuery,
+			sql: `SELECT * FROM stock_price_history
+  MATCH_RECOGNIZE(
+    PARTITION BY company ORDER BY price_date
+    MEASURES MATCH_NUMBER() AS match_number
+    PATTERN (^ A+ (B | C)* D{1,} E? $)
+    DEFINE A AS price > 10, B AS price < LAG(price)
+  )`,
+			expectedReferences: []string{"stock_price_history"},
+		},
+		{
+			name:               "distinct comparison is not a reference",
+			kind:               BodyKindQuery,
+			sql:                "SELECT * FROM t WHERE a IS DISTINCT FROM c OR b IS NOT DISTINCT FROM d",
+			expectedReferences: []string{"t"},
+		},
+		{name: "semi-structured and bind variables", kind: BodyKindQuery, sql: "SELECT v:field::string, ? FROM t WHERE id = :id", expectedReferences: []string{"t"}},
+
+		{name: "expression", kind: BodyKindExpression, sql: "3.141592654::FLOAT", expectedReferences: []string{}},
+		{name: "expression with arguments", kind: BodyKindExpression, sql: "a * b + 1e-3", expectedReferences: []string{}},
+		{name: "function named as a statement keyword", kind: BodyKindExpression, sql: "GET(arr, 0)", expectedReferences: []string{}},
+		{name: "query as expression", kind: BodyKindExpression, sql: "SELECT COUNT(*) FROM db.schema.t", expectedReferences: []string{"db.schema.t"}},
+
+		{name: "insert", kind: BodyKindStatement, sql: "INSERT INTO target (id) SELECT id FROM source", expectedReferences: []string{"target", "source"}},
+		{name: "call", kind: BodyKindStatement, sql: "CALL db.schema.proc(1, 'a')", expectedReferences: []string{"db.schema.proc"}},
+		{name: "merge", kind: BodyKindStatement, sql: "MERGE INTO t USING s ON t.id = s.id WHEN MATCHED THEN UPDATE SET t.v = s.v", expectedReferences: []string{"t", "s"}},
+		{name: "transaction begin", kind: BodyKindStatement, sql: "BEGIN TRANSACTION", expectedReferences: []string{}},
+		{
+			name: "scripting block in a task",
+			kind: BodyKindStatement,
+			sql: `BEGIN
+  INSERT INTO t VALUES (1);
+  CALL proc();
+END;`,
+			expectedReferences: []string{"t", "proc"},
+		},
+
+		{
+			name: "scripting block",
+			kind: BodyKindScriptingBlock,
+			sql: `DECLARE
+  counter INTEGER DEFAULT 0;
+  c CURSOR FOR SELECT id FROM t;
+BEGIN
+  FOR rec IN c DO
+    counter := counter + 1;
+  END FOR;
+  IF (counter > 0) THEN
+    SELECT COUNT(*) INTO :counter FROM other;
+  ELSE
+    counter := CASE WHEN counter IS NULL THEN 0 ELSE counter END;
+  END IF;
+  CASE (counter)
+    WHEN 1 THEN RETURN 'one';
+  END CASE;
+  BEGIN
+    UPDATE t SET v = 1;
+  EXCEPTION
+    WHEN OTHER THEN RETURN 'error';
+  END;
+  RETURN counter;
+END`,
+			expectedReferences: []string{"t", "other"},
+		},
+	}
+
+	for _, tc := range valid {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := Validate(tc.sql, tc.kind)
+			require.NoError(t, err)
+
+			references := make([]string, len(result.References))
+			for i, reference := range result.References {
+				references[i] = reference.Name
+			}
+			assert.Equal(t, tc.expectedReferences, references)
+		})
+	}
+}
+
+func Test_Validate_Invalid(t *testing.T) {
+	type test struct {
+		name          string
+		sql           string
+		kind          BodyKind
+		expectedError string
+	}
+
+	invalid := []test{
+		{name: "empty body", kind: BodyKindQuery, sql: " -- only comment\n", expectedError: "line 1, column 1: expected query, got an empty body"},
+		{name: "unterminated string", kind: BodyKindQuery, sql: "SELECT\n  'abc", expectedError: "line 2, column 3: unterminated string literal"},
+		{name: "unterminated identifier", kind: BodyKindQuery, sql: `SELECT "abc`, expectedError: "line 1, column 8: unterminated quoted identifier"},
+		{name: "unterminated comment", kind: BodyKindQuery, sql: "SELECT 1 /* abc", expectedError: "line 1, column 10: unterminated comment"},
+		{name: "unterminated dollar-quoted string", kind: BodyKindQuery, sql: "SELECT $$abc", expectedError: "line 1, column 8: unterminated $$ string literal"},
+		{name: "unexpected character", kind: BodyKindQuery, sql: "SELECT 1 # 2", expectedError: "line 1, column 10: unexpected character '#'"},
+		{name: "unclosed parenthesis", kind: BodyKindQuery, sql: "SELECT COUNT(\n*\nFROM t", expectedError: "line 1, column 13: '(' is never closed"},
+		{name: "unexpected parenthesis", kind: BodyKindQuery, sql: "SELECT 1)", expectedError: "line 1, column 9: unexpected ')'"},
+		{name: "mismatched brackets", kind: BodyKindQuery, sql: "SELECT [1)", expectedError: "line 1, column 10: unexpected ')'"},
+		{name: "not a query", kind: BodyKindQuery, sql: "INSERT INTO t VALUES (1)", expectedError: "line 1, column 1: expected SELECT or WITH, got INSERT"},
+		{name: "multiple statements", kind: BodyKindQuery, sql: "SELECT 1;\nSELECT 2", expectedError: "line 2, column 1: only a single statement is allowed, got SELECT after ';'"},
+		{name: "missing condition", kind: BodyKindQuery, sql: "SELECT *\nFROM t\nWHERE\nORDER BY 1", expectedError: "line 4, column 1: unexpected ORDER after WHERE"},
+		{name: "missing table", kind: BodyKindQuery, sql: "SELECT * FROM WHERE a = 1", expectedError: "line 1, column 15: unexpected WHERE after FROM"},
+		{name: "trailing operator", kind: BodyKindQuery, sql: "SELECT a +", expectedError: "line 1, column 10: unexpected end of the body after '+'"},
+		{name: "trailing keyword", kind: BodyKindQuery, sql: "SELECT a FROM t WHERE a = 1 AND;", expectedError: "line 1, column 32: unexpected ';' after AND"},
+		{name: "double comma", kind: BodyKindQuery, sql: "SELECT a,, b FROM t", expectedError: "line 1, column 10: unexpected ',' after ','"},
+		{name: "comma before closing parenthesis", kind: BodyKindQuery, sql: "SELECT f(a,) FROM t", expectedError: "line 1, column 12: unexpected ')' after ','"},
+
+		{name: "statement in expression", kind: BodyKindExpression, sql: "DELETE FROM t", expectedError: "line 1, column 1: expected an expression or a query, got DELETE"},
+
+		{name: "not a statement", kind: BodyKindStatement, sql: "SELEC 1", expectedError: "line 1, column 1: expected a statement, got identifier SELEC"},
+		{name: "multiple statements in task", kind: BodyKindStatement, sql: "INSERT INTO t VALUES (1); DELETE FROM t", expectedError: "line 1, column 27: only a single statement is allowed, got DELETE after ';'"},
+
+		{name: "not a block", kind: BodyKindScriptingBlock, sql: "SELECT 1", expectedError: "line 1, column 1: expected DECLARE or BEGIN, got SELECT"},
+		{name: "missing END", kind: BodyKindScriptingBlock, sql: "BEGIN\n  BEGIN\n    RETURN 1;\nEND;", expectedError: "line 1, column 1: BEGIN without matching END"},
+		{name: "missing BEGIN", kind: BodyKindScriptingBlock, sql: "DECLARE x INTEGER;", expectedError: "line 1, column 18: expected BEGIN after the DECLARE section"},
+		{name: "unexpected END", kind: BodyKindScriptingBlock, sql: "DECLARE x INTEGER;\nEND", expectedError: "line 2, column 1: unexpected END without matching BEGIN"},
+		{name: "statement after block", kind: BodyKindScriptingBlock, sql: "BEGIN\n  RETURN 1;\nEND;\nSELECT 1", expectedError: "line 4, column 1: unexpected SELECT after the end of the block"},
+		{name: "unclosed CASE", kind: BodyKindScriptingBlock, sql: "BEGIN\n  RETURN CASE WHEN 1 = 1 THEN 1;\nEND", expectedError: "line 1, column 1: BEGIN without matching END"},
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Validate(tc.sql, tc.kind)
+
+			var syntaxError *SyntaxError
+			require.ErrorAs(t, err, &syntaxError)
+			assert.Equal(t, tc.expectedError, err.Error())
+		})
+	}
+}
+
+func Test_Reference_IsFullyQualified(t *testing.T) {
+	assert.True(t, Reference{Name: `db.schema.t`}.IsFullyQualified())
+	assert.True(t, Reference{Name: `"D.B"."SCHEMA"."T"`}.IsFullyQualified())
+	assert.False(t, Reference{Name: `schema.t`}.IsFullyQualified())
+	assert.False(t, Reference{Name: `"a.b.c"`}.IsFullyQualified())
+}
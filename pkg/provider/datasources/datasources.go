@@ -5,8 +5,11 @@ type datasource string
 const (
 	Accounts                       datasource = "snowflake_accounts"
 	AccountRoles                   datasource = "snowflake_account_roles"
+	AggregationPolicies            datasource = "snowflake_aggregation_policies"
 	Alerts                         datasource = "snowflake_alerts"
 	AuthenticationPolicies         datasource = "snowflake_authentication_policies"
+	Backups                        datasource = "snowflake_backups"
+	BehaviorChangeBundles          datasource = "snowflake_behavior_change_bundles"
 	CatalogIntegrations            datasource = "snowflake_catalog_integrations"
 	ComputePools                   datasource = "snowflake_compute_pools"
 	Connections                    datasource = "snowflake_connections"
@@ -19,6 +22,8 @@ const (
 	DatabaseRoles                  datasource = "snowflake_database_roles"
 	Databases                      datasource = "snowflake_databases"
 	DynamicTables                  datasource = "snowflake_dynamic_tables"
+	EffectivePrivileges            datasource = "snowflake_effective_privileges"
+	ExternalAccessIntegrations     datasource = "snowflake_external_access_integrations"
 	ExternalFunctions              datasource = "snowflake_external_functions"
 	ExternalTables                 datasource = "snowflake_external_tables"
 	ExternalVolumes                datasource = "snowflake_external_volumes"
@@ -26,8 +31,10 @@ const (
 	FileFormats                    datasource = "snowflake_file_formats"
 	Functions                      datasource = "snowflake_functions"
 	GitRepositories                datasource = "snowflake_git_repositories"
+	GrantDriftReport               datasource = "snowflake_grant_drift_report"
 	Grants                         datasource = "snowflake_grants"
 	ImageRepositories              datasource = "snowflake_image_repositories"
+	JoinPolicies                   datasource = "snowflake_join_policies"
 	Listings                       datasource = "snowflake_listings"
 	MaskingPolicies                datasource = "snowflake_masking_policies"
 	MaterializedViews              datasource = "snowflake_materialized_views"
@@ -35,10 +42,14 @@ const (
 	NetworkRules                   datasource = "snowflake_network_rules"
 	Notebooks                      datasource = "snowflake_notebooks"
 	Parameters                     datasource = "snowflake_parameters"
+	PackagesPolicies               datasource = "snowflake_packages_policies"
 	PasswordPolicies               datasource = "snowflake_password_policies"
 	Pipes                          datasource = "snowflake_pipes"
+	PrivacyPolicies                datasource = "snowflake_privacy_policies"
 	Procedures                     datasource = "snowflake_procedures"
+	ProjectionPolicies             datasource = "snowflake_projection_policies"
 	ResourceMonitors               datasource = "snowflake_resource_monitors"
+	RoleHierarchy                  datasource = "snowflake_role_hierarchy"
 	RowAccessPolicies              datasource = "snowflake_row_access_policies"
 	Schemas                        datasource = "snowflake_schemas"
 	Secrets                        datasource = "snowflake_secrets"
@@ -24,6 +24,7 @@ const (
 	GrantsSafeDestroy              ExperimentalFeature = "GRANTS_SAFE_DESTROY"
 	TagAssociationSafeDestroy      ExperimentalFeature = "TAG_ASSOCIATION_SAFE_DESTROY"
 	GrantAccountRoleSafePublicRole ExperimentalFeature = "GRANT_ACCOUNT_ROLE_SAFE_PUBLIC_ROLE"
+	SqlBodyStructureCheck          ExperimentalFeature = "SQL_BODY_STRUCTURE_CHECK"
 )
 
 type experimentalFeatureState string
@@ -148,6 +149,16 @@ var allExperiments = []Experiment{
 			"With this experiment, Create, Read, and Delete all treat PUBLIC role grants as permanent fixtures that require no actual SQL.",
 		),
 	},
+	{
+		SqlBodyStructureCheck,
+		ExperimentalFeatureStateActive,
+		joinWithDoubleNewline(
+			"When enabled, the SQL bodies are checked offline during the plan, so the most common mistakes are reported before Snowflake executes the DDL during the apply.",
+			"Currently supported by: `snowflake_view` (`statement`), `snowflake_task` (`sql_statement`), `snowflake_function_sql` (`function_definition`), and `snowflake_procedure_sql` (`procedure_definition`).",
+			"It is a lexical and structural check, not a validation against the Snowflake SQL grammar; based on the tokens, it checks the lexical structure (strings, quoted identifiers, comments), balanced brackets and Snowflake Scripting blocks, the expected statement kind (e.g., a query for views, a scripting block for procedures), and the most common clause errors (e.g., a `WHERE` without a condition). A body passing the check can still be rejected by Snowflake during the apply. The errors contain the field name, and the line and column in the SQL body.",
+			"Additionally, the objects referenced by the SQL body (e.g., tables in the `FROM` clause or called procedures) are detected on a best-effort basis and returned as Terraform warnings pointing to the checked field, so the missing dependencies between the resources can be spotted. These warnings are emitted only after the apply (after the object is created or the field is updated), not during the plan, because the plan-time checks cannot return warnings. The bodies with unknown values (e.g., referencing other resources' attributes computed during the apply) are not checked.",
+		),
+	},
 }
 
 func joinWithDoubleNewline(parts ...string) string {
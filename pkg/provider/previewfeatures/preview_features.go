@@ -11,19 +11,30 @@ import (
 type feature string
 
 const (
+	AccessProfileResource                         feature = "snowflake_access_profile_resource"
 	AccountAuthenticationPolicyAttachmentResource feature = "snowflake_account_authentication_policy_attachment_resource"
 	AccountPasswordPolicyAttachmentResource       feature = "snowflake_account_password_policy_attachment_resource"
+	AccountPrivatelinkAuthorizationResource       feature = "snowflake_account_privatelink_authorization_resource"
 	AccountSessionPolicyAttachmentResource        feature = "snowflake_account_session_policy_attachment_resource"
+	AggregationPolicyResource                     feature = "snowflake_aggregation_policy_resource"
+	AggregationPoliciesDatasource                 feature = "snowflake_aggregation_policies_datasource"
 	AlertResource                                 feature = "snowflake_alert_resource"
 	AlertsDatasource                              feature = "snowflake_alerts_datasource"
 	ApiIntegrationResource                        feature = "snowflake_api_integration_resource"
 	AuthenticationPolicyResource                  feature = "snowflake_authentication_policy_resource"
 	AuthenticationPoliciesDatasource              feature = "snowflake_authentication_policies_datasource"
+	BackupPolicyResource                          feature = "snowflake_backup_policy_resource"
+	BackupRestoreResource                         feature = "snowflake_backup_restore_resource"
+	BackupSetResource                             feature = "snowflake_backup_set_resource"
+	BackupsDatasource                             feature = "snowflake_backups_datasource"
+	BehaviorChangeBundleResource                  feature = "snowflake_behavior_change_bundle_resource"
+	BehaviorChangeBundlesDatasource               feature = "snowflake_behavior_change_bundles_datasource"
 	CatalogIntegrationAwsGlueResource             feature = "snowflake_catalog_integration_aws_glue_resource"
 	CatalogIntegrationObjectStorageResource       feature = "snowflake_catalog_integration_object_storage_resource"
 	CatalogIntegrationOpenCatalogResource         feature = "snowflake_catalog_integration_open_catalog_resource"
 	CatalogIntegrationIcebergRestResource         feature = "snowflake_catalog_integration_iceberg_rest_resource"
 	CatalogIntegrationsDatasource                 feature = "snowflake_catalog_integrations_datasource"
+	ClassificationProfileResource                 feature = "snowflake_classification_profile_resource"
 	ComputePoolResource                           feature = "snowflake_compute_pool_resource"
 	ComputePoolsDatasource                        feature = "snowflake_compute_pools_datasource"
 	CortexAgentResource                           feature = "snowflake_cortex_agent_resource"
@@ -35,9 +46,13 @@ const (
 	CurrentOrganizationAccountResource            feature = "snowflake_current_organization_account_resource"
 	DatabaseDatasource                            feature = "snowflake_database_datasource"
 	DatabaseRoleDatasource                        feature = "snowflake_database_role_datasource"
+	DbtProjectResource                            feature = "snowflake_dbt_project_resource"
 	DynamicTableResource                          feature = "snowflake_dynamic_table_resource"
 	DynamicTablesDatasource                       feature = "snowflake_dynamic_tables_datasource"
+	EffectivePrivilegesDatasource                 feature = "snowflake_effective_privileges_datasource"
 	EmailNotificationIntegrationResource          feature = "snowflake_email_notification_integration_resource"
+	ExternalAccessIntegrationResource             feature = "snowflake_external_access_integration_resource"
+	ExternalAccessIntegrationsDatasource          feature = "snowflake_external_access_integrations_datasource"
 	ExternalAzureStageResource                    feature = "snowflake_stage_external_azure_resource"
 	ExternalFunctionResource                      feature = "snowflake_external_function_resource"
 	ExternalFunctionsDatasource                   feature = "snowflake_external_functions_datasource"
@@ -60,10 +75,13 @@ const (
 	FunctionsDatasource                           feature = "snowflake_functions_datasource"
 	GitRepositoryResource                         feature = "snowflake_git_repository_resource"
 	GitRepositoriesDatasource                     feature = "snowflake_git_repositories_datasource"
+	GrantDriftReportDatasource                    feature = "snowflake_grant_drift_report_datasource"
 	ImageRepositoryResource                       feature = "snowflake_image_repository_resource"
 	ImageRepositoriesDatasource                   feature = "snowflake_image_repositories_datasource"
 	InternalStageResource                         feature = "snowflake_stage_internal_resource"
 	JobServiceResource                            feature = "snowflake_job_service_resource"
+	JoinPolicyResource                            feature = "snowflake_join_policy_resource"
+	JoinPoliciesDatasource                        feature = "snowflake_join_policies_datasource"
 	ListingResource                               feature = "snowflake_listing_resource"
 	ListingsDatasource                            feature = "snowflake_listings_datasource"
 	ManagedAccountResource                        feature = "snowflake_managed_account_resource"
@@ -76,16 +94,24 @@ const (
 	NotebooksDatasource                           feature = "snowflake_notebooks_datasource"
 	NotificationIntegrationResource               feature = "snowflake_notification_integration_resource"
 	ObjectParameterResource                       feature = "snowflake_object_parameter_resource"
+	PackagesPoliciesDatasource                    feature = "snowflake_packages_policies_datasource"
+	PackagesPolicyResource                        feature = "snowflake_packages_policy_resource"
 	PasswordPoliciesDatasource                    feature = "snowflake_password_policies_datasource"
 	PasswordPolicyResource                        feature = "snowflake_password_policy_resource"
 	PipeResource                                  feature = "snowflake_pipe_resource"
 	PipesDatasource                               feature = "snowflake_pipes_datasource"
+	PrivacyPolicyResource                         feature = "snowflake_privacy_policy_resource"
+	PrivacyPoliciesDatasource                     feature = "snowflake_privacy_policies_datasource"
+	PrivatelinkEndpointResource                   feature = "snowflake_privatelink_endpoint_resource"
 	ProcedureJavaResource                         feature = "snowflake_procedure_java_resource"
 	ProcedureJavascriptResource                   feature = "snowflake_procedure_javascript_resource"
 	ProcedurePythonResource                       feature = "snowflake_procedure_python_resource"
 	ProcedureScalaResource                        feature = "snowflake_procedure_scala_resource"
 	ProcedureSqlResource                          feature = "snowflake_procedure_sql_resource"
 	ProceduresDatasource                          feature = "snowflake_procedures_datasource"
+	ProjectionPolicyResource                      feature = "snowflake_projection_policy_resource"
+	ProjectionPoliciesDatasource                  feature = "snowflake_projection_policies_datasource"
+	RoleHierarchyDatasource                       feature = "snowflake_role_hierarchy_datasource"
 	CurrentRoleDatasource                         feature = "snowflake_current_role_datasource"
 	SemanticViewResource                          feature = "snowflake_semantic_view_resource"
 	SemanticViewDatasource                        feature = "snowflake_semantic_views_datasource"
@@ -98,6 +124,7 @@ const (
 	ShareResource                                 feature = "snowflake_share_resource"
 	SharesDatasource                              feature = "snowflake_shares_datasource"
 	ParametersDatasource                          feature = "snowflake_parameters_datasource"
+	SnapshotResource                              feature = "snowflake_snapshot_resource"
 	StageResource                                 feature = "snowflake_stage_resource"
 	StagesDatasource                              feature = "snowflake_stages_datasource"
 	StorageIntegrationResource                    feature = "snowflake_storage_integration_resource"
@@ -105,6 +132,7 @@ const (
 	StorageIntegrationAzureResource               feature = "snowflake_storage_integration_azure_resource"
 	StorageIntegrationGcsResource                 feature = "snowflake_storage_integration_gcs_resource"
 	StorageIntegrationsDatasource                 feature = "snowflake_storage_integrations_datasource"
+	StorageLifecyclePolicyResource                feature = "snowflake_storage_lifecycle_policy_resource"
 	SystemGenerateSCIMAccessTokenDatasource       feature = "snowflake_system_generate_scim_access_token_datasource"
 	SystemGetAWSSNSIAMPolicyDatasource            feature = "snowflake_system_get_aws_sns_iam_policy_datasource"
 	SystemGetPrivateLinkConfigDatasource          feature = "snowflake_system_get_privatelink_config_datasource"
@@ -123,19 +151,30 @@ const (
 )
 
 var allPreviewFeatures = []feature{
+	AccessProfileResource,
 	AccountAuthenticationPolicyAttachmentResource,
 	AccountPasswordPolicyAttachmentResource,
+	AccountPrivatelinkAuthorizationResource,
 	AccountSessionPolicyAttachmentResource,
+	AggregationPolicyResource,
+	AggregationPoliciesDatasource,
 	AlertResource,
 	AlertsDatasource,
 	ApiIntegrationResource,
 	AuthenticationPolicyResource,
 	AuthenticationPoliciesDatasource,
+	BackupPolicyResource,
+	BackupRestoreResource,
+	BackupSetResource,
+	BackupsDatasource,
+	BehaviorChangeBundleResource,
+	BehaviorChangeBundlesDatasource,
 	CatalogIntegrationAwsGlueResource,
 	CatalogIntegrationObjectStorageResource,
 	CatalogIntegrationOpenCatalogResource,
 	CatalogIntegrationIcebergRestResource,
 	CatalogIntegrationsDatasource,
+	ClassificationProfileResource,
 	CortexAgentResource,
 	CortexAgentsDatasource,
 	CortexSearchServiceResource,
@@ -145,8 +184,12 @@ var allPreviewFeatures = []feature{
 	CurrentOrganizationAccountResource,
 	DatabaseDatasource,
 	DatabaseRoleDatasource,
+	DbtProjectResource,
 	DynamicTableResource,
 	DynamicTablesDatasource,
+	EffectivePrivilegesDatasource,
+	ExternalAccessIntegrationResource,
+	ExternalAccessIntegrationsDatasource,
 	ExternalAzureStageResource,
 	ExternalFunctionResource,
 	ExternalFunctionsDatasource,
@@ -167,8 +210,11 @@ var allPreviewFeatures = []feature{
 	FunctionScalaResource,
 	FunctionSqlResource,
 	FunctionsDatasource,
+	GrantDriftReportDatasource,
 	InternalStageResource,
 	JobServiceResource,
+	JoinPolicyResource,
+	JoinPoliciesDatasource,
 	ListingsDatasource,
 	ManagedAccountResource,
 	MaterializedViewResource,
@@ -180,6 +226,8 @@ var allPreviewFeatures = []feature{
 	EmailNotificationIntegrationResource,
 	NotificationIntegrationResource,
 	ObjectParameterResource,
+	PackagesPoliciesDatasource,
+	PackagesPolicyResource,
 	PasswordPoliciesDatasource,
 	PasswordPolicyResource,
 	PipeResource,
@@ -194,12 +242,19 @@ var allPreviewFeatures = []feature{
 	ShareResource,
 	SharesDatasource,
 	ParametersDatasource,
+	PrivacyPolicyResource,
+	PrivacyPoliciesDatasource,
+	PrivatelinkEndpointResource,
 	ProcedureJavaResource,
 	ProcedureJavascriptResource,
 	ProcedurePythonResource,
 	ProcedureScalaResource,
 	ProcedureSqlResource,
 	ProceduresDatasource,
+	ProjectionPolicyResource,
+	ProjectionPoliciesDatasource,
+	RoleHierarchyDatasource,
+	SnapshotResource,
 	StageResource,
 	StagesDatasource,
 	StorageIntegrationResource,
@@ -207,6 +262,7 @@ var allPreviewFeatures = []feature{
 	StorageIntegrationAzureResource,
 	StorageIntegrationGcsResource,
 	StorageIntegrationsDatasource,
+	StorageLifecyclePolicyResource,
 	SystemGenerateSCIMAccessTokenDatasource,
 	SystemGetAWSSNSIAMPolicyDatasource,
 	SystemGetPrivateLinkConfigDatasource,
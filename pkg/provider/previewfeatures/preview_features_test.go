@@ -18,19 +18,30 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "SNOWFLAKE_CURRENT_ACCOUNT_DATASOURCE", want: CurrentAccountDatasource},
 
 		// Supported Values.
+		{input: "snowflake_access_profile_resource", want: AccessProfileResource},
 		{input: "snowflake_account_authentication_policy_attachment_resource", want: AccountAuthenticationPolicyAttachmentResource},
 		{input: "snowflake_account_password_policy_attachment_resource", want: AccountPasswordPolicyAttachmentResource},
+		{input: "snowflake_account_privatelink_authorization_resource", want: AccountPrivatelinkAuthorizationResource},
 		{input: "snowflake_account_session_policy_attachment_resource", want: AccountSessionPolicyAttachmentResource},
+		{input: "snowflake_aggregation_policy_resource", want: AggregationPolicyResource},
+		{input: "snowflake_aggregation_policies_datasource", want: AggregationPoliciesDatasource},
 		{input: "snowflake_alert_resource", want: AlertResource},
 		{input: "snowflake_alerts_datasource", want: AlertsDatasource},
 		{input: "snowflake_api_integration_resource", want: ApiIntegrationResource},
 		{input: "snowflake_authentication_policy_resource", want: AuthenticationPolicyResource},
 		{input: "snowflake_authentication_policies_datasource", want: AuthenticationPoliciesDatasource},
+		{input: "snowflake_backup_policy_resource", want: BackupPolicyResource},
+		{input: "snowflake_backup_restore_resource", want: BackupRestoreResource},
+		{input: "snowflake_backup_set_resource", want: BackupSetResource},
+		{input: "snowflake_backups_datasource", want: BackupsDatasource},
+		{input: "snowflake_behavior_change_bundle_resource", want: BehaviorChangeBundleResource},
+		{input: "snowflake_behavior_change_bundles_datasource", want: BehaviorChangeBundlesDatasource},
 		{input: "snowflake_catalog_integration_aws_glue_resource", want: CatalogIntegrationAwsGlueResource},
 		{input: "snowflake_catalog_integration_object_storage_resource", want: CatalogIntegrationObjectStorageResource},
 		{input: "snowflake_catalog_integration_open_catalog_resource", want: CatalogIntegrationOpenCatalogResource},
 		{input: "snowflake_catalog_integration_iceberg_rest_resource", want: CatalogIntegrationIcebergRestResource},
 		{input: "snowflake_catalog_integrations_datasource", want: CatalogIntegrationsDatasource},
+		{input: "snowflake_classification_profile_resource", want: ClassificationProfileResource},
 		{input: "snowflake_compute_pool_resource", want: ComputePoolResource},
 		{input: "snowflake_compute_pools_datasource", want: ComputePoolsDatasource},
 		{input: "snowflake_cortex_agent_resource", want: CortexAgentResource},
@@ -42,9 +53,13 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_current_organization_account_resource", want: CurrentOrganizationAccountResource},
 		{input: "snowflake_database_datasource", want: DatabaseDatasource},
 		{input: "snowflake_database_role_datasource", want: DatabaseRoleDatasource},
+		{input: "snowflake_dbt_project_resource", want: DbtProjectResource},
 		{input: "snowflake_dynamic_table_resource", want: DynamicTableResource},
 		{input: "snowflake_dynamic_tables_datasource", want: DynamicTablesDatasource},
+		{input: "snowflake_effective_privileges_datasource", want: EffectivePrivilegesDatasource},
 		{input: "snowflake_email_notification_integration_resource", want: EmailNotificationIntegrationResource},
+		{input: "snowflake_external_access_integration_resource", want: ExternalAccessIntegrationResource},
+		{input: "snowflake_external_access_integrations_datasource", want: ExternalAccessIntegrationsDatasource},
 		{input: "snowflake_stage_external_azure_resource", want: ExternalAzureStageResource},
 		{input: "snowflake_external_function_resource", want: ExternalFunctionResource},
 		{input: "snowflake_external_functions_datasource", want: ExternalFunctionsDatasource},
@@ -65,12 +80,15 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_function_scala_resource", want: FunctionScalaResource},
 		{input: "snowflake_function_sql_resource", want: FunctionSqlResource},
 		{input: "snowflake_functions_datasource", want: FunctionsDatasource},
+		{input: "snowflake_grant_drift_report_datasource", want: GrantDriftReportDatasource},
 		{input: "snowflake_git_repository_resource", want: GitRepositoryResource},
 		{input: "snowflake_git_repositories_datasource", want: GitRepositoriesDatasource},
 		{input: "snowflake_image_repository_resource", want: ImageRepositoryResource},
 		{input: "snowflake_image_repositories_datasource", want: ImageRepositoriesDatasource},
 		{input: "snowflake_stage_internal_resource", want: InternalStageResource},
 		{input: "snowflake_job_service_resource", want: JobServiceResource},
+		{input: "snowflake_join_policy_resource", want: JoinPolicyResource},
+		{input: "snowflake_join_policies_datasource", want: JoinPoliciesDatasource},
 		{input: "snowflake_listing_resource", want: ListingResource},
 		{input: "snowflake_listings_datasource", want: ListingsDatasource},
 		{input: "snowflake_managed_account_resource", want: ManagedAccountResource},
@@ -83,16 +101,24 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_notebooks_datasource", want: NotebooksDatasource},
 		{input: "snowflake_notification_integration_resource", want: NotificationIntegrationResource},
 		{input: "snowflake_object_parameter_resource", want: ObjectParameterResource},
+		{input: "snowflake_packages_policies_datasource", want: PackagesPoliciesDatasource},
+		{input: "snowflake_packages_policy_resource", want: PackagesPolicyResource},
 		{input: "snowflake_password_policies_datasource", want: PasswordPoliciesDatasource},
 		{input: "snowflake_password_policy_resource", want: PasswordPolicyResource},
 		{input: "snowflake_pipe_resource", want: PipeResource},
 		{input: "snowflake_pipes_datasource", want: PipesDatasource},
+		{input: "snowflake_privacy_policy_resource", want: PrivacyPolicyResource},
+		{input: "snowflake_privacy_policies_datasource", want: PrivacyPoliciesDatasource},
+		{input: "snowflake_privatelink_endpoint_resource", want: PrivatelinkEndpointResource},
 		{input: "snowflake_procedure_java_resource", want: ProcedureJavaResource},
 		{input: "snowflake_procedure_javascript_resource", want: ProcedureJavascriptResource},
 		{input: "snowflake_procedure_python_resource", want: ProcedurePythonResource},
 		{input: "snowflake_procedure_scala_resource", want: ProcedureScalaResource},
 		{input: "snowflake_procedure_sql_resource", want: ProcedureSqlResource},
 		{input: "snowflake_procedures_datasource", want: ProceduresDatasource},
+		{input: "snowflake_projection_policy_resource", want: ProjectionPolicyResource},
+		{input: "snowflake_projection_policies_datasource", want: ProjectionPoliciesDatasource},
+		{input: "snowflake_role_hierarchy_datasource", want: RoleHierarchyDatasource},
 		{input: "snowflake_current_role_datasource", want: CurrentRoleDatasource},
 		{input: "snowflake_semantic_view_resource", want: SemanticViewResource},
 		{input: "snowflake_semantic_views_datasource", want: SemanticViewDatasource},
@@ -105,6 +131,7 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_share_resource", want: ShareResource},
 		{input: "snowflake_shares_datasource", want: SharesDatasource},
 		{input: "snowflake_parameters_datasource", want: ParametersDatasource},
+		{input: "snowflake_snapshot_resource", want: SnapshotResource},
 		{input: "snowflake_stage_resource", want: StageResource},
 		{input: "snowflake_stages_datasource", want: StagesDatasource},
 		{input: "snowflake_storage_integration_resource", want: StorageIntegrationResource},
@@ -112,6 +139,7 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_storage_integration_azure_resource", want: StorageIntegrationAzureResource},
 		{input: "snowflake_storage_integration_gcs_resource", want: StorageIntegrationGcsResource},
 		{input: "snowflake_storage_integrations_datasource", want: StorageIntegrationsDatasource},
+		{input: "snowflake_storage_lifecycle_policy_resource", want: StorageLifecyclePolicyResource},
 		{input: "snowflake_system_generate_scim_access_token_datasource", want: SystemGenerateSCIMAccessTokenDatasource},
 		{input: "snowflake_system_get_aws_sns_iam_policy_datasource", want: SystemGetAWSSNSIAMPolicyDatasource},
 		{input: "snowflake_system_get_privatelink_config_datasource", want: SystemGetPrivateLinkConfigDatasource},
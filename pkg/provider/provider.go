@@ -578,22 +578,30 @@ func GetProviderSchema() map[string]*schema.Schema {
 
 func getResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"snowflake_account": resources.Account(),
+		"snowflake_access_profile":                                               resources.AccessProfile(),
+		"snowflake_account":                                                      resources.Account(),
 		"snowflake_account_authentication_policy_attachment":                     resources.AccountAuthenticationPolicyAttachment(),
+		"snowflake_account_privatelink_authorization":                            resources.AccountPrivatelinkAuthorization(),
 		"snowflake_account_role":                                                 resources.AccountRole(),
 		"snowflake_account_password_policy_attachment":                           resources.AccountPasswordPolicyAttachment(),
 		"snowflake_account_parameter":                                            resources.AccountParameter(),
 		"snowflake_account_session_policy_attachment":                            resources.AccountSessionPolicyAttachment(),
+		"snowflake_aggregation_policy":                                           resources.AggregationPolicy(),
 		"snowflake_alert":                                                        resources.Alert(),
 		"snowflake_api_authentication_integration_with_authorization_code_grant": resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant(),
 		"snowflake_api_authentication_integration_with_client_credentials":       resources.ApiAuthenticationIntegrationWithClientCredentials(),
 		"snowflake_api_authentication_integration_with_jwt_bearer":               resources.ApiAuthenticationIntegrationWithJwtBearer(),
 		"snowflake_api_integration":                                              resources.APIIntegration(),
 		"snowflake_authentication_policy":                                        resources.AuthenticationPolicy(),
+		"snowflake_backup_policy":                                                resources.BackupPolicy(),
+		"snowflake_backup_restore":                                               resources.BackupRestore(),
+		"snowflake_backup_set":                                                   resources.BackupSet(),
+		"snowflake_behavior_change_bundle":                                       resources.BehaviorChangeBundle(),
 		"snowflake_catalog_integration_aws_glue":                                 resources.CatalogIntegrationAwsGlue(),
 		"snowflake_catalog_integration_object_storage":                           resources.CatalogIntegrationObjectStorage(),
 		"snowflake_catalog_integration_open_catalog":                             resources.CatalogIntegrationOpenCatalog(),
 		"snowflake_catalog_integration_iceberg_rest":                             resources.CatalogIntegrationIcebergRest(),
+		"snowflake_classification_profile":                                       resources.ClassificationProfile(),
 		"snowflake_compute_pool":                                                 resources.ComputePool(),
 		"snowflake_cortex_agent":                                                 resources.CortexAgent(),
 		"snowflake_cortex_search_service":                                        resources.CortexSearchService(),
@@ -601,9 +609,11 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_current_organization_account":                                 resources.CurrentOrganizationAccount(),
 		"snowflake_database":                                                     resources.Database(),
 		"snowflake_database_role":                                                resources.DatabaseRole(),
+		"snowflake_dbt_project":                                                  resources.DbtProject(),
 		"snowflake_dynamic_table":                                                resources.DynamicTable(),
 		"snowflake_email_notification_integration":                               resources.EmailNotificationIntegration(),
 		"snowflake_execute":                                                      resources.Execute(),
+		"snowflake_external_access_integration":                                  resources.ExternalAccessIntegration(),
 		"snowflake_stage_external_azure":                                         resources.ExternalAzureStage(),
 		"snowflake_external_function":                                            resources.ExternalFunction(),
 		"snowflake_stage_external_gcs":                                           resources.ExternalGcsStage(),
@@ -630,6 +640,7 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_image_repository":                                             resources.ImageRepository(),
 		"snowflake_stage_internal":                                               resources.InternalStage(),
 		"snowflake_job_service":                                                  resources.JobService(),
+		"snowflake_join_policy":                                                  resources.JoinPolicy(),
 		"snowflake_legacy_service_user":                                          resources.LegacyServiceUser(),
 		"snowflake_listing":                                                      resources.Listing(),
 		"snowflake_managed_account":                                              resources.ManagedAccount(),
@@ -643,14 +654,18 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_oauth_integration_for_partner_applications":                   resources.OauthIntegrationForPartnerApplications(),
 		"snowflake_oauth_integration_for_custom_clients":                         resources.OauthIntegrationForCustomClients(),
 		"snowflake_object_parameter":                                             resources.ObjectParameter(),
+		"snowflake_packages_policy":                                              resources.PackagesPolicy(),
 		"snowflake_password_policy":                                              resources.PasswordPolicy(),
 		"snowflake_pipe":                                                         resources.Pipe(),
 		"snowflake_primary_connection":                                           resources.PrimaryConnection(),
+		"snowflake_privacy_policy":                                               resources.PrivacyPolicy(),
+		"snowflake_privatelink_endpoint":                                         resources.PrivatelinkEndpoint(),
 		"snowflake_procedure_java":                                               resources.ProcedureJava(),
 		"snowflake_procedure_javascript":                                         resources.ProcedureJavascript(),
 		"snowflake_procedure_python":                                             resources.ProcedurePython(),
 		"snowflake_procedure_scala":                                              resources.ProcedureScala(),
 		"snowflake_procedure_sql":                                                resources.ProcedureSql(),
+		"snowflake_projection_policy":                                            resources.ProjectionPolicy(),
 		"snowflake_resource_monitor":                                             resources.ResourceMonitor(),
 		"snowflake_row_access_policy":                                            resources.RowAccessPolicy(),
 		"snowflake_saml2_integration":                                            resources.SAML2Integration(),
@@ -669,11 +684,13 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_service_user":                                                 resources.ServiceUser(),
 		"snowflake_share":                                                        resources.Share(),
 		"snowflake_shared_database":                                              resources.SharedDatabase(),
+		"snowflake_snapshot":                                                     resources.Snapshot(),
 		"snowflake_stage":                                                        resources.Stage(),
 		"snowflake_storage_integration":                                          resources.StorageIntegration(),
 		"snowflake_storage_integration_aws":                                      resources.StorageIntegrationAws(),
 		"snowflake_storage_integration_azure":                                    resources.StorageIntegrationAzure(),
 		"snowflake_storage_integration_gcs":                                      resources.StorageIntegrationGcs(),
+		"snowflake_storage_lifecycle_policy":                                     resources.StorageLifecyclePolicy(),
 		"snowflake_stream_on_directory_table":                                    resources.StreamOnDirectoryTable(),
 		"snowflake_stream_on_external_table":                                     resources.StreamOnExternalTable(),
 		"snowflake_stream_on_table":                                              resources.StreamOnTable(),
@@ -701,8 +718,11 @@ func getDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"snowflake_accounts":                           datasources.Accounts(),
 		"snowflake_account_roles":                      datasources.AccountRoles(),
+		"snowflake_aggregation_policies":               datasources.AggregationPolicies(),
 		"snowflake_alerts":                             datasources.Alerts(),
 		"snowflake_authentication_policies":            datasources.AuthenticationPolicies(),
+		"snowflake_backups":                            datasources.Backups(),
+		"snowflake_behavior_change_bundles":            datasources.BehaviorChangeBundles(),
 		"snowflake_catalog_integrations":               datasources.CatalogIntegrations(),
 		"snowflake_compute_pools":                      datasources.ComputePools(),
 		"snowflake_connections":                        datasources.Connections(),
@@ -715,6 +735,8 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_database_roles":                     datasources.DatabaseRoles(),
 		"snowflake_databases":                          datasources.Databases(),
 		"snowflake_dynamic_tables":                     datasources.DynamicTables(),
+		"snowflake_effective_privileges":               datasources.EffectivePrivileges(),
+		"snowflake_external_access_integrations":       datasources.ExternalAccessIntegrations(),
 		"snowflake_external_functions":                 datasources.ExternalFunctions(),
 		"snowflake_external_tables":                    datasources.ExternalTables(),
 		"snowflake_external_volumes":                   datasources.ExternalVolumes(),
@@ -722,8 +744,10 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_file_formats":                       datasources.FileFormats(),
 		"snowflake_functions":                          datasources.Functions(),
 		"snowflake_git_repositories":                   datasources.GitRepositories(),
+		"snowflake_grant_drift_report":                 datasources.GrantDriftReport(),
 		"snowflake_grants":                             datasources.Grants(),
 		"snowflake_image_repositories":                 datasources.ImageRepositories(),
+		"snowflake_join_policies":                      datasources.JoinPolicies(),
 		"snowflake_listings":                           datasources.Listings(),
 		"snowflake_masking_policies":                   datasources.MaskingPolicies(),
 		"snowflake_materialized_views":                 datasources.MaterializedViews(),
@@ -731,10 +755,14 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_network_rules":                      datasources.NetworkRules(),
 		"snowflake_notebooks":                          datasources.Notebooks(),
 		"snowflake_parameters":                         datasources.Parameters(),
+		"snowflake_packages_policies":                  datasources.PackagesPolicies(),
 		"snowflake_password_policies":                  datasources.PasswordPolicies(),
 		"snowflake_pipes":                              datasources.Pipes(),
+		"snowflake_privacy_policies":                   datasources.PrivacyPolicies(),
 		"snowflake_procedures":                         datasources.Procedures(),
+		"snowflake_projection_policies":                datasources.ProjectionPolicies(),
 		"snowflake_resource_monitors":                  datasources.ResourceMonitors(),
+		"snowflake_role_hierarchy":                     datasources.RoleHierarchy(),
 		"snowflake_row_access_policies":                datasources.RowAccessPolicies(),
 		"snowflake_schemas":                            datasources.Schemas(),
 		"snowflake_secrets":                            datasources.Secrets(),
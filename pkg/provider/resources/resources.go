@@ -3,22 +3,30 @@ package resources
 type resource string
 
 const (
+	AccessProfile                                          resource = "snowflake_access_profile"
 	Account                                                resource = "snowflake_account"
 	AccountAuthenticationPolicyAttachment                  resource = "snowflake_account_authentication_policy_attachment"
 	AccountParameter                                       resource = "snowflake_account_parameter"
 	AccountPasswordPolicyAttachment                        resource = "snowflake_account_password_policy_attachment"
+	AccountPrivatelinkAuthorization                        resource = "snowflake_account_privatelink_authorization"
 	AccountRole                                            resource = "snowflake_account_role"
 	AccountSessionPolicyAttachment                         resource = "snowflake_account_session_policy_attachment"
+	AggregationPolicy                                      resource = "snowflake_aggregation_policy"
 	Alert                                                  resource = "snowflake_alert"
 	ApiAuthenticationIntegrationWithAuthorizationCodeGrant resource = "snowflake_api_authentication_integration_with_authorization_code_grant"
 	ApiAuthenticationIntegrationWithClientCredentials      resource = "snowflake_api_authentication_integration_with_client_credentials"
 	ApiAuthenticationIntegrationWithJwtBearer              resource = "snowflake_api_authentication_integration_with_jwt_bearer"
 	ApiIntegration                                         resource = "snowflake_api_integration"
 	AuthenticationPolicy                                   resource = "snowflake_authentication_policy"
+	BackupPolicy                                           resource = "snowflake_backup_policy"
+	BackupRestore                                          resource = "snowflake_backup_restore"
+	BackupSet                                              resource = "snowflake_backup_set"
+	BehaviorChangeBundle                                   resource = "snowflake_behavior_change_bundle"
 	CatalogIntegrationAwsGlue                              resource = "snowflake_catalog_integration_aws_glue"
 	CatalogIntegrationObjectStorage                        resource = "snowflake_catalog_integration_object_storage"
 	CatalogIntegrationOpenCatalog                          resource = "snowflake_catalog_integration_open_catalog"
 	CatalogIntegrationIcebergRest                          resource = "snowflake_catalog_integration_iceberg_rest"
+	ClassificationProfile                                  resource = "snowflake_classification_profile"
 	ComputePool                                            resource = "snowflake_compute_pool"
 	CortexAgent                                            resource = "snowflake_cortex_agent"
 	CortexSearchService                                    resource = "snowflake_cortex_search_service"
@@ -26,10 +34,12 @@ const (
 	CurrentOrganizationAccount                             resource = "snowflake_current_organization_account"
 	Database                                               resource = "snowflake_database"
 	DatabaseRole                                           resource = "snowflake_database_role"
+	DbtProject                                             resource = "snowflake_dbt_project"
 	DynamicTable                                           resource = "snowflake_dynamic_table"
 	EmailNotificationIntegration                           resource = "snowflake_email_notification_integration"
 	Execute                                                resource = "snowflake_execute"
 	ExternalAzureStage                                     resource = "snowflake_stage_external_azure"
+	ExternalAccessIntegration                              resource = "snowflake_external_access_integration"
 	ExternalFunction                                       resource = "snowflake_external_function"
 	ExternalGcsStage                                       resource = "snowflake_stage_external_gcs"
 	ExternalS3Stage                                        resource = "snowflake_stage_external_s3"
@@ -55,6 +65,7 @@ const (
 	ImageRepository                                        resource = "snowflake_image_repository"
 	InternalStage                                          resource = "snowflake_stage_internal"
 	JobService                                             resource = "snowflake_job_service"
+	JoinPolicy                                             resource = "snowflake_join_policy"
 	LegacyServiceUser                                      resource = "snowflake_legacy_service_user"
 	Listing                                                resource = "snowflake_listing"
 	ManagedAccount                                         resource = "snowflake_managed_account"
@@ -69,14 +80,18 @@ const (
 	OauthIntegrationForCustomClients                       resource = "snowflake_oauth_integration_for_custom_clients"
 	OauthIntegrationForPartnerApplications                 resource = "snowflake_oauth_integration_for_partner_applications"
 	ObjectParameter                                        resource = "snowflake_object_parameter"
+	PackagesPolicy                                         resource = "snowflake_packages_policy"
 	PasswordPolicy                                         resource = "snowflake_password_policy"
 	Pipe                                                   resource = "snowflake_pipe"
 	PrimaryConnection                                      resource = "snowflake_primary_connection"
+	PrivacyPolicy                                          resource = "snowflake_privacy_policy"
+	PrivatelinkEndpoint                                    resource = "snowflake_privatelink_endpoint"
 	ProcedureJava                                          resource = "snowflake_procedure_java"
 	ProcedureJavascript                                    resource = "snowflake_procedure_javascript"
 	ProcedurePython                                        resource = "snowflake_procedure_python"
 	ProcedureScala                                         resource = "snowflake_procedure_scala"
 	ProcedureSql                                           resource = "snowflake_procedure_sql"
+	ProjectionPolicy                                       resource = "snowflake_projection_policy"
 	ResourceMonitor                                        resource = "snowflake_resource_monitor"
 	RowAccessPolicy                                        resource = "snowflake_row_access_policy"
 	SamlSecurityIntegration                                resource = "snowflake_saml_integration"
@@ -97,11 +112,13 @@ const (
 	ServiceUser                                            resource = "snowflake_service_user"
 	Share                                                  resource = "snowflake_share"
 	SharedDatabase                                         resource = "snowflake_shared_database"
+	Snapshot                                               resource = "snowflake_snapshot"
 	Stage                                                  resource = "snowflake_stage"
 	StorageIntegration                                     resource = "snowflake_storage_integration"
 	StorageIntegrationAws                                  resource = "snowflake_storage_integration_aws"
 	StorageIntegrationAzure                                resource = "snowflake_storage_integration_azure"
 	StorageIntegrationGcs                                  resource = "snowflake_storage_integration_gcs"
+	StorageLifecyclePolicy                                 resource = "snowflake_storage_lifecycle_policy"
 	StreamOnDirectoryTable                                 resource = "snowflake_stream_on_directory_table"
 	StreamOnExternalTable                                  resource = "snowflake_stream_on_external_table"
 	StreamOnTable                                          resource = "snowflake_stream_on_table"
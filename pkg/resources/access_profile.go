@@ -0,0 +1,842 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// accessProfileObjectTypes are the object types supported in privilege_set: databases, schemas, and the schema objects supporting bulk (ALL or FUTURE) grants.
+var accessProfileObjectTypes = func() []string {
+	objectTypes := []string{sdk.ObjectTypeDatabase.String(), sdk.ObjectTypeSchema.String()}
+	for _, plural := range append(slices.Clone(sdk.ValidGrantToAllPluralObjectTypesString), sdk.ValidGrantToFuturePluralObjectTypesString...) {
+		if objectType := sdk.PluralObjectType(plural).Singular().String(); !slices.Contains(objectTypes, objectType) {
+			objectTypes = append(objectTypes, objectType)
+		}
+	}
+	return objectTypes
+}()
+
+var accessProfileSchema = map[string]*schema.Schema{
+	"account_role_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("The fully qualified name of the account role to which the privileges are granted. Each account role can be managed by at most one access profile.", resources.AccountRole),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"privilege_set": {
+		Type:        schema.TypeSet,
+		Required:    true,
+		MinItems:    1,
+		Description: "The privileges granted on the objects of the given type in every object matched by `object_selector`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  fmt.Sprintf("The type of the objects. Valid values are (case-insensitive): %s.", possibleValuesListed(accessProfileObjectTypes)),
+					ValidateFunc: validation.StringInSlice(accessProfileObjectTypes, true),
+				},
+				"privileges": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty},
+					Description: "The privileges to grant on the objects, e.g. `USAGE` or `SELECT`.",
+				},
+			},
+		},
+	},
+	"object_selector": {
+		Type:        schema.TypeSet,
+		Required:    true,
+		MinItems:    1,
+		Description: "Selects the databases and schemas in which the privileges are granted. The selectors are resolved on every refresh and apply, so the objects created later and matching a pattern are picked up.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"database": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "The name of the database. Exactly one of `database` and `database_pattern` has to be set.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+				},
+				"database_pattern": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Regular expression matching the names of the databases, e.g. `^ANALYTICS_`. Exactly one of `database` and `database_pattern` has to be set.",
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+				"schema": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "The name of the schema in the selected databases. When neither `schema` nor `schema_pattern` is set, all the schemas are selected and the schema object privileges are granted on the database level.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+				},
+				"schema_pattern": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Regular expression matching the names of the schemas in the selected databases. Conflicts with `schema`.",
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+				"include_future": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Grants the privileges also on the future objects (`ON FUTURE ... IN DATABASE|SCHEMA`). The future schemas are covered only when all the schemas are selected.",
+				},
+			},
+		},
+	},
+	"expanded_grants": {
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The grants managed by the access profile, e.g. `USAGE ON SCHEMA \"DB\".\"SCHEMA\"`. On read, it contains the grants present in Snowflake; any difference from `configured_grants` (missing grants, additional privileges on the selected objects) is shown in the plan and reconciled on apply.",
+	},
+	"configured_grants": {
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The grants expanded from the configuration on the databases and schemas matched during the last read. The plan compares them with `expanded_grants` without querying Snowflake; after changing `privilege_set` or `object_selector`, both are known after apply.",
+	},
+}
+
+func AccessProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.AccessProfileResource), TrackingCreateWrapper(resources.AccessProfile, CreateAccessProfile)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.AccessProfileResource), TrackingReadWrapper(resources.AccessProfile, ReadAccessProfile)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.AccessProfileResource), TrackingUpdateWrapper(resources.AccessProfile, UpdateAccessProfile)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.AccessProfileResource), TrackingDeleteWrapper(resources.AccessProfile, DeleteAccessProfile)),
+		Description: joinWithSpace(
+			"Resource used to manage the privileges of an account role on many databases and schemas as one unit.",
+			"The privilege sets and the object selectors are expanded into the underlying grants (on the databases and schemas, and on all and future schema objects); the additions and removals are reconciled together on apply.",
+			"Every privilege granted to the role on the selected objects of the configured types and not expanded from the configuration is revoked, so the privileges on these objects should not be managed by other resources.",
+			"The grants on all the existing schema objects (`ON ALL ... IN DATABASE|SCHEMA`) are present when every object of the given type in the database or schema has the privilege.",
+			"The existing tables and views are listed; for the other object types, only the objects on which the role has any privilege are checked.",
+		),
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.AccessProfile, accessProfileExpandedGrantsCustomDiff),
+
+		Schema: accessProfileSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.AccessProfile, ImportAccessProfile),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateAccessProfile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	config, err := parseAccessProfileConfig(d.Get("account_role_name"), d.Get("privilege_set"), d.Get("object_selector"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := applyAccessProfile(ctx, client, nil, config); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(config.Role))
+
+	return ReadAccessProfile(ctx, d, meta)
+}
+
+// ImportAccessProfile reconstructs the configuration from the grants of the role: one privilege set per granted object type and one object selector per database (selecting all the schemas).
+// The privileges on all the existing schema objects are recovered only from the objects present in Snowflake (or from the future grants).
+func ImportAccessProfile(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+
+	roleId, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := client.Roles.ShowByID(ctx, roleId); err != nil {
+		return nil, err
+	}
+	current, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: roleId}})
+	if err != nil {
+		return nil, err
+	}
+	future, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{Future: sdk.Bool(true), To: &sdk.ShowGrantsTo{Role: roleId}})
+	if err != nil {
+		return nil, err
+	}
+
+	privileges := make(map[string]map[string]struct{})
+	// databases maps the database names to the include_future value of their selectors
+	databases := make(map[string]bool)
+	add := func(objectType sdk.ObjectType, privilege string, name sdk.ObjectIdentifier, isFuture bool) {
+		if privilege == "OWNERSHIP" || name == nil || !slices.Contains(accessProfileObjectTypes, objectType.String()) {
+			return
+		}
+		database := accessProfileGrantDatabase(objectType, name)
+		if database == "" {
+			return
+		}
+		if privileges[objectType.String()] == nil {
+			privileges[objectType.String()] = make(map[string]struct{})
+		}
+		privileges[objectType.String()][privilege] = struct{}{}
+		databases[database] = databases[database] || isFuture
+	}
+	for _, grant := range current {
+		add(grant.GrantedOn, grant.Privilege, grant.Name, false)
+	}
+	for _, grant := range future {
+		add(grant.GrantOn, grant.Privilege, grant.Name, true)
+	}
+	if len(privileges) == 0 {
+		return nil, fmt.Errorf("account role %s has no privileges on databases, schemas, or schema objects", roleId.FullyQualifiedName())
+	}
+
+	privilegeSets := make([]any, 0, len(privileges))
+	for _, objectType := range slices.Sorted(maps.Keys(privileges)) {
+		privilegeSets = append(privilegeSets, map[string]any{
+			"object_type": objectType,
+			"privileges":  slices.Sorted(maps.Keys(privileges[objectType])),
+		})
+	}
+	selectors := make([]any, 0, len(databases))
+	for _, database := range slices.Sorted(maps.Keys(databases)) {
+		selectors = append(selectors, map[string]any{
+			"database":       database,
+			"include_future": databases[database],
+		})
+	}
+
+	if err := errors.Join(
+		d.Set("account_role_name", roleId.Name()),
+		d.Set("privilege_set", privilegeSets),
+		d.Set("object_selector", selectors),
+	); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// accessProfileGrantDatabase returns the name of the database containing the object the grant is given on (empty when the name has an unexpected format).
+func accessProfileGrantDatabase(objectType sdk.ObjectType, name sdk.ObjectIdentifier) string {
+	if objectType == sdk.ObjectTypeDatabase {
+		return name.Name()
+	}
+	if inDatabase, ok := name.(interface{ DatabaseName() string }); ok {
+		return inDatabase.DatabaseName()
+	}
+	return ""
+}
+
+func ReadAccessProfile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	config, err := parseAccessProfileConfig(d.Get("account_role_name"), d.Get("privilege_set"), d.Get("object_selector"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.Roles.ShowByIDSafely(ctx, config.Role); err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve account role. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	containers, err := resolveAccessProfileContainers(ctx, client, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	actual, err := actualAccessProfileGrants(ctx, client, config, containers)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := errors.Join(
+		d.Set("expanded_grants", accessProfileGrantStrings(actual)),
+		d.Set("configured_grants", accessProfileGrantStrings(desiredAccessProfileGrants(config, containers))),
+	); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func UpdateAccessProfile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	oldPrivilegeSets, newPrivilegeSets := d.GetChange("privilege_set")
+	oldSelectors, newSelectors := d.GetChange("object_selector")
+	oldConfig, err := parseAccessProfileConfig(d.Get("account_role_name"), oldPrivilegeSets, oldSelectors)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	newConfig, err := parseAccessProfileConfig(d.Get("account_role_name"), newPrivilegeSets, newSelectors)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := applyAccessProfile(ctx, client, &oldConfig, newConfig); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadAccessProfile(ctx, d, meta)
+}
+
+func DeleteAccessProfile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	config, err := parseAccessProfileConfig(d.Get("account_role_name"), d.Get("privilege_set"), d.Get("object_selector"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, err := client.Roles.ShowByIDSafely(ctx, config.Role); err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	containers, err := resolveAccessProfileContainers(ctx, client, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	actual, err := actualAccessProfileGrants(ctx, client, config, containers)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// the grants on all the existing objects present only on some of the objects are revoked as well
+	actual = append(actual, accessProfileBulkAllGrants(desiredAccessProfileGrants(config, containers))...)
+	if err := revokeAccessProfileGrants(ctx, client, config.Role, uniqueAccessProfileGrants(actual)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// accessProfileExpandedGrantsCustomDiff shows the differences between the grants configured and present in Snowflake during the last read; it does not query Snowflake.
+func accessProfileExpandedGrantsCustomDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChanges("privilege_set", "object_selector") {
+		return errors.Join(
+			d.SetNewComputed("expanded_grants"),
+			d.SetNewComputed("configured_grants"),
+		)
+	}
+	configured := d.Get("configured_grants").(*schema.Set)
+	if configured.Equal(d.Get("expanded_grants").(*schema.Set)) {
+		return nil
+	}
+	return d.SetNew("expanded_grants", configured.List())
+}
+
+type accessProfileConfig struct {
+	Role          sdk.AccountObjectIdentifier
+	PrivilegeSets []accessProfilePrivilegeSet
+	Selectors     []accessProfileSelector
+}
+
+type accessProfilePrivilegeSet struct {
+	ObjectType sdk.ObjectType
+	Privileges []string
+}
+
+type accessProfileSelector struct {
+	Database        string
+	DatabasePattern *regexp.Regexp
+	Schema          string
+	SchemaPattern   *regexp.Regexp
+	IncludeFuture   bool
+}
+
+func (s accessProfileSelector) hasSchemaFilter() bool {
+	return s.Schema != "" || s.SchemaPattern != nil
+}
+
+func parseAccessProfileConfig(role any, privilegeSets any, selectors any) (accessProfileConfig, error) {
+	roleId, err := sdk.ParseAccountObjectIdentifier(role.(string))
+	if err != nil {
+		return accessProfileConfig{}, err
+	}
+	config := accessProfileConfig{Role: roleId}
+
+	for _, raw := range privilegeSets.(*schema.Set).List() {
+		privilegeSet := raw.(map[string]any)
+		privileges := make([]string, 0)
+		for _, privilege := range expandStringList(privilegeSet["privileges"].(*schema.Set).List()) {
+			privileges = append(privileges, strings.ToUpper(privilege))
+		}
+		slices.Sort(privileges)
+		config.PrivilegeSets = append(config.PrivilegeSets, accessProfilePrivilegeSet{
+			ObjectType: sdk.ObjectType(strings.ToUpper(privilegeSet["object_type"].(string))),
+			Privileges: slices.Compact(privileges),
+		})
+	}
+
+	var errs []error
+	for _, raw := range selectors.(*schema.Set).List() {
+		selector := raw.(map[string]any)
+		s := accessProfileSelector{IncludeFuture: selector["include_future"].(bool)}
+		database, databasePattern := selector["database"].(string), selector["database_pattern"].(string)
+		schemaName, schemaPattern := selector["schema"].(string), selector["schema_pattern"].(string)
+		if (database == "") == (databasePattern == "") {
+			errs = append(errs, errors.New("exactly one of database and database_pattern has to be set in object_selector"))
+			continue
+		}
+		if schemaName != "" && schemaPattern != "" {
+			errs = append(errs, errors.New("schema and schema_pattern cannot be set together in object_selector"))
+			continue
+		}
+		if database != "" {
+			id, err := sdk.ParseAccountObjectIdentifier(database)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			s.Database = id.Name()
+		} else if s.DatabasePattern, err = regexp.Compile(databasePattern); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if schemaName != "" {
+			id, err := sdk.ParseAccountObjectIdentifier(schemaName)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			s.Schema = id.Name()
+		} else if schemaPattern != "" {
+			if s.SchemaPattern, err = regexp.Compile(schemaPattern); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+		config.Selectors = append(config.Selectors, s)
+	}
+	return config, errors.Join(errs...)
+}
+
+func (c accessProfileConfig) hasObjectType(objectType sdk.ObjectType) bool {
+	return slices.ContainsFunc(c.PrivilegeSets, func(p accessProfilePrivilegeSet) bool { return p.ObjectType == objectType })
+}
+
+// accessProfileContainer is a database matched by an object selector together with the schemas matched in it.
+type accessProfileContainer struct {
+	Database sdk.AccountObjectIdentifier
+	Schemas  []sdk.DatabaseObjectIdentifier
+	// AllSchemas is set when the selector has no schema filter; the schema object grants are then issued on the database level.
+	AllSchemas    bool
+	IncludeFuture bool
+}
+
+// resolveAccessProfileContainers matches the object selectors against the databases and schemas currently present in Snowflake.
+func resolveAccessProfileContainers(ctx context.Context, client *sdk.Client, config accessProfileConfig) ([]accessProfileContainer, error) {
+	databases, err := client.Databases.Show(ctx, &sdk.ShowDatabasesOptions{})
+	if err != nil {
+		return nil, err
+	}
+	listSchemas := config.hasObjectType(sdk.ObjectTypeSchema)
+
+	containers := make([]accessProfileContainer, 0)
+	for _, selector := range config.Selectors {
+		for _, database := range databases {
+			if (selector.Database != "" && database.Name != selector.Database) || (selector.DatabasePattern != nil && !selector.DatabasePattern.MatchString(database.Name)) {
+				continue
+			}
+			container := accessProfileContainer{Database: database.ID(), AllSchemas: !selector.hasSchemaFilter(), IncludeFuture: selector.IncludeFuture}
+			if listSchemas || selector.hasSchemaFilter() {
+				schemas, err := client.Schemas.Show(ctx, &sdk.ShowSchemaOptions{In: &sdk.SchemaIn{Database: sdk.Bool(true), Name: database.ID()}})
+				if err != nil {
+					return nil, err
+				}
+				for _, s := range schemas {
+					if s.Name == "INFORMATION_SCHEMA" ||
+						(selector.Schema != "" && s.Name != selector.Schema) ||
+						(selector.SchemaPattern != nil && !selector.SchemaPattern.MatchString(s.Name)) {
+						continue
+					}
+					container.Schemas = append(container.Schemas, s.ID())
+				}
+			}
+			containers = append(containers, container)
+		}
+	}
+	return containers, nil
+}
+
+type accessProfileBulkKind string
+
+const (
+	accessProfileBulkAll    accessProfileBulkKind = "ALL"
+	accessProfileBulkFuture accessProfileBulkKind = "FUTURE"
+)
+
+// accessProfileGrant is a single privilege granted on a database, a schema, or on all or future objects in a database or a schema.
+type accessProfileGrant struct {
+	Privilege  string
+	ObjectType sdk.ObjectType
+	Bulk       accessProfileBulkKind
+	Database   sdk.AccountObjectIdentifier
+	Schema     *sdk.DatabaseObjectIdentifier
+}
+
+// target returns the grant without the privilege, e.g. `ON ALL TABLES IN SCHEMA "DB"."SCHEMA"`.
+func (g accessProfileGrant) target() string {
+	var container string
+	var containerType sdk.ObjectType
+	if g.Schema != nil {
+		container, containerType = g.Schema.FullyQualifiedName(), sdk.ObjectTypeSchema
+	} else {
+		container, containerType = g.Database.FullyQualifiedName(), sdk.ObjectTypeDatabase
+	}
+	if g.Bulk == "" {
+		return fmt.Sprintf("ON %s %s", g.ObjectType, container)
+	}
+	return fmt.Sprintf("ON %s %s IN %s %s", g.Bulk, g.ObjectType.Plural(), containerType, container)
+}
+
+func (g accessProfileGrant) String() string {
+	return fmt.Sprintf("%s %s", g.Privilege, g.target())
+}
+
+func (g accessProfileGrant) grantOn() *sdk.AccountRoleGrantOn {
+	switch {
+	case g.ObjectType == sdk.ObjectTypeDatabase:
+		return &sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Database: sdk.Pointer(g.Database)}}
+	case g.ObjectType == sdk.ObjectTypeSchema && g.Bulk == accessProfileBulkFuture:
+		return &sdk.AccountRoleGrantOn{Schema: &sdk.GrantOnSchema{FutureSchemasInDatabase: sdk.Pointer(g.Database)}}
+	case g.ObjectType == sdk.ObjectTypeSchema:
+		return &sdk.AccountRoleGrantOn{Schema: &sdk.GrantOnSchema{Schema: g.Schema}}
+	}
+	in := &sdk.GrantOnSchemaObjectIn{PluralObjectType: g.ObjectType.Plural()}
+	if g.Schema != nil {
+		in.InSchema = g.Schema
+	} else {
+		in.InDatabase = sdk.Pointer(g.Database)
+	}
+	if g.Bulk == accessProfileBulkAll {
+		return &sdk.AccountRoleGrantOn{SchemaObject: &sdk.GrantOnSchemaObject{All: in}}
+	}
+	return &sdk.AccountRoleGrantOn{SchemaObject: &sdk.GrantOnSchemaObject{Future: in}}
+}
+
+func accessProfilePrivileges(objectType sdk.ObjectType, privileges []string) *sdk.AccountRoleGrantPrivileges {
+	switch objectType {
+	case sdk.ObjectTypeDatabase:
+		return &sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: collections.Map(privileges, func(p string) sdk.AccountObjectPrivilege { return sdk.AccountObjectPrivilege(p) })}
+	case sdk.ObjectTypeSchema:
+		return &sdk.AccountRoleGrantPrivileges{SchemaPrivileges: collections.Map(privileges, func(p string) sdk.SchemaPrivilege { return sdk.SchemaPrivilege(p) })}
+	default:
+		return &sdk.AccountRoleGrantPrivileges{SchemaObjectPrivileges: collections.Map(privileges, func(p string) sdk.SchemaObjectPrivilege { return sdk.SchemaObjectPrivilege(p) })}
+	}
+}
+
+// desiredAccessProfileGrants expands the privilege sets on the resolved containers.
+func desiredAccessProfileGrants(config accessProfileConfig, containers []accessProfileContainer) []accessProfileGrant {
+	grants := make([]accessProfileGrant, 0)
+	add := func(privileges []string, g accessProfileGrant) {
+		for _, privilege := range privileges {
+			g.Privilege = privilege
+			grants = append(grants, g)
+		}
+	}
+	for _, privilegeSet := range config.PrivilegeSets {
+		objectType := privilegeSet.ObjectType
+		for _, container := range containers {
+			switch objectType {
+			case sdk.ObjectTypeDatabase:
+				add(privilegeSet.Privileges, accessProfileGrant{ObjectType: objectType, Database: container.Database})
+			case sdk.ObjectTypeSchema:
+				for _, schemaId := range container.Schemas {
+					add(privilegeSet.Privileges, accessProfileGrant{ObjectType: objectType, Database: container.Database, Schema: sdk.Pointer(schemaId)})
+				}
+				if container.AllSchemas && container.IncludeFuture {
+					add(privilegeSet.Privileges, accessProfileGrant{ObjectType: objectType, Bulk: accessProfileBulkFuture, Database: container.Database})
+				}
+			default:
+				schemas := []*sdk.DatabaseObjectIdentifier{nil}
+				if !container.AllSchemas {
+					schemas = collections.Map(container.Schemas, func(id sdk.DatabaseObjectIdentifier) *sdk.DatabaseObjectIdentifier { return sdk.Pointer(id) })
+				}
+				for _, schemaId := range schemas {
+					if slices.Contains(sdk.ValidGrantToAllPluralObjectTypesString, objectType.Plural().String()) {
+						add(privilegeSet.Privileges, accessProfileGrant{ObjectType: objectType, Bulk: accessProfileBulkAll, Database: container.Database, Schema: schemaId})
+					}
+					if container.IncludeFuture && slices.Contains(sdk.ValidGrantToFuturePluralObjectTypesString, objectType.Plural().String()) {
+						add(privilegeSet.Privileges, accessProfileGrant{ObjectType: objectType, Bulk: accessProfileBulkFuture, Database: container.Database, Schema: schemaId})
+					}
+				}
+			}
+		}
+	}
+	return uniqueAccessProfileGrants(grants)
+}
+
+// actualAccessProfileGrants lists the grants of the role on the resolved containers, limited to the configured object types (all the privileges except OWNERSHIP are returned).
+// The grants on all the existing schema objects expanded from the configuration are included when every object of the given type in the container has the privilege.
+func actualAccessProfileGrants(ctx context.Context, client *sdk.Client, config accessProfileConfig, containers []accessProfileContainer) ([]accessProfileGrant, error) {
+	databases := make(map[string]bool)
+	schemas := make(map[string]bool)
+	for _, container := range containers {
+		databases[container.Database.FullyQualifiedName()] = true
+		for _, schemaId := range container.Schemas {
+			schemas[schemaId.FullyQualifiedName()] = true
+		}
+	}
+
+	grants := make([]accessProfileGrant, 0)
+	current, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: config.Role}})
+	if err != nil {
+		return nil, err
+	}
+	// objectPrivileges maps the schema objects (by type and name) to the privileges the role has on them
+	objectPrivileges := make(map[sdk.ObjectType]map[string]map[string]struct{})
+	for _, grant := range current {
+		if grant.Privilege == "OWNERSHIP" || grant.Name == nil || !config.hasObjectType(grant.GrantedOn) {
+			continue
+		}
+		switch grant.GrantedOn {
+		case sdk.ObjectTypeDatabase:
+			if databases[grant.Name.FullyQualifiedName()] {
+				grants = append(grants, accessProfileGrant{Privilege: grant.Privilege, ObjectType: grant.GrantedOn, Database: sdk.NewAccountObjectIdentifier(grant.Name.Name())})
+			}
+		case sdk.ObjectTypeSchema:
+			if schemas[grant.Name.FullyQualifiedName()] {
+				schemaId := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(grant.Name.FullyQualifiedName())
+				grants = append(grants, accessProfileGrant{Privilege: grant.Privilege, ObjectType: grant.GrantedOn, Database: schemaId.DatabaseId(), Schema: &schemaId})
+			}
+		default:
+			if objectPrivileges[grant.GrantedOn] == nil {
+				objectPrivileges[grant.GrantedOn] = make(map[string]map[string]struct{})
+			}
+			if objectPrivileges[grant.GrantedOn][grant.Name.FullyQualifiedName()] == nil {
+				objectPrivileges[grant.GrantedOn][grant.Name.FullyQualifiedName()] = make(map[string]struct{})
+			}
+			objectPrivileges[grant.GrantedOn][grant.Name.FullyQualifiedName()][grant.Privilege] = struct{}{}
+		}
+	}
+
+	type futureScope struct {
+		database sdk.AccountObjectIdentifier
+		schema   *sdk.DatabaseObjectIdentifier
+	}
+	scopes := make([]futureScope, 0)
+	for _, container := range containers {
+		if container.AllSchemas {
+			scopes = append(scopes, futureScope{database: container.Database})
+			continue
+		}
+		for _, schemaId := range container.Schemas {
+			scopes = append(scopes, futureScope{database: container.Database, schema: sdk.Pointer(schemaId)})
+		}
+	}
+	visited := make(map[string]bool)
+	for _, scope := range scopes {
+		in := &sdk.ShowGrantsIn{Database: sdk.Pointer(scope.database)}
+		key := scope.database.FullyQualifiedName()
+		if scope.schema != nil {
+			in = &sdk.ShowGrantsIn{Schema: scope.schema}
+			key = scope.schema.FullyQualifiedName()
+		}
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+
+		future, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{Future: sdk.Bool(true), In: in})
+		if err != nil {
+			return nil, err
+		}
+		for _, grant := range future {
+			if grant.GrantTo != sdk.ObjectTypeRole || grant.GranteeName.Name() != config.Role.Name() || !config.hasObjectType(grant.GrantOn) {
+				continue
+			}
+			grants = append(grants, accessProfileGrant{Privilege: grant.Privilege, ObjectType: grant.GrantOn, Bulk: accessProfileBulkFuture, Database: scope.database, Schema: scope.schema})
+		}
+	}
+
+	// the grants on all the existing schema objects are listed per object, so they are checked on every object in the container
+	for _, g := range accessProfileBulkAllGrants(desiredAccessProfileGrants(config, containers)) {
+		objects, err := accessProfileSchemaObjects(ctx, client, g, objectPrivileges[g.ObjectType])
+		if err != nil {
+			return nil, err
+		}
+		if !slices.ContainsFunc(objects, func(name string) bool {
+			_, ok := objectPrivileges[g.ObjectType][name][g.Privilege]
+			return !ok
+		}) {
+			grants = append(grants, g)
+		}
+	}
+	return uniqueAccessProfileGrants(grants), nil
+}
+
+// accessProfileSchemaObjectListers list the existing objects of the types supported by the SDK show operations (the names are fully qualified).
+var accessProfileSchemaObjectListers = map[sdk.ObjectType]func(ctx context.Context, client *sdk.Client, in sdk.ExtendedIn) ([]string, error){
+	sdk.ObjectTypeTable: func(ctx context.Context, client *sdk.Client, in sdk.ExtendedIn) ([]string, error) {
+		tables, err := client.Tables.Show(ctx, sdk.NewShowTableRequest().WithIn(in))
+		return collections.Map(tables, func(t sdk.Table) string { return t.ID().FullyQualifiedName() }), err
+	},
+	sdk.ObjectTypeView: func(ctx context.Context, client *sdk.Client, in sdk.ExtendedIn) ([]string, error) {
+		views, err := client.Views.Show(ctx, sdk.NewShowViewRequest().WithIn(in))
+		return collections.Map(views, func(v sdk.View) string { return v.ID().FullyQualifiedName() }), err
+	},
+}
+
+// accessProfileSchemaObjects returns the names of the objects covered by the grant on all the existing objects: the listed ones and the ones on which the role has any privilege.
+func accessProfileSchemaObjects(ctx context.Context, client *sdk.Client, g accessProfileGrant, granted map[string]map[string]struct{}) ([]string, error) {
+	objects := make(map[string]struct{})
+	for name := range granted {
+		id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(name)
+		if id.DatabaseName() == g.Database.Name() && (g.Schema == nil || id.SchemaName() == g.Schema.Name()) {
+			objects[name] = struct{}{}
+		}
+	}
+	if lister, ok := accessProfileSchemaObjectListers[g.ObjectType]; ok {
+		in := sdk.ExtendedIn{In: sdk.In{Database: g.Database}}
+		if g.Schema != nil {
+			in = sdk.ExtendedIn{In: sdk.In{Schema: *g.Schema}}
+		}
+		listed, err := lister(ctx, client, in)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range listed {
+			objects[name] = struct{}{}
+		}
+	}
+	return slices.Sorted(maps.Keys(objects)), nil
+}
+
+func accessProfileBulkAllGrants(grants []accessProfileGrant) []accessProfileGrant {
+	return collections.Filter(grants, func(g accessProfileGrant) bool { return g.Bulk == accessProfileBulkAll })
+}
+
+// applyAccessProfile reconciles the grants of the new configuration; the grants of the old configuration (if given) no longer expanded from the new one are revoked.
+func applyAccessProfile(ctx context.Context, client *sdk.Client, oldConfig *accessProfileConfig, newConfig accessProfileConfig) error {
+	containers, err := resolveAccessProfileContainers(ctx, client, newConfig)
+	if err != nil {
+		return err
+	}
+	desired := desiredAccessProfileGrants(newConfig, containers)
+	actual, err := actualAccessProfileGrants(ctx, client, newConfig, containers)
+	if err != nil {
+		return err
+	}
+	if oldConfig != nil {
+		oldContainers, err := resolveAccessProfileContainers(ctx, client, *oldConfig)
+		if err != nil {
+			return err
+		}
+		oldActual, err := actualAccessProfileGrants(ctx, client, *oldConfig, oldContainers)
+		if err != nil {
+			return err
+		}
+		// the grants on all the existing objects present only on some of the objects are revoked as well
+		actual = append(actual, oldActual...)
+		actual = append(actual, accessProfileBulkAllGrants(desiredAccessProfileGrants(*oldConfig, oldContainers))...)
+	}
+
+	desiredKeys := accessProfileGrantKeys(desired)
+	actualKeys := accessProfileGrantKeys(actual)
+	toRevoke := slices.DeleteFunc(uniqueAccessProfileGrants(actual), func(g accessProfileGrant) bool { return desiredKeys[g.String()] })
+	// the grants on all the existing objects are always reapplied to cover the objects created since the last apply
+	toGrant := slices.DeleteFunc(slices.Clone(desired), func(g accessProfileGrant) bool {
+		return g.Bulk != accessProfileBulkAll && actualKeys[g.String()]
+	})
+
+	if err := revokeAccessProfileGrants(ctx, client, newConfig.Role, toRevoke); err != nil {
+		return err
+	}
+	for _, group := range groupAccessProfileGrants(toGrant) {
+		if err := client.Grants.GrantPrivilegesToAccountRole(ctx, accessProfilePrivileges(group.grant.ObjectType, group.privileges), group.grant.grantOn(), newConfig.Role, nil); err != nil {
+			return fmt.Errorf("granting %s to account role %s: %w", group, newConfig.Role.FullyQualifiedName(), err)
+		}
+	}
+	return nil
+}
+
+func revokeAccessProfileGrants(ctx context.Context, client *sdk.Client, role sdk.AccountObjectIdentifier, grants []accessProfileGrant) error {
+	for _, group := range groupAccessProfileGrants(grants) {
+		if err := client.Grants.RevokePrivilegesFromAccountRole(ctx, accessProfilePrivileges(group.grant.ObjectType, group.privileges), group.grant.grantOn(), role, nil); err != nil {
+			return fmt.Errorf("revoking %s from account role %s: %w", group, role.FullyQualifiedName(), err)
+		}
+	}
+	return nil
+}
+
+// accessProfileGrantGroup gathers the privileges granted on the same target, so that they are granted or revoked with a single statement.
+type accessProfileGrantGroup struct {
+	grant      accessProfileGrant
+	privileges []string
+}
+
+func (g accessProfileGrantGroup) String() string {
+	return fmt.Sprintf("%s %s", strings.Join(g.privileges, ", "), g.grant.target())
+}
+
+func groupAccessProfileGrants(grants []accessProfileGrant) []accessProfileGrantGroup {
+	groups := make([]accessProfileGrantGroup, 0)
+	indexByTarget := make(map[string]int)
+	for _, g := range grants {
+		i, ok := indexByTarget[g.target()]
+		if !ok {
+			groups = append(groups, accessProfileGrantGroup{grant: g})
+			i = len(groups) - 1
+			indexByTarget[g.target()] = i
+		}
+		groups[i].privileges = append(groups[i].privileges, g.Privilege)
+	}
+	return groups
+}
+
+func uniqueAccessProfileGrants(grants []accessProfileGrant) []accessProfileGrant {
+	seen := make(map[string]bool)
+	unique := make([]accessProfileGrant, 0, len(grants))
+	for _, g := range grants {
+		if !seen[g.String()] {
+			seen[g.String()] = true
+			unique = append(unique, g)
+		}
+	}
+	return unique
+}
+
+func accessProfileGrantKeys(grants []accessProfileGrant) map[string]bool {
+	keys := make(map[string]bool, len(grants))
+	for _, g := range grants {
+		keys[g.String()] = true
+	}
+	return keys
+}
+
+func accessProfileGrantStrings(grants []accessProfileGrant) []string {
+	result := make([]string, 0, len(grants))
+	for _, g := range uniqueAccessProfileGrants(grants) {
+		result = append(result, g.String())
+	}
+	slices.Sort(result)
+	return result
+}
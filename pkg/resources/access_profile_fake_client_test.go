@@ -0,0 +1,164 @@
+//go:build fake_client_tests
+
+package resources
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AccessProfile_Reconciliation(t *testing.T) {
+	ctx := context.Background()
+	client := sdk.NewFakeClient(sdk.NewFakeCatalog())
+
+	roleId := sdk.NewAccountObjectIdentifier("FR_ANALYST")
+	require.NoError(t, client.Roles.Create(ctx, sdk.NewCreateRoleRequest(roleId)))
+	for _, name := range []string{"ANALYTICS_A", "ANALYTICS_B", "RAW"} {
+		require.NoError(t, client.Databases.Create(ctx, sdk.NewAccountObjectIdentifier(name), nil))
+	}
+	require.NoError(t, client.Schemas.Create(ctx, sdk.NewDatabaseObjectIdentifier("ANALYTICS_A", "MART"), nil))
+
+	config := accessProfileConfig{
+		Role: roleId,
+		PrivilegeSets: []accessProfilePrivilegeSet{
+			{ObjectType: sdk.ObjectTypeDatabase, Privileges: []string{"USAGE"}},
+			{ObjectType: sdk.ObjectTypeSchema, Privileges: []string{"USAGE"}},
+			{ObjectType: sdk.ObjectTypeTable, Privileges: []string{"SELECT"}},
+		},
+		Selectors: []accessProfileSelector{
+			{DatabasePattern: regexp.MustCompile("^ANALYTICS_"), IncludeFuture: true},
+		},
+	}
+	expected := []string{
+		`SELECT ON ALL TABLES IN DATABASE "ANALYTICS_A"`,
+		`SELECT ON ALL TABLES IN DATABASE "ANALYTICS_B"`,
+		`SELECT ON FUTURE TABLES IN DATABASE "ANALYTICS_A"`,
+		`SELECT ON FUTURE TABLES IN DATABASE "ANALYTICS_B"`,
+		`USAGE ON DATABASE "ANALYTICS_A"`,
+		`USAGE ON DATABASE "ANALYTICS_B"`,
+		`USAGE ON FUTURE SCHEMAS IN DATABASE "ANALYTICS_A"`,
+		`USAGE ON FUTURE SCHEMAS IN DATABASE "ANALYTICS_B"`,
+		`USAGE ON SCHEMA "ANALYTICS_A"."MART"`,
+		`USAGE ON SCHEMA "ANALYTICS_A"."PUBLIC"`,
+		`USAGE ON SCHEMA "ANALYTICS_B"."PUBLIC"`,
+	}
+	actualGrants := func(config accessProfileConfig) []string {
+		t.Helper()
+		containers, err := resolveAccessProfileContainers(ctx, client, config)
+		require.NoError(t, err)
+		actual, err := actualAccessProfileGrants(ctx, client, config, containers)
+		require.NoError(t, err)
+		return accessProfileGrantStrings(actual)
+	}
+
+	t.Run("expand", func(t *testing.T) {
+		containers, err := resolveAccessProfileContainers(ctx, client, config)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, accessProfileGrantStrings(desiredAccessProfileGrants(config, containers)))
+	})
+
+	t.Run("create", func(t *testing.T) {
+		require.NoError(t, applyAccessProfile(ctx, client, nil, config))
+
+		assert.Equal(t, expected, actualGrants(config))
+	})
+
+	t.Run("drift is reconciled", func(t *testing.T) {
+		databaseId := sdk.NewAccountObjectIdentifier("ANALYTICS_B")
+		require.NoError(t, client.Grants.RevokePrivilegesFromAccountRole(ctx,
+			&sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeUsage}},
+			&sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Database: &databaseId}},
+			roleId, nil,
+		))
+		require.NoError(t, client.Grants.GrantPrivilegesToAccountRole(ctx,
+			&sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeMonitor}},
+			&sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Database: &databaseId}},
+			roleId, nil,
+		))
+		drifted := actualGrants(config)
+		assert.NotContains(t, drifted, `USAGE ON DATABASE "ANALYTICS_B"`)
+		assert.Contains(t, drifted, `MONITOR ON DATABASE "ANALYTICS_B"`)
+
+		require.NoError(t, applyAccessProfile(ctx, client, &config, config))
+
+		assert.Equal(t, expected, actualGrants(config))
+	})
+
+	t.Run("revocation on a single table is detected", func(t *testing.T) {
+		tableId := sdk.NewSchemaObjectIdentifier("ANALYTICS_A", "MART", "EVENTS")
+		require.NoError(t, client.Tables.Create(ctx, sdk.NewCreateTableRequest(tableId, []sdk.TableColumnRequest{*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber)})))
+		// the fake catalog does not apply the future grants, so the new table has no privileges
+		assert.NotContains(t, actualGrants(config), `SELECT ON ALL TABLES IN DATABASE "ANALYTICS_A"`)
+
+		require.NoError(t, applyAccessProfile(ctx, client, &config, config))
+		assert.Equal(t, expected, actualGrants(config))
+
+		require.NoError(t, client.Grants.RevokePrivilegesFromAccountRole(ctx,
+			&sdk.AccountRoleGrantPrivileges{SchemaObjectPrivileges: []sdk.SchemaObjectPrivilege{sdk.SchemaObjectPrivilegeSelect}},
+			&sdk.AccountRoleGrantOn{SchemaObject: &sdk.GrantOnSchemaObject{SchemaObject: &sdk.Object{ObjectType: sdk.ObjectTypeTable, Name: tableId}}},
+			roleId, nil,
+		))
+		assert.NotContains(t, actualGrants(config), `SELECT ON ALL TABLES IN DATABASE "ANALYTICS_A"`)
+
+		require.NoError(t, applyAccessProfile(ctx, client, &config, config))
+		assert.Equal(t, expected, actualGrants(config))
+	})
+
+	t.Run("import", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, accessProfileSchema, map[string]any{})
+		d.SetId(roleId.Name())
+
+		_, err := ImportAccessProfile(ctx, d, &provider.Context{Client: client})
+		require.NoError(t, err)
+
+		imported, err := parseAccessProfileConfig(d.Get("account_role_name"), d.Get("privilege_set"), d.Get("object_selector"))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, config.PrivilegeSets, imported.PrivilegeSets)
+		assert.ElementsMatch(t, []accessProfileSelector{
+			{Database: "ANALYTICS_A", IncludeFuture: true},
+			{Database: "ANALYTICS_B", IncludeFuture: true},
+		}, imported.Selectors)
+	})
+
+	t.Run("selector change revokes the grants no longer expanded", func(t *testing.T) {
+		narrowed := config
+		narrowed.Selectors = []accessProfileSelector{{Database: "ANALYTICS_A", Schema: "MART"}}
+
+		require.NoError(t, applyAccessProfile(ctx, client, &config, narrowed))
+
+		assert.Equal(t, []string{
+			`SELECT ON ALL TABLES IN SCHEMA "ANALYTICS_A"."MART"`,
+			`USAGE ON DATABASE "ANALYTICS_A"`,
+			`USAGE ON SCHEMA "ANALYTICS_A"."MART"`,
+		}, actualGrants(narrowed))
+		assert.Equal(t, []string{
+			`SELECT ON ALL TABLES IN DATABASE "ANALYTICS_A"`,
+			`SELECT ON ALL TABLES IN DATABASE "ANALYTICS_B"`,
+			`USAGE ON DATABASE "ANALYTICS_A"`,
+			`USAGE ON SCHEMA "ANALYTICS_A"."MART"`,
+		}, actualGrants(config))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		narrowed := config
+		narrowed.Selectors = []accessProfileSelector{{Database: "ANALYTICS_A", Schema: "MART"}}
+		containers, err := resolveAccessProfileContainers(ctx, client, narrowed)
+		require.NoError(t, err)
+		actual, err := actualAccessProfileGrants(ctx, client, narrowed, containers)
+		require.NoError(t, err)
+
+		require.NoError(t, revokeAccessProfileGrants(ctx, client, roleId, actual))
+
+		grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: roleId}})
+		require.NoError(t, err)
+		assert.Empty(t, grants)
+	})
+}
@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AccessProfile_ParseConfig(t *testing.T) {
+	parse := func(t *testing.T, selector map[string]any) (accessProfileConfig, error) {
+		t.Helper()
+		d := schema.TestResourceDataRaw(t, accessProfileSchema, map[string]any{
+			"account_role_name": `"fr_analyst"`,
+			"privilege_set": []any{
+				map[string]any{"object_type": "table", "privileges": []any{"select", "SELECT", "references"}},
+			},
+			"object_selector": []any{selector},
+		})
+		return parseAccessProfileConfig(d.Get("account_role_name"), d.Get("privilege_set"), d.Get("object_selector"))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		config, err := parse(t, map[string]any{"database": `"db"`, "schema_pattern": "^STG_"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "fr_analyst", config.Role.Name())
+		assert.Equal(t, []accessProfilePrivilegeSet{{ObjectType: sdk.ObjectTypeTable, Privileges: []string{"REFERENCES", "SELECT"}}}, config.PrivilegeSets)
+		require.Len(t, config.Selectors, 1)
+		assert.Equal(t, "db", config.Selectors[0].Database)
+		assert.Equal(t, "^STG_", config.Selectors[0].SchemaPattern.String())
+		assert.True(t, config.Selectors[0].IncludeFuture)
+	})
+
+	t.Run("both database and database pattern", func(t *testing.T) {
+		_, err := parse(t, map[string]any{"database": "DB", "database_pattern": "^DB"})
+		require.ErrorContains(t, err, "exactly one of database and database_pattern has to be set in object_selector")
+	})
+
+	t.Run("no database", func(t *testing.T) {
+		_, err := parse(t, map[string]any{"schema": "PUBLIC"})
+		require.ErrorContains(t, err, "exactly one of database and database_pattern has to be set in object_selector")
+	})
+
+	t.Run("both schema and schema pattern", func(t *testing.T) {
+		_, err := parse(t, map[string]any{"database": "DB", "schema": "PUBLIC", "schema_pattern": "^P"})
+		require.ErrorContains(t, err, "schema and schema_pattern cannot be set together in object_selector")
+	})
+}
@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var accountPrivatelinkAuthorizationSchema = map[string]*schema.Schema{
+	"endpoint_id": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "Specifies the identifier of the endpoint authorized to connect to the current account: the 12-digit AWS account ID or the resource ID of the Microsoft Azure private endpoint.",
+		ValidateFunc: validation.StringIsNotEmpty,
+	},
+	"token": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Sensitive:    true,
+		Description:  "Specifies the token proving the ownership of the endpoint: the AWS federated token (e.g. from `aws sts get-federation-token`) or the Microsoft Azure access token (e.g. from `az account get-access-token`). The token is used to authorize and revoke the endpoint; it is not returned by Snowflake, so changing it does not affect the authorization. Tokens expire, so make sure a valid one is set before destroying the resource.",
+		ValidateFunc: validation.StringIsNotEmpty,
+	},
+	"endpoint_id_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Type of the endpoint identifier as returned by `SYSTEM$GET_PRIVATELINK_AUTHORIZED_ENDPOINTS`.",
+	},
+}
+
+func AccountPrivatelinkAuthorization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.AccountPrivatelinkAuthorizationResource), TrackingCreateWrapper(resources.AccountPrivatelinkAuthorization, CreateAccountPrivatelinkAuthorization)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.AccountPrivatelinkAuthorizationResource), TrackingReadWrapper(resources.AccountPrivatelinkAuthorization, ReadAccountPrivatelinkAuthorization)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.AccountPrivatelinkAuthorizationResource), TrackingUpdateWrapper(resources.AccountPrivatelinkAuthorization, UpdateAccountPrivatelinkAuthorization)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.AccountPrivatelinkAuthorizationResource), TrackingDeleteWrapper(resources.AccountPrivatelinkAuthorization, DeleteAccountPrivatelinkAuthorization)),
+		Description: joinWithSpace(
+			"Resource used to authorize inbound private connectivity (AWS PrivateLink or Azure Private Link) to the current account for the given endpoint.",
+			"The endpoint is authorized with `SYSTEM$AUTHORIZE_PRIVATELINK` and revoked with `SYSTEM$REVOKE_PRIVATELINK`.",
+			"For more information, check [AWS PrivateLink documentation](https://docs.snowflake.com/en/user-guide/admin-security-privatelink) and [Azure Private Link documentation](https://docs.snowflake.com/en/user-guide/privatelink-azure).",
+			"Requires the Business Critical edition (or higher) and the ACCOUNTADMIN role. To authorize endpoints in a different account, use a provider alias.",
+			"The URLs needed to configure the private connectivity can be read with the `snowflake_system_get_privatelink_config` data source.",
+		),
+
+		Schema: accountPrivatelinkAuthorizationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.AccountPrivatelinkAuthorization, ImportAccountPrivatelinkAuthorization),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportAccountPrivatelinkAuthorization(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := d.Set("endpoint_id", d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateAccountPrivatelinkAuthorization(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	endpointId := d.Get("endpoint_id").(string)
+
+	if err := client.SystemFunctions.AuthorizePrivatelink(ctx, endpointId, d.Get("token").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(endpointId)
+
+	return ReadAccountPrivatelinkAuthorization(ctx, d, meta)
+}
+
+func ReadAccountPrivatelinkAuthorization(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	endpointId := d.Id()
+
+	endpoint, err := findPrivatelinkAuthorizedEndpoint(ctx, client, endpointId)
+	if err != nil {
+		if errors.Is(err, collections.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query privatelink authorized endpoint. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Privatelink endpoint id: %s, Err: %s", endpointId, err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := errors.Join(
+		d.Set("endpoint_id", endpoint.EndpointId),
+		d.Set("endpoint_id_type", endpoint.EndpointIdType),
+	); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// UpdateAccountPrivatelinkAuthorization only stores the new token in the state, so it can be used to revoke the authorization later.
+func UpdateAccountPrivatelinkAuthorization(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return ReadAccountPrivatelinkAuthorization(ctx, d, meta)
+}
+
+// DeleteAccountPrivatelinkAuthorization revokes the authorization. Authorizations already revoked outside of Terraform are left untouched.
+func DeleteAccountPrivatelinkAuthorization(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	endpointId := d.Id()
+
+	if _, err := findPrivatelinkAuthorizedEndpoint(ctx, client, endpointId); err != nil {
+		if errors.Is(err, collections.ErrObjectNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := client.SystemFunctions.RevokePrivatelink(ctx, endpointId, d.Get("token").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func findPrivatelinkAuthorizedEndpoint(ctx context.Context, client *sdk.Client, endpointId string) (*sdk.PrivatelinkAuthorizedEndpoint, error) {
+	endpoints, err := client.SystemFunctions.GetPrivatelinkAuthorizedEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(endpoints, func(endpoint sdk.PrivatelinkAuthorizedEndpoint) bool {
+		return endpoint.EndpointId == endpointId
+	})
+}
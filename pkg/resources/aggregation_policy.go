@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var aggregationPolicyDefinition = bodyPolicyDefinition[sdk.CreateAggregationPolicyRequest, sdk.AlterAggregationPolicyRequest, sdk.AggregationPolicy, sdk.AggregationPolicyDescription]{
+	resource:       resources.AggregationPolicy,
+	previewFeature: string(previewfeatures.AggregationPolicyResource),
+	objectType:     sdk.ObjectTypeAggregationPolicy,
+	description: joinWithSpace(
+		"Resource used to manage aggregation policy objects. For more information, check [aggregation policy documentation](https://docs.snowflake.com/en/user-guide/aggregation-policies).",
+		"Aggregation policies require the queries on the protected table or view to aggregate the data into groups of a minimum size.",
+	),
+	bodyDescription: joinWithSpace(
+		"Specifies the SQL expression that determines the restrictions of the aggregation policy. The expression has to return `AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => <integer>)` or `NO_AGGREGATION_CONSTRAINT()`, e.g. `AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)`.",
+		"The entity keys are not a part of the policy; they are specified when the policy is set on a table or a view (see `aggregation_policy.entity_key` in `snowflake_table` and `snowflake_view`).",
+	),
+
+	showOutputSchema:     schemas.ShowAggregationPolicySchema,
+	describeOutputSchema: schemas.ShowAggregationPolicyDescriptionSchema,
+	toSchema:             schemas.AggregationPolicyToSchema,
+	descriptionToSchema:  schemas.AggregationPolicyDescriptionToSchema,
+
+	client: func(client *sdk.Client) bodyPolicyClient[sdk.CreateAggregationPolicyRequest, sdk.AlterAggregationPolicyRequest, sdk.AggregationPolicy, sdk.AggregationPolicyDescription] {
+		return client.AggregationPolicies
+	},
+	newCreateRequest: sdk.NewCreateAggregationPolicyRequest,
+	withComment:      (*sdk.CreateAggregationPolicyRequest).WithComment,
+	newAlterRequest:  sdk.NewAlterAggregationPolicyRequest,
+	withRenameTo:     (*sdk.AlterAggregationPolicyRequest).WithRenameTo,
+	withSetBody:      (*sdk.AlterAggregationPolicyRequest).WithSetBody,
+	withSetComment:   (*sdk.AlterAggregationPolicyRequest).WithSetComment,
+	withUnsetComment: (*sdk.AlterAggregationPolicyRequest).WithUnsetComment,
+}
+
+func AggregationPolicy() *schema.Resource {
+	return bodyPolicyResource(aggregationPolicyDefinition)
+}
@@ -13,11 +13,13 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/util"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -47,15 +49,14 @@ var alertSchema = map[string]*schema.Schema{
 	},
 	"warehouse": {
 		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The warehouse the alert will use.",
-		ForceNew:    true,
+		Optional:    true,
+		Description: "The warehouse the alert will use. If not set, the alert uses serverless compute (Snowflake-managed compute resources).",
 	},
 	"alert_schedule": {
 		Type:        schema.TypeList,
 		Optional:    true,
 		MaxItems:    1,
-		Description: "The schedule for periodically running an alert.",
+		Description: "The schedule for periodically running an alert. If not set, the alert is triggered on new data, i.e. when new rows are added to the tables referenced in the condition.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"cron": {
@@ -108,6 +109,35 @@ var alertSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Specifies if an alert should be 'started' (enabled) after creation or should remain 'suspended' (default).",
 	},
+	"execute_trigger": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Setting or changing the value of this field runs the alert once (using `EXECUTE ALERT`), regardless of its schedule and state. The value itself is not sent to Snowflake; use e.g. a timestamp or a counter to trigger the next run. If the execution fails, the apply fails and the new value is not saved in the state, so the execution is retried in the next apply.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW ALERTS` for the given alert.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowAlertSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE ALERT` for the given alert.",
+		Elem: &schema.Resource{
+			Schema: schemas.DescribeAlertSchema,
+		},
+	},
+	ParametersAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW PARAMETERS IN ALERT` for the given alert.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowAlertParametersSchema,
+		},
+	},
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -123,7 +153,14 @@ func Alert() *schema.Resource {
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.AlertResource), TrackingUpdateWrapper(resources.Alert, UpdateAlert)),
 		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.AlertResource), TrackingDeleteWrapper(resources.Alert, deleteFunc)),
 
-		Schema: alertSchema,
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.Alert, customdiff.All(
+			ComputedIfAnyAttributeChanged(alertSchema, ShowOutputAttributeName, "comment", "warehouse", "alert_schedule", "condition", "action", "enabled"),
+			ComputedIfAnyAttributeChanged(alertSchema, DescribeOutputAttributeName, "comment", "warehouse", "alert_schedule", "condition", "action", "enabled"),
+			ComputedIfAnyAttributeChanged(alertParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllAlertParameters), strings.ToLower)...),
+			alertParametersCustomDiff,
+		)),
+
+		Schema: collections.MergeMaps(alertSchema, alertParametersSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -168,7 +205,11 @@ func ReadAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 	}
 
 	alertSchedule := alert.Schedule
-	if alertSchedule != "" {
+	if alertSchedule == "" {
+		if err := d.Set("alert_schedule", nil); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
 		if strings.Contains(alertSchedule, "MINUTE") {
 			interval, err := strconv.Atoi(strings.TrimSuffix(alertSchedule, " MINUTE"))
 			if err != nil {
@@ -221,6 +262,32 @@ func ReadAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 	if err := d.Set("action", alert.Action); err != nil {
 		return diag.FromErr(err)
 	}
+
+	alertDetails, err := client.Alerts.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	alertParameters, err := client.Alerts.ShowParameters(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := handleAlertParameterRead(d, alertParameters); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(ParametersAttributeName, []map[string]any{schemas.AlertParametersToSchema(alertParameters, meta.(*provider.Context))}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(ShowOutputAttributeName, []map[string]any{schemas.AlertToSchema(alert)}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(DescribeOutputAttributeName, []map[string]any{schemas.AlertDetailsToSchema(alertDetails)}); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
 
@@ -236,8 +303,11 @@ func CreateAlert(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	alertSchedule := getAlertSchedule(d.Get("alert_schedule"))
 
-	warehouseName := d.Get("warehouse").(string)
-	warehouse := sdk.NewAccountObjectIdentifier(warehouseName)
+	// an empty warehouse results in a serverless alert
+	var warehouse sdk.AccountObjectIdentifier
+	if v, ok := d.GetOk("warehouse"); ok {
+		warehouse = sdk.NewAccountObjectIdentifier(v.(string))
+	}
 
 	opts := &sdk.CreateAlertOptions{}
 
@@ -256,6 +326,16 @@ func CreateAlert(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
 
+	parametersSet := &sdk.AlertSet{}
+	if parametersCreateDiags := handleAlertParametersCreate(d, parametersSet); len(parametersCreateDiags) > 0 {
+		return parametersCreateDiags
+	}
+	if *parametersSet != (sdk.AlertSet{}) {
+		if err := client.Alerts.Alter(ctx, objectIdentifier, &sdk.AlterAlertOptions{Set: parametersSet}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	enabled := d.Get("enabled").(bool)
 	var diags diag.Diagnostics
 	if enabled {
@@ -268,11 +348,40 @@ func CreateAlert(ctx context.Context, d *schema.ResourceData, meta interface{})
 			})
 		}
 	}
+
+	if v, ok := d.GetOk("execute_trigger"); ok && v.(string) != "" {
+		if executeDiags := executeAlert(ctx, client, d, objectIdentifier); executeDiags.HasError() {
+			return append(diags, executeDiags...)
+		}
+	}
 	return append(diags, ReadAlert(ctx, d, meta)...)
 }
 
+// executeAlert runs the alert once with EXECUTE ALERT. When the execution fails, the previous value of execute_trigger
+// is restored, so the new value is not saved in the state and the execution is retried in the next apply.
+func executeAlert(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier) diag.Diagnostics {
+	if err := client.Alerts.Execute(ctx, id); err != nil {
+		oldTrigger, _ := d.GetChange("execute_trigger")
+		if setErr := d.Set("execute_trigger", oldTrigger); setErr != nil {
+			err = errors.Join(err, setErr)
+		}
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to execute alert",
+				Detail:   fmt.Sprintf("Alert id: %s, Err: %s", id.FullyQualifiedName(), err),
+			},
+		}
+	}
+	return nil
+}
+
+// getAlertSchedule returns the alert schedule or an empty string if the schedule is not set (alert on new data).
 func getAlertSchedule(v interface{}) string {
 	var alertSchedule string
+	if len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return alertSchedule
+	}
 	schedule := v.([]interface{})[0].(map[string]interface{})
 	if v, ok := schedule["cron"]; ok {
 		c := v.([]interface{})
@@ -309,20 +418,31 @@ func UpdateAlert(ctx context.Context, d *schema.ResourceData, meta interface{})
 		Unset: &sdk.AlertUnset{},
 	}
 	runSetStatement := false
+	runUnsetStatement := false
 
 	if d.HasChange("warehouse") {
-		runSetStatement = true
 		_, v := d.GetChange("warehouse")
 		warehouseName := v.(string)
-		warehouse := sdk.NewAccountObjectIdentifier(warehouseName)
-		opts.Set.Warehouse = &warehouse
+		if warehouseName != "" {
+			runSetStatement = true
+			warehouse := sdk.NewAccountObjectIdentifier(warehouseName)
+			opts.Set.Warehouse = &warehouse
+		} else {
+			runUnsetStatement = true
+			opts.Unset.Warehouse = sdk.Bool(true)
+		}
 	}
 
 	if d.HasChange("alert_schedule") {
-		runSetStatement = true
 		_, v := d.GetChange("alert_schedule")
 		alertSchedule := getAlertSchedule(v)
-		opts.Set.Schedule = &alertSchedule
+		if alertSchedule != "" {
+			runSetStatement = true
+			opts.Set.Schedule = &alertSchedule
+		} else {
+			runUnsetStatement = true
+			opts.Unset.Schedule = sdk.Bool(true)
+		}
 	}
 
 	if d.HasChange("comment") {
@@ -332,6 +452,12 @@ func UpdateAlert(ctx context.Context, d *schema.ResourceData, meta interface{})
 		opts.Set.Comment = &newComment
 	}
 
+	if updateParamDiags := handleAlertParametersUpdate(d, opts.Set, opts.Unset); len(updateParamDiags) > 0 {
+		return updateParamDiags
+	}
+	runSetStatement = runSetStatement || opts.Set.LogLevel != nil || opts.Set.TraceLevel != nil
+	runUnsetStatement = runUnsetStatement || opts.Unset.LogLevel != nil || opts.Unset.TraceLevel != nil
+
 	if runSetStatement {
 		setOptions := &sdk.AlterAlertOptions{Set: opts.Set}
 		err := client.Alerts.Alter(ctx, objectIdentifier, setOptions)
@@ -340,6 +466,14 @@ func UpdateAlert(ctx context.Context, d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if runUnsetStatement {
+		unsetOptions := &sdk.AlterAlertOptions{Unset: opts.Unset}
+		err := client.Alerts.Alter(ctx, objectIdentifier, unsetOptions)
+		if err != nil {
+			return diag.Errorf("error updating alert %v: %v", objectIdentifier.Name(), err)
+		}
+	}
+
 	if d.HasChange("condition") {
 		condition := d.Get("condition").(string)
 		alterOptions := &sdk.AlterAlertOptions{}
@@ -375,6 +509,12 @@ func UpdateAlert(ctx context.Context, d *schema.ResourceData, meta interface{})
 			})
 		}
 	}
+
+	if d.HasChange("execute_trigger") && d.Get("execute_trigger").(string) != "" {
+		if executeDiags := executeAlert(ctx, client, d, objectIdentifier); executeDiags.HasError() {
+			return append(diags, executeDiags...)
+		}
+	}
 	return append(diags, ReadAlert(ctx, d, meta)...)
 }
 
@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	alertParametersSchema     = make(map[string]*schema.Schema)
+	alertParametersCustomDiff = ParametersCustomDiff(
+		alertParametersProvider,
+		parameter[sdk.AlertParameter]{sdk.AlertParameterLogLevel, valueTypeString, sdk.ParameterTypeAlert},
+		parameter[sdk.AlertParameter]{sdk.AlertParameterTraceLevel, valueTypeString, sdk.ParameterTypeAlert},
+	)
+)
+
+func init() {
+	alertParameterFields := []parameterDef[sdk.AlertParameter]{
+		{Name: sdk.AlertParameterLogLevel, Type: schema.TypeString, ValidateDiag: sdkValidation(sdk.ToLogLevel), DiffSuppress: NormalizeAndCompare(sdk.ToLogLevel), Description: "LOG_LEVEL to use when filtering events"},
+		{Name: sdk.AlertParameterTraceLevel, Type: schema.TypeString, ValidateDiag: sdkValidation(sdk.ToTraceLevel), DiffSuppress: NormalizeAndCompare(sdk.ToTraceLevel), Description: "Trace level value to use when generating/filtering trace events"},
+	}
+
+	for _, field := range alertParameterFields {
+		fieldName := strings.ToLower(string(field.Name))
+
+		alertParametersSchema[fieldName] = &schema.Schema{
+			Type:             field.Type,
+			Description:      enrichWithReferenceToParameterDocs(field.Name, field.Description),
+			Computed:         true,
+			Optional:         true,
+			ValidateDiagFunc: field.ValidateDiag,
+			DiffSuppressFunc: field.DiffSuppress,
+			ConflictsWith:    field.ConflictsWith,
+		}
+	}
+}
+
+func alertParametersProvider(ctx context.Context, d ResourceIdProvider, meta any) ([]*sdk.Parameter, error) {
+	return parametersProvider(ctx, d, meta.(*provider.Context), alertParametersProviderFunc, helpers.DecodeSnowflakeIDErrLegacy[sdk.SchemaObjectIdentifier])
+}
+
+func alertParametersProviderFunc(c *sdk.Client) showParametersFunc[sdk.SchemaObjectIdentifier] {
+	return c.Alerts.ShowParameters
+}
+
+func handleAlertParameterRead(d *schema.ResourceData, alertParameters []*sdk.Parameter) error {
+	for _, p := range alertParameters {
+		switch p.Key {
+		case
+			string(sdk.AlertParameterLogLevel),
+			string(sdk.AlertParameterTraceLevel):
+			if err := d.Set(strings.ToLower(p.Key), p.Value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// They are not available in CREATE ALERT, that's why they are set in alter
+func handleAlertParametersCreate(d *schema.ResourceData, set *sdk.AlertSet) diag.Diagnostics {
+	return JoinDiags(
+		handleParameterCreateWithMapping(d, sdk.AlertParameterLogLevel, &set.LogLevel, stringToStringEnumProvider(sdk.ToLogLevel)),
+		handleParameterCreateWithMapping(d, sdk.AlertParameterTraceLevel, &set.TraceLevel, stringToStringEnumProvider(sdk.ToTraceLevel)),
+	)
+}
+
+func handleAlertParametersUpdate(d *schema.ResourceData, set *sdk.AlertSet, unset *sdk.AlertUnset) diag.Diagnostics {
+	return JoinDiags(
+		handleParameterUpdateWithMapping(d, sdk.AlertParameterLogLevel, &set.LogLevel, &unset.LogLevel, stringToStringEnumProvider(sdk.ToLogLevel)),
+		handleParameterUpdateWithMapping(d, sdk.AlertParameterTraceLevel, &set.TraceLevel, &unset.TraceLevel, stringToStringEnumProvider(sdk.ToTraceLevel)),
+	)
+}
@@ -0,0 +1,222 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var backupPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the backup policy; must be unique for the database and schema in which the backup policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the backup policy."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the backup policy."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"with_retention_lock": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		ForceNew: true,
+		Description: joinWithSpace(
+			"Specifies whether the backups created with this policy are protected by a retention lock. Backups under a retention lock can't be deleted before they expire, even by privileged roles.",
+			"The retention lock can't be removed after the policy is created, so changing this field recreates the resource.",
+		),
+	},
+	"schedule": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the schedule for creating backups, e.g. `60 MINUTE`, `12 HOUR`, or `USING CRON 0 0 * * * UTC`. When not set, backups are only created manually.",
+	},
+	"expire_after_days": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		Description:      "Specifies the number of days after which the backups are expired. When not set, backups don't expire.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the backup policy.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW BACKUP POLICIES` for the given backup policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowBackupPolicySchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func BackupPolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.BackupPolicies.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.BackupPolicyResource), TrackingCreateWrapper(resources.BackupPolicy, CreateBackupPolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.BackupPolicyResource), TrackingReadWrapper(resources.BackupPolicy, ReadBackupPolicy)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.BackupPolicyResource), TrackingUpdateWrapper(resources.BackupPolicy, UpdateBackupPolicy)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.BackupPolicyResource), TrackingDeleteWrapper(resources.BackupPolicy, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage backup policy objects. For more information, check [backup documentation](https://docs.snowflake.com/en/user-guide/backups).",
+			"Backup policies define the schedule and the retention of the backups in a backup set. To apply the policy, use `backup_policy` in `snowflake_backup_set`.",
+		),
+
+		Schema: backupPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.BackupPolicy, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+		Timeouts: defaultTimeouts,
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.BackupPolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(backupPolicySchema, ShowOutputAttributeName, "name", "schedule", "expire_after_days", "comment"),
+			ComputedIfAnyAttributeChanged(backupPolicySchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+	}
+}
+
+func CreateBackupPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateBackupPolicyRequest(id)
+	if d.Get("with_retention_lock").(bool) {
+		request.WithWithRetentionLock(true)
+	}
+	errs := errors.Join(
+		stringAttributeCreateBuilder(d, "schedule", request.WithSchedule),
+		intAttributeWithSpecialDefaultCreateBuilder(d, "expire_after_days", request.WithExpireAfterDays),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.BackupPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating backup policy %s, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadBackupPolicy(ctx, d, meta)
+}
+
+func ReadBackupPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy, err := client.BackupPolicies.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query backup policy. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Backup policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	schedule := ""
+	if policy.Schedule != nil {
+		schedule = *policy.Schedule
+	}
+	expireAfterDays := IntDefault
+	if policy.ExpireAfterDays != nil {
+		expireAfterDays = *policy.ExpireAfterDays
+	}
+
+	errs := errors.Join(
+		d.Set("with_retention_lock", policy.HasRetentionLock),
+		d.Set("schedule", schedule),
+		d.Set("expire_after_days", expireAfterDays),
+		d.Set("comment", policy.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.BackupPolicyToSchema(policy)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateBackupPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.BackupPolicies.Alter(ctx, sdk.NewAlterBackupPolicyRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming backup policy %s, err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	set, unset := sdk.NewBackupPolicySetRequest(), sdk.NewBackupPolicyUnsetRequest()
+	errs := errors.Join(
+		stringAttributeUpdate(d, "schedule", &set.Schedule, &unset.Schedule),
+		intAttributeWithSpecialDefaultUpdate(d, "expire_after_days", &set.ExpireAfterDays, &unset.ExpireAfterDays),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if !reflect.DeepEqual(*set, *sdk.NewBackupPolicySetRequest()) {
+		if err := client.BackupPolicies.Alter(ctx, sdk.NewAlterBackupPolicyRequest(id).WithSet(*set)); err != nil {
+			d.Partial(true)
+			return diag.FromErr(fmt.Errorf("error updating backup policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unset, *sdk.NewBackupPolicyUnsetRequest()) {
+		if err := client.BackupPolicies.Alter(ctx, sdk.NewAlterBackupPolicyRequest(id).WithUnset(*unset)); err != nil {
+			d.Partial(true)
+			return diag.FromErr(fmt.Errorf("error updating backup policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	return ReadBackupPolicy(ctx, d, meta)
+}
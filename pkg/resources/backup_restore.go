@@ -0,0 +1,212 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var backupRestoreTargetFields = []string{"restored_database", "restored_schema", "restored_table"}
+
+var backupRestoreSchema = map[string]*schema.Schema{
+	"backup_set": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription(fmt.Sprintf("Specifies the backup set containing the backup to restore. %s", exampleSchemaObjectIdentifier("backup_set")), resources.BackupSet),
+	},
+	"backup_id": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: isNotEqualTo("", "backup_id must not be empty"),
+		Description:      "Specifies the identifier of the backup to restore. The available backups can be listed with the `snowflake_backups` data source.",
+	},
+	"restored_database": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     backupRestoreTargetFields,
+		Description:      "Specifies the identifier of the new database created from a database backup.",
+	},
+	"restored_schema": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     backupRestoreTargetFields,
+		Description:      fmt.Sprintf("Specifies the identifier of the new schema created from a schema backup. %s", exampleDatabaseObjectIdentifier("schema")),
+	},
+	"restored_table": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     backupRestoreTargetFields,
+		Description:      fmt.Sprintf("Specifies the identifier of the new table created from a table backup. %s", exampleSchemaObjectIdentifier("table")),
+	},
+}
+
+func BackupRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.BackupRestoreResource), TrackingCreateWrapper(resources.BackupRestore, CreateBackupRestore)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.BackupRestoreResource), TrackingReadWrapper(resources.BackupRestore, ReadBackupRestore)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.BackupRestoreResource), TrackingDeleteWrapper(resources.BackupRestore, DeleteBackupRestore)),
+		Description: joinWithSpace(
+			"Resource used to restore a database, schema, or table from a backup. For more information, check [backup documentation](https://docs.snowflake.com/en/user-guide/backups).",
+			"The restore creates a new object; the resource manages its lifecycle, so removing the resource drops the restored object.",
+			"To keep the restored object under Terraform management after the restore, import it into the matching resource and remove this resource from the state.",
+		),
+
+		Schema:   backupRestoreSchema,
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateBackupRestore(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	backupSetId, err := sdk.ParseSchemaObjectIdentifier(d.Get("backup_set").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := sdk.NewRestoreBackupSetRequest(backupSetId, d.Get("backup_id").(string))
+
+	var objectType sdk.ObjectType
+	var objectName string
+	switch {
+	case d.Get("restored_database").(string) != "":
+		id, err := sdk.ParseAccountObjectIdentifier(d.Get("restored_database").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithDatabase(id)
+		objectType, objectName = sdk.ObjectTypeDatabase, id.FullyQualifiedName()
+	case d.Get("restored_schema").(string) != "":
+		id, err := sdk.ParseDatabaseObjectIdentifier(d.Get("restored_schema").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithSchema(id)
+		objectType, objectName = sdk.ObjectTypeSchema, id.FullyQualifiedName()
+	case d.Get("restored_table").(string) != "":
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Get("restored_table").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithTable(id)
+		objectType, objectName = sdk.ObjectTypeTable, id.FullyQualifiedName()
+	}
+
+	if err := client.BackupSets.Restore(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error restoring %s %s from backup set %s, err = %w", objectType, objectName, backupSetId.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(objectType.String(), objectName))
+	return ReadBackupRestore(ctx, d, meta)
+}
+
+func parseBackupRestoreId(id string) (sdk.ObjectType, string, error) {
+	parts := helpers.ParseResourceIdentifier(id)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("unexpected number of parts in backup restore id %s, expected 2, got %d", id, len(parts))
+	}
+	return sdk.ObjectType(parts[0]), parts[1], nil
+}
+
+func ReadBackupRestore(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	objectType, objectName, err := parseBackupRestoreId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	switch objectType {
+	case sdk.ObjectTypeDatabase:
+		id, parseErr := sdk.ParseAccountObjectIdentifier(objectName)
+		if parseErr != nil {
+			return diag.FromErr(parseErr)
+		}
+		_, err = client.Databases.ShowByIDSafely(ctx, id)
+	case sdk.ObjectTypeSchema:
+		id, parseErr := sdk.ParseDatabaseObjectIdentifier(objectName)
+		if parseErr != nil {
+			return diag.FromErr(parseErr)
+		}
+		_, err = client.Schemas.ShowByIDSafely(ctx, id)
+	case sdk.ObjectTypeTable:
+		id, parseErr := sdk.ParseSchemaObjectIdentifier(objectName)
+		if parseErr != nil {
+			return diag.FromErr(parseErr)
+		}
+		_, err = client.Tables.ShowByIDSafely(ctx, id)
+	default:
+		return diag.FromErr(fmt.Errorf("unsupported object type %s in backup restore id %s", objectType, d.Id()))
+	}
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query restored object. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Object type: %s, object name: %s, Err: %s", objectType, objectName, err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func DeleteBackupRestore(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	objectType, objectName, err := parseBackupRestoreId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	switch objectType {
+	case sdk.ObjectTypeDatabase:
+		id, parseErr := sdk.ParseAccountObjectIdentifier(objectName)
+		if parseErr != nil {
+			return diag.FromErr(parseErr)
+		}
+		err = client.Databases.DropSafely(ctx, id)
+	case sdk.ObjectTypeSchema:
+		id, parseErr := sdk.ParseDatabaseObjectIdentifier(objectName)
+		if parseErr != nil {
+			return diag.FromErr(parseErr)
+		}
+		err = client.Schemas.DropSafely(ctx, id)
+	case sdk.ObjectTypeTable:
+		id, parseErr := sdk.ParseSchemaObjectIdentifier(objectName)
+		if parseErr != nil {
+			return diag.FromErr(parseErr)
+		}
+		err = client.Tables.DropSafely(ctx, id)
+	default:
+		return diag.FromErr(fmt.Errorf("unsupported object type %s in backup restore id %s", objectType, d.Id()))
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error dropping restored %s %s, err = %w", objectType, objectName, err))
+	}
+
+	d.SetId("")
+	return nil
+}
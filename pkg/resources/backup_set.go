@@ -0,0 +1,262 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var backupSetForFields = []string{"for_database", "for_schema", "for_table"}
+
+var backupSetSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the backup set; must be unique for the database and schema in which the backup set is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the backup set."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the backup set."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"for_database": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     backupSetForFields,
+		Description:      relatedResourceDescription("Specifies the database backed up by the backup set.", resources.Database),
+	},
+	"for_schema": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     backupSetForFields,
+		Description:      relatedResourceDescription(fmt.Sprintf("Specifies the schema backed up by the backup set. %s", exampleDatabaseObjectIdentifier("schema")), resources.Schema),
+	},
+	"for_table": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     backupSetForFields,
+		Description:      relatedResourceDescription(fmt.Sprintf("Specifies the table backed up by the backup set. %s", exampleSchemaObjectIdentifier("table")), resources.Table),
+	},
+	"backup_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description: relatedResourceDescription(joinWithSpace(
+			"Specifies the backup policy that defines the schedule and the retention of the backups in the set.",
+			exampleSchemaObjectIdentifier("backup_policy"),
+			"Changing the policy applies the new one to the backup set. Backup policies can't be removed from a backup set, so removing this field recreates the resource.",
+		), resources.BackupPolicy),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the backup set.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW BACKUP SETS` for the given backup set.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowBackupSetSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func BackupSet() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.BackupSets.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.BackupSetResource), TrackingCreateWrapper(resources.BackupSet, CreateBackupSet)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.BackupSetResource), TrackingReadWrapper(resources.BackupSet, ReadBackupSet)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.BackupSetResource), TrackingUpdateWrapper(resources.BackupSet, UpdateBackupSet)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.BackupSetResource), TrackingDeleteWrapper(resources.BackupSet, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage backup set objects. For more information, check [backup documentation](https://docs.snowflake.com/en/user-guide/backups).",
+			"A backup set holds the backups of a single database, schema, or table. To list the backups, use the `snowflake_backups` data source. To restore a backup, use `snowflake_backup_restore`.",
+		),
+
+		Schema: backupSetSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.BackupSet, ImportBackupSet),
+		},
+		Timeouts: defaultTimeouts,
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.BackupSet, customdiff.All(
+			ForceNewIfChangeToEmptyString("backup_policy"),
+			ComputedIfAnyAttributeChanged(backupSetSchema, ShowOutputAttributeName, "backup_policy", "comment"),
+		)),
+	}
+}
+
+func ImportBackupSet(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ImportName[sdk.SchemaObjectIdentifier](ctx, d, nil); err != nil {
+		return nil, err
+	}
+
+	backupSet, err := client.BackupSets.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch sdk.ObjectType(backupSet.ObjectKind) {
+	case sdk.ObjectTypeDatabase:
+		err = d.Set("for_database", sdk.NewAccountObjectIdentifier(backupSet.ObjectName).FullyQualifiedName())
+	case sdk.ObjectTypeSchema:
+		if backupSet.ObjectDatabaseName == nil {
+			return nil, fmt.Errorf("missing database name of the schema backed up by the backup set %s", id.FullyQualifiedName())
+		}
+		err = d.Set("for_schema", sdk.NewDatabaseObjectIdentifier(*backupSet.ObjectDatabaseName, backupSet.ObjectName).FullyQualifiedName())
+	case sdk.ObjectTypeTable:
+		if backupSet.ObjectDatabaseName == nil || backupSet.ObjectSchemaName == nil {
+			return nil, fmt.Errorf("missing database or schema name of the table backed up by the backup set %s", id.FullyQualifiedName())
+		}
+		err = d.Set("for_table", sdk.NewSchemaObjectIdentifier(*backupSet.ObjectDatabaseName, *backupSet.ObjectSchemaName, backupSet.ObjectName).FullyQualifiedName())
+	default:
+		err = fmt.Errorf("unsupported object kind %s of the backup set %s", backupSet.ObjectKind, id.FullyQualifiedName())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateBackupSet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateBackupSetRequest(id)
+	errs := errors.Join(
+		attributeMappedValueCreateBuilder(d, "for_database", request.WithForDatabase, sdk.ParseAccountObjectIdentifier),
+		attributeMappedValueCreateBuilder(d, "for_schema", request.WithForSchema, sdk.ParseDatabaseObjectIdentifier),
+		attributeMappedValueCreateBuilder(d, "for_table", request.WithForTable, sdk.ParseSchemaObjectIdentifier),
+		attributeMappedValueCreateBuilder(d, "backup_policy", request.WithBackupPolicy, sdk.ParseSchemaObjectIdentifier),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.BackupSets.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating backup set %s, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadBackupSet(ctx, d, meta)
+}
+
+func ReadBackupSet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupSet, err := client.BackupSets.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query backup set. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Backup set id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	backupPolicy := ""
+	if backupSet.BackupPolicyName != nil && backupSet.BackupPolicyDatabaseName != nil && backupSet.BackupPolicySchemaName != nil {
+		backupPolicy = sdk.NewSchemaObjectIdentifier(*backupSet.BackupPolicyDatabaseName, *backupSet.BackupPolicySchemaName, *backupSet.BackupPolicyName).FullyQualifiedName()
+	}
+
+	errs := errors.Join(
+		d.Set("backup_policy", backupPolicy),
+		d.Set("comment", backupSet.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.BackupSetToSchema(backupSet)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateBackupSet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Removing the backup policy recreates the resource (see CustomizeDiff), so only a new policy is handled here.
+	if d.HasChange("backup_policy") {
+		if v, ok := d.GetOk("backup_policy"); ok {
+			policyId, err := sdk.ParseSchemaObjectIdentifier(v.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(id).WithApplyBackupPolicy(policyId)); err != nil {
+				return diag.FromErr(fmt.Errorf("error applying backup policy to backup set %s, err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("comment") {
+		request := sdk.NewAlterBackupSetRequest(id)
+		if v, ok := d.GetOk("comment"); ok {
+			request.WithSetComment(v.(string))
+		} else {
+			request.WithUnsetComment(true)
+		}
+		if err := client.BackupSets.Alter(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating backup set %s, err = %w", d.Id(), err))
+		}
+	}
+
+	return ReadBackupSet(ctx, d, meta)
+}
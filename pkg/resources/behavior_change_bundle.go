@@ -0,0 +1,184 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var behaviorChangeBundleSchema = map[string]*schema.Schema{
+	"name": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "Name of the behavior change bundle, e.g. `2025_01`. Check [behavior change release notes](https://docs.snowflake.com/en/release-notes/behavior-changes) for the list of available bundles.",
+		ValidateFunc: validation.StringIsNotEmpty,
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies whether the behavior change bundle should be enabled (`SYSTEM$ENABLE_BEHAVIOR_CHANGE_BUNDLE`) or disabled (`SYSTEM$DISABLE_BEHAVIOR_CHANGE_BUNDLE`) in the current account.",
+	},
+	"status": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Status of the behavior change bundle as returned by `SYSTEM$BEHAVIOR_CHANGE_BUNDLE_STATUS`. Possible values are: `ENABLED` | `DISABLED` | `RELEASED`.",
+	},
+	"is_default": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Specifies whether the behavior change bundle is enabled by default in the current account. It is based on the output of `SYSTEM$SHOW_ACTIVE_BEHAVIOR_CHANGE_BUNDLES`; it is false for bundles that are no longer active (e.g. released ones).",
+	},
+}
+
+func BehaviorChangeBundle() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.BehaviorChangeBundleResource), TrackingCreateWrapper(resources.BehaviorChangeBundle, CreateBehaviorChangeBundle)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.BehaviorChangeBundleResource), TrackingReadWrapper(resources.BehaviorChangeBundle, ReadBehaviorChangeBundle)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.BehaviorChangeBundleResource), TrackingUpdateWrapper(resources.BehaviorChangeBundle, UpdateBehaviorChangeBundle)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.BehaviorChangeBundleResource), TrackingDeleteWrapper(resources.BehaviorChangeBundle, DeleteBehaviorChangeBundle)),
+		Description: joinWithSpace(
+			"Resource used to manage the state of a [behavior change bundle](https://docs.snowflake.com/en/release-notes/behavior-changes) in the current account.",
+			"Removing the resource from the configuration restores the default state of the bundle (enabled for bundles enabled by default, disabled otherwise).",
+			"Released bundles cannot be disabled; in such a case, the resource should be removed from the configuration.",
+			"To manage bundles in a different account, use a provider alias.",
+			"Consult the [Snowflake BCR migration guide](https://github.com/snowflakedb/terraform-provider-snowflake/blob/main/SNOWFLAKE_BCR_MIGRATION_GUIDE.md) for changes in the provider behavior after enabling given bundles.",
+		),
+
+		Schema: behaviorChangeBundleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.BehaviorChangeBundle, ImportBehaviorChangeBundle),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportBehaviorChangeBundle(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+
+	status, err := client.SystemFunctions.BehaviorChangeBundleStatus(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := errors.Join(
+		d.Set("name", d.Id()),
+		d.Set("enabled", status != sdk.BehaviorChangeBundleStatusDisabled),
+	); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateBehaviorChangeBundle(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	name := d.Get("name").(string)
+
+	if err := setBehaviorChangeBundleEnabled(ctx, client, name, d.Get("enabled").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(name)
+
+	return ReadBehaviorChangeBundle(ctx, d, meta)
+}
+
+func ReadBehaviorChangeBundle(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	name := d.Id()
+
+	status, err := client.SystemFunctions.BehaviorChangeBundleStatus(ctx, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bundle, err := findActiveBehaviorChangeBundle(ctx, client, name)
+	if err != nil && !errors.Is(err, collections.ErrObjectNotFound) {
+		return diag.FromErr(err)
+	}
+	isDefault := bundle != nil && bundle.IsDefault
+
+	if err := errors.Join(
+		d.Set("name", name),
+		d.Set("enabled", status != sdk.BehaviorChangeBundleStatusDisabled),
+		d.Set("status", string(status)),
+		d.Set("is_default", isDefault),
+	); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if status == sdk.BehaviorChangeBundleStatusReleased {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "Behavior change bundle has been released.",
+				Detail:   fmt.Sprintf("Behavior change bundle %s is released and it is always enabled. It can no longer be managed, so the resource can be safely removed from the configuration.", name),
+			},
+		}
+	}
+
+	return nil
+}
+
+func UpdateBehaviorChangeBundle(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	if d.HasChange("enabled") {
+		if err := setBehaviorChangeBundleEnabled(ctx, client, d.Id(), d.Get("enabled").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadBehaviorChangeBundle(ctx, d, meta)
+}
+
+// DeleteBehaviorChangeBundle restores the default state of the bundle. Bundles that are no longer active (e.g. released) are left untouched.
+func DeleteBehaviorChangeBundle(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	name := d.Id()
+
+	bundle, err := findActiveBehaviorChangeBundle(ctx, client, name)
+	if err != nil {
+		if errors.Is(err, collections.ErrObjectNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if bundle.IsEnabled != bundle.IsDefault {
+		if err := setBehaviorChangeBundleEnabled(ctx, client, name, bundle.IsDefault); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func setBehaviorChangeBundleEnabled(ctx context.Context, client *sdk.Client, name string, enabled bool) error {
+	if enabled {
+		return client.SystemFunctions.EnableBehaviorChangeBundle(ctx, name)
+	}
+	return client.SystemFunctions.DisableBehaviorChangeBundle(ctx, name)
+}
+
+func findActiveBehaviorChangeBundle(ctx context.Context, client *sdk.Client, name string) (*sdk.BehaviorChangeBundleInfo, error) {
+	bundles, err := client.SystemFunctions.ShowActiveBehaviorChangeBundles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(bundles, func(bundle sdk.BehaviorChangeBundleInfo) bool {
+		return bundle.Name == name
+	})
+}
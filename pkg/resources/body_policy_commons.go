@@ -0,0 +1,239 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// bodyPolicyClient is the part of the SDK interfaces shared by the policies consisting only of a body and a comment
+// (aggregation, join, privacy, and projection policies).
+type bodyPolicyClient[CreateRequest, AlterRequest, Policy, Description any] interface {
+	Create(ctx context.Context, request *CreateRequest) error
+	Alter(ctx context.Context, request *AlterRequest) error
+	DropSafely(ctx context.Context, id sdk.SchemaObjectIdentifier) error
+	ShowByIDSafely(ctx context.Context, id sdk.SchemaObjectIdentifier) (*Policy, error)
+	Describe(ctx context.Context, id sdk.SchemaObjectIdentifier) (*Description, error)
+}
+
+// bodyPolicyDefinition holds everything that differs between the resources of the policies consisting only of a body and a comment.
+// The request builders are passed as method expressions, e.g. (*sdk.AlterAggregationPolicyRequest).WithSetBody.
+type bodyPolicyDefinition[CreateRequest, AlterRequest, Policy, Description any] struct {
+	resource       resources.Resource
+	previewFeature string
+	objectType     sdk.ObjectType
+	// description is the description of the whole resource
+	description string
+	// bodyDescription describes what the body has to return, with an example
+	bodyDescription string
+
+	showOutputSchema     map[string]*schema.Schema
+	describeOutputSchema map[string]*schema.Schema
+	toSchema             func(*Policy) map[string]any
+	descriptionToSchema  func(*Description) map[string]any
+
+	client           func(*sdk.Client) bodyPolicyClient[CreateRequest, AlterRequest, Policy, Description]
+	newCreateRequest func(sdk.SchemaObjectIdentifier, string) *CreateRequest
+	withComment      func(*CreateRequest, string) *CreateRequest
+	newAlterRequest  func(sdk.SchemaObjectIdentifier) *AlterRequest
+	withRenameTo     func(*AlterRequest, sdk.SchemaObjectIdentifier) *AlterRequest
+	withSetBody      func(*AlterRequest, string) *AlterRequest
+	withSetComment   func(*AlterRequest, string) *AlterRequest
+	withUnsetComment func(*AlterRequest, bool) *AlterRequest
+}
+
+// objectName returns the name of the policy used in the descriptions and the errors, e.g. "aggregation policy".
+func (def bodyPolicyDefinition[CreateRequest, AlterRequest, Policy, Description]) objectName() string {
+	return strings.ToLower(def.objectType.String())
+}
+
+func (def bodyPolicyDefinition[CreateRequest, AlterRequest, Policy, Description]) schema() map[string]*schema.Schema {
+	objectName := def.objectName()
+	return map[string]*schema.Schema{
+		"name": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      blocklistedCharactersFieldDescription(fmt.Sprintf("Specifies the identifier for the %[1]s; must be unique for the database and schema in which the %[1]s is created.", objectName)),
+			DiffSuppressFunc: suppressIdentifierQuoting,
+		},
+		"database": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      blocklistedCharactersFieldDescription(fmt.Sprintf("The database in which to create the %s.", objectName)),
+			ForceNew:         true,
+			DiffSuppressFunc: suppressIdentifierQuoting,
+		},
+		"schema": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      blocklistedCharactersFieldDescription(fmt.Sprintf("The schema in which to create the %s.", objectName)),
+			ForceNew:         true,
+			DiffSuppressFunc: suppressIdentifierQuoting,
+		},
+		"body": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      diffSuppressStatementFieldDescription(def.bodyDescription),
+			DiffSuppressFunc: DiffSuppressStatement,
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Specifies a comment for the %s.", objectName),
+		},
+		ShowOutputAttributeName: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("Outputs the result of `SHOW %s` for the given %s.", def.objectType.Plural(), objectName),
+			Elem: &schema.Resource{
+				Schema: def.showOutputSchema,
+			},
+		},
+		DescribeOutputAttributeName: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("Outputs the result of `DESCRIBE %s` for the given %s.", def.objectType, objectName),
+			Elem: &schema.Resource{
+				Schema: def.describeOutputSchema,
+			},
+		},
+		FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	}
+}
+
+// bodyPolicyResource builds the resource of one of the policies consisting only of a body and a comment.
+func bodyPolicyResource[CreateRequest, AlterRequest, Policy, Description any](def bodyPolicyDefinition[CreateRequest, AlterRequest, Policy, Description]) *schema.Resource {
+	policySchema := def.schema()
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return def.client(client).DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(def.previewFeature, TrackingCreateWrapper(def.resource, def.create)),
+		ReadContext:   PreviewFeatureReadContextWrapper(def.previewFeature, TrackingReadWrapper(def.resource, def.read)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(def.previewFeature, TrackingUpdateWrapper(def.resource, def.update)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(def.previewFeature, TrackingDeleteWrapper(def.resource, deleteFunc)),
+		Description:   def.description,
+
+		Schema: policySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(def.resource, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+		Timeouts: defaultTimeouts,
+
+		CustomizeDiff: TrackingCustomDiffWrapper(def.resource, customdiff.All(
+			ComputedIfAnyAttributeChanged(policySchema, ShowOutputAttributeName, "comment", "name"),
+			ComputedIfAnyAttributeChanged(policySchema, DescribeOutputAttributeName, "body", "name"),
+			ComputedIfAnyAttributeChanged(policySchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+	}
+}
+
+func (def bodyPolicyDefinition[CreateRequest, AlterRequest, Policy, Description]) create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := def.client(meta.(*provider.Context).Client)
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	request := def.newCreateRequest(id, d.Get("body").(string))
+	if err := stringAttributeCreateBuilder(d, "comment", func(comment string) *CreateRequest { return def.withComment(request, comment) }); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating %s %s, err = %w", def.objectName(), id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return def.read(ctx, d, meta)
+}
+
+func (def bodyPolicyDefinition[CreateRequest, AlterRequest, Policy, Description]) read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := def.client(meta.(*provider.Context).Client)
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy, err := client.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			objectName := def.objectName()
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Failed to query %s. Marking the resource as removed.", objectName),
+					Detail:   fmt.Sprintf("%s%s id: %s, Err: %s", strings.ToUpper(objectName[:1]), objectName[1:], id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	description, err := client.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	showOutput := def.toSchema(policy)
+	describeOutput := def.descriptionToSchema(description)
+	errs := errors.Join(
+		d.Set("body", describeOutput["body"]),
+		d.Set("comment", showOutput["comment"]),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{showOutput}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{describeOutput}),
+	)
+	return diag.FromErr(errs)
+}
+
+func (def bodyPolicyDefinition[CreateRequest, AlterRequest, Policy, Description]) update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := def.client(meta.(*provider.Context).Client)
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.Alter(ctx, def.withRenameTo(def.newAlterRequest(id), newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming %s %s, err = %w", def.objectName(), d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("body") {
+		if err := client.Alter(ctx, def.withSetBody(def.newAlterRequest(id), d.Get("body").(string))); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating body of %s %s, err = %w", def.objectName(), d.Id(), err))
+		}
+	}
+
+	if d.HasChange("comment") {
+		request := def.newAlterRequest(id)
+		if comment := d.Get("comment").(string); comment == "" {
+			def.withUnsetComment(request, true)
+		} else {
+			def.withSetComment(request, comment)
+		}
+		if err := client.Alter(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating comment of %s %s, err = %w", def.objectName(), d.Id(), err))
+		}
+	}
+
+	return def.read(ctx, d, meta)
+}
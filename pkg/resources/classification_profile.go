@@ -0,0 +1,387 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var classificationProfileSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the classification profile; must be unique for the database and schema in which the classification profile is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the classification profile."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the classification profile."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"minimum_object_age_for_classification_days": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		Description:      "Specifies the number of days that must pass since the object was created before it is automatically classified. Removing the field from the config recreates the resource.",
+	},
+	"maximum_classification_validity_days": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		Description:      "Specifies the number of days after which an already classified object is classified again. Removing the field from the config recreates the resource.",
+	},
+	"auto_tag": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the system tags and the tags from `tag_map` are automatically applied to the classified columns.",
+	},
+	"tag_map": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies the mapping between the semantic categories and the user-defined tags applied to the classified columns.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"column_tag_map": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Description: "Specifies the tags applied to the columns classified with the given semantic categories.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"tag_name": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+								DiffSuppressFunc: suppressIdentifierQuoting,
+								Description:      relatedResourceDescription("Specifies the fully qualified name of the tag.", resources.Tag),
+							},
+							"tag_value": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Specifies the value of the tag. When not set, the name of the semantic category is used.",
+							},
+							"semantic_categories": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Specifies the semantic categories (e.g. `NAME`, `EMAIL`) of the columns to which the tag is applied. When not set, the tag is applied to all classified columns.",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	"custom_classifiers": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Description: joinWithSpace(
+			"Specifies the custom classifiers used in addition to the system classifiers.",
+			"Snowflake does not return the custom classifiers of the classification profile, so the provider is not able to detect external changes of this field.",
+		),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"label": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the label under which the custom classifier is referenced in the classification profile.",
+				},
+				"classifier": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      "Specifies the fully qualified name of the `SNOWFLAKE.DATA_PRIVACY.CUSTOM_CLASSIFIER` instance.",
+				},
+			},
+		},
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW SNOWFLAKE.DATA_PRIVACY.CLASSIFICATION_PROFILE` for the given classification profile.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowClassificationProfileSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func ClassificationProfile() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.ClassificationProfiles.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ClassificationProfileResource), TrackingCreateWrapper(resources.ClassificationProfile, CreateClassificationProfile)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ClassificationProfileResource), TrackingReadWrapper(resources.ClassificationProfile, ReadClassificationProfile)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ClassificationProfileResource), TrackingUpdateWrapper(resources.ClassificationProfile, UpdateClassificationProfile)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ClassificationProfileResource), TrackingDeleteWrapper(resources.ClassificationProfile, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage classification profile objects. For more information, check [classification profile documentation](https://docs.snowflake.com/en/sql-reference/classes/classification_profile).",
+			"Classification profiles control the automatic sensitive data classification. To apply the profile, use `classification_profile` in `snowflake_database` or `snowflake_schema`.",
+		),
+
+		Schema: classificationProfileSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.ClassificationProfile, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+		Timeouts: defaultTimeouts,
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.ClassificationProfile, customdiff.All(
+			ComputedIfAnyAttributeChanged(classificationProfileSchema, ShowOutputAttributeName, "minimum_object_age_for_classification_days", "maximum_classification_validity_days", "auto_tag", "tag_map", "custom_classifiers"),
+			ComputedIfAnyAttributeChanged(classificationProfileSchema, FullyQualifiedNameAttributeName, "name"),
+			// The classification profile methods don't allow unsetting the validity settings, so the profile has to be recreated.
+			customdiff.ForceNewIfChange("minimum_object_age_for_classification_days", func(ctx context.Context, oldValue, newValue, meta any) bool {
+				return newValue.(int) == IntDefault
+			}),
+			customdiff.ForceNewIfChange("maximum_classification_validity_days", func(ctx context.Context, oldValue, newValue, meta any) bool {
+				return newValue.(int) == IntDefault
+			}),
+		)),
+	}
+}
+
+func CreateClassificationProfile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	config := sdk.ClassificationProfileConfig{
+		AutoTag: sdk.Bool(d.Get("auto_tag").(bool)),
+	}
+	if v := d.Get("minimum_object_age_for_classification_days").(int); v != IntDefault {
+		config.MinimumObjectAgeForClassificationDays = sdk.Int(v)
+	}
+	if v := d.Get("maximum_classification_validity_days").(int); v != IntDefault {
+		config.MaximumClassificationValidityDays = sdk.Int(v)
+	}
+	tagMap, err := classificationProfileTagMapFromConfig(d.Get("tag_map").([]any))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	config.TagMap = tagMap
+	customClassifiers, err := classificationProfileCustomClassifiersFromConfig(d.Get("custom_classifiers").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	config.CustomClassifiers = customClassifiers
+
+	if err := client.ClassificationProfiles.Create(ctx, sdk.NewCreateClassificationProfileRequestWithConfig(id, config)); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating classification profile %s, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadClassificationProfile(ctx, d, meta)
+}
+
+func ReadClassificationProfile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	classificationProfile, err := client.ClassificationProfiles.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query classification profile. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Classification profile id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	details, err := client.ClassificationProfiles.DescribeDetails(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The validity settings are read only when they were set in the config; otherwise, the defaults returned by Snowflake would cause a permanent diff.
+	minimumObjectAge := d.Get("minimum_object_age_for_classification_days").(int)
+	if minimumObjectAge != IntDefault && details.MinimumObjectAgeForClassificationDays != nil {
+		minimumObjectAge = *details.MinimumObjectAgeForClassificationDays
+	}
+	maximumValidity := d.Get("maximum_classification_validity_days").(int)
+	if maximumValidity != IntDefault && details.MaximumClassificationValidityDays != nil {
+		maximumValidity = *details.MaximumClassificationValidityDays
+	}
+	autoTag := false
+	if details.AutoTag != nil {
+		autoTag = *details.AutoTag
+	}
+
+	errs := errors.Join(
+		d.Set("minimum_object_age_for_classification_days", minimumObjectAge),
+		d.Set("maximum_classification_validity_days", maximumValidity),
+		d.Set("auto_tag", autoTag),
+		d.Set("tag_map", classificationProfileTagMapToSchema(details.TagMap)),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ClassificationProfileToSchema(classificationProfile)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateClassificationProfile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("minimum_object_age_for_classification_days") {
+		if v := d.Get("minimum_object_age_for_classification_days").(int); v != IntDefault {
+			if _, err := client.ClassificationProfiles.SetMinimumObjectAgeForClassificationDays(ctx, sdk.NewSetMinimumObjectAgeForClassificationDaysClassificationProfileRequest(id, *sdk.NewClassificationProfileSetMinimumObjectAgeForClassificationDaysArgsRequest(v))); err != nil {
+				d.Partial(true)
+				return diag.FromErr(fmt.Errorf("error updating minimum object age for classification days of classification profile %s, err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("maximum_classification_validity_days") {
+		if v := d.Get("maximum_classification_validity_days").(int); v != IntDefault {
+			if _, err := client.ClassificationProfiles.SetMaximumClassificationValidityDays(ctx, sdk.NewSetMaximumClassificationValidityDaysClassificationProfileRequest(id, *sdk.NewClassificationProfileSetMaximumClassificationValidityDaysArgsRequest(v))); err != nil {
+				d.Partial(true)
+				return diag.FromErr(fmt.Errorf("error updating maximum classification validity days of classification profile %s, err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("auto_tag") {
+		if _, err := client.ClassificationProfiles.SetAutoTag(ctx, sdk.NewSetAutoTagClassificationProfileRequest(id, *sdk.NewClassificationProfileSetAutoTagArgsRequest(d.Get("auto_tag").(bool)))); err != nil {
+			d.Partial(true)
+			return diag.FromErr(fmt.Errorf("error updating auto tag of classification profile %s, err = %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tag_map") {
+		tagMap, err := classificationProfileTagMapFromConfig(d.Get("tag_map").([]any))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if tagMap != nil {
+			_, err = client.ClassificationProfiles.SetTagMap(ctx, sdk.NewSetTagMapClassificationProfileRequest(id, *sdk.NewClassificationProfileSetTagMapArgsRequest(tagMap.ToSql())))
+		} else {
+			_, err = client.ClassificationProfiles.UnsetTagMap(ctx, sdk.NewUnsetTagMapClassificationProfileRequest(id))
+		}
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(fmt.Errorf("error updating tag map of classification profile %s, err = %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("custom_classifiers") {
+		customClassifiers, err := classificationProfileCustomClassifiersFromConfig(d.Get("custom_classifiers").(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(customClassifiers) > 0 {
+			_, err = client.ClassificationProfiles.SetCustomClassifiers(ctx, sdk.NewSetCustomClassifiersClassificationProfileRequest(id, *sdk.NewClassificationProfileSetCustomClassifiersArgsRequest(sdk.ClassificationProfileCustomClassifiersToSql(customClassifiers))))
+		} else {
+			_, err = client.ClassificationProfiles.UnsetCustomClassifiers(ctx, sdk.NewUnsetCustomClassifiersClassificationProfileRequest(id))
+		}
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(fmt.Errorf("error updating custom classifiers of classification profile %s, err = %w", d.Id(), err))
+		}
+	}
+
+	return ReadClassificationProfile(ctx, d, meta)
+}
+
+func classificationProfileTagMapFromConfig(tagMapConfig []any) (*sdk.ClassificationProfileTagMap, error) {
+	if len(tagMapConfig) == 0 || tagMapConfig[0] == nil {
+		return nil, nil
+	}
+	entries := tagMapConfig[0].(map[string]any)["column_tag_map"].([]any)
+	tagMap := &sdk.ClassificationProfileTagMap{
+		ColumnTagMap: make([]sdk.ClassificationProfileColumnTagMapEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		entry := e.(map[string]any)
+		tagId, err := sdk.ParseSchemaObjectIdentifier(entry["tag_name"].(string))
+		if err != nil {
+			return nil, err
+		}
+		columnTagMapEntry := sdk.ClassificationProfileColumnTagMapEntry{
+			TagName:            tagId,
+			SemanticCategories: expandStringList(entry["semantic_categories"].(*schema.Set).List()),
+		}
+		if v := entry["tag_value"].(string); v != "" {
+			columnTagMapEntry.TagValue = sdk.String(v)
+		}
+		tagMap.ColumnTagMap = append(tagMap.ColumnTagMap, columnTagMapEntry)
+	}
+	return tagMap, nil
+}
+
+func classificationProfileTagMapToSchema(tagMap *sdk.ClassificationProfileDetailsTagMap) []any {
+	if tagMap == nil || len(tagMap.ColumnTagMap) == 0 {
+		return []any{}
+	}
+	entries := make([]any, 0, len(tagMap.ColumnTagMap))
+	for _, e := range tagMap.ColumnTagMap {
+		tagName := e.TagName
+		if tagId, err := sdk.ParseSchemaObjectIdentifier(e.TagName); err == nil {
+			tagName = tagId.FullyQualifiedName()
+		}
+		tagValue := ""
+		if e.TagValue != nil {
+			tagValue = *e.TagValue
+		}
+		entries = append(entries, map[string]any{
+			"tag_name":            tagName,
+			"tag_value":           tagValue,
+			"semantic_categories": e.SemanticCategories,
+		})
+	}
+	return []any{map[string]any{"column_tag_map": entries}}
+}
+
+func classificationProfileCustomClassifiersFromConfig(customClassifiersConfig []any) ([]sdk.ClassificationProfileCustomClassifier, error) {
+	customClassifiers := make([]sdk.ClassificationProfileCustomClassifier, 0, len(customClassifiersConfig))
+	for _, c := range customClassifiersConfig {
+		customClassifier := c.(map[string]any)
+		classifierId, err := sdk.ParseSchemaObjectIdentifier(customClassifier["classifier"].(string))
+		if err != nil {
+			return nil, err
+		}
+		customClassifiers = append(customClassifiers, sdk.ClassificationProfileCustomClassifier{
+			Label:      customClassifier["label"].(string),
+			Classifier: classifierId,
+		})
+	}
+	return customClassifiers, nil
+}
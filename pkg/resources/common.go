@@ -10,6 +10,8 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/sqlvalidation"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/experimentalfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -236,6 +238,57 @@ func PreviewFeatureDeleteContextWrapper(featureRaw string, deleteFunc schema.Del
 	}
 }
 
+// SqlBodyReferencesCreateWrapper returns the objects referenced by the SQL body set in the given field as warnings
+// after a successful create, when the SqlBodyStructureCheck experiment is enabled (see CheckSqlBodyStructure).
+func SqlBodyReferencesCreateWrapper(key string, kind sqlvalidation.BodyKind, createFunc schema.CreateContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		diags := createFunc(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, sqlBodyReferencesWarnings(d, key, kind, meta)...)
+	}
+}
+
+// SqlBodyReferencesUpdateWrapper returns the objects referenced by the SQL body set in the given field as warnings
+// after a successful update changing the field, when the SqlBodyStructureCheck experiment is enabled (see CheckSqlBodyStructure).
+func SqlBodyReferencesUpdateWrapper(key string, kind sqlvalidation.BodyKind, updateFunc schema.UpdateContextFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		bodyChanged := d.HasChange(key)
+		diags := updateFunc(ctx, d, meta)
+		if diags.HasError() || !bodyChanged {
+			return diags
+		}
+		return append(diags, sqlBodyReferencesWarnings(d, key, kind, meta)...)
+	}
+}
+
+func sqlBodyReferencesWarnings(d *schema.ResourceData, key string, kind sqlvalidation.BodyKind, meta any) diag.Diagnostics {
+	if !experimentalfeatures.IsExperimentEnabled(experimentalfeatures.SqlBodyStructureCheck, meta.(*provider.Context).EnabledExperiments) {
+		return nil
+	}
+	// The body was already validated during the plan; here, only the references are extracted.
+	result, err := sqlvalidation.Validate(d.Get(key).(string), kind)
+	if err != nil {
+		return nil
+	}
+
+	diags := make(diag.Diagnostics, 0, len(result.References))
+	for _, reference := range result.References {
+		detail := fmt.Sprintf("Make sure %s is created before this object, e.g., by referencing its fully_qualified_name or using depends_on.", reference.Name)
+		if !reference.IsFullyQualified() {
+			detail = fmt.Sprintf("%s is not fully qualified; it is resolved using the current database and schema, and it has to exist before this object is created.", reference.Name)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("The SQL %s references %s (%s)", kind, reference.Name, reference.Position),
+			Detail:        detail,
+			AttributePath: cty.GetAttrPath(key),
+		})
+	}
+	return diags
+}
+
 const (
 	defaultCreateTimeout = 20 * time.Minute
 	defaultReadTimeout   = 20 * time.Minute
@@ -1,8 +1,16 @@
 package resources
 
 import (
+	"context"
 	"testing"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/sqlvalidation"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/experimentalfeatures"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -47,3 +55,34 @@ func Test_suppressIdentifierQuoting(t *testing.T) {
 		require.False(t, result)
 	})
 }
+
+func Test_SqlBodyReferencesCreateWrapper(t *testing.T) {
+	testSchema := map[string]*schema.Schema{
+		"statement": {Type: schema.TypeString, Required: true},
+	}
+	createFunc := SqlBodyReferencesCreateWrapper("statement", sqlvalidation.BodyKindQuery, func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+		return nil
+	})
+	experimentEnabled := &provider.Context{EnabledExperiments: []string{string(experimentalfeatures.SqlBodyStructureCheck)}}
+
+	t.Run("references are returned as warnings with the attribute path", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, testSchema, map[string]any{"statement": "SELECT * FROM db.schema.t JOIN other ON TRUE"})
+
+		diags := createFunc(context.Background(), d, experimentEnabled)
+
+		require.Len(t, diags, 2)
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "The SQL query references db.schema.t (line 1, column 15)", diags[0].Summary)
+		assert.Equal(t, cty.GetAttrPath("statement"), diags[0].AttributePath)
+		assert.Equal(t, "The SQL query references other (line 1, column 32)", diags[1].Summary)
+		assert.Contains(t, diags[1].Detail, "other is not fully qualified")
+	})
+
+	t.Run("no warnings with the experiment disabled", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, testSchema, map[string]any{"statement": "SELECT * FROM db.schema.t"})
+
+		diags := createFunc(context.Background(), d, &provider.Context{})
+
+		require.Empty(t, diags)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/sdkv2enhancements"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/sqlvalidation"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/experimentalfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -356,7 +357,7 @@ func RecreateWhenResourceTypeChangedExternally[T ~string](typeField string, want
 // This means that the provider can detect that change in `stale` from `true` to `false`, where `false` is our desired state.
 func RecreateWhenStreamIsStale() schema.CustomizeDiffFunc {
 	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
-		if old, _ := diff.GetChange("stale"); old.(bool) {
+		if old, _ := diff.GetChange("stale"); old.(bool) && diff.Get("recreate_when_stale").(bool) {
 			return diff.SetNew("stale", false)
 		}
 		return nil
@@ -411,3 +412,24 @@ func RecreateWhenResourceStringFieldChangedExternally(field string, expectedValu
 		return nil
 	}
 }
+
+// CheckSqlBodyStructure checks the lexical structure of the SQL body set in the given field during the plan, when the SqlBodyStructureCheck experiment is enabled.
+// The objects referenced by the body are returned as warnings after the apply by SqlBodyReferencesCreateWrapper and SqlBodyReferencesUpdateWrapper,
+// as the custom diffs cannot return warning diagnostics.
+func CheckSqlBodyStructure(key string, kind sqlvalidation.BodyKind) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		providerCtx := meta.(*provider.Context)
+		if !experimentalfeatures.IsExperimentEnabled(experimentalfeatures.SqlBodyStructureCheck, providerCtx.EnabledExperiments) {
+			return nil
+		}
+		// The value may be unknown when it refers to other resources' attributes computed during the apply.
+		if !d.HasChange(key) || !d.NewValueKnown(key) {
+			return nil
+		}
+
+		if _, err := sqlvalidation.Validate(d.Get(key).(string), kind); err != nil {
+			return fmt.Errorf("invalid SQL %s in the %s field: %w", kind, key, err)
+		}
+		return nil
+	}
+}
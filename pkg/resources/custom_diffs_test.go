@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/sqlvalidation"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/experimentalfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/go-cty/cty"
@@ -1290,3 +1292,67 @@ func Test_RecreateWhenCredentialsAndStorageIntegrationChangedOnExternalStage(t *
 		})
 	}
 }
+
+func Test_CheckSqlBodyStructure(t *testing.T) {
+	customDiff := resources.CheckSqlBodyStructure("statement", sqlvalidation.BodyKindQuery)
+	testProvider := createProviderWithNamedPropertyAndCustomDiff(t, "statement", &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}, customDiff)
+	experimentEnabled := &provider.Context{Client: &sdk.Client{}, EnabledExperiments: []string{string(experimentalfeatures.SqlBodyStructureCheck)}}
+	experimentDisabled := &provider.Context{Client: &sdk.Client{}}
+
+	tests := []struct {
+		name          string
+		meta          *provider.Context
+		stateValue    map[string]string
+		configValue   map[string]any
+		expectedError string
+	}{
+		{
+			name:        "valid statement",
+			meta:        experimentEnabled,
+			stateValue:  map[string]string{},
+			configValue: map[string]any{"statement": "SELECT * FROM DB.SCHEMA.TABLE"},
+		},
+		{
+			name:          "invalid statement",
+			meta:          experimentEnabled,
+			stateValue:    map[string]string{},
+			configValue:   map[string]any{"statement": "SELECT *\nFROM WHERE"},
+			expectedError: "invalid SQL query in the statement field: line 2, column 6: unexpected WHERE after FROM",
+		},
+		{
+			name:        "invalid statement without changes",
+			meta:        experimentEnabled,
+			stateValue:  map[string]string{"statement": "SELECT *\nFROM WHERE"},
+			configValue: map[string]any{"statement": "SELECT *\nFROM WHERE"},
+		},
+		{
+			name:        "invalid statement with experiment disabled",
+			meta:        experimentDisabled,
+			stateValue:  map[string]string{},
+			configValue: map[string]any{"statement": "SELECT *\nFROM WHERE"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testProvider.ResourcesMap["test"].Diff(
+				context.Background(),
+				&terraform.InstanceState{
+					Attributes: tt.stateValue,
+				},
+				&terraform.ResourceConfig{
+					Config: tt.configValue,
+				},
+				tt.meta,
+			)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -38,6 +38,7 @@ var databaseSchema = map[string]*schema.Schema{
 		Description:      "Specifies whether to drop public schema on creation or not. Modifying the parameter after database is already created won't have any effect.",
 		DiffSuppressFunc: IgnoreAfterCreation,
 	},
+	"recover_if_dropped": recoverIfDroppedSchema(sdk.ObjectTypeDatabase),
 	"is_transient": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -83,6 +84,13 @@ var databaseSchema = map[string]*schema.Schema{
 			},
 		},
 	},
+	"classification_profile": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the classification profile used for the automatic sensitive data classification of the database. Snowflake does not return the classification profile set on the database, so the provider is not able to detect external changes of this field.", resources.ClassificationProfile),
+	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -138,6 +146,17 @@ func CreateDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diag.FromErr(err)
 	}
 
+	if d.Get("recover_if_dropped").(bool) {
+		recovered, err := recoverDroppedDatabase(ctx, client, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if recovered {
+			d.SetId(helpers.EncodeResourceIdentifier(id))
+			return append(recoveredObjectDiagnostics(sdk.ObjectTypeDatabase, id), UpdateDatabase(ctx, d, meta)...)
+		}
+	}
+
 	opts := &sdk.CreateDatabaseOptions{
 		Transient: GetConfigPropertyAsPointerAllowingZeroValue[bool](d, "is_transient"),
 		Comment:   GetConfigPropertyAsPointerAllowingZeroValue[string](d, "comment"),
@@ -153,6 +172,18 @@ func CreateDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
 
+	if v, ok := d.GetOk("classification_profile"); ok {
+		classificationProfileId, err := sdk.ParseSchemaObjectIdentifier(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{
+			Set: &sdk.DatabaseSet{ClassificationProfile: &classificationProfileId},
+		}); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting classification profile on database %v: %w", id.FullyQualifiedName(), err))
+		}
+	}
+
 	var diags diag.Diagnostics
 
 	if d.Get("drop_public_schema_on_creation").(bool) {
@@ -240,7 +271,7 @@ func UpdateDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diag.FromErr(err)
 	}
 
-	if d.HasChange("name") {
+	if d.HasChange("name") && !d.GetRawState().IsNull() {
 		newId, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
 		if err != nil {
 			return diag.FromErr(err)
@@ -340,6 +371,10 @@ func UpdateDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 	}
 
+	if err := schemaObjectIdentifierAttributeUpdate(d, "classification_profile", &databaseSetRequest.ClassificationProfile, &databaseUnsetRequest.ClassificationProfile); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("comment") {
 		comment := d.Get("comment").(string)
 		if len(comment) > 0 {
@@ -0,0 +1,388 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var dbtProjectSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the dbt project; must be unique for the schema in which the dbt project is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the dbt project."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the dbt project."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"from": {
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Description: joinWithSpace(
+			"Specifies the location of the dbt project files: a Git repository (e.g. with `branches/main/<dir>` path) or a stage.",
+			"Changing this field adds a new version of the dbt project with `ALTER DBT PROJECT ... ADD VERSION`, so the history of versions is kept.",
+			"The resource does not track the changes of the files in the source location; use `version_trigger` to add a new version when they change.",
+			"This field is not set on import, as Snowflake does not return it in a form that can be mapped back; the first apply after the import only saves it in the state without adding a new version.",
+		),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"stage": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Identifier of the Git repository or the stage where the dbt project files are located.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+				},
+				"path": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path to the dbt project directory in the Git repository or the stage, e.g. `branches/main/dbt`.",
+				},
+				"version_alias": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: joinWithSpace(
+						"Specifies an alias for the version added when the source location changes.",
+						"It's case-sensitive. The versions of the dbt project are available in the `versions` field.",
+						"The alias of an existing version cannot be changed, so changing only this field also adds a new version from the same source location.",
+					),
+				},
+				"version_trigger": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: joinWithSpace(
+						"Changing the value of this field adds a new version of the dbt project from the same source location.",
+						"The value itself is not sent to Snowflake; set it to an identifier of the sources, e.g. the commit SHA of the Git repository branch or a hash of the files put on the stage, so that a new version is added whenever the sources change.",
+					),
+				},
+			},
+		},
+	},
+	"default_args": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the default dbt command and arguments used when the dbt project is executed, e.g. `run --select tag:nightly`.",
+	},
+	"default_target": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the default target from the `profiles.yml` file used when the dbt project is executed.",
+	},
+	"default_version": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the default version of the dbt project used when the dbt project is executed. Valid values are `FIRST`, `LAST`, `VERSION$<num>`, or a version alias.",
+	},
+	"dbt_version": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the dbt Core version used by the dbt project, e.g. `1.9.4`. If not set, the `DEFAULT_DBT_VERSION` account parameter is used.",
+	},
+	"external_access_integrations": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+		Optional:    true,
+		Description: "The names of [external access integrations](https://docs.snowflake.com/en/sql-reference/sql/create-external-access-integration) needed to install dbt packages (e.g. with `dbt deps`) from external networks.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the dbt project.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW DBT PROJECTS` for the given dbt project.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowDbtProjectSchema,
+		},
+	},
+	"versions": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW VERSIONS IN DBT PROJECT` for the given dbt project.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowDbtProjectVersionSchema,
+		},
+	},
+}
+
+func DbtProject() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.DbtProjects.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.DbtProjectResource), TrackingCreateWrapper(resources.DbtProject, CreateDbtProject)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.DbtProjectResource), TrackingReadWrapper(resources.DbtProject, GetReadDbtProjectFunc(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.DbtProjectResource), TrackingUpdateWrapper(resources.DbtProject, UpdateDbtProject)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.DbtProjectResource), TrackingDeleteWrapper(resources.DbtProject, deleteFunc)),
+		Description:   "Resource used to manage dbt project objects. For more information, check [dbt projects on Snowflake documentation](https://docs.snowflake.com/en/user-guide/data-engineering/dbt-projects-on-snowflake).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.DbtProject, customdiff.All(
+			ComputedIfAnyAttributeChanged(dbtProjectSchema, ShowOutputAttributeName, "name", "from", "default_args", "default_target", "default_version", "dbt_version", "external_access_integrations", "comment"),
+			ComputedIfAnyAttributeChanged(dbtProjectSchema, "versions", "from"),
+			ComputedIfAnyAttributeChanged(dbtProjectSchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+
+		Schema: dbtProjectSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.DbtProject, ImportDbtProject),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportDbtProject(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	dbtProject, err := client.DbtProjects.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ImportName[sdk.SchemaObjectIdentifier](ctx, d, nil); err != nil {
+		return nil, err
+	}
+
+	errs := errors.Join(
+		d.Set("default_version", stringValueOrEmpty(dbtProject.DefaultVersion)),
+		d.Set("dbt_version", stringValueOrEmpty(dbtProject.DbtVersion)),
+	)
+	if errs != nil {
+		return nil, errs
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateDbtProject(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	request := sdk.NewCreateDbtProjectRequest(id)
+
+	location, _, err := dbtProjectSourceLocation(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request.WithFrom(location)
+
+	errs := errors.Join(
+		stringAttributeCreateBuilder(d, "default_args", request.WithDefaultArgs),
+		stringAttributeCreateBuilder(d, "default_target", request.WithDefaultTarget),
+		stringAttributeCreateBuilder(d, "default_version", request.WithDefaultVersion),
+		stringAttributeCreateBuilder(d, "dbt_version", request.WithDbtVersion),
+		setExternalAccessIntegrationsInBuilder(d, request.WithExternalAccessIntegrations),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.DbtProjects.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating dbt project %s, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return GetReadDbtProjectFunc(false)(ctx, d, meta)
+}
+
+// dbtProjectSourceLocation returns the source location and the optional version alias from the from block.
+func dbtProjectSourceLocation(d *schema.ResourceData) (sdk.Location, string, error) {
+	fromMap := d.Get("from").([]any)[0].(map[string]any)
+
+	stage, err := sdk.ParseSchemaObjectIdentifier(fromMap["stage"].(string))
+	if err != nil {
+		return nil, "", err
+	}
+
+	var path string
+	if v, ok := fromMap["path"]; ok {
+		path = v.(string)
+	}
+
+	var versionAlias string
+	if v, ok := fromMap["version_alias"]; ok {
+		versionAlias = v.(string)
+	}
+
+	return sdk.NewStageLocation(stage, path), versionAlias, nil
+}
+
+func GetReadDbtProjectFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		dbtProject, err := client.DbtProjects.ShowByIDSafely(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query dbt project. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("Dbt project id: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+
+		versions, err := client.DbtProjects.ShowVersions(ctx, sdk.NewShowVersionsDbtProjectRequest(id))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// DEFAULT_VERSION and DBT_VERSION are filled by Snowflake when they are not set, so only external changes are marked.
+		if withExternalChangesMarking {
+			defaultVersion := stringValueOrEmpty(dbtProject.DefaultVersion)
+			dbtVersion := stringValueOrEmpty(dbtProject.DbtVersion)
+			if err = handleExternalChangesToObjectInShow(d,
+				outputMapping{"default_version", "default_version", defaultVersion, defaultVersion, nil},
+				outputMapping{"dbt_version", "dbt_version", dbtVersion, dbtVersion, nil},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if err = setStateToValuesFromConfig(d, dbtProjectSchema, []string{
+			"default_version",
+			"dbt_version",
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		externalAccessIntegrations := make([]string, 0)
+		if dbtProject.ExternalAccessIntegrations != nil {
+			externalAccessIntegrations = sdk.ParseCommaSeparatedStringArray(*dbtProject.ExternalAccessIntegrations, false)
+		}
+
+		errs := errors.Join(
+			d.Set("default_args", stringValueOrEmpty(dbtProject.DefaultArgs)),
+			d.Set("default_target", stringValueOrEmpty(dbtProject.DefaultTarget)),
+			d.Set("external_access_integrations", externalAccessIntegrations),
+			d.Set("comment", stringValueOrEmpty(dbtProject.Comment)),
+			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			d.Set(ShowOutputAttributeName, []map[string]any{schemas.DbtProjectToSchema(dbtProject)}),
+			d.Set("versions", collections.Map(versions, func(version sdk.DbtProjectVersion) map[string]any {
+				return schemas.DbtProjectVersionToSchema(&version)
+			})),
+		)
+		return diag.FromErr(errs)
+	}
+}
+
+func UpdateDbtProject(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.DbtProjects.Alter(ctx, sdk.NewAlterDbtProjectRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming dbt project %s, err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	// The from field is empty after the import, so in this case its value is only saved in the state.
+	if oldFrom, _ := d.GetChange("from"); d.HasChange("from") && len(oldFrom.([]any)) > 0 {
+		location, versionAlias, err := dbtProjectSourceLocation(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		request := sdk.NewAddDbtProjectVersionRequest(location)
+		if versionAlias != "" {
+			request.WithVersionAlias(versionAlias)
+		}
+
+		if err := client.DbtProjects.Alter(ctx, sdk.NewAlterDbtProjectRequest(id).WithAddVersion(*request)); err != nil {
+			d.Partial(true)
+			return diag.FromErr(fmt.Errorf("error adding version to dbt project %s, err = %w", d.Id(), err))
+		}
+	}
+
+	set, unset := sdk.NewDbtProjectSetRequest(), sdk.NewDbtProjectUnsetRequest()
+	errs := errors.Join(
+		stringAttributeUpdate(d, "default_args", &set.DefaultArgs, &unset.DefaultArgs),
+		stringAttributeUpdate(d, "default_target", &set.DefaultTarget, &unset.DefaultTarget),
+		stringAttributeUpdate(d, "default_version", &set.DefaultVersion, &unset.DefaultVersion),
+		stringAttributeUpdate(d, "dbt_version", &set.DbtVersion, &unset.DbtVersion),
+		setValueUpdate(d, "external_access_integrations", &set.ExternalAccessIntegrations, &unset.ExternalAccessIntegrations, func(v any) (sdk.AccountObjectIdentifier, error) {
+			return sdk.ParseAccountObjectIdentifier(v.(string))
+		}),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if !reflect.DeepEqual(*set, *sdk.NewDbtProjectSetRequest()) {
+		if err := client.DbtProjects.Alter(ctx, sdk.NewAlterDbtProjectRequest(id).WithSet(*set)); err != nil {
+			d.Partial(true)
+			return diag.FromErr(fmt.Errorf("error updating dbt project %s, err = %w", d.Id(), err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unset, *sdk.NewDbtProjectUnsetRequest()) {
+		if err := client.DbtProjects.Alter(ctx, sdk.NewAlterDbtProjectRequest(id).WithUnset(*unset)); err != nil {
+			d.Partial(true)
+			return diag.FromErr(fmt.Errorf("error updating dbt project %s, err = %w", d.Id(), err))
+		}
+	}
+
+	return GetReadDbtProjectFunc(false)(ctx, d, meta)
+}
+
+func stringValueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
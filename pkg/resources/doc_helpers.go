@@ -70,6 +70,10 @@ func joinWithSpace(parts ...string) string {
 	return strings.Join(parts, " ")
 }
 
+func exampleDatabaseObjectIdentifier(databaseObjectName string) string {
+	return fmt.Sprintf("Example: `\"\\\"<db_name>\\\".\\\"<%s_name>\\\"\"`.", databaseObjectName)
+}
+
 func exampleSchemaObjectIdentifier(schemaObjectName string) string {
 	return fmt.Sprintf("Example: `\"\\\"<db_name>\\\".\\\"<schema_name>\\\".\\\"<%s_name>\\\"\"`.", schemaObjectName)
 }
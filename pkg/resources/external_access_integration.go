@@ -0,0 +1,283 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var externalAccessIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier (i.e. name) for the external access integration; must be unique in your account."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"allowed_network_rules": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		Required:         true,
+		MinItems:         1,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("allowed_network_rules"),
+		Description:      relatedResourceDescription("Specifies the fully qualified names of the egress network rules that describe the network locations the integration allows access to.", resources.NetworkRule),
+	},
+	"allowed_api_authentication_integrations": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("allowed_api_authentication_integrations"),
+		Description: joinWithSpace(
+			"Specifies the names of the security integrations whose OAuth authorization server issued the secrets used by the UDF or procedure.",
+			fmt.Sprintf("The integrations can be managed with the `%s`, `%s`, and `%s` resources.", resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, resources.ApiAuthenticationIntegrationWithClientCredentials, resources.ApiAuthenticationIntegrationWithJwtBearer),
+		),
+	},
+	"allowed_authentication_secrets": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("allowed_authentication_secrets"),
+		Description: joinWithSpace(
+			"Specifies the fully qualified names of the secrets that the UDF or procedure can use when referring to this integration.",
+			fmt.Sprintf("The secrets can be managed with the `%s`, `%s`, `%s`, and `%s` resources.", resources.SecretWithAuthorizationCodeGrant, resources.SecretWithBasicAuthentication, resources.SecretWithClientCredentials, resources.SecretWithGenericString),
+		),
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies whether this integration is enabled or disabled. If the integration is disabled, any handler code that relies on it will be unable to reach the external network locations.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the external access integration.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW EXTERNAL ACCESS INTEGRATIONS` for the given external access integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowExternalAccessIntegrationSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE EXTERNAL ACCESS INTEGRATION` for the given external access integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.DescribeExternalAccessIntegrationDetailsSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func ExternalAccessIntegration() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseAccountObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] {
+			return client.ExternalAccessIntegrations.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingCreateWrapper(resources.ExternalAccessIntegration, CreateExternalAccessIntegration)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingReadWrapper(resources.ExternalAccessIntegration, ReadExternalAccessIntegration)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingUpdateWrapper(resources.ExternalAccessIntegration, UpdateExternalAccessIntegration)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingDeleteWrapper(resources.ExternalAccessIntegration, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage external access integrations. For more information, check [external access integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-external-access-integration).",
+			"External access integrations allow the UDFs and procedures to access the external network locations and use the secrets containing the credentials.",
+		),
+
+		Schema: externalAccessIntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.ExternalAccessIntegration, ImportName[sdk.AccountObjectIdentifier]),
+		},
+		Timeouts: defaultTimeouts,
+		// The identifier sets are not listed below; see the comment in the network policy resource (SNOW-1648997).
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.ExternalAccessIntegration, customdiff.All(
+			ComputedIfAnyAttributeChanged(externalAccessIntegrationSchema, ShowOutputAttributeName, "enabled", "comment"),
+			ComputedIfAnyAttributeChanged(externalAccessIntegrationSchema, DescribeOutputAttributeName, "enabled", "comment"),
+		)),
+	}
+}
+
+func CreateExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allowedNetworkRules, err := parseSchemaObjectIdentifierSet(d.Get("allowed_network_rules"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateExternalAccessIntegrationRequest(id, allowedNetworkRules, d.Get("enabled").(bool))
+	errs := errors.Join(
+		func() error {
+			if v, ok := d.GetOk("allowed_api_authentication_integrations"); ok {
+				integrations, err := parseAccountObjectIdentifierSet(v)
+				if err != nil {
+					return err
+				}
+				request.WithAllowedApiAuthenticationIntegrations(integrations)
+			}
+			return nil
+		}(),
+		func() error {
+			if v, ok := d.GetOk("allowed_authentication_secrets"); ok {
+				secrets, err := parseSchemaObjectIdentifierSet(v)
+				if err != nil {
+					return err
+				}
+				request.WithAllowedAuthenticationSecrets(secrets)
+			}
+			return nil
+		}(),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.ExternalAccessIntegrations.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating external access integration: %w", err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadExternalAccessIntegration(ctx, d, meta)
+}
+
+func ReadExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	integration, err := client.ExternalAccessIntegrations.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query external access integration. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("External access integration id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	details, err := client.ExternalAccessIntegrations.DescribeDetails(ctx, id)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("could not describe external access integration (%s), err = %w", d.Id(), err))
+	}
+
+	errs := errors.Join(
+		// not reading name on purpose (we never update the name externally)
+		d.Set("allowed_network_rules", collections.Map(details.AllowedNetworkRules, sdk.SchemaObjectIdentifier.FullyQualifiedName)),
+		d.Set("allowed_api_authentication_integrations", collections.Map(details.AllowedApiAuthenticationIntegrations, sdk.AccountObjectIdentifier.Name)),
+		d.Set("allowed_authentication_secrets", collections.Map(details.AllowedAuthenticationSecrets, sdk.SchemaObjectIdentifier.FullyQualifiedName)),
+		d.Set("enabled", integration.Enabled),
+		d.Set("comment", integration.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ExternalAccessIntegrationToSchema(integration)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.ExternalAccessIntegrationDetailsToSchema(details)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set, unset := sdk.NewExternalAccessIntegrationSetRequest(), sdk.NewExternalAccessIntegrationUnsetRequest()
+
+	errs := errors.Join(
+		func() error {
+			if d.HasChange("allowed_network_rules") {
+				networkRules, err := parseSchemaObjectIdentifierSet(d.Get("allowed_network_rules"))
+				if err != nil {
+					return err
+				}
+				set.WithAllowedNetworkRules(networkRules)
+			}
+			return nil
+		}(),
+		func() error {
+			if d.HasChange("allowed_api_authentication_integrations") {
+				if v, ok := d.GetOk("allowed_api_authentication_integrations"); ok {
+					integrations, err := parseAccountObjectIdentifierSet(v)
+					if err != nil {
+						return err
+					}
+					set.WithAllowedApiAuthenticationIntegrations(integrations)
+				} else {
+					unset.WithAllowedApiAuthenticationIntegrations(true)
+				}
+			}
+			return nil
+		}(),
+		func() error {
+			if d.HasChange("allowed_authentication_secrets") {
+				if v, ok := d.GetOk("allowed_authentication_secrets"); ok {
+					secrets, err := parseSchemaObjectIdentifierSet(v)
+					if err != nil {
+						return err
+					}
+					set.WithAllowedAuthenticationSecrets(secrets)
+				} else {
+					unset.WithAllowedAuthenticationSecrets(true)
+				}
+			}
+			return nil
+		}(),
+		booleanAttributeUpdateSetOnly(d, "enabled", &set.Enabled),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if !reflect.DeepEqual(*set, *sdk.NewExternalAccessIntegrationSetRequest()) {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating external access integration, err = %w", err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unset, *sdk.NewExternalAccessIntegrationUnsetRequest()) {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating external access integration, err = %w", err))
+		}
+	}
+
+	return ReadExternalAccessIntegration(ctx, d, meta)
+}
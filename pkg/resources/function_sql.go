@@ -9,6 +9,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/sqlvalidation"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
@@ -21,9 +22,9 @@ import (
 
 func FunctionSql() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.FunctionSqlResource), TrackingCreateWrapper(resources.FunctionSql, CreateContextFunctionSql)),
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.FunctionSqlResource), TrackingCreateWrapper(resources.FunctionSql, SqlBodyReferencesCreateWrapper("function_definition", sqlvalidation.BodyKindExpression, CreateContextFunctionSql))),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.FunctionSqlResource), TrackingReadWrapper(resources.FunctionSql, ReadContextFunctionSql)),
-		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.FunctionSqlResource), TrackingUpdateWrapper(resources.FunctionSql, UpdateFunction("SQL", ReadContextFunctionSql))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.FunctionSqlResource), TrackingUpdateWrapper(resources.FunctionSql, SqlBodyReferencesUpdateWrapper("function_definition", sqlvalidation.BodyKindExpression, UpdateFunction("SQL", ReadContextFunctionSql)))),
 		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.FunctionSqlResource), TrackingDeleteWrapper(resources.FunctionSql, DeleteFunction)),
 		Description:   "Resource used to manage sql function objects. For more information, check [function documentation](https://docs.snowflake.com/en/sql-reference/sql/create-function).",
 
@@ -37,6 +38,7 @@ func FunctionSql() *schema.Resource {
 			// When language changes, these attributes also change, causing the object to recreate either way.
 			// The only potential option is java staged <-> scala staged (however scala need runtime_version which may interfere).
 			RecreateWhenResourceStringFieldChangedExternally("function_language", "SQL"),
+			CheckSqlBodyStructure("function_definition", sqlvalidation.BodyKindExpression),
 		)),
 
 		Schema: collections.MergeMaps(sqlFunctionSchema, functionParametersSchema),
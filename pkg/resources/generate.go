@@ -0,0 +1,3 @@
+package resources
+
+//go:generate go run --tags=sdk_generation ../sdk/generator/resourcescaffold/main/main.go $SF_TF_GENERATOR_ARGS
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var joinPolicyDefinition = bodyPolicyDefinition[sdk.CreateJoinPolicyRequest, sdk.AlterJoinPolicyRequest, sdk.JoinPolicy, sdk.JoinPolicyDescription]{
+	resource:       resources.JoinPolicy,
+	previewFeature: string(previewfeatures.JoinPolicyResource),
+	objectType:     sdk.ObjectTypeJoinPolicy,
+	description: joinWithSpace(
+		"Resource used to manage join policy objects. For more information, check [join policy documentation](https://docs.snowflake.com/en/user-guide/join-policies).",
+		"Join policies require the queries on the protected table or view to join it with another table or view before the data can be returned.",
+	),
+	bodyDescription: joinWithSpace(
+		"Specifies the SQL expression that determines the restrictions of the join policy. The expression has to return `JOIN_CONSTRAINT(JOIN_REQUIRED => <boolean>)`, e.g. `JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)`.",
+		"The allowed join keys are not a part of the policy; they are specified when the policy is set on a table or a view (see `join_policy.allowed_join_keys` in `snowflake_table` and `snowflake_view`).",
+	),
+
+	showOutputSchema:     schemas.ShowJoinPolicySchema,
+	describeOutputSchema: schemas.ShowJoinPolicyDescriptionSchema,
+	toSchema:             schemas.JoinPolicyToSchema,
+	descriptionToSchema:  schemas.JoinPolicyDescriptionToSchema,
+
+	client: func(client *sdk.Client) bodyPolicyClient[sdk.CreateJoinPolicyRequest, sdk.AlterJoinPolicyRequest, sdk.JoinPolicy, sdk.JoinPolicyDescription] {
+		return client.JoinPolicies
+	},
+	newCreateRequest: sdk.NewCreateJoinPolicyRequest,
+	withComment:      (*sdk.CreateJoinPolicyRequest).WithComment,
+	newAlterRequest:  sdk.NewAlterJoinPolicyRequest,
+	withRenameTo:     (*sdk.AlterJoinPolicyRequest).WithRenameTo,
+	withSetBody:      (*sdk.AlterJoinPolicyRequest).WithSetBody,
+	withSetComment:   (*sdk.AlterJoinPolicyRequest).WithSetComment,
+	withUnsetComment: (*sdk.AlterJoinPolicyRequest).WithUnsetComment,
+}
+
+func JoinPolicy() *schema.Resource {
+	return bodyPolicyResource(joinPolicyDefinition)
+}
@@ -0,0 +1,248 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var packagesPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the packages policy; must be unique for the database and schema in which the packages policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the packages policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the packages policy."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"language": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Default:          string(sdk.PackagesPolicyLanguagePython),
+		Description:      fmt.Sprintf("Specifies the language of the packages that the policy applies to. %s", possibleValuesListed(sdk.AllPackagesPolicyLanguages)),
+		ValidateDiagFunc: sdkValidation(sdk.ToPackagesPolicyLanguage),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToPackagesPolicyLanguage),
+	},
+	"allowlist": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies a list of package specs that are allowed, e.g. `numpy` or `pandas==1.2.3`. When not set, Snowflake allows all packages (`*`).",
+	},
+	"blocklist": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies a list of package specs that are not allowed. The blocklist takes precedence over the allowlist.",
+	},
+	"additional_creation_blocklist": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies a list of package specs that are not allowed when creating or replacing functions and procedures. Already existing objects that use these packages can still be executed.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the packages policy.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW PACKAGES POLICIES` for the given packages policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowPackagesPolicySchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE PACKAGES POLICY` for the given packages policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowPackagesPolicyDescriptionSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func PackagesPolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.PackagesPolicies.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.PackagesPolicyResource), TrackingCreateWrapper(resources.PackagesPolicy, CreatePackagesPolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.PackagesPolicyResource), TrackingReadWrapper(resources.PackagesPolicy, ReadPackagesPolicy)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.PackagesPolicyResource), TrackingUpdateWrapper(resources.PackagesPolicy, UpdatePackagesPolicy)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.PackagesPolicyResource), TrackingDeleteWrapper(resources.PackagesPolicy, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage packages policy objects. For more information, check [packages policy documentation](https://docs.snowflake.com/en/developer-guide/udf/python/packages-policy).",
+			"Packages policies control which third-party packages from Anaconda can be used in Python functions and procedures in the account.",
+			"To set the policy on the account, use the `packages_policy` field in `snowflake_current_account`.",
+		),
+
+		Schema: packagesPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.PackagesPolicy, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+		Timeouts: defaultTimeouts,
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.PackagesPolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(packagesPolicySchema, ShowOutputAttributeName, "comment"),
+			ComputedIfAnyAttributeChanged(packagesPolicySchema, DescribeOutputAttributeName, "allowlist", "blocklist", "additional_creation_blocklist", "comment"),
+			ComputedIfAnyAttributeChanged(packagesPolicySchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+	}
+}
+
+func CreatePackagesPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	language, err := sdk.ToPackagesPolicyLanguage(d.Get("language").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := sdk.NewCreatePackagesPolicyRequest(id, language)
+	if v, ok := d.GetOk("allowlist"); ok {
+		request.WithAllowlist(packageSpecsFromSet(v))
+	}
+	if v, ok := d.GetOk("blocklist"); ok {
+		request.WithBlocklist(packageSpecsFromSet(v))
+	}
+	if v, ok := d.GetOk("additional_creation_blocklist"); ok {
+		request.WithAdditionalCreationBlocklist(packageSpecsFromSet(v))
+	}
+	if err := stringAttributeCreateBuilder(d, "comment", request.WithComment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.PackagesPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating packages policy %s, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadPackagesPolicy(ctx, d, meta)
+}
+
+func packageSpecsFromSet(v any) []sdk.StringListItemWrapper {
+	packageSpecs := expandStringList(v.(*schema.Set).List())
+	items := make([]sdk.StringListItemWrapper, len(packageSpecs))
+	for i, packageSpec := range packageSpecs {
+		items[i] = sdk.StringListItemWrapper{Value: packageSpec}
+	}
+	return items
+}
+
+func ReadPackagesPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy, err := client.PackagesPolicies.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query packages policy. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Packages policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	description, err := client.PackagesPolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allowlist := sdk.ParseCommaSeparatedStringArray(description.Allowlist, true)
+	// Snowflake returns ['*'] when the allowlist is not set; keep the state empty unless '*' is set explicitly.
+	if slices.Equal(allowlist, []string{"*"}) && d.Get("allowlist").(*schema.Set).Len() == 0 {
+		allowlist = []string{}
+	}
+
+	errs := errors.Join(
+		d.Set("language", description.Language),
+		d.Set("allowlist", allowlist),
+		d.Set("blocklist", sdk.ParseCommaSeparatedStringArray(description.Blocklist, true)),
+		d.Set("additional_creation_blocklist", sdk.ParseCommaSeparatedStringArray(description.AdditionalCreationBlocklist, true)),
+		d.Set("comment", policy.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.PackagesPolicyToSchema(policy)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.PackagesPolicyDescriptionToSchema(description)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdatePackagesPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set := sdk.NewPackagesPolicySetRequest()
+	unset := sdk.NewPackagesPolicyUnsetRequest()
+
+	packageSpecMapper := func(v any) (sdk.StringListItemWrapper, error) {
+		return sdk.StringListItemWrapper{Value: v.(string)}, nil
+	}
+	errs := errors.Join(
+		setValueUpdate(d, "allowlist", &set.Allowlist, &unset.Allowlist, packageSpecMapper),
+		setValueUpdate(d, "blocklist", &set.Blocklist, &unset.Blocklist, packageSpecMapper),
+		setValueUpdate(d, "additional_creation_blocklist", &set.AdditionalCreationBlocklist, &unset.AdditionalCreationBlocklist, packageSpecMapper),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if !reflect.DeepEqual(*set, *sdk.NewPackagesPolicySetRequest()) {
+		if err := client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating packages policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unset, *sdk.NewPackagesPolicyUnsetRequest()) {
+		if err := client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating packages policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	return ReadPackagesPolicy(ctx, d, meta)
+}
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var privacyPolicyDefinition = bodyPolicyDefinition[sdk.CreatePrivacyPolicyRequest, sdk.AlterPrivacyPolicyRequest, sdk.PrivacyPolicy, sdk.PrivacyPolicyDescription]{
+	resource:       resources.PrivacyPolicy,
+	previewFeature: string(previewfeatures.PrivacyPolicyResource),
+	objectType:     sdk.ObjectTypePrivacyPolicy,
+	description: joinWithSpace(
+		"Resource used to manage privacy policy objects. For more information, check [privacy policy documentation](https://docs.snowflake.com/en/user-guide/privacy-policies).",
+		"Privacy policies protect the table or view with differential privacy; every query spends a part of the privacy budget assigned to the querying user.",
+	),
+	bodyDescription: joinWithSpace(
+		"Specifies the SQL expression that determines the restrictions of the privacy policy. The expression has to return `NO_PRIVACY_POLICY()` or `PRIVACY_BUDGET(BUDGET_NAME => <string> [, BUDGET_LIMIT => <decimal>] [, MAX_BUDGET_PER_AGGREGATE => <decimal>] [, BUDGET_WINDOW => <string>])`, e.g. `PRIVACY_BUDGET(BUDGET_NAME => 'analysts', BUDGET_LIMIT => 233, BUDGET_WINDOW => 'WEEKLY')`.",
+		"The privacy budget settings are a part of the body. The entity keys are not a part of the policy; they are specified when the policy is added to a table or a view (see `privacy_policy.entity_key` in `snowflake_table` and `snowflake_view`).",
+	),
+
+	showOutputSchema:     schemas.ShowPrivacyPolicySchema,
+	describeOutputSchema: schemas.ShowPrivacyPolicyDescriptionSchema,
+	toSchema:             schemas.PrivacyPolicyToSchema,
+	descriptionToSchema:  schemas.PrivacyPolicyDescriptionToSchema,
+
+	client: func(client *sdk.Client) bodyPolicyClient[sdk.CreatePrivacyPolicyRequest, sdk.AlterPrivacyPolicyRequest, sdk.PrivacyPolicy, sdk.PrivacyPolicyDescription] {
+		return client.PrivacyPolicies
+	},
+	newCreateRequest: sdk.NewCreatePrivacyPolicyRequest,
+	withComment:      (*sdk.CreatePrivacyPolicyRequest).WithComment,
+	newAlterRequest:  sdk.NewAlterPrivacyPolicyRequest,
+	withRenameTo:     (*sdk.AlterPrivacyPolicyRequest).WithRenameTo,
+	withSetBody:      (*sdk.AlterPrivacyPolicyRequest).WithSetBody,
+	withSetComment:   (*sdk.AlterPrivacyPolicyRequest).WithSetComment,
+	withUnsetComment: (*sdk.AlterPrivacyPolicyRequest).WithUnsetComment,
+}
+
+func PrivacyPolicy() *schema.Resource {
+	return bodyPolicyResource(privacyPolicyDefinition)
+}
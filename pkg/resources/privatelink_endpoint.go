@@ -0,0 +1,167 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var privatelinkEndpointSchema = map[string]*schema.Schema{
+	"provider_resource_id": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "Specifies the identifier of the cloud provider resource the endpoint connects to, e.g. `com.amazonaws.us-west-2.s3` for an AWS service or the resource ID of a Microsoft Azure resource. Only one endpoint can be provisioned for the given resource.",
+		ValidateFunc: validation.StringIsNotEmpty,
+	},
+	"host": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "Specifies the fully qualified host name used to access the cloud provider resource through the endpoint, e.g. `*.s3.us-west-2.amazonaws.com`.",
+		ValidateFunc: validation.StringIsNotEmpty,
+	},
+	"subresource": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies the name of the subresource of the Microsoft Azure resource, e.g. `blob`, `dfs`, or `sqlServer`. Applicable only to Microsoft Azure.",
+		ValidateFunc: validation.StringIsNotEmpty,
+	},
+	"snowflake_resource_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Identifier of the endpoint in the Snowflake VPC or VNet (e.g. the AWS VPC endpoint ID), as returned by `SYSTEM$GET_PRIVATELINK_ENDPOINTS_INFO`.",
+	},
+	"endpoint_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "State of the endpoint as returned by `SYSTEM$GET_PRIVATELINK_ENDPOINTS_INFO`.",
+	},
+	"status": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Status of the connection to the cloud provider resource as returned by `SYSTEM$GET_PRIVATELINK_ENDPOINTS_INFO`.",
+	},
+}
+
+func PrivatelinkEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.PrivatelinkEndpointResource), TrackingCreateWrapper(resources.PrivatelinkEndpoint, CreatePrivatelinkEndpoint)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.PrivatelinkEndpointResource), TrackingReadWrapper(resources.PrivatelinkEndpoint, ReadPrivatelinkEndpoint)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.PrivatelinkEndpointResource), TrackingDeleteWrapper(resources.PrivatelinkEndpoint, DeletePrivatelinkEndpoint)),
+		Description: joinWithSpace(
+			"Resource used to manage outbound private endpoints in the Snowflake VPC or VNet.",
+			"The endpoint is provisioned with `SYSTEM$PROVISION_PRIVATELINK_ENDPOINT` and deprovisioned with `SYSTEM$DEPROVISION_PRIVATELINK_ENDPOINT`.",
+			"For more information, check [private connectivity documentation](https://docs.snowflake.com/en/user-guide/private-connectivity-outbound).",
+			"Requires the Business Critical edition (or higher). The endpoint still has to be approved on the cloud provider side (e.g. in the Azure portal) before it can be used.",
+		),
+
+		Schema: privatelinkEndpointSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.PrivatelinkEndpoint, ImportPrivatelinkEndpoint),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportPrivatelinkEndpoint(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := d.Set("provider_resource_id", d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreatePrivatelinkEndpoint(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	providerResourceId := d.Get("provider_resource_id").(string)
+
+	request := sdk.ProvisionPrivatelinkEndpointRequest{
+		ProviderResourceId: providerResourceId,
+		HostName:           d.Get("host").(string),
+	}
+	if v, ok := d.GetOk("subresource"); ok {
+		request.Subresource = sdk.String(v.(string))
+	}
+
+	if err := client.SystemFunctions.ProvisionPrivatelinkEndpoint(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(providerResourceId)
+
+	return ReadPrivatelinkEndpoint(ctx, d, meta)
+}
+
+func ReadPrivatelinkEndpoint(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	providerResourceId := d.Id()
+
+	endpoint, err := findProvisionedPrivatelinkEndpoint(ctx, client, providerResourceId)
+	if err != nil {
+		if errors.Is(err, collections.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query privatelink endpoint. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Privatelink endpoint provider resource id: %s, Err: %s", providerResourceId, err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := errors.Join(
+		d.Set("provider_resource_id", endpoint.ProviderResourceId),
+		d.Set("host", endpoint.Host),
+		d.Set("subresource", endpoint.Subresource),
+		d.Set("snowflake_resource_id", endpoint.SnowflakeResourceId),
+		d.Set("endpoint_state", endpoint.EndpointState),
+		d.Set("status", endpoint.Status),
+	); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// DeletePrivatelinkEndpoint deprovisions the endpoint. Endpoints already deprovisioned outside of Terraform are left untouched.
+func DeletePrivatelinkEndpoint(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	providerResourceId := d.Id()
+
+	if _, err := findProvisionedPrivatelinkEndpoint(ctx, client, providerResourceId); err != nil {
+		if errors.Is(err, collections.ErrObjectNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := client.SystemFunctions.DeprovisionPrivatelinkEndpoint(ctx, providerResourceId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func findProvisionedPrivatelinkEndpoint(ctx context.Context, client *sdk.Client, providerResourceId string) (*sdk.PrivatelinkEndpointInfo, error) {
+	endpoints, err := client.SystemFunctions.GetPrivatelinkEndpointsInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(endpoints, func(endpoint sdk.PrivatelinkEndpointInfo) bool {
+		return endpoint.ProviderResourceId == providerResourceId && !sdk.IsPrivatelinkEndpointDeprovisioned(endpoint)
+	})
+}
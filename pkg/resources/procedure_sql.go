@@ -9,6 +9,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/sqlvalidation"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
@@ -21,9 +22,9 @@ import (
 
 func ProcedureSql() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ProcedureSqlResource), TrackingCreateWrapper(resources.ProcedureSql, CreateContextProcedureSql)),
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ProcedureSqlResource), TrackingCreateWrapper(resources.ProcedureSql, SqlBodyReferencesCreateWrapper("procedure_definition", sqlvalidation.BodyKindScriptingBlock, CreateContextProcedureSql))),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ProcedureSqlResource), TrackingReadWrapper(resources.ProcedureSql, ReadContextProcedureSql)),
-		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ProcedureSqlResource), TrackingUpdateWrapper(resources.ProcedureSql, UpdateProcedure("SQL", ReadContextProcedureSql))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ProcedureSqlResource), TrackingUpdateWrapper(resources.ProcedureSql, SqlBodyReferencesUpdateWrapper("procedure_definition", sqlvalidation.BodyKindScriptingBlock, UpdateProcedure("SQL", ReadContextProcedureSql)))),
 		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ProcedureSqlResource), TrackingDeleteWrapper(resources.ProcedureSql, DeleteProcedure)),
 		Description:   "Resource used to manage sql procedure objects. For more information, check [procedure documentation](https://docs.snowflake.com/en/sql-reference/sql/create-procedure).",
 
@@ -37,6 +38,7 @@ func ProcedureSql() *schema.Resource {
 			// When language changes, these attributes also change, causing the object to recreate either way.
 			// The only option is java staged <-> scala staged (however scala need runtime_version which may interfere).
 			RecreateWhenResourceStringFieldChangedExternally("procedure_language", "SQL"),
+			CheckSqlBodyStructure("procedure_definition", sqlvalidation.BodyKindScriptingBlock),
 		)),
 
 		Schema: collections.MergeMaps(sqlProcedureSchema, procedureParametersSchema),
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var projectionPolicyDefinition = bodyPolicyDefinition[sdk.CreateProjectionPolicyRequest, sdk.AlterProjectionPolicyRequest, sdk.ProjectionPolicy, sdk.ProjectionPolicyDescription]{
+	resource:       resources.ProjectionPolicy,
+	previewFeature: string(previewfeatures.ProjectionPolicyResource),
+	objectType:     sdk.ObjectTypeProjectionPolicy,
+	description: joinWithSpace(
+		"Resource used to manage projection policy objects. For more information, check [projection policy documentation](https://docs.snowflake.com/en/user-guide/projection-policies).",
+		"Projection policies control whether a column can be projected in the output of a query, while still allowing it to be used in the other clauses, e.g. in the `WHERE` clause.",
+	),
+	bodyDescription: joinWithSpace(
+		"Specifies the SQL expression that determines whether a column can be projected. The expression has to return `PROJECTION_CONSTRAINT(ALLOW => <boolean>)`, e.g. `PROJECTION_CONSTRAINT(ALLOW => false)`.",
+		"The policy is set on the columns of a table or a view (see `column.projection_policy` in `snowflake_table` and `snowflake_view`).",
+	),
+
+	showOutputSchema:     schemas.ShowProjectionPolicySchema,
+	describeOutputSchema: schemas.ShowProjectionPolicyDescriptionSchema,
+	toSchema:             schemas.ProjectionPolicyToSchema,
+	descriptionToSchema:  schemas.ProjectionPolicyDescriptionToSchema,
+
+	client: func(client *sdk.Client) bodyPolicyClient[sdk.CreateProjectionPolicyRequest, sdk.AlterProjectionPolicyRequest, sdk.ProjectionPolicy, sdk.ProjectionPolicyDescription] {
+		return client.ProjectionPolicies
+	},
+	newCreateRequest: sdk.NewCreateProjectionPolicyRequest,
+	withComment:      (*sdk.CreateProjectionPolicyRequest).WithComment,
+	newAlterRequest:  sdk.NewAlterProjectionPolicyRequest,
+	withRenameTo:     (*sdk.AlterProjectionPolicyRequest).WithRenameTo,
+	withSetBody:      (*sdk.AlterProjectionPolicyRequest).WithSetBody,
+	withSetComment:   (*sdk.AlterProjectionPolicyRequest).WithSetComment,
+	withUnsetComment: (*sdk.AlterProjectionPolicyRequest).WithUnsetComment,
+}
+
+func ProjectionPolicy() *schema.Resource {
+	return bodyPolicyResource(projectionPolicyDefinition)
+}
@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const recoverIfDroppedAttributeName = "recover_if_dropped"
+
+// recoverIfDroppedSchema returns the recover_if_dropped field schema. By default, the description states that the rest
+// of the configuration is applied with ALTER after the recovery; objects for which only a part of the configuration
+// is applied should pass the appliedConfigurationDescription describing it.
+func recoverIfDroppedSchema(objectType sdk.ObjectType, appliedConfigurationDescription ...string) *schema.Schema {
+	appliedConfiguration := "After the recovery, the rest of the configuration is applied with `ALTER`."
+	if len(appliedConfigurationDescription) > 0 {
+		appliedConfiguration = joinWithSpace(appliedConfigurationDescription...)
+	}
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Description: joinWithSpace(
+			fmt.Sprintf("Specifies whether to recover a recently dropped %s with the same name (using `UNDROP %s`) instead of creating a new one.", strings.ToLower(string(objectType)), objectType),
+			"The object can be recovered only if it is still within the Time Travel retention period. If there are multiple dropped objects with the same name, the most recently dropped one is recovered.",
+			appliedConfiguration,
+			"Modifying the parameter after the object is already created won't have any effect.",
+		),
+		DiffSuppressFunc: IgnoreAfterCreation,
+	}
+}
+
+func recoveredObjectDiagnostics(objectType sdk.ObjectType, id sdk.ObjectIdentifier) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Recovered dropped %s.", objectType),
+			Detail:   fmt.Sprintf("%s %s was recovered with UNDROP instead of being created, because %s is set to true. The next plan may show differences between the recovered object and the configuration.", objectType, id.FullyQualifiedName(), recoverIfDroppedAttributeName),
+		},
+	}
+}
+
+// recoverDroppedDatabase undrops the database if it was dropped and there is no existing database with the same name.
+// It returns true if the database was recovered.
+func recoverDroppedDatabase(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier) (bool, error) {
+	databases, err := client.Databases.Show(ctx, &sdk.ShowDatabasesOptions{
+		History: sdk.Bool(true),
+		Like:    &sdk.Like{Pattern: sdk.String(id.Name())},
+	})
+	if err != nil {
+		return false, err
+	}
+	databases = slices.DeleteFunc(databases, func(database sdk.Database) bool { return database.Name != id.Name() })
+	if !hasOnlyDroppedObjects(databases, func(database sdk.Database) bool { return !database.DroppedOn.IsZero() }) {
+		return false, nil
+	}
+	log.Printf("[DEBUG] found dropped database %s, undropping it", id.FullyQualifiedName())
+	return true, client.Databases.Undrop(ctx, id)
+}
+
+// recoverDroppedSchema undrops the schema if it was dropped and there is no existing schema with the same name.
+// It returns true if the schema was recovered.
+func recoverDroppedSchema(ctx context.Context, client *sdk.Client, id sdk.DatabaseObjectIdentifier) (bool, error) {
+	schemas, err := client.Schemas.Show(ctx, &sdk.ShowSchemaOptions{
+		History: sdk.Bool(true),
+		Like:    &sdk.Like{Pattern: sdk.String(id.Name())},
+		In: &sdk.SchemaIn{
+			Database: sdk.Bool(true),
+			Name:     id.DatabaseId(),
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	schemas = slices.DeleteFunc(schemas, func(schema sdk.Schema) bool { return schema.Name != id.Name() })
+	if !hasOnlyDroppedObjects(schemas, func(schema sdk.Schema) bool { return !schema.DroppedOn.IsZero() }) {
+		return false, nil
+	}
+	log.Printf("[DEBUG] found dropped schema %s, undropping it", id.FullyQualifiedName())
+	return true, client.Schemas.Undrop(ctx, id)
+}
+
+// recoverDroppedTable undrops the table if it was dropped and there is no existing table with the same name.
+// It returns true if the table was recovered.
+func recoverDroppedTable(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier) (bool, error) {
+	tables, err := client.Tables.Show(ctx, sdk.NewShowTableRequest().
+		WithHistory(sdk.Bool(true)).
+		WithLike(sdk.Like{Pattern: sdk.String(id.Name())}).
+		WithIn(sdk.ExtendedIn{In: sdk.In{Schema: id.SchemaId()}}),
+	)
+	if err != nil {
+		return false, err
+	}
+	tables = slices.DeleteFunc(tables, func(table sdk.Table) bool { return table.Name != id.Name() })
+	if !hasOnlyDroppedObjects(tables, func(table sdk.Table) bool { return table.DroppedOn != nil && *table.DroppedOn != "" }) {
+		return false, nil
+	}
+	log.Printf("[DEBUG] found dropped table %s, undropping it", id.FullyQualifiedName())
+	return true, client.Tables.Undrop(ctx, sdk.NewUndropTableRequest(id))
+}
+
+// recoverDroppedTag tries to undrop the tag. SHOW TAGS does not support the HISTORY keyword, so the dropped tag cannot be found upfront.
+// UNDROP errors stating that there is no dropped tag, or that there is an existing tag with the same name, are treated as nothing to recover,
+// so that the regular creation can proceed (and fail with a meaningful error if needed). Other errors (e.g. insufficient privileges) are returned.
+// It returns true if the tag was recovered.
+func recoverDroppedTag(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier) (bool, error) {
+	err := client.Tags.Undrop(ctx, sdk.NewUndropTagRequest(id))
+	if err == nil {
+		return true, nil
+	}
+	if isNotRecoverableUndropError(err) {
+		log.Printf("[DEBUG] could not undrop tag %s, proceeding with creation, err = %s", id.FullyQualifiedName(), err)
+		return false, nil
+	}
+	return false, err
+}
+
+func isNotRecoverableUndropError(err error) bool {
+	return errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) ||
+		strings.Contains(err.Error(), "does not exist") ||
+		strings.Contains(err.Error(), "already exists")
+}
+
+func hasOnlyDroppedObjects[T any](objects []T, isDropped func(T) bool) bool {
+	return len(objects) > 0 && !slices.ContainsFunc(objects, func(o T) bool { return !isDropped(o) })
+}
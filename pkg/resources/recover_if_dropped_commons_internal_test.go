@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isNotRecoverableUndropError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "object does not exist or not authorized", err: fmt.Errorf("undrop failed: %w", sdk.ErrObjectNotExistOrAuthorized), expected: true},
+		{name: "does not exist", err: errors.New("002003 (02000): SQL compilation error: Tag 'T' does not exist."), expected: true},
+		{name: "already exists", err: errors.New("002002 (42710): SQL compilation error: Object 'T' already exists."), expected: true},
+		{name: "insufficient privileges", err: errors.New("003001 (42501): SQL access control error: Insufficient privileges to operate on schema 'S'"), expected: false},
+		{name: "other error", err: errors.New("connection reset"), expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isNotRecoverableUndropError(tc.err))
+		})
+	}
+}
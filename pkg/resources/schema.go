@@ -35,6 +35,7 @@ var schemaSchema = map[string]*schema.Schema{
 		ForceNew:         true,
 		DiffSuppressFunc: suppressIdentifierQuoting,
 	},
+	"recover_if_dropped": recoverIfDroppedSchema(sdk.ObjectTypeSchema),
 	"with_managed_access": {
 		Type:             schema.TypeString,
 		Optional:         true,
@@ -56,6 +57,13 @@ var schemaSchema = map[string]*schema.Schema{
 			return slices.Contains(sdk.ParseCommaSeparatedStringArray(x.(string), false), "TRANSIENT")
 		}),
 	},
+	"classification_profile": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the classification profile used for the automatic sensitive data classification of the schema. Snowflake does not return the classification profile set on the schema, so the provider is not able to detect external changes of this field.", resources.ClassificationProfile),
+	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -190,6 +198,17 @@ func CreateContextSchema(ctx context.Context, d *schema.ResourceData, meta any)
 		}
 	}
 
+	if d.Get("recover_if_dropped").(bool) {
+		recovered, err := recoverDroppedSchema(ctx, client, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if recovered {
+			d.SetId(helpers.EncodeResourceIdentifier(id))
+			return append(recoveredObjectDiagnostics(sdk.ObjectTypeSchema, id), UpdateContextSchema(ctx, d, meta)...)
+		}
+	}
+
 	opts := &sdk.CreateSchemaOptions{
 		Comment: GetConfigPropertyAsPointerAllowingZeroValue[string](d, "comment"),
 	}
@@ -223,6 +242,18 @@ func CreateContextSchema(ctx context.Context, d *schema.ResourceData, meta any)
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
 
+	if v, ok := d.GetOk("classification_profile"); ok {
+		classificationProfileId, err := sdk.ParseSchemaObjectIdentifier(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := client.Schemas.Alter(ctx, id, &sdk.AlterSchemaOptions{
+			Set: &sdk.SchemaSet{ClassificationProfile: &classificationProfileId},
+		}); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting classification profile on schema %v: %w", id.FullyQualifiedName(), err))
+		}
+	}
+
 	return ReadContextSchema(false)(ctx, d, meta)
 }
 
@@ -363,6 +394,11 @@ func UpdateContextSchema(ctx context.Context, d *schema.ResourceData, meta any)
 	set := new(sdk.SchemaSet)
 	unset := new(sdk.SchemaUnset)
 
+	if err := schemaObjectIdentifierAttributeUpdate(d, "classification_profile", &set.ClassificationProfile, &unset.ClassificationProfile); err != nil {
+		d.Partial(true)
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("comment") {
 		comment := d.Get("comment").(string)
 		if len(comment) > 0 {
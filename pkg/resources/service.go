@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
@@ -54,6 +55,42 @@ var serviceSchema = func() map[string]*schema.Schema {
 			DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInShow("max_instances"),
 			Description:      "Specifies the maximum number of service instances to run.",
 		},
+		"restore_from_snapshot": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"volume": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Specifies the name of the block storage volume to restore, as defined in the service specification.",
+					},
+					"instances": {
+						Type:     schema.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &schema.Schema{
+							Type:             schema.TypeInt,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+						},
+						Description: "Specifies the IDs of the service instances whose volumes are restored. Instance IDs start at 0.",
+					},
+					"snapshot": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+						DiffSuppressFunc: suppressIdentifierQuoting,
+						Description:      relatedResourceDescription(fmt.Sprintf("Specifies the snapshot to restore the volume from. %s", exampleSchemaObjectIdentifier("snapshot")), resources.Snapshot),
+					},
+				},
+			},
+			Description: joinWithSpace(
+				"Restores a block storage volume of the service from a snapshot (`ALTER SERVICE ... RESTORE VOLUME`).",
+				"The restore is run when the service is created with this field set and every time this field changes. A running service is suspended for the restore and resumed afterwards.",
+				"Removing this field does not revert the restore. External changes on this field are not detected.",
+			),
+		},
 	}
 	return collections.MergeMaps(serviceBaseSchema(false), serviceSchema)
 }()
@@ -128,6 +165,13 @@ func CreateService(ctx context.Context, d *schema.ResourceData, meta any) diag.D
 		return diag.FromErr(err)
 	}
 	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	if v, ok := d.GetOk("restore_from_snapshot"); ok {
+		if err := restoreServiceFromSnapshot(ctx, client, id, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ReadServiceFunc(false)(ctx, d, meta)
 }
 
@@ -184,9 +228,54 @@ func UpdateService(ctx context.Context, d *schema.ResourceData, meta any) diag.D
 			return diag.FromErr(err)
 		}
 	}
+	if d.HasChange("restore_from_snapshot") {
+		if v, ok := d.GetOk("restore_from_snapshot"); ok {
+			if err := restoreServiceFromSnapshot(ctx, client, id, v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
 	return ReadServiceFunc(false)(ctx, d, meta)
 }
 
+func ToServiceRestoreRequest(value any) (sdk.RestoreRequest, error) {
+	restoreConfig := value.([]any)[0].(map[string]any)
+	snapshotId, err := sdk.ParseSchemaObjectIdentifier(restoreConfig["snapshot"].(string))
+	if err != nil {
+		return sdk.RestoreRequest{}, err
+	}
+	instances := collections.Map(restoreConfig["instances"].([]any), func(v any) int { return v.(int) })
+	return *sdk.NewRestoreRequest(restoreConfig["volume"].(string), instances, snapshotId), nil
+}
+
+// restoreServiceFromSnapshot restores the service volume from a snapshot. Snowflake requires the service to be suspended for the restore,
+// so a service that is not suspended is suspended first and resumed after the restore, even if the restore fails.
+func restoreServiceFromSnapshot(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, value any) (err error) {
+	restore, err := ToServiceRestoreRequest(value)
+	if err != nil {
+		return err
+	}
+	service, err := client.Services.ShowByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	wasSuspended := service.Status == sdk.ServiceStatusSuspended
+	if !wasSuspended {
+		if err := client.Services.Alter(ctx, sdk.NewAlterServiceRequest(id).WithSuspend(true)); err != nil {
+			return fmt.Errorf("error suspending service %s before restore, err = %w", id.FullyQualifiedName(), err)
+		}
+		defer func() {
+			if resumeErr := client.Services.Alter(ctx, sdk.NewAlterServiceRequest(id).WithResume(true)); resumeErr != nil {
+				err = errors.Join(err, fmt.Errorf("error resuming service %s after restore, err = %w", id.FullyQualifiedName(), resumeErr))
+			}
+		}()
+	}
+	if err := client.Services.Alter(ctx, sdk.NewAlterServiceRequest(id).WithRestore(restore)); err != nil {
+		return fmt.Errorf("error restoring volume %s of service %s from snapshot %s, err = %w", restore.Volume, id.FullyQualifiedName(), restore.FromSnapshot.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
 func serviceCustomFieldsHandler(d *schema.ResourceData, service *sdk.Service) error {
 	return errors.Join(
 		d.Set("max_instances", service.MaxInstances),
@@ -0,0 +1,214 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var snapshotSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the snapshot; must be unique for the schema in which the snapshot is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the snapshot."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the snapshot."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"service": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription(fmt.Sprintf("Specifies the service whose block storage volume is snapshotted. %s", exampleSchemaObjectIdentifier("service")), resources.Service),
+	},
+	"volume": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the name of the block storage volume, as defined in the service specification.",
+	},
+	"instance": {
+		Type:             schema.TypeInt,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		Description:      "Specifies the ID of the service instance whose volume is snapshotted. Instance IDs start at 0.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the snapshot.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW SNAPSHOTS` for the given snapshot.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowSnapshotSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func Snapshot() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.Snapshots.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.SnapshotResource), TrackingCreateWrapper(resources.Snapshot, CreateSnapshot)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.SnapshotResource), TrackingReadWrapper(resources.Snapshot, ReadSnapshot)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.SnapshotResource), TrackingUpdateWrapper(resources.Snapshot, UpdateSnapshot)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.SnapshotResource), TrackingDeleteWrapper(resources.Snapshot, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage snapshots of Snowpark Container Services block storage volumes. For more information, check [snapshot documentation](https://docs.snowflake.com/en/sql-reference/sql/create-snapshot).",
+			"To restore a volume from a snapshot, use `restore_from_snapshot` in `snowflake_service`.",
+		),
+
+		Schema: snapshotSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.Snapshot, ImportSnapshot),
+		},
+		Timeouts: defaultTimeouts,
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.Snapshot, customdiff.All(
+			ComputedIfAnyAttributeChanged(snapshotSchema, ShowOutputAttributeName, "comment"),
+		)),
+	}
+}
+
+func ImportSnapshot(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ImportName[sdk.SchemaObjectIdentifier](ctx, d, nil); err != nil {
+		return nil, err
+	}
+
+	snapshot, err := client.Snapshots.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// SHOW SNAPSHOTS returns only the service name, so the service is assumed to be in the same schema as the snapshot.
+	if err := errors.Join(
+		d.Set("service", sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), snapshot.ServiceName).FullyQualifiedName()),
+		d.Set("volume", snapshot.VolumeName),
+		d.Set("instance", snapshot.Instance),
+	); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateSnapshot(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	serviceId, err := sdk.ParseSchemaObjectIdentifier(d.Get("service").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateSnapshotRequest(id, serviceId, d.Get("volume").(string), d.Get("instance").(int))
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.Snapshots.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating snapshot %s, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadSnapshot(ctx, d, meta)
+}
+
+func ReadSnapshot(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshot, err := client.Snapshots.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query snapshot. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Snapshot id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	errs := errors.Join(
+		d.Set("volume", snapshot.VolumeName),
+		d.Set("instance", snapshot.Instance),
+		d.Set("comment", snapshot.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.SnapshotToSchema(snapshot)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateSnapshot(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("comment") {
+		request := sdk.NewAlterSnapshotRequest(id)
+		if v, ok := d.GetOk("comment"); ok {
+			request.WithSetComment(v.(string))
+		} else {
+			request.WithUnsetComment(true)
+		}
+		if err := client.Snapshots.Alter(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating snapshot %s, err = %w", d.Id(), err))
+		}
+	}
+
+	return ReadSnapshot(ctx, d, meta)
+}
@@ -0,0 +1,290 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var storageLifecyclePolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the storage lifecycle policy; must be unique for the database and schema in which the storage lifecycle policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the storage lifecycle policy."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the storage lifecycle policy."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"argument": {
+		Type:     schema.TypeList,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The argument name.",
+					ForceNew:    true,
+				},
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      dataTypeFieldDescription("The argument type. VECTOR data types are not yet supported."),
+					DiffSuppressFunc: DiffSuppressDataTypes,
+					ValidateDiagFunc: IsDataTypeValid,
+					StateFunc:        DataTypeStateFunc,
+					ForceNew:         true,
+				},
+			},
+		},
+		Required: true,
+		Description: joinWithSpace(
+			"List of the arguments for the storage lifecycle policy. The values of the arguments come from the table columns specified when the policy is added to a table (see `storage_lifecycle_policy.on` in `snowflake_table`).",
+			"If any argument name or type is changed, the resource is recreated.",
+		),
+		ForceNew: true,
+	},
+	"body": {
+		Type:     schema.TypeString,
+		Required: true,
+		Description: diffSuppressStatementFieldDescription(joinWithSpace(
+			"Specifies the SQL expression that determines which rows are affected by the policy. The expression has to return a boolean value, e.g. `event_ts < DATEADD(day, -365, CURRENT_TIMESTAMP())`.",
+			"The rows for which the expression returns `TRUE` are archived (when `archive_tier` is set) or expired.",
+		)),
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"archive_tier": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToStorageLifecyclePolicyArchiveTier),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToStorageLifecyclePolicyArchiveTier),
+		Description: joinWithSpace(
+			fmt.Sprintf("Specifies the storage tier for the archived rows. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllStorageLifecyclePolicyArchiveTiers)),
+			"When not set, the matching rows are expired instead of archived. The archive tier can't be changed after the policy is created, so changing this field recreates the resource.",
+		),
+	},
+	"archive_for_days": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		Description:      "Specifies the number of days the rows are kept in the archive tier before they are expired. Can be set only together with `archive_tier`.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the storage lifecycle policy.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW STORAGE LIFECYCLE POLICIES` for the given storage lifecycle policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowStorageLifecyclePolicySchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE STORAGE LIFECYCLE POLICY` for the given storage lifecycle policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.StorageLifecyclePolicyDescribeSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func StorageLifecyclePolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.StorageLifecyclePolicies.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.StorageLifecyclePolicyResource), TrackingCreateWrapper(resources.StorageLifecyclePolicy, CreateStorageLifecyclePolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.StorageLifecyclePolicyResource), TrackingReadWrapper(resources.StorageLifecyclePolicy, ReadStorageLifecyclePolicy)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.StorageLifecyclePolicyResource), TrackingUpdateWrapper(resources.StorageLifecyclePolicy, UpdateStorageLifecyclePolicy)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.StorageLifecyclePolicyResource), TrackingDeleteWrapper(resources.StorageLifecyclePolicy, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage storage lifecycle policy objects. For more information, check [storage lifecycle policy documentation](https://docs.snowflake.com/en/user-guide/storage-management/storage-lifecycle-policies).",
+			"Storage lifecycle policies archive or expire the table rows matching the policy expression. To add the policy to a table, use `storage_lifecycle_policy` in `snowflake_table`.",
+		),
+
+		Schema: storageLifecyclePolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.StorageLifecyclePolicy, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+		Timeouts: defaultTimeouts,
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.StorageLifecyclePolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(storageLifecyclePolicySchema, ShowOutputAttributeName, "comment", "name"),
+			ComputedIfAnyAttributeChanged(storageLifecyclePolicySchema, DescribeOutputAttributeName, "body", "name", "archive_for_days"),
+			ComputedIfAnyAttributeChanged(storageLifecyclePolicySchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+	}
+}
+
+func CreateStorageLifecyclePolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	args, err := HandleNestedDataTypeCreate(d, "argument", "type", func(v map[string]any, dataType datatypes.DataType) (sdk.CreateStorageLifecyclePolicyArgsRequest, error) {
+		return *sdk.NewCreateStorageLifecyclePolicyArgsRequest(v["name"].(string), dataType), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateStorageLifecyclePolicyRequest(id, args, d.Get("body").(string))
+	errs := errors.Join(
+		attributeMappedValueCreateBuilder(d, "archive_tier", request.WithArchiveTier, sdk.ToStorageLifecyclePolicyArchiveTier),
+		intAttributeWithSpecialDefaultCreateBuilder(d, "archive_for_days", request.WithArchiveForDays),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.StorageLifecyclePolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating storage lifecycle policy %s, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadStorageLifecyclePolicy(ctx, d, meta)
+}
+
+func ReadStorageLifecyclePolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy, err := client.StorageLifecyclePolicies.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query storage lifecycle policy. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Storage lifecycle policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	description, err := client.StorageLifecyclePolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := HandleNestedDataTypeSet(d, "argument", "type", description.Signature,
+		func(signature sdk.TableColumnSignature) datatypes.DataType { return signature.Type },
+		func(signature sdk.TableColumnSignature, arg map[string]any, _ map[string]any) {
+			arg["name"] = signature.Name
+		},
+	); err != nil {
+		return diag.FromErr(err)
+	}
+
+	archiveTier := ""
+	if description.ArchiveTier != nil {
+		archiveTier = *description.ArchiveTier
+	}
+	archiveForDays := IntDefault
+	if description.ArchiveForDays != nil {
+		archiveForDays = *description.ArchiveForDays
+	}
+
+	errs := errors.Join(
+		d.Set("body", description.Body),
+		d.Set("archive_tier", archiveTier),
+		d.Set("archive_for_days", archiveForDays),
+		d.Set("comment", policy.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.StorageLifecyclePolicyToSchema(policy)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.StorageLifecyclePolicyDescriptionToSchema(*description)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateStorageLifecyclePolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming storage lifecycle policy %s, err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("body") {
+		if err := client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(id).WithSetBody(d.Get("body").(string))); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating body of storage lifecycle policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	set, unset := sdk.NewStorageLifecyclePolicySetRequest(), sdk.NewStorageLifecyclePolicyUnsetRequest()
+	errs := errors.Join(
+		intAttributeWithSpecialDefaultUpdate(d, "archive_for_days", &set.ArchiveForDays, &unset.ArchiveForDays),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if !reflect.DeepEqual(*set, *sdk.NewStorageLifecyclePolicySetRequest()) {
+		if err := client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(id).WithSet(*set)); err != nil {
+			d.Partial(true)
+			return diag.FromErr(fmt.Errorf("error updating storage lifecycle policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unset, *sdk.NewStorageLifecyclePolicyUnsetRequest()) {
+		if err := client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(id).WithUnset(*unset)); err != nil {
+			d.Partial(true)
+			return diag.FromErr(fmt.Errorf("error updating storage lifecycle policy %s, err = %w", d.Id(), err))
+		}
+	}
+
+	return ReadStorageLifecyclePolicy(ctx, d, meta)
+}
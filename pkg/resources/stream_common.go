@@ -2,9 +2,12 @@ package resources
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -41,7 +44,13 @@ var streamCommonSchema = map[string]*schema.Schema{
 	"stale": {
 		Type:        schema.TypeBool,
 		Computed:    true,
-		Description: "Indicated if the stream is stale. When Terraform detects that the stream is stale, the stream is recreated with `CREATE OR REPLACE`. Read more on stream staleness in Snowflake [docs](https://docs.snowflake.com/en/user-guide/streams-intro#data-retention-period-and-staleness).",
+		Description: "Indicated if the stream is stale or invalid (e.g. its source object was recreated). When Terraform detects that the stream is stale and `recreate_when_stale` is enabled, the stream is recreated with `CREATE OR REPLACE`. Read more on stream staleness in Snowflake [docs](https://docs.snowflake.com/en/user-guide/streams-intro#data-retention-period-and-staleness).",
+	},
+	"recreate_when_stale": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether the stream should be recreated with `CREATE OR REPLACE` when Terraform detects that it is stale or invalid. When disabled, Terraform only emits a warning. Additionally, a warning is emitted when the stream is about to become stale (`stale_after` is within 24 hours). It is enabled by default to keep the behavior of the previous versions, which always recreated stale streams.",
 	},
 	"comment": {
 		Type:        schema.TypeString,
@@ -191,6 +200,34 @@ func handleStreamRead(d *schema.ResourceData,
 		d.Set(ShowOutputAttributeName, []map[string]any{schemas.StreamToSchema(stream)}),
 		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.StreamDescriptionToSchema(*streamDescription)}),
 		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
-		d.Set("stale", stream.Stale),
+		d.Set("stale", stream.Stale || stream.IsInvalid()),
 	)
 }
+
+// streamStaleAfterWarningThreshold defines how long before stream's `stale_after` a warning is emitted.
+const streamStaleAfterWarningThreshold = 24 * time.Hour
+
+func streamStalenessDiagnostics(d *schema.ResourceData, id sdk.SchemaObjectIdentifier, stream *sdk.Stream) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch {
+	case stream.Stale || stream.IsInvalid():
+		if !d.Get("recreate_when_stale").(bool) {
+			detail := fmt.Sprintf("Stream %s is stale or invalid, but `recreate_when_stale` is disabled, so it will not be recreated. Recreate the stream manually or enable `recreate_when_stale`.", id.FullyQualifiedName())
+			if stream.IsInvalid() {
+				detail += fmt.Sprintf(" Invalid reason: %s.", *stream.InvalidReason)
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Stream is stale",
+				Detail:   detail,
+			})
+		}
+	case stream.StaleAfter != nil && time.Until(*stream.StaleAfter) < streamStaleAfterWarningThreshold:
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Stream is about to become stale",
+			Detail:   fmt.Sprintf("Stream %s will become stale after %s. Consume the stream or extend the data retention time of the source object to prevent it.", id.FullyQualifiedName(), stream.StaleAfter.Format(time.RFC3339)),
+		})
+	}
+	return diags
+}
@@ -50,7 +50,7 @@ func StreamOnDirectoryTable() *schema.Resource {
 		Schema: streamOnDirectoryTableSchema,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.StreamOnDirectoryTable, ImportName[sdk.SchemaObjectIdentifier]),
+			StateContext: TrackingImportWrapper(resources.StreamOnDirectoryTable, ImportStreamOnDirectoryTable),
 		},
 
 		SchemaVersion: 1,
@@ -67,6 +67,17 @@ func StreamOnDirectoryTable() *schema.Resource {
 	}
 }
 
+func ImportStreamOnDirectoryTable(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] Starting stream import")
+	if _, err := ImportName[sdk.SchemaObjectIdentifier](ctx, d, nil); err != nil {
+		return nil, err
+	}
+	if err := d.Set("recreate_when_stale", true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func CreateStreamOnDirectoryTable(orReplace bool) schema.CreateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		client := meta.(*provider.Context).Client
@@ -134,7 +145,7 @@ func ReadStreamOnDirectoryTable(withDirectoryChangesMarking bool) schema.ReadCon
 			return diag.FromErr(err)
 		}
 
-		return nil
+		return streamStalenessDiagnostics(d, id, stream)
 	}
 }
 
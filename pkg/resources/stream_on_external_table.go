@@ -82,7 +82,10 @@ func ImportStreamOnExternalTable(ctx context.Context, d *schema.ResourceData, me
 	if _, err := ImportName[sdk.SchemaObjectIdentifier](ctx, d, nil); err != nil {
 		return nil, err
 	}
-	if err := d.Set("insert_only", booleanStringFromBool(v.IsInsertOnly())); err != nil {
+	if err := errors.Join(
+		d.Set("insert_only", booleanStringFromBool(v.IsInsertOnly())),
+		d.Set("recreate_when_stale", true),
+	); err != nil {
 		return nil, err
 	}
 	return []*schema.ResourceData{d}, nil
@@ -179,7 +182,7 @@ func ReadStreamOnExternalTable(withExternalChangesMarking bool) schema.ReadConte
 			return diag.FromErr(err)
 		}
 
-		return nil
+		return streamStalenessDiagnostics(d, id, stream)
 	}
 }
 
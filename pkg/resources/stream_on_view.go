@@ -93,6 +93,7 @@ func ImportStreamOnView(ctx context.Context, d *schema.ResourceData, meta any) (
 	}
 	errs := errors.Join(
 		d.Set("append_only", booleanStringFromBool(v.IsAppendOnly())),
+		d.Set("recreate_when_stale", true),
 	)
 	if experimentalfeatures.IsExperimentEnabled(experimentalfeatures.ImportBooleanDefault, providerCtx.EnabledExperiments) {
 		errs = errors.Join(errs, d.Set("show_initial_rows", BooleanDefault))
@@ -195,7 +196,7 @@ func ReadStreamOnView(withExternalChangesMarking bool) schema.ReadContextFunc {
 			return diag.FromErr(err)
 		}
 
-		return nil
+		return streamStalenessDiagnostics(d, id, stream)
 	}
 }
 
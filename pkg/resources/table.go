@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -43,6 +44,10 @@ var tableSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Description: "The database in which to create the table.",
 	},
+	"recover_if_dropped": recoverIfDroppedSchema(sdk.ObjectTypeTable,
+		"After the recovery, only `comment`, `change_tracking`, `data_retention_time_in_days`, and `cluster_by` are applied with `ALTER`.",
+		"Columns, constraints, and tags are not altered, so the recovered table may differ from the configuration; the differences are shown in the next plan.",
+	),
 	"cluster_by": {
 		Type:        schema.TypeList,
 		Elem:        &schema.Schema{Type: schema.TypeString},
@@ -143,6 +148,19 @@ var tableSchema = map[string]*schema.Schema{
 					Default:     "",
 					Description: "Masking policy to apply on column. It has to be a fully qualified name.",
 				},
+				"masking_policy_using": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MinItems:    2,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Specifies the arguments to pass into the conditional masking policy SQL expression. The first column in the list specifies the column for the policy conditions to mask or tokenize the data and must match the column to which the masking policy is set. The additional columns specify the columns to evaluate to determine whether to mask or tokenize the data in each row of the query result.",
+				},
+				"projection_policy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: relatedResourceDescription("Projection policy to apply on column. It has to be a fully qualified name.", resources.ProjectionPolicy),
+				},
 				"collate": {
 					Type:        schema.TypeString,
 					Optional:    true,
@@ -205,6 +223,150 @@ var tableSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Specifies whether to enable change tracking on the table. Default false.",
 	},
+	"is_transient": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies the table as transient. Transient tables do not have a Fail-safe period. The value is read from the table kind, so external changes (e.g. recreating the table as a permanent one) are detected and cause the resource to be recreated.",
+	},
+	"enable_schema_evolution": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Enables or disables automatic changes to the table schema from data loaded into the table from source files. Default false.",
+	},
+	"search_optimization": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to add search optimization to the table. Default false.",
+	},
+	"row_access_policy": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      relatedResourceDescription("Row access policy name.", resources.RowAccessPolicy),
+				},
+				"on": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Defines which columns are affected by the policy.",
+				},
+			},
+		},
+		Description: "Specifies the row access policy to set on a table.",
+	},
+	"aggregation_policy": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      relatedResourceDescription("Aggregation policy name.", resources.AggregationPolicy),
+				},
+				"entity_key": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Defines which columns uniquely identify an entity within the table.",
+				},
+			},
+		},
+		Description: "Specifies the aggregation policy to set on a table.",
+	},
+	"join_policy": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      relatedResourceDescription("Join policy name.", resources.JoinPolicy),
+				},
+				"allowed_join_keys": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Defines which columns can be used as join keys when joining the table.",
+				},
+			},
+		},
+		Description: "Specifies the join policy to set on a table.",
+	},
+	"privacy_policy": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      relatedResourceDescription("Privacy policy name.", resources.PrivacyPolicy),
+				},
+				"entity_key": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Defines which columns uniquely identify an entity within the table.",
+				},
+			},
+		},
+		Description: "Specifies the privacy policy to add to a table.",
+	},
+	"storage_lifecycle_policy": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      relatedResourceDescription("Storage lifecycle policy name.", resources.StorageLifecyclePolicy),
+				},
+				"on": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Defines which columns are passed to the storage lifecycle policy as arguments. The number and the types of the columns must match the signature of the policy.",
+				},
+			},
+		},
+		Description: "Specifies the storage lifecycle policy to add to a table. The policy determines which rows are archived or expired.",
+	},
 	"tag":                           tagReferenceSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
@@ -227,12 +389,35 @@ func Table() *schema.Resource {
 
 		Schema: tableSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: TrackingImportWrapper(resources.Table, ImportTable),
 		},
 		Timeouts: defaultTimeouts,
 	}
 }
 
+func ImportTable(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+
+	id := helpers.DecodeSnowflakeIDLegacy(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	// The policies are read in ReadTable only when they are present in the state, so they have to be imported here.
+	columnPolicyRefs, err := readTablePolicyReferences(ctx, client, id, d)
+	if err != nil {
+		return nil, err
+	}
+	if len(columnPolicyRefs) > 0 {
+		tableDescription, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+		if err != nil {
+			return nil, err
+		}
+		if err := d.Set("column", toColumnConfig(tableDescription, columnPolicyRefs)); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 type columnDefault struct {
 	constant   *string
 	expression *string
@@ -261,14 +446,16 @@ type columnIdentity struct {
 }
 
 type column struct {
-	name          string
-	dataType      string
-	nullable      bool
-	_default      *columnDefault
-	identity      *columnIdentity
-	comment       string
-	maskingPolicy string
-	collate       string
+	name               string
+	dataType           string
+	nullable           bool
+	_default           *columnDefault
+	identity           *columnIdentity
+	comment            string
+	maskingPolicy      string
+	maskingPolicyUsing []string
+	projectionPolicy   string
+	collate            string
 }
 
 type columns []column
@@ -276,20 +463,21 @@ type columns []column
 type changedColumns []changedColumn
 
 type changedColumn struct {
-	newColumn             column // our new column
-	changedDataType       bool
-	changedNullConstraint bool
-	droppedDefault        bool
-	changedComment        bool
-	changedMaskingPolicy  bool
-	changedCollate        bool
+	newColumn               column // our new column
+	changedDataType         bool
+	changedNullConstraint   bool
+	droppedDefault          bool
+	changedComment          bool
+	changedMaskingPolicy    bool
+	changedProjectionPolicy bool
+	changedCollate          bool
 }
 
 func (c columns) getChangedColumnProperties(new columns) (changed changedColumns) {
 	changed = make(changedColumns, 0, len(new)*len(c))
 	for _, cO := range c {
 		for _, cN := range new {
-			changeColumn := changedColumn{cN, false, false, false, false, false, false}
+			changeColumn := changedColumn{cN, false, false, false, false, false, false, false}
 			if cO.name == cN.name && cO.dataType != cN.dataType {
 				changeColumn.changedDataType = true
 			}
@@ -304,10 +492,14 @@ func (c columns) getChangedColumnProperties(new columns) (changed changedColumns
 				changeColumn.changedComment = true
 			}
 
-			if cO.name == cN.name && cO.maskingPolicy != cN.maskingPolicy {
+			if cO.name == cN.name && (cO.maskingPolicy != cN.maskingPolicy || !slices.Equal(cO.maskingPolicyUsing, cN.maskingPolicyUsing)) {
 				changeColumn.changedMaskingPolicy = true
 			}
 
+			if cO.name == cN.name && cO.projectionPolicy != cN.projectionPolicy {
+				changeColumn.changedProjectionPolicy = true
+			}
+
 			if cO.name == cN.name && cO.collate != cN.collate {
 				changeColumn.changedCollate = true
 			}
@@ -376,14 +568,16 @@ func getColumn(from interface{}) (to column) {
 	}
 
 	return column{
-		name:          c["name"].(string),
-		dataType:      c["type"].(string),
-		nullable:      c["nullable"].(bool),
-		_default:      cd,
-		identity:      id,
-		comment:       c["comment"].(string),
-		collate:       c["collate"].(string),
-		maskingPolicy: c["masking_policy"].(string),
+		name:               c["name"].(string),
+		dataType:           c["type"].(string),
+		nullable:           c["nullable"].(bool),
+		_default:           cd,
+		identity:           id,
+		comment:            c["comment"].(string),
+		collate:            c["collate"].(string),
+		maskingPolicy:      c["masking_policy"].(string),
+		maskingPolicyUsing: expandStringList(c["masking_policy_using"].([]interface{})),
+		projectionPolicy:   c["projection_policy"].(string),
 	}
 }
 
@@ -444,7 +638,16 @@ func getTableColumnRequest(from interface{}) (*sdk.TableColumnRequest, error) {
 
 	maskingPolicy := c["masking_policy"].(string)
 	if maskingPolicy != "" {
-		request.WithMaskingPolicy(sdk.NewColumnMaskingPolicyRequest(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(maskingPolicy)))
+		maskingPolicyRequest := sdk.NewColumnMaskingPolicyRequest(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(maskingPolicy))
+		if using := expandStringList(c["masking_policy_using"].([]interface{})); len(using) > 0 {
+			maskingPolicyRequest.WithUsing(snowflake.QuoteStringList(using))
+		}
+		request.WithMaskingPolicy(maskingPolicyRequest)
+	}
+
+	projectionPolicy := c["projection_policy"].(string)
+	if projectionPolicy != "" {
+		request.WithProjectionPolicy(sdk.NewColumnProjectionPolicyRequest(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(projectionPolicy)))
 	}
 
 	if datatypes.IsTextDataType(dataType) {
@@ -486,7 +689,85 @@ func getPrimaryKey(from interface{}) (to primarykey) {
 	return to
 }
 
-func toColumnConfig(descriptions []sdk.TableColumnDetails) []any {
+// hasTablePolicyReferences checks if any of the fields read from POLICY_REFERENCES is set in the configuration or in the state.
+// Querying POLICY_REFERENCES requires a running warehouse and is much slower than SHOW, so it is skipped for tables without such policies.
+func hasTablePolicyReferences(d *schema.ResourceData) bool {
+	for _, key := range []string{"row_access_policy", "aggregation_policy", "join_policy", "privacy_policy", "storage_lifecycle_policy"} {
+		if len(d.Get(key).([]any)) > 0 {
+			return true
+		}
+	}
+	for _, c := range d.Get("column").([]any) {
+		column := c.(map[string]any)
+		if len(column["masking_policy_using"].([]any)) > 0 || column["projection_policy"].(string) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// readTablePolicyReferences sets the policies set directly on the table and returns the policies set on the table columns.
+func readTablePolicyReferences(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) (map[string][]sdk.PolicyReference, error) {
+	policyRefs, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(id, sdk.PolicyEntityDomainTable))
+	if err != nil {
+		return nil, fmt.Errorf("getting policy references for table: %w", err)
+	}
+	tablePolicyRefs, columnPolicyRefs := splitTablePolicyReferences(policyRefs)
+	if err := handlePolicyReferences(tablePolicyRefs, d); err != nil {
+		return nil, err
+	}
+	if err := handleStorageLifecyclePolicyReferences(policyRefs, d); err != nil {
+		return nil, err
+	}
+	return columnPolicyRefs, nil
+}
+
+// splitTablePolicyReferences separates the policies set directly on the table (row access, aggregation, join, and privacy policies)
+// from the policies set directly on the table columns (masking and projection policies), grouped by the column name.
+// Policies set through tags are skipped. Storage lifecycle policies are handled separately by handleStorageLifecyclePolicyReferences.
+func splitTablePolicyReferences(policyRefs []sdk.PolicyReference) ([]sdk.PolicyReference, map[string][]sdk.PolicyReference) {
+	tablePolicyRefs := make([]sdk.PolicyReference, 0)
+	columnPolicyRefs := make(map[string][]sdk.PolicyReference)
+	for _, p := range policyRefs {
+		if p.TagName != nil && *p.TagName != "" {
+			continue
+		}
+		switch p.PolicyKind {
+		case sdk.PolicyKindRowAccessPolicy, sdk.PolicyKindAggregationPolicy, sdk.PolicyKindJoinPolicy, sdk.PolicyKindPrivacyPolicy:
+			tablePolicyRefs = append(tablePolicyRefs, p)
+		case sdk.PolicyKindMaskingPolicy, sdk.PolicyKindProjectionPolicy:
+			if p.RefColumnName != nil {
+				columnPolicyRefs[*p.RefColumnName] = append(columnPolicyRefs[*p.RefColumnName], p)
+			}
+		case sdk.PolicyKindStorageLifecyclePolicy:
+			continue
+		default:
+			log.Printf("[DEBUG] unexpected policy kind %v in policy references returned from Snowflake", p.PolicyKind)
+		}
+	}
+	return tablePolicyRefs, columnPolicyRefs
+}
+
+// handleStorageLifecyclePolicyReferences sets the storage lifecycle policy added directly on the table.
+func handleStorageLifecyclePolicyReferences(policyRefs []sdk.PolicyReference, d *schema.ResourceData) error {
+	var storageLifecyclePolicies []map[string]any
+	for _, p := range policyRefs {
+		if p.PolicyKind != sdk.PolicyKindStorageLifecyclePolicy || (p.TagName != nil && *p.TagName != "") {
+			continue
+		}
+		var on []string
+		if p.RefArgColumnNames != nil {
+			on = sdk.ParseCommaSeparatedStringArray(*p.RefArgColumnNames, true)
+		}
+		storageLifecyclePolicies = append(storageLifecyclePolicies, map[string]any{
+			"policy_name": sdk.NewSchemaObjectIdentifier(*p.PolicyDb, *p.PolicySchema, p.PolicyName).FullyQualifiedName(),
+			"on":          on,
+		})
+	}
+	return d.Set("storage_lifecycle_policy", storageLifecyclePolicies)
+}
+
+func toColumnConfig(descriptions []sdk.TableColumnDetails, columnPolicyRefs map[string][]sdk.PolicyReference) []any {
 	flattened := make([]any, 0)
 	for _, td := range descriptions {
 		if td.Kind != "COLUMN" {
@@ -511,6 +792,19 @@ func toColumnConfig(descriptions []sdk.TableColumnDetails) []any {
 			flat["masking_policy"] = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(*td.PolicyName).FullyQualifiedName()
 		}
 
+		for _, p := range columnPolicyRefs[td.Name] {
+			switch p.PolicyKind {
+			case sdk.PolicyKindMaskingPolicy:
+				if p.RefArgColumnNames != nil {
+					if args := sdk.ParseCommaSeparatedStringArray(*p.RefArgColumnNames, true); len(args) > 0 {
+						flat["masking_policy_using"] = append([]string{td.Name}, args...)
+					}
+				}
+			case sdk.PolicyKindProjectionPolicy:
+				flat["projection_policy"] = sdk.NewSchemaObjectIdentifier(*p.PolicyDb, *p.PolicySchema, p.PolicyName).FullyQualifiedName()
+			}
+		}
+
 		identity := toColumnIdentityConfig(td)
 		if identity != nil {
 			flat["identity"] = []any{identity}
@@ -593,6 +887,20 @@ func CreateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 	name := d.Get("name").(string)
 	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
+	if d.Get("recover_if_dropped").(bool) {
+		recovered, err := recoverDroppedTable(ctx, client, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if recovered {
+			d.SetId(helpers.EncodeSnowflakeID(id))
+			if err := alterRecoveredTable(ctx, client, id, d); err != nil {
+				return diag.FromErr(err)
+			}
+			return append(recoveredObjectDiagnostics(sdk.ObjectTypeTable, id), ReadTable(ctx, d, meta)...)
+		}
+	}
+
 	tableColumnRequests, err := getTableColumnRequests(d.Get("column").([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
@@ -629,6 +937,30 @@ func CreateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 		createRequest.WithChangeTracking(sdk.Bool(v.(bool)))
 	}
 
+	if v, ok := d.GetOk("is_transient"); ok && v.(bool) {
+		createRequest.WithKind(sdk.Pointer(sdk.TransientTableKind))
+	}
+
+	if v, ok := d.GetOk("enable_schema_evolution"); ok {
+		createRequest.WithEnableSchemaEvolution(sdk.Bool(v.(bool)))
+	}
+
+	if v := d.Get("row_access_policy"); len(v.([]any)) > 0 {
+		policyId, on, err := extractPolicyWithColumnsSet(v, "on")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		createRequest.WithRowAccessPolicy(&sdk.RowAccessPolicyRequest{Name: policyId, On: quotedColumnNames(on)})
+	}
+
+	if v := d.Get("aggregation_policy"); len(v.([]any)) > 0 {
+		policyId, entityKey, err := extractPolicyWithColumnsSet(v, "entity_key")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		createRequest.WithAggregationPolicy(&sdk.AggregationPolicyRequest{Name: policyId, EntityKey: entityKey})
+	}
+
 	var tagAssociationRequests []sdk.TagAssociationRequest
 	if _, ok := d.GetOk("tag"); ok {
 		tagAssociations := getPropertyTags(d, "tag")
@@ -646,9 +978,92 @@ func CreateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
+	if v, ok := d.GetOk("search_optimization"); ok && v.(bool) {
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSearchOptimizationAction(sdk.NewTableSearchOptimizationActionLegacyRequest().WithAddSearchOptimization(sdk.Bool(true))))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error adding search optimization to table %v err = %w", name, err))
+		}
+	}
+
+	if v := d.Get("join_policy"); len(v.([]any)) > 0 {
+		policyId, allowedJoinKeys, err := extractPolicyWithColumnsSet(v, "allowed_join_keys")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		joinPolicyReq := sdk.NewTableSetJoinPolicyRequest(policyId)
+		if len(allowedJoinKeys) > 0 {
+			joinPolicyReq.WithAllowedJoinKeys(allowedJoinKeys)
+		}
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetJoinPolicy(joinPolicyReq))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error setting join policy on table %v err = %w", name, err))
+		}
+	}
+
+	if v := d.Get("privacy_policy"); len(v.([]any)) > 0 {
+		policyId, entityKey, err := extractPolicyWithColumnsSet(v, "entity_key")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithAddPrivacyPolicy(sdk.NewTableAddPrivacyPolicyRequest(policyId, entityKey)))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error adding privacy policy to table %v err = %w", name, err))
+		}
+	}
+
+	if v := d.Get("storage_lifecycle_policy"); len(v.([]any)) > 0 {
+		policyId, on, err := extractPolicyWithColumnsSet(v, "on")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithAddStorageLifecyclePolicy(sdk.NewTableAddStorageLifecyclePolicyRequest(policyId, on)))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error adding storage lifecycle policy to table %v err = %w", name, err))
+		}
+	}
+
 	return ReadTable(ctx, d, meta)
 }
 
+func quotedColumnNames(columns []sdk.Column) []string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Value
+	}
+	return snowflake.QuoteStringList(names)
+}
+
+// alterRecoveredTable applies the table-level properties from the configuration to the recovered table.
+// Columns, constraints, and tags are not altered here; any differences are shown in the next plan.
+func alterRecoveredTable(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	var runSetStatement bool
+	setRequest := sdk.NewTableSetRequest()
+	if v, ok := d.GetOk("comment"); ok {
+		runSetStatement = true
+		setRequest.WithComment(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("change_tracking"); ok {
+		runSetStatement = true
+		setRequest.WithChangeTracking(sdk.Bool(v.(bool)))
+	}
+	if v := d.Get("data_retention_time_in_days"); v.(int) != IntDefault {
+		runSetStatement = true
+		setRequest.WithDataRetentionTimeInDays(sdk.Int(v.(int)))
+	}
+	if runSetStatement {
+		if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSet(setRequest)); err != nil {
+			return fmt.Errorf("error updating recovered table: %w", err)
+		}
+	}
+
+	if v, ok := d.GetOk("cluster_by"); ok {
+		if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithClusteringAction(sdk.NewTableClusteringActionRequest().WithClusterBy(expandStringList(v.([]interface{}))))); err != nil {
+			return fmt.Errorf("error updating recovered table: %w", err)
+		}
+	}
+	return nil
+}
+
 func ReadTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
 
@@ -697,16 +1112,27 @@ func ReadTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagn
 		return diag.FromErr(err)
 	}
 
+	var columnPolicyRefs map[string][]sdk.PolicyReference
+	if hasTablePolicyReferences(d) {
+		columnPolicyRefs, err = readTablePolicyReferences(ctx, client, id, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// Set the relevant data in the state
 	toSet := map[string]interface{}{
-		"name":            table.Name,
-		"owner":           table.Owner,
-		"database":        table.DatabaseName,
-		"schema":          table.SchemaName,
-		"comment":         table.Comment,
-		"column":          toColumnConfig(tableDescription),
-		"cluster_by":      table.GetClusterByKeys(),
-		"change_tracking": table.ChangeTracking,
+		"name":                    table.Name,
+		"owner":                   table.Owner,
+		"database":                table.DatabaseName,
+		"schema":                  table.SchemaName,
+		"comment":                 table.Comment,
+		"column":                  toColumnConfig(tableDescription, columnPolicyRefs),
+		"cluster_by":              table.GetClusterByKeys(),
+		"change_tracking":         table.ChangeTracking,
+		"is_transient":            table.Kind == string(sdk.TransientTableKind),
+		"enable_schema_evolution": table.EnableSchemaEvolution,
+		"search_optimization":     table.SearchOptimization,
 	}
 	if v := d.Get("data_retention_time_in_days"); v.(int) != IntDefault || int64(table.RetentionTime) != schemaRetentionTime {
 		toSet["data_retention_time_in_days"] = table.RetentionTime
@@ -760,6 +1186,16 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 		setRequest.WithChangeTracking(sdk.Bool(changeTracking))
 	}
 
+	if d.HasChange("enable_schema_evolution") {
+		if d.Get("enable_schema_evolution").(bool) {
+			runSetStatement = true
+			setRequest.WithEnableSchemaEvolution(sdk.Bool(true))
+		} else {
+			runUnsetStatement = true
+			unsetRequest.WithEnableSchemaEvolution(true)
+		}
+	}
+
 	if d.HasChange("data_retention_time_in_days") {
 		if days := d.Get("data_retention_time_in_days"); days.(int) != IntDefault {
 			runSetStatement = true
@@ -800,6 +1236,19 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 		}
 	}
 
+	if d.HasChange("search_optimization") {
+		searchOptimizationRequest := sdk.NewTableSearchOptimizationActionLegacyRequest()
+		if d.Get("search_optimization").(bool) {
+			searchOptimizationRequest.WithAddSearchOptimization(sdk.Bool(true))
+		} else {
+			searchOptimizationRequest.WithDropSearchOptimization(sdk.Bool(true))
+		}
+		err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSearchOptimizationAction(searchOptimizationRequest))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating search optimization for table %v: %w", d.Id(), err))
+		}
+	}
+
 	if d.HasChange("column") {
 		t, n := d.GetChange("column")
 		removed, added, changed := getColumns(t).diffs(getColumns(n))
@@ -837,7 +1286,15 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 			}
 
 			if cA.maskingPolicy != "" {
-				addRequest.WithMaskingPolicy(sdk.NewColumnMaskingPolicyRequest(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(cA.maskingPolicy)))
+				maskingPolicyRequest := sdk.NewColumnMaskingPolicyRequest(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(cA.maskingPolicy))
+				if len(cA.maskingPolicyUsing) > 0 {
+					maskingPolicyRequest.WithUsing(snowflake.QuoteStringList(cA.maskingPolicyUsing))
+				}
+				addRequest.WithMaskingPolicy(maskingPolicyRequest)
+			}
+
+			if cA.projectionPolicy != "" {
+				addRequest.WithProjectionPolicy(sdk.NewColumnProjectionPolicyRequest(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(cA.projectionPolicy)))
 			}
 
 			if cA.comment != "" {
@@ -900,7 +1357,19 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 				if strings.TrimSpace(cA.newColumn.maskingPolicy) == "" {
 					columnAction.WithUnsetMaskingPolicy(sdk.NewTableColumnAlterUnsetMaskingPolicyActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name)))
 				} else {
-					columnAction.WithSetMaskingPolicy(sdk.NewTableColumnAlterSetMaskingPolicyActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name), sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(cA.newColumn.maskingPolicy), []string{}).WithForce(sdk.Bool(true)))
+					columnAction.WithSetMaskingPolicy(sdk.NewTableColumnAlterSetMaskingPolicyActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name), sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(cA.newColumn.maskingPolicy), snowflake.QuoteStringList(cA.newColumn.maskingPolicyUsing)).WithForce(sdk.Bool(true)))
+				}
+				err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(columnAction))
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error changing property on %v: err %w", d.Id(), err))
+				}
+			}
+			if cA.changedProjectionPolicy {
+				columnAction := sdk.NewTableColumnActionRequest()
+				if strings.TrimSpace(cA.newColumn.projectionPolicy) == "" {
+					columnAction.WithUnsetProjectionPolicy(sdk.NewTableColumnAlterUnsetProjectionPolicyActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name)))
+				} else {
+					columnAction.WithSetProjectionPolicy(sdk.NewTableColumnAlterSetProjectionPolicyActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name), sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(cA.newColumn.projectionPolicy)).WithForce(sdk.Bool(true)))
 				}
 				err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(columnAction))
 				if err != nil {
@@ -910,6 +1379,130 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 		}
 	}
 
+	if d.HasChange("row_access_policy") {
+		var addReq *sdk.TableAddRowAccessPolicyRequest
+		var dropReq *sdk.TableDropRowAccessPolicyRequest
+
+		oldRaw, newRaw := d.GetChange("row_access_policy")
+		if len(oldRaw.([]any)) > 0 {
+			oldId, _, err := extractPolicyWithColumnsSet(oldRaw, "on")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			dropReq = sdk.NewTableDropRowAccessPolicyRequest(oldId)
+		}
+		if len(newRaw.([]any)) > 0 {
+			newId, newColumns, err := extractPolicyWithColumnsSet(newRaw, "on")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			addReq = sdk.NewTableAddRowAccessPolicyRequest(newId, quotedColumnNames(newColumns))
+		}
+		req := sdk.NewAlterTableRequest(id)
+		if addReq != nil && dropReq != nil { // nolint
+			req.WithDropAndAddRowAccessPolicy(&sdk.TableDropAndAddRowAccessPolicy{
+				Drop: sdk.TableDropRowAccessPolicy{RowAccessPolicy: dropReq.RowAccessPolicy},
+				Add:  sdk.TableAddRowAccessPolicy{RowAccessPolicy: addReq.RowAccessPolicy, On: addReq.On},
+			})
+		} else if addReq != nil {
+			req.WithAddRowAccessPolicy(addReq)
+		} else if dropReq != nil {
+			req.WithDropRowAccessPolicy(dropReq)
+		}
+		err := client.Tables.Alter(ctx, req)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error altering row_access_policy for table %v: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("aggregation_policy") {
+		if v, ok := d.GetOk("aggregation_policy"); ok {
+			newId, newColumns, err := extractPolicyWithColumnsSet(v, "entity_key")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			aggregationPolicyReq := sdk.NewTableSetAggregationPolicyRequest(newId)
+			if len(newColumns) > 0 {
+				aggregationPolicyReq.WithEntityKey(newColumns)
+			}
+			err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetAggregationPolicy(aggregationPolicyReq.WithForce(true)))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error setting aggregation policy for table %v: %w", d.Id(), err))
+			}
+		} else {
+			err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithUnsetAggregationPolicy(&sdk.TableUnsetAggregationPolicyRequest{}))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting aggregation policy for table %v: %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("join_policy") {
+		if v, ok := d.GetOk("join_policy"); ok {
+			newId, newColumns, err := extractPolicyWithColumnsSet(v, "allowed_join_keys")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			joinPolicyReq := sdk.NewTableSetJoinPolicyRequest(newId)
+			if len(newColumns) > 0 {
+				joinPolicyReq.WithAllowedJoinKeys(newColumns)
+			}
+			err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetJoinPolicy(joinPolicyReq.WithForce(true)))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error setting join policy for table %v: %w", d.Id(), err))
+			}
+		} else {
+			err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithUnsetJoinPolicy(sdk.NewTableUnsetJoinPolicyRequest()))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting join policy for table %v: %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("privacy_policy") {
+		oldRaw, newRaw := d.GetChange("privacy_policy")
+		if len(oldRaw.([]any)) > 0 {
+			oldId, _, err := extractPolicyWithColumnsSet(oldRaw, "entity_key")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithDropPrivacyPolicy(sdk.NewTableDropPrivacyPolicyRequest(oldId)))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error dropping privacy policy for table %v: %w", d.Id(), err))
+			}
+		}
+		if len(newRaw.([]any)) > 0 {
+			newId, newColumns, err := extractPolicyWithColumnsSet(newRaw, "entity_key")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithAddPrivacyPolicy(sdk.NewTableAddPrivacyPolicyRequest(newId, newColumns)))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error adding privacy policy for table %v: %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("storage_lifecycle_policy") {
+		oldRaw, newRaw := d.GetChange("storage_lifecycle_policy")
+		if len(oldRaw.([]any)) > 0 {
+			err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithDropStorageLifecyclePolicy(sdk.NewTableDropStorageLifecyclePolicyRequest()))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error dropping storage lifecycle policy for table %v: %w", d.Id(), err))
+			}
+		}
+		if len(newRaw.([]any)) > 0 {
+			newId, newColumns, err := extractPolicyWithColumnsSet(newRaw, "on")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithAddStorageLifecyclePolicy(sdk.NewTableAddStorageLifecyclePolicyRequest(newId, newColumns)))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error adding storage lifecycle policy for table %v: %w", d.Id(), err))
+			}
+		}
+	}
+
 	if d.HasChange("primary_key") {
 		o, n := d.GetChange("primary_key")
 
@@ -43,6 +43,7 @@ var tagSchema = map[string]*schema.Schema{
 		Description:      blocklistedCharactersFieldDescription("The schema in which to create the tag."),
 		DiffSuppressFunc: suppressIdentifierQuoting,
 	},
+	"recover_if_dropped": recoverIfDroppedSchema(sdk.ObjectTypeTag),
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -211,6 +212,17 @@ func CreateContextTag(ctx context.Context, d *schema.ResourceData, meta any) dia
 	database := d.Get("database").(string)
 	id := sdk.NewSchemaObjectIdentifier(database, schemaName, name)
 
+	if d.Get("recover_if_dropped").(bool) {
+		recovered, err := recoverDroppedTag(ctx, client, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if recovered {
+			d.SetId(helpers.EncodeResourceIdentifier(id))
+			return append(recoveredObjectDiagnostics(sdk.ObjectTypeTag, id), UpdateContextTag(ctx, d, meta)...)
+		}
+	}
+
 	request := sdk.NewCreateTagRequest(id)
 
 	if v, ok := d.GetOk("comment"); ok {
@@ -385,7 +397,7 @@ func UpdateContextTag(ctx context.Context, d *schema.ResourceData, meta any) dia
 		return diag.FromErr(err)
 	}
 
-	if d.HasChange("name") {
+	if d.HasChange("name") && !d.GetRawState().IsNull() {
 		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
 
 		err := client.Tags.Alter(ctx, sdk.NewAlterTagRequest(id).WithRename(newId))
@@ -11,6 +11,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/sqlvalidation"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/util"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
@@ -221,8 +222,8 @@ var taskSchema = map[string]*schema.Schema{
 
 func Task() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.Task, CreateTask),
-		UpdateContext: TrackingUpdateWrapper(resources.Task, UpdateTask),
+		CreateContext: TrackingCreateWrapper(resources.Task, SqlBodyReferencesCreateWrapper("sql_statement", sqlvalidation.BodyKindStatement, CreateTask)),
+		UpdateContext: TrackingUpdateWrapper(resources.Task, SqlBodyReferencesUpdateWrapper("sql_statement", sqlvalidation.BodyKindStatement, UpdateTask)),
 		ReadContext:   TrackingReadWrapper(resources.Task, ReadTask(true)),
 		DeleteContext: TrackingDeleteWrapper(resources.Task, DeleteTask),
 		Description:   "Resource used to manage task objects. For more information, check [task documentation](https://docs.snowflake.com/en/user-guide/tasks-intro).",
@@ -237,6 +238,7 @@ func Task() *schema.Resource {
 			ComputedIfAnyAttributeChanged(taskParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllTaskParameters), strings.ToLower)...),
 			ComputedIfAnyAttributeChanged(taskSchema, FullyQualifiedNameAttributeName, "name"),
 			taskParametersCustomDiff,
+			CheckSqlBodyStructure("sql_statement", sqlvalidation.BodyKindStatement),
 		)),
 
 		SchemaVersion: 1,
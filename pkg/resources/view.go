@@ -13,6 +13,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/sqlvalidation"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -186,7 +187,7 @@ var viewSchema = map[string]*schema.Schema{
 								Required:         true,
 								DiffSuppressFunc: suppressIdentifierQuoting,
 								ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
-								Description:      "Specifies the projection policy to set on a column.",
+								Description:      relatedResourceDescription("Specifies the projection policy to set on a column.", resources.ProjectionPolicy),
 							},
 						},
 					},
@@ -242,7 +243,7 @@ var viewSchema = map[string]*schema.Schema{
 					Required:         true,
 					DiffSuppressFunc: suppressIdentifierQuoting,
 					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
-					Description:      "Aggregation policy name.",
+					Description:      relatedResourceDescription("Aggregation policy name.", resources.AggregationPolicy),
 				},
 				"entity_key": {
 					Type:     schema.TypeSet,
@@ -256,6 +257,56 @@ var viewSchema = map[string]*schema.Schema{
 		},
 		Description: "Specifies the aggregation policy to set on a view.",
 	},
+	"join_policy": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      relatedResourceDescription("Join policy name.", resources.JoinPolicy),
+				},
+				"allowed_join_keys": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Defines which columns can be used as join keys when joining the view.",
+				},
+			},
+		},
+		Description: "Specifies the join policy to set on a view.",
+	},
+	"privacy_policy": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      relatedResourceDescription("Privacy policy name.", resources.PrivacyPolicy),
+				},
+				"entity_key": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Defines which columns uniquely identify an entity within the view.",
+				},
+			},
+		},
+		Description: "Specifies the privacy policy to add to a view.",
+	},
 	"statement": {
 		Type:             schema.TypeString,
 		Required:         true,
@@ -290,15 +341,16 @@ func View() *schema.Resource {
 	return &schema.Resource{
 		SchemaVersion: 1,
 
-		CreateContext: TrackingCreateWrapper(resources.View, CreateView(false)),
+		CreateContext: TrackingCreateWrapper(resources.View, SqlBodyReferencesCreateWrapper("statement", sqlvalidation.BodyKindQuery, CreateView(false))),
 		ReadContext:   TrackingReadWrapper(resources.View, ReadView(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.View, UpdateView),
+		UpdateContext: TrackingUpdateWrapper(resources.View, SqlBodyReferencesUpdateWrapper("statement", sqlvalidation.BodyKindQuery, UpdateView)),
 		DeleteContext: TrackingDeleteWrapper(resources.View, deleteFunc),
 		Description:   "Resource used to manage view objects. For more information, check [view documentation](https://docs.snowflake.com/en/sql-reference/sql/create-view).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.View, customdiff.All(
 			ComputedIfAnyAttributeChanged(viewSchema, ShowOutputAttributeName, "comment", "change_tracking", "is_secure", "is_temporary", "is_recursive", "statement"),
 			ComputedIfAnyAttributeChanged(viewSchema, FullyQualifiedNameAttributeName, "name"),
+			CheckSqlBodyStructure("statement", sqlvalidation.BodyKindQuery),
 		)),
 
 		Schema: viewSchema,
@@ -447,6 +499,32 @@ func CreateView(orReplace bool) schema.CreateContextFunc {
 			}
 		}
 
+		if v := d.Get("join_policy"); len(v.([]any)) > 0 {
+			policyId, columns, err := extractPolicyWithColumnsSet(v, "allowed_join_keys")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			joinPolicyReq := sdk.NewViewSetJoinPolicyRequest(policyId)
+			if len(columns) > 0 {
+				joinPolicyReq.WithAllowedJoinKeys(columns)
+			}
+			err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetJoinPolicy(*joinPolicyReq))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error setting join policy in view %v err = %w", id.Name(), err))
+			}
+		}
+
+		if v := d.Get("privacy_policy"); len(v.([]any)) > 0 {
+			policyId, columns, err := extractPolicyWithColumnsSet(v, "entity_key")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithAddPrivacyPolicy(*sdk.NewViewAddPrivacyPolicyRequest(policyId, columns)))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error adding privacy policy in view %v err = %w", id.Name(), err))
+			}
+		}
+
 		if v := d.Get("data_metric_schedule"); len(v.([]any)) > 0 {
 			var req *sdk.ViewSetDataMetricScheduleRequest
 			dmsConfig := v.([]any)[0].(map[string]any)
@@ -693,6 +771,8 @@ func ReadView(withExternalChangesMarking bool) schema.ReadContextFunc {
 
 func handlePolicyReferences(policyRefs []sdk.PolicyReference, d *schema.ResourceData) error {
 	var aggregationPolicies []map[string]any
+	var joinPolicies []map[string]any
+	var privacyPolicies []map[string]any
 	var rowAccessPolicies []map[string]any
 	for _, p := range policyRefs {
 		policyName := sdk.NewSchemaObjectIdentifier(*p.PolicyDb, *p.PolicySchema, p.PolicyName)
@@ -706,6 +786,24 @@ func handlePolicyReferences(policyRefs []sdk.PolicyReference, d *schema.Resource
 				"policy_name": policyName.FullyQualifiedName(),
 				"entity_key":  entityKey,
 			})
+		case sdk.PolicyKindJoinPolicy:
+			var allowedJoinKeys []string
+			if p.RefArgColumnNames != nil {
+				allowedJoinKeys = sdk.ParseCommaSeparatedStringArray(*p.RefArgColumnNames, true)
+			}
+			joinPolicies = append(joinPolicies, map[string]any{
+				"policy_name":       policyName.FullyQualifiedName(),
+				"allowed_join_keys": allowedJoinKeys,
+			})
+		case sdk.PolicyKindPrivacyPolicy:
+			var entityKey []string
+			if p.RefArgColumnNames != nil {
+				entityKey = sdk.ParseCommaSeparatedStringArray(*p.RefArgColumnNames, true)
+			}
+			privacyPolicies = append(privacyPolicies, map[string]any{
+				"policy_name": policyName.FullyQualifiedName(),
+				"entity_key":  entityKey,
+			})
 		case sdk.PolicyKindRowAccessPolicy:
 			var on []string
 			if p.RefArgColumnNames != nil {
@@ -722,6 +820,12 @@ func handlePolicyReferences(policyRefs []sdk.PolicyReference, d *schema.Resource
 	if err := d.Set("aggregation_policy", aggregationPolicies); err != nil {
 		return err
 	}
+	if err := d.Set("join_policy", joinPolicies); err != nil {
+		return err
+	}
+	if err := d.Set("privacy_policy", privacyPolicies); err != nil {
+		return err
+	}
 	if err := d.Set("row_access_policy", rowAccessPolicies); err != nil {
 		return err
 	}
@@ -1097,6 +1201,50 @@ func UpdateView(ctx context.Context, d *schema.ResourceData, meta any) diag.Diag
 			}
 		}
 	}
+	if d.HasChange("join_policy") {
+		if v, ok := d.GetOk("join_policy"); ok {
+			newId, newColumns, err := extractPolicyWithColumnsSet(v, "allowed_join_keys")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			joinPolicyReq := sdk.NewViewSetJoinPolicyRequest(newId)
+			if len(newColumns) > 0 {
+				joinPolicyReq.WithAllowedJoinKeys(newColumns)
+			}
+			err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetJoinPolicy(*joinPolicyReq.WithForce(true)))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error setting join policy for view %v: %w", d.Id(), err))
+			}
+		} else {
+			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithUnsetJoinPolicy(*sdk.NewViewUnsetJoinPolicyRequest()))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting join policy for view %v: %w", d.Id(), err))
+			}
+		}
+	}
+	if d.HasChange("privacy_policy") {
+		oldRaw, newRaw := d.GetChange("privacy_policy")
+		if len(oldRaw.([]any)) > 0 {
+			oldId, _, err := extractPolicyWithColumnsSet(oldRaw, "entity_key")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithDropPrivacyPolicy(*sdk.NewViewDropPrivacyPolicyRequest(oldId)))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error dropping privacy policy for view %v: %w", d.Id(), err))
+			}
+		}
+		if len(newRaw.([]any)) > 0 {
+			newId, newColumns, err := extractPolicyWithColumnsSet(newRaw, "entity_key")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithAddPrivacyPolicy(*sdk.NewViewAddPrivacyPolicyRequest(newId, newColumns)))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error adding privacy policy for view %v: %w", d.Id(), err))
+			}
+		}
+	}
 
 	return ReadView(false)(ctx, d, meta)
 }
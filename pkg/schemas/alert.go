@@ -0,0 +1,72 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeAlertSchema represents output of DESCRIBE query for the single Alert.
+var DescribeAlertSchema = map[string]*schema.Schema{
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"warehouse": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schedule": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"state": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"condition": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"action": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func AlertDetailsToSchema(details *sdk.AlertDetails) map[string]any {
+	alertDetailsSchema := make(map[string]any)
+	alertDetailsSchema["created_on"] = details.CreatedOn.String()
+	alertDetailsSchema["name"] = details.Name
+	alertDetailsSchema["database_name"] = details.DatabaseName
+	alertDetailsSchema["schema_name"] = details.SchemaName
+	alertDetailsSchema["owner"] = details.Owner
+	if details.Comment != nil {
+		alertDetailsSchema["comment"] = details.Comment
+	}
+	alertDetailsSchema["warehouse"] = details.Warehouse
+	alertDetailsSchema["schedule"] = details.Schedule
+	alertDetailsSchema["state"] = details.State
+	alertDetailsSchema["condition"] = details.Condition
+	alertDetailsSchema["action"] = details.Action
+	return alertDetailsSchema
+}
@@ -0,0 +1,34 @@
+package schemas
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ShowAlertParametersSchema = make(map[string]*schema.Schema)
+	AlertParameters           = []sdk.AlertParameter{
+		sdk.AlertParameterLogLevel,
+		sdk.AlertParameterTraceLevel,
+	}
+)
+
+func init() {
+	for _, param := range AlertParameters {
+		ShowAlertParametersSchema[strings.ToLower(string(param))] = ParameterListSchema
+	}
+}
+
+func AlertParametersToSchema(parameters []*sdk.Parameter, providerCtx *provider.Context) map[string]any {
+	alertParametersValue := make(map[string]any)
+	for _, param := range parameters {
+		if slices.Contains(AlertParameters, sdk.AlertParameter(param.Key)) {
+			alertParametersValue[strings.ToLower(param.Key)] = []map[string]any{ParameterToSchemaReducedOutput(param, providerCtx)}
+		}
+	}
+	return alertParametersValue
+}
@@ -0,0 +1,52 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var DescribeExternalAccessIntegrationDetailsSchema = map[string]*schema.Schema{
+	"id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"enabled": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"allowed_network_rules": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"allowed_api_authentication_integrations": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"allowed_authentication_secrets": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = DescribeExternalAccessIntegrationDetailsSchema
+
+func ExternalAccessIntegrationDetailsToSchema(externalAccessIntegrationDetails *sdk.ExternalAccessIntegrationDetails) map[string]any {
+	externalAccessIntegrationDetailsSchema := make(map[string]any)
+	externalAccessIntegrationDetailsSchema["id"] = externalAccessIntegrationDetails.Id.Name()
+	externalAccessIntegrationDetailsSchema["enabled"] = externalAccessIntegrationDetails.Enabled
+	externalAccessIntegrationDetailsSchema["allowed_network_rules"] = collections.Map(externalAccessIntegrationDetails.AllowedNetworkRules, sdk.SchemaObjectIdentifier.FullyQualifiedName)
+	externalAccessIntegrationDetailsSchema["allowed_api_authentication_integrations"] = collections.Map(externalAccessIntegrationDetails.AllowedApiAuthenticationIntegrations, sdk.AccountObjectIdentifier.FullyQualifiedName)
+	externalAccessIntegrationDetailsSchema["allowed_authentication_secrets"] = collections.Map(externalAccessIntegrationDetails.AllowedAuthenticationSecrets, sdk.SchemaObjectIdentifier.FullyQualifiedName)
+	externalAccessIntegrationDetailsSchema["comment"] = externalAccessIntegrationDetails.Comment
+	return externalAccessIntegrationDetailsSchema
+}
+
+var _ = ExternalAccessIntegrationDetailsToSchema
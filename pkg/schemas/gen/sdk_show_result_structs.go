@@ -4,20 +4,28 @@ import "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
 var SdkShowResultStructs = []any{
 	sdk.Account{},
+	sdk.AggregationPolicy{},
 	sdk.Alert{},
 	sdk.ApiIntegration{},
 	sdk.ApplicationPackage{},
 	sdk.ApplicationRole{},
 	sdk.Application{},
 	sdk.AuthenticationPolicy{},
+	sdk.Backup{},
+	sdk.BackupPolicy{},
+	sdk.BackupSet{},
 	sdk.CatalogIntegration{},
+	sdk.ClassificationProfile{},
 	sdk.ComputePool{},
 	sdk.Connection{},
 	sdk.CortexAgent{},
 	sdk.DatabaseRole{},
 	sdk.Database{},
+	sdk.DbtProject{},
+	sdk.DbtProjectVersion{},
 	sdk.DynamicTable{},
 	sdk.EventTable{},
+	sdk.ExternalAccessIntegration{},
 	sdk.ExternalFunction{},
 	sdk.ExternalTable{},
 	sdk.ExternalVolume{},
@@ -27,6 +35,7 @@ var SdkShowResultStructs = []any{
 	sdk.GitRepository{},
 	sdk.Grant{},
 	sdk.ImageRepository{},
+	sdk.JoinPolicy{},
 	sdk.Listing{},
 	sdk.ManagedAccount{},
 	sdk.MaskingPolicy{},
@@ -36,11 +45,14 @@ var SdkShowResultStructs = []any{
 	sdk.Notebook{},
 	sdk.NotificationIntegration{},
 	sdk.OrganizationAccount{},
+	sdk.PackagesPolicy{},
 	sdk.Parameter{},
 	sdk.PasswordPolicy{},
 	sdk.Pipe{},
+	sdk.PrivacyPolicy{},
 	sdk.PolicyReference{},
 	sdk.Procedure{},
+	sdk.ProjectionPolicy{},
 	sdk.ReplicationAccount{},
 	sdk.ReplicationDatabase{},
 	sdk.Region{},
@@ -54,8 +66,10 @@ var SdkShowResultStructs = []any{
 	sdk.Sequence{},
 	sdk.SessionPolicy{},
 	sdk.Share{},
+	sdk.Snapshot{},
 	sdk.Stage{},
 	sdk.StorageIntegration{},
+	sdk.StorageLifecyclePolicy{},
 	sdk.Streamlit{},
 	sdk.Stream{},
 	sdk.Table{},
@@ -80,4 +94,9 @@ var AdditionalStructs = []any{
 	sdk.SessionPolicyDetails{},
 	sdk.PasswordPolicyDetails{},
 	sdk.CortexAgentDetails{},
+	sdk.AggregationPolicyDescription{},
+	sdk.ProjectionPolicyDescription{},
+	sdk.JoinPolicyDescription{},
+	sdk.PrivacyPolicyDescription{},
+	sdk.PackagesPolicyDescription{},
 }
@@ -4,8 +4,11 @@ import (
 	"strconv"
 	"strings"
 
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
 )
 
 // csvUnescape reverses the CSV escaping done by the Terraform HCL generator.
@@ -56,6 +59,18 @@ func handleBooleanString[T any](condition bool, builder func(string) *T) {
 	}
 }
 
+func stringVariables(values []string) []tfconfig.Variable {
+	return collections.Map(values, func(value string) tfconfig.Variable { return tfconfig.StringVariable(value) })
+}
+
+// multilineStringVariable returns a heredoc variable for values spanning multiple lines, because HCL does not allow newlines in quoted strings.
+func multilineStringVariable(value string) tfconfig.Variable {
+	if strings.Contains(value, "\n") {
+		return accconfig.MultilineWrapperVariable(value)
+	}
+	return tfconfig.StringVariable(value)
+}
+
 type parameterHandler struct {
 	level sdk.ParameterType
 }
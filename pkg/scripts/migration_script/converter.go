@@ -6,6 +6,9 @@ import (
 	"reflect"
 	"slices"
 	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 )
 
 type ConvertibleCsvRow[T any] interface {
@@ -55,7 +58,8 @@ func ConvertCsvInput[T ConvertibleCsvRow[R], R any](csvInputFormat [][]string, o
 		})
 	}
 
-	csvHeader := csvInputFormat[0]
+	// Lowercase the header, so the outputs with uppercase column names (e.g., from INFORMATION_SCHEMA views) can be used as well
+	csvHeader := collections.Map(csvInputFormat[0], strings.ToLower)
 
 	// Remove fields that are not present in the CSV input
 	convertibleRowFields = slices.DeleteFunc(convertibleRowFields, func(field ConvertibleRowStructField) bool {
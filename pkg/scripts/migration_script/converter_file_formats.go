@@ -0,0 +1,85 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var _ ConvertibleCsvRow[FileFormatRepresentation] = new(FileFormatCsvRow)
+
+type FileFormatCsvRow struct {
+	CreatedOn     string `csv:"created_on"`
+	Name          string `csv:"name"`
+	DatabaseName  string `csv:"database_name"`
+	SchemaName    string `csv:"schema_name"`
+	Type          string `csv:"type"`
+	Owner         string `csv:"owner"`
+	Comment       string `csv:"comment"`
+	OwnerRoleType string `csv:"owner_role_type"`
+	FormatOptions string `csv:"format_options"`
+}
+
+// FileFormatOptions holds the options returned in the format_options column of SHOW FILE FORMATS.
+// The returned options depend on the file format type, so all of them are optional.
+type FileFormatOptions struct {
+	// CSV + shared fields
+	RecordDelimiter            *string  `json:"RECORD_DELIMITER"`
+	FieldDelimiter             *string  `json:"FIELD_DELIMITER"`
+	FileExtension              *string  `json:"FILE_EXTENSION"`
+	SkipHeader                 *int     `json:"SKIP_HEADER"`
+	ParseHeader                *bool    `json:"PARSE_HEADER"`
+	DateFormat                 *string  `json:"DATE_FORMAT"`
+	TimeFormat                 *string  `json:"TIME_FORMAT"`
+	TimestampFormat            *string  `json:"TIMESTAMP_FORMAT"`
+	BinaryFormat               *string  `json:"BINARY_FORMAT"`
+	Escape                     *string  `json:"ESCAPE"`
+	EscapeUnenclosedField      *string  `json:"ESCAPE_UNENCLOSED_FIELD"`
+	TrimSpace                  *bool    `json:"TRIM_SPACE"`
+	FieldOptionallyEnclosedBy  *string  `json:"FIELD_OPTIONALLY_ENCLOSED_BY"`
+	NullIf                     []string `json:"NULL_IF"`
+	Compression                *string  `json:"COMPRESSION"`
+	ErrorOnColumnCountMismatch *bool    `json:"ERROR_ON_COLUMN_COUNT_MISMATCH"`
+	SkipBlankLines             *bool    `json:"SKIP_BLANK_LINES"`
+	ReplaceInvalidCharacters   *bool    `json:"REPLACE_INVALID_CHARACTERS"`
+	EmptyFieldAsNull           *bool    `json:"EMPTY_FIELD_AS_NULL"`
+	SkipByteOrderMark          *bool    `json:"SKIP_BYTE_ORDER_MARK"`
+	Encoding                   *string  `json:"ENCODING"`
+
+	// JSON fields
+	EnableOctal     *bool `json:"ENABLE_OCTAL"`
+	AllowDuplicate  *bool `json:"ALLOW_DUPLICATE"`
+	StripOuterArray *bool `json:"STRIP_OUTER_ARRAY"`
+
+	// Parquet fields
+	BinaryAsText *bool `json:"BINARY_AS_TEXT"`
+
+	// XML fields
+	PreserveSpace        *bool `json:"PRESERVE_SPACE"`
+	StripOuterElement    *bool `json:"STRIP_OUTER_ELEMENT"`
+	DisableSnowflakeData *bool `json:"DISABLE_SNOWFLAKE_DATA"`
+	DisableAutoConvert   *bool `json:"DISABLE_AUTO_CONVERT"`
+}
+
+type FileFormatRepresentation struct {
+	Id      sdk.SchemaObjectIdentifier
+	Type    sdk.FileFormatType
+	Comment string
+	Options FileFormatOptions
+}
+
+func (row FileFormatCsvRow) convert() (*FileFormatRepresentation, error) {
+	fileFormatRepresentation := &FileFormatRepresentation{
+		Id:      sdk.NewSchemaObjectIdentifier(row.DatabaseName, row.SchemaName, row.Name),
+		Type:    sdk.FileFormatType(row.Type),
+		Comment: row.Comment,
+	}
+	if row.FormatOptions != "" {
+		if err := json.Unmarshal([]byte(row.FormatOptions), &fileFormatRepresentation.Options); err != nil {
+			return nil, fmt.Errorf("cannot parse format options of file format %s: %w", row.Name, err)
+		}
+	}
+
+	return fileFormatRepresentation, nil
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var _ ConvertibleCsvRow[RoutineRepresentation] = new(FunctionCsvRow)
+
+// FunctionCsvRow represents a row of the INFORMATION_SCHEMA.FUNCTIONS view.
+// The handler is not a part of the view; it can be provided in an additional column (e.g., from the DESCRIBE FUNCTION output), and it's required only for the Python functions.
+type FunctionCsvRow struct {
+	FunctionCatalog    string `csv:"function_catalog"`
+	FunctionSchema     string `csv:"function_schema"`
+	FunctionName       string `csv:"function_name"`
+	FunctionOwner      string `csv:"function_owner"`
+	ArgumentSignature  string `csv:"argument_signature"`
+	DataType           string `csv:"data_type"`
+	FunctionLanguage   string `csv:"function_language"`
+	FunctionDefinition string `csv:"function_definition"`
+	IsSecure           string `csv:"is_secure"`
+	Comment            string `csv:"comment"`
+	RuntimeVersion     string `csv:"runtime_version"`
+	Packages           string `csv:"packages"`
+	Handler            string `csv:"handler"`
+}
+
+// RoutineRepresentation is a common representation of functions and procedures.
+type RoutineRepresentation struct {
+	Id             sdk.SchemaObjectIdentifierWithArguments
+	Arguments      []sdk.TableColumnSignature
+	ReturnType     string
+	Language       string
+	Definition     string
+	IsSecure       bool
+	Comment        string
+	RuntimeVersion string
+	Packages       []string
+	Handler        string
+}
+
+func (row FunctionCsvRow) convert() (*RoutineRepresentation, error) {
+	return newRoutineRepresentation(routineCsvValues{
+		databaseName:      row.FunctionCatalog,
+		schemaName:        row.FunctionSchema,
+		name:              row.FunctionName,
+		argumentSignature: row.ArgumentSignature,
+		returnType:        row.DataType,
+		language:          row.FunctionLanguage,
+		definition:        row.FunctionDefinition,
+		isSecure:          row.IsSecure,
+		comment:           row.Comment,
+		runtimeVersion:    row.RuntimeVersion,
+		packages:          row.Packages,
+		handler:           row.Handler,
+	})
+}
+
+type routineCsvValues struct {
+	databaseName      string
+	schemaName        string
+	name              string
+	argumentSignature string
+	returnType        string
+	language          string
+	definition        string
+	isSecure          string
+	comment           string
+	runtimeVersion    string
+	packages          string
+	handler           string
+}
+
+func newRoutineRepresentation(values routineCsvValues) (*RoutineRepresentation, error) {
+	arguments := make([]sdk.TableColumnSignature, 0)
+	// The signature of a routine without arguments is "()"
+	if signature := strings.TrimSpace(values.argumentSignature); signature != "" && signature != "()" {
+		parsedArguments, err := sdk.ParseTableColumnSignature(signature)
+		if err != nil {
+			return nil, fmt.Errorf("parsing argument signature of %s: %w", values.name, err)
+		}
+		arguments = parsedArguments
+	}
+
+	argumentDataTypes := make([]sdk.DataType, len(arguments))
+	for i, argument := range arguments {
+		argumentDataTypes[i] = sdk.LegacyDataTypeFrom(argument.Type)
+	}
+
+	routineRepresentation := &RoutineRepresentation{
+		Id:             sdk.NewSchemaObjectIdentifierWithArguments(values.databaseName, values.schemaName, values.name, argumentDataTypes...),
+		Arguments:      arguments,
+		ReturnType:     values.returnType,
+		Language:       strings.ToUpper(values.language),
+		Definition:     csvUnescape(values.definition),
+		IsSecure:       values.isSecure == "YES",
+		Comment:        values.comment,
+		RuntimeVersion: values.runtimeVersion,
+		Packages:       make([]string, 0),
+		Handler:        values.handler,
+	}
+	if values.packages != "" {
+		routineRepresentation.Packages = sdk.ParseCommaSeparatedStringArray(values.packages, true)
+	}
+
+	return routineRepresentation, nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var _ ConvertibleCsvRow[IntegrationRepresentation] = new(IntegrationCsvRow)
+
+type IntegrationCsvRow struct {
+	Name      string `csv:"name"`
+	Type      string `csv:"type"`
+	Category  string `csv:"category"`
+	Enabled   string `csv:"enabled"`
+	Comment   string `csv:"comment"`
+	CreatedOn string `csv:"created_on"`
+	// DESCRIBE STORAGE INTEGRATION output
+	StorageProvider         string `csv:"storage_provider"`
+	StorageAllowedLocations string `csv:"storage_allowed_locations"`
+	StorageBlockedLocations string `csv:"storage_blocked_locations"`
+	StorageAwsRoleArn       string `csv:"storage_aws_role_arn"`
+	StorageAwsObjectAcl     string `csv:"storage_aws_object_acl"`
+	AzureTenantId           string `csv:"azure_tenant_id"`
+	UsePrivatelinkEndpoint  string `csv:"use_privatelink_endpoint"`
+}
+
+type IntegrationRepresentation struct {
+	sdk.StorageIntegration
+
+	// describe output
+	StorageProvider         string
+	StorageAllowedLocations []string
+	StorageBlockedLocations []string
+	StorageAwsRoleArn       string
+	StorageAwsObjectAcl     string
+	AzureTenantId           string
+	UsePrivatelinkEndpoint  *bool
+}
+
+func (row IntegrationCsvRow) convert() (*IntegrationRepresentation, error) {
+	integrationRepresentation := &IntegrationRepresentation{
+		StorageIntegration: sdk.StorageIntegration{
+			Name:        row.Name,
+			StorageType: row.Type,
+			Category:    row.Category,
+			Enabled:     row.Enabled == "true",
+			Comment:     row.Comment,
+		},
+		StorageProvider:         row.StorageProvider,
+		StorageAllowedLocations: sdk.ParseCommaSeparatedStringArray(row.StorageAllowedLocations, false),
+		StorageBlockedLocations: sdk.ParseCommaSeparatedStringArray(row.StorageBlockedLocations, false),
+		StorageAwsRoleArn:       row.StorageAwsRoleArn,
+		StorageAwsObjectAcl:     row.StorageAwsObjectAcl,
+		AzureTenantId:           row.AzureTenantId,
+	}
+	if row.UsePrivatelinkEndpoint != "" {
+		usePrivatelinkEndpoint := row.UsePrivatelinkEndpoint == "true"
+		integrationRepresentation.UsePrivatelinkEndpoint = &usePrivatelinkEndpoint
+	}
+
+	return integrationRepresentation, nil
+}
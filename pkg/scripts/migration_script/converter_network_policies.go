@@ -0,0 +1,74 @@
+package main
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var _ ConvertibleCsvRow[NetworkPolicyRepresentation] = new(NetworkPolicyCsvRow)
+
+type NetworkPolicyCsvRow struct {
+	CreatedOn                    string `csv:"created_on"`
+	Name                         string `csv:"name"`
+	Comment                      string `csv:"comment"`
+	EntriesInAllowedIpList       string `csv:"entries_in_allowed_ip_list"`
+	EntriesInBlockedIpList       string `csv:"entries_in_blocked_ip_list"`
+	EntriesInAllowedNetworkRules string `csv:"entries_in_allowed_network_rules"`
+	EntriesInBlockedNetworkRules string `csv:"entries_in_blocked_network_rules"`
+	// DESCRIBE NETWORK POLICY output
+	AllowedIpList          string `csv:"allowed_ip_list"`
+	BlockedIpList          string `csv:"blocked_ip_list"`
+	AllowedNetworkRuleList string `csv:"allowed_network_rule_list"`
+	BlockedNetworkRuleList string `csv:"blocked_network_rule_list"`
+}
+
+type NetworkPolicyRepresentation struct {
+	sdk.NetworkPolicy
+
+	// describe output
+	AllowedIpList          []string
+	BlockedIpList          []string
+	AllowedNetworkRuleList []sdk.SchemaObjectIdentifier
+	BlockedNetworkRuleList []sdk.SchemaObjectIdentifier
+}
+
+func (row NetworkPolicyCsvRow) convert() (*NetworkPolicyRepresentation, error) {
+	networkPolicyRepresentation := &NetworkPolicyRepresentation{
+		NetworkPolicy: sdk.NetworkPolicy{
+			CreatedOn:                    row.CreatedOn,
+			Name:                         row.Name,
+			Comment:                      row.Comment,
+			EntriesInAllowedIpList:       sdk.ToIntWithDefault(row.EntriesInAllowedIpList, 0),
+			EntriesInBlockedIpList:       sdk.ToIntWithDefault(row.EntriesInBlockedIpList, 0),
+			EntriesInAllowedNetworkRules: sdk.ToIntWithDefault(row.EntriesInAllowedNetworkRules, 0),
+			EntriesInBlockedNetworkRules: sdk.ToIntWithDefault(row.EntriesInBlockedNetworkRules, 0),
+		},
+		AllowedIpList: sdk.ParseCommaSeparatedStringArray(row.AllowedIpList, false),
+		BlockedIpList: sdk.ParseCommaSeparatedStringArray(row.BlockedIpList, false),
+	}
+
+	var err error
+	if networkPolicyRepresentation.AllowedNetworkRuleList, err = parseNetworkRuleList(row.AllowedNetworkRuleList); err != nil {
+		return nil, err
+	}
+	if networkPolicyRepresentation.BlockedNetworkRuleList, err = parseNetworkRuleList(row.BlockedNetworkRuleList); err != nil {
+		return nil, err
+	}
+
+	return networkPolicyRepresentation, nil
+}
+
+// parseNetworkRuleList parses the network rule list in the format returned by DESCRIBE NETWORK POLICY,
+// e.g. [{"fullyQualifiedRuleName":"\"DB\".\"SCHEMA\".\"RULE\""}].
+func parseNetworkRuleList(value string) ([]sdk.SchemaObjectIdentifier, error) {
+	if value == "" {
+		return nil, nil
+	}
+	networkRules, err := sdk.ParseNetworkRulesSnowflakeDto(value)
+	if err != nil {
+		return nil, err
+	}
+	return collections.MapErr(networkRules, func(rule sdk.NetworkRulesSnowflakeDto) (sdk.SchemaObjectIdentifier, error) {
+		return sdk.ParseSchemaObjectIdentifier(rule.FullyQualifiedRuleName)
+	})
+}
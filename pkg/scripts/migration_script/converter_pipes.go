@@ -0,0 +1,47 @@
+package main
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var _ ConvertibleCsvRow[PipeRepresentation] = new(PipeCsvRow)
+
+type PipeCsvRow struct {
+	CreatedOn           string `csv:"created_on"`
+	Name                string `csv:"name"`
+	DatabaseName        string `csv:"database_name"`
+	SchemaName          string `csv:"schema_name"`
+	Definition          string `csv:"definition"`
+	Owner               string `csv:"owner"`
+	NotificationChannel string `csv:"notification_channel"`
+	Comment             string `csv:"comment"`
+	Integration         string `csv:"integration"`
+	Pattern             string `csv:"pattern"`
+	ErrorIntegration    string `csv:"error_integration"`
+	OwnerRoleType       string `csv:"owner_role_type"`
+	InvalidReason       string `csv:"invalid_reason"`
+}
+
+type PipeRepresentation struct {
+	sdk.Pipe
+}
+
+func (row PipeCsvRow) convert() (*PipeRepresentation, error) {
+	return &PipeRepresentation{
+		Pipe: sdk.Pipe{
+			CreatedOn:           row.CreatedOn,
+			Name:                row.Name,
+			DatabaseName:        row.DatabaseName,
+			SchemaName:          row.SchemaName,
+			Definition:          csvUnescape(row.Definition),
+			Owner:               row.Owner,
+			NotificationChannel: row.NotificationChannel,
+			Comment:             row.Comment,
+			Integration:         row.Integration,
+			Pattern:             row.Pattern,
+			ErrorIntegration:    row.ErrorIntegration,
+			OwnerRoleType:       row.OwnerRoleType,
+			InvalidReason:       row.InvalidReason,
+		},
+	}, nil
+}
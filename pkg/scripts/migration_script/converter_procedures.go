@@ -0,0 +1,38 @@
+package main
+
+var _ ConvertibleCsvRow[RoutineRepresentation] = new(ProcedureCsvRow)
+
+// ProcedureCsvRow represents a row of the INFORMATION_SCHEMA.PROCEDURES view.
+// Similarly to FunctionCsvRow, the handler can be provided in an additional column, and it's required only for the Python procedures.
+type ProcedureCsvRow struct {
+	ProcedureCatalog    string `csv:"procedure_catalog"`
+	ProcedureSchema     string `csv:"procedure_schema"`
+	ProcedureName       string `csv:"procedure_name"`
+	ProcedureOwner      string `csv:"procedure_owner"`
+	ArgumentSignature   string `csv:"argument_signature"`
+	DataType            string `csv:"data_type"`
+	ProcedureLanguage   string `csv:"procedure_language"`
+	ProcedureDefinition string `csv:"procedure_definition"`
+	IsSecure            string `csv:"is_secure"`
+	Comment             string `csv:"comment"`
+	RuntimeVersion      string `csv:"runtime_version"`
+	Packages            string `csv:"packages"`
+	Handler             string `csv:"handler"`
+}
+
+func (row ProcedureCsvRow) convert() (*RoutineRepresentation, error) {
+	return newRoutineRepresentation(routineCsvValues{
+		databaseName:      row.ProcedureCatalog,
+		schemaName:        row.ProcedureSchema,
+		name:              row.ProcedureName,
+		argumentSignature: row.ArgumentSignature,
+		returnType:        row.DataType,
+		language:          row.ProcedureLanguage,
+		definition:        row.ProcedureDefinition,
+		isSecure:          row.IsSecure,
+		comment:           row.Comment,
+		runtimeVersion:    row.RuntimeVersion,
+		packages:          row.Packages,
+		handler:           row.Handler,
+	})
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var _ ConvertibleCsvRow[StageRepresentation] = new(StageCsvRow)
+
+type StageCsvRow struct {
+	CreatedOn          string `csv:"created_on"`
+	Name               string `csv:"name"`
+	DatabaseName       string `csv:"database_name"`
+	SchemaName         string `csv:"schema_name"`
+	Url                string `csv:"url"`
+	HasCredentials     string `csv:"has_credentials"`
+	HasEncryptionKey   string `csv:"has_encryption_key"`
+	Owner              string `csv:"owner"`
+	Comment            string `csv:"comment"`
+	Region             string `csv:"region"`
+	Type               string `csv:"type"`
+	Cloud              string `csv:"cloud"`
+	StorageIntegration string `csv:"storage_integration"`
+	Endpoint           string `csv:"endpoint"`
+	OwnerRoleType      string `csv:"owner_role_type"`
+	DirectoryEnabled   string `csv:"directory_enabled"`
+}
+
+type StageRepresentation struct {
+	sdk.Stage
+}
+
+func (row StageCsvRow) convert() (*StageRepresentation, error) {
+	stageRepresentation := &StageRepresentation{
+		Stage: sdk.Stage{
+			Name:             row.Name,
+			DatabaseName:     row.DatabaseName,
+			SchemaName:       row.SchemaName,
+			Url:              row.Url,
+			HasCredentials:   row.HasCredentials == "Y",
+			HasEncryptionKey: row.HasEncryptionKey == "Y",
+			Owner:            row.Owner,
+			Comment:          row.Comment,
+			Type:             sdk.StageType(row.Type),
+			DirectoryEnabled: row.DirectoryEnabled == "Y",
+		},
+	}
+	if row.StorageIntegration != "" {
+		storageIntegration := sdk.NewAccountObjectIdentifier(row.StorageIntegration)
+		stageRepresentation.StorageIntegration = &storageIntegration
+	}
+
+	return stageRepresentation, nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var _ ConvertibleCsvRow[StreamRepresentation] = new(StreamCsvRow)
+
+type StreamCsvRow struct {
+	CreatedOn     string `csv:"created_on"`
+	Name          string `csv:"name"`
+	DatabaseName  string `csv:"database_name"`
+	SchemaName    string `csv:"schema_name"`
+	Owner         string `csv:"owner"`
+	Comment       string `csv:"comment"`
+	TableName     string `csv:"table_name"`
+	SourceType    string `csv:"source_type"`
+	BaseTables    string `csv:"base_tables"`
+	Type          string `csv:"type"`
+	Stale         string `csv:"stale"`
+	Mode          string `csv:"mode"`
+	StaleAfter    string `csv:"stale_after"`
+	InvalidReason string `csv:"invalid_reason"`
+	OwnerRoleType string `csv:"owner_role_type"`
+}
+
+type StreamRepresentation struct {
+	sdk.Stream
+}
+
+func (row StreamCsvRow) convert() (*StreamRepresentation, error) {
+	streamRepresentation := &StreamRepresentation{
+		Stream: sdk.Stream{
+			Name:         row.Name,
+			DatabaseName: row.DatabaseName,
+			SchemaName:   row.SchemaName,
+			Stale:        row.Stale == "true",
+		},
+	}
+	if row.Comment != "" {
+		streamRepresentation.Comment = &row.Comment
+	}
+	if row.TableName != "" {
+		tableName, err := sdk.ParseSchemaObjectIdentifier(row.TableName)
+		if err != nil {
+			return nil, fmt.Errorf("parsing source of stream %s: %w", row.Name, err)
+		}
+		streamRepresentation.TableName = &tableName
+	}
+	if row.SourceType != "" {
+		sourceType, err := sdk.ToStreamSourceType(row.SourceType)
+		if err != nil {
+			return nil, err
+		}
+		streamRepresentation.SourceType = &sourceType
+	}
+	if row.Mode != "" {
+		mode, err := sdk.ToStreamMode(row.Mode)
+		if err != nil {
+			return nil, err
+		}
+		streamRepresentation.Mode = &mode
+	}
+
+	return streamRepresentation, nil
+}
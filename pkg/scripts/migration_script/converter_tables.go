@@ -0,0 +1,67 @@
+package main
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var _ ConvertibleCsvRow[TableColumnRepresentation] = new(TableColumnCsvRow)
+
+// TableColumnCsvRow represents a single row of the DESCRIBE TABLE output combined with the identifier of the described table.
+type TableColumnCsvRow struct {
+	DatabaseName string `csv:"database_name"`
+	SchemaName   string `csv:"schema_name"`
+	TableName    string `csv:"table_name"`
+	TableComment string `csv:"table_comment"`
+	// DESCRIBE TABLE output
+	Name       string `csv:"name"`
+	Type       string `csv:"type"`
+	Kind       string `csv:"kind"`
+	Null       string `csv:"null?"`
+	Default    string `csv:"default"`
+	PrimaryKey string `csv:"primary key"`
+	UniqueKey  string `csv:"unique key"`
+	Check      string `csv:"check"`
+	Expression string `csv:"expression"`
+	Comment    string `csv:"comment"`
+	PolicyName string `csv:"policy name"`
+	Collation  string `csv:"collation"`
+}
+
+type TableColumnRepresentation struct {
+	sdk.TableColumnDetails
+
+	TableId      sdk.SchemaObjectIdentifier
+	TableComment string
+}
+
+func (row TableColumnCsvRow) convert() (*TableColumnRepresentation, error) {
+	tableColumnRepresentation := &TableColumnRepresentation{
+		TableColumnDetails: sdk.TableColumnDetails{
+			Name:       row.Name,
+			Type:       sdk.DataType(row.Type),
+			Kind:       row.Kind,
+			IsNullable: row.Null != "N",
+			IsPrimary:  row.PrimaryKey == "Y",
+			IsUnique:   row.UniqueKey == "Y",
+		},
+		TableId:      sdk.NewSchemaObjectIdentifier(row.DatabaseName, row.SchemaName, row.TableName),
+		TableComment: row.TableComment,
+	}
+	if row.Default != "" {
+		tableColumnRepresentation.Default = &row.Default
+	}
+	if row.Expression != "" {
+		tableColumnRepresentation.Expression = &row.Expression
+	}
+	if row.Comment != "" {
+		tableColumnRepresentation.Comment = &row.Comment
+	}
+	if row.PolicyName != "" {
+		tableColumnRepresentation.PolicyName = &row.PolicyName
+	}
+	if row.Collation != "" {
+		tableColumnRepresentation.Collation = &row.Collation
+	}
+
+	return tableColumnRepresentation, nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var _ ConvertibleCsvRow[TagRepresentation] = new(TagCsvRow)
+
+type TagCsvRow struct {
+	CreatedOn     string `csv:"created_on"`
+	Name          string `csv:"name"`
+	DatabaseName  string `csv:"database_name"`
+	SchemaName    string `csv:"schema_name"`
+	Owner         string `csv:"owner"`
+	Comment       string `csv:"comment"`
+	AllowedValues string `csv:"allowed_values"`
+	OwnerRoleType string `csv:"owner_role_type"`
+	Propagate     string `csv:"propagate"`
+	OnConflict    string `csv:"on_conflict"`
+}
+
+type TagRepresentation struct {
+	sdk.Tag
+}
+
+func (row TagCsvRow) convert() (*TagRepresentation, error) {
+	tagRepresentation := &TagRepresentation{
+		Tag: sdk.Tag{
+			Name:          row.Name,
+			DatabaseName:  row.DatabaseName,
+			SchemaName:    row.SchemaName,
+			Owner:         row.Owner,
+			Comment:       row.Comment,
+			OwnerRoleType: row.OwnerRoleType,
+		},
+	}
+	if row.AllowedValues != "" {
+		tagRepresentation.AllowedValues = sdk.ParseCommaSeparatedStringArray(row.AllowedValues, true)
+	}
+	if row.Propagate != "" {
+		propagate, err := sdk.ToTagPropagation(row.Propagate)
+		if err != nil {
+			return nil, err
+		}
+		tagRepresentation.Propagate = &propagate
+	}
+	if row.OnConflict != "" {
+		tagRepresentation.OnConflict = &row.OnConflict
+	}
+
+	return tagRepresentation, nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var _ ConvertibleCsvRow[TaskRepresentation] = new(TaskCsvRow)
+
+type TaskCsvRow struct {
+	CreatedOn                 string `csv:"created_on"`
+	Name                      string `csv:"name"`
+	Id                        string `csv:"id"`
+	DatabaseName              string `csv:"database_name"`
+	SchemaName                string `csv:"schema_name"`
+	Owner                     string `csv:"owner"`
+	Comment                   string `csv:"comment"`
+	Warehouse                 string `csv:"warehouse"`
+	Schedule                  string `csv:"schedule"`
+	Predecessors              string `csv:"predecessors"`
+	State                     string `csv:"state"`
+	Definition                string `csv:"definition"`
+	Condition                 string `csv:"condition"`
+	AllowOverlappingExecution string `csv:"allow_overlapping_execution"`
+	ErrorIntegration          string `csv:"error_integration"`
+	LastCommittedOn           string `csv:"last_committed_on"`
+	LastSuspendedOn           string `csv:"last_suspended_on"`
+	OwnerRoleType             string `csv:"owner_role_type"`
+	Config                    string `csv:"config"`
+	Budget                    string `csv:"budget"`
+}
+
+type TaskRepresentation struct {
+	sdk.Task
+}
+
+func (row TaskCsvRow) convert() (*TaskRepresentation, error) {
+	taskRepresentation := &TaskRepresentation{
+		Task: sdk.Task{
+			CreatedOn:                 row.CreatedOn,
+			Name:                      row.Name,
+			Id:                        row.Id,
+			DatabaseName:              row.DatabaseName,
+			SchemaName:                row.SchemaName,
+			Owner:                     row.Owner,
+			Comment:                   row.Comment,
+			Schedule:                  row.Schedule,
+			Definition:                csvUnescape(row.Definition),
+			Condition:                 row.Condition,
+			AllowOverlappingExecution: row.AllowOverlappingExecution == "true",
+			OwnerRoleType:             row.OwnerRoleType,
+			Config:                    row.Config,
+			Budget:                    row.Budget,
+			Predecessors:              make([]sdk.SchemaObjectIdentifier, 0),
+		},
+	}
+
+	if row.Warehouse != "" && row.Warehouse != "null" {
+		warehouseId, err := sdk.ParseAccountObjectIdentifier(row.Warehouse)
+		if err != nil {
+			return nil, fmt.Errorf("parsing warehouse of task %s: %w", row.Name, err)
+		}
+		taskRepresentation.Warehouse = &warehouseId
+	}
+	if row.ErrorIntegration != "" && row.ErrorIntegration != "null" {
+		errorIntegrationId, err := sdk.ParseAccountObjectIdentifier(row.ErrorIntegration)
+		if err != nil {
+			return nil, fmt.Errorf("parsing error integration of task %s: %w", row.Name, err)
+		}
+		taskRepresentation.ErrorIntegration = &errorIntegrationId
+	}
+	if row.State != "" {
+		state, err := sdk.ToTaskState(row.State)
+		if err != nil {
+			return nil, err
+		}
+		taskRepresentation.State = state
+	}
+	if row.Predecessors != "" {
+		// Predecessors are returned as a JSON array of fully qualified names, e.g. ["\"DB\".\"SCHEMA\".\"TASK\""].
+		var predecessors []string
+		if err := json.Unmarshal([]byte(row.Predecessors), &predecessors); err != nil {
+			return nil, fmt.Errorf("parsing predecessors of task %s: %w", row.Name, err)
+		}
+		for _, predecessor := range predecessors {
+			predecessorId, err := sdk.ParseSchemaObjectIdentifier(predecessor)
+			if err != nil {
+				return nil, fmt.Errorf("parsing predecessor of task %s: %w", row.Name, err)
+			}
+			taskRepresentation.Predecessors = append(taskRepresentation.Predecessors, predecessorId)
+		}
+	}
+
+	return taskRepresentation, nil
+}
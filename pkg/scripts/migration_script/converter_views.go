@@ -0,0 +1,49 @@
+package main
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var _ ConvertibleCsvRow[ViewRepresentation] = new(ViewCsvRow)
+
+type ViewCsvRow struct {
+	CreatedOn      string `csv:"created_on"`
+	Name           string `csv:"name"`
+	Kind           string `csv:"kind"`
+	Reserved       string `csv:"reserved"`
+	DatabaseName   string `csv:"database_name"`
+	SchemaName     string `csv:"schema_name"`
+	Owner          string `csv:"owner"`
+	Comment        string `csv:"comment"`
+	Text           string `csv:"text"`
+	IsSecure       string `csv:"is_secure"`
+	IsMaterialized string `csv:"is_materialized"`
+	OwnerRoleType  string `csv:"owner_role_type"`
+	ChangeTracking string `csv:"change_tracking"`
+}
+
+type ViewRepresentation struct {
+	sdk.View
+}
+
+func (row ViewCsvRow) convert() (*ViewRepresentation, error) {
+	viewRepresentation := &ViewRepresentation{
+		View: sdk.View{
+			CreatedOn:      row.CreatedOn,
+			Name:           row.Name,
+			Kind:           row.Kind,
+			Reserved:       row.Reserved,
+			DatabaseName:   row.DatabaseName,
+			SchemaName:     row.SchemaName,
+			Owner:          row.Owner,
+			Comment:        row.Comment,
+			Text:           row.Text,
+			IsSecure:       row.IsSecure == "true",
+			IsMaterialized: row.IsMaterialized == "true",
+			OwnerRoleType:  row.OwnerRoleType,
+			ChangeTracking: row.ChangeTracking,
+		},
+	}
+
+	return viewRepresentation, nil
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+// ObjectKey identifies a Snowflake object represented by a given resource.
+// The Id is the fully qualified name of the object (it's not the import identifier, as some older resources use a legacy format).
+type ObjectKey struct {
+	Resource resources.Resource
+	Id       string
+}
+
+func NewObjectKey(resource resources.Resource, fullyQualifiedName string) ObjectKey {
+	return ObjectKey{Resource: resource, Id: fullyQualifiedName}
+}
+
+// ObjectReference describes a field of the generated resource that points to another Snowflake object.
+// When the referenced object is generated in the same run, Set is called with a reference to the TargetAttribute of the referenced resource.
+// If Set is nil, the referenced resource is added to the depends_on list instead.
+type ObjectReference struct {
+	Target          ObjectKey
+	TargetAttribute string
+	Set             func(value tfconfig.Variable)
+}
+
+func NewObjectReference(target ObjectKey, targetAttribute string, set func(value tfconfig.Variable)) ObjectReference {
+	return ObjectReference{Target: target, TargetAttribute: targetAttribute, Set: set}
+}
+
+func NewDependsOnReference(target ObjectKey) ObjectReference {
+	return ObjectReference{Target: target}
+}
+
+// referenceTo returns a reference replacing the field set by the given builder with the reference to the TargetAttribute of the referenced resource.
+func referenceTo[T any](target ObjectKey, targetAttribute string, builder func(value tfconfig.Variable) *T) ObjectReference {
+	return NewObjectReference(target, targetAttribute, func(value tfconfig.Variable) { builder(value) })
+}
+
+func databaseReference[T any](databaseId sdk.AccountObjectIdentifier, builder func(value tfconfig.Variable) *T) ObjectReference {
+	return referenceTo(NewObjectKey(resources.Database, databaseId.FullyQualifiedName()), "name", builder)
+}
+
+func schemaReference[T any](schemaId sdk.DatabaseObjectIdentifier, builder func(value tfconfig.Variable) *T) ObjectReference {
+	return referenceTo(NewObjectKey(resources.Schema, schemaId.FullyQualifiedName()), "name", builder)
+}
+
+// schemaObjectReferences returns references to the database and schema containing a schema-level object.
+func schemaObjectReferences[T any](id sdk.SchemaObjectIdentifier, databaseBuilder, schemaBuilder func(value tfconfig.Variable) *T) []ObjectReference {
+	return []ObjectReference{
+		databaseReference(id.DatabaseId(), databaseBuilder),
+		schemaReference(id.SchemaId(), schemaBuilder),
+	}
+}
+
+// GeneratedResource groups the resource model with its import and references to other objects.
+type GeneratedResource struct {
+	Key        ObjectKey
+	Model      accconfig.ResourceModel
+	Import     ImportModel
+	References []ObjectReference
+}
+
+func NewGeneratedResource(resourceModel accconfig.ResourceModel, importModel *ImportModel, fullyQualifiedName string, references ...ObjectReference) *GeneratedResource {
+	return &GeneratedResource{
+		Key:        NewObjectKey(resourceModel.Resource(), fullyQualifiedName),
+		Model:      resourceModel,
+		Import:     *importModel,
+		References: references,
+	}
+}
+
+// ResolveReferences replaces literal values pointing to other generated resources with references to them.
+// References to objects that are not generated are left untouched.
+func ResolveReferences(generatedResources []GeneratedResource) []GeneratedResource {
+	generatedByKey := make(map[ObjectKey]GeneratedResource)
+	for _, generatedResource := range generatedResources {
+		generatedByKey[generatedResource.Key] = generatedResource
+	}
+
+	for _, generatedResource := range generatedResources {
+		dependsOn := make([]string, 0)
+		for _, reference := range generatedResource.References {
+			target, ok := generatedByKey[reference.Target]
+			if !ok || target.Key == generatedResource.Key {
+				continue
+			}
+			if reference.Set != nil {
+				reference.Set(accconfig.UnquotedWrapperVariable(fmt.Sprintf("%s.%s", target.Model.ResourceReference(), reference.TargetAttribute)))
+			} else if !slices.Contains(dependsOn, target.Model.ResourceReference()) {
+				dependsOn = append(dependsOn, target.Model.ResourceReference())
+			}
+		}
+		if len(dependsOn) > 0 {
+			generatedResource.Model.SetDependsOn(dependsOn...)
+		}
+	}
+
+	return generatedResources
+}
+
+// resourcesOrder lists the groups of resources in the order of their usual dependencies, e.g., databases come before schemas, and schemas before tables.
+// Resources within the same group keep the input order. Resources that are not listed are placed at the end.
+var resourcesOrder = [][]resources.Resource{
+	{resources.Database},
+	{resources.Warehouse},
+	{resources.AccountRole},
+	{resources.User, resources.ServiceUser, resources.LegacyServiceUser},
+	{resources.DatabaseRole},
+	{resources.Schema},
+	{resources.StorageIntegration},
+	{resources.NetworkPolicy},
+	{resources.Tag},
+	{resources.FileFormat},
+	{resources.Stage},
+	{resources.Table},
+	{resources.View},
+	{resources.FunctionSql, resources.FunctionJavascript, resources.FunctionPython},
+	{resources.ProcedureSql, resources.ProcedureJavascript, resources.ProcedurePython},
+	{resources.StreamOnTable, resources.StreamOnView, resources.StreamOnExternalTable, resources.StreamOnDirectoryTable},
+	{resources.Pipe},
+	{resources.Task},
+}
+
+func resourceOrder(resource resources.Resource) int {
+	if index := slices.IndexFunc(resourcesOrder, func(group []resources.Resource) bool { return slices.Contains(group, resource) }); index != -1 {
+		return index
+	}
+	return len(resourcesOrder)
+}
+
+// OrderResources orders the generated resources, so that every resource comes after the generated resources it references.
+// Apart from that, resources are ordered by their type (see resourcesOrder) and keep the input order within the same type.
+func OrderResources(generatedResources []GeneratedResource) []GeneratedResource {
+	indexByKey := make(map[ObjectKey]int)
+	for i, generatedResource := range generatedResources {
+		indexByKey[generatedResource.Key] = i
+	}
+
+	pendingDependencies := make([]int, len(generatedResources))
+	dependents := make([][]int, len(generatedResources))
+	for i, generatedResource := range generatedResources {
+		for _, reference := range generatedResource.References {
+			if targetIndex, ok := indexByKey[reference.Target]; ok && targetIndex != i && !slices.Contains(dependents[targetIndex], i) {
+				dependents[targetIndex] = append(dependents[targetIndex], i)
+				pendingDependencies[i]++
+			}
+		}
+	}
+
+	isBefore := func(a, b int) bool {
+		orderA, orderB := resourceOrder(generatedResources[a].Model.Resource()), resourceOrder(generatedResources[b].Model.Resource())
+		return orderA < orderB || (orderA == orderB && a < b)
+	}
+
+	ordered := make([]GeneratedResource, 0, len(generatedResources))
+	done := make([]bool, len(generatedResources))
+	for len(ordered) < len(generatedResources) {
+		next := -1
+		for i := range generatedResources {
+			if !done[i] && pendingDependencies[i] == 0 && (next == -1 || isBefore(i, next)) {
+				next = i
+			}
+		}
+		// Cyclic references; fall back to the first remaining resource to not lose any of them.
+		if next == -1 {
+			next = slices.Index(done, false)
+		}
+
+		done[next] = true
+		ordered = append(ordered, generatedResources[next])
+		for _, dependent := range dependents[next] {
+			pendingDependencies[dependent]--
+		}
+	}
+
+	return ordered
+}
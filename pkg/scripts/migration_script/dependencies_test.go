@@ -0,0 +1,122 @@
+package main
+
+import (
+	"testing"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveReferences(t *testing.T) {
+	databaseId := sdk.NewAccountObjectIdentifier("DB")
+	schemaId := sdk.NewDatabaseObjectIdentifier("DB", "SCHEMA")
+	otherSchemaId := sdk.NewDatabaseObjectIdentifier("OTHER_DB", "SCHEMA")
+
+	newDatabase := func() GeneratedResource {
+		databaseModel := model.Database("database", databaseId.Name())
+		return *NewGeneratedResource(databaseModel, NewImportModel(databaseModel.ResourceReference(), databaseId.FullyQualifiedName()), databaseId.FullyQualifiedName())
+	}
+	newSchema := func(id sdk.DatabaseObjectIdentifier, references ...func(*model.SchemaModel) ObjectReference) GeneratedResource {
+		schemaModel := model.Schema("schema_"+id.DatabaseName(), id.DatabaseName(), id.Name())
+		return *NewGeneratedResource(schemaModel, NewImportModel(schemaModel.ResourceReference(), id.FullyQualifiedName()), id.FullyQualifiedName(), collections.Map(references, func(reference func(*model.SchemaModel) ObjectReference) ObjectReference {
+			return reference(schemaModel)
+		})...)
+	}
+	databaseReference := func(id sdk.AccountObjectIdentifier) func(*model.SchemaModel) ObjectReference {
+		return func(schemaModel *model.SchemaModel) ObjectReference {
+			return databaseReference(id, schemaModel.WithDatabaseValue)
+		}
+	}
+
+	t.Run("reference to a generated resource", func(t *testing.T) {
+		generatedResources := ResolveReferences([]GeneratedResource{newDatabase(), newSchema(schemaId, databaseReference(databaseId))})
+
+		output, err := ResourceFromModel(generatedResources[1].Model)
+		require.NoError(t, err)
+		assert.Equal(t, `resource "snowflake_schema" "schema_DB" {
+  database = snowflake_database.database.name
+  name = "SCHEMA"
+}
+`, output)
+	})
+
+	t.Run("reference to an object that is not generated", func(t *testing.T) {
+		generatedResources := ResolveReferences([]GeneratedResource{newDatabase(), newSchema(otherSchemaId, databaseReference(otherSchemaId.DatabaseId()))})
+
+		output, err := ResourceFromModel(generatedResources[1].Model)
+		require.NoError(t, err)
+		assert.Equal(t, `resource "snowflake_schema" "schema_OTHER_DB" {
+  database = "OTHER_DB"
+  name = "SCHEMA"
+}
+`, output)
+	})
+
+	t.Run("depends on reference", func(t *testing.T) {
+		dependsOnDatabase := func(*model.SchemaModel) ObjectReference {
+			return NewDependsOnReference(NewObjectKey(resources.Database, databaseId.FullyQualifiedName()))
+		}
+		generatedResources := ResolveReferences([]GeneratedResource{newDatabase(), newSchema(otherSchemaId, dependsOnDatabase, dependsOnDatabase)})
+
+		output, err := ResourceFromModel(generatedResources[1].Model)
+		require.NoError(t, err)
+		assert.Equal(t, `resource "snowflake_schema" "schema_OTHER_DB" {
+  database = "OTHER_DB"
+  name = "SCHEMA"
+  depends_on = [snowflake_database.database]
+}
+`, output)
+	})
+}
+
+func TestOrderResources(t *testing.T) {
+	// The model is used only to determine the resource type, so the same model can be used for all the resource types.
+	newResource := func(resource resources.Resource, id string, references ...ObjectKey) GeneratedResource {
+		resourceModel := model.Database(id, id)
+		resourceModel.ResourceModelMeta = accconfig.Meta(id, resource)
+		return GeneratedResource{
+			Key:        NewObjectKey(resource, id),
+			Model:      resourceModel,
+			References: collections.Map(references, NewDependsOnReference),
+		}
+	}
+	keys := func(generatedResources []GeneratedResource) []string {
+		return collections.Map(generatedResources, func(generatedResource GeneratedResource) string { return generatedResource.Key.Id })
+	}
+
+	t.Run("resources are ordered by type and keep the input order within the same type", func(t *testing.T) {
+		ordered := OrderResources([]GeneratedResource{
+			newResource(resources.Table, "TABLE_B"),
+			newResource(resources.Schema, "SCHEMA"),
+			newResource(resources.Table, "TABLE_A"),
+			newResource(resources.Database, "DB"),
+		})
+
+		assert.Equal(t, []string{"DB", "SCHEMA", "TABLE_B", "TABLE_A"}, keys(ordered))
+	})
+
+	t.Run("referenced resources come first", func(t *testing.T) {
+		ordered := OrderResources([]GeneratedResource{
+			newResource(resources.Task, "CHILD", NewObjectKey(resources.Task, "ROOT")),
+			newResource(resources.Task, "ROOT"),
+			newResource(resources.Task, "GRANDCHILD", NewObjectKey(resources.Task, "CHILD")),
+		})
+
+		assert.Equal(t, []string{"ROOT", "CHILD", "GRANDCHILD"}, keys(ordered))
+	})
+
+	t.Run("cyclic references do not lose any resource", func(t *testing.T) {
+		ordered := OrderResources([]GeneratedResource{
+			newResource(resources.Task, "A", NewObjectKey(resources.Task, "B")),
+			newResource(resources.Task, "B", NewObjectKey(resources.Task, "A")),
+			newResource(resources.Database, "DB"),
+		})
+
+		assert.Equal(t, []string{"DB", "A", "B"}, keys(ordered))
+	})
+}
@@ -0,0 +1,381 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// SnowflakeClient is the part of the SDK client used to query Snowflake in the live mode.
+type SnowflakeClient interface {
+	QueryUnsafe(ctx context.Context, sql string) ([]map[string]*any, error)
+}
+
+// NewSnowflakeClientFromProfile connects to Snowflake using the given profile from the TOML config file, the same way the provider does.
+func NewSnowflakeClientFromProfile(profile string) (SnowflakeClient, error) {
+	config, err := sdk.ProfileConfig(profile)
+	if err != nil {
+		return nil, fmt.Errorf(`could not retrieve "%s" profile config: %w`, profile, err)
+	}
+	if config == nil {
+		return nil, fmt.Errorf(`profile "%s" not found in the config file`, profile)
+	}
+	return sdk.NewClient(config)
+}
+
+// LiveConfig holds the configuration of the live mode, in which the script queries Snowflake directly instead of reading the CSV input.
+type LiveConfig struct {
+	Profile     string
+	Database    string
+	OutputDir   string
+	ObjectTypes []ObjectType
+}
+
+// IsDatabaseScope returns true if the live mode generates objects from a single database instead of the account-level objects.
+func (c *LiveConfig) IsDatabaseScope() bool {
+	return c.Database != ""
+}
+
+// ScopeDirectory returns the directory (relative to the output directory) the generated files for the given scope are written to.
+func (c *LiveConfig) ScopeDirectory() string {
+	if c.IsDatabaseScope() {
+		return fmt.Sprintf("databases/%s", resourceIdDisallowedCharacters.ReplaceAllString(c.Database, ""))
+	}
+	return "account"
+}
+
+// AccountScopeObjectTypes lists the object types generated in the live mode when no database is specified.
+// Databases are not a part of the account scope; every database is generated together with its contents in the database scope.
+var AccountScopeObjectTypes = []ObjectType{
+	ObjectTypeWarehouses,
+	ObjectTypeAccountRoles,
+	ObjectTypeUsers,
+	ObjectTypeNetworkPolicies,
+	ObjectTypeIntegrations,
+}
+
+// DatabaseScopeObjectTypes lists the object types generated in the live mode for the specified database.
+var DatabaseScopeObjectTypes = []ObjectType{
+	ObjectTypeDatabases,
+	ObjectTypeSchemas,
+	ObjectTypeDatabaseRoles,
+	ObjectTypeTags,
+	ObjectTypeFileFormats,
+	ObjectTypeStages,
+	ObjectTypeTables,
+	ObjectTypeViews,
+	ObjectTypeFunctions,
+	ObjectTypeProcedures,
+	ObjectTypeStreams,
+	ObjectTypePipes,
+	ObjectTypeTasks,
+}
+
+// systemAccountRoles are created by Snowflake in every account, so they are not generated.
+var systemAccountRoles = []string{"ACCOUNTADMIN", "GLOBALORGADMIN", "ORGADMIN", "PUBLIC", "SECURITYADMIN", "SYSADMIN", "USERADMIN"}
+
+// queryRow is a single row of the query output, with lowercase column names.
+type queryRow map[string]string
+
+type liveExporter struct {
+	ctx    context.Context
+	client SnowflakeClient
+	config *LiveConfig
+}
+
+func newLiveExporter(ctx context.Context, client SnowflakeClient, config *LiveConfig) *liveExporter {
+	return &liveExporter{ctx: ctx, client: client, config: config}
+}
+
+// Export queries Snowflake for the objects of the given type and returns them in the same form as the CSV input.
+func (e *liveExporter) Export(objectType ObjectType) ([][]string, error) {
+	var rows []queryRow
+	var err error
+	if e.config.IsDatabaseScope() {
+		rows, err = e.exportDatabaseScope(objectType, sdk.NewAccountObjectIdentifier(e.config.Database))
+	} else {
+		rows, err = e.exportAccountScope(objectType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error querying %s: %w", objectType, err)
+	}
+	return rowsToCsv(rows), nil
+}
+
+func (e *liveExporter) exportAccountScope(objectType ObjectType) ([]queryRow, error) {
+	switch objectType {
+	case ObjectTypeWarehouses:
+		warehouses, err := e.query("SHOW WAREHOUSES")
+		if err != nil {
+			return nil, err
+		}
+		return warehouses, e.withParameters(warehouses, "WAREHOUSE", func(row queryRow) string {
+			return sdk.NewAccountObjectIdentifier(row["name"]).FullyQualifiedName()
+		})
+	case ObjectTypeAccountRoles:
+		roles, err := e.query("SHOW ROLES")
+		if err != nil {
+			return nil, err
+		}
+		return slices.DeleteFunc(roles, func(row queryRow) bool { return slices.Contains(systemAccountRoles, row["name"]) }), nil
+	case ObjectTypeUsers:
+		users, err := e.query("SHOW USERS")
+		if err != nil {
+			return nil, err
+		}
+		users = slices.DeleteFunc(users, func(row queryRow) bool { return row["name"] == "SNOWFLAKE" })
+		return users, e.withParameters(users, "USER", func(row queryRow) string {
+			return sdk.NewAccountObjectIdentifier(row["name"]).FullyQualifiedName()
+		})
+	case ObjectTypeNetworkPolicies:
+		networkPolicies, err := e.query("SHOW NETWORK POLICIES")
+		if err != nil {
+			return nil, err
+		}
+		return networkPolicies, e.withProperties(networkPolicies, "name", "value", func(row queryRow) string {
+			return fmt.Sprintf("DESCRIBE NETWORK POLICY %s", sdk.NewAccountObjectIdentifier(row["name"]).FullyQualifiedName())
+		})
+	case ObjectTypeIntegrations:
+		integrations, err := e.query("SHOW INTEGRATIONS")
+		if err != nil {
+			return nil, err
+		}
+		// Only storage integrations are supported, so there is no need to describe the other ones
+		integrations = slices.DeleteFunc(integrations, func(row queryRow) bool { return row["category"] != IntegrationCategoryStorage })
+		return integrations, e.withProperties(integrations, "property", "property_value", func(row queryRow) string {
+			return fmt.Sprintf("DESCRIBE INTEGRATION %s", sdk.NewAccountObjectIdentifier(row["name"]).FullyQualifiedName())
+		})
+	default:
+		return nil, fmt.Errorf("object type %s is not supported in the account scope", objectType)
+	}
+}
+
+func (e *liveExporter) exportDatabaseScope(objectType ObjectType, databaseId sdk.AccountObjectIdentifier) ([]queryRow, error) {
+	inDatabase := func(objects string) string {
+		return fmt.Sprintf("SHOW %s IN DATABASE %s", objects, databaseId.FullyQualifiedName())
+	}
+
+	switch objectType {
+	case ObjectTypeDatabases:
+		databases, err := e.query(fmt.Sprintf("SHOW DATABASES LIKE '%s'", escapeStringLiteral(databaseId.Name())))
+		if err != nil {
+			return nil, err
+		}
+		// LIKE is case-insensitive and treats underscores as wildcards, so only the exact match is kept
+		databases = slices.DeleteFunc(databases, func(row queryRow) bool { return row["name"] != databaseId.Name() })
+		return databases, e.withParameters(databases, "DATABASE", func(row queryRow) string {
+			return databaseId.FullyQualifiedName()
+		})
+	case ObjectTypeSchemas:
+		schemas, err := e.query(inDatabase("SCHEMAS"))
+		if err != nil {
+			return nil, err
+		}
+		schemas = slices.DeleteFunc(schemas, func(row queryRow) bool { return row["name"] == "INFORMATION_SCHEMA" })
+		return schemas, e.withParameters(schemas, "SCHEMA", func(row queryRow) string {
+			return sdk.NewDatabaseObjectIdentifier(databaseId.Name(), row["name"]).FullyQualifiedName()
+		})
+	case ObjectTypeDatabaseRoles:
+		databaseRoles, err := e.query(inDatabase("DATABASE ROLES"))
+		if err != nil {
+			return nil, err
+		}
+		// The output does not contain the database name
+		for _, row := range databaseRoles {
+			row["database_name"] = databaseId.Name()
+		}
+		return databaseRoles, nil
+	case ObjectTypeTags:
+		return e.querySchemaObjects(inDatabase("TAGS"))
+	case ObjectTypeFileFormats:
+		return e.querySchemaObjects(inDatabase("FILE FORMATS"))
+	case ObjectTypeStages:
+		return e.querySchemaObjects(inDatabase("STAGES"))
+	case ObjectTypeViews:
+		views, err := e.querySchemaObjects(inDatabase("VIEWS"))
+		if err != nil {
+			return nil, err
+		}
+		return slices.DeleteFunc(views, func(row queryRow) bool { return row["is_materialized"] == "true" }), nil
+	case ObjectTypeStreams:
+		return e.querySchemaObjects(inDatabase("STREAMS"))
+	case ObjectTypePipes:
+		return e.querySchemaObjects(inDatabase("PIPES"))
+	case ObjectTypeTasks:
+		return e.querySchemaObjects(inDatabase("TASKS"))
+	case ObjectTypeTables:
+		return e.exportTables(inDatabase("TABLES"))
+	case ObjectTypeFunctions:
+		return e.exportRoutines(databaseId, "FUNCTION", "FUNCTIONS")
+	case ObjectTypeProcedures:
+		return e.exportRoutines(databaseId, "PROCEDURE", "PROCEDURES")
+	default:
+		return nil, fmt.Errorf("object type %s is not supported in the database scope", objectType)
+	}
+}
+
+// exportTables returns the DESCRIBE TABLE output of every table extended with the table's identifier and comment (see TableColumnCsvRow).
+func (e *liveExporter) exportTables(showTablesQuery string) ([]queryRow, error) {
+	tables, err := e.querySchemaObjects(showTablesQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]queryRow, 0)
+	for _, table := range tables {
+		// Only the standard tables are supported by the snowflake_table resource
+		if table["is_external"] == "Y" || table["is_dynamic"] == "Y" || table["is_iceberg"] == "Y" || table["is_hybrid"] == "Y" || table["kind"] == "TEMPORARY" {
+			continue
+		}
+		tableId := sdk.NewSchemaObjectIdentifier(table["database_name"], table["schema_name"], table["name"])
+		tableColumns, err := e.query(fmt.Sprintf("DESCRIBE TABLE %s", tableId.FullyQualifiedName()))
+		if err != nil {
+			return nil, err
+		}
+		for _, column := range tableColumns {
+			column["database_name"] = tableId.DatabaseName()
+			column["schema_name"] = tableId.SchemaName()
+			column["table_name"] = tableId.Name()
+			column["table_comment"] = table["comment"]
+		}
+		columns = append(columns, tableColumns...)
+	}
+	return columns, nil
+}
+
+// exportRoutines returns the rows of the INFORMATION_SCHEMA.FUNCTIONS or INFORMATION_SCHEMA.PROCEDURES view.
+// The handlers of Python routines are not a part of the view, so they are taken from the DESCRIBE output.
+func (e *liveExporter) exportRoutines(databaseId sdk.AccountObjectIdentifier, routineType string, view string) ([]queryRow, error) {
+	routines, err := e.query(fmt.Sprintf("SELECT * FROM %s.INFORMATION_SCHEMA.%s", databaseId.FullyQualifiedName(), view))
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := strings.ToLower(routineType)
+	for _, routine := range routines {
+		if routine[prefix+"_language"] != "PYTHON" {
+			continue
+		}
+		routineRepresentation, err := newRoutineRepresentation(routineCsvValues{
+			databaseName:      routine[prefix+"_catalog"],
+			schemaName:        routine[prefix+"_schema"],
+			name:              routine[prefix+"_name"],
+			argumentSignature: routine["argument_signature"],
+		})
+		if err != nil {
+			return nil, err
+		}
+		properties, err := e.query(fmt.Sprintf("DESCRIBE %s %s", routineType, routineRepresentation.Id.FullyQualifiedName()))
+		if err != nil {
+			return nil, err
+		}
+		for _, property := range properties {
+			if property["property"] == "handler" {
+				routine["handler"] = property["value"]
+			}
+		}
+	}
+	return routines, nil
+}
+
+// querySchemaObjects runs the given SHOW query and skips the objects from INFORMATION_SCHEMA.
+func (e *liveExporter) querySchemaObjects(sql string) ([]queryRow, error) {
+	rows, err := e.query(sql)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(rows, func(row queryRow) bool { return row["schema_name"] == "INFORMATION_SCHEMA" }), nil
+}
+
+// withParameters adds the parameters of every object in the same format as described in the Multiple sources section of the readme,
+// i.e., the <parameter>_value and <parameter>_level columns.
+func (e *liveExporter) withParameters(rows []queryRow, objectType string, objectIdentifier func(row queryRow) string) error {
+	for _, row := range rows {
+		parameters, err := e.query(fmt.Sprintf("SHOW PARAMETERS IN %s %s", objectType, objectIdentifier(row)))
+		if err != nil {
+			return err
+		}
+		for _, parameter := range parameters {
+			key := strings.ToLower(parameter["key"])
+			row[key+"_value"] = parameter["value"]
+			row[key+"_level"] = parameter["level"]
+		}
+	}
+	return nil
+}
+
+// withProperties adds the properties returned by the DESCRIBE query of every object as additional columns.
+func (e *liveExporter) withProperties(rows []queryRow, nameColumn string, valueColumn string, describeQuery func(row queryRow) string) error {
+	for _, row := range rows {
+		properties, err := e.query(describeQuery(row))
+		if err != nil {
+			return err
+		}
+		for _, property := range properties {
+			row[strings.ToLower(property[nameColumn])] = property[valueColumn]
+		}
+	}
+	return nil
+}
+
+func (e *liveExporter) query(sql string) ([]queryRow, error) {
+	rows, err := e.client.QueryUnsafe(e.ctx, sql)
+	if err != nil {
+		return nil, fmt.Errorf("query %q failed: %w", sql, err)
+	}
+
+	queryRows := make([]queryRow, len(rows))
+	for i, row := range rows {
+		queryRows[i] = make(queryRow, len(row))
+		for column, value := range row {
+			queryRows[i][strings.ToLower(column)] = formatQueryValue(value)
+		}
+	}
+	return queryRows, nil
+}
+
+func formatQueryValue(value *any) string {
+	if value == nil || *value == nil {
+		return ""
+	}
+	switch v := (*value).(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// rowsToCsv converts the rows into the CSV-like input with a header containing all the columns present in the rows.
+func rowsToCsv(rows []queryRow) [][]string {
+	columns := make(map[string]struct{})
+	for _, row := range rows {
+		for column := range row {
+			columns[column] = struct{}{}
+		}
+	}
+	header := slices.Sorted(maps.Keys(columns))
+
+	csvInput := [][]string{header}
+	for _, row := range rows {
+		csvRow := make([]string, len(header))
+		for i, column := range header {
+			csvRow[i] = row[column]
+		}
+		csvInput = append(csvInput, csvRow)
+	}
+	return csvInput
+}
+
+func escapeStringLiteral(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+}
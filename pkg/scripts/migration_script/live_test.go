@@ -0,0 +1,334 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSnowflakeClient returns the predefined rows for the given queries and an empty output for the other ones.
+type fakeSnowflakeClient struct {
+	outputs map[string][]map[string]any
+	queries []string
+}
+
+func (c *fakeSnowflakeClient) QueryUnsafe(_ context.Context, sql string) ([]map[string]*any, error) {
+	c.queries = append(c.queries, sql)
+	if sql == "FAIL" {
+		return nil, fmt.Errorf("query failed")
+	}
+	rows := make([]map[string]*any, 0)
+	for _, output := range c.outputs[sql] {
+		row := make(map[string]*any, len(output))
+		for column, value := range output {
+			row[column] = &value
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func TestLiveConfig_ScopeDirectory(t *testing.T) {
+	assert.Equal(t, "account", (&LiveConfig{}).ScopeDirectory())
+	assert.Equal(t, "databases/DB", (&LiveConfig{Database: "DB"}).ScopeDirectory())
+	assert.Equal(t, "databases/MYDB", (&LiveConfig{Database: "MY/DB"}).ScopeDirectory())
+}
+
+func TestLiveExporter_Export(t *testing.T) {
+	testCases := []struct {
+		name       string
+		database   string
+		objectType ObjectType
+		outputs    map[string][]map[string]any
+
+		expectedQueries []string
+		expectedCsv     [][]string
+		expectedError   string
+	}{
+		{
+			name:       "account roles without system roles",
+			objectType: ObjectTypeAccountRoles,
+			outputs: map[string][]map[string]any{
+				"SHOW ROLES": {
+					{"name": "ACCOUNTADMIN"},
+					{"name": "ROLE"},
+					{"name": "PUBLIC"},
+				},
+			},
+			expectedQueries: []string{"SHOW ROLES"},
+			expectedCsv:     [][]string{{"name"}, {"ROLE"}},
+		},
+		{
+			name:       "storage integrations with properties",
+			objectType: ObjectTypeIntegrations,
+			outputs: map[string][]map[string]any{
+				"SHOW INTEGRATIONS": {
+					{"name": "STORAGE", "category": "STORAGE"},
+					{"name": "API", "category": "API"},
+				},
+				`DESCRIBE INTEGRATION "STORAGE"`: {
+					{"property": "STORAGE_PROVIDER", "property_value": "S3"},
+				},
+			},
+			expectedQueries: []string{"SHOW INTEGRATIONS", `DESCRIBE INTEGRATION "STORAGE"`},
+			expectedCsv:     [][]string{{"category", "name", "storage_provider"}, {"STORAGE", "STORAGE", "S3"}},
+		},
+		{
+			name:       "database in database scope",
+			database:   "DB_1",
+			objectType: ObjectTypeDatabases,
+			outputs: map[string][]map[string]any{
+				"SHOW DATABASES LIKE 'DB_1'": {
+					{"name": "DB_1"},
+					{"name": "DBX1"},
+				},
+			},
+			expectedQueries: []string{"SHOW DATABASES LIKE 'DB_1'", `SHOW PARAMETERS IN DATABASE "DB_1"`},
+			expectedCsv:     [][]string{{"name"}, {"DB_1"}},
+		},
+		{
+			name:       "database roles with database name",
+			database:   "DB",
+			objectType: ObjectTypeDatabaseRoles,
+			outputs: map[string][]map[string]any{
+				`SHOW DATABASE ROLES IN DATABASE "DB"`: {
+					{"name": "ROLE"},
+				},
+			},
+			expectedQueries: []string{`SHOW DATABASE ROLES IN DATABASE "DB"`},
+			expectedCsv:     [][]string{{"database_name", "name"}, {"DB", "ROLE"}},
+		},
+		{
+			name:       "tables with described columns",
+			database:   "DB",
+			objectType: ObjectTypeTables,
+			outputs: map[string][]map[string]any{
+				`SHOW TABLES IN DATABASE "DB"`: {
+					{"database_name": "DB", "schema_name": "SCHEMA", "name": "TABLE", "comment": "table comment", "is_external": "N", "kind": "TABLE"},
+					{"database_name": "DB", "schema_name": "SCHEMA", "name": "EXTERNAL", "comment": "", "is_external": "Y", "kind": "TABLE"},
+					{"database_name": "DB", "schema_name": "SCHEMA", "name": "TEMPORARY", "comment": "", "is_external": "N", "kind": "TEMPORARY"},
+				},
+				`DESCRIBE TABLE "DB"."SCHEMA"."TABLE"`: {
+					{"name": "ID", "type": "NUMBER(38,0)", "kind": "COLUMN"},
+				},
+			},
+			expectedQueries: []string{`SHOW TABLES IN DATABASE "DB"`, `DESCRIBE TABLE "DB"."SCHEMA"."TABLE"`},
+			expectedCsv: [][]string{
+				{"database_name", "kind", "name", "schema_name", "table_comment", "table_name", "type"},
+				{"DB", "COLUMN", "ID", "SCHEMA", "table comment", "TABLE", "NUMBER(38,0)"},
+			},
+		},
+		{
+			name:       "python functions with handler",
+			database:   "DB",
+			objectType: ObjectTypeFunctions,
+			outputs: map[string][]map[string]any{
+				`SELECT * FROM "DB".INFORMATION_SCHEMA.FUNCTIONS`: {
+					{"FUNCTION_CATALOG": "DB", "FUNCTION_SCHEMA": "SCHEMA", "FUNCTION_NAME": "FUNC", "ARGUMENT_SIGNATURE": "(A NUMBER)", "FUNCTION_LANGUAGE": "PYTHON"},
+					{"FUNCTION_CATALOG": "DB", "FUNCTION_SCHEMA": "SCHEMA", "FUNCTION_NAME": "SQL_FUNC", "ARGUMENT_SIGNATURE": "()", "FUNCTION_LANGUAGE": "SQL"},
+				},
+				`DESCRIBE FUNCTION "DB"."SCHEMA"."FUNC"(NUMBER)`: {
+					{"property": "handler", "value": "main"},
+				},
+			},
+			expectedQueries: []string{`SELECT * FROM "DB".INFORMATION_SCHEMA.FUNCTIONS`, `DESCRIBE FUNCTION "DB"."SCHEMA"."FUNC"(NUMBER)`},
+			expectedCsv: [][]string{
+				{"argument_signature", "function_catalog", "function_language", "function_name", "function_schema", "handler"},
+				{"(A NUMBER)", "DB", "PYTHON", "FUNC", "SCHEMA", "main"},
+				{"()", "DB", "SQL", "SQL_FUNC", "SCHEMA", ""},
+			},
+		},
+		{
+			name:       "views without materialized views and information schema",
+			database:   "DB",
+			objectType: ObjectTypeViews,
+			outputs: map[string][]map[string]any{
+				`SHOW VIEWS IN DATABASE "DB"`: {
+					{"schema_name": "SCHEMA", "name": "VIEW", "is_materialized": "false"},
+					{"schema_name": "SCHEMA", "name": "MATERIALIZED", "is_materialized": "true"},
+					{"schema_name": "INFORMATION_SCHEMA", "name": "TABLES", "is_materialized": "false"},
+				},
+			},
+			expectedQueries: []string{`SHOW VIEWS IN DATABASE "DB"`},
+			expectedCsv:     [][]string{{"is_materialized", "name", "schema_name"}, {"false", "VIEW", "SCHEMA"}},
+		},
+		{
+			name:          "object type not supported in the scope",
+			objectType:    ObjectTypeTables,
+			expectedError: "error querying tables: object type tables is not supported in the account scope",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &fakeSnowflakeClient{outputs: tc.outputs}
+			exporter := newLiveExporter(context.Background(), client, &LiveConfig{Database: tc.database})
+
+			csvInput, err := exporter.Export(tc.objectType)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCsv, csvInput)
+			assert.Equal(t, tc.expectedQueries, client.queries)
+		})
+	}
+}
+
+func TestProgram_Live(t *testing.T) {
+	client := &fakeSnowflakeClient{outputs: map[string][]map[string]any{
+		"SHOW DATABASES LIKE 'DB'": {
+			{"name": "DB", "kind": "STANDARD"},
+		},
+		`SHOW SCHEMAS IN DATABASE "DB"`: {
+			{"database_name": "DB", "name": "SCHEMA"},
+			{"database_name": "DB", "name": "INFORMATION_SCHEMA"},
+		},
+	}}
+	newSnowflakeClient := func(profile string) (SnowflakeClient, error) {
+		if profile != "default" {
+			return nil, fmt.Errorf(`profile "%s" not found in the config file`, profile)
+		}
+		return client, nil
+	}
+
+	testCases := []struct {
+		name string
+		args []string
+
+		expectedExitCode  ExitCode
+		expectedErrOutput string
+	}{
+		{
+			name:              "validation: missing output dir",
+			args:              []string{"cmd", "-profile=default"},
+			expectedErrOutput: `Error parsing input arguments: output-dir flag is required in the live mode, use -h for help`,
+			expectedExitCode:  ExitCodeFailedInputArgumentParsing,
+		},
+		{
+			name:              "validation: object type together with profile",
+			args:              []string{"cmd", "-profile=default", "-output-dir=out", "databases"},
+			expectedErrOutput: `Error parsing input arguments: object type and input flag cannot be specified together with the profile flag, use -h for help`,
+			expectedExitCode:  ExitCodeFailedInputArgumentParsing,
+		},
+		{
+			name:              "validation: live flags without profile",
+			args:              []string{"cmd", "-database=DB", "databases"},
+			expectedErrOutput: `Error parsing input arguments: database, output-dir, and object-types flags can be specified only together with the profile flag, use -h for help`,
+			expectedExitCode:  ExitCodeFailedInputArgumentParsing,
+		},
+		{
+			name:              "validation: invalid object type",
+			args:              []string{"cmd", "-profile=default", "-output-dir=out", "-object-types=warehouses,invalid"},
+			expectedErrOutput: `Error parsing input arguments: error parsing object types: unsupported object type: invalid`,
+			expectedExitCode:  ExitCodeFailedInputArgumentParsing,
+		},
+		{
+			name:              "validation: object type outside of the scope",
+			args:              []string{"cmd", "-profile=default", "-output-dir=out", "-object-types=grants"},
+			expectedErrOutput: `Error parsing input arguments: object type grants is not supported in the live mode for the given scope, use -h for help`,
+			expectedExitCode:  ExitCodeFailedInputArgumentParsing,
+		},
+		{
+			name:              "validation: databases outside of the database scope",
+			args:              []string{"cmd", "-profile=default", "-output-dir=out", "-object-types=databases"},
+			expectedErrOutput: `Error parsing input arguments: object type databases is not supported in the live mode for the given scope, use -h for help`,
+			expectedExitCode:  ExitCodeFailedInputArgumentParsing,
+		},
+		{
+			name:              "unknown profile",
+			args:              []string{"cmd", "-profile=unknown", "-output-dir=out"},
+			expectedErrOutput: `Error connecting to Snowflake: profile "unknown" not found in the config file`,
+			expectedExitCode:  ExitCodeFailedQueryingSnowflake,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			program := Program{
+				Args:               tc.args,
+				StdOut:             bytes.NewBuffer(nil),
+				StdErr:             bytes.NewBuffer(nil),
+				StdIn:              bytes.NewBuffer(nil),
+				NewSnowflakeClient: newSnowflakeClient,
+			}
+
+			assert.Equal(t, tc.expectedExitCode, program.Run())
+			assert.Contains(t, program.StdErr.(*bytes.Buffer).String(), tc.expectedErrOutput)
+		})
+	}
+
+	t.Run("generates files per object type", func(t *testing.T) {
+		outputDir := t.TempDir()
+		program := Program{
+			Args:               []string{"cmd", "-import=block", "-profile=default", "-database=DB", "-object-types=schemas,databases,tables", "-output-dir=" + outputDir},
+			StdOut:             bytes.NewBuffer(nil),
+			StdErr:             bytes.NewBuffer(nil),
+			StdIn:              bytes.NewBuffer(nil),
+			NewSnowflakeClient: newSnowflakeClient,
+		}
+
+		assert.Equal(t, ExitCodeSuccess, program.Run())
+		assert.Empty(t, program.StdErr.(*bytes.Buffer).String())
+
+		databasesPath := filepath.Join(outputDir, "databases", "DB", "databases.tf")
+		schemasPath := filepath.Join(outputDir, "databases", "DB", "schemas.tf")
+		assert.Equal(t, fmt.Sprintf("Generated 1 schemas resources in %s\nGenerated 1 databases resources in %s\n", schemasPath, databasesPath), program.StdOut.(*bytes.Buffer).String())
+		assert.NoFileExists(t, filepath.Join(outputDir, "databases", "DB", "tables.tf"))
+
+		databases, err := os.ReadFile(databasesPath)
+		require.NoError(t, err)
+		assert.Equal(t, `resource "snowflake_database" "snowflake_generated_database_DB" {
+  name = "DB"
+}
+import {
+  to = snowflake_database.snowflake_generated_database_DB
+  id = "\"DB\""
+}
+`, string(databases))
+
+		schemas, err := os.ReadFile(schemasPath)
+		require.NoError(t, err)
+		assert.Equal(t, `resource "snowflake_schema" "snowflake_generated_schema_DB_SCHEMA" {
+  database = snowflake_database.snowflake_generated_database_DB.name
+  name = "SCHEMA"
+}
+import {
+  to = snowflake_schema.snowflake_generated_schema_DB_SCHEMA
+  id = "\"DB\".\"SCHEMA\""
+}
+`, string(schemas))
+	})
+
+	t.Run("does not write any file if one of them already exists", func(t *testing.T) {
+		outputDir := t.TempDir()
+		existingPath := filepath.Join(outputDir, "databases", "DB", "databases.tf")
+		require.NoError(t, os.MkdirAll(filepath.Dir(existingPath), 0o755))
+		require.NoError(t, os.WriteFile(existingPath, []byte("existing"), 0o600))
+
+		program := Program{
+			Args:               []string{"cmd", "-profile=default", "-database=DB", "-object-types=schemas,databases", "-output-dir=" + outputDir},
+			StdOut:             bytes.NewBuffer(nil),
+			StdErr:             bytes.NewBuffer(nil),
+			StdIn:              bytes.NewBuffer(nil),
+			NewSnowflakeClient: newSnowflakeClient,
+		}
+
+		assert.Equal(t, ExitCodeFailedWritingOutput, program.Run())
+		assert.Contains(t, program.StdErr.(*bytes.Buffer).String(), fmt.Sprintf("Error writing output: file %s already exists", existingPath))
+		assert.Empty(t, program.StdOut.(*bytes.Buffer).String())
+		assert.NoFileExists(t, filepath.Join(outputDir, "databases", "DB", "schemas.tf"))
+
+		existing, err := os.ReadFile(existingPath)
+		require.NoError(t, err)
+		assert.Equal(t, "existing", string(existing))
+	})
+}
@@ -33,36 +33,66 @@ func ResourceFromModel(model config.ResourceModel) (string, error) {
 	return config.DefaultHclConfigProvider.HclFromJson(resourceJson)
 }
 
-func HandleResources[T ConvertibleCsvRow[R], R any](
-	config *Config,
-	csvInput [][]string,
-	mapObjToModel func(obj R) (accconfig.ResourceModel, *ImportModel, error),
-) (string, error) {
-	objects, err := ConvertCsvInput[T, R](csvInput)
+// HandleResources collects the resources from the CSV input and generates the final output for them.
+func HandleResources(config *Config, csvInput [][]string, collect func(csvInput [][]string) ([]GeneratedResource, error)) (string, error) {
+	generatedResources, err := collect(csvInput)
 	if err != nil {
 		return "", err
 	}
+	return GenerateOutput(config, generatedResources)
+}
 
-	resourceModels := make([]accconfig.ResourceModel, 0)
-	importModels := make([]ImportModel, 0)
+// CollectResources converts the CSV input and maps every object into a GeneratedResource.
+// Objects that cannot be mapped are logged and skipped.
+func CollectResources[T ConvertibleCsvRow[R], R any](
+	csvInput [][]string,
+	mapObjToResource func(obj R) (*GeneratedResource, error),
+) ([]GeneratedResource, error) {
+	objects, err := ConvertCsvInput[T, R](csvInput)
+	if err != nil {
+		return nil, err
+	}
 
+	generatedResources := make([]GeneratedResource, 0)
 	for _, object := range objects {
-		mappedModel, importModel, err := mapObjToModel(object)
+		generatedResource, err := mapObjToResource(object)
 		if err != nil {
 			log.Printf("Error converting object of type %T to model: %v. Skipping object and continuing with other mappings.", object, err)
 		} else {
-			resourceModels = append(resourceModels, mappedModel)
-			importModels = append(importModels, *importModel)
+			generatedResources = append(generatedResources, *generatedResource)
 		}
 	}
 
-	mappedModels, err := collections.MapErr(resourceModels, ResourceFromModel)
+	return generatedResources, nil
+}
+
+// WithoutReferences adapts mapping functions of objects that do not reference other objects.
+func WithoutReferences[R any](mapObjToModel func(obj R) (accconfig.ResourceModel, *ImportModel, error)) func(obj R) (*GeneratedResource, error) {
+	return func(obj R) (*GeneratedResource, error) {
+		resourceModel, importModel, err := mapObjToModel(obj)
+		if err != nil {
+			return nil, err
+		}
+		return NewGeneratedResource(resourceModel, importModel, importModel.Id), nil
+	}
+}
+
+// GenerateOutput resolves the references between the generated resources, orders them, and transforms them into the final output.
+func GenerateOutput(config *Config, generatedResources []GeneratedResource) (string, error) {
+	return RenderResources(config, OrderResources(ResolveReferences(generatedResources)))
+}
+
+// RenderResources transforms the generated resources into the final output, keeping their order.
+func RenderResources(config *Config, generatedResources []GeneratedResource) (string, error) {
+	mappedModels, err := collections.MapErr(generatedResources, func(generatedResource GeneratedResource) (string, error) {
+		return ResourceFromModel(generatedResource.Model)
+	})
 	if err != nil {
 		return "", fmt.Errorf("errors from resource model to HCL conversion: %w", err)
 	}
 
-	mappedImports, err := collections.MapErr(importModels, func(importModel ImportModel) (string, error) {
-		return TransformImportModel(config, importModel)
+	mappedImports, err := collections.MapErr(generatedResources, func(generatedResource GeneratedResource) (string, error) {
+		return TransformImportModel(config, generatedResource.Import)
 	})
 	if err != nil {
 		return "", fmt.Errorf("errors during import transformations: %w", err)
@@ -8,7 +8,11 @@ import (
 )
 
 func HandleAccountRoles(config *Config, csvInput [][]string) (string, error) {
-	return HandleResources[AccountRoleCsvRow, AccountRoleRepresentation](config, csvInput, MapAccountRoleToModel)
+	return HandleResources(config, csvInput, CollectAccountRoles)
+}
+
+func CollectAccountRoles(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[AccountRoleCsvRow, AccountRoleRepresentation](csvInput, WithoutReferences(MapAccountRoleToModel))
 }
 
 func MapAccountRoleToModel(role AccountRoleRepresentation) (accconfig.ResourceModel, *ImportModel, error) {
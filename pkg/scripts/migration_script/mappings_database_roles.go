@@ -1,17 +1,20 @@
 package main
 
 import (
-	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
 
 func HandleDatabaseRoles(config *Config, csvInput [][]string) (string, error) {
-	return HandleResources[DatabaseRoleCsvRow, DatabaseRoleRepresentation](config, csvInput, MapDatabaseRoleToModel)
+	return HandleResources(config, csvInput, CollectDatabaseRoles)
 }
 
-func MapDatabaseRoleToModel(role DatabaseRoleRepresentation) (accconfig.ResourceModel, *ImportModel, error) {
+func CollectDatabaseRoles(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[DatabaseRoleCsvRow, DatabaseRoleRepresentation](csvInput, MapDatabaseRoleToModel)
+}
+
+func MapDatabaseRoleToModel(role DatabaseRoleRepresentation) (*GeneratedResource, error) {
 	roleId := sdk.NewDatabaseObjectIdentifier(role.DatabaseName, role.Name)
 	resourceId := ResourceId(resources.DatabaseRole, roleId.FullyQualifiedName())
 	resourceModel := model.DatabaseRole(resourceId, role.DatabaseName, role.Name)
@@ -23,5 +26,7 @@ func MapDatabaseRoleToModel(role DatabaseRoleRepresentation) (accconfig.Resource
 		roleId.FullyQualifiedName(),
 	)
 
-	return resourceModel, importModel, nil
+	return NewGeneratedResource(resourceModel, importModel, roleId.FullyQualifiedName(),
+		databaseReference(roleId.DatabaseId(), resourceModel.WithDatabaseValue),
+	), nil
 }
@@ -8,7 +8,11 @@ import (
 )
 
 func HandleDatabases(config *Config, csvInput [][]string) (string, error) {
-	return HandleResources[DatabaseCsvRow, DatabaseRepresentation](config, csvInput, MapDatabaseToModel)
+	return HandleResources(config, csvInput, CollectDatabases)
+}
+
+func CollectDatabases(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[DatabaseCsvRow, DatabaseRepresentation](csvInput, WithoutReferences(MapDatabaseToModel))
 }
 
 func MapDatabaseToModel(database DatabaseRepresentation) (accconfig.ResourceModel, *ImportModel, error) {
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func HandleFileFormats(config *Config, csvInput [][]string) (string, error) {
+	return HandleResources(config, csvInput, CollectFileFormats)
+}
+
+func CollectFileFormats(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[FileFormatCsvRow, FileFormatRepresentation](csvInput, MapFileFormatToModel)
+}
+
+func MapFileFormatToModel(fileFormat FileFormatRepresentation) (*GeneratedResource, error) {
+	fileFormatId := fileFormat.Id
+	resourceId := ResourceId(resources.FileFormat, fileFormatId.FullyQualifiedName())
+	resourceModel := model.FileFormat(resourceId, fileFormatId.DatabaseName(), fileFormatId.SchemaName(), fileFormatId.Name(), string(fileFormat.Type))
+
+	handleIfNotEmpty(fileFormat.Comment, resourceModel.WithComment)
+
+	options := fileFormat.Options
+	handleOptionalFieldWithBuilder(options.Compression, resourceModel.WithCompression)
+	handleFileFormatDelimiter("record_delimiter", options.RecordDelimiter, resourceModel.WithRecordDelimiter)
+	handleFileFormatDelimiter("field_delimiter", options.FieldDelimiter, resourceModel.WithFieldDelimiter)
+	handleOptionalFieldWithBuilder(options.FileExtension, resourceModel.WithFileExtension)
+	handleOptionalFieldWithBuilder(options.ParseHeader, resourceModel.WithParseHeader)
+	handleOptionalFieldWithBuilder(options.SkipHeader, resourceModel.WithSkipHeader)
+	handleOptionalFieldWithBuilder(options.SkipBlankLines, resourceModel.WithSkipBlankLines)
+	handleOptionalFieldWithBuilder(options.DateFormat, resourceModel.WithDateFormat)
+	handleOptionalFieldWithBuilder(options.TimeFormat, resourceModel.WithTimeFormat)
+	handleOptionalFieldWithBuilder(options.TimestampFormat, resourceModel.WithTimestampFormat)
+	handleOptionalFieldWithBuilder(options.BinaryFormat, resourceModel.WithBinaryFormat)
+	handleOptionalFieldWithBuilder(options.Escape, resourceModel.WithEscape)
+	handleOptionalFieldWithBuilder(options.EscapeUnenclosedField, resourceModel.WithEscapeUnenclosedField)
+	handleOptionalFieldWithBuilder(options.TrimSpace, resourceModel.WithTrimSpace)
+	handleOptionalFieldWithBuilder(options.FieldOptionallyEnclosedBy, resourceModel.WithFieldOptionallyEnclosedBy)
+	if len(options.NullIf) > 0 {
+		resourceModel.WithNullIfValue(tfconfig.ListVariable(stringVariables(options.NullIf)...))
+	}
+	handleOptionalFieldWithBuilder(options.ErrorOnColumnCountMismatch, resourceModel.WithErrorOnColumnCountMismatch)
+	handleOptionalFieldWithBuilder(options.ReplaceInvalidCharacters, resourceModel.WithReplaceInvalidCharacters)
+	handleOptionalFieldWithBuilder(options.EmptyFieldAsNull, resourceModel.WithEmptyFieldAsNull)
+	handleOptionalFieldWithBuilder(options.SkipByteOrderMark, resourceModel.WithSkipByteOrderMark)
+	handleOptionalFieldWithBuilder(options.Encoding, resourceModel.WithEncoding)
+	handleOptionalFieldWithBuilder(options.EnableOctal, resourceModel.WithEnableOctal)
+	handleOptionalFieldWithBuilder(options.AllowDuplicate, resourceModel.WithAllowDuplicate)
+	handleOptionalFieldWithBuilder(options.StripOuterArray, resourceModel.WithStripOuterArray)
+	handleOptionalFieldWithBuilder(options.BinaryAsText, resourceModel.WithBinaryAsText)
+	handleOptionalFieldWithBuilder(options.PreserveSpace, resourceModel.WithPreserveSpace)
+	handleOptionalFieldWithBuilder(options.StripOuterElement, resourceModel.WithStripOuterElement)
+	handleOptionalFieldWithBuilder(options.DisableSnowflakeData, resourceModel.WithDisableSnowflakeData)
+	handleOptionalFieldWithBuilder(options.DisableAutoConvert, resourceModel.WithDisableAutoConvert)
+
+	importModel := NewImportModel(
+		resourceModel.ResourceReference(),
+		helpers.EncodeSnowflakeID(fileFormatId),
+	)
+
+	return NewGeneratedResource(resourceModel, importModel, fileFormatId.FullyQualifiedName(),
+		schemaObjectReferences(fileFormatId, resourceModel.WithDatabaseValue, resourceModel.WithSchemaValue)...,
+	), nil
+}
+
+// handleFileFormatDelimiter skips delimiters containing line breaks, as they cannot be rendered in the generated HCL.
+// Both delimiter fields are computed, so the most common case (the default newline record delimiter) is handled by the provider anyway.
+func handleFileFormatDelimiter[T any](fieldName string, value *string, builder func(string) *T) {
+	if value == nil {
+		return
+	}
+	if strings.ContainsAny(*value, "\r\n") {
+		if *value != "\n" {
+			log.Printf("The %s value %q contains line breaks and cannot be generated. Set it manually in the generated resource.", fieldName, *value)
+		}
+		return
+	}
+	builder(*value)
+}
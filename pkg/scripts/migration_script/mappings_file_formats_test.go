@@ -0,0 +1,94 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleFileFormatsMappings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputRows      [][]string
+		expectedOutput string
+	}{
+		{
+			name: "minimal file format",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "type"},
+				{"DB", "SCHEMA", "FORMAT", "CSV"},
+			},
+			expectedOutput: `
+resource "snowflake_file_format" "snowflake_generated_file_format_DB_SCHEMA_FORMAT" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "FORMAT"
+  format_type = "CSV"
+}
+import {
+  to = snowflake_file_format.snowflake_generated_file_format_DB_SCHEMA_FORMAT
+  id = "DB|SCHEMA|FORMAT"
+}
+`,
+		},
+		{
+			name: "csv file format with options",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "type", "comment", "format_options"},
+				{"DB", "SCHEMA", "FORMAT", "CSV", "format comment", "{\"TYPE\":\"CSV\",\"RECORD_DELIMITER\":\"\\n\",\"FIELD_DELIMITER\":\";\",\"ESCAPE\":\"\\\\\",\"SKIP_HEADER\":1,\"NULL_IF\":[\"NULL\",\"\"],\"COMPRESSION\":\"AUTO\",\"TRIM_SPACE\":true}"},
+			},
+			expectedOutput: `
+resource "snowflake_file_format" "snowflake_generated_file_format_DB_SCHEMA_FORMAT" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "FORMAT"
+  comment = "format comment"
+  compression = "AUTO"
+  escape = "\\"
+  field_delimiter = ";"
+  format_type = "CSV"
+  null_if = ["NULL", ""]
+  skip_header = 1
+  trim_space = true
+}
+import {
+  to = snowflake_file_format.snowflake_generated_file_format_DB_SCHEMA_FORMAT
+  id = "DB|SCHEMA|FORMAT"
+}
+`,
+		},
+		{
+			name: "json file format with options",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "type", "format_options"},
+				{"DB", "SCHEMA", "FORMAT", "JSON", "{\"TYPE\":\"JSON\",\"STRIP_OUTER_ARRAY\":true,\"COMPRESSION\":\"GZIP\"}"},
+			},
+			expectedOutput: `
+resource "snowflake_file_format" "snowflake_generated_file_format_DB_SCHEMA_FORMAT" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "FORMAT"
+  compression = "GZIP"
+  format_type = "JSON"
+  strip_outer_array = true
+}
+import {
+  to = snowflake_file_format.snowflake_generated_file_format_DB_SCHEMA_FORMAT
+  id = "DB|SCHEMA|FORMAT"
+}
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := HandleFileFormats(&Config{
+				ObjectType: ObjectTypeFileFormats,
+				ImportFlag: ImportStatementTypeBlock,
+			}, tc.inputRows)
+			assert.NoError(t, err)
+			assert.Equal(t, strings.TrimLeft(tc.expectedOutput, "\n"), strings.TrimLeft(output, "\n"))
+		})
+	}
+}
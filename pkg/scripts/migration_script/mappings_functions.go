@@ -0,0 +1,89 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func HandleFunctions(config *Config, csvInput [][]string) (string, error) {
+	return HandleResources(config, csvInput, CollectFunctions)
+}
+
+func CollectFunctions(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[FunctionCsvRow, RoutineRepresentation](csvInput, MapFunctionToModel)
+}
+
+func MapFunctionToModel(function RoutineRepresentation) (*GeneratedResource, error) {
+	id := function.Id
+	schemaObjectId := id.SchemaObjectId()
+
+	var resourceModel accconfig.ResourceModel
+	var references []ObjectReference
+	switch function.Language {
+	case "SQL":
+		functionModel := model.FunctionSql(ResourceId(resources.FunctionSql, id.FullyQualifiedName()), id.DatabaseName(), id.SchemaName(), id.Name(), function.Definition, function.ReturnType).
+			WithFunctionDefinitionValue(multilineStringVariable(function.Definition))
+		handleRoutineArguments(function, functionModel.WithArgumentsValue)
+		handleIf(function.IsSecure, functionModel.WithIsSecure)
+		handleIfNotEmpty(function.Comment, functionModel.WithComment)
+		references = schemaObjectReferences(schemaObjectId, functionModel.WithDatabaseValue, functionModel.WithSchemaValue)
+		resourceModel = functionModel
+	case "JAVASCRIPT":
+		functionModel := model.FunctionJavascript(ResourceId(resources.FunctionJavascript, id.FullyQualifiedName()), id.DatabaseName(), id.SchemaName(), id.Name(), function.Definition, function.ReturnType).
+			WithFunctionDefinitionValue(multilineStringVariable(function.Definition))
+		handleRoutineArguments(function, functionModel.WithArgumentsValue)
+		handleIf(function.IsSecure, functionModel.WithIsSecure)
+		handleIfNotEmpty(function.Comment, functionModel.WithComment)
+		references = schemaObjectReferences(schemaObjectId, functionModel.WithDatabaseValue, functionModel.WithSchemaValue)
+		resourceModel = functionModel
+	case "PYTHON":
+		if function.Handler == "" {
+			return nil, errors.New("handler is required for Python functions; provide it in the handler column")
+		}
+		functionModel := model.FunctionPython(ResourceId(resources.FunctionPython, id.FullyQualifiedName()), id.DatabaseName(), id.SchemaName(), id.Name(), function.Handler, function.ReturnType, function.RuntimeVersion)
+		if function.Definition != "" {
+			functionModel.WithFunctionDefinitionValue(multilineStringVariable(function.Definition))
+		}
+		handleRoutineArguments(function, functionModel.WithArgumentsValue)
+		handleRoutinePackages(function.Packages, functionModel.WithPackagesValue)
+		handleIf(function.IsSecure, functionModel.WithIsSecure)
+		handleIfNotEmpty(function.Comment, functionModel.WithComment)
+		references = schemaObjectReferences(schemaObjectId, functionModel.WithDatabaseValue, functionModel.WithSchemaValue)
+		resourceModel = functionModel
+	default:
+		return nil, fmt.Errorf("unsupported function language: %s", function.Language)
+	}
+
+	importModel := NewImportModel(
+		resourceModel.ResourceReference(),
+		id.FullyQualifiedName(),
+	)
+
+	return NewGeneratedResource(resourceModel, importModel, id.FullyQualifiedName(), references...), nil
+}
+
+func handleRoutineArguments[T any](routine RoutineRepresentation, builder func(value tfconfig.Variable) *T) {
+	if len(routine.Arguments) == 0 {
+		return
+	}
+	builder(tfconfig.TupleVariable(collections.Map(routine.Arguments, func(argument sdk.TableColumnSignature) tfconfig.Variable {
+		return tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"arg_name":      tfconfig.StringVariable(argument.Name),
+			"arg_data_type": tfconfig.StringVariable(argument.Type.ToSql()),
+		})
+	})...))
+}
+
+func handleRoutinePackages[T any](packages []string, builder func(value tfconfig.Variable) *T) {
+	if len(packages) == 0 {
+		return
+	}
+	builder(tfconfig.SetVariable(stringVariables(packages)...))
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleFunctionsMappings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputRows      [][]string
+		expectedOutput string
+	}{
+		{
+			name: "sql function",
+			inputRows: [][]string{
+				{"FUNCTION_CATALOG", "FUNCTION_SCHEMA", "FUNCTION_NAME", "ARGUMENT_SIGNATURE", "DATA_TYPE", "FUNCTION_LANGUAGE", "FUNCTION_DEFINITION", "IS_SECURE", "COMMENT"},
+				{"DB", "SCHEMA", "FUNCTION", "(A NUMBER, B VARCHAR)", "NUMBER(38,0)", "SQL", "A + 1", "YES", "function comment"},
+			},
+			expectedOutput: `
+resource "snowflake_function_sql" "snowflake_generated_function_sql_DB_SCHEMA_FUNCTIONNUMBERVARCHAR" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "FUNCTION"
+  arguments {
+    arg_data_type = "NUMBER(38, 0)"
+    arg_name = "A"
+  }
+  arguments {
+    arg_data_type = "VARCHAR(16777216)"
+    arg_name = "B"
+  }
+  comment = "function comment"
+  function_definition = "A + 1"
+  is_secure = "true"
+  return_type = "NUMBER(38,0)"
+}
+import {
+  to = snowflake_function_sql.snowflake_generated_function_sql_DB_SCHEMA_FUNCTIONNUMBERVARCHAR
+  id = "\"DB\".\"SCHEMA\".\"FUNCTION\"(NUMBER, VARCHAR)"
+}
+`,
+		},
+		{
+			name: "javascript function without arguments",
+			inputRows: [][]string{
+				{"function_catalog", "function_schema", "function_name", "argument_signature", "data_type", "function_language", "function_definition", "is_secure"},
+				{"DB", "SCHEMA", "FUNCTION", "()", "FLOAT", "JAVASCRIPT", "return 1;", "NO"},
+			},
+			expectedOutput: `
+resource "snowflake_function_javascript" "snowflake_generated_function_javascript_DB_SCHEMA_FUNCTION" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "FUNCTION"
+  function_definition = "return 1;"
+  return_type = "FLOAT"
+}
+import {
+  to = snowflake_function_javascript.snowflake_generated_function_javascript_DB_SCHEMA_FUNCTION
+  id = "\"DB\".\"SCHEMA\".\"FUNCTION\"()"
+}
+`,
+		},
+		{
+			name: "python function",
+			inputRows: [][]string{
+				{"function_catalog", "function_schema", "function_name", "argument_signature", "data_type", "function_language", "function_definition", "is_secure", "runtime_version", "packages", "handler"},
+				{"DB", "SCHEMA", "FUNCTION", "(A NUMBER)", "NUMBER(38,0)", "PYTHON", "def handler(a):\n    return a", "NO", "3.9", "['numpy','pandas']", "handler"},
+			},
+			expectedOutput: `
+resource "snowflake_function_python" "snowflake_generated_function_python_DB_SCHEMA_FUNCTIONNUMBER" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "FUNCTION"
+  arguments {
+    arg_data_type = "NUMBER(38, 0)"
+    arg_name = "A"
+  }
+  function_definition = <<EOT
+def handler(a):
+    return a
+EOT
+  handler = "handler"
+  packages = ["numpy", "pandas"]
+  return_type = "NUMBER(38,0)"
+  runtime_version = "3.9"
+}
+import {
+  to = snowflake_function_python.snowflake_generated_function_python_DB_SCHEMA_FUNCTIONNUMBER
+  id = "\"DB\".\"SCHEMA\".\"FUNCTION\"(NUMBER)"
+}
+`,
+		},
+		{
+			name: "python function without handler is skipped",
+			inputRows: [][]string{
+				{"function_catalog", "function_schema", "function_name", "argument_signature", "data_type", "function_language", "function_definition", "runtime_version"},
+				{"DB", "SCHEMA", "FUNCTION", "()", "NUMBER(38,0)", "PYTHON", "def handler():\n    return 1", "3.9"},
+			},
+			expectedOutput: "",
+		},
+		{
+			name: "java function is skipped",
+			inputRows: [][]string{
+				{"function_catalog", "function_schema", "function_name", "argument_signature", "data_type", "function_language", "function_definition"},
+				{"DB", "SCHEMA", "FUNCTION", "()", "NUMBER(38,0)", "JAVA", "class Test {}"},
+			},
+			expectedOutput: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := HandleFunctions(&Config{
+				ObjectType: ObjectTypeFunctions,
+				ImportFlag: ImportStatementTypeBlock,
+			}, tc.inputRows)
+			assert.NoError(t, err)
+			assert.Equal(t, strings.TrimLeft(tc.expectedOutput, "\n"), strings.TrimLeft(output, "\n"))
+		})
+	}
+}
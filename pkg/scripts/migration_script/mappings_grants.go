@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"log"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
@@ -10,49 +11,152 @@ import (
 	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
 )
 
 func HandleGrants(config *Config, csvInput [][]string) (string, error) {
+	return HandleResources(config, csvInput, CollectGrants)
+}
+
+func CollectGrants(csvInput [][]string) ([]GeneratedResource, error) {
 	grants, err := ConvertCsvInput[GrantCsvRow, sdk.Grant](csvInput)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	groupedGrants := GroupGrants(grants)
 
-	resourceModels := make([]accconfig.ResourceModel, 0)
-	importModels := make([]ImportModel, 0)
-
-	for _, grantGroup := range groupedGrants {
+	generatedResources := make([]GeneratedResource, 0)
+	// The groups are sorted, so the output is deterministic
+	for _, groupKey := range slices.Sorted(maps.Keys(groupedGrants)) {
+		grantGroup := groupedGrants[groupKey]
 		mappedModel, importModel, err := MapGrantToModel(grantGroup)
 		if err != nil {
 			log.Printf("Error converting grant group: %+v to model: %v. Skipping grant and continuing with other mappings.", grantGroup, err)
 		} else {
-			resourceModels = append(resourceModels, mappedModel)
-			importModels = append(importModels, *importModel)
+			generatedResources = append(generatedResources, *NewGeneratedResource(mappedModel, importModel, importModel.Id, GrantReferences(grantGroup[0], mappedModel)...))
 		}
 	}
 
-	mappedModels, err := collections.MapErr(resourceModels, ResourceFromModel)
-	if err != nil {
-		return "", fmt.Errorf("errors from resource model to HCL conversion: %w", err)
+	return generatedResources, nil
+}
+
+// GrantReferences returns the references to the roles and users the grant is given to and to the objects it is given on.
+func GrantReferences(grant sdk.Grant, resourceModel accconfig.ResourceModel) []ObjectReference {
+	switch m := resourceModel.(type) {
+	case *model.GrantAccountRoleModel:
+		references := []ObjectReference{accountRoleReference(grant.Name, m.WithRoleNameValue)}
+		switch grant.GrantedTo {
+		case sdk.ObjectTypeRole:
+			references = append(references, accountRoleReference(grant.GranteeName, m.WithParentRoleNameValue))
+		case sdk.ObjectTypeUser:
+			references = append(references, userReferences(grant.GranteeName, m.WithUserNameValue)...)
+		}
+		return references
+	case *model.GrantDatabaseRoleModel:
+		databaseRoleId := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(grant.Name.FullyQualifiedName())
+		references := []ObjectReference{databaseRoleReference(databaseRoleId, m.WithDatabaseRoleNameValue)}
+		switch grant.GrantedTo {
+		case sdk.ObjectTypeDatabaseRole:
+			references = append(references, databaseRoleReference(sdk.NewDatabaseObjectIdentifier(databaseRoleId.DatabaseName(), grant.GranteeName.Name()), m.WithParentDatabaseRoleNameValue))
+		case sdk.ObjectTypeRole:
+			references = append(references, accountRoleReference(grant.GranteeName, m.WithParentRoleNameValue))
+		}
+		return references
+	case *model.GrantPrivilegesToAccountRoleModel:
+		references := []ObjectReference{accountRoleReference(grant.GranteeName, m.WithAccountRoleNameValue)}
+		if grant.GrantedOn != sdk.ObjectTypeAccount {
+			references = append(references, grantOnReferences(grant, m.WithOnAccountObjectValue, m.WithOnSchemaValue, m.WithOnSchemaObjectValue)...)
+		}
+		return references
+	case *model.GrantPrivilegesToDatabaseRoleModel:
+		var databaseName string
+		if grant.GrantedOn == sdk.ObjectTypeDatabase {
+			databaseName = grant.Name.Name()
+		} else if inDatabase, ok := grant.Name.(interface{ DatabaseName() string }); ok {
+			databaseName = inDatabase.DatabaseName()
+		}
+		references := []ObjectReference{databaseRoleReference(sdk.NewDatabaseObjectIdentifier(databaseName, grant.GranteeName.Name()), m.WithDatabaseRoleNameValue)}
+		if grant.GrantedOn == sdk.ObjectTypeDatabase {
+			return append(references, databaseReference(sdk.NewAccountObjectIdentifier(grant.Name.Name()), m.WithOnDatabaseValue))
+		}
+		return append(references, grantOnReferences(grant, nil, m.WithOnSchemaValue, m.WithOnSchemaObjectValue)...)
+	default:
+		return nil
 	}
+}
+
+func accountRoleReference[T any](roleId sdk.ObjectIdentifier, builder func(value tfconfig.Variable) *T) ObjectReference {
+	return referenceTo(NewObjectKey(resources.AccountRole, roleId.FullyQualifiedName()), "name", builder)
+}
 
-	mappedImports, err := collections.MapErr(importModels, func(importModel ImportModel) (string, error) {
-		return TransformImportModel(config, importModel)
+func databaseRoleReference[T any](databaseRoleId sdk.DatabaseObjectIdentifier, builder func(value tfconfig.Variable) *T) ObjectReference {
+	return referenceTo(NewObjectKey(resources.DatabaseRole, databaseRoleId.FullyQualifiedName()), "fully_qualified_name", builder)
+}
+
+// userReferences refers to the user of any type; only the one which is generated is used.
+func userReferences[T any](userId sdk.ObjectIdentifier, builder func(value tfconfig.Variable) *T) []ObjectReference {
+	return collections.Map([]resources.Resource{resources.User, resources.ServiceUser, resources.LegacyServiceUser}, func(resource resources.Resource) ObjectReference {
+		return referenceTo(NewObjectKey(resource, userId.FullyQualifiedName()), "name", builder)
 	})
-	if err != nil {
-		return "", fmt.Errorf("errors during import transformations: %w", err)
-	}
+}
 
-	outputBuilder := new(strings.Builder)
-	outputBuilder.WriteString(collections.JoinStrings(mappedModels, "\n"))
-	outputBuilder.WriteString(collections.JoinStrings(mappedImports, ""))
+// grantOnSchemaObjectResources lists the resources which can represent the schema objects of a given type.
+// The resources without the fully_qualified_name attribute (e.g., streams) are referenced only in depends_on.
+var grantOnSchemaObjectResources = map[sdk.ObjectType][]resources.Resource{
+	sdk.ObjectTypeTable:      {resources.Table},
+	sdk.ObjectTypeView:       {resources.View},
+	sdk.ObjectTypeStage:      {resources.Stage},
+	sdk.ObjectTypeFileFormat: {resources.FileFormat},
+	sdk.ObjectTypePipe:       {resources.Pipe},
+	sdk.ObjectTypeTask:       {resources.Task},
+	sdk.ObjectTypeTag:        {resources.Tag},
+}
+
+var grantOnSchemaObjectDependsOnResources = map[sdk.ObjectType][]resources.Resource{
+	sdk.ObjectTypeStream: {resources.StreamOnTable, resources.StreamOnView, resources.StreamOnExternalTable, resources.StreamOnDirectoryTable},
+}
 
-	return outputBuilder.String(), nil
+// grantOnReferences returns the references to the object the grant is given on; the nested on_* blocks are rebuilt with the reference in place of the object name.
+func grantOnReferences[T any](
+	grant sdk.Grant,
+	onAccountObjectBuilder func(value tfconfig.Variable) *T,
+	onSchemaBuilder func(value tfconfig.Variable) *T,
+	onSchemaObjectBuilder func(value tfconfig.Variable) *T,
+) []ObjectReference {
+	switch {
+	case grant.GrantedOn == sdk.ObjectTypeSchema:
+		return []ObjectReference{NewObjectReference(NewObjectKey(resources.Schema, grant.Name.FullyQualifiedName()), "fully_qualified_name", func(value tfconfig.Variable) {
+			onSchemaBuilder(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+				"schema_name": value,
+			}))
+		})}
+	case onAccountObjectBuilder != nil && (grant.GrantedOn == sdk.ObjectTypeDatabase || grant.GrantedOn == sdk.ObjectTypeWarehouse):
+		target := map[sdk.ObjectType]resources.Resource{sdk.ObjectTypeDatabase: resources.Database, sdk.ObjectTypeWarehouse: resources.Warehouse}[grant.GrantedOn]
+		return []ObjectReference{NewObjectReference(NewObjectKey(target, grant.Name.FullyQualifiedName()), "name", func(value tfconfig.Variable) {
+			onAccountObjectBuilder(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+				"object_type": tfconfig.StringVariable(string(grant.GrantedOn)),
+				"object_name": value,
+			}))
+		})}
+	}
+
+	references := make([]ObjectReference, 0)
+	for _, resource := range grantOnSchemaObjectResources[grant.GrantedOn] {
+		references = append(references, NewObjectReference(NewObjectKey(resource, grant.Name.FullyQualifiedName()), "fully_qualified_name", func(value tfconfig.Variable) {
+			onSchemaObjectBuilder(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+				"object_type": tfconfig.StringVariable(string(grant.GrantedOn)),
+				"object_name": value,
+			}))
+		}))
+	}
+	for _, resource := range grantOnSchemaObjectDependsOnResources[grant.GrantedOn] {
+		references = append(references, NewDependsOnReference(NewObjectKey(resource, grant.Name.FullyQualifiedName())))
+	}
+	return references
 }
 
 func GroupGrants(grants []sdk.Grant) map[string][]sdk.Grant {
@@ -98,19 +202,19 @@ func MapGrantToModel(grantGroup []sdk.Grant) (accconfig.ResourceModel, *ImportMo
 
 func MapToGrantAccountRole(grant sdk.Grant) (accconfig.ResourceModel, *ImportModel, error) {
 	var resourceModel *model.GrantAccountRoleModel
-	var stateResourceId resources.GrantAccountRoleId
+	var stateResourceId r.GrantAccountRoleId
 
 	switch grant.GrantedTo {
 	case sdk.ObjectTypeRole:
 		resourceId := NormalizeResourceId(fmt.Sprintf("grant_%s_to_role_%s", grant.Name.Name(), grant.GranteeName.Name()))
 		resourceModel = model.GrantAccountRole(resourceId, grant.Name.Name()).WithParentRoleName(grant.GranteeName.Name())
 
-		stateResourceId = resources.NewGrantAccountRoleIdToRole(grant.Name.(sdk.AccountObjectIdentifier), grant.GranteeName.(sdk.AccountObjectIdentifier))
+		stateResourceId = r.NewGrantAccountRoleIdToRole(grant.Name.(sdk.AccountObjectIdentifier), grant.GranteeName.(sdk.AccountObjectIdentifier))
 	case sdk.ObjectTypeUser:
 		resourceId := NormalizeResourceId(fmt.Sprintf("grant_%s_to_user_%s", grant.Name.Name(), grant.GranteeName.Name()))
 		resourceModel = model.GrantAccountRole(resourceId, grant.Name.Name()).WithUserName(grant.GranteeName.Name())
 
-		stateResourceId = resources.NewGrantAccountRoleIdToUser(grant.Name.(sdk.AccountObjectIdentifier), grant.GranteeName.(sdk.AccountObjectIdentifier))
+		stateResourceId = r.NewGrantAccountRoleIdToUser(grant.Name.(sdk.AccountObjectIdentifier), grant.GranteeName.(sdk.AccountObjectIdentifier))
 	default:
 		return nil, nil, fmt.Errorf("unsupported grant account role mapping")
 	}
@@ -120,7 +224,7 @@ func MapToGrantAccountRole(grant sdk.Grant) (accconfig.ResourceModel, *ImportMod
 
 func MapToGrantDatabaseRole(grant sdk.Grant) (accconfig.ResourceModel, *ImportModel, error) {
 	var resourceModel *model.GrantDatabaseRoleModel
-	var stateResourceId resources.GrantDatabaseRoleId
+	var stateResourceId r.GrantDatabaseRoleId
 
 	databaseRoleName, err := sdk.ParseDatabaseObjectIdentifier(grant.Name.FullyQualifiedName())
 	if err != nil {
@@ -134,12 +238,12 @@ func MapToGrantDatabaseRole(grant sdk.Grant) (accconfig.ResourceModel, *ImportMo
 		resourceId := NormalizeResourceId(fmt.Sprintf("grant_%s_to_database_role_%s", databaseRoleName.FullyQualifiedName(), granteeName.FullyQualifiedName()))
 		resourceModel = model.GrantDatabaseRole(resourceId, databaseRoleName.FullyQualifiedName()).WithParentDatabaseRoleName(granteeName.FullyQualifiedName())
 
-		stateResourceId = resources.NewGrantDatabaseRoleIdToDatabaseRole(databaseRoleName, granteeName)
+		stateResourceId = r.NewGrantDatabaseRoleIdToDatabaseRole(databaseRoleName, granteeName)
 	case sdk.ObjectTypeRole:
 		resourceId := NormalizeResourceId(fmt.Sprintf("grant_%s_to_role_%s", databaseRoleName.FullyQualifiedName(), grant.GranteeName.Name()))
 		resourceModel = model.GrantDatabaseRole(resourceId, databaseRoleName.FullyQualifiedName()).WithParentRoleName(grant.GranteeName.Name())
 
-		stateResourceId = resources.NewGrantDatabaseRoleIdToRole(databaseRoleName, grant.GranteeName.(sdk.AccountObjectIdentifier))
+		stateResourceId = r.NewGrantDatabaseRoleIdToRole(databaseRoleName, grant.GranteeName.(sdk.AccountObjectIdentifier))
 	default:
 		return nil, nil, fmt.Errorf("unsupported grant database role mapping")
 	}
@@ -149,7 +253,7 @@ func MapToGrantDatabaseRole(grant sdk.Grant) (accconfig.ResourceModel, *ImportMo
 
 func MapToGrantPrivilegesToAccountRole(grant sdk.Grant, privileges ...string) (accconfig.ResourceModel, *ImportModel, error) {
 	var resourceModel accconfig.ResourceModel
-	var stateResourceId resources.GrantPrivilegesToAccountRoleId
+	var stateResourceId r.GrantPrivilegesToAccountRoleId
 
 	switch {
 	case grant.GrantedOn == sdk.ObjectTypeAccount:
@@ -160,7 +264,7 @@ func MapToGrantPrivilegesToAccountRole(grant sdk.Grant, privileges ...string) (a
 			WithOnAccount(true).
 			WithWithGrantOption(grant.GrantOption)
 
-		stateResourceId = resources.NewGrantPrivilegesToAccountRoleIdOnAccount(
+		stateResourceId = r.NewGrantPrivilegesToAccountRoleIdOnAccount(
 			grant.GranteeName.(sdk.AccountObjectIdentifier),
 			grant.GrantOption,
 			false,
@@ -178,7 +282,7 @@ func MapToGrantPrivilegesToAccountRole(grant sdk.Grant, privileges ...string) (a
 			})).
 			WithWithGrantOption(grant.GrantOption)
 
-		stateResourceId = resources.NewGrantPrivilegesToAccountRoleIdOnAccountObject(
+		stateResourceId = r.NewGrantPrivilegesToAccountRoleIdOnAccountObject(
 			grant.GranteeName.(sdk.AccountObjectIdentifier),
 			grant.GrantOption,
 			false,
@@ -197,7 +301,7 @@ func MapToGrantPrivilegesToAccountRole(grant sdk.Grant, privileges ...string) (a
 			})).
 			WithWithGrantOption(grant.GrantOption)
 
-		stateResourceId = resources.NewGrantPrivilegesToAccountRoleIdOnSchemaOnSchema(
+		stateResourceId = r.NewGrantPrivilegesToAccountRoleIdOnSchemaOnSchema(
 			grant.GranteeName.(sdk.AccountObjectIdentifier),
 			grant.GrantOption,
 			false,
@@ -216,7 +320,7 @@ func MapToGrantPrivilegesToAccountRole(grant sdk.Grant, privileges ...string) (a
 			})).
 			WithWithGrantOption(grant.GrantOption)
 
-		stateResourceId = resources.NewGrantPrivilegesToAccountRoleIdOnSchemaObjectOnObject(
+		stateResourceId = r.NewGrantPrivilegesToAccountRoleIdOnSchemaObjectOnObject(
 			grant.GranteeName.(sdk.AccountObjectIdentifier),
 			grant.GrantOption,
 			false,
@@ -234,7 +338,7 @@ func MapToGrantPrivilegesToAccountRole(grant sdk.Grant, privileges ...string) (a
 
 func MapToGrantPrivilegesToDatabaseRole(grant sdk.Grant, privileges ...string) (accconfig.ResourceModel, *ImportModel, error) {
 	var resourceModel accconfig.ResourceModel
-	var stateResourceId resources.GrantPrivilegesToDatabaseRoleId
+	var stateResourceId r.GrantPrivilegesToDatabaseRoleId
 
 	withGrantOption := "without"
 	if grant.GrantOption {
@@ -253,7 +357,7 @@ func MapToGrantPrivilegesToDatabaseRole(grant sdk.Grant, privileges ...string) (
 			WithOnDatabase(grant.Name.Name()).
 			WithWithGrantOption(grant.GrantOption)
 
-		stateResourceId = resources.NewGrantPrivilegesToDatabaseRoleIdOnDatabase(
+		stateResourceId = r.NewGrantPrivilegesToDatabaseRoleIdOnDatabase(
 			granteeName,
 			grant.GrantOption,
 			false,
@@ -274,7 +378,7 @@ func MapToGrantPrivilegesToDatabaseRole(grant sdk.Grant, privileges ...string) (
 			})).
 			WithWithGrantOption(grant.GrantOption)
 
-		stateResourceId = resources.NewGrantPrivilegesToDatabaseRoleIdOnSchemaOnSchema(
+		stateResourceId = r.NewGrantPrivilegesToDatabaseRoleIdOnSchemaOnSchema(
 			granteeName,
 			grant.GrantOption,
 			false,
@@ -296,7 +400,7 @@ func MapToGrantPrivilegesToDatabaseRole(grant sdk.Grant, privileges ...string) (
 			})).
 			WithWithGrantOption(grant.GrantOption)
 
-		stateResourceId = resources.NewGrantPrivilegesToDatabaseRoleIdOnSchemaObjectOnObject(
+		stateResourceId = r.NewGrantPrivilegesToDatabaseRoleIdOnSchemaObjectOnObject(
 			granteeName,
 			grant.GrantOption,
 			false,
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+const IntegrationCategoryStorage = "STORAGE"
+
+func HandleIntegrations(config *Config, csvInput [][]string) (string, error) {
+	return HandleResources(config, csvInput, CollectIntegrations)
+}
+
+func CollectIntegrations(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[IntegrationCsvRow, IntegrationRepresentation](csvInput, MapIntegrationToModel)
+}
+
+func MapIntegrationToModel(integration IntegrationRepresentation) (*GeneratedResource, error) {
+	switch strings.ToUpper(integration.Category) {
+	case IntegrationCategoryStorage:
+		return MapToStorageIntegration(integration)
+	default:
+		return nil, fmt.Errorf("unsupported integration category: %s (integration %s)", integration.Category, integration.Name)
+	}
+}
+
+func MapToStorageIntegration(integration IntegrationRepresentation) (*GeneratedResource, error) {
+	if integration.StorageProvider == "" || len(integration.StorageAllowedLocations) == 0 {
+		return nil, fmt.Errorf("storage_provider and storage_allowed_locations are required for storage integration %s, combine the input with the DESCRIBE STORAGE INTEGRATION output", integration.Name)
+	}
+
+	integrationId := sdk.NewAccountObjectIdentifier(integration.Name)
+	resourceId := ResourceId(resources.StorageIntegration, integrationId.FullyQualifiedName())
+	resourceModel := model.StorageIntegration(resourceId, integration.Name, integration.StorageAllowedLocations, integration.StorageProvider)
+
+	resourceModel.WithEnabled(integration.Enabled)
+	handleIfNotEmpty(integration.Comment, resourceModel.WithComment)
+	if len(integration.StorageBlockedLocations) > 0 {
+		resourceModel.WithStorageBlockedLocationsValue(tfconfig.SetVariable(stringVariables(integration.StorageBlockedLocations)...))
+	}
+	handleIfNotEmpty(integration.StorageAwsRoleArn, resourceModel.WithStorageAwsRoleArn)
+	handleIfNotEmpty(integration.StorageAwsObjectAcl, resourceModel.WithStorageAwsObjectAcl)
+	handleIfNotEmpty(integration.AzureTenantId, resourceModel.WithAzureTenantId)
+	if integration.UsePrivatelinkEndpoint != nil {
+		handleBooleanString(*integration.UsePrivatelinkEndpoint, resourceModel.WithUsePrivatelinkEndpoint)
+	}
+
+	importModel := NewImportModel(
+		resourceModel.ResourceReference(),
+		integrationId.FullyQualifiedName(),
+	)
+
+	return NewGeneratedResource(resourceModel, importModel, integrationId.FullyQualifiedName()), nil
+}
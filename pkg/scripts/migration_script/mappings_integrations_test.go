@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleIntegrationsMappings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputRows      [][]string
+		expectedOutput string
+	}{
+		{
+			name: "s3 storage integration",
+			inputRows: [][]string{
+				{"name", "type", "category", "enabled", "comment", "storage_provider", "storage_allowed_locations", "storage_blocked_locations", "storage_aws_role_arn", "storage_aws_object_acl"},
+				{"INTEGRATION", "EXTERNAL_STAGE", "STORAGE", "true", "integration comment", "S3", "s3://bucket/a/,s3://bucket/b/", "s3://bucket/a/blocked/", "arn:aws:iam::000000000001:/role/test", ""},
+			},
+			expectedOutput: `
+resource "snowflake_storage_integration" "snowflake_generated_storage_integration_INTEGRATION" {
+  name = "INTEGRATION"
+  comment = "integration comment"
+  enabled = true
+  storage_allowed_locations = ["s3://bucket/a/", "s3://bucket/b/"]
+  storage_aws_role_arn = "arn:aws:iam::000000000001:/role/test"
+  storage_blocked_locations = ["s3://bucket/a/blocked/"]
+  storage_provider = "S3"
+}
+import {
+  to = snowflake_storage_integration.snowflake_generated_storage_integration_INTEGRATION
+  id = "\"INTEGRATION\""
+}
+`,
+		},
+		{
+			name: "unsupported integration category is skipped",
+			inputRows: [][]string{
+				{"name", "type", "category", "enabled"},
+				{"INTEGRATION", "EXTERNAL_API", "API", "true"},
+			},
+			expectedOutput: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := HandleIntegrations(&Config{
+				ObjectType: ObjectTypeIntegrations,
+				ImportFlag: ImportStatementTypeBlock,
+			}, tc.inputRows)
+			assert.NoError(t, err)
+			assert.Equal(t, strings.TrimLeft(tc.expectedOutput, "\n"), strings.TrimLeft(output, "\n"))
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func HandleNetworkPolicies(config *Config, csvInput [][]string) (string, error) {
+	return HandleResources(config, csvInput, CollectNetworkPolicies)
+}
+
+func CollectNetworkPolicies(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[NetworkPolicyCsvRow, NetworkPolicyRepresentation](csvInput, MapNetworkPolicyToModel)
+}
+
+func MapNetworkPolicyToModel(networkPolicy NetworkPolicyRepresentation) (*GeneratedResource, error) {
+	networkPolicyId := sdk.NewAccountObjectIdentifier(networkPolicy.Name)
+	resourceId := ResourceId(resources.NetworkPolicy, networkPolicyId.FullyQualifiedName())
+	resourceModel := model.NetworkPolicy(resourceId, networkPolicy.Name)
+
+	handleIfNotEmpty(networkPolicy.Comment, resourceModel.WithComment)
+	if len(networkPolicy.AllowedIpList) > 0 {
+		resourceModel.WithAllowedIps(networkPolicy.AllowedIpList...)
+	}
+	if len(networkPolicy.BlockedIpList) > 0 {
+		resourceModel.WithBlockedIps(networkPolicy.BlockedIpList...)
+	}
+	if len(networkPolicy.AllowedNetworkRuleList) > 0 {
+		resourceModel.WithAllowedNetworkRules(networkPolicy.AllowedNetworkRuleList...)
+	}
+	if len(networkPolicy.BlockedNetworkRuleList) > 0 {
+		resourceModel.WithBlockedNetworkRules(networkPolicy.BlockedNetworkRuleList...)
+	}
+
+	importModel := NewImportModel(
+		resourceModel.ResourceReference(),
+		networkPolicyId.FullyQualifiedName(),
+	)
+
+	return NewGeneratedResource(resourceModel, importModel, networkPolicyId.FullyQualifiedName()), nil
+}
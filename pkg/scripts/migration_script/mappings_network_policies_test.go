@@ -0,0 +1,64 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleNetworkPoliciesMappings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputRows      [][]string
+		expectedOutput string
+	}{
+		{
+			name: "minimal network policy",
+			inputRows: [][]string{
+				{"name"},
+				{"POLICY"},
+			},
+			expectedOutput: `
+resource "snowflake_network_policy" "snowflake_generated_network_policy_POLICY" {
+  name = "POLICY"
+}
+import {
+  to = snowflake_network_policy.snowflake_generated_network_policy_POLICY
+  id = "\"POLICY\""
+}
+`,
+		},
+		{
+			name: "network policy with describe output",
+			inputRows: [][]string{
+				{"name", "comment", "allowed_ip_list", "blocked_ip_list", "allowed_network_rule_list", "blocked_network_rule_list"},
+				{"POLICY", "policy comment", "192.168.0.100,192.168.0.101", "192.168.0.102", "[{\"fullyQualifiedRuleName\":\"\\\"DB\\\".\\\"SCHEMA\\\".\\\"ALLOWED\\\"\"}]", ""},
+			},
+			expectedOutput: `
+resource "snowflake_network_policy" "snowflake_generated_network_policy_POLICY" {
+  name = "POLICY"
+  allowed_ip_list = ["192.168.0.100", "192.168.0.101"]
+  allowed_network_rule_list = ["\"DB\".\"SCHEMA\".\"ALLOWED\""]
+  blocked_ip_list = ["192.168.0.102"]
+  comment = "policy comment"
+}
+import {
+  to = snowflake_network_policy.snowflake_generated_network_policy_POLICY
+  id = "\"POLICY\""
+}
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := HandleNetworkPolicies(&Config{
+				ObjectType: ObjectTypeNetworkPolicies,
+				ImportFlag: ImportStatementTypeBlock,
+			}, tc.inputRows)
+			assert.NoError(t, err)
+			assert.Equal(t, strings.TrimLeft(tc.expectedOutput, "\n"), strings.TrimLeft(output, "\n"))
+		})
+	}
+}
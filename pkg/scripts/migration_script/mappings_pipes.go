@@ -0,0 +1,43 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func HandlePipes(config *Config, csvInput [][]string) (string, error) {
+	return HandleResources(config, csvInput, CollectPipes)
+}
+
+func CollectPipes(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[PipeCsvRow, PipeRepresentation](csvInput, MapPipeToModel)
+}
+
+func MapPipeToModel(pipe PipeRepresentation) (*GeneratedResource, error) {
+	if pipe.Definition == "" {
+		return nil, errors.New("pipe definition is empty")
+	}
+
+	pipeId := sdk.NewSchemaObjectIdentifier(pipe.DatabaseName, pipe.SchemaName, pipe.Name)
+	resourceId := ResourceId(resources.Pipe, pipeId.FullyQualifiedName())
+	resourceModel := model.Pipe(resourceId, pipe.DatabaseName, pipe.SchemaName, pipe.Name, pipe.Definition).
+		WithCopyStatementValue(multilineStringVariable(pipe.Definition))
+
+	if pipe.NotificationChannel != "" {
+		resourceModel.WithAutoIngest(true)
+	}
+	handleIfNotEmpty(pipe.Integration, resourceModel.WithIntegration)
+	handleIfNotEmpty(pipe.ErrorIntegration, resourceModel.WithErrorIntegration)
+	handleIfNotEmpty(pipe.Comment, resourceModel.WithComment)
+
+	importModel := NewImportModel(
+		resourceModel.ResourceReference(),
+		helpers.EncodeSnowflakeID(pipeId),
+	)
+
+	return NewGeneratedResource(resourceModel, importModel, pipeId.FullyQualifiedName(), schemaObjectReferences(pipeId, resourceModel.WithDatabaseValue, resourceModel.WithSchemaValue)...), nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandlePipesMappings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputRows      [][]string
+		expectedOutput string
+	}{
+		{
+			name: "minimal pipe",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "definition"},
+				{"DB", "SCHEMA", "PIPE", "COPY INTO DB.SCHEMA.TABLE FROM @DB.SCHEMA.STAGE"},
+			},
+			expectedOutput: `
+resource "snowflake_pipe" "snowflake_generated_pipe_DB_SCHEMA_PIPE" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "PIPE"
+  copy_statement = "COPY INTO DB.SCHEMA.TABLE FROM @DB.SCHEMA.STAGE"
+}
+import {
+  to = snowflake_pipe.snowflake_generated_pipe_DB_SCHEMA_PIPE
+  id = "DB|SCHEMA|PIPE"
+}
+`,
+		},
+		{
+			name: "pipe with all fields",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "definition", "notification_channel", "integration", "error_integration", "comment"},
+				{"DB", "SCHEMA", "PIPE", "COPY INTO DB.SCHEMA.TABLE\\nFROM @DB.SCHEMA.STAGE", "arn:aws:sqs:us-west-2:000000000001:sf-snowpipe", "", "NOTIFICATION_INTEGRATION", "pipe comment"},
+			},
+			expectedOutput: `
+resource "snowflake_pipe" "snowflake_generated_pipe_DB_SCHEMA_PIPE" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "PIPE"
+  auto_ingest = true
+  comment = "pipe comment"
+  copy_statement = <<EOT
+COPY INTO DB.SCHEMA.TABLE
+FROM @DB.SCHEMA.STAGE
+EOT
+  error_integration = "NOTIFICATION_INTEGRATION"
+}
+import {
+  to = snowflake_pipe.snowflake_generated_pipe_DB_SCHEMA_PIPE
+  id = "DB|SCHEMA|PIPE"
+}
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := HandlePipes(&Config{
+				ObjectType: ObjectTypePipes,
+				ImportFlag: ImportStatementTypeBlock,
+			}, tc.inputRows)
+			assert.NoError(t, err)
+			assert.Equal(t, strings.TrimLeft(tc.expectedOutput, "\n"), strings.TrimLeft(output, "\n"))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func HandleProcedures(config *Config, csvInput [][]string) (string, error) {
+	return HandleResources(config, csvInput, CollectProcedures)
+}
+
+func CollectProcedures(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[ProcedureCsvRow, RoutineRepresentation](csvInput, MapProcedureToModel)
+}
+
+func MapProcedureToModel(procedure RoutineRepresentation) (*GeneratedResource, error) {
+	id := procedure.Id
+	schemaObjectId := id.SchemaObjectId()
+
+	var resourceModel accconfig.ResourceModel
+	var references []ObjectReference
+	switch procedure.Language {
+	case "SQL":
+		procedureModel := model.ProcedureSql(ResourceId(resources.ProcedureSql, id.FullyQualifiedName()), id.DatabaseName(), id.SchemaName(), id.Name(), procedure.Definition, procedure.ReturnType).
+			WithProcedureDefinitionValue(multilineStringVariable(procedure.Definition))
+		handleRoutineArguments(procedure, procedureModel.WithArgumentsValue)
+		handleIf(procedure.IsSecure, procedureModel.WithIsSecure)
+		handleIfNotEmpty(procedure.Comment, procedureModel.WithComment)
+		references = schemaObjectReferences(schemaObjectId, procedureModel.WithDatabaseValue, procedureModel.WithSchemaValue)
+		resourceModel = procedureModel
+	case "JAVASCRIPT":
+		procedureModel := model.ProcedureJavascript(ResourceId(resources.ProcedureJavascript, id.FullyQualifiedName()), id.DatabaseName(), id.SchemaName(), id.Name(), procedure.Definition, procedure.ReturnType).
+			WithProcedureDefinitionValue(multilineStringVariable(procedure.Definition))
+		handleRoutineArguments(procedure, procedureModel.WithArgumentsValue)
+		handleIf(procedure.IsSecure, procedureModel.WithIsSecure)
+		handleIfNotEmpty(procedure.Comment, procedureModel.WithComment)
+		references = schemaObjectReferences(schemaObjectId, procedureModel.WithDatabaseValue, procedureModel.WithSchemaValue)
+		resourceModel = procedureModel
+	case "PYTHON":
+		if procedure.Handler == "" {
+			return nil, errors.New("handler is required for Python procedures; provide it in the handler column")
+		}
+		// The Snowpark package is a separate attribute in the resource, so it is extracted from the packages list.
+		snowparkPackageIndex := slices.IndexFunc(procedure.Packages, func(p string) bool { return strings.HasPrefix(p, sdk.PythonSnowparkPackageString) })
+		if snowparkPackageIndex == -1 {
+			return nil, fmt.Errorf("the %s package with an explicit version is required for Python procedures", strings.TrimSuffix(sdk.PythonSnowparkPackageString, "=="))
+		}
+		snowparkPackage := strings.TrimPrefix(procedure.Packages[snowparkPackageIndex], sdk.PythonSnowparkPackageString)
+		packages := slices.Delete(slices.Clone(procedure.Packages), snowparkPackageIndex, snowparkPackageIndex+1)
+
+		procedureModel := model.ProcedurePython(ResourceId(resources.ProcedurePython, id.FullyQualifiedName()), id.DatabaseName(), id.SchemaName(), id.Name(), procedure.Handler, procedure.ReturnType, procedure.RuntimeVersion, snowparkPackage)
+		if procedure.Definition != "" {
+			procedureModel.WithProcedureDefinitionValue(multilineStringVariable(procedure.Definition))
+		}
+		handleRoutineArguments(procedure, procedureModel.WithArgumentsValue)
+		handleRoutinePackages(packages, procedureModel.WithPackagesValue)
+		handleIf(procedure.IsSecure, procedureModel.WithIsSecure)
+		handleIfNotEmpty(procedure.Comment, procedureModel.WithComment)
+		references = schemaObjectReferences(schemaObjectId, procedureModel.WithDatabaseValue, procedureModel.WithSchemaValue)
+		resourceModel = procedureModel
+	default:
+		return nil, fmt.Errorf("unsupported procedure language: %s", procedure.Language)
+	}
+
+	importModel := NewImportModel(
+		resourceModel.ResourceReference(),
+		id.FullyQualifiedName(),
+	)
+
+	return NewGeneratedResource(resourceModel, importModel, id.FullyQualifiedName(), references...), nil
+}
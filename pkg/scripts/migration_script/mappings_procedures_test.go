@@ -0,0 +1,116 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleProceduresMappings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputRows      [][]string
+		expectedOutput string
+	}{
+		{
+			name: "sql procedure",
+			inputRows: [][]string{
+				{"PROCEDURE_CATALOG", "PROCEDURE_SCHEMA", "PROCEDURE_NAME", "ARGUMENT_SIGNATURE", "DATA_TYPE", "PROCEDURE_LANGUAGE", "PROCEDURE_DEFINITION", "COMMENT"},
+				{"DB", "SCHEMA", "PROCEDURE", "(A NUMBER)", "NUMBER(38,0)", "SQL", "BEGIN\n  RETURN A;\nEND;", "procedure comment"},
+			},
+			expectedOutput: `
+resource "snowflake_procedure_sql" "snowflake_generated_procedure_sql_DB_SCHEMA_PROCEDURENUMBER" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "PROCEDURE"
+  arguments {
+    arg_data_type = "NUMBER(38, 0)"
+    arg_name = "A"
+  }
+  comment = "procedure comment"
+  procedure_definition = <<EOT
+BEGIN
+  RETURN A;
+END;
+EOT
+  return_type = "NUMBER(38,0)"
+}
+import {
+  to = snowflake_procedure_sql.snowflake_generated_procedure_sql_DB_SCHEMA_PROCEDURENUMBER
+  id = "\"DB\".\"SCHEMA\".\"PROCEDURE\"(NUMBER)"
+}
+`,
+		},
+		{
+			name: "javascript procedure",
+			inputRows: [][]string{
+				{"procedure_catalog", "procedure_schema", "procedure_name", "argument_signature", "data_type", "procedure_language", "procedure_definition"},
+				{"DB", "SCHEMA", "PROCEDURE", "()", "VARCHAR", "JAVASCRIPT", "return 'x';"},
+			},
+			expectedOutput: `
+resource "snowflake_procedure_javascript" "snowflake_generated_procedure_javascript_DB_SCHEMA_PROCEDURE" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "PROCEDURE"
+  procedure_definition = "return 'x';"
+  return_type = "VARCHAR"
+}
+import {
+  to = snowflake_procedure_javascript.snowflake_generated_procedure_javascript_DB_SCHEMA_PROCEDURE
+  id = "\"DB\".\"SCHEMA\".\"PROCEDURE\"()"
+}
+`,
+		},
+		{
+			name: "python procedure",
+			inputRows: [][]string{
+				{"procedure_catalog", "procedure_schema", "procedure_name", "argument_signature", "data_type", "procedure_language", "procedure_definition", "runtime_version", "packages", "handler"},
+				{"DB", "SCHEMA", "PROCEDURE", "(A NUMBER)", "NUMBER(38,0)", "PYTHON", "def run(session, a):\n    return a", "3.9", "['snowflake-snowpark-python==1.14.0','numpy']", "run"},
+			},
+			expectedOutput: `
+resource "snowflake_procedure_python" "snowflake_generated_procedure_python_DB_SCHEMA_PROCEDURENUMBER" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "PROCEDURE"
+  arguments {
+    arg_data_type = "NUMBER(38, 0)"
+    arg_name = "A"
+  }
+  handler = "run"
+  packages = ["numpy"]
+  procedure_definition = <<EOT
+def run(session, a):
+    return a
+EOT
+  return_type = "NUMBER(38,0)"
+  runtime_version = "3.9"
+  snowpark_package = "1.14.0"
+}
+import {
+  to = snowflake_procedure_python.snowflake_generated_procedure_python_DB_SCHEMA_PROCEDURENUMBER
+  id = "\"DB\".\"SCHEMA\".\"PROCEDURE\"(NUMBER)"
+}
+`,
+		},
+		{
+			name: "python procedure without snowpark package is skipped",
+			inputRows: [][]string{
+				{"procedure_catalog", "procedure_schema", "procedure_name", "argument_signature", "data_type", "procedure_language", "procedure_definition", "runtime_version", "packages", "handler"},
+				{"DB", "SCHEMA", "PROCEDURE", "()", "NUMBER(38,0)", "PYTHON", "def run(session):\n    return 1", "3.9", "['numpy']", "run"},
+			},
+			expectedOutput: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := HandleProcedures(&Config{
+				ObjectType: ObjectTypeProcedures,
+				ImportFlag: ImportStatementTypeBlock,
+			}, tc.inputRows)
+			assert.NoError(t, err)
+			assert.Equal(t, strings.TrimLeft(tc.expectedOutput, "\n"), strings.TrimLeft(output, "\n"))
+		})
+	}
+}
@@ -1,17 +1,20 @@
 package main
 
 import (
-	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
 
 func HandleSchemas(config *Config, csvInput [][]string) (string, error) {
-	return HandleResources[SchemaCsvRow, SchemaRepresentation](config, csvInput, MapSchemaToModel)
+	return HandleResources(config, csvInput, CollectSchemas)
 }
 
-func MapSchemaToModel(schema SchemaRepresentation) (accconfig.ResourceModel, *ImportModel, error) {
+func CollectSchemas(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[SchemaCsvRow, SchemaRepresentation](csvInput, MapSchemaToModel)
+}
+
+func MapSchemaToModel(schema SchemaRepresentation) (*GeneratedResource, error) {
 	schemaId := sdk.NewDatabaseObjectIdentifier(schema.DatabaseName, schema.Name)
 	resourceId := ResourceId(resources.Schema, schemaId.FullyQualifiedName())
 	resourceModel := model.Schema(resourceId, schema.DatabaseName, schema.Name)
@@ -43,5 +46,7 @@ func MapSchemaToModel(schema SchemaRepresentation) (accconfig.ResourceModel, *Im
 		schemaId.FullyQualifiedName(),
 	)
 
-	return resourceModel, importModel, nil
+	return NewGeneratedResource(resourceModel, importModel, schemaId.FullyQualifiedName(),
+		databaseReference(schemaId.DatabaseId(), resourceModel.WithDatabaseValue),
+	), nil
 }
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func HandleStages(config *Config, csvInput [][]string) (string, error) {
+	return HandleResources(config, csvInput, CollectStages)
+}
+
+func CollectStages(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[StageCsvRow, StageRepresentation](csvInput, MapStageToModel)
+}
+
+func MapStageToModel(stage StageRepresentation) (*GeneratedResource, error) {
+	stageId := sdk.NewSchemaObjectIdentifier(stage.DatabaseName, stage.SchemaName, stage.Name)
+	resourceId := ResourceId(resources.Stage, stageId.FullyQualifiedName())
+	resourceModel := model.Stage(resourceId, stage.DatabaseName, stage.SchemaName, stage.Name)
+
+	handleIfNotEmpty(stage.Url, resourceModel.WithUrl)
+	handleIfNotEmpty(stage.Comment, resourceModel.WithComment)
+	if stage.DirectoryEnabled {
+		resourceModel.WithDirectory("ENABLE = true")
+	}
+
+	references := schemaObjectReferences(stageId, resourceModel.WithDatabaseValue, resourceModel.WithSchemaValue)
+	if stage.StorageIntegration != nil {
+		resourceModel.WithStorageIntegration(stage.StorageIntegration.Name())
+		references = append(references, referenceTo(NewObjectKey(resources.StorageIntegration, stage.StorageIntegration.FullyQualifiedName()), "name", resourceModel.WithStorageIntegrationValue))
+	}
+
+	importModel := NewImportModel(
+		resourceModel.ResourceReference(),
+		helpers.EncodeSnowflakeID(stageId),
+	)
+
+	return NewGeneratedResource(resourceModel, importModel, stageId.FullyQualifiedName(), references...), nil
+}
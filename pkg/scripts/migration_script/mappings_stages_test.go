@@ -0,0 +1,68 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleStagesMappings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputRows      [][]string
+		expectedOutput string
+	}{
+		{
+			name: "minimal stage",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name"},
+				{"DB", "SCHEMA", "STAGE"},
+			},
+			expectedOutput: `
+resource "snowflake_stage" "snowflake_generated_stage_DB_SCHEMA_STAGE" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "STAGE"
+}
+import {
+  to = snowflake_stage.snowflake_generated_stage_DB_SCHEMA_STAGE
+  id = "DB|SCHEMA|STAGE"
+}
+`,
+		},
+		{
+			name: "external stage with all fields",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "url", "comment", "storage_integration", "directory_enabled", "type"},
+				{"DB", "SCHEMA", "STAGE", "s3://bucket/path/", "stage comment", "INTEGRATION", "Y", "EXTERNAL"},
+			},
+			expectedOutput: `
+resource "snowflake_stage" "snowflake_generated_stage_DB_SCHEMA_STAGE" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "STAGE"
+  comment = "stage comment"
+  directory = "ENABLE = true"
+  storage_integration = "INTEGRATION"
+  url = "s3://bucket/path/"
+}
+import {
+  to = snowflake_stage.snowflake_generated_stage_DB_SCHEMA_STAGE
+  id = "DB|SCHEMA|STAGE"
+}
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := HandleStages(&Config{
+				ObjectType: ObjectTypeStages,
+				ImportFlag: ImportStatementTypeBlock,
+			}, tc.inputRows)
+			assert.NoError(t, err)
+			assert.Equal(t, strings.TrimLeft(tc.expectedOutput, "\n"), strings.TrimLeft(output, "\n"))
+		})
+	}
+}
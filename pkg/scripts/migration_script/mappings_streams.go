@@ -0,0 +1,72 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func HandleStreams(config *Config, csvInput [][]string) (string, error) {
+	return HandleResources(config, csvInput, CollectStreams)
+}
+
+func CollectStreams(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[StreamCsvRow, StreamRepresentation](csvInput, MapStreamToModel)
+}
+
+func MapStreamToModel(stream StreamRepresentation) (*GeneratedResource, error) {
+	if stream.SourceType == nil || stream.TableName == nil {
+		return nil, errors.New("stream source is missing (source_type and table_name are required)")
+	}
+
+	streamId := sdk.NewSchemaObjectIdentifier(stream.DatabaseName, stream.SchemaName, stream.Name)
+	sourceId := *stream.TableName
+	isAppendOnly := stream.Mode != nil && *stream.Mode == sdk.StreamModeAppendOnly
+
+	var resourceModel accconfig.ResourceModel
+	var references []ObjectReference
+	switch *stream.SourceType {
+	case sdk.StreamSourceTypeTable:
+		streamModel := model.StreamOnTable(ResourceId(resources.StreamOnTable, streamId.FullyQualifiedName()), stream.DatabaseName, stream.SchemaName, stream.Name, sourceId.FullyQualifiedName())
+		handleBooleanString(isAppendOnly, streamModel.WithAppendOnly)
+		handleIfNotNil(stream.Comment, streamModel.WithComment)
+		references = append(schemaObjectReferences(streamId, streamModel.WithDatabaseValue, streamModel.WithSchemaValue),
+			referenceTo(NewObjectKey(resources.Table, sourceId.FullyQualifiedName()), "fully_qualified_name", streamModel.WithTableValue),
+		)
+		resourceModel = streamModel
+	case sdk.StreamSourceTypeView:
+		streamModel := model.StreamOnView(ResourceId(resources.StreamOnView, streamId.FullyQualifiedName()), stream.DatabaseName, stream.SchemaName, stream.Name, sourceId.FullyQualifiedName())
+		handleBooleanString(isAppendOnly, streamModel.WithAppendOnly)
+		handleIfNotNil(stream.Comment, streamModel.WithComment)
+		references = append(schemaObjectReferences(streamId, streamModel.WithDatabaseValue, streamModel.WithSchemaValue),
+			referenceTo(NewObjectKey(resources.View, sourceId.FullyQualifiedName()), "fully_qualified_name", streamModel.WithViewValue),
+		)
+		resourceModel = streamModel
+	case sdk.StreamSourceTypeExternalTable:
+		streamModel := model.StreamOnExternalTable(ResourceId(resources.StreamOnExternalTable, streamId.FullyQualifiedName()), stream.DatabaseName, stream.SchemaName, stream.Name, sourceId.FullyQualifiedName())
+		handleBooleanString(stream.Mode != nil && *stream.Mode == sdk.StreamModeInsertOnly, streamModel.WithInsertOnly)
+		handleIfNotNil(stream.Comment, streamModel.WithComment)
+		references = schemaObjectReferences(streamId, streamModel.WithDatabaseValue, streamModel.WithSchemaValue)
+		resourceModel = streamModel
+	case sdk.StreamSourceTypeStage:
+		streamModel := model.StreamOnDirectoryTable(ResourceId(resources.StreamOnDirectoryTable, streamId.FullyQualifiedName()), stream.DatabaseName, stream.SchemaName, stream.Name, sourceId.FullyQualifiedName())
+		handleIfNotNil(stream.Comment, streamModel.WithComment)
+		references = append(schemaObjectReferences(streamId, streamModel.WithDatabaseValue, streamModel.WithSchemaValue),
+			referenceTo(NewObjectKey(resources.Stage, sourceId.FullyQualifiedName()), "fully_qualified_name", streamModel.WithStageValue),
+		)
+		resourceModel = streamModel
+	default:
+		return nil, fmt.Errorf("unsupported stream source type: %s", *stream.SourceType)
+	}
+
+	importModel := NewImportModel(
+		resourceModel.ResourceReference(),
+		streamId.FullyQualifiedName(),
+	)
+
+	return NewGeneratedResource(resourceModel, importModel, streamId.FullyQualifiedName(), references...), nil
+}
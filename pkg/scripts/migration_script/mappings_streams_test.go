@@ -0,0 +1,116 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleStreamsMappings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputRows      [][]string
+		expectedOutput string
+	}{
+		{
+			name: "stream on table",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "table_name", "source_type", "mode", "comment"},
+				{"DB", "SCHEMA", "STREAM", "DB.SCHEMA.TABLE", "Table", "APPEND_ONLY", "stream comment"},
+			},
+			expectedOutput: `
+resource "snowflake_stream_on_table" "snowflake_generated_stream_on_table_DB_SCHEMA_STREAM" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "STREAM"
+  append_only = "true"
+  comment = "stream comment"
+  table = "\"DB\".\"SCHEMA\".\"TABLE\""
+}
+import {
+  to = snowflake_stream_on_table.snowflake_generated_stream_on_table_DB_SCHEMA_STREAM
+  id = "\"DB\".\"SCHEMA\".\"STREAM\""
+}
+`,
+		},
+		{
+			name: "stream on view",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "table_name", "source_type", "mode"},
+				{"DB", "SCHEMA", "STREAM", "DB.SCHEMA.VIEW", "View", "DEFAULT"},
+			},
+			expectedOutput: `
+resource "snowflake_stream_on_view" "snowflake_generated_stream_on_view_DB_SCHEMA_STREAM" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "STREAM"
+  append_only = "false"
+  view = "\"DB\".\"SCHEMA\".\"VIEW\""
+}
+import {
+  to = snowflake_stream_on_view.snowflake_generated_stream_on_view_DB_SCHEMA_STREAM
+  id = "\"DB\".\"SCHEMA\".\"STREAM\""
+}
+`,
+		},
+		{
+			name: "stream on external table",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "table_name", "source_type", "mode"},
+				{"DB", "SCHEMA", "STREAM", "DB.SCHEMA.EXTERNAL_TABLE", "External Table", "INSERT_ONLY"},
+			},
+			expectedOutput: `
+resource "snowflake_stream_on_external_table" "snowflake_generated_stream_on_external_table_DB_SCHEMA_STREAM" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "STREAM"
+  external_table = "\"DB\".\"SCHEMA\".\"EXTERNAL_TABLE\""
+  insert_only = "true"
+}
+import {
+  to = snowflake_stream_on_external_table.snowflake_generated_stream_on_external_table_DB_SCHEMA_STREAM
+  id = "\"DB\".\"SCHEMA\".\"STREAM\""
+}
+`,
+		},
+		{
+			name: "stream on directory table",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "table_name", "source_type", "mode"},
+				{"DB", "SCHEMA", "STREAM", "DB.SCHEMA.STAGE", "Stage", "DEFAULT"},
+			},
+			expectedOutput: `
+resource "snowflake_stream_on_directory_table" "snowflake_generated_stream_on_directory_table_DB_SCHEMA_STREAM" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "STREAM"
+  stage = "\"DB\".\"SCHEMA\".\"STAGE\""
+}
+import {
+  to = snowflake_stream_on_directory_table.snowflake_generated_stream_on_directory_table_DB_SCHEMA_STREAM
+  id = "\"DB\".\"SCHEMA\".\"STREAM\""
+}
+`,
+		},
+		{
+			name: "stream on dropped source is skipped",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "table_name", "source_type", "mode"},
+				{"DB", "SCHEMA", "STREAM", "", "Table", "DEFAULT"},
+			},
+			expectedOutput: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := HandleStreams(&Config{
+				ObjectType: ObjectTypeStreams,
+				ImportFlag: ImportStatementTypeBlock,
+			}, tc.inputRows)
+			assert.NoError(t, err)
+			assert.Equal(t, strings.TrimLeft(tc.expectedOutput, "\n"), strings.TrimLeft(output, "\n"))
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func HandleTables(config *Config, csvInput [][]string) (string, error) {
+	return HandleResources(config, csvInput, CollectTables)
+}
+
+// CollectTables groups the described columns by their tables (keeping the input order) and maps every table into a single resource.
+func CollectTables(csvInput [][]string) ([]GeneratedResource, error) {
+	columns, err := ConvertCsvInput[TableColumnCsvRow, TableColumnRepresentation](csvInput)
+	if err != nil {
+		return nil, err
+	}
+
+	tableIds := make([]string, 0)
+	columnsByTable := make(map[string][]TableColumnRepresentation)
+	for _, column := range columns {
+		tableId := column.TableId.FullyQualifiedName()
+		if _, ok := columnsByTable[tableId]; !ok {
+			tableIds = append(tableIds, tableId)
+		}
+		columnsByTable[tableId] = append(columnsByTable[tableId], column)
+	}
+
+	generatedResources := make([]GeneratedResource, 0)
+	for _, tableId := range tableIds {
+		generatedResource, err := MapTableToModel(columnsByTable[tableId])
+		if err != nil {
+			log.Printf("Error converting table %s to model: %v. Skipping table and continuing with other mappings.", tableId, err)
+		} else {
+			generatedResources = append(generatedResources, *generatedResource)
+		}
+	}
+
+	return generatedResources, nil
+}
+
+func MapTableToModel(columns []TableColumnRepresentation) (*GeneratedResource, error) {
+	tableId := columns[0].TableId
+	columns = collections.Filter(columns, func(column TableColumnRepresentation) bool { return column.Kind == "" || column.Kind == "COLUMN" })
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %s has no columns", tableId.FullyQualifiedName())
+	}
+
+	resourceId := ResourceId(resources.Table, tableId.FullyQualifiedName())
+	resourceModel := model.Table(resourceId, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), nil).
+		WithColumnValue(tfconfig.TupleVariable(collections.Map(columns, mapTableColumn)...))
+
+	handleIfNotEmpty(columns[0].TableComment, resourceModel.WithComment)
+
+	importModel := NewImportModel(
+		resourceModel.ResourceReference(),
+		helpers.EncodeSnowflakeID(tableId),
+	)
+
+	return NewGeneratedResource(resourceModel, importModel, tableId.FullyQualifiedName(),
+		schemaObjectReferences(tableId, resourceModel.WithDatabaseValue, resourceModel.WithSchemaValue)...,
+	), nil
+}
+
+// mapTableColumn follows the logic of reading the columns in the snowflake_table resource.
+func mapTableColumn(column TableColumnRepresentation) tfconfig.Variable {
+	columnVariables := map[string]tfconfig.Variable{
+		"name": tfconfig.StringVariable(column.Name),
+		"type": tfconfig.StringVariable(string(column.Type)),
+	}
+	if !column.IsNullable {
+		columnVariables["nullable"] = tfconfig.BoolVariable(false)
+	}
+	if column.Comment != nil {
+		columnVariables["comment"] = tfconfig.StringVariable(*column.Comment)
+	}
+	if column.Collation != nil {
+		columnVariables["collate"] = tfconfig.StringVariable(*column.Collation)
+	}
+	if column.PolicyName != nil {
+		columnVariables["masking_policy"] = tfconfig.StringVariable(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(*column.PolicyName).FullyQualifiedName())
+	}
+	if column.Default != nil {
+		if identity := mapTableColumnIdentity(*column.Default); identity != nil {
+			columnVariables["identity"] = tfconfig.ListVariable(identity)
+		} else {
+			columnVariables["default"] = tfconfig.ListVariable(mapTableColumnDefault(column.Type, *column.Default))
+		}
+	}
+	return tfconfig.ObjectVariable(columnVariables)
+}
+
+func mapTableColumnDefault(columnType sdk.DataType, defaultValue string) tfconfig.Variable {
+	switch {
+	case strings.HasSuffix(defaultValue, ".NEXTVAL"):
+		sequenceId := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(strings.TrimSuffix(defaultValue, ".NEXTVAL"))
+		return tfconfig.ObjectVariable(map[string]tfconfig.Variable{"sequence": tfconfig.StringVariable(sequenceId.FullyQualifiedName())})
+	case strings.Contains(defaultValue, "(") && strings.Contains(defaultValue, ")"):
+		return tfconfig.ObjectVariable(map[string]tfconfig.Variable{"expression": tfconfig.StringVariable(defaultValue)})
+	case sdk.IsStringType(string(columnType)):
+		return tfconfig.ObjectVariable(map[string]tfconfig.Variable{"constant": tfconfig.StringVariable(snowflake.UnescapeSnowflakeString(defaultValue))})
+	default:
+		return tfconfig.ObjectVariable(map[string]tfconfig.Variable{"constant": tfconfig.StringVariable(defaultValue)})
+	}
+}
+
+// mapTableColumnIdentity parses the default value in the format of "IDENTITY START 1 INCREMENT 1".
+func mapTableColumnIdentity(defaultValue string) tfconfig.Variable {
+	if !strings.Contains(defaultValue, "IDENTITY") {
+		return nil
+	}
+	identityVariables := make(map[string]tfconfig.Variable)
+	if parts := strings.Split(defaultValue, " "); len(parts) >= 5 {
+		if start, err := strconv.Atoi(parts[2]); err == nil {
+			identityVariables["start_num"] = tfconfig.IntegerVariable(start)
+		}
+		if step, err := strconv.Atoi(parts[4]); err == nil {
+			identityVariables["step_num"] = tfconfig.IntegerVariable(step)
+		}
+	}
+	return tfconfig.ObjectVariable(identityVariables)
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleTablesMappings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputRows      [][]string
+		expectedOutput string
+	}{
+		{
+			name: "minimal table",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "table_name", "name", "type", "kind", "null?"},
+				{"DB", "SCHEMA", "TABLE", "ID", "NUMBER(38,0)", "COLUMN", "Y"},
+			},
+			expectedOutput: `
+resource "snowflake_table" "snowflake_generated_table_DB_SCHEMA_TABLE" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "TABLE"
+  column {
+    name = "ID"
+    type = "NUMBER(38,0)"
+  }
+}
+import {
+  to = snowflake_table.snowflake_generated_table_DB_SCHEMA_TABLE
+  id = "DB|SCHEMA|TABLE"
+}
+`,
+		},
+		{
+			name: "table with all column fields",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "table_name", "table_comment", "name", "type", "kind", "null?", "default", "primary key", "unique key", "check", "expression", "comment", "policy name", "collation"},
+				{"DB", "SCHEMA", "TABLE", "table comment", "ID", "NUMBER(38,0)", "COLUMN", "N", "IDENTITY START 1 INCREMENT 1 NOORDER", "Y", "N", "", "", "id column", "", ""},
+				{"DB", "SCHEMA", "TABLE", "table comment", "NAME", "VARCHAR(100)", "COLUMN", "Y", "'unknown'", "N", "N", "", "", "", "DB.SCHEMA.POLICY", "en-ci"},
+				{"DB", "SCHEMA", "TABLE", "table comment", "CREATED_AT", "TIMESTAMP_NTZ(9)", "COLUMN", "Y", "CURRENT_TIMESTAMP()", "N", "N", "", "", "", "", ""},
+				{"DB", "SCHEMA", "TABLE", "table comment", "VIRTUAL", "NUMBER(38,0)", "VIRTUAL", "Y", "", "N", "N", "", "ID + 1", "", "", ""},
+			},
+			expectedOutput: `
+resource "snowflake_table" "snowflake_generated_table_DB_SCHEMA_TABLE" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "TABLE"
+  column {
+    comment = "id column"
+    identity {
+      start_num = 1
+      step_num = 1
+    }
+    name = "ID"
+    nullable = false
+    type = "NUMBER(38,0)"
+  }
+  column {
+    collate = "en-ci"
+    default {
+      constant = "unknown"
+    }
+    masking_policy = "\"DB\".\"SCHEMA\".\"POLICY\""
+    name = "NAME"
+    type = "VARCHAR(100)"
+  }
+  column {
+    default {
+      expression = "CURRENT_TIMESTAMP()"
+    }
+    name = "CREATED_AT"
+    type = "TIMESTAMP_NTZ(9)"
+  }
+  comment = "table comment"
+}
+import {
+  to = snowflake_table.snowflake_generated_table_DB_SCHEMA_TABLE
+  id = "DB|SCHEMA|TABLE"
+}
+`,
+		},
+		{
+			name: "multiple tables",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "table_name", "name", "type", "kind", "null?"},
+				{"DB", "SCHEMA", "TABLE_A", "ID", "NUMBER(38,0)", "COLUMN", "Y"},
+				{"DB", "SCHEMA", "TABLE_B", "ID", "NUMBER(38,0)", "COLUMN", "Y"},
+				{"DB", "SCHEMA", "TABLE_A", "NAME", "VARCHAR(16777216)", "COLUMN", "Y"},
+			},
+			expectedOutput: `
+resource "snowflake_table" "snowflake_generated_table_DB_SCHEMA_TABLE_A" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "TABLE_A"
+  column {
+    name = "ID"
+    type = "NUMBER(38,0)"
+  }
+  column {
+    name = "NAME"
+    type = "VARCHAR(16777216)"
+  }
+}
+
+resource "snowflake_table" "snowflake_generated_table_DB_SCHEMA_TABLE_B" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "TABLE_B"
+  column {
+    name = "ID"
+    type = "NUMBER(38,0)"
+  }
+}
+import {
+  to = snowflake_table.snowflake_generated_table_DB_SCHEMA_TABLE_A
+  id = "DB|SCHEMA|TABLE_A"
+}
+import {
+  to = snowflake_table.snowflake_generated_table_DB_SCHEMA_TABLE_B
+  id = "DB|SCHEMA|TABLE_B"
+}
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := HandleTables(&Config{
+				ObjectType: ObjectTypeTables,
+				ImportFlag: ImportStatementTypeBlock,
+			}, tc.inputRows)
+			assert.NoError(t, err)
+			assert.Equal(t, strings.TrimLeft(tc.expectedOutput, "\n"), strings.TrimLeft(output, "\n"))
+		})
+	}
+}
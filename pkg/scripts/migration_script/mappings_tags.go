@@ -0,0 +1,45 @@
+package main
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func HandleTags(config *Config, csvInput [][]string) (string, error) {
+	return HandleResources(config, csvInput, CollectTags)
+}
+
+func CollectTags(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[TagCsvRow, TagRepresentation](csvInput, MapTagToModel)
+}
+
+func MapTagToModel(tag TagRepresentation) (*GeneratedResource, error) {
+	tagId := sdk.NewSchemaObjectIdentifier(tag.DatabaseName, tag.SchemaName, tag.Name)
+	resourceId := ResourceId(resources.Tag, tagId.FullyQualifiedName())
+	resourceModel := model.Tag(resourceId, tag.DatabaseName, tag.SchemaName, tag.Name)
+
+	handleIfNotEmpty(tag.Comment, resourceModel.WithComment)
+	if len(tag.AllowedValues) > 0 {
+		resourceModel.WithOrderedAllowedValues(tag.AllowedValues...)
+	}
+	if tag.Propagate != nil {
+		resourceModel.WithPropagateEnum(*tag.Propagate)
+	}
+	if tag.OnConflict != nil {
+		if *tag.OnConflict == sdk.TagOnConflictAllowedValuesSequence {
+			resourceModel.WithOnConflictAllowedValuesSequence()
+		} else {
+			resourceModel.WithOnConflictCustomValue(*tag.OnConflict)
+		}
+	}
+
+	importModel := NewImportModel(
+		resourceModel.ResourceReference(),
+		tagId.FullyQualifiedName(),
+	)
+
+	return NewGeneratedResource(resourceModel, importModel, tagId.FullyQualifiedName(),
+		schemaObjectReferences(tagId, resourceModel.WithDatabaseValue, resourceModel.WithSchemaValue)...,
+	), nil
+}
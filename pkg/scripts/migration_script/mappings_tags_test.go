@@ -0,0 +1,70 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleTagsMappings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputRows      [][]string
+		expectedOutput string
+	}{
+		{
+			name: "minimal tag",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name"},
+				{"DB", "SCHEMA", "TAG"},
+			},
+			expectedOutput: `
+resource "snowflake_tag" "snowflake_generated_tag_DB_SCHEMA_TAG" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "TAG"
+}
+import {
+  to = snowflake_tag.snowflake_generated_tag_DB_SCHEMA_TAG
+  id = "\"DB\".\"SCHEMA\".\"TAG\""
+}
+`,
+		},
+		{
+			name: "tag with all fields",
+			inputRows: [][]string{
+				{"created_on", "name", "database_name", "schema_name", "owner", "comment", "allowed_values", "owner_role_type", "propagate", "on_conflict"},
+				{"2024-06-06 00:00:00.000 +0000 UTC", "TAG", "DB", "SCHEMA", "ACCOUNTADMIN", "tag comment", "[\"b\", \"a\"]", "ROLE", "ON_DEPENDENCY_AND_DATA_MOVEMENT", "ALLOWED_VALUES_SEQUENCE"},
+			},
+			expectedOutput: `
+resource "snowflake_tag" "snowflake_generated_tag_DB_SCHEMA_TAG" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "TAG"
+  comment = "tag comment"
+  on_conflict {
+    allowed_values_sequence = true
+  }
+  ordered_allowed_values = ["b", "a"]
+  propagate = "ON_DEPENDENCY_AND_DATA_MOVEMENT"
+}
+import {
+  to = snowflake_tag.snowflake_generated_tag_DB_SCHEMA_TAG
+  id = "\"DB\".\"SCHEMA\".\"TAG\""
+}
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := HandleTags(&Config{
+				ObjectType: ObjectTypeTags,
+				ImportFlag: ImportStatementTypeBlock,
+			}, tc.inputRows)
+			assert.NoError(t, err)
+			assert.Equal(t, strings.TrimLeft(tc.expectedOutput, "\n"), strings.TrimLeft(output, "\n"))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func HandleTasks(config *Config, csvInput [][]string) (string, error) {
+	return HandleResources(config, csvInput, CollectTasks)
+}
+
+func CollectTasks(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[TaskCsvRow, TaskRepresentation](csvInput, MapTaskToModel)
+}
+
+func MapTaskToModel(task TaskRepresentation) (*GeneratedResource, error) {
+	if task.Definition == "" {
+		return nil, errors.New("task definition is empty")
+	}
+
+	taskId := sdk.NewSchemaObjectIdentifier(task.DatabaseName, task.SchemaName, task.Name)
+	resourceId := ResourceId(resources.Task, taskId.FullyQualifiedName())
+	resourceModel := model.Task(resourceId, task.DatabaseName, task.SchemaName, task.Name, task.Definition, task.State == sdk.TaskStateStarted).
+		WithSqlStatementValue(multilineStringVariable(task.Definition))
+
+	references := schemaObjectReferences(taskId, resourceModel.WithDatabaseValue, resourceModel.WithSchemaValue)
+
+	if task.Schedule != "" {
+		schedule, err := sdk.ParseTaskSchedule(task.Schedule)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case schedule.Minutes > 0:
+			resourceModel.WithScheduleMinutes(schedule.Minutes)
+		case schedule.Seconds > 0:
+			resourceModel.WithScheduleSeconds(schedule.Seconds)
+		case schedule.Hours > 0:
+			resourceModel.WithScheduleHours(schedule.Hours)
+		case schedule.Cron != "":
+			resourceModel.WithScheduleCron(schedule.Cron)
+		}
+	}
+	if task.Warehouse != nil {
+		resourceModel.WithWarehouse(task.Warehouse.Name())
+		references = append(references, referenceTo(NewObjectKey(resources.Warehouse, task.Warehouse.FullyQualifiedName()), "name", resourceModel.WithWarehouseValue))
+	}
+	if len(task.Predecessors) > 0 {
+		// Every predecessor is referenced separately, so each of them replaces only its own element of the list.
+		after := make([]tfconfig.Variable, len(task.Predecessors))
+		for i, predecessor := range task.Predecessors {
+			after[i] = tfconfig.StringVariable(predecessor.FullyQualifiedName())
+			references = append(references, NewObjectReference(NewObjectKey(resources.Task, predecessor.FullyQualifiedName()), "fully_qualified_name", func(value tfconfig.Variable) {
+				after[i] = value
+				resourceModel.WithAfterValue(tfconfig.TupleVariable(after...))
+			}))
+		}
+		resourceModel.WithAfterValue(tfconfig.TupleVariable(after...))
+	}
+	handleIfNotEmpty(task.Condition, resourceModel.WithWhen)
+	handleIf(task.AllowOverlappingExecution, resourceModel.WithAllowOverlappingExecution)
+	if task.ErrorIntegration != nil {
+		resourceModel.WithErrorIntegration(task.ErrorIntegration.Name())
+	}
+	handleIfNotEmpty(task.Config, resourceModel.WithConfig)
+	handleIfNotEmpty(task.Comment, resourceModel.WithComment)
+
+	importModel := NewImportModel(
+		resourceModel.ResourceReference(),
+		taskId.FullyQualifiedName(),
+	)
+
+	return NewGeneratedResource(resourceModel, importModel, taskId.FullyQualifiedName(), references...), nil
+}
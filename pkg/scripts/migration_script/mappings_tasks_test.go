@@ -0,0 +1,114 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleTasksMappings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputRows      [][]string
+		expectedOutput string
+	}{
+		{
+			name: "minimal task",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "definition", "state"},
+				{"DB", "SCHEMA", "TASK", "SELECT 1", "suspended"},
+			},
+			expectedOutput: `
+resource "snowflake_task" "snowflake_generated_task_DB_SCHEMA_TASK" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "TASK"
+  sql_statement = "SELECT 1"
+  started = false
+}
+import {
+  to = snowflake_task.snowflake_generated_task_DB_SCHEMA_TASK
+  id = "\"DB\".\"SCHEMA\".\"TASK\""
+}
+`,
+		},
+		{
+			name: "task with all fields",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "definition", "state", "warehouse", "schedule", "predecessors", "condition", "allow_overlapping_execution", "error_integration", "config", "comment"},
+				{"DB", "SCHEMA", "TASK", "SELECT 1", "started", "WAREHOUSE", "USING CRON * * * * * UTC", "[]", "SYSTEM$STREAM_HAS_DATA('DB.SCHEMA.STREAM')", "true", "NOTIFICATION_INTEGRATION", "{\"key\":\"value\"}", "task comment"},
+			},
+			expectedOutput: `
+resource "snowflake_task" "snowflake_generated_task_DB_SCHEMA_TASK" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "TASK"
+  allow_overlapping_execution = "true"
+  comment = "task comment"
+  config = "{\"key\":\"value\"}"
+  error_integration = "NOTIFICATION_INTEGRATION"
+  schedule {
+    using_cron = "* * * * * UTC"
+  }
+  sql_statement = "SELECT 1"
+  started = true
+  warehouse = "WAREHOUSE"
+  when = "SYSTEM$STREAM_HAS_DATA('DB.SCHEMA.STREAM')"
+}
+import {
+  to = snowflake_task.snowflake_generated_task_DB_SCHEMA_TASK
+  id = "\"DB\".\"SCHEMA\".\"TASK\""
+}
+`,
+		},
+		{
+			name: "tasks with predecessors",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "definition", "state", "schedule", "predecessors"},
+				{"DB", "SCHEMA", "CHILD", "SELECT 2", "suspended", "", "[\"\\\"DB\\\".\\\"SCHEMA\\\".\\\"ROOT\\\"\", \"\\\"DB\\\".\\\"SCHEMA\\\".\\\"OTHER\\\"\"]"},
+				{"DB", "SCHEMA", "ROOT", "SELECT 1", "suspended", "5 MINUTES", "[]"},
+			},
+			expectedOutput: `
+resource "snowflake_task" "snowflake_generated_task_DB_SCHEMA_ROOT" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "ROOT"
+  schedule {
+    minutes = 5
+  }
+  sql_statement = "SELECT 1"
+  started = false
+}
+
+resource "snowflake_task" "snowflake_generated_task_DB_SCHEMA_CHILD" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "CHILD"
+  after = [snowflake_task.snowflake_generated_task_DB_SCHEMA_ROOT.fully_qualified_name, "\"DB\".\"SCHEMA\".\"OTHER\""]
+  sql_statement = "SELECT 2"
+  started = false
+}
+import {
+  to = snowflake_task.snowflake_generated_task_DB_SCHEMA_ROOT
+  id = "\"DB\".\"SCHEMA\".\"ROOT\""
+}
+import {
+  to = snowflake_task.snowflake_generated_task_DB_SCHEMA_CHILD
+  id = "\"DB\".\"SCHEMA\".\"CHILD\""
+}
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := HandleTasks(&Config{
+				ObjectType: ObjectTypeTasks,
+				ImportFlag: ImportStatementTypeBlock,
+			}, tc.inputRows)
+			assert.NoError(t, err)
+			assert.Equal(t, strings.TrimLeft(tc.expectedOutput, "\n"), strings.TrimLeft(output, "\n"))
+		})
+	}
+}
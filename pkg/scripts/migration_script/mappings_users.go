@@ -17,7 +17,11 @@ const (
 )
 
 func HandleUsers(config *Config, csvInput [][]string) (string, error) {
-	return HandleResources[UserCsvRow, UserRepresentation](config, csvInput, MapUserToModel)
+	return HandleResources(config, csvInput, CollectUsers)
+}
+
+func CollectUsers(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[UserCsvRow, UserRepresentation](csvInput, WithoutReferences(MapUserToModel))
 }
 
 func MapUserToModel(user UserRepresentation) (accconfig.ResourceModel, *ImportModel, error) {
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+)
+
+func HandleViews(config *Config, csvInput [][]string) (string, error) {
+	return HandleResources(config, csvInput, CollectViews)
+}
+
+func CollectViews(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[ViewCsvRow, ViewRepresentation](csvInput, MapViewToModel)
+}
+
+func MapViewToModel(view ViewRepresentation) (*GeneratedResource, error) {
+	if view.IsMaterialized {
+		return nil, fmt.Errorf("materialized views are not supported (view %s)", view.Name)
+	}
+	if view.Text == "" {
+		return nil, errors.New("text is missing; if the view is secure, then the role used to query Snowflake must own the view")
+	}
+
+	statement, err := snowflake.NewViewSelectStatementExtractor(view.Text).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("extracting statement of view %s: %w", view.Name, err)
+	}
+
+	viewId := sdk.NewSchemaObjectIdentifier(view.DatabaseName, view.SchemaName, view.Name)
+	resourceId := ResourceId(resources.View, viewId.FullyQualifiedName())
+	resourceModel := model.View(resourceId, view.DatabaseName, view.SchemaName, view.Name, statement).
+		WithStatementValue(multilineStringVariable(statement))
+
+	handleIfNotEmpty(view.Comment, resourceModel.WithComment)
+	handleIf(view.IsSecure, resourceModel.WithIsSecure)
+	handleIf(view.ChangeTracking == "ON", resourceModel.WithChangeTracking)
+
+	importModel := NewImportModel(
+		resourceModel.ResourceReference(),
+		viewId.FullyQualifiedName(),
+	)
+
+	return NewGeneratedResource(resourceModel, importModel, viewId.FullyQualifiedName(),
+		schemaObjectReferences(viewId, resourceModel.WithDatabaseValue, resourceModel.WithSchemaValue)...,
+	), nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleViewsMappings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputRows      [][]string
+		expectedOutput string
+	}{
+		{
+			name: "minimal view",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "text"},
+				{"DB", "SCHEMA", "VIEW", "CREATE VIEW VIEW AS SELECT 1 AS A"},
+			},
+			expectedOutput: `
+resource "snowflake_view" "snowflake_generated_view_DB_SCHEMA_VIEW" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "VIEW"
+  statement = "SELECT 1 AS A"
+}
+import {
+  to = snowflake_view.snowflake_generated_view_DB_SCHEMA_VIEW
+  id = "\"DB\".\"SCHEMA\".\"VIEW\""
+}
+`,
+		},
+		{
+			name: "view with all fields",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "text", "comment", "is_secure", "is_materialized", "change_tracking"},
+				{"DB", "SCHEMA", "VIEW", "CREATE OR REPLACE SECURE VIEW VIEW COMMENT = 'view comment' AS SELECT *\nFROM TABLE", "view comment", "true", "false", "ON"},
+			},
+			expectedOutput: `
+resource "snowflake_view" "snowflake_generated_view_DB_SCHEMA_VIEW" {
+  database = "DB"
+  schema = "SCHEMA"
+  name = "VIEW"
+  change_tracking = "true"
+  comment = "view comment"
+  is_secure = "true"
+  statement = <<EOT
+SELECT *
+FROM TABLE
+EOT
+}
+import {
+  to = snowflake_view.snowflake_generated_view_DB_SCHEMA_VIEW
+  id = "\"DB\".\"SCHEMA\".\"VIEW\""
+}
+`,
+		},
+		{
+			name: "materialized view is skipped",
+			inputRows: [][]string{
+				{"database_name", "schema_name", "name", "text", "is_materialized"},
+				{"DB", "SCHEMA", "VIEW", "CREATE MATERIALIZED VIEW VIEW AS SELECT 1 AS A", "true"},
+			},
+			expectedOutput: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := HandleViews(&Config{
+				ObjectType: ObjectTypeViews,
+				ImportFlag: ImportStatementTypeBlock,
+			}, tc.inputRows)
+			assert.NoError(t, err)
+			assert.Equal(t, strings.TrimLeft(tc.expectedOutput, "\n"), strings.TrimLeft(output, "\n"))
+		})
+	}
+}
@@ -10,7 +10,11 @@ import (
 )
 
 func HandleWarehouses(config *Config, csvInput [][]string) (string, error) {
-	return HandleResources[WarehouseCsvRow, WarehouseRepresentation](config, csvInput, MapWarehouseToModel)
+	return HandleResources(config, csvInput, CollectWarehouses)
+}
+
+func CollectWarehouses(csvInput [][]string) ([]GeneratedResource, error) {
+	return CollectResources[WarehouseCsvRow, WarehouseRepresentation](csvInput, WithoutReferences(MapWarehouseToModel))
 }
 
 func MapWarehouseToModel(warehouse WarehouseRepresentation) (accconfig.ResourceModel, *ImportModel, error) {
@@ -3,12 +3,15 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/csv"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 
@@ -18,13 +21,25 @@ import (
 type ObjectType string
 
 const (
-	ObjectTypeGrants        ObjectType = "grants"
-	ObjectTypeSchemas       ObjectType = "schemas"
-	ObjectTypeDatabases     ObjectType = "databases"
-	ObjectTypeWarehouses    ObjectType = "warehouses"
-	ObjectTypeAccountRoles  ObjectType = "account_roles"
-	ObjectTypeDatabaseRoles ObjectType = "database_roles"
-	ObjectTypeUsers         ObjectType = "users"
+	ObjectTypeGrants          ObjectType = "grants"
+	ObjectTypeSchemas         ObjectType = "schemas"
+	ObjectTypeDatabases       ObjectType = "databases"
+	ObjectTypeWarehouses      ObjectType = "warehouses"
+	ObjectTypeAccountRoles    ObjectType = "account_roles"
+	ObjectTypeDatabaseRoles   ObjectType = "database_roles"
+	ObjectTypeUsers           ObjectType = "users"
+	ObjectTypeTables          ObjectType = "tables"
+	ObjectTypeViews           ObjectType = "views"
+	ObjectTypeStages          ObjectType = "stages"
+	ObjectTypeFileFormats     ObjectType = "file_formats"
+	ObjectTypeTasks           ObjectType = "tasks"
+	ObjectTypeStreams         ObjectType = "streams"
+	ObjectTypePipes           ObjectType = "pipes"
+	ObjectTypeFunctions       ObjectType = "functions"
+	ObjectTypeProcedures      ObjectType = "procedures"
+	ObjectTypeNetworkPolicies ObjectType = "network_policies"
+	ObjectTypeIntegrations    ObjectType = "integrations"
+	ObjectTypeTags            ObjectType = "tags"
 )
 
 var AllObjectTypes = []ObjectType{
@@ -35,6 +50,18 @@ var AllObjectTypes = []ObjectType{
 	ObjectTypeAccountRoles,
 	ObjectTypeDatabaseRoles,
 	ObjectTypeUsers,
+	ObjectTypeTables,
+	ObjectTypeViews,
+	ObjectTypeStages,
+	ObjectTypeFileFormats,
+	ObjectTypeTasks,
+	ObjectTypeStreams,
+	ObjectTypePipes,
+	ObjectTypeFunctions,
+	ObjectTypeProcedures,
+	ObjectTypeNetworkPolicies,
+	ObjectTypeIntegrations,
+	ObjectTypeTags,
 }
 
 func ToObjectType(s string) (ObjectType, error) {
@@ -71,11 +98,43 @@ const (
 	ExitCodeFailedInputArgumentParsing
 	ExitCodeFailedCsvInputParsing
 	ExitCodeFailedGeneratingTerraformOutput
+	ExitCodeFailedQueryingSnowflake
+	ExitCodeFailedWritingOutput
 )
 
+// ObjectInput points to a file containing the CSV input for the given object type.
+type ObjectInput struct {
+	ObjectType ObjectType
+	Path       string
+}
+
 type Config struct {
 	ObjectType ObjectType
 	ImportFlag ImportStatementType
+	Inputs     []ObjectInput
+	Live       *LiveConfig
+}
+
+// objectInputsFlag is a repeatable flag in the format of <object_type>:<path>.
+type objectInputsFlag []ObjectInput
+
+func (f *objectInputsFlag) String() string {
+	return collections.JoinStrings(collections.Map(*f, func(input ObjectInput) string {
+		return fmt.Sprintf("%s:%s", input.ObjectType, input.Path)
+	}), ",")
+}
+
+func (f *objectInputsFlag) Set(value string) error {
+	objectTypeString, path, found := strings.Cut(value, ":")
+	if !found || path == "" {
+		return fmt.Errorf("expected input in the format of <object_type>:<path>, got: %s", value)
+	}
+	objectType, err := ToObjectType(objectTypeString)
+	if err != nil {
+		return err
+	}
+	*f = append(*f, ObjectInput{ObjectType: objectType, Path: path})
+	return nil
 }
 
 type Program struct {
@@ -83,14 +142,17 @@ type Program struct {
 	StdOut, StdErr io.Writer
 	StdIn          io.Reader
 	Config         *Config
+	// NewSnowflakeClient is used in the live mode to connect to Snowflake; NewSnowflakeClientFromProfile is used if not set.
+	NewSnowflakeClient func(profile string) (SnowflakeClient, error)
 }
 
 func NewDefaultProgram() *Program {
 	return &Program{
-		Args:   os.Args,
-		StdOut: os.Stdout,
-		StdErr: os.Stderr,
-		StdIn:  os.Stdin,
+		Args:               os.Args,
+		StdOut:             os.Stdout,
+		StdErr:             os.Stderr,
+		StdIn:              os.Stdin,
+		NewSnowflakeClient: NewSnowflakeClientFromProfile,
 	}
 }
 
@@ -106,13 +168,16 @@ func (p *Program) Run() ExitCode {
 	}
 	p.Config = config
 
-	input, err := readAllAsCsv(p.StdIn)
-	if err != nil {
-		_, _ = fmt.Fprintf(p.StdErr, "Error reading CSV input: %v", err)
-		return ExitCodeFailedCsvInputParsing
+	if p.Config.Live != nil {
+		return p.runLive()
+	}
+
+	generatedResources, exitCode := p.collectAllResources()
+	if exitCode != ExitCodeSuccess {
+		return exitCode
 	}
 
-	output, err := p.generateOutput(input)
+	output, err := GenerateOutput(p.Config, generatedResources)
 	if err != nil {
 		_, _ = fmt.Fprintf(p.StdErr, "Error generating output: %v", err)
 		return ExitCodeFailedGeneratingTerraformOutput
@@ -133,6 +198,8 @@ Any logs or errors are written to STDERR. You should separate outputs from STDOU
 to clearly see in case of any errors or skipped objects (due to, for example, incorrect or unexpected format).
 
 usage: migration_script [-import=<statement|block>] <object_type>
+       migration_script [-import=<statement|block>] -input=<object_type>:<path> [-input=<object_type>:<path> ...]
+       migration_script [-import=<statement|block>] -profile=<profile> [-database=<database>] [-object-types=<object_type>,...] -output-dir=<path>
 
 import optional flag determines the output format for import statements. The possible values are:
 	- "statement" will print appropriate terraform import command at the end of generated content (default) (see https://developer.hashicorp.com/terraform/cli/commands/import)
@@ -142,6 +209,9 @@ Important: The behavior between import blocks embedded into hcl and the terrafor
 - Import Blocks (see https://developer.hashicorp.com/terraform/language/block/import): When using embedded import blocks, the 'terraform apply' command performs two actions: it imports the resource into the state and immediately applies any configuration changes. Consequently, this method should be avoided if your primary goal is to preview changes before they are committed. Furthermore, 'terraform plan' may not provide comprehensive insights at first, as the resources have not yet been formally ingested into the state file during the planning phase.
 - Import Command (see https://developer.hashicorp.com/terraform/cli/commands/import): When using the 'terraform import' command, the import process is decoupled from the plan/apply cycle. Once the command is executed, the resource is immediately added to the state. Subsequent runs of 'terraform plan' will accurately reflect the delta between your configuration and the existing infrastructure. In this workflow, 'terraform apply' does not handle the ingestion; the 'terraform import' command must be executed successfully beforehand.
 
+input optional flag reads the CSV input for the given object type from a file instead of STDIN. It can be specified multiple times to generate resources for different object types at once.
+	When the input flag is used, the object_type positional argument should not be specified, and STDIN is not read.
+
 object_type represents the type of Snowflake object you want to generate terraform resources for.
 	It is a required positional argument and possible values are listed below.
 	A given object_type corresponds to a specific Snowflake output expected as input to the script.
@@ -197,13 +267,95 @@ object_type represents the type of Snowflake object you want to generate terrafo
 				- snowflake_user
 				- snowflake_service_user
 				- snowflake_legacy_service_user
+		- "tables" which expects the DESCRIBE TABLE output extended with the "database_name", "schema_name", and "table_name" columns (and optionally "table_comment") for every described table.
+			Columns of all the tables can be combined into one CSV; they are grouped into tables by the database_name, schema_name, and table_name columns.
+			Only columns of kind COLUMN are mapped; identity, default, collation, comment, and masking policy are supported. Other table properties (e.g., clustering keys, constraints) are not supported.
+			Supported resources:
+				- snowflake_table
+		- "views" which expects input in the form of [SHOW VIEWS](https://docs.snowflake.com/en/sql-reference/sql/show-views) output. Can also be obtained as a converted CSV output from the snowflake_views data source.
+			The view statement is extracted from the text column. Materialized views are not supported.
+			Supported resources:
+				- snowflake_view
+		- "stages" which expects input in the form of [SHOW STAGES](https://docs.snowflake.com/en/sql-reference/sql/show-stages) output.
+			Credentials and encryption are not a part of the output, so they have to be added manually.
+			Supported resources:
+				- snowflake_stage
+		- "file_formats" which expects input in the form of [SHOW FILE FORMATS](https://docs.snowflake.com/en/sql-reference/sql/show-file-formats) output.
+			Supported resources:
+				- snowflake_file_format
+		- "tasks" which expects input in the form of [SHOW TASKS](https://docs.snowflake.com/en/sql-reference/sql/show-tasks) output. Can also be obtained as a converted CSV output from the snowflake_tasks data source.
+			Task parameters are not supported.
+			Supported resources:
+				- snowflake_task
+		- "streams" which expects input in the form of [SHOW STREAMS](https://docs.snowflake.com/en/sql-reference/sql/show-streams) output. Can also be obtained as a converted CSV output from the snowflake_streams data source.
+			Streams are mapped to their respective terraform resources based on the source_type column:
+				- Table -> snowflake_stream_on_table
+				- View -> snowflake_stream_on_view
+				- External Table -> snowflake_stream_on_external_table
+				- Stage -> snowflake_stream_on_directory_table
+			Supported resources:
+				- snowflake_stream_on_table
+				- snowflake_stream_on_view
+				- snowflake_stream_on_external_table
+				- snowflake_stream_on_directory_table
+		- "pipes" which expects input in the form of [SHOW PIPES](https://docs.snowflake.com/en/sql-reference/sql/show-pipes) output.
+			Supported resources:
+				- snowflake_pipe
+		- "functions" which expects input in the form of the INFORMATION_SCHEMA.FUNCTIONS view (see https://docs.snowflake.com/en/sql-reference/info-schema/functions).
+			Only SQL, JavaScript, and Python functions are supported. Python functions require an additional "handler" column (e.g., taken from the DESCRIBE FUNCTION output).
+			Supported resources:
+				- snowflake_function_sql
+				- snowflake_function_javascript
+				- snowflake_function_python
+		- "procedures" which expects input in the form of the INFORMATION_SCHEMA.PROCEDURES view (see https://docs.snowflake.com/en/sql-reference/info-schema/procedures).
+			Only SQL, JavaScript, and Python procedures are supported. Python procedures require an additional "handler" column (e.g., taken from the DESCRIBE PROCEDURE output).
+			Supported resources:
+				- snowflake_procedure_sql
+				- snowflake_procedure_javascript
+				- snowflake_procedure_python
+		- "network_policies" which expects input in the form of [SHOW NETWORK POLICIES](https://docs.snowflake.com/en/sql-reference/sql/show-network-policies) output.
+			To support the lists of IPs and network rules, combine it with the DESCRIBE NETWORK POLICY output, so the CSV header contains the "allowed_ip_list", "blocked_ip_list", "allowed_network_rule_list", and "blocked_network_rule_list" columns.
+			Supported resources:
+				- snowflake_network_policy
+		- "integrations" which expects input in the form of [SHOW INTEGRATIONS](https://docs.snowflake.com/en/sql-reference/sql/show-integrations) output.
+			Currently, only storage integrations are supported. They require the DESCRIBE INTEGRATION output combined with the SHOW INTEGRATIONS output, so the CSV header contains the "storage_provider", "storage_allowed_locations", and other properties columns.
+			Supported resources:
+				- snowflake_storage_integration
+		- "tags" which expects input in the form of [SHOW TAGS](https://docs.snowflake.com/en/sql-reference/sql/show-tags) output. Can also be obtained as a converted CSV output from the snowflake_tags data source.
+			Supported resources:
+				- snowflake_tag
+
+Some of the generated resources are preview features, and they have to be enabled in the provider configuration (see the preview_features_enabled field).
+
+References between the generated resources:
+	When an object references another object generated in the same run (e.g., a schema in a database, or a stream on a table), the literal name is replaced with a reference to the generated resource (e.g., snowflake_database.snowflake_generated_database_DB.name).
+	The generated resources are ordered, so that the referenced resources come first. To resolve references between different object types, use the input flag (or the live mode).
+
+Live mode:
+	When the profile flag is specified, the script connects to Snowflake using the given profile from the TOML config file (the same one as used by the provider, see https://registry.terraform.io/providers/snowflakedb/snowflake/latest/docs#order-precedence),
+	runs the SHOW and DESCRIBE commands itself, and writes the generated resources into the output-dir directory, one file per object type (e.g., <output-dir>/databases/<database>/tables.tf).
+	The existing files are never overwritten.
+	Without the database flag, the account-level objects are generated into <output-dir>/account: warehouses, account_roles, users, network_policies, integrations.
+	With the database flag, the given database and its contents are generated into <output-dir>/databases/<database>: databases, schemas, database_roles, tags, file_formats, stages, tables, views, functions, procedures, streams, pipes, tasks.
+	The object-types flag limits the generated object types to the given ones from the lists above. Grants are not supported in the live mode.
 
 example usage:
 	migration_script -import=block grants < show_grants_output.csv > generated_output.tf
+	migration_script -import=block -input=databases:databases.csv -input=schemas:schemas.csv -input=tables:tables.csv > generated_output.tf
+	migration_script -import=block -profile=default -database=ANALYTICS -output-dir=./generated
 `)
 	}
 
 	// flags
+	var inputs objectInputsFlag
+	commandLine.Var(&inputs, "input", collections.JoinStrings([]string{
+		"Reads the CSV input for the given object type from a file instead of STDIN, in the format of <object_type>:<path>.",
+		"Can be specified multiple times to generate resources for different object types together.",
+	}, "\n"))
+	profileFlag := commandLine.String("profile", "", "Enables the live mode, in which Snowflake is queried directly using the given profile from the TOML config file.")
+	databaseFlag := commandLine.String("database", "", "Live mode only. Generates the given database with its contents instead of the account-level objects.")
+	outputDirFlag := commandLine.String("output-dir", "", "Live mode only. Directory the generated files are written to.")
+	objectTypesFlag := commandLine.String("object-types", "", "Live mode only. Comma-separated list of object types to generate. By default, all the object types supported in the given scope are generated.")
 	importFlagString := commandLine.String("import", "statement", collections.JoinStrings([]string{
 		"Determines the output format for import statements.",
 		"Possible values:",
@@ -216,8 +368,38 @@ example usage:
 		return nil, err
 	}
 
+	importFlagType, err := ToImportStatementType(*importFlagString)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing import flag: %w", err)
+	}
+
 	// positional arguments
 	args := commandLine.Args()
+	if *profileFlag != "" {
+		if len(args) != 0 || len(inputs) > 0 {
+			return nil, fmt.Errorf("object type and input flag cannot be specified together with the profile flag, use -h for help")
+		}
+		liveConfig, err := parseLiveConfig(*profileFlag, *databaseFlag, *outputDirFlag, *objectTypesFlag)
+		if err != nil {
+			return nil, err
+		}
+		return &Config{
+			ImportFlag: importFlagType,
+			Live:       liveConfig,
+		}, nil
+	}
+	if *databaseFlag != "" || *outputDirFlag != "" || *objectTypesFlag != "" {
+		return nil, fmt.Errorf("database, output-dir, and object-types flags can be specified only together with the profile flag, use -h for help")
+	}
+	if len(inputs) > 0 {
+		if len(args) != 0 {
+			return nil, fmt.Errorf("object type cannot be specified together with the input flag, use -h for help")
+		}
+		return &Config{
+			ImportFlag: importFlagType,
+			Inputs:     inputs,
+		}, nil
+	}
 	if len(args) != 1 {
 		return nil, fmt.Errorf("no object type specified, use -h for help")
 	}
@@ -226,17 +408,43 @@ example usage:
 		return nil, fmt.Errorf("error parsing object type: %w", err)
 	}
 
-	importFlagType, err := ToImportStatementType(*importFlagString)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing import flag: %w", err)
-	}
-
 	return &Config{
 		ObjectType: parsedObjectType,
 		ImportFlag: importFlagType,
 	}, nil
 }
 
+func parseLiveConfig(profile string, database string, outputDir string, objectTypes string) (*LiveConfig, error) {
+	if outputDir == "" {
+		return nil, fmt.Errorf("output-dir flag is required in the live mode, use -h for help")
+	}
+	liveConfig := &LiveConfig{
+		Profile:   profile,
+		Database:  database,
+		OutputDir: outputDir,
+	}
+
+	scopeObjectTypes := AccountScopeObjectTypes
+	if liveConfig.IsDatabaseScope() {
+		scopeObjectTypes = DatabaseScopeObjectTypes
+	}
+	if objectTypes == "" {
+		liveConfig.ObjectTypes = scopeObjectTypes
+		return liveConfig, nil
+	}
+	for _, objectTypeString := range strings.Split(objectTypes, ",") {
+		objectType, err := ToObjectType(strings.TrimSpace(objectTypeString))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing object types: %w", err)
+		}
+		if !slices.Contains(scopeObjectTypes, objectType) {
+			return nil, fmt.Errorf("object type %s is not supported in the live mode for the given scope, use -h for help", objectType)
+		}
+		liveConfig.ObjectTypes = append(liveConfig.ObjectTypes, objectType)
+	}
+	return liveConfig, nil
+}
+
 func readAllAsCsv(reader io.Reader) ([][]string, error) {
 	inputBytes, err := io.ReadAll(bufio.NewReader(reader))
 	if err != nil {
@@ -247,23 +455,202 @@ func readAllAsCsv(reader io.Reader) ([][]string, error) {
 	return csvReader.ReadAll()
 }
 
-func (p *Program) generateOutput(input [][]string) (string, error) {
-	switch p.Config.ObjectType {
+// runLive queries Snowflake for all the object types in the given scope and writes the generated resources into a file per object type.
+// Resources of all the object types are collected before writing, so the references between them can be resolved.
+func (p *Program) runLive() ExitCode {
+	liveConfig := p.Config.Live
+	newSnowflakeClient := p.NewSnowflakeClient
+	if newSnowflakeClient == nil {
+		newSnowflakeClient = NewSnowflakeClientFromProfile
+	}
+	client, err := newSnowflakeClient(liveConfig.Profile)
+	if err != nil {
+		_, _ = fmt.Fprintf(p.StdErr, "Error connecting to Snowflake: %v", err)
+		return ExitCodeFailedQueryingSnowflake
+	}
+
+	exporter := newLiveExporter(context.Background(), client, liveConfig)
+	generatedResourcesByType := make(map[ObjectType][]GeneratedResource)
+	allGeneratedResources := make([]GeneratedResource, 0)
+	for _, objectType := range liveConfig.ObjectTypes {
+		input, err := exporter.Export(objectType)
+		if err != nil {
+			_, _ = fmt.Fprintf(p.StdErr, "Error querying Snowflake: %v", err)
+			return ExitCodeFailedQueryingSnowflake
+		}
+		generatedResources, err := collectResources(objectType, input)
+		if err != nil {
+			_, _ = fmt.Fprintf(p.StdErr, "Error generating output: %v", err)
+			return ExitCodeFailedGeneratingTerraformOutput
+		}
+		generatedResourcesByType[objectType] = generatedResources
+		allGeneratedResources = append(allGeneratedResources, generatedResources...)
+	}
+	ResolveReferences(allGeneratedResources)
+
+	outputDir := filepath.Join(liveConfig.OutputDir, filepath.FromSlash(liveConfig.ScopeDirectory()))
+	outputFiles := make([]outputFile, 0)
+	for _, objectType := range liveConfig.ObjectTypes {
+		generatedResources := generatedResourcesByType[objectType]
+		if len(generatedResources) == 0 {
+			continue
+		}
+		output, err := RenderResources(p.Config, OrderResources(generatedResources))
+		if err != nil {
+			_, _ = fmt.Fprintf(p.StdErr, "Error generating output: %v", err)
+			return ExitCodeFailedGeneratingTerraformOutput
+		}
+		outputFiles = append(outputFiles, outputFile{
+			path:           filepath.Join(outputDir, fmt.Sprintf("%s.tf", objectType)),
+			content:        output,
+			objectType:     objectType,
+			resourcesCount: len(generatedResources),
+		})
+	}
+
+	if err := writeNewFiles(outputFiles); err != nil {
+		_, _ = fmt.Fprintf(p.StdErr, "Error writing output: %v", err)
+		return ExitCodeFailedWritingOutput
+	}
+	for _, file := range outputFiles {
+		_, _ = fmt.Fprintf(p.StdOut, "Generated %d %s resources in %s\n", file.resourcesCount, file.objectType, file.path)
+	}
+
+	return ExitCodeSuccess
+}
+
+type outputFile struct {
+	path           string
+	content        string
+	objectType     ObjectType
+	resourcesCount int
+}
+
+// writeNewFiles writes all the files or none of them. It fails before writing anything if any of the files already exists,
+// so no previously generated or edited files are overwritten, and it removes the already written files if a later write fails,
+// so the script can be safely run again.
+func writeNewFiles(files []outputFile) error {
+	for _, file := range files {
+		if _, err := os.Lstat(file.path); err == nil {
+			return fmt.Errorf("file %s already exists", file.path)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	for i, file := range files {
+		if err := writeNewFile(file.path, file.content); err != nil {
+			for _, writtenFile := range files[:i] {
+				err = errors.Join(err, os.Remove(writtenFile.path))
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// writeNewFile writes the content to the file creating the missing directories. It fails if the file already exists.
+func writeNewFile(path string, content string) (err error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, file.Close())
+		if err != nil {
+			err = errors.Join(err, os.Remove(path))
+		}
+	}()
+
+	_, err = file.WriteString(content)
+	return err
+}
+
+// collectAllResources collects the resources from STDIN or, if specified, from all the input files.
+// Resources from all the inputs are gathered together, so the references between them can be resolved.
+func (p *Program) collectAllResources() ([]GeneratedResource, ExitCode) {
+	inputs := p.Config.Inputs
+	if len(inputs) == 0 {
+		inputs = []ObjectInput{{ObjectType: p.Config.ObjectType}}
+	}
+
+	generatedResources := make([]GeneratedResource, 0)
+	for _, objectInput := range inputs {
+		input, err := p.readInput(objectInput)
+		if err != nil {
+			_, _ = fmt.Fprintf(p.StdErr, "Error reading CSV input: %v", err)
+			return nil, ExitCodeFailedCsvInputParsing
+		}
+
+		collected, err := collectResources(objectInput.ObjectType, input)
+		if err != nil {
+			_, _ = fmt.Fprintf(p.StdErr, "Error generating output: %v", err)
+			return nil, ExitCodeFailedGeneratingTerraformOutput
+		}
+		generatedResources = append(generatedResources, collected...)
+	}
+
+	return generatedResources, ExitCodeSuccess
+}
+
+func (p *Program) readInput(objectInput ObjectInput) ([][]string, error) {
+	if objectInput.Path == "" {
+		return readAllAsCsv(p.StdIn)
+	}
+
+	file, err := os.Open(objectInput.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return readAllAsCsv(file)
+}
+
+func collectResources(objectType ObjectType, input [][]string) ([]GeneratedResource, error) {
+	switch objectType {
 	case ObjectTypeGrants:
-		return HandleGrants(p.Config, input)
+		return CollectGrants(input)
 	case ObjectTypeSchemas:
-		return HandleSchemas(p.Config, input)
+		return CollectSchemas(input)
 	case ObjectTypeDatabases:
-		return HandleDatabases(p.Config, input)
+		return CollectDatabases(input)
 	case ObjectTypeWarehouses:
-		return HandleWarehouses(p.Config, input)
+		return CollectWarehouses(input)
 	case ObjectTypeAccountRoles:
-		return HandleAccountRoles(p.Config, input)
+		return CollectAccountRoles(input)
 	case ObjectTypeDatabaseRoles:
-		return HandleDatabaseRoles(p.Config, input)
+		return CollectDatabaseRoles(input)
 	case ObjectTypeUsers:
-		return HandleUsers(p.Config, input)
+		return CollectUsers(input)
+	case ObjectTypeTables:
+		return CollectTables(input)
+	case ObjectTypeViews:
+		return CollectViews(input)
+	case ObjectTypeStages:
+		return CollectStages(input)
+	case ObjectTypeFileFormats:
+		return CollectFileFormats(input)
+	case ObjectTypeTasks:
+		return CollectTasks(input)
+	case ObjectTypeStreams:
+		return CollectStreams(input)
+	case ObjectTypePipes:
+		return CollectPipes(input)
+	case ObjectTypeFunctions:
+		return CollectFunctions(input)
+	case ObjectTypeProcedures:
+		return CollectProcedures(input)
+	case ObjectTypeNetworkPolicies:
+		return CollectNetworkPolicies(input)
+	case ObjectTypeIntegrations:
+		return CollectIntegrations(input)
+	case ObjectTypeTags:
+		return CollectTags(input)
 	default:
-		return "", fmt.Errorf("unsupported object type: %s, run -h to get more information on allowed object types", p.Config.ObjectType)
+		return nil, fmt.Errorf("unsupported object type: %s, run -h to get more information on allowed object types", objectType)
 	}
 }
@@ -2,9 +2,12 @@ package main
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProgram(t *testing.T) {
@@ -28,6 +31,8 @@ Any logs or errors are written to STDERR. You should separate outputs from STDOU
 to clearly see in case of any errors or skipped objects (due to, for example, incorrect or unexpected format).
 
 usage: migration_script [-import=<statement|block>] <object_type>
+       migration_script [-import=<statement|block>] -input=<object_type>:<path> [-input=<object_type>:<path> ...]
+       migration_script [-import=<statement|block>] -profile=<profile> [-database=<database>] [-object-types=<object_type>,...] -output-dir=<path>
 
 import optional flag determines the output format for import statements. The possible values are:
 	- "statement" will print appropriate terraform import command at the end of generated content (default) (see https://developer.hashicorp.com/terraform/cli/commands/import)
@@ -37,6 +42,9 @@ Important: The behavior between import blocks embedded into hcl and the terrafor
 - Import Blocks (see https://developer.hashicorp.com/terraform/language/block/import): When using embedded import blocks, the 'terraform apply' command performs two actions: it imports the resource into the state and immediately applies any configuration changes. Consequently, this method should be avoided if your primary goal is to preview changes before they are committed. Furthermore, 'terraform plan' may not provide comprehensive insights at first, as the resources have not yet been formally ingested into the state file during the planning phase.
 - Import Command (see https://developer.hashicorp.com/terraform/cli/commands/import): When using the 'terraform import' command, the import process is decoupled from the plan/apply cycle. Once the command is executed, the resource is immediately added to the state. Subsequent runs of 'terraform plan' will accurately reflect the delta between your configuration and the existing infrastructure. In this workflow, 'terraform apply' does not handle the ingestion; the 'terraform import' command must be executed successfully beforehand.
 
+input optional flag reads the CSV input for the given object type from a file instead of STDIN. It can be specified multiple times to generate resources for different object types at once.
+	When the input flag is used, the object_type positional argument should not be specified, and STDIN is not read.
+
 object_type represents the type of Snowflake object you want to generate terraform resources for.
 	It is a required positional argument and possible values are listed below.
 	A given object_type corresponds to a specific Snowflake output expected as input to the script.
@@ -92,9 +100,82 @@ object_type represents the type of Snowflake object you want to generate terrafo
 				- snowflake_user
 				- snowflake_service_user
 				- snowflake_legacy_service_user
+		- "tables" which expects the DESCRIBE TABLE output extended with the "database_name", "schema_name", and "table_name" columns (and optionally "table_comment") for every described table.
+			Columns of all the tables can be combined into one CSV; they are grouped into tables by the database_name, schema_name, and table_name columns.
+			Only columns of kind COLUMN are mapped; identity, default, collation, comment, and masking policy are supported. Other table properties (e.g., clustering keys, constraints) are not supported.
+			Supported resources:
+				- snowflake_table
+		- "views" which expects input in the form of [SHOW VIEWS](https://docs.snowflake.com/en/sql-reference/sql/show-views) output. Can also be obtained as a converted CSV output from the snowflake_views data source.
+			The view statement is extracted from the text column. Materialized views are not supported.
+			Supported resources:
+				- snowflake_view
+		- "stages" which expects input in the form of [SHOW STAGES](https://docs.snowflake.com/en/sql-reference/sql/show-stages) output.
+			Credentials and encryption are not a part of the output, so they have to be added manually.
+			Supported resources:
+				- snowflake_stage
+		- "file_formats" which expects input in the form of [SHOW FILE FORMATS](https://docs.snowflake.com/en/sql-reference/sql/show-file-formats) output.
+			Supported resources:
+				- snowflake_file_format
+		- "tasks" which expects input in the form of [SHOW TASKS](https://docs.snowflake.com/en/sql-reference/sql/show-tasks) output. Can also be obtained as a converted CSV output from the snowflake_tasks data source.
+			Task parameters are not supported.
+			Supported resources:
+				- snowflake_task
+		- "streams" which expects input in the form of [SHOW STREAMS](https://docs.snowflake.com/en/sql-reference/sql/show-streams) output. Can also be obtained as a converted CSV output from the snowflake_streams data source.
+			Streams are mapped to their respective terraform resources based on the source_type column:
+				- Table -> snowflake_stream_on_table
+				- View -> snowflake_stream_on_view
+				- External Table -> snowflake_stream_on_external_table
+				- Stage -> snowflake_stream_on_directory_table
+			Supported resources:
+				- snowflake_stream_on_table
+				- snowflake_stream_on_view
+				- snowflake_stream_on_external_table
+				- snowflake_stream_on_directory_table
+		- "pipes" which expects input in the form of [SHOW PIPES](https://docs.snowflake.com/en/sql-reference/sql/show-pipes) output.
+			Supported resources:
+				- snowflake_pipe
+		- "functions" which expects input in the form of the INFORMATION_SCHEMA.FUNCTIONS view (see https://docs.snowflake.com/en/sql-reference/info-schema/functions).
+			Only SQL, JavaScript, and Python functions are supported. Python functions require an additional "handler" column (e.g., taken from the DESCRIBE FUNCTION output).
+			Supported resources:
+				- snowflake_function_sql
+				- snowflake_function_javascript
+				- snowflake_function_python
+		- "procedures" which expects input in the form of the INFORMATION_SCHEMA.PROCEDURES view (see https://docs.snowflake.com/en/sql-reference/info-schema/procedures).
+			Only SQL, JavaScript, and Python procedures are supported. Python procedures require an additional "handler" column (e.g., taken from the DESCRIBE PROCEDURE output).
+			Supported resources:
+				- snowflake_procedure_sql
+				- snowflake_procedure_javascript
+				- snowflake_procedure_python
+		- "network_policies" which expects input in the form of [SHOW NETWORK POLICIES](https://docs.snowflake.com/en/sql-reference/sql/show-network-policies) output.
+			To support the lists of IPs and network rules, combine it with the DESCRIBE NETWORK POLICY output, so the CSV header contains the "allowed_ip_list", "blocked_ip_list", "allowed_network_rule_list", and "blocked_network_rule_list" columns.
+			Supported resources:
+				- snowflake_network_policy
+		- "integrations" which expects input in the form of [SHOW INTEGRATIONS](https://docs.snowflake.com/en/sql-reference/sql/show-integrations) output.
+			Currently, only storage integrations are supported. They require the DESCRIBE INTEGRATION output combined with the SHOW INTEGRATIONS output, so the CSV header contains the "storage_provider", "storage_allowed_locations", and other properties columns.
+			Supported resources:
+				- snowflake_storage_integration
+		- "tags" which expects input in the form of [SHOW TAGS](https://docs.snowflake.com/en/sql-reference/sql/show-tags) output. Can also be obtained as a converted CSV output from the snowflake_tags data source.
+			Supported resources:
+				- snowflake_tag
+
+Some of the generated resources are preview features, and they have to be enabled in the provider configuration (see the preview_features_enabled field).
+
+References between the generated resources:
+	When an object references another object generated in the same run (e.g., a schema in a database, or a stream on a table), the literal name is replaced with a reference to the generated resource (e.g., snowflake_database.snowflake_generated_database_DB.name).
+	The generated resources are ordered, so that the referenced resources come first. To resolve references between different object types, use the input flag (or the live mode).
+
+Live mode:
+	When the profile flag is specified, the script connects to Snowflake using the given profile from the TOML config file (the same one as used by the provider, see https://registry.terraform.io/providers/snowflakedb/snowflake/latest/docs#order-precedence),
+	runs the SHOW and DESCRIBE commands itself, and writes the generated resources into the output-dir directory, one file per object type (e.g., <output-dir>/databases/<database>/tables.tf).
+	The existing files are never overwritten.
+	Without the database flag, the account-level objects are generated into <output-dir>/account: warehouses, account_roles, users, network_policies, integrations.
+	With the database flag, the given database and its contents are generated into <output-dir>/databases/<database>: databases, schemas, database_roles, tags, file_formats, stages, tables, views, functions, procedures, streams, pipes, tasks.
+	The object-types flag limits the generated object types to the given ones from the lists above. Grants are not supported in the live mode.
 
 example usage:
 	migration_script -import=block grants < show_grants_output.csv > generated_output.tf
+	migration_script -import=block -input=databases:databases.csv -input=schemas:schemas.csv -input=tables:tables.csv > generated_output.tf
+	migration_script -import=block -profile=default -database=ANALYTICS -output-dir=./generated
 `,
 		},
 		{
@@ -673,3 +754,211 @@ import {
 		})
 	}
 }
+
+func TestProgram_Inputs(t *testing.T) {
+	writeInput := func(t *testing.T, name string, content string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	databasesInput := writeInput(t, "databases.csv", `"name","kind"
+"DB","STANDARD"`)
+	schemasInput := writeInput(t, "schemas.csv", `"database_name","name"
+"DB","SCHEMA"`)
+	tablesInput := writeInput(t, "tables.csv", `"database_name","schema_name","table_name","name","type","kind","null?","default","primary key","unique key","check","expression","comment","policy name","collation"
+"DB","SCHEMA","TABLE","ID","NUMBER(38,0)","COLUMN","N","","N","N","","","","",""`)
+	missingInput := filepath.Join(t.TempDir(), "missing.csv")
+	streamsInput := writeInput(t, "streams.csv", `"database_name","schema_name","name","table_name","source_type","mode","comment"
+"DB","SCHEMA","STREAM","DB.SCHEMA.TABLE","Table","DEFAULT",""`)
+
+	accountRolesInput := writeInput(t, "account_roles.csv", `"name"
+"ROLE"`)
+	grantsInput := writeInput(t, "grants.csv", `"privilege","granted_on","name","granted_to","grantee_name","grant_option"
+"USAGE","DATABASE","DB","ROLE","ROLE","false"
+"USAGE","SCHEMA","DB.SCHEMA","ROLE","ROLE","false"
+"SELECT","TABLE","DB.SCHEMA.TABLE","ROLE","ROLE","false"`)
+
+	testCases := []struct {
+		name string
+		args []string
+
+		expectedExitCode  ExitCode
+		expectedErrOutput string
+		expectedOutput    string
+	}{
+		{
+			name:              "validation: invalid input format",
+			args:              []string{"cmd", "-input=databases"},
+			expectedErrOutput: "invalid value \"databases\" for flag -input: expected input in the format of <object_type>:<path>, got: databases",
+			expectedExitCode:  ExitCodeFailedInputArgumentParsing,
+		},
+		{
+			name:              "validation: invalid input object type",
+			args:              []string{"cmd", "-input=invalid-object-type:" + databasesInput},
+			expectedErrOutput: "invalid value \"invalid-object-type:" + databasesInput + "\" for flag -input: unsupported object type: invalid-object-type",
+			expectedExitCode:  ExitCodeFailedInputArgumentParsing,
+		},
+		{
+			name:              "validation: input together with object type",
+			args:              []string{"cmd", "-input=databases:" + databasesInput, "databases"},
+			expectedErrOutput: `Error parsing input arguments: object type cannot be specified together with the input flag, use -h for help, run -h to get more information on running the script`,
+			expectedExitCode:  ExitCodeFailedInputArgumentParsing,
+		},
+		{
+			name:              "validation: missing input file",
+			args:              []string{"cmd", "-input=databases:" + missingInput},
+			expectedErrOutput: "Error reading CSV input: open " + missingInput + ": no such file or directory",
+			expectedExitCode:  ExitCodeFailedCsvInputParsing,
+		},
+		{
+			name: "multiple inputs with references",
+			args: []string{"cmd", "-import=block", "-input=streams:" + streamsInput, "-input=tables:" + tablesInput, "-input=schemas:" + schemasInput, "-input=databases:" + databasesInput},
+			expectedOutput: `resource "snowflake_database" "snowflake_generated_database_DB" {
+  name = "DB"
+}
+
+resource "snowflake_schema" "snowflake_generated_schema_DB_SCHEMA" {
+  database = snowflake_database.snowflake_generated_database_DB.name
+  name = "SCHEMA"
+}
+
+resource "snowflake_table" "snowflake_generated_table_DB_SCHEMA_TABLE" {
+  database = snowflake_database.snowflake_generated_database_DB.name
+  schema = snowflake_schema.snowflake_generated_schema_DB_SCHEMA.name
+  name = "TABLE"
+  column {
+    name = "ID"
+    nullable = false
+    type = "NUMBER(38,0)"
+  }
+}
+
+resource "snowflake_stream_on_table" "snowflake_generated_stream_on_table_DB_SCHEMA_STREAM" {
+  database = snowflake_database.snowflake_generated_database_DB.name
+  schema = snowflake_schema.snowflake_generated_schema_DB_SCHEMA.name
+  name = "STREAM"
+  append_only = "false"
+  table = snowflake_table.snowflake_generated_table_DB_SCHEMA_TABLE.fully_qualified_name
+}
+import {
+  to = snowflake_database.snowflake_generated_database_DB
+  id = "\"DB\""
+}
+import {
+  to = snowflake_schema.snowflake_generated_schema_DB_SCHEMA
+  id = "\"DB\".\"SCHEMA\""
+}
+import {
+  to = snowflake_table.snowflake_generated_table_DB_SCHEMA_TABLE
+  id = "DB|SCHEMA|TABLE"
+}
+import {
+  to = snowflake_stream_on_table.snowflake_generated_stream_on_table_DB_SCHEMA_STREAM
+  id = "\"DB\".\"SCHEMA\".\"STREAM\""
+}
+`,
+		},
+		{
+			name: "grants with references",
+			args: []string{"cmd", "-import=block", "-input=grants:" + grantsInput, "-input=account_roles:" + accountRolesInput, "-input=tables:" + tablesInput, "-input=schemas:" + schemasInput, "-input=databases:" + databasesInput},
+			expectedOutput: `resource "snowflake_database" "snowflake_generated_database_DB" {
+  name = "DB"
+}
+
+resource "snowflake_account_role" "snowflake_generated_account_role_ROLE" {
+  name = "ROLE"
+}
+
+resource "snowflake_schema" "snowflake_generated_schema_DB_SCHEMA" {
+  database = snowflake_database.snowflake_generated_database_DB.name
+  name = "SCHEMA"
+}
+
+resource "snowflake_table" "snowflake_generated_table_DB_SCHEMA_TABLE" {
+  database = snowflake_database.snowflake_generated_database_DB.name
+  schema = snowflake_schema.snowflake_generated_schema_DB_SCHEMA.name
+  name = "TABLE"
+  column {
+    name = "ID"
+    nullable = false
+    type = "NUMBER(38,0)"
+  }
+}
+
+resource "snowflake_grant_privileges_to_account_role" "snowflake_generated_grant_on_DATABASE_DB_to_ROLE_without_grant_option" {
+  account_role_name = snowflake_account_role.snowflake_generated_account_role_ROLE.name
+  on_account_object {
+    object_name = snowflake_database.snowflake_generated_database_DB.name
+    object_type = "DATABASE"
+  }
+  privileges = ["USAGE"]
+  with_grant_option = false
+}
+
+resource "snowflake_grant_privileges_to_account_role" "snowflake_generated_grant_on_schema_DB_SCHEMA_to_ROLE_without_grant_option" {
+  account_role_name = snowflake_account_role.snowflake_generated_account_role_ROLE.name
+  on_schema {
+    schema_name = snowflake_schema.snowflake_generated_schema_DB_SCHEMA.fully_qualified_name
+  }
+  privileges = ["USAGE"]
+  with_grant_option = false
+}
+
+resource "snowflake_grant_privileges_to_account_role" "snowflake_generated_grant_on_TABLE_DB_SCHEMA_TABLE_to_ROLE_without_grant_option" {
+  account_role_name = snowflake_account_role.snowflake_generated_account_role_ROLE.name
+  on_schema_object {
+    object_name = snowflake_table.snowflake_generated_table_DB_SCHEMA_TABLE.fully_qualified_name
+    object_type = "TABLE"
+  }
+  privileges = ["SELECT"]
+  with_grant_option = false
+}
+import {
+  to = snowflake_database.snowflake_generated_database_DB
+  id = "\"DB\""
+}
+import {
+  to = snowflake_account_role.snowflake_generated_account_role_ROLE
+  id = "\"ROLE\""
+}
+import {
+  to = snowflake_schema.snowflake_generated_schema_DB_SCHEMA
+  id = "\"DB\".\"SCHEMA\""
+}
+import {
+  to = snowflake_table.snowflake_generated_table_DB_SCHEMA_TABLE
+  id = "DB|SCHEMA|TABLE"
+}
+import {
+  to = snowflake_grant_privileges_to_account_role.snowflake_generated_grant_on_DATABASE_DB_to_ROLE_without_grant_option
+  id = "\"ROLE\"|false|false|USAGE|OnAccountObject|DATABASE|\"DB\""
+}
+import {
+  to = snowflake_grant_privileges_to_account_role.snowflake_generated_grant_on_schema_DB_SCHEMA_to_ROLE_without_grant_option
+  id = "\"ROLE\"|false|false|USAGE|OnSchema|OnSchema|\"DB\".\"SCHEMA\""
+}
+import {
+  to = snowflake_grant_privileges_to_account_role.snowflake_generated_grant_on_TABLE_DB_SCHEMA_TABLE_to_ROLE_without_grant_option
+  id = "\"ROLE\"|false|false|SELECT|OnSchemaObject|OnObject|TABLE|\"DB\".\"SCHEMA\".\"TABLE\""
+}
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			program := Program{
+				Args:   tc.args,
+				StdOut: bytes.NewBuffer(nil),
+				StdErr: bytes.NewBuffer(nil),
+				StdIn:  bytes.NewBuffer(nil),
+			}
+
+			assert.Equal(t, tc.expectedExitCode, program.Run())
+			assert.Equal(t, tc.expectedOutput, program.StdOut.(*bytes.Buffer).String())
+			assert.Contains(t, program.StdErr.(*bytes.Buffer).String(), tc.expectedErrOutput)
+		})
+	}
+}
@@ -18,6 +18,7 @@ var (
 	_ validatable = new(AlterAlertOptions)
 	_ validatable = new(DropAlertOptions)
 	_ validatable = new(ShowAlertOptions)
+	_ validatable = new(executeAlertOptions)
 
 	_ convertibleRow[Alert] = new(alertDBRow)
 )
@@ -31,6 +32,8 @@ type Alerts interface {
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Alert, error)
 	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*Alert, error)
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*AlertDetails, error)
+	Execute(ctx context.Context, id SchemaObjectIdentifier) error
+	ShowParameters(ctx context.Context, id SchemaObjectIdentifier) ([]*Parameter, error)
 }
 
 type alerts struct {
@@ -45,9 +48,10 @@ type CreateAlertOptions struct {
 	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
 	name        SchemaObjectIdentifier `ddl:"identifier"`
 
-	// required
-	warehouse AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
-	schedule  string                  `ddl:"parameter,single_quotes" sql:"SCHEDULE"`
+	// optional; serverless compute is used when the warehouse is not specified
+	warehouse *AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	// optional; the alert is triggered on new data when the schedule is not specified
+	schedule *string `ddl:"parameter,single_quotes" sql:"SCHEDULE"`
 
 	// optional
 	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
@@ -65,20 +69,29 @@ func (opts *CreateAlertOptions) validate() error {
 	if opts == nil {
 		return errors.Join(ErrNilOptions)
 	}
+	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
-		return errors.Join(ErrInvalidObjectIdentifier)
+		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	return nil
+	if opts.warehouse != nil && !ValidObjectIdentifier(opts.warehouse) {
+		errs = append(errs, errInvalidIdentifier("CreateAlertOptions", "warehouse"))
+	}
+	return errors.Join(errs...)
 }
 
+// Create creates an alert. The warehouse and schedule are optional: when the warehouse is empty, the alert uses serverless compute,
+// and when the schedule is empty, the alert is triggered when new rows are added to the tables referenced in the condition.
 func (v *alerts) Create(ctx context.Context, id SchemaObjectIdentifier, warehouse AccountObjectIdentifier, schedule string, condition string, action string, opts *CreateAlertOptions) error {
 	if opts == nil {
 		opts = &CreateAlertOptions{}
 	}
 	opts.name = id
-	opts.warehouse = warehouse
-	opts.schedule = schedule
-	opts.name = id
+	if warehouse.Name() != "" {
+		opts.warehouse = &warehouse
+	}
+	if schedule != "" {
+		opts.schedule = &schedule
+	}
 	opts.condition = []AlertCondition{{Condition: []string{condition}}}
 	opts.action = action
 	if err := opts.validate(); err != nil {
@@ -118,7 +131,7 @@ type AlterAlertOptions struct {
 	// One of
 	Action          *AlertAction `ddl:"keyword"`
 	Set             *AlertSet    `ddl:"keyword" sql:"SET"`
-	Unset           *AlertUnset  `ddl:"keyword" sql:"UNSET"`
+	Unset           *AlertUnset  `ddl:"list,no_parentheses" sql:"UNSET"`
 	ModifyCondition *[]string    `ddl:"keyword,parentheses,no_comma" sql:"MODIFY CONDITION EXISTS"`
 	ModifyAction    *string      `ddl:"parameter,no_equals" sql:"MODIFY ACTION"`
 }
@@ -141,12 +154,21 @@ type AlertSet struct {
 	Warehouse *AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
 	Schedule  *string                  `ddl:"parameter,single_quotes" sql:"SCHEDULE"`
 	Comment   *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+
+	// Parameters
+	LogLevel   *LogLevel   `ddl:"parameter,single_quotes" sql:"LOG_LEVEL"`
+	TraceLevel *TraceLevel `ddl:"parameter,single_quotes" sql:"TRACE_LEVEL"`
 }
 
+// AlertUnset is rendered as a comma-separated list, e.g. UNSET WAREHOUSE, SCHEDULE.
 type AlertUnset struct {
 	Warehouse *bool `ddl:"keyword" sql:"WAREHOUSE"`
 	Schedule  *bool `ddl:"keyword" sql:"SCHEDULE"`
 	Comment   *bool `ddl:"keyword" sql:"COMMENT"`
+
+	// Parameters
+	LogLevel   *bool `ddl:"keyword" sql:"LOG_LEVEL"`
+	TraceLevel *bool `ddl:"keyword" sql:"TRACE_LEVEL"`
 }
 
 func (v *alerts) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterAlertOptions) error {
@@ -203,6 +225,14 @@ func (v *alerts) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *Drop
 	return err
 }
 
+func (v *alerts) ShowParameters(ctx context.Context, id SchemaObjectIdentifier) ([]*Parameter, error) {
+	return v.client.Parameters.ShowParameters(ctx, &ShowParametersOptions{
+		In: &ParametersIn{
+			Alert: id,
+		},
+	})
+}
+
 func (v *alerts) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
 	return SafeDrop(v.client, func() error { return v.Drop(ctx, id, &DropAlertOptions{IfExists: Bool(true)}) }, ctx, id)
 }
@@ -228,6 +258,16 @@ func (v *Alert) ObjectType() ObjectType {
 	return ObjectTypeAlert
 }
 
+// IsServerless returns true if the alert does not use a warehouse.
+func (v *Alert) IsServerless() bool {
+	return v.Warehouse == ""
+}
+
+// IsTriggeredOnNewData returns true if the alert does not have a schedule and is triggered when new data arrives.
+func (v *Alert) IsTriggeredOnNewData() bool {
+	return v.Schedule == ""
+}
+
 type Alert struct {
 	CreatedOn     time.Time
 	Name          string
@@ -250,8 +290,8 @@ type alertDBRow struct {
 	SchemaName    string         `db:"schema_name"`
 	Owner         string         `db:"owner"`
 	Comment       *string        `db:"comment"`
-	Warehouse     string         `db:"warehouse"`
-	Schedule      string         `db:"schedule"`
+	Warehouse     sql.NullString `db:"warehouse"`
+	Schedule      sql.NullString `db:"schedule"`
 	State         string         `db:"state"` // suspended, started
 	Condition     string         `db:"condition"`
 	Action        string         `db:"action"`
@@ -266,8 +306,8 @@ func (row alertDBRow) convert() (*Alert, error) {
 		SchemaName:   row.SchemaName,
 		Owner:        row.Owner,
 		Comment:      row.Comment,
-		Warehouse:    row.Warehouse,
-		Schedule:     row.Schedule,
+		Warehouse:    row.Warehouse.String,
+		Schedule:     row.Schedule.String,
 		// TODO [SNOW-3108659]: use enum mapping instead
 		State:     AlertState(row.State),
 		Condition: row.Condition,
@@ -357,8 +397,8 @@ func (row alertDBRow) toAlertDetails() (*AlertDetails, error) {
 		SchemaName:   row.SchemaName,
 		Owner:        row.Owner,
 		Comment:      row.Comment,
-		Warehouse:    row.Warehouse,
-		Schedule:     row.Schedule,
+		Warehouse:    row.Warehouse.String,
+		Schedule:     row.Schedule.String,
 		State:        row.State,
 		Condition:    row.Condition,
 		Action:       row.Action,
@@ -387,3 +427,27 @@ func (v *alerts) Describe(ctx context.Context, id SchemaObjectIdentifier) (*Aler
 
 	return dest.toAlertDetails()
 }
+
+// executeAlertOptions is based on https://docs.snowflake.com/en/sql-reference/sql/execute-alert.
+type executeAlertOptions struct {
+	execute bool                   `ddl:"static" sql:"EXECUTE"`
+	alert   bool                   `ddl:"static" sql:"ALERT"`
+	name    SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *executeAlertOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+func (v *alerts) Execute(ctx context.Context, id SchemaObjectIdentifier) error {
+	opts := &executeAlertOptions{
+		name: id,
+	}
+	return validateAndExec(v.client, ctx, opts)
+}
@@ -19,8 +19,8 @@ func TestAlertCreate(t *testing.T) {
 
 		opts := &CreateAlertOptions{
 			name:      id,
-			warehouse: warehouse,
-			schedule:  schedule,
+			warehouse: &warehouse,
+			schedule:  &schedule,
 			condition: []AlertCondition{condition},
 			action:    action,
 			Comment:   String(newComment),
@@ -28,6 +28,30 @@ func TestAlertCreate(t *testing.T) {
 
 		assertOptsValidAndSQLEquals(t, opts, `CREATE ALERT %s WAREHOUSE = "%s" SCHEDULE = '%s' COMMENT = '%s' IF (EXISTS (%s)) THEN %s`, id.FullyQualifiedName(), warehouse.name, schedule, newComment, existsCondition, action)
 	})
+
+	t.Run("serverless alert on new data", func(t *testing.T) {
+		existsCondition := "SELECT 1"
+		action := "INSERT INTO FOO VALUES (1)"
+
+		opts := &CreateAlertOptions{
+			name:      id,
+			condition: []AlertCondition{{[]string{existsCondition}}},
+			action:    action,
+		}
+
+		assertOptsValidAndSQLEquals(t, opts, `CREATE ALERT %s IF (EXISTS (%s)) THEN %s`, id.FullyQualifiedName(), existsCondition, action)
+	})
+
+	t.Run("validation: invalid warehouse", func(t *testing.T) {
+		opts := &CreateAlertOptions{
+			name:      id,
+			warehouse: &AccountObjectIdentifier{},
+			condition: []AlertCondition{{[]string{"SELECT 1"}}},
+			action:    "SELECT 1",
+		}
+
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("CreateAlertOptions", "warehouse"))
+	})
 }
 
 func TestAlertAlter(t *testing.T) {
@@ -93,6 +117,42 @@ func TestAlertAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER ALERT %s UNSET COMMENT", id.FullyQualifiedName())
 	})
 
+	t.Run("with unset warehouse and schedule", func(t *testing.T) {
+		opts := &AlterAlertOptions{
+			name: id,
+			Unset: &AlertUnset{
+				Warehouse: Bool(true),
+				Schedule:  Bool(true),
+			},
+		}
+
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ALERT %s UNSET WAREHOUSE, SCHEDULE", id.FullyQualifiedName())
+	})
+
+	t.Run("with set parameters", func(t *testing.T) {
+		opts := &AlterAlertOptions{
+			name: id,
+			Set: &AlertSet{
+				LogLevel:   Pointer(LogLevelInfo),
+				TraceLevel: Pointer(TraceLevelAlways),
+			},
+		}
+
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ALERT %s SET LOG_LEVEL = 'INFO' TRACE_LEVEL = 'ALWAYS'", id.FullyQualifiedName())
+	})
+
+	t.Run("with unset parameters", func(t *testing.T) {
+		opts := &AlterAlertOptions{
+			name: id,
+			Unset: &AlertUnset{
+				LogLevel:   Bool(true),
+				TraceLevel: Bool(true),
+			},
+		}
+
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ALERT %s UNSET LOG_LEVEL, TRACE_LEVEL", id.FullyQualifiedName())
+	})
+
 	t.Run("with modify condition", func(t *testing.T) {
 		modifyCondition := "SELECT * FROM FOO"
 		opts := &AlterAlertOptions{
@@ -226,3 +286,19 @@ func TestAlertDescribe(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE ALERT %s", id.FullyQualifiedName())
 	})
 }
+
+func TestAlertExecute(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	t.Run("empty options", func(t *testing.T) {
+		opts := &executeAlertOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &executeAlertOptions{
+			name: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "EXECUTE ALERT %s", id.FullyQualifiedName())
+	})
+}
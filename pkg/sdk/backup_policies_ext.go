@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateBackupPolicyRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateBackupSetRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
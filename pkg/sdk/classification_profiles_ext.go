@@ -0,0 +1,128 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+func (r *CreateClassificationProfileRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+// ClassificationProfileConfig is a typed representation of the OBJECT constant passed to CREATE SNOWFLAKE.DATA_PRIVACY.CLASSIFICATION_PROFILE.
+// Read more in https://docs.snowflake.com/en/sql-reference/classes/classification_profile/commands/create-classification-profile.
+type ClassificationProfileConfig struct {
+	MinimumObjectAgeForClassificationDays *int
+	MaximumClassificationValidityDays     *int
+	AutoTag                               *bool
+	TagMap                                *ClassificationProfileTagMap
+	CustomClassifiers                     []ClassificationProfileCustomClassifier
+}
+
+type ClassificationProfileTagMap struct {
+	ColumnTagMap []ClassificationProfileColumnTagMapEntry
+}
+
+type ClassificationProfileColumnTagMapEntry struct {
+	TagName            SchemaObjectIdentifier
+	TagValue           *string
+	SemanticCategories []string
+}
+
+type ClassificationProfileCustomClassifier struct {
+	Label      string
+	Classifier SchemaObjectIdentifier
+}
+
+// NewCreateClassificationProfileRequestWithConfig is a convenience constructor rendering the typed config into the OBJECT constant.
+func NewCreateClassificationProfileRequestWithConfig(name SchemaObjectIdentifier, config ClassificationProfileConfig) *CreateClassificationProfileRequest {
+	return NewCreateClassificationProfileRequest(name, *NewClassificationProfileConfigObjectRequest(config.ToSql()))
+}
+
+func (c ClassificationProfileConfig) ToSql() string {
+	parts := make([]string, 0)
+	if c.MinimumObjectAgeForClassificationDays != nil {
+		parts = append(parts, fmt.Sprintf("'minimum_object_age_for_classification_days': %d", *c.MinimumObjectAgeForClassificationDays))
+	}
+	if c.MaximumClassificationValidityDays != nil {
+		parts = append(parts, fmt.Sprintf("'maximum_classification_validity_days': %d", *c.MaximumClassificationValidityDays))
+	}
+	if c.AutoTag != nil {
+		parts = append(parts, fmt.Sprintf("'auto_tag': %t", *c.AutoTag))
+	}
+	if c.TagMap != nil {
+		parts = append(parts, fmt.Sprintf("'tag_map': %s", c.TagMap.ToSql()))
+	}
+	if len(c.CustomClassifiers) > 0 {
+		parts = append(parts, fmt.Sprintf("'custom_classifiers': %s", ClassificationProfileCustomClassifiersToSql(c.CustomClassifiers)))
+	}
+	return fmt.Sprintf("{%s}", strings.Join(parts, ", "))
+}
+
+func (m ClassificationProfileTagMap) ToSql() string {
+	entries := collections.Map(m.ColumnTagMap, func(e ClassificationProfileColumnTagMapEntry) string { return e.toSql() })
+	return fmt.Sprintf("{'column_tag_map': [%s]}", strings.Join(entries, ", "))
+}
+
+func (e ClassificationProfileColumnTagMapEntry) toSql() string {
+	parts := []string{fmt.Sprintf("'tag_name': '%s'", escapeSingleQuotes(e.TagName.FullyQualifiedName()))}
+	if e.TagValue != nil {
+		parts = append(parts, fmt.Sprintf("'tag_value': '%s'", escapeSingleQuotes(*e.TagValue)))
+	}
+	if len(e.SemanticCategories) > 0 {
+		categories := collections.Map(e.SemanticCategories, func(c string) string { return fmt.Sprintf("'%s'", escapeSingleQuotes(c)) })
+		parts = append(parts, fmt.Sprintf("'semantic_categories': [%s]", strings.Join(categories, ", ")))
+	}
+	return fmt.Sprintf("{%s}", strings.Join(parts, ", "))
+}
+
+// ClassificationProfileCustomClassifiersToSql renders the custom classifiers as an OBJECT constant mapping labels to the classifier instances' LIST() method.
+func ClassificationProfileCustomClassifiersToSql(classifiers []ClassificationProfileCustomClassifier) string {
+	entries := collections.Map(classifiers, func(c ClassificationProfileCustomClassifier) string {
+		return fmt.Sprintf("'%s': %s!LIST()", escapeSingleQuotes(c.Label), c.Classifier.FullyQualifiedName())
+	})
+	return fmt.Sprintf("{%s}", strings.Join(entries, ", "))
+}
+
+func escapeSingleQuotes(s string) string {
+	return strings.ReplaceAll(s, "'", "\\'")
+}
+
+// ClassificationProfileDetails is the parsed output of the DESCRIBE instance method.
+type ClassificationProfileDetails struct {
+	MinimumObjectAgeForClassificationDays *int                                `json:"minimum_object_age_for_classification_days,omitempty"`
+	MaximumClassificationValidityDays     *int                                `json:"maximum_classification_validity_days,omitempty"`
+	AutoTag                               *bool                               `json:"auto_tag,omitempty"`
+	TagMap                                *ClassificationProfileDetailsTagMap `json:"tag_map,omitempty"`
+}
+
+type ClassificationProfileDetailsTagMap struct {
+	ColumnTagMap []ClassificationProfileDetailsColumnTagMapEntry `json:"column_tag_map"`
+}
+
+type ClassificationProfileDetailsColumnTagMapEntry struct {
+	TagName            string   `json:"tag_name"`
+	TagValue           *string  `json:"tag_value,omitempty"`
+	SemanticCategories []string `json:"semantic_categories,omitempty"`
+}
+
+func (v *classificationProfiles) DescribeDetails(ctx context.Context, id SchemaObjectIdentifier) (*ClassificationProfileDetails, error) {
+	raw, err := v.Describe(ctx, NewDescribeClassificationProfileRequest(id))
+	if err != nil {
+		return nil, err
+	}
+	return ParseClassificationProfileDetails(*raw)
+}
+
+// ParseClassificationProfileDetails parses the JSON returned by the DESCRIBE instance method.
+func ParseClassificationProfileDetails(raw string) (*ClassificationProfileDetails, error) {
+	var details ClassificationProfileDetails
+	if err := json.Unmarshal([]byte(raw), &details); err != nil {
+		return nil, fmt.Errorf("unable to parse classification profile details: %w", err)
+	}
+	return &details, nil
+}
@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassificationProfileConfig_ToSql(t *testing.T) {
+	tagId := NewSchemaObjectIdentifier("db", "sch", "tag")
+	classifierId := NewSchemaObjectIdentifier("db", "sch", "cls")
+
+	testCases := []struct {
+		name     string
+		config   ClassificationProfileConfig
+		expected string
+	}{
+		{
+			name:     "empty",
+			config:   ClassificationProfileConfig{},
+			expected: "{}",
+		},
+		{
+			name: "scalar fields",
+			config: ClassificationProfileConfig{
+				MinimumObjectAgeForClassificationDays: Int(0),
+				MaximumClassificationValidityDays:     Int(30),
+				AutoTag:                               Bool(true),
+			},
+			expected: "{'minimum_object_age_for_classification_days': 0, 'maximum_classification_validity_days': 30, 'auto_tag': true}",
+		},
+		{
+			name: "tag map and custom classifiers",
+			config: ClassificationProfileConfig{
+				TagMap: &ClassificationProfileTagMap{
+					ColumnTagMap: []ClassificationProfileColumnTagMapEntry{
+						{TagName: tagId, TagValue: String("it's pii"), SemanticCategories: []string{"NAME", "EMAIL"}},
+						{TagName: tagId},
+					},
+				},
+				CustomClassifiers: []ClassificationProfileCustomClassifier{
+					{Label: "codes", Classifier: classifierId},
+				},
+			},
+			expected: `{'tag_map': {'column_tag_map': [{'tag_name': '"db"."sch"."tag"', 'tag_value': 'it\'s pii', 'semantic_categories': ['NAME', 'EMAIL']}, {'tag_name': '"db"."sch"."tag"'}]}, 'custom_classifiers': {'codes': "db"."sch"."cls"!LIST()}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.config.ToSql())
+		})
+	}
+}
+
+func TestParseClassificationProfileDetails(t *testing.T) {
+	t.Run("all fields present", func(t *testing.T) {
+		details, err := ParseClassificationProfileDetails(`{"auto_tag":true,"maximum_classification_validity_days":30,"minimum_object_age_for_classification_days":0,"tag_map":{"column_tag_map":[{"tag_name":"DB.SCH.TAG","tag_value":"pii","semantic_categories":["NAME"]}]}}`)
+		require.NoError(t, err)
+		require.Equal(t, &ClassificationProfileDetails{
+			MinimumObjectAgeForClassificationDays: Int(0),
+			MaximumClassificationValidityDays:     Int(30),
+			AutoTag:                               Bool(true),
+			TagMap: &ClassificationProfileDetailsTagMap{
+				ColumnTagMap: []ClassificationProfileDetailsColumnTagMapEntry{
+					{TagName: "DB.SCH.TAG", TagValue: String("pii"), SemanticCategories: []string{"NAME"}},
+				},
+			},
+		}, details)
+	})
+
+	t.Run("empty object", func(t *testing.T) {
+		details, err := ParseClassificationProfileDetails(`{}`)
+		require.NoError(t, err)
+		require.Equal(t, &ClassificationProfileDetails{}, details)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := ParseClassificationProfileDetails(`{"broken"`)
+		require.ErrorContains(t, err, "unable to parse classification profile details")
+	})
+}
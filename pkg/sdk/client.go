@@ -3,6 +3,7 @@ package sdk
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"log"
 
@@ -24,14 +25,18 @@ type Client struct {
 
 	// DDL Commands
 	Accounts                     Accounts
+	AggregationPolicies          AggregationPolicies
 	Alerts                       Alerts
 	ApiIntegrations              ApiIntegrations
 	ApplicationPackages          ApplicationPackages
 	ApplicationRoles             ApplicationRoles
 	Applications                 Applications
 	AuthenticationPolicies       AuthenticationPolicies
+	BackupPolicies               BackupPolicies
+	BackupSets                   BackupSets
 	Budgets                      Budgets
 	CatalogIntegrations          CatalogIntegrations
+	ClassificationProfiles       ClassificationProfiles
 	Comments                     Comments
 	ComputePools                 ComputePools
 	Connections                  Connections
@@ -40,7 +45,9 @@ type Client struct {
 	DatabaseRoles                DatabaseRoles
 	Databases                    Databases
 	DataMetricFunctionReferences DataMetricFunctionReferences
+	DbtProjects                  DbtProjects
 	DynamicTables                DynamicTables
+	ExternalAccessIntegrations   ExternalAccessIntegrations
 	ExternalFunctions            ExternalFunctions
 	ExternalVolumes              ExternalVolumes
 	ExternalTables               ExternalTables
@@ -52,6 +59,7 @@ type Client struct {
 	Grants                       Grants
 	HybridTables                 HybridTables
 	ImageRepositories            ImageRepositories
+	JoinPolicies                 JoinPolicies
 	Listings                     Listings
 	ManagedAccounts              ManagedAccounts
 	MaskingPolicies              MaskingPolicies
@@ -65,11 +73,14 @@ type Client struct {
 	OpenflowRuntimes             OpenflowRuntimes
 	OrganizationAccounts         OrganizationAccounts
 	Parameters                   Parameters
+	PackagesPolicies             PackagesPolicies
 	PasswordPolicies             PasswordPolicies
 	Pipes                        Pipes
 	PolicyReferences             PolicyReferences
 	PostgresInstances            PostgresInstances
+	PrivacyPolicies              PrivacyPolicies
 	Procedures                   Procedures
+	ProjectionPolicies           ProjectionPolicies
 	ResourceMonitors             ResourceMonitors
 	Roles                        Roles
 	RowAccessPolicies            RowAccessPolicies
@@ -82,8 +93,10 @@ type Client struct {
 	SessionPolicies              SessionPolicies
 	Sessions                     Sessions
 	Shares                       Shares
+	Snapshots                    Snapshots
 	Stages                       Stages
 	StorageIntegrations          StorageIntegrations
+	StorageLifecyclePolicies     StorageLifecyclePolicies
 	Streamlits                   Streamlits
 	Streams                      Streams
 	Tables                       Tables
@@ -134,6 +147,20 @@ func NewClient(cfg *gosnowflake.Config, opts ...func(*FileReaderConfig)) (*Clien
 		return nil, fmt.Errorf("open snowflake connection: %w", err)
 	}
 
+	return newClient(cfg, db)
+}
+
+// NewClientWithConnector creates a client that talks to Snowflake through the given connector instead of the default driver.
+// It allows wrapping the driver, e.g., to record or replay the SQL traffic in tests.
+func NewClientWithConnector(cfg *gosnowflake.Config, connector driver.Connector) (*Client, error) {
+	db := sqlx.NewDb(sql.OpenDB(connector), "snowflake")
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("open snowflake connection: %w", err)
+	}
+	return newClient(cfg, db)
+}
+
+func newClient(cfg *gosnowflake.Config, db *sqlx.DB) (*Client, error) {
 	client := &Client{
 		// snowflake does not adhere to the normal sql driver interface, so we have to use unsafe
 		db:     db.Unsafe(),
@@ -141,7 +168,7 @@ func NewClient(cfg *gosnowflake.Config, opts ...func(*FileReaderConfig)) (*Clien
 	}
 	client.initialize()
 
-	err = client.Ping()
+	err := client.Ping()
 	if err != nil {
 		return nil, fmt.Errorf("ping snowflake: %w", err)
 	}
@@ -164,14 +191,18 @@ func NewClient(cfg *gosnowflake.Config, opts ...func(*FileReaderConfig)) (*Clien
 
 func (c *Client) initialize() {
 	c.Accounts = &accounts{client: c}
+	c.AggregationPolicies = &aggregationPolicies{client: c}
 	c.Alerts = &alerts{client: c}
 	c.ApiIntegrations = &apiIntegrations{client: c}
 	c.ApplicationPackages = &applicationPackages{client: c}
 	c.ApplicationRoles = &applicationRoles{client: c}
 	c.Applications = &applications{client: c}
 	c.AuthenticationPolicies = &authenticationPolicies{client: c}
+	c.BackupPolicies = &backupPolicies{client: c}
+	c.BackupSets = &backupSets{client: c}
 	c.Budgets = &budgets{client: c}
 	c.CatalogIntegrations = &catalogIntegrations{client: c}
+	c.ClassificationProfiles = &classificationProfiles{client: c}
 	c.Comments = &comments{client: c}
 	c.ComputePools = &computePools{client: c}
 	c.Connections = &connections{client: c}
@@ -181,7 +212,9 @@ func (c *Client) initialize() {
 	c.DatabaseRoles = &databaseRoles{client: c}
 	c.Databases = &databases{client: c}
 	c.DataMetricFunctionReferences = &dataMetricFunctionReferences{client: c}
+	c.DbtProjects = &dbtProjects{client: c}
 	c.DynamicTables = &dynamicTables{client: c}
+	c.ExternalAccessIntegrations = &externalAccessIntegrations{client: c}
 	c.ExternalFunctions = &externalFunctions{client: c}
 	c.ExternalVolumes = &externalVolumes{client: c}
 	c.ExternalTables = &externalTables{client: c}
@@ -193,6 +226,7 @@ func (c *Client) initialize() {
 	c.Grants = &grants{client: c}
 	c.HybridTables = &hybridTables{client: c}
 	c.ImageRepositories = &imageRepositories{client: c}
+	c.JoinPolicies = &joinPolicies{client: c}
 	c.Listings = &listings{client: c}
 	c.ManagedAccounts = &managedAccounts{client: c}
 	c.MaskingPolicies = &maskingPolicies{client: c}
@@ -206,11 +240,14 @@ func (c *Client) initialize() {
 	c.OpenflowRuntimes = &openflowRuntimes{client: c}
 	c.OrganizationAccounts = &organizationAccounts{client: c}
 	c.Parameters = &parameters{client: c}
+	c.PackagesPolicies = &packagesPolicies{client: c}
 	c.PasswordPolicies = &passwordPolicies{client: c}
 	c.Pipes = &pipes{client: c}
 	c.PolicyReferences = &policyReference{client: c}
 	c.PostgresInstances = &postgresInstances{client: c}
+	c.PrivacyPolicies = &privacyPolicies{client: c}
 	c.Procedures = &procedures{client: c}
+	c.ProjectionPolicies = &projectionPolicies{client: c}
 	c.ReplicationFunctions = &replicationFunctions{client: c}
 	c.ResourceMonitors = &resourceMonitors{client: c}
 	c.Roles = &roles{client: c}
@@ -224,8 +261,10 @@ func (c *Client) initialize() {
 	c.SessionPolicies = &sessionPolicies{client: c}
 	c.Sessions = &sessions{client: c}
 	c.Shares = &shares{client: c}
+	c.Snapshots = &snapshots{client: c}
 	c.Stages = &stages{client: c}
 	c.StorageIntegrations = &storageIntegrations{client: c}
+	c.StorageLifecyclePolicies = &storageLifecyclePolicies{client: c}
 	c.Streamlits = &streamlits{client: c}
 	c.Streams = &streams{client: c}
 	c.SystemFunctions = &systemFunctions{client: c}
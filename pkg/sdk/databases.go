@@ -499,7 +499,8 @@ type DatabaseSet struct {
 	QuotedIdentifiersIgnoreCase             *bool                       `ddl:"parameter" sql:"QUOTED_IDENTIFIERS_IGNORE_CASE"`
 	EnableConsoleOutput                     *bool                       `ddl:"parameter" sql:"ENABLE_CONSOLE_OUTPUT"`
 
-	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	ClassificationProfile *SchemaObjectIdentifier `ddl:"identifier,equals,single_quotes" sql:"CLASSIFICATION_PROFILE"`
+	Comment               *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
 func (v *DatabaseSet) validate() error {
@@ -510,6 +511,9 @@ func (v *DatabaseSet) validate() error {
 	if v.Catalog != nil && !ValidObjectIdentifier(v.Catalog) {
 		errs = append(errs, errInvalidIdentifier("DatabaseSet", "Catalog"))
 	}
+	if v.ClassificationProfile != nil && !ValidObjectIdentifier(v.ClassificationProfile) {
+		errs = append(errs, errInvalidIdentifier("DatabaseSet", "ClassificationProfile"))
+	}
 	if !anyValueSet(
 		v.DataRetentionTimeInDays,
 		v.MaxDataExtensionTimeInDays,
@@ -527,6 +531,7 @@ func (v *DatabaseSet) validate() error {
 		v.UserTaskMinimumTriggerIntervalInSeconds,
 		v.QuotedIdentifiersIgnoreCase,
 		v.EnableConsoleOutput,
+		v.ClassificationProfile,
 		v.Comment,
 	) {
 		errs = append(errs, errAtLeastOneOf(
@@ -547,6 +552,7 @@ func (v *DatabaseSet) validate() error {
 			"UserTaskMinimumTriggerIntervalInSeconds",
 			"QuotedIdentifiersIgnoreCase",
 			"EnableConsoleOutput",
+			"ClassificationProfile",
 			"Comment",
 		))
 	}
@@ -572,7 +578,8 @@ type DatabaseUnset struct {
 	QuotedIdentifiersIgnoreCase             *bool `ddl:"keyword" sql:"QUOTED_IDENTIFIERS_IGNORE_CASE"`
 	EnableConsoleOutput                     *bool `ddl:"keyword" sql:"ENABLE_CONSOLE_OUTPUT"`
 
-	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+	ClassificationProfile *bool `ddl:"keyword" sql:"CLASSIFICATION_PROFILE"`
+	Comment               *bool `ddl:"keyword" sql:"COMMENT"`
 }
 
 func (v *DatabaseUnset) validate() error {
@@ -594,6 +601,7 @@ func (v *DatabaseUnset) validate() error {
 		v.UserTaskMinimumTriggerIntervalInSeconds,
 		v.QuotedIdentifiersIgnoreCase,
 		v.EnableConsoleOutput,
+		v.ClassificationProfile,
 		v.Comment,
 	) {
 		errs = append(errs, errAtLeastOneOf(
@@ -614,6 +622,7 @@ func (v *DatabaseUnset) validate() error {
 			"UserTaskMinimumTriggerIntervalInSeconds",
 			"QuotedIdentifiersIgnoreCase",
 			"EnableConsoleOutput",
+			"ClassificationProfile",
 			"Comment",
 		))
 	}
@@ -339,6 +339,7 @@ func TestDatabasesAlter(t *testing.T) {
 			"UserTaskMinimumTriggerIntervalInSeconds",
 			"QuotedIdentifiersIgnoreCase",
 			"EnableConsoleOutput",
+			"ClassificationProfile",
 			"Comment",
 		))
 	})
@@ -364,6 +365,7 @@ func TestDatabasesAlter(t *testing.T) {
 			"UserTaskMinimumTriggerIntervalInSeconds",
 			"QuotedIdentifiersIgnoreCase",
 			"EnableConsoleOutput",
+			"ClassificationProfile",
 			"Comment",
 		))
 	})
@@ -384,6 +386,14 @@ func TestDatabasesAlter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("DatabaseSet", "Catalog"))
 	})
 
+	t.Run("validation: invalid classification profile identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &DatabaseSet{
+			ClassificationProfile: Pointer(emptySchemaObjectIdentifier),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("DatabaseSet", "ClassificationProfile"))
+	})
+
 	t.Run("validation: invalid NewName identifier", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.NewName = Pointer(emptyAccountObjectIdentifier)
@@ -445,6 +455,23 @@ func TestDatabasesAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER DATABASE %s UNSET DATA_RETENTION_TIME_IN_DAYS, MAX_DATA_EXTENSION_TIME_IN_DAYS, EXTERNAL_VOLUME, CATALOG, REPLACE_INVALID_CHARACTERS, DEFAULT_DDL_COLLATION, STORAGE_SERIALIZATION_POLICY, LOG_LEVEL, TRACE_LEVEL, COMMENT`, opts.name.FullyQualifiedName())
 	})
 
+	t.Run("set classification profile", func(t *testing.T) {
+		classificationProfileId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.Set = &DatabaseSet{
+			ClassificationProfile: &classificationProfileId,
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DATABASE %s SET CLASSIFICATION_PROFILE = '\"%s\".\"%s\".\"%s\"'`, opts.name.FullyQualifiedName(), classificationProfileId.DatabaseName(), classificationProfileId.SchemaName(), classificationProfileId.Name())
+	})
+
+	t.Run("unset classification profile", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &DatabaseUnset{
+			ClassificationProfile: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DATABASE %s UNSET CLASSIFICATION_PROFILE`, opts.name.FullyQualifiedName())
+	})
+
 	t.Run("with set tag", func(t *testing.T) {
 		tagId1 := randomSchemaObjectIdentifier()
 		tagId2 := randomSchemaObjectIdentifierInSchema(tagId1.SchemaId())
@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateDbtProjectRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
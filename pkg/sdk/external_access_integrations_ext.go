@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"strconv"
+)
+
+func (d *ExternalAccessIntegrationDetails) ID() AccountObjectIdentifier {
+	return d.Id
+}
+
+func (r *CreateExternalAccessIntegrationRequest) GetName() AccountObjectIdentifier {
+	return r.name
+}
+
+func (v *externalAccessIntegrations) DescribeDetails(ctx context.Context, id AccountObjectIdentifier) (*ExternalAccessIntegrationDetails, error) {
+	properties, err := v.Describe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return parseExternalAccessIntegrationProperties(properties, id)
+}
+
+func parseExternalAccessIntegrationProperties(properties []ExternalAccessIntegrationProperty, id AccountObjectIdentifier) (*ExternalAccessIntegrationDetails, error) {
+	details := &ExternalAccessIntegrationDetails{
+		Id:                                   id,
+		AllowedNetworkRules:                  make([]SchemaObjectIdentifier, 0),
+		AllowedApiAuthenticationIntegrations: make([]AccountObjectIdentifier, 0),
+		AllowedAuthenticationSecrets:         make([]SchemaObjectIdentifier, 0),
+	}
+	var errs []error
+	for _, prop := range properties {
+		switch prop.Name {
+		case "ENABLED":
+			if val, err := strconv.ParseBool(prop.Value); err != nil {
+				errs = append(errs, err)
+			} else {
+				details.Enabled = val
+			}
+		case "ALLOWED_NETWORK_RULES":
+			if val, err := ParseCommaSeparatedSchemaObjectIdentifierArray(prop.Value); err != nil {
+				errs = append(errs, err)
+			} else {
+				details.AllowedNetworkRules = val
+			}
+		case "ALLOWED_API_AUTHENTICATION_INTEGRATIONS":
+			if val, err := ParseCommaSeparatedAccountObjectIdentifierArray(prop.Value); err != nil {
+				errs = append(errs, err)
+			} else {
+				details.AllowedApiAuthenticationIntegrations = val
+			}
+		case "ALLOWED_AUTHENTICATION_SECRETS":
+			if val, err := ParseCommaSeparatedSchemaObjectIdentifierArray(prop.Value); err != nil {
+				errs = append(errs, err)
+			} else {
+				details.AllowedAuthenticationSecrets = val
+			}
+		case "COMMENT":
+			details.Comment = prop.Value
+		}
+	}
+	return details, errors.Join(errs...)
+}
@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseExternalAccessIntegrationProperties(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	t.Run("all properties", func(t *testing.T) {
+		properties := []ExternalAccessIntegrationProperty{
+			{Name: "ENABLED", Type: "Boolean", Value: "true", Default: "false"},
+			{Name: "ALLOWED_NETWORK_RULES", Type: "List", Value: `[DB.SCHEMA.RULE_1, "db"."schema"."rule_2"]`},
+			{Name: "ALLOWED_API_AUTHENTICATION_INTEGRATIONS", Type: "List", Value: "[API_INTEGRATION]"},
+			{Name: "ALLOWED_AUTHENTICATION_SECRETS", Type: "List", Value: "[DB.SCHEMA.SECRET]"},
+			{Name: "COMMENT", Type: "String", Value: "some comment"},
+		}
+
+		details, err := parseExternalAccessIntegrationProperties(properties, id)
+		require.NoError(t, err)
+
+		assert.Equal(t, &ExternalAccessIntegrationDetails{
+			Id:      id,
+			Enabled: true,
+			AllowedNetworkRules: []SchemaObjectIdentifier{
+				NewSchemaObjectIdentifier("DB", "SCHEMA", "RULE_1"),
+				NewSchemaObjectIdentifier("db", "schema", "rule_2"),
+			},
+			AllowedApiAuthenticationIntegrations: []AccountObjectIdentifier{NewAccountObjectIdentifier("API_INTEGRATION")},
+			AllowedAuthenticationSecrets:         []SchemaObjectIdentifier{NewSchemaObjectIdentifier("DB", "SCHEMA", "SECRET")},
+			Comment:                              "some comment",
+		}, details)
+	})
+
+	t.Run("empty lists", func(t *testing.T) {
+		properties := []ExternalAccessIntegrationProperty{
+			{Name: "ENABLED", Type: "Boolean", Value: "false", Default: "false"},
+			{Name: "ALLOWED_NETWORK_RULES", Type: "List", Value: "[DB.SCHEMA.RULE]"},
+			{Name: "ALLOWED_API_AUTHENTICATION_INTEGRATIONS", Type: "List", Value: "[]"},
+			{Name: "ALLOWED_AUTHENTICATION_SECRETS", Type: "List", Value: ""},
+		}
+
+		details, err := parseExternalAccessIntegrationProperties(properties, id)
+		require.NoError(t, err)
+
+		assert.False(t, details.Enabled)
+		assert.Empty(t, details.AllowedApiAuthenticationIntegrations)
+		assert.Empty(t, details.AllowedAuthenticationSecrets)
+		assert.Empty(t, details.Comment)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		properties := []ExternalAccessIntegrationProperty{
+			{Name: "ENABLED", Type: "Boolean", Value: "maybe"},
+			{Name: "ALLOWED_NETWORK_RULES", Type: "List", Value: "[RULE]"},
+		}
+
+		_, err := parseExternalAccessIntegrationProperties(properties, id)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,208 @@
+//go:build exclude
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/genhelpers"
+)
+
+const outputName = "fake_unsupported_generated.go"
+
+// This generator creates the stubs returning ErrFakeUnsupported for all the object interfaces of sdk.Client,
+// so that the fake client (see NewFakeClient) never leaves any of them nil.
+func main() {
+	wd, err := os.Getwd()
+	if err != nil {
+		log.Panicln(err)
+	}
+	fmt.Printf("Running fake client generator in %s\n", wd)
+
+	interfaces, client := parsePackage(wd)
+	fields := clientInterfaceFields(client, interfaces)
+
+	var buffer bytes.Buffer
+	printf(&buffer, "//go:build fake_client_tests\n\n")
+	printf(&buffer, "// Code generated by fake client generator; DO NOT EDIT.\n\n")
+	printf(&buffer, "package %s\n\n", os.Getenv("GOPACKAGE"))
+
+	printf(&buffer, "// setFakeUnsupportedInterfaces sets all the object interfaces of the client to the stubs returning ErrFakeUnsupported.\n")
+	printf(&buffer, "func setFakeUnsupportedInterfaces(client *Client) {\n")
+	for _, field := range fields {
+		printf(&buffer, "client.%s = %s{}\n", field.name, stubName(field.interfaceName))
+	}
+	printf(&buffer, "}\n\n")
+
+	generated := make(map[string]bool)
+	for _, field := range fields {
+		if generated[field.interfaceName] {
+			continue
+		}
+		generated[field.interfaceName] = true
+		writeStub(&buffer, field.interfaceName, methods(interfaces, field.interfaceName))
+	}
+
+	outputPath := filepath.Join(wd, outputName)
+	src, err := genhelpers.AddImports(outputPath, buffer.Bytes())
+	if err != nil {
+		log.Panicln(err)
+	}
+	if err := os.WriteFile(outputPath, src, 0o600); err != nil {
+		log.Panicln(err)
+	}
+	fmt.Printf("Generated %d stubs in %s\n", len(generated), outputName)
+}
+
+type clientField struct {
+	name          string
+	interfaceName string
+}
+
+// parsePackage returns all the interfaces and the Client struct declared in the non-test and non-fake files of the package.
+func parsePackage(dir string) (map[string]*ast.InterfaceType, *ast.StructType) {
+	fileSet := token.NewFileSet()
+	packages, err := parser.ParseDir(fileSet, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && !strings.HasPrefix(info.Name(), "fake_")
+	}, 0)
+	if err != nil {
+		log.Panicln(err)
+	}
+	interfaces := make(map[string]*ast.InterfaceType)
+	var client *ast.StructType
+	for _, pkg := range packages {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(node ast.Node) bool {
+				if typeSpec, ok := node.(*ast.TypeSpec); ok {
+					if interfaceType, ok := typeSpec.Type.(*ast.InterfaceType); ok {
+						interfaces[typeSpec.Name.Name] = interfaceType
+					}
+				}
+				return true
+			})
+			if structType := clientStruct(file); structType != nil {
+				client = structType
+			}
+		}
+	}
+	if client == nil {
+		log.Panicln("Client struct not found")
+	}
+	return interfaces, client
+}
+
+func clientStruct(file *ast.File) *ast.StructType {
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			if typeSpec, ok := spec.(*ast.TypeSpec); ok && typeSpec.Name.Name == "Client" {
+				return typeSpec.Type.(*ast.StructType)
+			}
+		}
+	}
+	return nil
+}
+
+// clientInterfaceFields returns the exported fields of the Client struct typed with an interface declared in the package.
+func clientInterfaceFields(client *ast.StructType, interfaces map[string]*ast.InterfaceType) []clientField {
+	fields := make([]clientField, 0)
+	for _, field := range client.Fields.List {
+		typeName, ok := field.Type.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		if _, isInterface := interfaces[typeName.Name]; !isInterface {
+			continue
+		}
+		for _, name := range field.Names {
+			if ast.IsExported(name.Name) {
+				fields = append(fields, clientField{name: name.Name, interfaceName: typeName.Name})
+			}
+		}
+	}
+	return fields
+}
+
+// methods returns the methods of the interface together with the methods of the embedded interfaces, sorted by name.
+func methods(interfaces map[string]*ast.InterfaceType, interfaceName string) []*ast.Field {
+	interfaceType, ok := interfaces[interfaceName]
+	if !ok {
+		log.Panicf("interface %s not found", interfaceName)
+	}
+	result := make([]*ast.Field, 0)
+	for _, method := range interfaceType.Methods.List {
+		if len(method.Names) == 0 {
+			result = append(result, methods(interfaces, types.ExprString(method.Type))...)
+			continue
+		}
+		result = append(result, method)
+	}
+	slices.SortFunc(result, func(a, b *ast.Field) int { return strings.Compare(a.Names[0].Name, b.Names[0].Name) })
+	return result
+}
+
+func stubName(interfaceName string) string {
+	return fmt.Sprintf("fakeUnsupported%s", interfaceName)
+}
+
+func writeStub(w io.Writer, interfaceName string, interfaceMethods []*ast.Field) {
+	name := stubName(interfaceName)
+	printf(w, "type %s struct{}\n\n", name)
+	printf(w, "var _ %s = %s{}\n\n", interfaceName, name)
+	for _, method := range interfaceMethods {
+		methodName := method.Names[0].Name
+		funcType := method.Type.(*ast.FuncType)
+
+		params := make([]string, 0)
+		for _, param := range funcType.Params.List {
+			for range max(len(param.Names), 1) {
+				params = append(params, "_ "+types.ExprString(param.Type))
+			}
+		}
+
+		results := make([]string, 0)
+		returnsError := false
+		if funcType.Results != nil {
+			for _, result := range funcType.Results.List {
+				for range max(len(result.Names), 1) {
+					results = append(results, "_ "+types.ExprString(result.Type))
+				}
+			}
+			if last := results[len(results)-1]; last == "_ error" {
+				results[len(results)-1] = "err error"
+				returnsError = true
+			}
+		}
+
+		operation := fmt.Sprintf("%s.%s", interfaceName, methodName)
+		printf(w, "func (%s) %s(%s) (%s) {\n", name, methodName, strings.Join(params, ", "), strings.Join(results, ", "))
+		if returnsError {
+			printf(w, "err = fakeErrUnsupported(%q)\n", operation)
+			printf(w, "return\n")
+		} else {
+			printf(w, "panic(fakeErrUnsupported(%q))\n", operation)
+		}
+		printf(w, "}\n\n")
+	}
+}
+
+func printf(w io.Writer, format string, args ...any) {
+	_, err := fmt.Fprintf(w, format, args...)
+	if err != nil {
+		log.Panicln(err)
+	}
+}
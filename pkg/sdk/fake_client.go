@@ -0,0 +1,212 @@
+//go:build fake_client_tests
+
+//go:generate go run ./fake-client-generator/main.go
+
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+const (
+	FakeAccountLocator   = "FAKE_ACCOUNT"
+	FakeAccountName      = "FAKE_ACCOUNT_NAME"
+	FakeOrganizationName = "FAKE_ORGANIZATION"
+	FakeRegion           = "FAKE_REGION"
+	FakeUser             = "FAKE_USER"
+	FakeRole             = "ACCOUNTADMIN"
+)
+
+// ErrFakeUnsupported is returned by the fake client (see NewFakeClient) for operations that are not modeled by the in-memory catalog.
+var ErrFakeUnsupported = errors.New("operation is not supported by the fake client")
+
+// FakeCatalog is an in-memory representation of a Snowflake account used by the fake client (see NewFakeClient).
+// It answers SHOW and DESCRIBE commands consistently with the previously executed CREATE, ALTER, and DROP commands.
+// All the operations are guarded by a single mutex, so the catalog can be safely shared between multiple clients.
+type FakeCatalog struct {
+	mu sync.Mutex
+
+	currentRole      string
+	currentDatabase  string
+	currentSchema    string
+	currentWarehouse string
+
+	databases        map[string]*fakeDatabase
+	droppedDatabases map[string]*fakeDatabase
+	warehouses       map[string]*fakeWarehouse
+	roles            map[string]*fakeRole
+	users            map[string]*fakeUser
+	grants           []Grant
+	futureGrants     []fakeFutureGrant
+}
+
+// NewFakeCatalog returns an empty catalog containing only the system-defined roles.
+func NewFakeCatalog() *FakeCatalog {
+	c := &FakeCatalog{
+		currentRole:      FakeRole,
+		databases:        make(map[string]*fakeDatabase),
+		droppedDatabases: make(map[string]*fakeDatabase),
+		warehouses:       make(map[string]*fakeWarehouse),
+		roles:            make(map[string]*fakeRole),
+		users:            make(map[string]*fakeUser),
+	}
+	for _, role := range []string{"ACCOUNTADMIN", "SECURITYADMIN", "SYSADMIN", "USERADMIN", "PUBLIC"} {
+		c.roles[role] = &fakeRole{fakeObject: newFakeObject(role, "")}
+	}
+	return c
+}
+
+// NewFakeClient returns a client backed by the given in-memory catalog instead of a Snowflake connection.
+// The fake client is compiled only with the fake_client_tests build tag, so it is not a part of the provider binary.
+// Only databases, schemas, warehouses, roles, users, grants, tables, and the context and replication functions are implemented
+// (policy references are always empty); all the other object interfaces are set to the generated stubs (see fake_unsupported_generated.go).
+// The stubs and the operations that cannot be modeled return ErrFakeUnsupported.
+func NewFakeClient(catalog *FakeCatalog) *Client {
+	client := &Client{
+		accountLocator: FakeAccountLocator,
+		sessionID:      "0",
+	}
+	setFakeUnsupportedInterfaces(client)
+	client.ContextFunctions = &fakeContextFunctions{catalog: catalog}
+	client.ReplicationFunctions = &fakeReplicationFunctions{}
+	client.PolicyReferences = &fakePolicyReferences{}
+	client.Databases = &fakeDatabases{client: client, catalog: catalog}
+	client.Schemas = &fakeSchemas{client: client, catalog: catalog}
+	client.Warehouses = &fakeWarehouses{client: client, catalog: catalog}
+	client.Roles = &fakeRoles{client: client, catalog: catalog}
+	client.Users = &fakeUsers{client: client, catalog: catalog}
+	client.Grants = &fakeGrants{catalog: catalog}
+	client.Tables = &fakeTables{client: client, catalog: catalog}
+	return client
+}
+
+// fakeObject holds the data common for all the objects stored in the catalog.
+// Properties and parameters are kept under their SQL names (e.g. COMMENT, DATA_RETENTION_TIME_IN_DAYS).
+type fakeObject struct {
+	name       string
+	createdOn  time.Time
+	owner      string
+	properties map[string]string
+}
+
+func newFakeObject(name string, owner string) fakeObject {
+	return fakeObject{
+		name:       name,
+		createdOn:  time.Now().UTC(),
+		owner:      owner,
+		properties: make(map[string]string),
+	}
+}
+
+func (o *fakeObject) property(key string, defaultValue string) string {
+	if v, ok := o.properties[key]; ok {
+		return v
+	}
+	return defaultValue
+}
+
+func (o *fakeObject) intProperty(key string, defaultValue int) int {
+	if v, ok := o.properties[key]; ok {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return defaultValue
+}
+
+func (o *fakeObject) boolProperty(key string, defaultValue bool) bool {
+	if v, ok := o.properties[key]; ok {
+		return strings.EqualFold(v, "true")
+	}
+	return defaultValue
+}
+
+func (o *fakeObject) parameters(level ParameterType, keys []string) []*Parameter {
+	parameters := make([]*Parameter, 0)
+	for _, key := range keys {
+		if v, ok := o.properties[key]; ok {
+			parameters = append(parameters, &Parameter{Key: key, Value: v, Level: level})
+		}
+	}
+	return parameters
+}
+
+func fakeValidate(opts any) error {
+	if v, ok := opts.(validatable); ok {
+		return v.validate()
+	}
+	return nil
+}
+
+func fakeErrAlreadyExists(objectType ObjectType, id ObjectIdentifier) error {
+	return fmt.Errorf("%s %s already exists", objectType, id.FullyQualifiedName())
+}
+
+func fakeErrDoesNotExist(objectType ObjectType, id ObjectIdentifier) error {
+	return fmt.Errorf("%w: %s %s", ErrObjectNotExistOrAuthorized, objectType, id.FullyQualifiedName())
+}
+
+func fakeErrUnsupported(operation string) error {
+	return fmt.Errorf("%w: %s", ErrFakeUnsupported, operation)
+}
+
+// fakeMatchesLike checks the name against the LIKE pattern the same way Snowflake does for SHOW commands (case-insensitive, % and _ wildcards, \ escape).
+func fakeMatchesLike(like *Like, name string) bool {
+	if like == nil || like.Pattern == nil {
+		return true
+	}
+	var b strings.Builder
+	b.WriteString("(?is)^")
+	escaped := false
+	for _, r := range *like.Pattern {
+		switch {
+		case escaped:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			b.WriteString(".*")
+		case r == '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	matched, err := regexp.MatchString(b.String(), name)
+	return err == nil && matched
+}
+
+// fakeFilterNames returns sorted names matching the SHOW filters.
+func fakeFilterNames[T any](objects map[string]T, like *Like, startsWith *string, limit *LimitFrom) []string {
+	names := make([]string, 0, len(objects))
+	for name := range objects {
+		if !fakeMatchesLike(like, name) {
+			continue
+		}
+		if startsWith != nil && !strings.HasPrefix(name, *startsWith) {
+			continue
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	if limit != nil {
+		if limit.From != nil {
+			names = collections.Filter(names, func(name string) bool { return name > *limit.From })
+		}
+		if limit.Rows != nil && len(names) > *limit.Rows {
+			names = names[:*limit.Rows]
+		}
+	}
+	return names
+}
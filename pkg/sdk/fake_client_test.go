@@ -0,0 +1,342 @@
+//go:build fake_client_tests
+
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeClient_Databases(t *testing.T) {
+	ctx := context.Background()
+	client := NewFakeClient(NewFakeCatalog())
+	id := NewAccountObjectIdentifier("DB")
+
+	t.Run("create, show and describe", func(t *testing.T) {
+		err := client.Databases.Create(ctx, id, &CreateDatabaseOptions{
+			Comment:                 String("comment"),
+			DataRetentionTimeInDays: Int(3),
+		})
+		require.NoError(t, err)
+
+		database, err := client.Databases.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "DB", database.Name)
+		assert.Equal(t, "comment", database.Comment)
+		assert.Equal(t, 3, database.RetentionTime)
+		assert.Equal(t, FakeRole, database.Owner)
+
+		details, err := client.Databases.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Len(t, details.Rows, 2)
+
+		err = client.Databases.Create(ctx, id, nil)
+		require.Error(t, err)
+
+		err = client.Databases.Create(ctx, id, &CreateDatabaseOptions{IfNotExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("alter", func(t *testing.T) {
+		err := client.Databases.Alter(ctx, id, &AlterDatabaseOptions{Unset: &DatabaseUnset{Comment: Bool(true)}})
+		require.NoError(t, err)
+
+		database, err := client.Databases.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, database.Comment)
+
+		newId := NewAccountObjectIdentifier("DB_NEW")
+		err = client.Databases.Alter(ctx, id, &AlterDatabaseOptions{NewName: &newId})
+		require.NoError(t, err)
+
+		_, err = client.Databases.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotFound)
+
+		err = client.Databases.Alter(ctx, newId, &AlterDatabaseOptions{NewName: &id})
+		require.NoError(t, err)
+	})
+
+	t.Run("show with like", func(t *testing.T) {
+		require.NoError(t, client.Databases.Create(ctx, NewAccountObjectIdentifier("OTHER"), nil))
+
+		databases, err := client.Databases.Show(ctx, &ShowDatabasesOptions{Like: &Like{Pattern: String("d%")}})
+		require.NoError(t, err)
+		require.Len(t, databases, 1)
+		assert.Equal(t, "DB", databases[0].Name)
+	})
+
+	t.Run("drop and undrop", func(t *testing.T) {
+		require.NoError(t, client.Databases.Drop(ctx, id, nil))
+
+		_, err := client.Databases.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotFound)
+
+		require.ErrorIs(t, client.Databases.Drop(ctx, id, nil), ErrObjectNotExistOrAuthorized)
+		require.NoError(t, client.Databases.DropSafely(ctx, id))
+
+		require.NoError(t, client.Databases.Undrop(ctx, id))
+		_, err = client.Databases.ShowByID(ctx, id)
+		require.NoError(t, err)
+	})
+}
+
+func TestFakeClient_SchemasAndTables(t *testing.T) {
+	ctx := context.Background()
+	client := NewFakeClient(NewFakeCatalog())
+	databaseId := NewAccountObjectIdentifier("DB")
+	schemaId := NewDatabaseObjectIdentifier("DB", "SCHEMA")
+	tableId := NewSchemaObjectIdentifierInSchema(schemaId, "TABLE")
+
+	require.NoError(t, client.Databases.Create(ctx, databaseId, nil))
+
+	t.Run("schema in missing database", func(t *testing.T) {
+		err := client.Schemas.Create(ctx, NewDatabaseObjectIdentifier("MISSING", "SCHEMA"), nil)
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+
+		_, err = client.Schemas.ShowByIDSafely(ctx, NewDatabaseObjectIdentifier("MISSING", "SCHEMA"))
+		require.ErrorIs(t, err, ErrObjectNotFound)
+	})
+
+	t.Run("schema", func(t *testing.T) {
+		require.NoError(t, client.Schemas.Create(ctx, schemaId, &CreateSchemaOptions{WithManagedAccess: Bool(true), Comment: String("comment")}))
+
+		schema, err := client.Schemas.ShowByID(ctx, schemaId)
+		require.NoError(t, err)
+		assert.Equal(t, "DB", schema.DatabaseName)
+		assert.Equal(t, "comment", schema.Comment)
+		require.NotNil(t, schema.Options)
+		assert.Equal(t, "MANAGED ACCESS", *schema.Options)
+
+		schemas, err := client.Schemas.Show(ctx, &ShowSchemaOptions{In: &SchemaIn{Database: Bool(true), Name: databaseId}})
+		require.NoError(t, err)
+		assert.Len(t, schemas, 3)
+	})
+
+	t.Run("table", func(t *testing.T) {
+		request := NewCreateTableRequest(tableId, []TableColumnRequest{
+			*NewTableColumnRequest("ID", DataTypeNumber).WithNotNull(Bool(true)),
+		}).WithComment(String("comment"))
+		require.NoError(t, client.Tables.Create(ctx, request))
+
+		add := NewTableColumnAddActionRequest("NAME", DataTypeVARCHAR).WithComment(String("name"))
+		require.NoError(t, client.Tables.Alter(ctx, NewAlterTableRequest(tableId).WithColumnAction(NewTableColumnActionRequest().WithAdd(add))))
+
+		table, err := client.Tables.ShowByID(ctx, tableId)
+		require.NoError(t, err)
+		assert.Equal(t, "comment", table.Comment)
+		assert.Equal(t, "TABLE", table.Kind)
+
+		columns, err := client.Tables.DescribeColumns(ctx, NewDescribeTableColumnsRequest(tableId))
+		require.NoError(t, err)
+		require.Len(t, columns, 2)
+		assert.Equal(t, "ID", columns[0].Name)
+		assert.False(t, columns[0].IsNullable)
+		assert.Equal(t, "NAME", columns[1].Name)
+		assert.True(t, columns[1].IsNullable)
+
+		details, err := client.Schemas.Describe(ctx, schemaId)
+		require.NoError(t, err)
+		require.Len(t, details, 1)
+		assert.Equal(t, "TABLE", details[0].Name)
+	})
+
+	t.Run("dropping database drops its content", func(t *testing.T) {
+		require.NoError(t, client.Databases.Drop(ctx, databaseId, nil))
+
+		_, err := client.Tables.ShowByIDSafely(ctx, tableId)
+		require.ErrorIs(t, err, ErrObjectNotFound)
+		require.NoError(t, client.Tables.DropSafely(ctx, tableId))
+	})
+}
+
+func TestFakeClient_Warehouses(t *testing.T) {
+	ctx := context.Background()
+	client := NewFakeClient(NewFakeCatalog())
+	id := NewAccountObjectIdentifier("WH")
+
+	require.NoError(t, client.Warehouses.Create(ctx, id, &CreateWarehouseOptions{
+		WarehouseSize:      Pointer(WarehouseSizeSmall),
+		InitiallySuspended: Bool(true),
+		AutoSuspend:        Int(60),
+	}))
+
+	warehouse, err := client.Warehouses.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, WarehouseStateSuspended, warehouse.State)
+	assert.Equal(t, WarehouseSizeSmall, *warehouse.Size)
+	assert.Equal(t, 60, *warehouse.AutoSuspend)
+	assert.Equal(t, WarehouseTypeStandard, warehouse.Type)
+	assert.Nil(t, warehouse.Generation)
+
+	require.NoError(t, client.Warehouses.Alter(ctx, id, &AlterWarehouseOptions{Resume: Bool(true)}))
+	require.NoError(t, client.Warehouses.Alter(ctx, id, &AlterWarehouseOptions{Unset: &WarehouseUnset{AutoSuspend: Bool(true)}}))
+
+	warehouse, err = client.Warehouses.ShowByIDExperimentalSafely(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, WarehouseStateStarted, warehouse.State)
+	assert.Equal(t, 600, *warehouse.AutoSuspend)
+
+	require.NoError(t, client.Warehouses.Drop(ctx, id, nil))
+	_, err = client.Warehouses.ShowByIDSafely(ctx, id)
+	require.ErrorIs(t, err, ErrObjectNotFound)
+}
+
+func TestFakeClient_RolesAndGrants(t *testing.T) {
+	ctx := context.Background()
+	client := NewFakeClient(NewFakeCatalog())
+	roleId := NewAccountObjectIdentifier("ROLE")
+	userId := NewAccountObjectIdentifier("USER")
+	databaseId := NewAccountObjectIdentifier("DB")
+
+	require.NoError(t, client.Roles.Create(ctx, NewCreateRoleRequest(roleId).WithComment("comment")))
+	require.NoError(t, client.Users.Create(ctx, userId, &CreateUserOptions{ObjectProperties: &UserObjectProperties{LoginName: String("login")}}))
+	require.NoError(t, client.Databases.Create(ctx, databaseId, nil))
+
+	t.Run("role grants", func(t *testing.T) {
+		require.NoError(t, client.Roles.Grant(ctx, NewGrantRoleRequest(roleId, GrantRole{User: &userId})))
+		require.NoError(t, client.Roles.Grant(ctx, NewGrantRoleRequest(roleId, GrantRole{Role: Pointer(NewAccountObjectIdentifier("SYSADMIN"))})))
+
+		role, err := client.Roles.ShowByID(ctx, roleId)
+		require.NoError(t, err)
+		assert.Equal(t, "comment", role.Comment)
+		assert.Equal(t, 1, role.AssignedToUsers)
+		assert.Equal(t, 1, role.GrantedToRoles)
+
+		grants, err := client.Grants.Show(ctx, &ShowGrantOptions{Of: &ShowGrantsOf{Role: roleId}})
+		require.NoError(t, err)
+		assert.Len(t, grants, 2)
+
+		// the granted role is not mapped for SHOW GRANTS TO USER, the same way as for the real client
+		grants, err = client.Grants.Show(ctx, &ShowGrantOptions{To: &ShowGrantsTo{User: userId}})
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, ObjectTypeUser, grants[0].GrantedTo)
+		assert.Equal(t, userId.Name(), grants[0].GranteeName.Name())
+		assert.Empty(t, grants[0].Privilege)
+		assert.Empty(t, grants[0].GrantedOn)
+		assert.Empty(t, grants[0].Name.Name())
+
+		require.NoError(t, client.Roles.Revoke(ctx, NewRevokeRoleRequest(roleId, RevokeRole{User: &userId})))
+		grants, err = client.Grants.Show(ctx, &ShowGrantOptions{Of: &ShowGrantsOf{Role: roleId}})
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, ObjectTypeRole, grants[0].GrantedTo)
+	})
+
+	t.Run("privilege grants", func(t *testing.T) {
+		on := &AccountRoleGrantOn{AccountObject: &GrantOnAccountObject{Database: &databaseId}}
+		privileges := &AccountRoleGrantPrivileges{AccountObjectPrivileges: []AccountObjectPrivilege{AccountObjectPrivilegeUsage, AccountObjectPrivilegeMonitor}}
+		require.NoError(t, client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, on, roleId, &GrantPrivilegesToAccountRoleOptions{WithGrantOption: Bool(true)}))
+
+		grants, err := client.Grants.Show(ctx, &ShowGrantOptions{On: &ShowGrantsOn{Object: &Object{ObjectType: ObjectTypeDatabase, Name: databaseId}}})
+		require.NoError(t, err)
+		require.Len(t, grants, 2)
+		assert.True(t, grants[0].GrantOption)
+		assert.Equal(t, FakeRole, grants[0].GrantedBy.Name())
+
+		revoked := &AccountRoleGrantPrivileges{AccountObjectPrivileges: []AccountObjectPrivilege{AccountObjectPrivilegeMonitor}}
+		require.NoError(t, client.Grants.RevokePrivilegesFromAccountRole(ctx, revoked, on, roleId, nil))
+
+		grants, err = client.Grants.Show(ctx, &ShowGrantOptions{To: &ShowGrantsTo{Role: roleId}})
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, AccountObjectPrivilegeUsage.String(), grants[0].Privilege)
+	})
+
+	t.Run("future grants", func(t *testing.T) {
+		on := &AccountRoleGrantOn{SchemaObject: &GrantOnSchemaObject{Future: &GrantOnSchemaObjectIn{PluralObjectType: PluralObjectTypeTables, InDatabase: &databaseId}}}
+		privileges := &AccountRoleGrantPrivileges{SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect}}
+		require.NoError(t, client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, on, roleId, nil))
+
+		grants, err := client.Grants.Show(ctx, &ShowGrantOptions{Future: Bool(true), In: &ShowGrantsIn{Database: &databaseId}})
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, ObjectTypeTable, grants[0].GrantOn)
+		assert.Equal(t, roleId.Name(), grants[0].GranteeName.Name())
+
+		grants, err = client.Grants.Show(ctx, &ShowGrantOptions{Future: Bool(true), To: &ShowGrantsTo{Role: roleId}})
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, SchemaObjectPrivilegeSelect.String(), grants[0].Privilege)
+	})
+
+	t.Run("dropping role removes its grants", func(t *testing.T) {
+		require.NoError(t, client.Roles.DropSafely(ctx, roleId))
+
+		_, err := client.Roles.ShowByIDSafely(ctx, roleId)
+		require.ErrorIs(t, err, ErrObjectNotFound)
+
+		grants, err := client.Grants.Show(ctx, &ShowGrantOptions{On: &ShowGrantsOn{Object: &Object{ObjectType: ObjectTypeDatabase, Name: databaseId}}})
+		require.NoError(t, err)
+		assert.Empty(t, grants)
+	})
+}
+
+func TestFakeClient_Users(t *testing.T) {
+	ctx := context.Background()
+	client := NewFakeClient(NewFakeCatalog())
+	id := NewAccountObjectIdentifier("USER")
+
+	require.NoError(t, client.Users.Create(ctx, id, &CreateUserOptions{
+		ObjectProperties: &UserObjectProperties{
+			Password:         String("secret"),
+			DefaultRole:      Pointer(NewAccountObjectIdentifier("PUBLIC")),
+			DefaultNamespace: Pointer[ObjectIdentifier](NewDatabaseObjectIdentifier("DB", "SCHEMA")),
+		},
+	}))
+
+	user, err := client.Users.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.True(t, user.HasPassword)
+	assert.Equal(t, "PUBLIC", user.DefaultRole)
+	assert.Equal(t, `"DB"."SCHEMA"`, user.DefaultNamespace)
+	assert.Equal(t, "USER", user.LoginName)
+
+	details, err := client.Users.Describe(ctx, id)
+	require.NoError(t, err)
+	require.NotNil(t, details.Password)
+	assert.Equal(t, "********", details.Password.Value)
+
+	require.NoError(t, client.Users.Alter(ctx, id, &AlterUserOptions{Unset: &UserUnset{ObjectProperties: &UserObjectPropertiesUnset{DefaultRole: Bool(true)}}}))
+	user, err = client.Users.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Empty(t, user.DefaultRole)
+
+	_, err = client.Users.ShowProgrammaticAccessTokens(ctx, nil)
+	require.ErrorIs(t, err, ErrFakeUnsupported)
+}
+
+func TestFakeClient_UnsupportedInterfaces(t *testing.T) {
+	ctx := context.Background()
+	client := NewFakeClient(NewFakeCatalog())
+
+	_, err := client.Alerts.Show(ctx, nil)
+	require.ErrorIs(t, err, ErrFakeUnsupported)
+
+	err = client.Streams.Drop(ctx, NewDropStreamRequest(NewSchemaObjectIdentifier("DB", "SCHEMA", "STREAM")))
+	require.ErrorIs(t, err, ErrFakeUnsupported)
+}
+
+func TestFakeClient_matchesLike(t *testing.T) {
+	testCases := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{pattern: "abc", name: "ABC", expected: true},
+		{pattern: "a%", name: "ABC", expected: true},
+		{pattern: "a_c", name: "ABC", expected: true},
+		{pattern: "a_c", name: "ABBC", expected: false},
+		{pattern: `a\_c`, name: "ABC", expected: false},
+		{pattern: `a\_c`, name: "A_C", expected: true},
+		{pattern: "a.c", name: "ABC", expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.pattern+" "+tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, fakeMatchesLike(&Like{Pattern: String(tc.pattern)}, tc.name))
+		})
+	}
+}
@@ -0,0 +1,115 @@
+//go:build fake_client_tests
+
+package sdk
+
+import (
+	"context"
+)
+
+var (
+	_ ContextFunctions     = (*fakeContextFunctions)(nil)
+	_ ReplicationFunctions = (*fakeReplicationFunctions)(nil)
+	_ PolicyReferences     = (*fakePolicyReferences)(nil)
+)
+
+type fakeContextFunctions struct {
+	catalog *FakeCatalog
+}
+
+func (v *fakeContextFunctions) CurrentAccount(_ context.Context) (string, error) {
+	return FakeAccountLocator, nil
+}
+
+func (v *fakeContextFunctions) CurrentOrganizationName(_ context.Context) (string, error) {
+	return FakeOrganizationName, nil
+}
+
+func (v *fakeContextFunctions) CurrentAccountName(_ context.Context) (string, error) {
+	return FakeAccountName, nil
+}
+
+func (v *fakeContextFunctions) CurrentRole(_ context.Context) (AccountObjectIdentifier, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+	return NewAccountObjectIdentifier(v.catalog.currentRole), nil
+}
+
+func (v *fakeContextFunctions) CurrentSecondaryRoles(_ context.Context) (*CurrentSecondaryRoles, error) {
+	return &CurrentSecondaryRoles{Roles: []AccountObjectIdentifier{}, Value: SecondaryRolesNone}, nil
+}
+
+func (v *fakeContextFunctions) CurrentRegion(_ context.Context) (string, error) {
+	return FakeRegion, nil
+}
+
+func (v *fakeContextFunctions) CurrentSession(_ context.Context) (string, error) {
+	return "0", nil
+}
+
+func (v *fakeContextFunctions) CurrentUser(_ context.Context) (AccountObjectIdentifier, error) {
+	return NewAccountObjectIdentifier(FakeUser), nil
+}
+
+func (v *fakeContextFunctions) CurrentSessionDetails(_ context.Context) (*CurrentSessionDetails, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+	return &CurrentSessionDetails{
+		Account:          FakeAccountLocator,
+		AccountName:      FakeAccountName,
+		OrganizationName: FakeOrganizationName,
+		Role:             v.catalog.currentRole,
+		Region:           FakeRegion,
+		Session:          "0",
+		User:             FakeUser,
+	}, nil
+}
+
+func (v *fakeContextFunctions) LastQueryId(_ context.Context) (string, error) {
+	return "", nil
+}
+
+func (v *fakeContextFunctions) CurrentDatabase(_ context.Context) (string, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+	return v.catalog.currentDatabase, nil
+}
+
+func (v *fakeContextFunctions) CurrentSchema(_ context.Context) (string, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+	return v.catalog.currentSchema, nil
+}
+
+func (v *fakeContextFunctions) CurrentWarehouse(_ context.Context) (string, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+	return v.catalog.currentWarehouse, nil
+}
+
+func (v *fakeContextFunctions) IsRoleInSession(_ context.Context, role AccountObjectIdentifier) (bool, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+	return role.Name() == v.catalog.currentRole, nil
+}
+
+// fakeReplicationFunctions models an account without any replication configured.
+type fakeReplicationFunctions struct{}
+
+func (v *fakeReplicationFunctions) ShowReplicationAccounts(_ context.Context) ([]*ReplicationAccount, error) {
+	return []*ReplicationAccount{}, nil
+}
+
+func (v *fakeReplicationFunctions) ShowReplicationDatabases(_ context.Context, _ *ShowReplicationDatabasesOptions) ([]ReplicationDatabase, error) {
+	return []ReplicationDatabase{}, nil
+}
+
+func (v *fakeReplicationFunctions) ShowRegions(_ context.Context, _ *ShowRegionsOptions) ([]*Region, error) {
+	return []*Region{}, nil
+}
+
+// fakePolicyReferences models an account without any policies attached.
+type fakePolicyReferences struct{}
+
+func (v *fakePolicyReferences) GetForEntity(_ context.Context, _ *GetForEntityPolicyReferenceRequest) ([]PolicyReference, error) {
+	return []PolicyReference{}, nil
+}
@@ -0,0 +1,262 @@
+//go:build fake_client_tests
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ Databases = (*fakeDatabases)(nil)
+
+type fakeDatabase struct {
+	fakeObject
+	transient      bool
+	schemas        map[string]*fakeSchema
+	droppedSchemas map[string]*fakeSchema
+}
+
+func newFakeDatabase(name string, owner string) *fakeDatabase {
+	database := &fakeDatabase{
+		fakeObject:     newFakeObject(name, owner),
+		schemas:        make(map[string]*fakeSchema),
+		droppedSchemas: make(map[string]*fakeSchema),
+	}
+	database.schemas["INFORMATION_SCHEMA"] = newFakeSchema("INFORMATION_SCHEMA", "")
+	database.schemas["PUBLIC"] = newFakeSchema("PUBLIC", owner)
+	return database
+}
+
+func (c *FakeCatalog) database(id AccountObjectIdentifier) (*fakeDatabase, error) {
+	database, ok := c.databases[id.Name()]
+	if !ok {
+		return nil, fakeErrDoesNotExist(ObjectTypeDatabase, id)
+	}
+	return database, nil
+}
+
+type fakeDatabases struct {
+	client  *Client
+	catalog *FakeCatalog
+}
+
+func (v *fakeDatabases) Create(_ context.Context, id AccountObjectIdentifier, opts *CreateDatabaseOptions) error {
+	if opts == nil {
+		opts = &CreateDatabaseOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	if opts.Clone != nil {
+		return fakeErrUnsupported("CREATE DATABASE ... CLONE")
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, ok := v.catalog.databases[id.Name()]; ok {
+		switch {
+		case opts.IfNotExists != nil && *opts.IfNotExists:
+			return nil
+		case opts.OrReplace == nil || !*opts.OrReplace:
+			return fakeErrAlreadyExists(ObjectTypeDatabase, id)
+		}
+	}
+	database := newFakeDatabase(id.Name(), v.catalog.currentRole)
+	database.transient = opts.Transient != nil && *opts.Transient
+	fakeSetProperties(database.properties, opts)
+	v.catalog.databases[id.Name()] = database
+	return nil
+}
+
+func (v *fakeDatabases) CreateShared(_ context.Context, _ AccountObjectIdentifier, _ ExternalObjectIdentifier, _ *CreateSharedDatabaseOptions) error {
+	return fakeErrUnsupported("CREATE DATABASE ... FROM SHARE")
+}
+
+func (v *fakeDatabases) CreateSecondary(_ context.Context, _ AccountObjectIdentifier, _ ExternalObjectIdentifier, _ *CreateSecondaryDatabaseOptions) error {
+	return fakeErrUnsupported("CREATE DATABASE ... AS REPLICA OF")
+}
+
+func (v *fakeDatabases) CreateFromListing(_ context.Context, _ AccountObjectIdentifier, _ string, _ *CreateDatabaseFromListingOptions) error {
+	return fakeErrUnsupported("CREATE DATABASE ... FROM LISTING")
+}
+
+func (v *fakeDatabases) Alter(_ context.Context, id AccountObjectIdentifier, opts *AlterDatabaseOptions) error {
+	if opts == nil {
+		opts = &AlterDatabaseOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	database, err := v.catalog.database(id)
+	if err != nil {
+		if opts.IfExists != nil && *opts.IfExists {
+			return nil
+		}
+		return err
+	}
+	switch {
+	case opts.NewName != nil:
+		if _, ok := v.catalog.databases[opts.NewName.Name()]; ok {
+			return fakeErrAlreadyExists(ObjectTypeDatabase, *opts.NewName)
+		}
+		delete(v.catalog.databases, id.Name())
+		database.name = opts.NewName.Name()
+		v.catalog.databases[database.name] = database
+	case opts.SwapWith != nil:
+		other, err := v.catalog.database(*opts.SwapWith)
+		if err != nil {
+			return err
+		}
+		database.name, other.name = other.name, database.name
+		v.catalog.databases[database.name], v.catalog.databases[other.name] = database, other
+	case opts.Set != nil:
+		fakeSetProperties(database.properties, opts.Set)
+	case opts.Unset != nil:
+		fakeUnsetProperties(database.properties, opts.Unset)
+	}
+	return nil
+}
+
+func (v *fakeDatabases) AlterReplication(_ context.Context, _ AccountObjectIdentifier, _ *AlterDatabaseReplicationOptions) error {
+	return fakeErrUnsupported("ALTER DATABASE ... REPLICATION")
+}
+
+func (v *fakeDatabases) AlterFailover(_ context.Context, _ AccountObjectIdentifier, _ *AlterDatabaseFailoverOptions) error {
+	return fakeErrUnsupported("ALTER DATABASE ... FAILOVER")
+}
+
+func (v *fakeDatabases) Drop(_ context.Context, id AccountObjectIdentifier, opts *DropDatabaseOptions) error {
+	if opts == nil {
+		opts = &DropDatabaseOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	database, err := v.catalog.database(id)
+	if err != nil {
+		if opts.IfExists != nil && *opts.IfExists {
+			return nil
+		}
+		return err
+	}
+	delete(v.catalog.databases, id.Name())
+	v.catalog.droppedDatabases[id.Name()] = database
+	return nil
+}
+
+func (v *fakeDatabases) DropSafely(ctx context.Context, id AccountObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, id, &DropDatabaseOptions{IfExists: Bool(true)}) }, ctx, id)
+}
+
+func (v *fakeDatabases) Undrop(_ context.Context, id AccountObjectIdentifier) error {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	database, ok := v.catalog.droppedDatabases[id.Name()]
+	if !ok {
+		return fakeErrDoesNotExist(ObjectTypeDatabase, id)
+	}
+	if _, ok := v.catalog.databases[id.Name()]; ok {
+		return fakeErrAlreadyExists(ObjectTypeDatabase, id)
+	}
+	delete(v.catalog.droppedDatabases, id.Name())
+	v.catalog.databases[id.Name()] = database
+	return nil
+}
+
+func (v *fakeDatabases) Show(_ context.Context, opts *ShowDatabasesOptions) ([]Database, error) {
+	opts = createIfNil(opts)
+	if err := fakeValidate(opts); err != nil {
+		return nil, err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	names := fakeFilterNames(v.catalog.databases, opts.Like, opts.StartsWith, opts.LimitFrom)
+	return collections.Map(names, func(name string) Database {
+		return v.catalog.databases[name].toDatabase(name == v.catalog.currentDatabase)
+	}), nil
+}
+
+func (d *fakeDatabase) toDatabase(isCurrent bool) Database {
+	database := Database{
+		CreatedOn:     d.createdOn,
+		Name:          d.name,
+		IsCurrent:     isCurrent,
+		Owner:         d.owner,
+		Comment:       d.property("COMMENT", ""),
+		RetentionTime: d.intProperty(string(ObjectParameterDataRetentionTimeInDays), 1),
+		Transient:     d.transient,
+		Kind:          "STANDARD",
+		OwnerRoleType: "ROLE",
+	}
+	if d.transient {
+		database.Options = "TRANSIENT"
+	}
+	return database
+}
+
+func (v *fakeDatabases) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Database, error) {
+	databases, err := v.Show(ctx, &ShowDatabasesOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(databases, func(r Database) bool { return r.Name == id.Name() })
+}
+
+func (v *fakeDatabases) ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*Database, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *fakeDatabases) Describe(_ context.Context, id AccountObjectIdentifier) (*DatabaseDetails, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	database, err := v.catalog.database(id)
+	if err != nil {
+		return nil, err
+	}
+	names := fakeFilterNames(database.schemas, nil, nil, nil)
+	return &DatabaseDetails{
+		Rows: collections.Map(names, func(name string) DatabaseDetailsRow {
+			return DatabaseDetailsRow{CreatedOn: database.schemas[name].createdOn, Name: name, Kind: "SCHEMA"}
+		}),
+	}, nil
+}
+
+func (v *fakeDatabases) Use(_ context.Context, id AccountObjectIdentifier) error {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, err := v.catalog.database(id); err != nil {
+		return err
+	}
+	v.catalog.currentDatabase = id.Name()
+	v.catalog.currentSchema = "PUBLIC"
+	return nil
+}
+
+func (v *fakeDatabases) ShowParameters(_ context.Context, id AccountObjectIdentifier) ([]*Parameter, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	database, err := v.catalog.database(id)
+	if err != nil {
+		return nil, err
+	}
+	return database.parameters(ParameterTypeDatabase, AsStringList(AllSchemaParameters)), nil
+}
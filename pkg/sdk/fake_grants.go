@@ -0,0 +1,483 @@
+//go:build fake_client_tests
+
+package sdk
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ Grants = (*fakeGrants)(nil)
+
+// fakeFutureGrant is a future grant together with its scope (a database or a schema).
+type fakeFutureGrant struct {
+	Grant
+	inDatabase AccountObjectIdentifier
+	inSchema   *DatabaseObjectIdentifier
+}
+
+type fakeGrants struct {
+	catalog *FakeCatalog
+}
+
+func (c *FakeCatalog) addGrant(grant Grant) {
+	grant.CreatedOn = time.Now().UTC()
+	grant.GrantedBy = NewAccountObjectIdentifier(c.currentRole)
+	for i, g := range c.grants {
+		if g.Privilege == grant.Privilege && sameGrantTarget(g, grant) {
+			c.grants[i].GrantOption = grant.GrantOption
+			return
+		}
+	}
+	c.grants = append(c.grants, grant)
+}
+
+func (c *FakeCatalog) addFutureGrant(grant fakeFutureGrant) {
+	grant.CreatedOn = time.Now().UTC()
+	grant.Name = grant.placeholderName()
+	for i, g := range c.futureGrants {
+		if g.Privilege == grant.Privilege && g.GrantOn == grant.GrantOn && g.GranteeName.FullyQualifiedName() == grant.GranteeName.FullyQualifiedName() &&
+			g.inDatabase == grant.inDatabase && sameSchemaScope(g.inSchema, grant.inSchema) {
+			c.futureGrants[i].GrantOption = grant.GrantOption
+			return
+		}
+	}
+	c.futureGrants = append(c.futureGrants, grant)
+}
+
+// placeholderName mimics the name Snowflake returns for future grants, e.g. DB.<TABLE> or DB.SCHEMA.<TABLE>.
+func (g fakeFutureGrant) placeholderName() ObjectIdentifier {
+	placeholder := "<" + strings.ReplaceAll(g.GrantOn.String(), " ", "_") + ">"
+	if g.inSchema != nil {
+		return NewSchemaObjectIdentifierInSchema(*g.inSchema, placeholder)
+	}
+	return NewDatabaseObjectIdentifier(g.inDatabase.Name(), placeholder)
+}
+
+func sameGrantTarget(a Grant, b Grant) bool {
+	return a.GrantedOn == b.GrantedOn && a.Name.FullyQualifiedName() == b.Name.FullyQualifiedName() &&
+		a.GrantedTo == b.GrantedTo && a.GranteeName.FullyQualifiedName() == b.GranteeName.FullyQualifiedName()
+}
+
+func sameSchemaScope(a *DatabaseObjectIdentifier, b *DatabaseObjectIdentifier) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.FullyQualifiedName() == b.FullyQualifiedName()
+}
+
+// renameInGrants updates both the grants given to and the grants given on the renamed object.
+func (c *FakeCatalog) renameInGrants(objectType ObjectType, oldId AccountObjectIdentifier, newId AccountObjectIdentifier) {
+	for i, g := range c.grants {
+		if g.GrantedTo == objectType && g.GranteeName.FullyQualifiedName() == oldId.FullyQualifiedName() {
+			c.grants[i].GranteeName = newId
+		}
+		if g.GrantedOn == objectType && g.Name.FullyQualifiedName() == oldId.FullyQualifiedName() {
+			c.grants[i].Name = newId
+		}
+	}
+	for i, g := range c.futureGrants {
+		if g.GrantTo == objectType && g.GranteeName.FullyQualifiedName() == oldId.FullyQualifiedName() {
+			c.futureGrants[i].GranteeName = newId
+		}
+	}
+}
+
+// dropGrantsFor removes both the grants given to and the grants given on the dropped object.
+func (c *FakeCatalog) dropGrantsFor(objectType ObjectType, id AccountObjectIdentifier) {
+	c.grants = collections.Filter(c.grants, func(g Grant) bool {
+		return !(g.GrantedTo == objectType && g.GranteeName.FullyQualifiedName() == id.FullyQualifiedName()) &&
+			!(g.GrantedOn == objectType && g.Name.FullyQualifiedName() == id.FullyQualifiedName())
+	})
+	c.futureGrants = collections.Filter(c.futureGrants, func(g fakeFutureGrant) bool {
+		return !(g.GrantTo == objectType && g.GranteeName.FullyQualifiedName() == id.FullyQualifiedName())
+	})
+}
+
+// fakePrivileges flattens the privileges; ALL PRIVILEGES is not modeled, because it would require knowing every privilege applicable to the object type.
+func (p *AccountRoleGrantPrivileges) fakePrivileges() ([]string, error) {
+	if p.AllPrivileges != nil && *p.AllPrivileges {
+		return nil, fakeErrUnsupported("GRANT ALL PRIVILEGES")
+	}
+	privileges := make([]string, 0)
+	privileges = append(privileges, AsStringList(p.GlobalPrivileges)...)
+	privileges = append(privileges, AsStringList(p.AccountObjectPrivileges)...)
+	privileges = append(privileges, AsStringList(p.SchemaPrivileges)...)
+	privileges = append(privileges, AsStringList(p.SchemaObjectPrivileges)...)
+	return privileges, nil
+}
+
+// fakeGrantTarget is a single object (or a future grant scope) resolved from AccountRoleGrantOn.
+type fakeGrantTarget struct {
+	objectType ObjectType
+	name       ObjectIdentifier
+	future     *fakeFutureGrant
+}
+
+// resolveGrantTargets expands the ON clause into the objects currently present in the catalog.
+func (c *FakeCatalog) resolveGrantTargets(on *AccountRoleGrantOn) ([]fakeGrantTarget, error) {
+	switch {
+	case on.Account != nil && *on.Account:
+		return []fakeGrantTarget{{objectType: ObjectTypeAccount, name: NewAccountObjectIdentifier(FakeAccountLocator)}}, nil
+	case on.AccountObject != nil:
+		o := on.AccountObject
+		for objectType, id := range map[ObjectType]*AccountObjectIdentifier{
+			ObjectTypeUser:             o.User,
+			ObjectTypeResourceMonitor:  o.ResourceMonitor,
+			ObjectTypeWarehouse:        o.Warehouse,
+			ObjectTypeComputePool:      o.ComputePool,
+			ObjectTypeDatabase:         o.Database,
+			ObjectTypeIntegration:      o.Integration,
+			ObjectTypeConnection:       o.Connection,
+			ObjectTypeFailoverGroup:    o.FailoverGroup,
+			ObjectTypeReplicationGroup: o.ReplicationGroup,
+			ObjectTypeExternalVolume:   o.ExternalVolume,
+		} {
+			if id != nil {
+				return []fakeGrantTarget{{objectType: objectType, name: *id}}, nil
+			}
+		}
+	case on.Schema != nil:
+		switch {
+		case on.Schema.Schema != nil:
+			if _, err := c.schema(*on.Schema.Schema); err != nil {
+				return nil, err
+			}
+			return []fakeGrantTarget{{objectType: ObjectTypeSchema, name: *on.Schema.Schema}}, nil
+		case on.Schema.AllSchemasInDatabase != nil:
+			database, err := c.database(*on.Schema.AllSchemasInDatabase)
+			if err != nil {
+				return nil, err
+			}
+			targets := make([]fakeGrantTarget, 0)
+			for _, name := range fakeFilterNames(database.schemas, nil, nil, nil) {
+				if name != "INFORMATION_SCHEMA" {
+					targets = append(targets, fakeGrantTarget{objectType: ObjectTypeSchema, name: NewDatabaseObjectIdentifier(database.name, name)})
+				}
+			}
+			return targets, nil
+		case on.Schema.FutureSchemasInDatabase != nil:
+			return []fakeGrantTarget{{future: &fakeFutureGrant{Grant: Grant{GrantOn: ObjectTypeSchema}, inDatabase: *on.Schema.FutureSchemasInDatabase}}}, nil
+		}
+	case on.SchemaObject != nil:
+		switch {
+		case on.SchemaObject.SchemaObject != nil:
+			return []fakeGrantTarget{{objectType: on.SchemaObject.SchemaObject.ObjectType, name: on.SchemaObject.SchemaObject.Name}}, nil
+		case on.SchemaObject.All != nil:
+			return c.resolveAllSchemaObjects(on.SchemaObject.All)
+		case on.SchemaObject.Future != nil:
+			future := &fakeFutureGrant{Grant: Grant{GrantOn: on.SchemaObject.Future.PluralObjectType.Singular()}, inSchema: on.SchemaObject.Future.InSchema}
+			if on.SchemaObject.Future.InDatabase != nil {
+				future.inDatabase = *on.SchemaObject.Future.InDatabase
+			}
+			if on.SchemaObject.Future.InSchema != nil {
+				future.inDatabase = on.SchemaObject.Future.InSchema.DatabaseId()
+			}
+			return []fakeGrantTarget{{future: future}}, nil
+		}
+	}
+	return nil, errOneOf("AccountRoleGrantOn", "Account", "AccountObject", "Schema", "SchemaObject")
+}
+
+// resolveAllSchemaObjects expands ALL <objects> IN DATABASE|SCHEMA; only tables are stored in the catalog.
+func (c *FakeCatalog) resolveAllSchemaObjects(in *GrantOnSchemaObjectIn) ([]fakeGrantTarget, error) {
+	if in.PluralObjectType != PluralObjectTypeTables {
+		return nil, fakeErrUnsupported("GRANT ON ALL " + string(in.PluralObjectType))
+	}
+	var scope *ExtendedIn
+	switch {
+	case in.InSchema != nil:
+		scope = &ExtendedIn{In: In{Schema: *in.InSchema}}
+	case in.InDatabase != nil:
+		scope = &ExtendedIn{In: In{Database: *in.InDatabase}}
+	}
+	schemaIds, err := c.schemasIn(scope)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]fakeGrantTarget, 0)
+	for _, schemaId := range schemaIds {
+		schema, _ := c.schema(schemaId)
+		for _, name := range fakeFilterNames(schema.tables, nil, nil, nil) {
+			targets = append(targets, fakeGrantTarget{objectType: ObjectTypeTable, name: NewSchemaObjectIdentifierInSchema(schemaId, name)})
+		}
+	}
+	return targets, nil
+}
+
+func (v *fakeGrants) GrantPrivilegesToAccountRole(_ context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *GrantPrivilegesToAccountRoleOptions) error {
+	opts = createIfNil(opts)
+	opts.privileges = privileges
+	opts.on = on
+	opts.accountRole = role
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, err := v.catalog.role(role); err != nil {
+		return err
+	}
+	privilegeNames, err := privileges.fakePrivileges()
+	if err != nil {
+		return err
+	}
+	targets, err := v.catalog.resolveGrantTargets(on)
+	if err != nil {
+		return err
+	}
+	grantOption := opts.WithGrantOption != nil && *opts.WithGrantOption
+	for _, target := range targets {
+		for _, privilege := range privilegeNames {
+			if target.future != nil {
+				future := *target.future
+				future.Privilege = privilege
+				future.GrantTo = ObjectTypeRole
+				future.GranteeName = role
+				future.GrantOption = grantOption
+				v.catalog.addFutureGrant(future)
+				continue
+			}
+			v.catalog.addGrant(Grant{
+				Privilege:   privilege,
+				GrantedOn:   target.objectType,
+				Name:        target.name,
+				GrantedTo:   ObjectTypeRole,
+				GranteeName: role,
+				GrantOption: grantOption,
+			})
+		}
+	}
+	return nil
+}
+
+func (v *fakeGrants) RevokePrivilegesFromAccountRole(_ context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *RevokePrivilegesFromAccountRoleOptions) error {
+	opts = createIfNil(opts)
+	opts.privileges = privileges
+	opts.on = on
+	opts.accountRole = role
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, err := v.catalog.role(role); err != nil {
+		return err
+	}
+	privilegeNames, err := privileges.fakePrivileges()
+	if err != nil {
+		return err
+	}
+	targets, err := v.catalog.resolveGrantTargets(on)
+	if err != nil {
+		return err
+	}
+	grantOptionOnly := opts.GrantOptionFor != nil && *opts.GrantOptionFor
+	for _, target := range targets {
+		for _, privilege := range privilegeNames {
+			if target.future != nil {
+				future := *target.future
+				v.catalog.futureGrants = revokeFrom(v.catalog.futureGrants, grantOptionOnly, func(g fakeFutureGrant) bool {
+					return g.Privilege == privilege && g.GrantOn == future.GrantOn && g.GranteeName.FullyQualifiedName() == role.FullyQualifiedName() &&
+						g.inDatabase == future.inDatabase && sameSchemaScope(g.inSchema, future.inSchema)
+				}, func(g *fakeFutureGrant) { g.GrantOption = false })
+				continue
+			}
+			revoked := Grant{GrantedOn: target.objectType, Name: target.name, GrantedTo: ObjectTypeRole, GranteeName: role}
+			v.catalog.grants = revokeFrom(v.catalog.grants, grantOptionOnly, func(g Grant) bool {
+				return g.Privilege == privilege && sameGrantTarget(g, revoked)
+			}, func(g *Grant) { g.GrantOption = false })
+		}
+	}
+	return nil
+}
+
+// revokeFrom removes the matching grants or, when only the grant option is revoked, clears the grant option on them.
+func revokeFrom[T any](grants []T, grantOptionOnly bool, matches func(T) bool, clearGrantOption func(*T)) []T {
+	if !grantOptionOnly {
+		return collections.Filter(grants, func(g T) bool { return !matches(g) })
+	}
+	for i := range grants {
+		if matches(grants[i]) {
+			clearGrantOption(&grants[i])
+		}
+	}
+	return grants
+}
+
+func (v *fakeGrants) RevokePrivilegesFromAccountRoleSafely(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *RevokePrivilegesFromAccountRoleOptions) error {
+	return SafeRevokePrivileges(func() error {
+		return v.RevokePrivilegesFromAccountRole(ctx, privileges, on, role, opts)
+	})
+}
+
+func (v *fakeGrants) GrantPrivilegesToDatabaseRole(_ context.Context, _ *DatabaseRoleGrantPrivileges, _ *DatabaseRoleGrantOn, _ DatabaseObjectIdentifier, _ *GrantPrivilegesToDatabaseRoleOptions) error {
+	return fakeErrUnsupported("GRANT ... TO DATABASE ROLE")
+}
+
+func (v *fakeGrants) RevokePrivilegesFromDatabaseRole(_ context.Context, _ *DatabaseRoleGrantPrivileges, _ *DatabaseRoleGrantOn, _ DatabaseObjectIdentifier, _ *RevokePrivilegesFromDatabaseRoleOptions) error {
+	return fakeErrUnsupported("REVOKE ... FROM DATABASE ROLE")
+}
+
+func (v *fakeGrants) RevokePrivilegesFromDatabaseRoleSafely(_ context.Context, _ *DatabaseRoleGrantPrivileges, _ *DatabaseRoleGrantOn, _ DatabaseObjectIdentifier, _ *RevokePrivilegesFromDatabaseRoleOptions) error {
+	return fakeErrUnsupported("REVOKE ... FROM DATABASE ROLE")
+}
+
+func (v *fakeGrants) GrantPrivilegeToShare(_ context.Context, _ []ObjectPrivilege, _ *ShareGrantOn, _ AccountObjectIdentifier) error {
+	return fakeErrUnsupported("GRANT ... TO SHARE")
+}
+
+func (v *fakeGrants) RevokePrivilegeFromShare(_ context.Context, _ []ObjectPrivilege, _ *ShareGrantOn, _ AccountObjectIdentifier) error {
+	return fakeErrUnsupported("REVOKE ... FROM SHARE")
+}
+
+func (v *fakeGrants) RevokePrivilegeFromShareSafely(_ context.Context, _ []ObjectPrivilege, _ *ShareGrantOn, _ AccountObjectIdentifier) error {
+	return fakeErrUnsupported("REVOKE ... FROM SHARE")
+}
+
+// GrantOwnership supports only single objects of the types stored in the catalog.
+func (v *fakeGrants) GrantOwnership(_ context.Context, on OwnershipGrantOn, to OwnershipGrantTo, _ *GrantOwnershipOptions) error {
+	if on.Object == nil || to.AccountRoleName == nil {
+		return fakeErrUnsupported("GRANT OWNERSHIP ON ALL|FUTURE or TO DATABASE ROLE")
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, err := v.catalog.role(*to.AccountRoleName); err != nil {
+		return err
+	}
+	object, err := v.catalog.object(on.Object.ObjectType, on.Object.Name)
+	if err != nil {
+		return err
+	}
+	object.owner = to.AccountRoleName.Name()
+	return nil
+}
+
+// object returns the common part of any object stored in the catalog.
+func (c *FakeCatalog) object(objectType ObjectType, id ObjectIdentifier) (*fakeObject, error) {
+	switch objectType {
+	case ObjectTypeDatabase:
+		database, err := c.database(NewAccountObjectIdentifier(id.Name()))
+		if err != nil {
+			return nil, err
+		}
+		return &database.fakeObject, nil
+	case ObjectTypeSchema:
+		schema, err := c.schema(NewDatabaseObjectIdentifierFromFullyQualifiedName(id.FullyQualifiedName()))
+		if err != nil {
+			return nil, err
+		}
+		return &schema.fakeObject, nil
+	case ObjectTypeTable:
+		table, err := c.table(NewSchemaObjectIdentifierFromFullyQualifiedName(id.FullyQualifiedName()))
+		if err != nil {
+			return nil, err
+		}
+		return &table.fakeObject, nil
+	case ObjectTypeWarehouse:
+		warehouse, err := c.warehouse(NewAccountObjectIdentifier(id.Name()))
+		if err != nil {
+			return nil, err
+		}
+		return &warehouse.fakeObject, nil
+	case ObjectTypeRole:
+		role, err := c.role(NewAccountObjectIdentifier(id.Name()))
+		if err != nil {
+			return nil, err
+		}
+		return &role.fakeObject, nil
+	case ObjectTypeUser:
+		user, err := c.user(NewAccountObjectIdentifier(id.Name()))
+		if err != nil {
+			return nil, err
+		}
+		return &user.fakeObject, nil
+	}
+	return nil, fakeErrUnsupported("objects of type " + string(objectType))
+}
+
+// fakeRoleGrantRow returns the role grant the same way as the real client does for SHOW GRANTS TO USER and SHOW GRANTS OF ROLE.
+// Snowflake returns only the created_on, role, granted_to, grantee_name, and granted_by columns for them, and the role column
+// is not mapped to Grant, so the privilege, the granted object type, and the granted role name are empty.
+func fakeRoleGrantRow(g Grant) Grant {
+	return Grant{
+		CreatedOn:   g.CreatedOn,
+		Name:        NewAccountObjectIdentifier(""),
+		GrantedTo:   g.GrantedTo,
+		GranteeName: g.GranteeName,
+		GrantedBy:   g.GrantedBy,
+	}
+}
+
+func (v *fakeGrants) Show(_ context.Context, opts *ShowGrantOptions) ([]Grant, error) {
+	opts = createIfNil(opts)
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if opts.Future != nil && *opts.Future {
+		if opts.To != nil && opts.To.Role.Name() != "" {
+			if _, err := v.catalog.role(opts.To.Role); err != nil {
+				return nil, err
+			}
+			futureGrants := collections.Filter(v.catalog.futureGrants, func(g fakeFutureGrant) bool {
+				return g.GrantTo == ObjectTypeRole && g.GranteeName.FullyQualifiedName() == opts.To.Role.FullyQualifiedName()
+			})
+			return collections.Map(futureGrants, func(g fakeFutureGrant) Grant { return g.Grant }), nil
+		}
+		if opts.In == nil {
+			return nil, fakeErrUnsupported("SHOW FUTURE GRANTS TO")
+		}
+		futureGrants := collections.Filter(v.catalog.futureGrants, func(g fakeFutureGrant) bool {
+			if opts.In.Schema != nil {
+				return sameSchemaScope(g.inSchema, opts.In.Schema)
+			}
+			return g.inSchema == nil && opts.In.Database != nil && g.inDatabase.FullyQualifiedName() == opts.In.Database.FullyQualifiedName()
+		})
+		return collections.Map(futureGrants, func(g fakeFutureGrant) Grant { return g.Grant }), nil
+	}
+
+	var matches func(Grant) bool
+	switch {
+	case opts.On != nil && opts.On.Account != nil && *opts.On.Account:
+		matches = func(g Grant) bool { return g.GrantedOn == ObjectTypeAccount }
+	case opts.On != nil && opts.On.Object != nil:
+		if _, err := v.catalog.object(opts.On.Object.ObjectType, opts.On.Object.Name); err != nil && opts.On.Object.ObjectType != ObjectTypeAccount {
+			return nil, err
+		}
+		matches = func(g Grant) bool {
+			return g.GrantedOn == opts.On.Object.ObjectType && g.Name.FullyQualifiedName() == opts.On.Object.Name.FullyQualifiedName()
+		}
+	case opts.To != nil && opts.To.Role.Name() != "":
+		if _, err := v.catalog.role(opts.To.Role); err != nil {
+			return nil, err
+		}
+		matches = func(g Grant) bool {
+			return g.GrantedTo == ObjectTypeRole && g.GranteeName.FullyQualifiedName() == opts.To.Role.FullyQualifiedName()
+		}
+	case opts.To != nil && opts.To.User.Name() != "":
+		if _, err := v.catalog.user(opts.To.User); err != nil {
+			return nil, err
+		}
+		grants := collections.Filter(v.catalog.grants, func(g Grant) bool {
+			return g.GrantedTo == ObjectTypeUser && g.GranteeName.FullyQualifiedName() == opts.To.User.FullyQualifiedName()
+		})
+		return collections.Map(grants, fakeRoleGrantRow), nil
+	case opts.Of != nil && opts.Of.Role.Name() != "":
+		if _, err := v.catalog.role(opts.Of.Role); err != nil {
+			return nil, err
+		}
+		return collections.Map(v.catalog.roleGrants(opts.Of.Role), fakeRoleGrantRow), nil
+	default:
+		return nil, fakeErrUnsupported("SHOW GRANTS")
+	}
+	return collections.Filter(v.catalog.grants, matches), nil
+}
@@ -0,0 +1,120 @@
+//go:build fake_client_tests
+
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// fakeSetProperties copies every set parameter of the given options struct into properties, using the SQL name of the parameter as a key.
+// It relies on the same ddl tags as the SQL builder: fields tagged as `parameter` or `identifier,equals` are treated as properties,
+// nested structs (e.g. ObjectProperties in users) are traversed recursively.
+func fakeSetProperties(properties map[string]string, opts any) {
+	v := reflect.ValueOf(opts)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		value := v.Field(i)
+		ddlTag := field.Tag.Get("ddl")
+		sqlTag := strings.TrimSpace(strings.TrimSuffix(field.Tag.Get("sql"), "="))
+		isProperty := strings.HasPrefix(ddlTag, "parameter") || strings.HasPrefix(ddlTag, "identifier")
+		switch {
+		case isProperty && sqlTag != "":
+			if s, ok := fakePropertyValue(value); ok {
+				properties[sqlTag] = s
+			}
+		case field.Anonymous || ddlTag == "keyword" || ddlTag == "list" || ddlTag == "":
+			if fakeIsStruct(value) && !fakeIsIdentifier(value) {
+				fakeSetProperties(properties, value.Interface())
+			}
+		}
+	}
+}
+
+// fakeUnsetProperties removes every property marked with a `keyword` boolean field in the given unset options struct.
+func fakeUnsetProperties(properties map[string]string, opts any) {
+	v := reflect.ValueOf(opts)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		value := v.Field(i)
+		sqlTag := field.Tag.Get("sql")
+		switch {
+		case value.Type() == reflect.TypeOf((*bool)(nil)) && sqlTag != "":
+			if !value.IsNil() && value.Elem().Bool() {
+				delete(properties, sqlTag)
+			}
+		case fakeIsStruct(value):
+			fakeUnsetProperties(properties, value.Interface())
+		}
+	}
+}
+
+func fakeIsStruct(v reflect.Value) bool {
+	if v.Kind() == reflect.Pointer {
+		return !v.IsNil() && v.Elem().Kind() == reflect.Struct
+	}
+	return v.Kind() == reflect.Struct
+}
+
+func fakeIsIdentifier(v reflect.Value) bool {
+	_, ok := v.Interface().(ObjectIdentifier)
+	return ok
+}
+
+// fakePropertyValue returns the value in the form it is returned by SHOW and DESCRIBE commands.
+func fakePropertyValue(v reflect.Value) (string, bool) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+	if v.IsZero() && v.Kind() == reflect.Struct {
+		return "", false
+	}
+	switch value := v.Interface().(type) {
+	case AccountObjectIdentifier:
+		return value.Name(), true
+	case ObjectIdentifier:
+		return value.FullyQualifiedName(), true
+	case StringAllowEmpty:
+		return value.Value, true
+	case SecondaryRoles:
+		if value.All != nil && *value.All {
+			return `["ALL"]`, true
+		}
+		return "[]", true
+	case DataType:
+		return string(value), true
+	}
+	if v.Kind() == reflect.Struct {
+		// structs not representable as a single value (e.g. file formats) are not modeled
+		return "", false
+	}
+	return fmt.Sprint(v.Interface()), true
+}
@@ -0,0 +1,241 @@
+//go:build fake_client_tests
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ Roles = (*fakeRoles)(nil)
+
+type fakeRole struct {
+	fakeObject
+}
+
+type fakeRoles struct {
+	client  *Client
+	catalog *FakeCatalog
+}
+
+func (c *FakeCatalog) role(id AccountObjectIdentifier) (*fakeRole, error) {
+	role, ok := c.roles[id.Name()]
+	if !ok {
+		return nil, fakeErrDoesNotExist(ObjectTypeRole, id)
+	}
+	return role, nil
+}
+
+// roleGrants returns the grants of the given role to other roles and users, as returned by SHOW GRANTS OF ROLE.
+func (c *FakeCatalog) roleGrants(id AccountObjectIdentifier) []Grant {
+	return collections.Filter(c.grants, func(g Grant) bool {
+		return g.GrantedOn == ObjectTypeRole && g.Name.FullyQualifiedName() == id.FullyQualifiedName()
+	})
+}
+
+func (v *fakeRoles) Create(_ context.Context, req *CreateRoleRequest) error {
+	opts := req.toOpts()
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, ok := v.catalog.roles[opts.name.Name()]; ok {
+		switch {
+		case opts.IfNotExists != nil && *opts.IfNotExists:
+			return nil
+		case opts.OrReplace == nil || !*opts.OrReplace:
+			return fakeErrAlreadyExists(ObjectTypeRole, opts.name)
+		}
+	}
+	role := &fakeRole{fakeObject: newFakeObject(opts.name.Name(), v.catalog.currentRole)}
+	fakeSetProperties(role.properties, opts)
+	v.catalog.roles[opts.name.Name()] = role
+	return nil
+}
+
+func (v *fakeRoles) Alter(_ context.Context, req *AlterRoleRequest) error {
+	opts := req.toOpts()
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	role, err := v.catalog.role(opts.name)
+	if err != nil {
+		if opts.IfExists != nil && *opts.IfExists {
+			return nil
+		}
+		return err
+	}
+	switch {
+	case opts.RenameTo != nil:
+		if _, ok := v.catalog.roles[opts.RenameTo.Name()]; ok {
+			return fakeErrAlreadyExists(ObjectTypeRole, *opts.RenameTo)
+		}
+		delete(v.catalog.roles, opts.name.Name())
+		role.name = opts.RenameTo.Name()
+		v.catalog.roles[role.name] = role
+		v.catalog.renameInGrants(ObjectTypeRole, opts.name, *opts.RenameTo)
+	case opts.SetComment != nil:
+		role.properties["COMMENT"] = *opts.SetComment
+	case opts.UnsetComment != nil && *opts.UnsetComment:
+		delete(role.properties, "COMMENT")
+	}
+	return nil
+}
+
+func (v *fakeRoles) Drop(_ context.Context, req *DropRoleRequest) error {
+	opts := req.toOpts()
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, err := v.catalog.role(opts.name); err != nil {
+		if opts.IfExists != nil && *opts.IfExists {
+			return nil
+		}
+		return err
+	}
+	delete(v.catalog.roles, opts.name.Name())
+	v.catalog.dropGrantsFor(ObjectTypeRole, opts.name)
+	return nil
+}
+
+func (v *fakeRoles) DropSafely(ctx context.Context, id AccountObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropRoleRequest(id).WithIfExists(true)) }, ctx, id)
+}
+
+func (v *fakeRoles) Show(_ context.Context, req *ShowRoleRequest) ([]Role, error) {
+	opts := req.toOpts()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if opts.InClass != nil {
+		return nil, fakeErrUnsupported("SHOW ROLES IN CLASS")
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	names := fakeFilterNames(v.catalog.roles, opts.Like, nil, nil)
+	return collections.Map(names, func(name string) Role {
+		role := v.catalog.roles[name]
+		id := NewAccountObjectIdentifier(name)
+		grantsOf := v.catalog.roleGrants(id)
+		grantedRoles := collections.Filter(v.catalog.grants, func(g Grant) bool {
+			return g.GrantedOn == ObjectTypeRole && g.GrantedTo == ObjectTypeRole && g.GranteeName.FullyQualifiedName() == id.FullyQualifiedName()
+		})
+		return Role{
+			CreatedOn:       role.createdOn,
+			Name:            name,
+			IsCurrent:       name == v.catalog.currentRole,
+			IsInherited:     false,
+			AssignedToUsers: len(collections.Filter(grantsOf, func(g Grant) bool { return g.GrantedTo == ObjectTypeUser })),
+			GrantedToRoles:  len(collections.Filter(grantsOf, func(g Grant) bool { return g.GrantedTo == ObjectTypeRole })),
+			GrantedRoles:    len(grantedRoles),
+			Owner:           role.owner,
+			Comment:         role.property("COMMENT", ""),
+		}
+	}), nil
+}
+
+func (v *fakeRoles) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Role, error) {
+	roleList, err := v.Show(ctx, NewShowRoleRequest().WithLike(NewLikeRequest(id.Name())))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(roleList, func(r Role) bool { return r.ID().name == id.Name() })
+}
+
+func (v *fakeRoles) ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*Role, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *fakeRoles) Grant(_ context.Context, req *GrantRoleRequest) error {
+	opts := req.toOpts()
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, err := v.catalog.role(opts.name); err != nil {
+		return err
+	}
+	grantedTo, grantee, err := v.catalog.roleGrantee(opts.Grant.Role, opts.Grant.User)
+	if err != nil {
+		return err
+	}
+	v.catalog.addGrant(Grant{
+		Privilege:   "USAGE",
+		GrantedOn:   ObjectTypeRole,
+		Name:        opts.name,
+		GrantedTo:   grantedTo,
+		GranteeName: grantee,
+	})
+	return nil
+}
+
+func (v *fakeRoles) Revoke(_ context.Context, req *RevokeRoleRequest) error {
+	opts := req.toOpts()
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, err := v.catalog.role(opts.name); err != nil {
+		return err
+	}
+	grantedTo, grantee, err := v.catalog.roleGrantee(opts.Revoke.Role, opts.Revoke.User)
+	if err != nil {
+		return err
+	}
+	v.catalog.grants = collections.Filter(v.catalog.grants, func(g Grant) bool {
+		return !(g.GrantedOn == ObjectTypeRole &&
+			g.Name.FullyQualifiedName() == opts.name.FullyQualifiedName() &&
+			g.GrantedTo == grantedTo &&
+			g.GranteeName.FullyQualifiedName() == grantee.FullyQualifiedName())
+	})
+	return nil
+}
+
+func (v *fakeRoles) RevokeSafely(ctx context.Context, req *RevokeRoleRequest) error {
+	return SafeRevokePrivileges(func() error { return v.Revoke(ctx, req) })
+}
+
+func (v *fakeRoles) Use(_ context.Context, req *UseRoleRequest) error {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, err := v.catalog.role(req.id); err != nil {
+		return err
+	}
+	v.catalog.currentRole = req.id.Name()
+	return nil
+}
+
+func (v *fakeRoles) UseSecondary(_ context.Context, _ *UseSecondaryRolesRequest) error {
+	return nil
+}
+
+func (c *FakeCatalog) roleGrantee(role *AccountObjectIdentifier, user *AccountObjectIdentifier) (ObjectType, AccountObjectIdentifier, error) {
+	switch {
+	case role != nil:
+		if _, err := c.role(*role); err != nil {
+			return "", AccountObjectIdentifier{}, err
+		}
+		return ObjectTypeRole, *role, nil
+	case user != nil:
+		if _, err := c.user(*user); err != nil {
+			return "", AccountObjectIdentifier{}, err
+		}
+		return ObjectTypeUser, *user, nil
+	}
+	return "", AccountObjectIdentifier{}, errOneOf("fakeRoles.roleGrantee", "Role", "User")
+}
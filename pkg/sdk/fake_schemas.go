@@ -0,0 +1,289 @@
+//go:build fake_client_tests
+
+package sdk
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ Schemas = (*fakeSchemas)(nil)
+
+type fakeSchema struct {
+	fakeObject
+	transient     bool
+	managedAccess bool
+	tables        map[string]*fakeTable
+	droppedTables map[string]*fakeTable
+}
+
+func newFakeSchema(name string, owner string) *fakeSchema {
+	return &fakeSchema{
+		fakeObject:    newFakeObject(name, owner),
+		tables:        make(map[string]*fakeTable),
+		droppedTables: make(map[string]*fakeTable),
+	}
+}
+
+func (c *FakeCatalog) schema(id DatabaseObjectIdentifier) (*fakeSchema, error) {
+	database, err := c.database(id.DatabaseId())
+	if err != nil {
+		return nil, err
+	}
+	schema, ok := database.schemas[id.Name()]
+	if !ok {
+		return nil, fakeErrDoesNotExist(ObjectTypeSchema, id)
+	}
+	return schema, nil
+}
+
+type fakeSchemas struct {
+	client  *Client
+	catalog *FakeCatalog
+}
+
+func (v *fakeSchemas) Create(_ context.Context, id DatabaseObjectIdentifier, opts *CreateSchemaOptions) error {
+	if opts == nil {
+		opts = &CreateSchemaOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	if opts.Clone != nil {
+		return fakeErrUnsupported("CREATE SCHEMA ... CLONE")
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	database, err := v.catalog.database(id.DatabaseId())
+	if err != nil {
+		return err
+	}
+	if _, ok := database.schemas[id.Name()]; ok {
+		switch {
+		case opts.IfNotExists != nil && *opts.IfNotExists:
+			return nil
+		case opts.OrReplace == nil || !*opts.OrReplace:
+			return fakeErrAlreadyExists(ObjectTypeSchema, id)
+		}
+	}
+	schema := newFakeSchema(id.Name(), v.catalog.currentRole)
+	schema.transient = opts.Transient != nil && *opts.Transient
+	schema.managedAccess = opts.WithManagedAccess != nil && *opts.WithManagedAccess
+	fakeSetProperties(schema.properties, opts)
+	database.schemas[id.Name()] = schema
+	return nil
+}
+
+func (v *fakeSchemas) Alter(_ context.Context, id DatabaseObjectIdentifier, opts *AlterSchemaOptions) error {
+	if opts == nil {
+		opts = &AlterSchemaOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	schema, err := v.catalog.schema(id)
+	if err != nil {
+		if opts.IfExists != nil && *opts.IfExists {
+			return nil
+		}
+		return err
+	}
+	switch {
+	case opts.NewName != nil:
+		target, err := v.catalog.database(opts.NewName.DatabaseId())
+		if err != nil {
+			return err
+		}
+		if _, ok := target.schemas[opts.NewName.Name()]; ok {
+			return fakeErrAlreadyExists(ObjectTypeSchema, *opts.NewName)
+		}
+		delete(v.catalog.databases[id.DatabaseName()].schemas, id.Name())
+		schema.name = opts.NewName.Name()
+		target.schemas[schema.name] = schema
+	case opts.SwapWith != nil:
+		other, err := v.catalog.schema(*opts.SwapWith)
+		if err != nil {
+			return err
+		}
+		database, otherDatabase := v.catalog.databases[id.DatabaseName()], v.catalog.databases[opts.SwapWith.DatabaseName()]
+		schema.name, other.name = other.name, schema.name
+		delete(database.schemas, id.Name())
+		delete(otherDatabase.schemas, opts.SwapWith.Name())
+		database.schemas[other.name] = other
+		otherDatabase.schemas[schema.name] = schema
+	case opts.Set != nil:
+		fakeSetProperties(schema.properties, opts.Set)
+	case opts.Unset != nil:
+		fakeUnsetProperties(schema.properties, opts.Unset)
+	case opts.EnableManagedAccess != nil && *opts.EnableManagedAccess:
+		schema.managedAccess = true
+	case opts.DisableManagedAccess != nil && *opts.DisableManagedAccess:
+		schema.managedAccess = false
+	}
+	return nil
+}
+
+func (v *fakeSchemas) Drop(_ context.Context, id DatabaseObjectIdentifier, opts *DropSchemaOptions) error {
+	if opts == nil {
+		opts = &DropSchemaOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	schema, err := v.catalog.schema(id)
+	if err != nil {
+		if opts.IfExists != nil && *opts.IfExists {
+			return nil
+		}
+		return err
+	}
+	database := v.catalog.databases[id.DatabaseName()]
+	delete(database.schemas, id.Name())
+	database.droppedSchemas[id.Name()] = schema
+	return nil
+}
+
+func (v *fakeSchemas) DropSafely(ctx context.Context, id DatabaseObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, id, &DropSchemaOptions{IfExists: Bool(true)}) }, ctx, id)
+}
+
+func (v *fakeSchemas) Undrop(_ context.Context, id DatabaseObjectIdentifier) error {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	database, err := v.catalog.database(id.DatabaseId())
+	if err != nil {
+		return err
+	}
+	schema, ok := database.droppedSchemas[id.Name()]
+	if !ok {
+		return fakeErrDoesNotExist(ObjectTypeSchema, id)
+	}
+	if _, ok := database.schemas[id.Name()]; ok {
+		return fakeErrAlreadyExists(ObjectTypeSchema, id)
+	}
+	delete(database.droppedSchemas, id.Name())
+	database.schemas[id.Name()] = schema
+	return nil
+}
+
+func (v *fakeSchemas) Describe(_ context.Context, id DatabaseObjectIdentifier) ([]SchemaDetails, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	schema, err := v.catalog.schema(id)
+	if err != nil {
+		return nil, err
+	}
+	names := fakeFilterNames(schema.tables, nil, nil, nil)
+	return collections.Map(names, func(name string) SchemaDetails {
+		return SchemaDetails{CreatedOn: schema.tables[name].createdOn, Name: name, Kind: string(ObjectTypeTable)}
+	}), nil
+}
+
+func (v *fakeSchemas) Show(_ context.Context, opts *ShowSchemaOptions) ([]Schema, error) {
+	opts = createIfNil(opts)
+	if err := fakeValidate(opts); err != nil {
+		return nil, err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	databaseNames := fakeFilterNames(v.catalog.databases, nil, nil, nil)
+	if opts.In != nil && opts.In.Database != nil && *opts.In.Database {
+		if _, err := v.catalog.database(opts.In.Name); err != nil {
+			return nil, err
+		}
+		databaseNames = []string{opts.In.Name.Name()}
+	}
+	schemas := make([]Schema, 0)
+	for _, databaseName := range databaseNames {
+		database := v.catalog.databases[databaseName]
+		for _, name := range fakeFilterNames(database.schemas, opts.Like, opts.StartsWith, nil) {
+			schemas = append(schemas, database.schemas[name].toSchema(databaseName, databaseName == v.catalog.currentDatabase && name == v.catalog.currentSchema))
+		}
+	}
+	if opts.LimitFrom != nil && opts.LimitFrom.Rows != nil && len(schemas) > *opts.LimitFrom.Rows {
+		schemas = schemas[:*opts.LimitFrom.Rows]
+	}
+	return schemas, nil
+}
+
+func (s *fakeSchema) toSchema(databaseName string, isCurrent bool) Schema {
+	schema := Schema{
+		CreatedOn:     s.createdOn,
+		Name:          s.name,
+		IsCurrent:     isCurrent,
+		DatabaseName:  databaseName,
+		Owner:         s.owner,
+		Comment:       s.property("COMMENT", ""),
+		RetentionTime: s.property(string(ObjectParameterDataRetentionTimeInDays), "1"),
+		OwnerRoleType: "ROLE",
+	}
+	options := make([]string, 0)
+	if s.transient {
+		options = append(options, "TRANSIENT")
+	}
+	if s.managedAccess {
+		options = append(options, "MANAGED ACCESS")
+	}
+	if len(options) > 0 {
+		schema.Options = String(strings.Join(options, ", "))
+	}
+	return schema
+}
+
+func (v *fakeSchemas) ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*Schema, error) {
+	schemas, err := v.Show(ctx, &ShowSchemaOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &SchemaIn{
+			Database: Bool(true),
+			Name:     id.DatabaseId(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(schemas, func(r Schema) bool { return r.Name == id.Name() })
+}
+
+func (v *fakeSchemas) ShowByIDSafely(ctx context.Context, id DatabaseObjectIdentifier) (*Schema, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *fakeSchemas) Use(_ context.Context, id DatabaseObjectIdentifier) error {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, err := v.catalog.schema(id); err != nil {
+		return err
+	}
+	v.catalog.currentDatabase = id.DatabaseName()
+	v.catalog.currentSchema = id.Name()
+	return nil
+}
+
+func (v *fakeSchemas) ShowParameters(_ context.Context, id DatabaseObjectIdentifier) ([]*Parameter, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	schema, err := v.catalog.schema(id)
+	if err != nil {
+		return nil, err
+	}
+	return schema.parameters(ParameterTypeSchema, AsStringList(AllSchemaParameters)), nil
+}
@@ -0,0 +1,408 @@
+//go:build fake_client_tests
+
+package sdk
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ Tables = (*fakeTables)(nil)
+
+type fakeTable struct {
+	fakeObject
+	kind               string
+	columns            []fakeColumn
+	clusterBy          []string
+	searchOptimization bool
+}
+
+type fakeColumn struct {
+	name         string
+	dataType     DataType
+	nullable     bool
+	defaultValue *string
+	comment      *string
+	collation    *string
+}
+
+type fakeTables struct {
+	client  *Client
+	catalog *FakeCatalog
+}
+
+func (c *FakeCatalog) table(id SchemaObjectIdentifier) (*fakeTable, error) {
+	schema, err := c.schema(id.SchemaId())
+	if err != nil {
+		return nil, err
+	}
+	table, ok := schema.tables[id.Name()]
+	if !ok {
+		return nil, fakeErrDoesNotExist(ObjectTypeTable, id)
+	}
+	return table, nil
+}
+
+// fakeColumnName resolves the column name the same way Snowflake does: quoted names are kept as they are, unquoted names are uppercased.
+func fakeColumnName(name string) string {
+	if len(name) > 1 && strings.HasPrefix(name, `"`) && strings.HasSuffix(name, `"`) {
+		return strings.ReplaceAll(name[1:len(name)-1], `""`, `"`)
+	}
+	return strings.ToUpper(name)
+}
+
+func (t *fakeTable) columnIndex(name string) int {
+	return slices.IndexFunc(t.columns, func(c fakeColumn) bool { return c.name == fakeColumnName(name) })
+}
+
+func (v *fakeTables) Create(_ context.Context, req *CreateTableRequest) error {
+	opts := req.toOpts()
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	schema, err := v.catalog.schema(opts.name.SchemaId())
+	if err != nil {
+		return err
+	}
+	if _, ok := schema.tables[opts.name.Name()]; ok {
+		switch {
+		case opts.IfNotExists != nil && *opts.IfNotExists:
+			return nil
+		case opts.OrReplace == nil || !*opts.OrReplace:
+			return fakeErrAlreadyExists(ObjectTypeTable, opts.name)
+		}
+	}
+	table := &fakeTable{
+		fakeObject: newFakeObject(opts.name.Name(), v.catalog.currentRole),
+		kind:       "TABLE",
+		clusterBy:  opts.ClusterBy,
+	}
+	if opts.Kind != nil {
+		table.kind = string(*opts.Kind)
+	}
+	if table.kind == "TABLE" && schema.transient {
+		table.kind = string(TransientTableKind)
+	}
+	for _, column := range opts.ColumnsAndConstraints.Columns {
+		c := fakeColumn{
+			name:      fakeColumnName(column.Name),
+			dataType:  column.Type,
+			nullable:  column.NotNull == nil || !*column.NotNull,
+			comment:   column.Comment,
+			collation: column.Collate,
+		}
+		if column.DefaultValue != nil {
+			c.defaultValue = column.DefaultValue.Expression
+		}
+		table.columns = append(table.columns, c)
+	}
+	fakeSetProperties(table.properties, opts)
+	schema.tables[opts.name.Name()] = table
+	return nil
+}
+
+func (v *fakeTables) CreateAsSelect(_ context.Context, _ *CreateTableAsSelectRequest) error {
+	return fakeErrUnsupported("CREATE TABLE ... AS SELECT")
+}
+
+func (v *fakeTables) CreateUsingTemplate(_ context.Context, _ *CreateTableUsingTemplateRequest) error {
+	return fakeErrUnsupported("CREATE TABLE ... USING TEMPLATE")
+}
+
+func (v *fakeTables) CreateLike(_ context.Context, _ *CreateTableLikeRequest) error {
+	return fakeErrUnsupported("CREATE TABLE ... LIKE")
+}
+
+func (v *fakeTables) CreateClone(_ context.Context, _ *CreateTableCloneRequest) error {
+	return fakeErrUnsupported("CREATE TABLE ... CLONE")
+}
+
+func (v *fakeTables) Alter(_ context.Context, req *AlterTableRequest) error {
+	opts := req.toOpts()
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	table, err := v.catalog.table(opts.name)
+	if err != nil {
+		if opts.IfExists != nil && *opts.IfExists {
+			return nil
+		}
+		return err
+	}
+	schema, _ := v.catalog.schema(opts.name.SchemaId())
+	switch {
+	case opts.NewName != nil:
+		target, err := v.catalog.schema(opts.NewName.SchemaId())
+		if err != nil {
+			return err
+		}
+		if _, ok := target.tables[opts.NewName.Name()]; ok {
+			return fakeErrAlreadyExists(ObjectTypeTable, *opts.NewName)
+		}
+		delete(schema.tables, opts.name.Name())
+		table.name = opts.NewName.Name()
+		target.tables[table.name] = table
+	case opts.SwapWith != nil:
+		other, err := v.catalog.table(*opts.SwapWith)
+		if err != nil {
+			return err
+		}
+		otherSchema, _ := v.catalog.schema(opts.SwapWith.SchemaId())
+		table.name, other.name = other.name, table.name
+		schema.tables[other.name] = other
+		otherSchema.tables[table.name] = table
+	case opts.ClusteringAction != nil:
+		switch {
+		case len(opts.ClusteringAction.ClusterBy) > 0:
+			table.clusterBy = opts.ClusteringAction.ClusterBy
+		case opts.ClusteringAction.DropClusteringKey != nil && *opts.ClusteringAction.DropClusteringKey:
+			table.clusterBy = nil
+		}
+	case opts.ColumnAction != nil:
+		return table.alterColumns(opts.name, opts.ColumnAction)
+	case opts.SearchOptimizationAction != nil:
+		switch {
+		case opts.SearchOptimizationAction.Add != nil:
+			table.searchOptimization = true
+		case opts.SearchOptimizationAction.Drop != nil:
+			table.searchOptimization = false
+		}
+	case opts.Set != nil:
+		fakeSetProperties(table.properties, opts.Set)
+	case opts.Unset != nil:
+		fakeUnsetProperties(table.properties, opts.Unset)
+	default:
+		return fakeErrUnsupported("ALTER TABLE")
+	}
+	return nil
+}
+
+func (t *fakeTable) alterColumns(id SchemaObjectIdentifier, action *TableColumnAction) error {
+	switch {
+	case action.Add != nil:
+		if t.columnIndex(action.Add.Name) >= 0 {
+			if action.Add.IfNotExists != nil && *action.Add.IfNotExists {
+				return nil
+			}
+			return fakeErrAlreadyExists(ObjectTypeColumn, NewTableColumnIdentifier(id.DatabaseName(), id.SchemaName(), id.Name(), action.Add.Name))
+		}
+		c := fakeColumn{
+			name:      fakeColumnName(action.Add.Name),
+			dataType:  action.Add.Type,
+			nullable:  action.Add.InlineConstraint == nil || action.Add.InlineConstraint.NotNull == nil || !*action.Add.InlineConstraint.NotNull,
+			comment:   action.Add.Comment,
+			collation: action.Add.Collate,
+		}
+		if action.Add.DefaultValue != nil {
+			c.defaultValue = action.Add.DefaultValue.Expression
+		}
+		t.columns = append(t.columns, c)
+	case action.Rename != nil:
+		i := t.columnIndex(action.Rename.OldName)
+		if i < 0 {
+			return fakeErrDoesNotExist(ObjectTypeColumn, NewTableColumnIdentifier(id.DatabaseName(), id.SchemaName(), id.Name(), action.Rename.OldName))
+		}
+		t.columns[i].name = fakeColumnName(action.Rename.NewName)
+	case len(action.Alter) > 0:
+		for _, alter := range action.Alter {
+			i := t.columnIndex(alter.Name)
+			if i < 0 {
+				return fakeErrDoesNotExist(ObjectTypeColumn, NewTableColumnIdentifier(id.DatabaseName(), id.SchemaName(), id.Name(), alter.Name))
+			}
+			c := &t.columns[i]
+			if alter.Type != nil {
+				c.dataType = *alter.Type
+			}
+			if alter.Comment != nil {
+				c.comment = alter.Comment
+			}
+			if alter.UnsetComment != nil && *alter.UnsetComment {
+				c.comment = nil
+			}
+			if alter.DropDefault != nil && *alter.DropDefault {
+				c.defaultValue = nil
+			}
+			if alter.NotNullConstraint != nil {
+				c.nullable = alter.NotNullConstraint.Drop != nil && *alter.NotNullConstraint.Drop
+			}
+		}
+	case action.DropColumns != nil:
+		t.columns = slices.DeleteFunc(t.columns, func(c fakeColumn) bool {
+			return slices.ContainsFunc(action.DropColumns.Columns, func(name string) bool { return c.name == fakeColumnName(name) })
+		})
+	default:
+		return fakeErrUnsupported("ALTER TABLE ... ALTER COLUMN")
+	}
+	return nil
+}
+
+func (v *fakeTables) Drop(_ context.Context, req *DropTableRequest) error {
+	opts := req.toOpts()
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	table, err := v.catalog.table(opts.name)
+	if err != nil {
+		if opts.IfExists != nil && *opts.IfExists {
+			return nil
+		}
+		return err
+	}
+	schema, _ := v.catalog.schema(opts.name.SchemaId())
+	delete(schema.tables, opts.name.Name())
+	schema.droppedTables[opts.name.Name()] = table
+	return nil
+}
+
+func (v *fakeTables) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropTableRequest(id).WithIfExists(Bool(true))) }, ctx, id)
+}
+
+func (v *fakeTables) Undrop(_ context.Context, req *UndropTableRequest) error {
+	opts := req.toOpts()
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	schema, err := v.catalog.schema(opts.name.SchemaId())
+	if err != nil {
+		return err
+	}
+	table, ok := schema.droppedTables[opts.name.Name()]
+	if !ok {
+		return fakeErrDoesNotExist(ObjectTypeTable, opts.name)
+	}
+	if _, ok := schema.tables[opts.name.Name()]; ok {
+		return fakeErrAlreadyExists(ObjectTypeTable, opts.name)
+	}
+	delete(schema.droppedTables, opts.name.Name())
+	schema.tables[opts.name.Name()] = table
+	return nil
+}
+
+func (v *fakeTables) Show(_ context.Context, req *ShowTableRequest) ([]Table, error) {
+	opts := req.toOpts()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	schemaIds, err := v.catalog.schemasIn(opts.In)
+	if err != nil {
+		return nil, err
+	}
+	tables := make([]Table, 0)
+	for _, schemaId := range schemaIds {
+		schema, _ := v.catalog.schema(schemaId)
+		for _, name := range fakeFilterNames(schema.tables, opts.Like, opts.StartsWith, nil) {
+			tables = append(tables, schema.tables[name].toTable(schemaId))
+		}
+	}
+	if opts.LimitFrom != nil && opts.LimitFrom.Rows != nil && len(tables) > *opts.LimitFrom.Rows {
+		tables = tables[:*opts.LimitFrom.Rows]
+	}
+	return tables, nil
+}
+
+// schemasIn lists the schemas in the given scope (account by default) in a deterministic order.
+func (c *FakeCatalog) schemasIn(in *ExtendedIn) ([]DatabaseObjectIdentifier, error) {
+	switch {
+	case in != nil && in.Schema.Name() != "":
+		if _, err := c.schema(in.Schema); err != nil {
+			return nil, err
+		}
+		return []DatabaseObjectIdentifier{in.Schema}, nil
+	case in != nil && in.Database.Name() != "":
+		database, err := c.database(in.Database)
+		if err != nil {
+			return nil, err
+		}
+		return collections.Map(fakeFilterNames(database.schemas, nil, nil, nil), func(name string) DatabaseObjectIdentifier {
+			return NewDatabaseObjectIdentifier(database.name, name)
+		}), nil
+	}
+	schemaIds := make([]DatabaseObjectIdentifier, 0)
+	for _, databaseName := range fakeFilterNames(c.databases, nil, nil, nil) {
+		for _, name := range fakeFilterNames(c.databases[databaseName].schemas, nil, nil, nil) {
+			schemaIds = append(schemaIds, NewDatabaseObjectIdentifier(databaseName, name))
+		}
+	}
+	return schemaIds, nil
+}
+
+func (t *fakeTable) toTable(schemaId DatabaseObjectIdentifier) Table {
+	table := Table{
+		CreatedOn:             t.createdOn.Format(time.RFC3339),
+		Name:                  t.name,
+		DatabaseName:          schemaId.DatabaseName(),
+		SchemaName:            schemaId.Name(),
+		Kind:                  t.kind,
+		Comment:               t.property("COMMENT", ""),
+		Owner:                 t.owner,
+		RetentionTime:         t.intProperty(string(ObjectParameterDataRetentionTimeInDays), 1),
+		ChangeTracking:        t.boolProperty("CHANGE_TRACKING", false),
+		SearchOptimization:    t.searchOptimization,
+		EnableSchemaEvolution: t.boolProperty("ENABLE_SCHEMA_EVOLUTION", false),
+		OwnerRoleType:         "ROLE",
+	}
+	if len(t.clusterBy) > 0 {
+		table.ClusterBy = "LINEAR(" + strings.Join(t.clusterBy, ", ") + ")"
+		table.AutomaticClustering = true
+	}
+	return table
+}
+
+func (v *fakeTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Table, error) {
+	request := NewShowTableRequest().WithIn(ExtendedIn{In: In{Schema: id.SchemaId()}}).
+		WithLike(Like{Pattern: String(id.Name())})
+	returnedTables, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(returnedTables, func(r Table) bool { return r.Name == id.Name() })
+}
+
+func (v *fakeTables) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*Table, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *fakeTables) DescribeColumns(_ context.Context, req *DescribeTableColumnsRequest) ([]TableColumnDetails, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	table, err := v.catalog.table(req.id)
+	if err != nil {
+		return nil, err
+	}
+	return collections.Map(table.columns, func(c fakeColumn) TableColumnDetails {
+		return TableColumnDetails{
+			Name:       c.name,
+			Type:       c.dataType,
+			Kind:       "COLUMN",
+			IsNullable: c.nullable,
+			Default:    c.defaultValue,
+			Comment:    c.comment,
+			Collation:  c.collation,
+		}
+	}), nil
+}
+
+func (v *fakeTables) DescribeStage(_ context.Context, _ *DescribeTableStageRequest) ([]TableStageDetails, error) {
+	return nil, fakeErrUnsupported("DESCRIBE TABLE ... TYPE = STAGE")
+}
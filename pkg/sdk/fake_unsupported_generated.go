@@ -0,0 +1,4500 @@
+//go:build fake_client_tests
+
+// Code generated by fake client generator; DO NOT EDIT.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+)
+
+// setFakeUnsupportedInterfaces sets all the object interfaces of the client to the stubs returning ErrFakeUnsupported.
+func setFakeUnsupportedInterfaces(client *Client) {
+	client.ContextFunctions = fakeUnsupportedContextFunctions{}
+	client.SystemFunctions = fakeUnsupportedSystemFunctions{}
+	client.ReplicationFunctions = fakeUnsupportedReplicationFunctions{}
+	client.Accounts = fakeUnsupportedAccounts{}
+	client.AggregationPolicies = fakeUnsupportedAggregationPolicies{}
+	client.Alerts = fakeUnsupportedAlerts{}
+	client.ApiIntegrations = fakeUnsupportedApiIntegrations{}
+	client.ApplicationPackages = fakeUnsupportedApplicationPackages{}
+	client.ApplicationRoles = fakeUnsupportedApplicationRoles{}
+	client.Applications = fakeUnsupportedApplications{}
+	client.AuthenticationPolicies = fakeUnsupportedAuthenticationPolicies{}
+	client.BackupPolicies = fakeUnsupportedBackupPolicies{}
+	client.BackupSets = fakeUnsupportedBackupSets{}
+	client.Budgets = fakeUnsupportedBudgets{}
+	client.CatalogIntegrations = fakeUnsupportedCatalogIntegrations{}
+	client.ClassificationProfiles = fakeUnsupportedClassificationProfiles{}
+	client.Comments = fakeUnsupportedComments{}
+	client.ComputePools = fakeUnsupportedComputePools{}
+	client.Connections = fakeUnsupportedConnections{}
+	client.CortexAgents = fakeUnsupportedCortexAgents{}
+	client.CortexSearchServices = fakeUnsupportedCortexSearchServices{}
+	client.DatabaseRoles = fakeUnsupportedDatabaseRoles{}
+	client.Databases = fakeUnsupportedDatabases{}
+	client.DataMetricFunctionReferences = fakeUnsupportedDataMetricFunctionReferences{}
+	client.DbtProjects = fakeUnsupportedDbtProjects{}
+	client.DynamicTables = fakeUnsupportedDynamicTables{}
+	client.ExternalAccessIntegrations = fakeUnsupportedExternalAccessIntegrations{}
+	client.ExternalFunctions = fakeUnsupportedExternalFunctions{}
+	client.ExternalVolumes = fakeUnsupportedExternalVolumes{}
+	client.ExternalTables = fakeUnsupportedExternalTables{}
+	client.EventTables = fakeUnsupportedEventTables{}
+	client.FailoverGroups = fakeUnsupportedFailoverGroups{}
+	client.FileFormats = fakeUnsupportedLegacyFileFormats{}
+	client.Functions = fakeUnsupportedFunctions{}
+	client.GitRepositories = fakeUnsupportedGitRepositories{}
+	client.Grants = fakeUnsupportedGrants{}
+	client.HybridTables = fakeUnsupportedHybridTables{}
+	client.ImageRepositories = fakeUnsupportedImageRepositories{}
+	client.JoinPolicies = fakeUnsupportedJoinPolicies{}
+	client.Listings = fakeUnsupportedListings{}
+	client.ManagedAccounts = fakeUnsupportedManagedAccounts{}
+	client.MaskingPolicies = fakeUnsupportedMaskingPolicies{}
+	client.MaterializedViews = fakeUnsupportedMaterializedViews{}
+	client.NetworkPolicies = fakeUnsupportedNetworkPolicies{}
+	client.NetworkRules = fakeUnsupportedNetworkRules{}
+	client.Notebooks = fakeUnsupportedNotebooks{}
+	client.NotificationIntegrations = fakeUnsupportedNotificationIntegrations{}
+	client.OpenflowConnectors = fakeUnsupportedOpenflowConnectors{}
+	client.OpenflowDeployments = fakeUnsupportedOpenflowDeployments{}
+	client.OpenflowRuntimes = fakeUnsupportedOpenflowRuntimes{}
+	client.OrganizationAccounts = fakeUnsupportedOrganizationAccounts{}
+	client.Parameters = fakeUnsupportedParameters{}
+	client.PackagesPolicies = fakeUnsupportedPackagesPolicies{}
+	client.PasswordPolicies = fakeUnsupportedPasswordPolicies{}
+	client.Pipes = fakeUnsupportedPipes{}
+	client.PolicyReferences = fakeUnsupportedPolicyReferences{}
+	client.PostgresInstances = fakeUnsupportedPostgresInstances{}
+	client.PrivacyPolicies = fakeUnsupportedPrivacyPolicies{}
+	client.Procedures = fakeUnsupportedProcedures{}
+	client.ProjectionPolicies = fakeUnsupportedProjectionPolicies{}
+	client.ResourceMonitors = fakeUnsupportedResourceMonitors{}
+	client.Roles = fakeUnsupportedRoles{}
+	client.RowAccessPolicies = fakeUnsupportedRowAccessPolicies{}
+	client.Schemas = fakeUnsupportedSchemas{}
+	client.Secrets = fakeUnsupportedSecrets{}
+	client.SecurityIntegrations = fakeUnsupportedSecurityIntegrations{}
+	client.SemanticViews = fakeUnsupportedSemanticViews{}
+	client.Services = fakeUnsupportedServices{}
+	client.Sequences = fakeUnsupportedSequences{}
+	client.SessionPolicies = fakeUnsupportedSessionPolicies{}
+	client.Sessions = fakeUnsupportedSessions{}
+	client.Shares = fakeUnsupportedShares{}
+	client.Snapshots = fakeUnsupportedSnapshots{}
+	client.Stages = fakeUnsupportedStages{}
+	client.StorageIntegrations = fakeUnsupportedStorageIntegrations{}
+	client.StorageLifecyclePolicies = fakeUnsupportedStorageLifecyclePolicies{}
+	client.Streamlits = fakeUnsupportedStreamlits{}
+	client.Streams = fakeUnsupportedStreams{}
+	client.Tables = fakeUnsupportedTables{}
+	client.TagReferences = fakeUnsupportedTagReferences{}
+	client.Tags = fakeUnsupportedTags{}
+	client.Tasks = fakeUnsupportedTasks{}
+	client.Users = fakeUnsupportedUsers{}
+	client.UserProgrammaticAccessTokens = fakeUnsupportedUserProgrammaticAccessTokens{}
+	client.Views = fakeUnsupportedViews{}
+	client.Warehouses = fakeUnsupportedWarehouses{}
+}
+
+type fakeUnsupportedContextFunctions struct{}
+
+var _ ContextFunctions = fakeUnsupportedContextFunctions{}
+
+func (fakeUnsupportedContextFunctions) CurrentAccount(_ context.Context) (_ string, err error) {
+	err = fakeErrUnsupported("ContextFunctions.CurrentAccount")
+	return
+}
+
+func (fakeUnsupportedContextFunctions) CurrentAccountName(_ context.Context) (_ string, err error) {
+	err = fakeErrUnsupported("ContextFunctions.CurrentAccountName")
+	return
+}
+
+func (fakeUnsupportedContextFunctions) CurrentDatabase(_ context.Context) (_ string, err error) {
+	err = fakeErrUnsupported("ContextFunctions.CurrentDatabase")
+	return
+}
+
+func (fakeUnsupportedContextFunctions) CurrentOrganizationName(_ context.Context) (_ string, err error) {
+	err = fakeErrUnsupported("ContextFunctions.CurrentOrganizationName")
+	return
+}
+
+func (fakeUnsupportedContextFunctions) CurrentRegion(_ context.Context) (_ string, err error) {
+	err = fakeErrUnsupported("ContextFunctions.CurrentRegion")
+	return
+}
+
+func (fakeUnsupportedContextFunctions) CurrentRole(_ context.Context) (_ AccountObjectIdentifier, err error) {
+	err = fakeErrUnsupported("ContextFunctions.CurrentRole")
+	return
+}
+
+func (fakeUnsupportedContextFunctions) CurrentSchema(_ context.Context) (_ string, err error) {
+	err = fakeErrUnsupported("ContextFunctions.CurrentSchema")
+	return
+}
+
+func (fakeUnsupportedContextFunctions) CurrentSecondaryRoles(_ context.Context) (_ *CurrentSecondaryRoles, err error) {
+	err = fakeErrUnsupported("ContextFunctions.CurrentSecondaryRoles")
+	return
+}
+
+func (fakeUnsupportedContextFunctions) CurrentSession(_ context.Context) (_ string, err error) {
+	err = fakeErrUnsupported("ContextFunctions.CurrentSession")
+	return
+}
+
+func (fakeUnsupportedContextFunctions) CurrentSessionDetails(_ context.Context) (_ *CurrentSessionDetails, err error) {
+	err = fakeErrUnsupported("ContextFunctions.CurrentSessionDetails")
+	return
+}
+
+func (fakeUnsupportedContextFunctions) CurrentUser(_ context.Context) (_ AccountObjectIdentifier, err error) {
+	err = fakeErrUnsupported("ContextFunctions.CurrentUser")
+	return
+}
+
+func (fakeUnsupportedContextFunctions) CurrentWarehouse(_ context.Context) (_ string, err error) {
+	err = fakeErrUnsupported("ContextFunctions.CurrentWarehouse")
+	return
+}
+
+func (fakeUnsupportedContextFunctions) IsRoleInSession(_ context.Context, _ AccountObjectIdentifier) (_ bool, err error) {
+	err = fakeErrUnsupported("ContextFunctions.IsRoleInSession")
+	return
+}
+
+func (fakeUnsupportedContextFunctions) LastQueryId(_ context.Context) (_ string, err error) {
+	err = fakeErrUnsupported("ContextFunctions.LastQueryId")
+	return
+}
+
+type fakeUnsupportedSystemFunctions struct{}
+
+var _ SystemFunctions = fakeUnsupportedSystemFunctions{}
+
+func (fakeUnsupportedSystemFunctions) AuthorizePrivatelink(_ context.Context, _ string, _ string) (err error) {
+	err = fakeErrUnsupported("SystemFunctions.AuthorizePrivatelink")
+	return
+}
+
+func (fakeUnsupportedSystemFunctions) BehaviorChangeBundleStatus(_ context.Context, _ string) (_ BehaviorChangeBundleStatus, err error) {
+	err = fakeErrUnsupported("SystemFunctions.BehaviorChangeBundleStatus")
+	return
+}
+
+func (fakeUnsupportedSystemFunctions) DeprovisionPrivatelinkEndpoint(_ context.Context, _ string) (err error) {
+	err = fakeErrUnsupported("SystemFunctions.DeprovisionPrivatelinkEndpoint")
+	return
+}
+
+func (fakeUnsupportedSystemFunctions) DisableBehaviorChangeBundle(_ context.Context, _ string) (err error) {
+	err = fakeErrUnsupported("SystemFunctions.DisableBehaviorChangeBundle")
+	return
+}
+
+func (fakeUnsupportedSystemFunctions) EnableBehaviorChangeBundle(_ context.Context, _ string) (err error) {
+	err = fakeErrUnsupported("SystemFunctions.EnableBehaviorChangeBundle")
+	return
+}
+
+func (fakeUnsupportedSystemFunctions) GetPrivatelinkAuthorizedEndpoints(_ context.Context) (_ []PrivatelinkAuthorizedEndpoint, err error) {
+	err = fakeErrUnsupported("SystemFunctions.GetPrivatelinkAuthorizedEndpoints")
+	return
+}
+
+func (fakeUnsupportedSystemFunctions) GetPrivatelinkEndpointsInfo(_ context.Context) (_ []PrivatelinkEndpointInfo, err error) {
+	err = fakeErrUnsupported("SystemFunctions.GetPrivatelinkEndpointsInfo")
+	return
+}
+
+func (fakeUnsupportedSystemFunctions) GetTag(_ context.Context, _ ObjectIdentifier, _ ObjectIdentifier, _ ObjectType) (_ *string, err error) {
+	err = fakeErrUnsupported("SystemFunctions.GetTag")
+	return
+}
+
+func (fakeUnsupportedSystemFunctions) PipeForceResume(_ SchemaObjectIdentifier, _ []ForceResumePipeOption) (err error) {
+	err = fakeErrUnsupported("SystemFunctions.PipeForceResume")
+	return
+}
+
+func (fakeUnsupportedSystemFunctions) PipeStatus(_ SchemaObjectIdentifier) (_ PipeExecutionState, err error) {
+	err = fakeErrUnsupported("SystemFunctions.PipeStatus")
+	return
+}
+
+func (fakeUnsupportedSystemFunctions) ProvisionPrivatelinkEndpoint(_ context.Context, _ ProvisionPrivatelinkEndpointRequest) (err error) {
+	err = fakeErrUnsupported("SystemFunctions.ProvisionPrivatelinkEndpoint")
+	return
+}
+
+func (fakeUnsupportedSystemFunctions) RevokePrivatelink(_ context.Context, _ string, _ string) (err error) {
+	err = fakeErrUnsupported("SystemFunctions.RevokePrivatelink")
+	return
+}
+
+func (fakeUnsupportedSystemFunctions) ShowActiveBehaviorChangeBundles(_ context.Context) (_ []BehaviorChangeBundleInfo, err error) {
+	err = fakeErrUnsupported("SystemFunctions.ShowActiveBehaviorChangeBundles")
+	return
+}
+
+type fakeUnsupportedReplicationFunctions struct{}
+
+var _ ReplicationFunctions = fakeUnsupportedReplicationFunctions{}
+
+func (fakeUnsupportedReplicationFunctions) ShowRegions(_ context.Context, _ *ShowRegionsOptions) (_ []*Region, err error) {
+	err = fakeErrUnsupported("ReplicationFunctions.ShowRegions")
+	return
+}
+
+func (fakeUnsupportedReplicationFunctions) ShowReplicationAccounts(_ context.Context) (_ []*ReplicationAccount, err error) {
+	err = fakeErrUnsupported("ReplicationFunctions.ShowReplicationAccounts")
+	return
+}
+
+func (fakeUnsupportedReplicationFunctions) ShowReplicationDatabases(_ context.Context, _ *ShowReplicationDatabasesOptions) (_ []ReplicationDatabase, err error) {
+	err = fakeErrUnsupported("ReplicationFunctions.ShowReplicationDatabases")
+	return
+}
+
+type fakeUnsupportedAccounts struct{}
+
+var _ Accounts = fakeUnsupportedAccounts{}
+
+func (fakeUnsupportedAccounts) Alter(_ context.Context, _ *AlterAccountOptions) (err error) {
+	err = fakeErrUnsupported("Accounts.Alter")
+	return
+}
+
+func (fakeUnsupportedAccounts) Create(_ context.Context, _ AccountObjectIdentifier, _ *CreateAccountOptions) (_ *AccountCreateResponse, err error) {
+	err = fakeErrUnsupported("Accounts.Create")
+	return
+}
+
+func (fakeUnsupportedAccounts) Drop(_ context.Context, _ AccountObjectIdentifier, _ int, _ *DropAccountOptions) (err error) {
+	err = fakeErrUnsupported("Accounts.Drop")
+	return
+}
+
+func (fakeUnsupportedAccounts) DropSafely(_ context.Context, _ AccountObjectIdentifier, _ int) (err error) {
+	err = fakeErrUnsupported("Accounts.DropSafely")
+	return
+}
+
+func (fakeUnsupportedAccounts) Show(_ context.Context, _ *ShowAccountOptions) (_ []Account, err error) {
+	err = fakeErrUnsupported("Accounts.Show")
+	return
+}
+
+func (fakeUnsupportedAccounts) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *Account, err error) {
+	err = fakeErrUnsupported("Accounts.ShowByID")
+	return
+}
+
+func (fakeUnsupportedAccounts) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *Account, err error) {
+	err = fakeErrUnsupported("Accounts.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedAccounts) ShowParameters(_ context.Context) (_ []*Parameter, err error) {
+	err = fakeErrUnsupported("Accounts.ShowParameters")
+	return
+}
+
+func (fakeUnsupportedAccounts) Undrop(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Accounts.Undrop")
+	return
+}
+
+func (fakeUnsupportedAccounts) UnsetAll(_ context.Context) (err error) {
+	err = fakeErrUnsupported("Accounts.UnsetAll")
+	return
+}
+
+func (fakeUnsupportedAccounts) UnsetAllParameters(_ context.Context) (err error) {
+	err = fakeErrUnsupported("Accounts.UnsetAllParameters")
+	return
+}
+
+func (fakeUnsupportedAccounts) UnsetAllPoliciesSafely(_ context.Context) (err error) {
+	err = fakeErrUnsupported("Accounts.UnsetAllPoliciesSafely")
+	return
+}
+
+func (fakeUnsupportedAccounts) UnsetPolicySafely(_ context.Context, _ PolicyKind) (err error) {
+	err = fakeErrUnsupported("Accounts.UnsetPolicySafely")
+	return
+}
+
+type fakeUnsupportedAggregationPolicies struct{}
+
+var _ AggregationPolicies = fakeUnsupportedAggregationPolicies{}
+
+func (fakeUnsupportedAggregationPolicies) Alter(_ context.Context, _ *AlterAggregationPolicyRequest) (err error) {
+	err = fakeErrUnsupported("AggregationPolicies.Alter")
+	return
+}
+
+func (fakeUnsupportedAggregationPolicies) Create(_ context.Context, _ *CreateAggregationPolicyRequest) (err error) {
+	err = fakeErrUnsupported("AggregationPolicies.Create")
+	return
+}
+
+func (fakeUnsupportedAggregationPolicies) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *AggregationPolicyDescription, err error) {
+	err = fakeErrUnsupported("AggregationPolicies.Describe")
+	return
+}
+
+func (fakeUnsupportedAggregationPolicies) Drop(_ context.Context, _ *DropAggregationPolicyRequest) (err error) {
+	err = fakeErrUnsupported("AggregationPolicies.Drop")
+	return
+}
+
+func (fakeUnsupportedAggregationPolicies) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("AggregationPolicies.DropSafely")
+	return
+}
+
+func (fakeUnsupportedAggregationPolicies) Show(_ context.Context, _ *ShowAggregationPolicyRequest) (_ []AggregationPolicy, err error) {
+	err = fakeErrUnsupported("AggregationPolicies.Show")
+	return
+}
+
+func (fakeUnsupportedAggregationPolicies) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *AggregationPolicy, err error) {
+	err = fakeErrUnsupported("AggregationPolicies.ShowByID")
+	return
+}
+
+func (fakeUnsupportedAggregationPolicies) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *AggregationPolicy, err error) {
+	err = fakeErrUnsupported("AggregationPolicies.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedAlerts struct{}
+
+var _ Alerts = fakeUnsupportedAlerts{}
+
+func (fakeUnsupportedAlerts) Alter(_ context.Context, _ SchemaObjectIdentifier, _ *AlterAlertOptions) (err error) {
+	err = fakeErrUnsupported("Alerts.Alter")
+	return
+}
+
+func (fakeUnsupportedAlerts) Create(_ context.Context, _ SchemaObjectIdentifier, _ AccountObjectIdentifier, _ string, _ string, _ string, _ *CreateAlertOptions) (err error) {
+	err = fakeErrUnsupported("Alerts.Create")
+	return
+}
+
+func (fakeUnsupportedAlerts) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *AlertDetails, err error) {
+	err = fakeErrUnsupported("Alerts.Describe")
+	return
+}
+
+func (fakeUnsupportedAlerts) Drop(_ context.Context, _ SchemaObjectIdentifier, _ *DropAlertOptions) (err error) {
+	err = fakeErrUnsupported("Alerts.Drop")
+	return
+}
+
+func (fakeUnsupportedAlerts) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Alerts.DropSafely")
+	return
+}
+
+func (fakeUnsupportedAlerts) Execute(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Alerts.Execute")
+	return
+}
+
+func (fakeUnsupportedAlerts) Show(_ context.Context, _ *ShowAlertOptions) (_ []Alert, err error) {
+	err = fakeErrUnsupported("Alerts.Show")
+	return
+}
+
+func (fakeUnsupportedAlerts) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *Alert, err error) {
+	err = fakeErrUnsupported("Alerts.ShowByID")
+	return
+}
+
+func (fakeUnsupportedAlerts) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *Alert, err error) {
+	err = fakeErrUnsupported("Alerts.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedAlerts) ShowParameters(_ context.Context, _ SchemaObjectIdentifier) (_ []*Parameter, err error) {
+	err = fakeErrUnsupported("Alerts.ShowParameters")
+	return
+}
+
+type fakeUnsupportedApiIntegrations struct{}
+
+var _ ApiIntegrations = fakeUnsupportedApiIntegrations{}
+
+func (fakeUnsupportedApiIntegrations) Alter(_ context.Context, _ *AlterApiIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("ApiIntegrations.Alter")
+	return
+}
+
+func (fakeUnsupportedApiIntegrations) Create(_ context.Context, _ *CreateApiIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("ApiIntegrations.Create")
+	return
+}
+
+func (fakeUnsupportedApiIntegrations) Describe(_ context.Context, _ AccountObjectIdentifier) (_ []ApiIntegrationProperty, err error) {
+	err = fakeErrUnsupported("ApiIntegrations.Describe")
+	return
+}
+
+func (fakeUnsupportedApiIntegrations) Drop(_ context.Context, _ *DropApiIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("ApiIntegrations.Drop")
+	return
+}
+
+func (fakeUnsupportedApiIntegrations) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("ApiIntegrations.DropSafely")
+	return
+}
+
+func (fakeUnsupportedApiIntegrations) Show(_ context.Context, _ *ShowApiIntegrationRequest) (_ []ApiIntegration, err error) {
+	err = fakeErrUnsupported("ApiIntegrations.Show")
+	return
+}
+
+func (fakeUnsupportedApiIntegrations) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *ApiIntegration, err error) {
+	err = fakeErrUnsupported("ApiIntegrations.ShowByID")
+	return
+}
+
+func (fakeUnsupportedApiIntegrations) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *ApiIntegration, err error) {
+	err = fakeErrUnsupported("ApiIntegrations.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedApplicationPackages struct{}
+
+var _ ApplicationPackages = fakeUnsupportedApplicationPackages{}
+
+func (fakeUnsupportedApplicationPackages) Alter(_ context.Context, _ *AlterApplicationPackageRequest) (err error) {
+	err = fakeErrUnsupported("ApplicationPackages.Alter")
+	return
+}
+
+func (fakeUnsupportedApplicationPackages) Create(_ context.Context, _ *CreateApplicationPackageRequest) (err error) {
+	err = fakeErrUnsupported("ApplicationPackages.Create")
+	return
+}
+
+func (fakeUnsupportedApplicationPackages) Drop(_ context.Context, _ *DropApplicationPackageRequest) (err error) {
+	err = fakeErrUnsupported("ApplicationPackages.Drop")
+	return
+}
+
+func (fakeUnsupportedApplicationPackages) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("ApplicationPackages.DropSafely")
+	return
+}
+
+func (fakeUnsupportedApplicationPackages) Show(_ context.Context, _ *ShowApplicationPackageRequest) (_ []ApplicationPackage, err error) {
+	err = fakeErrUnsupported("ApplicationPackages.Show")
+	return
+}
+
+func (fakeUnsupportedApplicationPackages) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *ApplicationPackage, err error) {
+	err = fakeErrUnsupported("ApplicationPackages.ShowByID")
+	return
+}
+
+func (fakeUnsupportedApplicationPackages) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *ApplicationPackage, err error) {
+	err = fakeErrUnsupported("ApplicationPackages.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedApplicationRoles struct{}
+
+var _ ApplicationRoles = fakeUnsupportedApplicationRoles{}
+
+func (fakeUnsupportedApplicationRoles) Grant(_ context.Context, _ *GrantApplicationRoleRequest) (err error) {
+	err = fakeErrUnsupported("ApplicationRoles.Grant")
+	return
+}
+
+func (fakeUnsupportedApplicationRoles) Revoke(_ context.Context, _ *RevokeApplicationRoleRequest) (err error) {
+	err = fakeErrUnsupported("ApplicationRoles.Revoke")
+	return
+}
+
+func (fakeUnsupportedApplicationRoles) RevokeSafely(_ context.Context, _ *RevokeApplicationRoleRequest) (err error) {
+	err = fakeErrUnsupported("ApplicationRoles.RevokeSafely")
+	return
+}
+
+func (fakeUnsupportedApplicationRoles) Show(_ context.Context, _ *ShowApplicationRoleRequest) (_ []ApplicationRole, err error) {
+	err = fakeErrUnsupported("ApplicationRoles.Show")
+	return
+}
+
+func (fakeUnsupportedApplicationRoles) ShowByID(_ context.Context, _ DatabaseObjectIdentifier) (_ *ApplicationRole, err error) {
+	err = fakeErrUnsupported("ApplicationRoles.ShowByID")
+	return
+}
+
+func (fakeUnsupportedApplicationRoles) ShowByIDSafely(_ context.Context, _ DatabaseObjectIdentifier) (_ *ApplicationRole, err error) {
+	err = fakeErrUnsupported("ApplicationRoles.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedApplications struct{}
+
+var _ Applications = fakeUnsupportedApplications{}
+
+func (fakeUnsupportedApplications) Alter(_ context.Context, _ *AlterApplicationRequest) (err error) {
+	err = fakeErrUnsupported("Applications.Alter")
+	return
+}
+
+func (fakeUnsupportedApplications) Create(_ context.Context, _ *CreateApplicationRequest) (err error) {
+	err = fakeErrUnsupported("Applications.Create")
+	return
+}
+
+func (fakeUnsupportedApplications) Describe(_ context.Context, _ AccountObjectIdentifier) (_ []ApplicationProperty, err error) {
+	err = fakeErrUnsupported("Applications.Describe")
+	return
+}
+
+func (fakeUnsupportedApplications) Drop(_ context.Context, _ *DropApplicationRequest) (err error) {
+	err = fakeErrUnsupported("Applications.Drop")
+	return
+}
+
+func (fakeUnsupportedApplications) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Applications.DropSafely")
+	return
+}
+
+func (fakeUnsupportedApplications) Show(_ context.Context, _ *ShowApplicationRequest) (_ []Application, err error) {
+	err = fakeErrUnsupported("Applications.Show")
+	return
+}
+
+func (fakeUnsupportedApplications) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *Application, err error) {
+	err = fakeErrUnsupported("Applications.ShowByID")
+	return
+}
+
+func (fakeUnsupportedApplications) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *Application, err error) {
+	err = fakeErrUnsupported("Applications.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedAuthenticationPolicies struct{}
+
+var _ AuthenticationPolicies = fakeUnsupportedAuthenticationPolicies{}
+
+func (fakeUnsupportedAuthenticationPolicies) Alter(_ context.Context, _ *AlterAuthenticationPolicyRequest) (err error) {
+	err = fakeErrUnsupported("AuthenticationPolicies.Alter")
+	return
+}
+
+func (fakeUnsupportedAuthenticationPolicies) Create(_ context.Context, _ *CreateAuthenticationPolicyRequest) (err error) {
+	err = fakeErrUnsupported("AuthenticationPolicies.Create")
+	return
+}
+
+func (fakeUnsupportedAuthenticationPolicies) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ []AuthenticationPolicyDescription, err error) {
+	err = fakeErrUnsupported("AuthenticationPolicies.Describe")
+	return
+}
+
+func (fakeUnsupportedAuthenticationPolicies) Drop(_ context.Context, _ *DropAuthenticationPolicyRequest) (err error) {
+	err = fakeErrUnsupported("AuthenticationPolicies.Drop")
+	return
+}
+
+func (fakeUnsupportedAuthenticationPolicies) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("AuthenticationPolicies.DropSafely")
+	return
+}
+
+func (fakeUnsupportedAuthenticationPolicies) Show(_ context.Context, _ *ShowAuthenticationPolicyRequest) (_ []AuthenticationPolicy, err error) {
+	err = fakeErrUnsupported("AuthenticationPolicies.Show")
+	return
+}
+
+func (fakeUnsupportedAuthenticationPolicies) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *AuthenticationPolicy, err error) {
+	err = fakeErrUnsupported("AuthenticationPolicies.ShowByID")
+	return
+}
+
+func (fakeUnsupportedAuthenticationPolicies) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *AuthenticationPolicy, err error) {
+	err = fakeErrUnsupported("AuthenticationPolicies.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedBackupPolicies struct{}
+
+var _ BackupPolicies = fakeUnsupportedBackupPolicies{}
+
+func (fakeUnsupportedBackupPolicies) Alter(_ context.Context, _ *AlterBackupPolicyRequest) (err error) {
+	err = fakeErrUnsupported("BackupPolicies.Alter")
+	return
+}
+
+func (fakeUnsupportedBackupPolicies) Create(_ context.Context, _ *CreateBackupPolicyRequest) (err error) {
+	err = fakeErrUnsupported("BackupPolicies.Create")
+	return
+}
+
+func (fakeUnsupportedBackupPolicies) Drop(_ context.Context, _ *DropBackupPolicyRequest) (err error) {
+	err = fakeErrUnsupported("BackupPolicies.Drop")
+	return
+}
+
+func (fakeUnsupportedBackupPolicies) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("BackupPolicies.DropSafely")
+	return
+}
+
+func (fakeUnsupportedBackupPolicies) Show(_ context.Context, _ *ShowBackupPolicyRequest) (_ []BackupPolicy, err error) {
+	err = fakeErrUnsupported("BackupPolicies.Show")
+	return
+}
+
+func (fakeUnsupportedBackupPolicies) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *BackupPolicy, err error) {
+	err = fakeErrUnsupported("BackupPolicies.ShowByID")
+	return
+}
+
+func (fakeUnsupportedBackupPolicies) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *BackupPolicy, err error) {
+	err = fakeErrUnsupported("BackupPolicies.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedBackupSets struct{}
+
+var _ BackupSets = fakeUnsupportedBackupSets{}
+
+func (fakeUnsupportedBackupSets) Alter(_ context.Context, _ *AlterBackupSetRequest) (err error) {
+	err = fakeErrUnsupported("BackupSets.Alter")
+	return
+}
+
+func (fakeUnsupportedBackupSets) Create(_ context.Context, _ *CreateBackupSetRequest) (err error) {
+	err = fakeErrUnsupported("BackupSets.Create")
+	return
+}
+
+func (fakeUnsupportedBackupSets) Drop(_ context.Context, _ *DropBackupSetRequest) (err error) {
+	err = fakeErrUnsupported("BackupSets.Drop")
+	return
+}
+
+func (fakeUnsupportedBackupSets) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("BackupSets.DropSafely")
+	return
+}
+
+func (fakeUnsupportedBackupSets) Restore(_ context.Context, _ *RestoreBackupSetRequest) (err error) {
+	err = fakeErrUnsupported("BackupSets.Restore")
+	return
+}
+
+func (fakeUnsupportedBackupSets) Show(_ context.Context, _ *ShowBackupSetRequest) (_ []BackupSet, err error) {
+	err = fakeErrUnsupported("BackupSets.Show")
+	return
+}
+
+func (fakeUnsupportedBackupSets) ShowBackups(_ context.Context, _ *ShowBackupsBackupSetRequest) (_ []Backup, err error) {
+	err = fakeErrUnsupported("BackupSets.ShowBackups")
+	return
+}
+
+func (fakeUnsupportedBackupSets) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *BackupSet, err error) {
+	err = fakeErrUnsupported("BackupSets.ShowByID")
+	return
+}
+
+func (fakeUnsupportedBackupSets) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *BackupSet, err error) {
+	err = fakeErrUnsupported("BackupSets.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedBudgets struct{}
+
+var _ Budgets = fakeUnsupportedBudgets{}
+
+func (fakeUnsupportedBudgets) Create(_ context.Context, _ *CreateBudgetRequest) (err error) {
+	err = fakeErrUnsupported("Budgets.Create")
+	return
+}
+
+func (fakeUnsupportedBudgets) Drop(_ context.Context, _ *DropBudgetRequest) (err error) {
+	err = fakeErrUnsupported("Budgets.Drop")
+	return
+}
+
+func (fakeUnsupportedBudgets) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Budgets.DropSafely")
+	return
+}
+
+func (fakeUnsupportedBudgets) GetCycleStartAction(_ context.Context, _ *GetCycleStartActionBudgetRequest) (_ *BudgetCycleStartAction, err error) {
+	err = fakeErrUnsupported("Budgets.GetCycleStartAction")
+	return
+}
+
+func (fakeUnsupportedBudgets) GetNotificationEmail(_ context.Context, _ *GetNotificationEmailBudgetRequest) (_ *string, err error) {
+	err = fakeErrUnsupported("Budgets.GetNotificationEmail")
+	return
+}
+
+func (fakeUnsupportedBudgets) GetNotificationIntegrationName(_ context.Context, _ *GetNotificationIntegrationNameBudgetRequest) (_ *string, err error) {
+	err = fakeErrUnsupported("Budgets.GetNotificationIntegrationName")
+	return
+}
+
+func (fakeUnsupportedBudgets) GetNotificationIntegrations(_ context.Context, _ *GetNotificationIntegrationsBudgetRequest) (_ []BudgetNotificationIntegration, err error) {
+	err = fakeErrUnsupported("Budgets.GetNotificationIntegrations")
+	return
+}
+
+func (fakeUnsupportedBudgets) GetSpendingLimit(_ context.Context, _ *GetSpendingLimitBudgetRequest) (_ *int, err error) {
+	err = fakeErrUnsupported("Budgets.GetSpendingLimit")
+	return
+}
+
+func (fakeUnsupportedBudgets) SetCycleStartAction(_ context.Context, _ *SetCycleStartActionBudgetRequest) (_ *string, err error) {
+	err = fakeErrUnsupported("Budgets.SetCycleStartAction")
+	return
+}
+
+func (fakeUnsupportedBudgets) SetEmailNotifications(_ context.Context, _ *SetEmailNotificationsBudgetRequest) (_ *string, err error) {
+	err = fakeErrUnsupported("Budgets.SetEmailNotifications")
+	return
+}
+
+func (fakeUnsupportedBudgets) SetSpendingLimit(_ context.Context, _ *SetSpendingLimitBudgetRequest) (_ *string, err error) {
+	err = fakeErrUnsupported("Budgets.SetSpendingLimit")
+	return
+}
+
+type fakeUnsupportedCatalogIntegrations struct{}
+
+var _ CatalogIntegrations = fakeUnsupportedCatalogIntegrations{}
+
+func (fakeUnsupportedCatalogIntegrations) Alter(_ context.Context, _ *AlterCatalogIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("CatalogIntegrations.Alter")
+	return
+}
+
+func (fakeUnsupportedCatalogIntegrations) Create(_ context.Context, _ *CreateCatalogIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("CatalogIntegrations.Create")
+	return
+}
+
+func (fakeUnsupportedCatalogIntegrations) Describe(_ context.Context, _ AccountObjectIdentifier) (_ []CatalogIntegrationProperty, err error) {
+	err = fakeErrUnsupported("CatalogIntegrations.Describe")
+	return
+}
+
+func (fakeUnsupportedCatalogIntegrations) DescribeAwsGlueDetails(_ context.Context, _ AccountObjectIdentifier) (_ *CatalogIntegrationAwsGlueDetails, err error) {
+	err = fakeErrUnsupported("CatalogIntegrations.DescribeAwsGlueDetails")
+	return
+}
+
+func (fakeUnsupportedCatalogIntegrations) DescribeDetails(_ context.Context, _ AccountObjectIdentifier) (_ *CatalogIntegrationAllDetails, err error) {
+	err = fakeErrUnsupported("CatalogIntegrations.DescribeDetails")
+	return
+}
+
+func (fakeUnsupportedCatalogIntegrations) DescribeIcebergRestDetails(_ context.Context, _ AccountObjectIdentifier) (_ *CatalogIntegrationIcebergRestDetails, err error) {
+	err = fakeErrUnsupported("CatalogIntegrations.DescribeIcebergRestDetails")
+	return
+}
+
+func (fakeUnsupportedCatalogIntegrations) DescribeObjectStorageDetails(_ context.Context, _ AccountObjectIdentifier) (_ *CatalogIntegrationObjectStorageDetails, err error) {
+	err = fakeErrUnsupported("CatalogIntegrations.DescribeObjectStorageDetails")
+	return
+}
+
+func (fakeUnsupportedCatalogIntegrations) DescribeOpenCatalogDetails(_ context.Context, _ AccountObjectIdentifier) (_ *CatalogIntegrationOpenCatalogDetails, err error) {
+	err = fakeErrUnsupported("CatalogIntegrations.DescribeOpenCatalogDetails")
+	return
+}
+
+func (fakeUnsupportedCatalogIntegrations) Drop(_ context.Context, _ *DropCatalogIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("CatalogIntegrations.Drop")
+	return
+}
+
+func (fakeUnsupportedCatalogIntegrations) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("CatalogIntegrations.DropSafely")
+	return
+}
+
+func (fakeUnsupportedCatalogIntegrations) Show(_ context.Context, _ *ShowCatalogIntegrationRequest) (_ []CatalogIntegration, err error) {
+	err = fakeErrUnsupported("CatalogIntegrations.Show")
+	return
+}
+
+func (fakeUnsupportedCatalogIntegrations) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *CatalogIntegration, err error) {
+	err = fakeErrUnsupported("CatalogIntegrations.ShowByID")
+	return
+}
+
+func (fakeUnsupportedCatalogIntegrations) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *CatalogIntegration, err error) {
+	err = fakeErrUnsupported("CatalogIntegrations.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedClassificationProfiles struct{}
+
+var _ ClassificationProfiles = fakeUnsupportedClassificationProfiles{}
+
+func (fakeUnsupportedClassificationProfiles) Create(_ context.Context, _ *CreateClassificationProfileRequest) (err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.Create")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) Describe(_ context.Context, _ *DescribeClassificationProfileRequest) (_ *string, err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.Describe")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) DescribeDetails(_ context.Context, _ SchemaObjectIdentifier) (_ *ClassificationProfileDetails, err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.DescribeDetails")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) Drop(_ context.Context, _ *DropClassificationProfileRequest) (err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.Drop")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.DropSafely")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) SetAutoTag(_ context.Context, _ *SetAutoTagClassificationProfileRequest) (_ *string, err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.SetAutoTag")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) SetCustomClassifiers(_ context.Context, _ *SetCustomClassifiersClassificationProfileRequest) (_ *string, err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.SetCustomClassifiers")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) SetMaximumClassificationValidityDays(_ context.Context, _ *SetMaximumClassificationValidityDaysClassificationProfileRequest) (_ *string, err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.SetMaximumClassificationValidityDays")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) SetMinimumObjectAgeForClassificationDays(_ context.Context, _ *SetMinimumObjectAgeForClassificationDaysClassificationProfileRequest) (_ *string, err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.SetMinimumObjectAgeForClassificationDays")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) SetTagMap(_ context.Context, _ *SetTagMapClassificationProfileRequest) (_ *string, err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.SetTagMap")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) Show(_ context.Context, _ *ShowClassificationProfileRequest) (_ []ClassificationProfile, err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.Show")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *ClassificationProfile, err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.ShowByID")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *ClassificationProfile, err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) UnsetCustomClassifiers(_ context.Context, _ *UnsetCustomClassifiersClassificationProfileRequest) (_ *string, err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.UnsetCustomClassifiers")
+	return
+}
+
+func (fakeUnsupportedClassificationProfiles) UnsetTagMap(_ context.Context, _ *UnsetTagMapClassificationProfileRequest) (_ *string, err error) {
+	err = fakeErrUnsupported("ClassificationProfiles.UnsetTagMap")
+	return
+}
+
+type fakeUnsupportedComments struct{}
+
+var _ Comments = fakeUnsupportedComments{}
+
+func (fakeUnsupportedComments) Set(_ context.Context, _ *SetCommentOptions) (err error) {
+	err = fakeErrUnsupported("Comments.Set")
+	return
+}
+
+func (fakeUnsupportedComments) SetColumn(_ context.Context, _ *SetColumnCommentOptions) (err error) {
+	err = fakeErrUnsupported("Comments.SetColumn")
+	return
+}
+
+type fakeUnsupportedComputePools struct{}
+
+var _ ComputePools = fakeUnsupportedComputePools{}
+
+func (fakeUnsupportedComputePools) Alter(_ context.Context, _ *AlterComputePoolRequest) (err error) {
+	err = fakeErrUnsupported("ComputePools.Alter")
+	return
+}
+
+func (fakeUnsupportedComputePools) Create(_ context.Context, _ *CreateComputePoolRequest) (err error) {
+	err = fakeErrUnsupported("ComputePools.Create")
+	return
+}
+
+func (fakeUnsupportedComputePools) Describe(_ context.Context, _ AccountObjectIdentifier) (_ *ComputePoolDetails, err error) {
+	err = fakeErrUnsupported("ComputePools.Describe")
+	return
+}
+
+func (fakeUnsupportedComputePools) Drop(_ context.Context, _ *DropComputePoolRequest) (err error) {
+	err = fakeErrUnsupported("ComputePools.Drop")
+	return
+}
+
+func (fakeUnsupportedComputePools) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("ComputePools.DropSafely")
+	return
+}
+
+func (fakeUnsupportedComputePools) Show(_ context.Context, _ *ShowComputePoolRequest) (_ []ComputePool, err error) {
+	err = fakeErrUnsupported("ComputePools.Show")
+	return
+}
+
+func (fakeUnsupportedComputePools) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *ComputePool, err error) {
+	err = fakeErrUnsupported("ComputePools.ShowByID")
+	return
+}
+
+func (fakeUnsupportedComputePools) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *ComputePool, err error) {
+	err = fakeErrUnsupported("ComputePools.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedConnections struct{}
+
+var _ Connections = fakeUnsupportedConnections{}
+
+func (fakeUnsupportedConnections) Alter(_ context.Context, _ *AlterConnectionRequest) (err error) {
+	err = fakeErrUnsupported("Connections.Alter")
+	return
+}
+
+func (fakeUnsupportedConnections) Create(_ context.Context, _ *CreateConnectionRequest) (err error) {
+	err = fakeErrUnsupported("Connections.Create")
+	return
+}
+
+func (fakeUnsupportedConnections) Drop(_ context.Context, _ *DropConnectionRequest) (err error) {
+	err = fakeErrUnsupported("Connections.Drop")
+	return
+}
+
+func (fakeUnsupportedConnections) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Connections.DropSafely")
+	return
+}
+
+func (fakeUnsupportedConnections) Show(_ context.Context, _ *ShowConnectionRequest) (_ []Connection, err error) {
+	err = fakeErrUnsupported("Connections.Show")
+	return
+}
+
+func (fakeUnsupportedConnections) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *Connection, err error) {
+	err = fakeErrUnsupported("Connections.ShowByID")
+	return
+}
+
+func (fakeUnsupportedConnections) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *Connection, err error) {
+	err = fakeErrUnsupported("Connections.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedCortexAgents struct{}
+
+var _ CortexAgents = fakeUnsupportedCortexAgents{}
+
+func (fakeUnsupportedCortexAgents) Alter(_ context.Context, _ *AlterCortexAgentRequest) (err error) {
+	err = fakeErrUnsupported("CortexAgents.Alter")
+	return
+}
+
+func (fakeUnsupportedCortexAgents) Create(_ context.Context, _ *CreateCortexAgentRequest) (err error) {
+	err = fakeErrUnsupported("CortexAgents.Create")
+	return
+}
+
+func (fakeUnsupportedCortexAgents) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *CortexAgentDetails, err error) {
+	err = fakeErrUnsupported("CortexAgents.Describe")
+	return
+}
+
+func (fakeUnsupportedCortexAgents) Drop(_ context.Context, _ *DropCortexAgentRequest) (err error) {
+	err = fakeErrUnsupported("CortexAgents.Drop")
+	return
+}
+
+func (fakeUnsupportedCortexAgents) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("CortexAgents.DropSafely")
+	return
+}
+
+func (fakeUnsupportedCortexAgents) Show(_ context.Context, _ *ShowCortexAgentRequest) (_ []CortexAgent, err error) {
+	err = fakeErrUnsupported("CortexAgents.Show")
+	return
+}
+
+func (fakeUnsupportedCortexAgents) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *CortexAgent, err error) {
+	err = fakeErrUnsupported("CortexAgents.ShowByID")
+	return
+}
+
+func (fakeUnsupportedCortexAgents) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *CortexAgent, err error) {
+	err = fakeErrUnsupported("CortexAgents.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedCortexSearchServices struct{}
+
+var _ CortexSearchServices = fakeUnsupportedCortexSearchServices{}
+
+func (fakeUnsupportedCortexSearchServices) Alter(_ context.Context, _ *AlterCortexSearchServiceRequest) (err error) {
+	err = fakeErrUnsupported("CortexSearchServices.Alter")
+	return
+}
+
+func (fakeUnsupportedCortexSearchServices) Create(_ context.Context, _ *CreateCortexSearchServiceRequest) (err error) {
+	err = fakeErrUnsupported("CortexSearchServices.Create")
+	return
+}
+
+func (fakeUnsupportedCortexSearchServices) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *CortexSearchServiceDetails, err error) {
+	err = fakeErrUnsupported("CortexSearchServices.Describe")
+	return
+}
+
+func (fakeUnsupportedCortexSearchServices) Drop(_ context.Context, _ *DropCortexSearchServiceRequest) (err error) {
+	err = fakeErrUnsupported("CortexSearchServices.Drop")
+	return
+}
+
+func (fakeUnsupportedCortexSearchServices) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("CortexSearchServices.DropSafely")
+	return
+}
+
+func (fakeUnsupportedCortexSearchServices) Show(_ context.Context, _ *ShowCortexSearchServiceRequest) (_ []CortexSearchService, err error) {
+	err = fakeErrUnsupported("CortexSearchServices.Show")
+	return
+}
+
+func (fakeUnsupportedCortexSearchServices) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *CortexSearchService, err error) {
+	err = fakeErrUnsupported("CortexSearchServices.ShowByID")
+	return
+}
+
+func (fakeUnsupportedCortexSearchServices) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *CortexSearchService, err error) {
+	err = fakeErrUnsupported("CortexSearchServices.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedDatabaseRoles struct{}
+
+var _ DatabaseRoles = fakeUnsupportedDatabaseRoles{}
+
+func (fakeUnsupportedDatabaseRoles) Alter(_ context.Context, _ *AlterDatabaseRoleRequest) (err error) {
+	err = fakeErrUnsupported("DatabaseRoles.Alter")
+	return
+}
+
+func (fakeUnsupportedDatabaseRoles) Create(_ context.Context, _ *CreateDatabaseRoleRequest) (err error) {
+	err = fakeErrUnsupported("DatabaseRoles.Create")
+	return
+}
+
+func (fakeUnsupportedDatabaseRoles) Drop(_ context.Context, _ *DropDatabaseRoleRequest) (err error) {
+	err = fakeErrUnsupported("DatabaseRoles.Drop")
+	return
+}
+
+func (fakeUnsupportedDatabaseRoles) DropSafely(_ context.Context, _ DatabaseObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("DatabaseRoles.DropSafely")
+	return
+}
+
+func (fakeUnsupportedDatabaseRoles) Grant(_ context.Context, _ *GrantDatabaseRoleRequest) (err error) {
+	err = fakeErrUnsupported("DatabaseRoles.Grant")
+	return
+}
+
+func (fakeUnsupportedDatabaseRoles) GrantToShare(_ context.Context, _ *GrantDatabaseRoleToShareRequest) (err error) {
+	err = fakeErrUnsupported("DatabaseRoles.GrantToShare")
+	return
+}
+
+func (fakeUnsupportedDatabaseRoles) Revoke(_ context.Context, _ *RevokeDatabaseRoleRequest) (err error) {
+	err = fakeErrUnsupported("DatabaseRoles.Revoke")
+	return
+}
+
+func (fakeUnsupportedDatabaseRoles) RevokeFromShare(_ context.Context, _ *RevokeDatabaseRoleFromShareRequest) (err error) {
+	err = fakeErrUnsupported("DatabaseRoles.RevokeFromShare")
+	return
+}
+
+func (fakeUnsupportedDatabaseRoles) RevokeFromShareSafely(_ context.Context, _ *RevokeDatabaseRoleFromShareRequest) (err error) {
+	err = fakeErrUnsupported("DatabaseRoles.RevokeFromShareSafely")
+	return
+}
+
+func (fakeUnsupportedDatabaseRoles) RevokeSafely(_ context.Context, _ *RevokeDatabaseRoleRequest) (err error) {
+	err = fakeErrUnsupported("DatabaseRoles.RevokeSafely")
+	return
+}
+
+func (fakeUnsupportedDatabaseRoles) Show(_ context.Context, _ *ShowDatabaseRoleRequest) (_ []DatabaseRole, err error) {
+	err = fakeErrUnsupported("DatabaseRoles.Show")
+	return
+}
+
+func (fakeUnsupportedDatabaseRoles) ShowByID(_ context.Context, _ DatabaseObjectIdentifier) (_ *DatabaseRole, err error) {
+	err = fakeErrUnsupported("DatabaseRoles.ShowByID")
+	return
+}
+
+func (fakeUnsupportedDatabaseRoles) ShowByIDSafely(_ context.Context, _ DatabaseObjectIdentifier) (_ *DatabaseRole, err error) {
+	err = fakeErrUnsupported("DatabaseRoles.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedDatabases struct{}
+
+var _ Databases = fakeUnsupportedDatabases{}
+
+func (fakeUnsupportedDatabases) Alter(_ context.Context, _ AccountObjectIdentifier, _ *AlterDatabaseOptions) (err error) {
+	err = fakeErrUnsupported("Databases.Alter")
+	return
+}
+
+func (fakeUnsupportedDatabases) AlterFailover(_ context.Context, _ AccountObjectIdentifier, _ *AlterDatabaseFailoverOptions) (err error) {
+	err = fakeErrUnsupported("Databases.AlterFailover")
+	return
+}
+
+func (fakeUnsupportedDatabases) AlterReplication(_ context.Context, _ AccountObjectIdentifier, _ *AlterDatabaseReplicationOptions) (err error) {
+	err = fakeErrUnsupported("Databases.AlterReplication")
+	return
+}
+
+func (fakeUnsupportedDatabases) Create(_ context.Context, _ AccountObjectIdentifier, _ *CreateDatabaseOptions) (err error) {
+	err = fakeErrUnsupported("Databases.Create")
+	return
+}
+
+func (fakeUnsupportedDatabases) CreateFromListing(_ context.Context, _ AccountObjectIdentifier, _ string, _ *CreateDatabaseFromListingOptions) (err error) {
+	err = fakeErrUnsupported("Databases.CreateFromListing")
+	return
+}
+
+func (fakeUnsupportedDatabases) CreateSecondary(_ context.Context, _ AccountObjectIdentifier, _ ExternalObjectIdentifier, _ *CreateSecondaryDatabaseOptions) (err error) {
+	err = fakeErrUnsupported("Databases.CreateSecondary")
+	return
+}
+
+func (fakeUnsupportedDatabases) CreateShared(_ context.Context, _ AccountObjectIdentifier, _ ExternalObjectIdentifier, _ *CreateSharedDatabaseOptions) (err error) {
+	err = fakeErrUnsupported("Databases.CreateShared")
+	return
+}
+
+func (fakeUnsupportedDatabases) Describe(_ context.Context, _ AccountObjectIdentifier) (_ *DatabaseDetails, err error) {
+	err = fakeErrUnsupported("Databases.Describe")
+	return
+}
+
+func (fakeUnsupportedDatabases) Drop(_ context.Context, _ AccountObjectIdentifier, _ *DropDatabaseOptions) (err error) {
+	err = fakeErrUnsupported("Databases.Drop")
+	return
+}
+
+func (fakeUnsupportedDatabases) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Databases.DropSafely")
+	return
+}
+
+func (fakeUnsupportedDatabases) Show(_ context.Context, _ *ShowDatabasesOptions) (_ []Database, err error) {
+	err = fakeErrUnsupported("Databases.Show")
+	return
+}
+
+func (fakeUnsupportedDatabases) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *Database, err error) {
+	err = fakeErrUnsupported("Databases.ShowByID")
+	return
+}
+
+func (fakeUnsupportedDatabases) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *Database, err error) {
+	err = fakeErrUnsupported("Databases.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedDatabases) ShowParameters(_ context.Context, _ AccountObjectIdentifier) (_ []*Parameter, err error) {
+	err = fakeErrUnsupported("Databases.ShowParameters")
+	return
+}
+
+func (fakeUnsupportedDatabases) Undrop(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Databases.Undrop")
+	return
+}
+
+func (fakeUnsupportedDatabases) Use(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Databases.Use")
+	return
+}
+
+type fakeUnsupportedDataMetricFunctionReferences struct{}
+
+var _ DataMetricFunctionReferences = fakeUnsupportedDataMetricFunctionReferences{}
+
+func (fakeUnsupportedDataMetricFunctionReferences) GetForEntity(_ context.Context, _ *GetForEntityDataMetricFunctionReferenceRequest) (_ []DataMetricFunctionReference, err error) {
+	err = fakeErrUnsupported("DataMetricFunctionReferences.GetForEntity")
+	return
+}
+
+type fakeUnsupportedDbtProjects struct{}
+
+var _ DbtProjects = fakeUnsupportedDbtProjects{}
+
+func (fakeUnsupportedDbtProjects) Alter(_ context.Context, _ *AlterDbtProjectRequest) (err error) {
+	err = fakeErrUnsupported("DbtProjects.Alter")
+	return
+}
+
+func (fakeUnsupportedDbtProjects) Create(_ context.Context, _ *CreateDbtProjectRequest) (err error) {
+	err = fakeErrUnsupported("DbtProjects.Create")
+	return
+}
+
+func (fakeUnsupportedDbtProjects) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *DbtProject, err error) {
+	err = fakeErrUnsupported("DbtProjects.Describe")
+	return
+}
+
+func (fakeUnsupportedDbtProjects) Drop(_ context.Context, _ *DropDbtProjectRequest) (err error) {
+	err = fakeErrUnsupported("DbtProjects.Drop")
+	return
+}
+
+func (fakeUnsupportedDbtProjects) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("DbtProjects.DropSafely")
+	return
+}
+
+func (fakeUnsupportedDbtProjects) Show(_ context.Context, _ *ShowDbtProjectRequest) (_ []DbtProject, err error) {
+	err = fakeErrUnsupported("DbtProjects.Show")
+	return
+}
+
+func (fakeUnsupportedDbtProjects) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *DbtProject, err error) {
+	err = fakeErrUnsupported("DbtProjects.ShowByID")
+	return
+}
+
+func (fakeUnsupportedDbtProjects) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *DbtProject, err error) {
+	err = fakeErrUnsupported("DbtProjects.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedDbtProjects) ShowVersions(_ context.Context, _ *ShowVersionsDbtProjectRequest) (_ []DbtProjectVersion, err error) {
+	err = fakeErrUnsupported("DbtProjects.ShowVersions")
+	return
+}
+
+type fakeUnsupportedDynamicTables struct{}
+
+var _ DynamicTables = fakeUnsupportedDynamicTables{}
+
+func (fakeUnsupportedDynamicTables) Alter(_ context.Context, _ *AlterDynamicTableRequest) (err error) {
+	err = fakeErrUnsupported("DynamicTables.Alter")
+	return
+}
+
+func (fakeUnsupportedDynamicTables) Create(_ context.Context, _ *CreateDynamicTableRequest) (err error) {
+	err = fakeErrUnsupported("DynamicTables.Create")
+	return
+}
+
+func (fakeUnsupportedDynamicTables) Describe(_ context.Context, _ *DescribeDynamicTableRequest) (_ *DynamicTableDetails, err error) {
+	err = fakeErrUnsupported("DynamicTables.Describe")
+	return
+}
+
+func (fakeUnsupportedDynamicTables) Drop(_ context.Context, _ *DropDynamicTableRequest) (err error) {
+	err = fakeErrUnsupported("DynamicTables.Drop")
+	return
+}
+
+func (fakeUnsupportedDynamicTables) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("DynamicTables.DropSafely")
+	return
+}
+
+func (fakeUnsupportedDynamicTables) Show(_ context.Context, _ *ShowDynamicTableRequest) (_ []DynamicTable, err error) {
+	err = fakeErrUnsupported("DynamicTables.Show")
+	return
+}
+
+func (fakeUnsupportedDynamicTables) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *DynamicTable, err error) {
+	err = fakeErrUnsupported("DynamicTables.ShowByID")
+	return
+}
+
+func (fakeUnsupportedDynamicTables) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *DynamicTable, err error) {
+	err = fakeErrUnsupported("DynamicTables.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedExternalAccessIntegrations struct{}
+
+var _ ExternalAccessIntegrations = fakeUnsupportedExternalAccessIntegrations{}
+
+func (fakeUnsupportedExternalAccessIntegrations) Alter(_ context.Context, _ *AlterExternalAccessIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("ExternalAccessIntegrations.Alter")
+	return
+}
+
+func (fakeUnsupportedExternalAccessIntegrations) Create(_ context.Context, _ *CreateExternalAccessIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("ExternalAccessIntegrations.Create")
+	return
+}
+
+func (fakeUnsupportedExternalAccessIntegrations) Describe(_ context.Context, _ AccountObjectIdentifier) (_ []ExternalAccessIntegrationProperty, err error) {
+	err = fakeErrUnsupported("ExternalAccessIntegrations.Describe")
+	return
+}
+
+func (fakeUnsupportedExternalAccessIntegrations) DescribeDetails(_ context.Context, _ AccountObjectIdentifier) (_ *ExternalAccessIntegrationDetails, err error) {
+	err = fakeErrUnsupported("ExternalAccessIntegrations.DescribeDetails")
+	return
+}
+
+func (fakeUnsupportedExternalAccessIntegrations) Drop(_ context.Context, _ *DropExternalAccessIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("ExternalAccessIntegrations.Drop")
+	return
+}
+
+func (fakeUnsupportedExternalAccessIntegrations) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("ExternalAccessIntegrations.DropSafely")
+	return
+}
+
+func (fakeUnsupportedExternalAccessIntegrations) Show(_ context.Context, _ *ShowExternalAccessIntegrationRequest) (_ []ExternalAccessIntegration, err error) {
+	err = fakeErrUnsupported("ExternalAccessIntegrations.Show")
+	return
+}
+
+func (fakeUnsupportedExternalAccessIntegrations) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *ExternalAccessIntegration, err error) {
+	err = fakeErrUnsupported("ExternalAccessIntegrations.ShowByID")
+	return
+}
+
+func (fakeUnsupportedExternalAccessIntegrations) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *ExternalAccessIntegration, err error) {
+	err = fakeErrUnsupported("ExternalAccessIntegrations.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedExternalFunctions struct{}
+
+var _ ExternalFunctions = fakeUnsupportedExternalFunctions{}
+
+func (fakeUnsupportedExternalFunctions) Alter(_ context.Context, _ *AlterExternalFunctionRequest) (err error) {
+	err = fakeErrUnsupported("ExternalFunctions.Alter")
+	return
+}
+
+func (fakeUnsupportedExternalFunctions) Create(_ context.Context, _ *CreateExternalFunctionRequest) (err error) {
+	err = fakeErrUnsupported("ExternalFunctions.Create")
+	return
+}
+
+func (fakeUnsupportedExternalFunctions) Describe(_ context.Context, _ SchemaObjectIdentifierWithArguments) (_ []ExternalFunctionProperty, err error) {
+	err = fakeErrUnsupported("ExternalFunctions.Describe")
+	return
+}
+
+func (fakeUnsupportedExternalFunctions) Show(_ context.Context, _ *ShowExternalFunctionRequest) (_ []ExternalFunction, err error) {
+	err = fakeErrUnsupported("ExternalFunctions.Show")
+	return
+}
+
+func (fakeUnsupportedExternalFunctions) ShowByID(_ context.Context, _ SchemaObjectIdentifierWithArguments) (_ *ExternalFunction, err error) {
+	err = fakeErrUnsupported("ExternalFunctions.ShowByID")
+	return
+}
+
+func (fakeUnsupportedExternalFunctions) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifierWithArguments) (_ *ExternalFunction, err error) {
+	err = fakeErrUnsupported("ExternalFunctions.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedExternalVolumes struct{}
+
+var _ ExternalVolumes = fakeUnsupportedExternalVolumes{}
+
+func (fakeUnsupportedExternalVolumes) Alter(_ context.Context, _ *AlterExternalVolumeRequest) (err error) {
+	err = fakeErrUnsupported("ExternalVolumes.Alter")
+	return
+}
+
+func (fakeUnsupportedExternalVolumes) Create(_ context.Context, _ *CreateExternalVolumeRequest) (err error) {
+	err = fakeErrUnsupported("ExternalVolumes.Create")
+	return
+}
+
+func (fakeUnsupportedExternalVolumes) Describe(_ context.Context, _ AccountObjectIdentifier) (_ []ExternalVolumeProperty, err error) {
+	err = fakeErrUnsupported("ExternalVolumes.Describe")
+	return
+}
+
+func (fakeUnsupportedExternalVolumes) Drop(_ context.Context, _ *DropExternalVolumeRequest) (err error) {
+	err = fakeErrUnsupported("ExternalVolumes.Drop")
+	return
+}
+
+func (fakeUnsupportedExternalVolumes) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("ExternalVolumes.DropSafely")
+	return
+}
+
+func (fakeUnsupportedExternalVolumes) Show(_ context.Context, _ *ShowExternalVolumeRequest) (_ []ExternalVolume, err error) {
+	err = fakeErrUnsupported("ExternalVolumes.Show")
+	return
+}
+
+func (fakeUnsupportedExternalVolumes) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *ExternalVolume, err error) {
+	err = fakeErrUnsupported("ExternalVolumes.ShowByID")
+	return
+}
+
+func (fakeUnsupportedExternalVolumes) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *ExternalVolume, err error) {
+	err = fakeErrUnsupported("ExternalVolumes.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedExternalTables struct{}
+
+var _ ExternalTables = fakeUnsupportedExternalTables{}
+
+func (fakeUnsupportedExternalTables) Alter(_ context.Context, _ *AlterExternalTableRequest) (err error) {
+	err = fakeErrUnsupported("ExternalTables.Alter")
+	return
+}
+
+func (fakeUnsupportedExternalTables) AlterPartitions(_ context.Context, _ *AlterExternalTablePartitionRequest) (err error) {
+	err = fakeErrUnsupported("ExternalTables.AlterPartitions")
+	return
+}
+
+func (fakeUnsupportedExternalTables) Create(_ context.Context, _ *CreateExternalTableRequest) (err error) {
+	err = fakeErrUnsupported("ExternalTables.Create")
+	return
+}
+
+func (fakeUnsupportedExternalTables) CreateDeltaLake(_ context.Context, _ *CreateDeltaLakeExternalTableRequest) (err error) {
+	err = fakeErrUnsupported("ExternalTables.CreateDeltaLake")
+	return
+}
+
+func (fakeUnsupportedExternalTables) CreateUsingTemplate(_ context.Context, _ *CreateExternalTableUsingTemplateRequest) (err error) {
+	err = fakeErrUnsupported("ExternalTables.CreateUsingTemplate")
+	return
+}
+
+func (fakeUnsupportedExternalTables) CreateWithManualPartitioning(_ context.Context, _ *CreateWithManualPartitioningExternalTableRequest) (err error) {
+	err = fakeErrUnsupported("ExternalTables.CreateWithManualPartitioning")
+	return
+}
+
+func (fakeUnsupportedExternalTables) DescribeColumns(_ context.Context, _ *DescribeExternalTableColumnsRequest) (_ []ExternalTableColumnDetails, err error) {
+	err = fakeErrUnsupported("ExternalTables.DescribeColumns")
+	return
+}
+
+func (fakeUnsupportedExternalTables) DescribeStage(_ context.Context, _ *DescribeExternalTableStageRequest) (_ []ExternalTableStageDetails, err error) {
+	err = fakeErrUnsupported("ExternalTables.DescribeStage")
+	return
+}
+
+func (fakeUnsupportedExternalTables) Drop(_ context.Context, _ *DropExternalTableRequest) (err error) {
+	err = fakeErrUnsupported("ExternalTables.Drop")
+	return
+}
+
+func (fakeUnsupportedExternalTables) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("ExternalTables.DropSafely")
+	return
+}
+
+func (fakeUnsupportedExternalTables) Show(_ context.Context, _ *ShowExternalTableRequest) (_ []ExternalTable, err error) {
+	err = fakeErrUnsupported("ExternalTables.Show")
+	return
+}
+
+func (fakeUnsupportedExternalTables) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *ExternalTable, err error) {
+	err = fakeErrUnsupported("ExternalTables.ShowByID")
+	return
+}
+
+func (fakeUnsupportedExternalTables) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *ExternalTable, err error) {
+	err = fakeErrUnsupported("ExternalTables.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedEventTables struct{}
+
+var _ EventTables = fakeUnsupportedEventTables{}
+
+func (fakeUnsupportedEventTables) Alter(_ context.Context, _ *AlterEventTableRequest) (err error) {
+	err = fakeErrUnsupported("EventTables.Alter")
+	return
+}
+
+func (fakeUnsupportedEventTables) Create(_ context.Context, _ *CreateEventTableRequest) (err error) {
+	err = fakeErrUnsupported("EventTables.Create")
+	return
+}
+
+func (fakeUnsupportedEventTables) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *EventTableDetails, err error) {
+	err = fakeErrUnsupported("EventTables.Describe")
+	return
+}
+
+func (fakeUnsupportedEventTables) Drop(_ context.Context, _ *DropEventTableRequest) (err error) {
+	err = fakeErrUnsupported("EventTables.Drop")
+	return
+}
+
+func (fakeUnsupportedEventTables) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("EventTables.DropSafely")
+	return
+}
+
+func (fakeUnsupportedEventTables) Show(_ context.Context, _ *ShowEventTableRequest) (_ []EventTable, err error) {
+	err = fakeErrUnsupported("EventTables.Show")
+	return
+}
+
+func (fakeUnsupportedEventTables) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *EventTable, err error) {
+	err = fakeErrUnsupported("EventTables.ShowByID")
+	return
+}
+
+func (fakeUnsupportedEventTables) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *EventTable, err error) {
+	err = fakeErrUnsupported("EventTables.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedFailoverGroups struct{}
+
+var _ FailoverGroups = fakeUnsupportedFailoverGroups{}
+
+func (fakeUnsupportedFailoverGroups) AlterSource(_ context.Context, _ AccountObjectIdentifier, _ *AlterSourceFailoverGroupOptions) (err error) {
+	err = fakeErrUnsupported("FailoverGroups.AlterSource")
+	return
+}
+
+func (fakeUnsupportedFailoverGroups) AlterTarget(_ context.Context, _ AccountObjectIdentifier, _ *AlterTargetFailoverGroupOptions) (err error) {
+	err = fakeErrUnsupported("FailoverGroups.AlterTarget")
+	return
+}
+
+func (fakeUnsupportedFailoverGroups) Create(_ context.Context, _ AccountObjectIdentifier, _ []PluralObjectType, _ []AccountIdentifier, _ *CreateFailoverGroupOptions) (err error) {
+	err = fakeErrUnsupported("FailoverGroups.Create")
+	return
+}
+
+func (fakeUnsupportedFailoverGroups) CreateSecondaryReplicationGroup(_ context.Context, _ AccountObjectIdentifier, _ ExternalObjectIdentifier, _ *CreateSecondaryReplicationGroupOptions) (err error) {
+	err = fakeErrUnsupported("FailoverGroups.CreateSecondaryReplicationGroup")
+	return
+}
+
+func (fakeUnsupportedFailoverGroups) Drop(_ context.Context, _ AccountObjectIdentifier, _ *DropFailoverGroupOptions) (err error) {
+	err = fakeErrUnsupported("FailoverGroups.Drop")
+	return
+}
+
+func (fakeUnsupportedFailoverGroups) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("FailoverGroups.DropSafely")
+	return
+}
+
+func (fakeUnsupportedFailoverGroups) Show(_ context.Context, _ *ShowFailoverGroupOptions) (_ []FailoverGroup, err error) {
+	err = fakeErrUnsupported("FailoverGroups.Show")
+	return
+}
+
+func (fakeUnsupportedFailoverGroups) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *FailoverGroup, err error) {
+	err = fakeErrUnsupported("FailoverGroups.ShowByID")
+	return
+}
+
+func (fakeUnsupportedFailoverGroups) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *FailoverGroup, err error) {
+	err = fakeErrUnsupported("FailoverGroups.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedFailoverGroups) ShowDatabases(_ context.Context, _ AccountObjectIdentifier) (_ []AccountObjectIdentifier, err error) {
+	err = fakeErrUnsupported("FailoverGroups.ShowDatabases")
+	return
+}
+
+func (fakeUnsupportedFailoverGroups) ShowShares(_ context.Context, _ AccountObjectIdentifier) (_ []AccountObjectIdentifier, err error) {
+	err = fakeErrUnsupported("FailoverGroups.ShowShares")
+	return
+}
+
+type fakeUnsupportedLegacyFileFormats struct{}
+
+var _ LegacyFileFormats = fakeUnsupportedLegacyFileFormats{}
+
+func (fakeUnsupportedLegacyFileFormats) Alter(_ context.Context, _ SchemaObjectIdentifier, _ *AlterFileFormatOptions) (err error) {
+	err = fakeErrUnsupported("LegacyFileFormats.Alter")
+	return
+}
+
+func (fakeUnsupportedLegacyFileFormats) Create(_ context.Context, _ SchemaObjectIdentifier, _ *CreateFileFormatOptions) (err error) {
+	err = fakeErrUnsupported("LegacyFileFormats.Create")
+	return
+}
+
+func (fakeUnsupportedLegacyFileFormats) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *FileFormatDetails, err error) {
+	err = fakeErrUnsupported("LegacyFileFormats.Describe")
+	return
+}
+
+func (fakeUnsupportedLegacyFileFormats) Drop(_ context.Context, _ SchemaObjectIdentifier, _ *DropFileFormatOptions) (err error) {
+	err = fakeErrUnsupported("LegacyFileFormats.Drop")
+	return
+}
+
+func (fakeUnsupportedLegacyFileFormats) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("LegacyFileFormats.DropSafely")
+	return
+}
+
+func (fakeUnsupportedLegacyFileFormats) Show(_ context.Context, _ *ShowFileFormatsOptions) (_ []FileFormat, err error) {
+	err = fakeErrUnsupported("LegacyFileFormats.Show")
+	return
+}
+
+func (fakeUnsupportedLegacyFileFormats) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *FileFormat, err error) {
+	err = fakeErrUnsupported("LegacyFileFormats.ShowByID")
+	return
+}
+
+func (fakeUnsupportedLegacyFileFormats) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *FileFormat, err error) {
+	err = fakeErrUnsupported("LegacyFileFormats.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedFunctions struct{}
+
+var _ Functions = fakeUnsupportedFunctions{}
+
+func (fakeUnsupportedFunctions) Alter(_ context.Context, _ *AlterFunctionRequest) (err error) {
+	err = fakeErrUnsupported("Functions.Alter")
+	return
+}
+
+func (fakeUnsupportedFunctions) CreateForJava(_ context.Context, _ *CreateForJavaFunctionRequest) (err error) {
+	err = fakeErrUnsupported("Functions.CreateForJava")
+	return
+}
+
+func (fakeUnsupportedFunctions) CreateForJavascript(_ context.Context, _ *CreateForJavascriptFunctionRequest) (err error) {
+	err = fakeErrUnsupported("Functions.CreateForJavascript")
+	return
+}
+
+func (fakeUnsupportedFunctions) CreateForPython(_ context.Context, _ *CreateForPythonFunctionRequest) (err error) {
+	err = fakeErrUnsupported("Functions.CreateForPython")
+	return
+}
+
+func (fakeUnsupportedFunctions) CreateForSQL(_ context.Context, _ *CreateForSQLFunctionRequest) (err error) {
+	err = fakeErrUnsupported("Functions.CreateForSQL")
+	return
+}
+
+func (fakeUnsupportedFunctions) CreateForScala(_ context.Context, _ *CreateForScalaFunctionRequest) (err error) {
+	err = fakeErrUnsupported("Functions.CreateForScala")
+	return
+}
+
+func (fakeUnsupportedFunctions) Describe(_ context.Context, _ SchemaObjectIdentifierWithArguments) (_ []FunctionDetail, err error) {
+	err = fakeErrUnsupported("Functions.Describe")
+	return
+}
+
+func (fakeUnsupportedFunctions) DescribeDetails(_ context.Context, _ SchemaObjectIdentifierWithArguments) (_ *FunctionDetails, err error) {
+	err = fakeErrUnsupported("Functions.DescribeDetails")
+	return
+}
+
+func (fakeUnsupportedFunctions) Drop(_ context.Context, _ *DropFunctionRequest) (err error) {
+	err = fakeErrUnsupported("Functions.Drop")
+	return
+}
+
+func (fakeUnsupportedFunctions) DropSafely(_ context.Context, _ SchemaObjectIdentifierWithArguments) (err error) {
+	err = fakeErrUnsupported("Functions.DropSafely")
+	return
+}
+
+func (fakeUnsupportedFunctions) Show(_ context.Context, _ *ShowFunctionRequest) (_ []Function, err error) {
+	err = fakeErrUnsupported("Functions.Show")
+	return
+}
+
+func (fakeUnsupportedFunctions) ShowByID(_ context.Context, _ SchemaObjectIdentifierWithArguments) (_ *Function, err error) {
+	err = fakeErrUnsupported("Functions.ShowByID")
+	return
+}
+
+func (fakeUnsupportedFunctions) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifierWithArguments) (_ *Function, err error) {
+	err = fakeErrUnsupported("Functions.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedFunctions) ShowParameters(_ context.Context, _ SchemaObjectIdentifierWithArguments) (_ []*Parameter, err error) {
+	err = fakeErrUnsupported("Functions.ShowParameters")
+	return
+}
+
+type fakeUnsupportedGitRepositories struct{}
+
+var _ GitRepositories = fakeUnsupportedGitRepositories{}
+
+func (fakeUnsupportedGitRepositories) Alter(_ context.Context, _ *AlterGitRepositoryRequest) (err error) {
+	err = fakeErrUnsupported("GitRepositories.Alter")
+	return
+}
+
+func (fakeUnsupportedGitRepositories) Create(_ context.Context, _ *CreateGitRepositoryRequest) (err error) {
+	err = fakeErrUnsupported("GitRepositories.Create")
+	return
+}
+
+func (fakeUnsupportedGitRepositories) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *GitRepository, err error) {
+	err = fakeErrUnsupported("GitRepositories.Describe")
+	return
+}
+
+func (fakeUnsupportedGitRepositories) Drop(_ context.Context, _ *DropGitRepositoryRequest) (err error) {
+	err = fakeErrUnsupported("GitRepositories.Drop")
+	return
+}
+
+func (fakeUnsupportedGitRepositories) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("GitRepositories.DropSafely")
+	return
+}
+
+func (fakeUnsupportedGitRepositories) Show(_ context.Context, _ *ShowGitRepositoryRequest) (_ []GitRepository, err error) {
+	err = fakeErrUnsupported("GitRepositories.Show")
+	return
+}
+
+func (fakeUnsupportedGitRepositories) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *GitRepository, err error) {
+	err = fakeErrUnsupported("GitRepositories.ShowByID")
+	return
+}
+
+func (fakeUnsupportedGitRepositories) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *GitRepository, err error) {
+	err = fakeErrUnsupported("GitRepositories.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedGitRepositories) ShowGitBranches(_ context.Context, _ *ShowGitBranchesGitRepositoryRequest) (_ []GitBranch, err error) {
+	err = fakeErrUnsupported("GitRepositories.ShowGitBranches")
+	return
+}
+
+func (fakeUnsupportedGitRepositories) ShowGitTags(_ context.Context, _ *ShowGitTagsGitRepositoryRequest) (_ []GitTag, err error) {
+	err = fakeErrUnsupported("GitRepositories.ShowGitTags")
+	return
+}
+
+type fakeUnsupportedGrants struct{}
+
+var _ Grants = fakeUnsupportedGrants{}
+
+func (fakeUnsupportedGrants) GrantOwnership(_ context.Context, _ OwnershipGrantOn, _ OwnershipGrantTo, _ *GrantOwnershipOptions) (err error) {
+	err = fakeErrUnsupported("Grants.GrantOwnership")
+	return
+}
+
+func (fakeUnsupportedGrants) GrantPrivilegeToShare(_ context.Context, _ []ObjectPrivilege, _ *ShareGrantOn, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Grants.GrantPrivilegeToShare")
+	return
+}
+
+func (fakeUnsupportedGrants) GrantPrivilegesToAccountRole(_ context.Context, _ *AccountRoleGrantPrivileges, _ *AccountRoleGrantOn, _ AccountObjectIdentifier, _ *GrantPrivilegesToAccountRoleOptions) (err error) {
+	err = fakeErrUnsupported("Grants.GrantPrivilegesToAccountRole")
+	return
+}
+
+func (fakeUnsupportedGrants) GrantPrivilegesToDatabaseRole(_ context.Context, _ *DatabaseRoleGrantPrivileges, _ *DatabaseRoleGrantOn, _ DatabaseObjectIdentifier, _ *GrantPrivilegesToDatabaseRoleOptions) (err error) {
+	err = fakeErrUnsupported("Grants.GrantPrivilegesToDatabaseRole")
+	return
+}
+
+func (fakeUnsupportedGrants) RevokePrivilegeFromShare(_ context.Context, _ []ObjectPrivilege, _ *ShareGrantOn, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Grants.RevokePrivilegeFromShare")
+	return
+}
+
+func (fakeUnsupportedGrants) RevokePrivilegeFromShareSafely(_ context.Context, _ []ObjectPrivilege, _ *ShareGrantOn, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Grants.RevokePrivilegeFromShareSafely")
+	return
+}
+
+func (fakeUnsupportedGrants) RevokePrivilegesFromAccountRole(_ context.Context, _ *AccountRoleGrantPrivileges, _ *AccountRoleGrantOn, _ AccountObjectIdentifier, _ *RevokePrivilegesFromAccountRoleOptions) (err error) {
+	err = fakeErrUnsupported("Grants.RevokePrivilegesFromAccountRole")
+	return
+}
+
+func (fakeUnsupportedGrants) RevokePrivilegesFromAccountRoleSafely(_ context.Context, _ *AccountRoleGrantPrivileges, _ *AccountRoleGrantOn, _ AccountObjectIdentifier, _ *RevokePrivilegesFromAccountRoleOptions) (err error) {
+	err = fakeErrUnsupported("Grants.RevokePrivilegesFromAccountRoleSafely")
+	return
+}
+
+func (fakeUnsupportedGrants) RevokePrivilegesFromDatabaseRole(_ context.Context, _ *DatabaseRoleGrantPrivileges, _ *DatabaseRoleGrantOn, _ DatabaseObjectIdentifier, _ *RevokePrivilegesFromDatabaseRoleOptions) (err error) {
+	err = fakeErrUnsupported("Grants.RevokePrivilegesFromDatabaseRole")
+	return
+}
+
+func (fakeUnsupportedGrants) RevokePrivilegesFromDatabaseRoleSafely(_ context.Context, _ *DatabaseRoleGrantPrivileges, _ *DatabaseRoleGrantOn, _ DatabaseObjectIdentifier, _ *RevokePrivilegesFromDatabaseRoleOptions) (err error) {
+	err = fakeErrUnsupported("Grants.RevokePrivilegesFromDatabaseRoleSafely")
+	return
+}
+
+func (fakeUnsupportedGrants) Show(_ context.Context, _ *ShowGrantOptions) (_ []Grant, err error) {
+	err = fakeErrUnsupported("Grants.Show")
+	return
+}
+
+type fakeUnsupportedHybridTables struct{}
+
+var _ HybridTables = fakeUnsupportedHybridTables{}
+
+func (fakeUnsupportedHybridTables) Alter(_ context.Context, _ *AlterHybridTableRequest) (err error) {
+	err = fakeErrUnsupported("HybridTables.Alter")
+	return
+}
+
+func (fakeUnsupportedHybridTables) Create(_ context.Context, _ *CreateHybridTableRequest) (err error) {
+	err = fakeErrUnsupported("HybridTables.Create")
+	return
+}
+
+func (fakeUnsupportedHybridTables) CreateIndex(_ context.Context, _ *CreateIndexHybridTableRequest) (err error) {
+	err = fakeErrUnsupported("HybridTables.CreateIndex")
+	return
+}
+
+func (fakeUnsupportedHybridTables) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ []HybridTableDetails, err error) {
+	err = fakeErrUnsupported("HybridTables.Describe")
+	return
+}
+
+func (fakeUnsupportedHybridTables) Drop(_ context.Context, _ *DropHybridTableRequest) (err error) {
+	err = fakeErrUnsupported("HybridTables.Drop")
+	return
+}
+
+func (fakeUnsupportedHybridTables) DropIndex(_ context.Context, _ *DropIndexHybridTableRequest) (err error) {
+	err = fakeErrUnsupported("HybridTables.DropIndex")
+	return
+}
+
+func (fakeUnsupportedHybridTables) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("HybridTables.DropSafely")
+	return
+}
+
+func (fakeUnsupportedHybridTables) Show(_ context.Context, _ *ShowHybridTableRequest) (_ []HybridTable, err error) {
+	err = fakeErrUnsupported("HybridTables.Show")
+	return
+}
+
+func (fakeUnsupportedHybridTables) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *HybridTable, err error) {
+	err = fakeErrUnsupported("HybridTables.ShowByID")
+	return
+}
+
+func (fakeUnsupportedHybridTables) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *HybridTable, err error) {
+	err = fakeErrUnsupported("HybridTables.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedHybridTables) ShowIndexes(_ context.Context, _ *ShowIndexesHybridTableRequest) (_ []HybridTableIndex, err error) {
+	err = fakeErrUnsupported("HybridTables.ShowIndexes")
+	return
+}
+
+type fakeUnsupportedImageRepositories struct{}
+
+var _ ImageRepositories = fakeUnsupportedImageRepositories{}
+
+func (fakeUnsupportedImageRepositories) Alter(_ context.Context, _ *AlterImageRepositoryRequest) (err error) {
+	err = fakeErrUnsupported("ImageRepositories.Alter")
+	return
+}
+
+func (fakeUnsupportedImageRepositories) Create(_ context.Context, _ *CreateImageRepositoryRequest) (err error) {
+	err = fakeErrUnsupported("ImageRepositories.Create")
+	return
+}
+
+func (fakeUnsupportedImageRepositories) Drop(_ context.Context, _ *DropImageRepositoryRequest) (err error) {
+	err = fakeErrUnsupported("ImageRepositories.Drop")
+	return
+}
+
+func (fakeUnsupportedImageRepositories) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("ImageRepositories.DropSafely")
+	return
+}
+
+func (fakeUnsupportedImageRepositories) Show(_ context.Context, _ *ShowImageRepositoryRequest) (_ []ImageRepository, err error) {
+	err = fakeErrUnsupported("ImageRepositories.Show")
+	return
+}
+
+func (fakeUnsupportedImageRepositories) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *ImageRepository, err error) {
+	err = fakeErrUnsupported("ImageRepositories.ShowByID")
+	return
+}
+
+func (fakeUnsupportedImageRepositories) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *ImageRepository, err error) {
+	err = fakeErrUnsupported("ImageRepositories.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedJoinPolicies struct{}
+
+var _ JoinPolicies = fakeUnsupportedJoinPolicies{}
+
+func (fakeUnsupportedJoinPolicies) Alter(_ context.Context, _ *AlterJoinPolicyRequest) (err error) {
+	err = fakeErrUnsupported("JoinPolicies.Alter")
+	return
+}
+
+func (fakeUnsupportedJoinPolicies) Create(_ context.Context, _ *CreateJoinPolicyRequest) (err error) {
+	err = fakeErrUnsupported("JoinPolicies.Create")
+	return
+}
+
+func (fakeUnsupportedJoinPolicies) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *JoinPolicyDescription, err error) {
+	err = fakeErrUnsupported("JoinPolicies.Describe")
+	return
+}
+
+func (fakeUnsupportedJoinPolicies) Drop(_ context.Context, _ *DropJoinPolicyRequest) (err error) {
+	err = fakeErrUnsupported("JoinPolicies.Drop")
+	return
+}
+
+func (fakeUnsupportedJoinPolicies) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("JoinPolicies.DropSafely")
+	return
+}
+
+func (fakeUnsupportedJoinPolicies) Show(_ context.Context, _ *ShowJoinPolicyRequest) (_ []JoinPolicy, err error) {
+	err = fakeErrUnsupported("JoinPolicies.Show")
+	return
+}
+
+func (fakeUnsupportedJoinPolicies) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *JoinPolicy, err error) {
+	err = fakeErrUnsupported("JoinPolicies.ShowByID")
+	return
+}
+
+func (fakeUnsupportedJoinPolicies) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *JoinPolicy, err error) {
+	err = fakeErrUnsupported("JoinPolicies.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedListings struct{}
+
+var _ Listings = fakeUnsupportedListings{}
+
+func (fakeUnsupportedListings) Alter(_ context.Context, _ *AlterListingRequest) (err error) {
+	err = fakeErrUnsupported("Listings.Alter")
+	return
+}
+
+func (fakeUnsupportedListings) Create(_ context.Context, _ *CreateListingRequest) (err error) {
+	err = fakeErrUnsupported("Listings.Create")
+	return
+}
+
+func (fakeUnsupportedListings) Describe(_ context.Context, _ *DescribeListingRequest) (_ *ListingDetails, err error) {
+	err = fakeErrUnsupported("Listings.Describe")
+	return
+}
+
+func (fakeUnsupportedListings) Drop(_ context.Context, _ *DropListingRequest) (err error) {
+	err = fakeErrUnsupported("Listings.Drop")
+	return
+}
+
+func (fakeUnsupportedListings) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Listings.DropSafely")
+	return
+}
+
+func (fakeUnsupportedListings) Show(_ context.Context, _ *ShowListingRequest) (_ []Listing, err error) {
+	err = fakeErrUnsupported("Listings.Show")
+	return
+}
+
+func (fakeUnsupportedListings) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *Listing, err error) {
+	err = fakeErrUnsupported("Listings.ShowByID")
+	return
+}
+
+func (fakeUnsupportedListings) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *Listing, err error) {
+	err = fakeErrUnsupported("Listings.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedListings) ShowVersions(_ context.Context, _ *ShowVersionsListingRequest) (_ []ListingVersion, err error) {
+	err = fakeErrUnsupported("Listings.ShowVersions")
+	return
+}
+
+type fakeUnsupportedManagedAccounts struct{}
+
+var _ ManagedAccounts = fakeUnsupportedManagedAccounts{}
+
+func (fakeUnsupportedManagedAccounts) Create(_ context.Context, _ *CreateManagedAccountRequest) (err error) {
+	err = fakeErrUnsupported("ManagedAccounts.Create")
+	return
+}
+
+func (fakeUnsupportedManagedAccounts) Drop(_ context.Context, _ *DropManagedAccountRequest) (err error) {
+	err = fakeErrUnsupported("ManagedAccounts.Drop")
+	return
+}
+
+func (fakeUnsupportedManagedAccounts) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("ManagedAccounts.DropSafely")
+	return
+}
+
+func (fakeUnsupportedManagedAccounts) Show(_ context.Context, _ *ShowManagedAccountRequest) (_ []ManagedAccount, err error) {
+	err = fakeErrUnsupported("ManagedAccounts.Show")
+	return
+}
+
+func (fakeUnsupportedManagedAccounts) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *ManagedAccount, err error) {
+	err = fakeErrUnsupported("ManagedAccounts.ShowByID")
+	return
+}
+
+func (fakeUnsupportedManagedAccounts) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *ManagedAccount, err error) {
+	err = fakeErrUnsupported("ManagedAccounts.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedMaskingPolicies struct{}
+
+var _ MaskingPolicies = fakeUnsupportedMaskingPolicies{}
+
+func (fakeUnsupportedMaskingPolicies) Alter(_ context.Context, _ SchemaObjectIdentifier, _ *AlterMaskingPolicyOptions) (err error) {
+	err = fakeErrUnsupported("MaskingPolicies.Alter")
+	return
+}
+
+func (fakeUnsupportedMaskingPolicies) Create(_ context.Context, _ SchemaObjectIdentifier, _ []TableColumnSignature, _ datatypes.DataType, _ string, _ *CreateMaskingPolicyOptions) (err error) {
+	err = fakeErrUnsupported("MaskingPolicies.Create")
+	return
+}
+
+func (fakeUnsupportedMaskingPolicies) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *MaskingPolicyDetails, err error) {
+	err = fakeErrUnsupported("MaskingPolicies.Describe")
+	return
+}
+
+func (fakeUnsupportedMaskingPolicies) Drop(_ context.Context, _ SchemaObjectIdentifier, _ *DropMaskingPolicyOptions) (err error) {
+	err = fakeErrUnsupported("MaskingPolicies.Drop")
+	return
+}
+
+func (fakeUnsupportedMaskingPolicies) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("MaskingPolicies.DropSafely")
+	return
+}
+
+func (fakeUnsupportedMaskingPolicies) Show(_ context.Context, _ *ShowMaskingPolicyOptions) (_ []MaskingPolicy, err error) {
+	err = fakeErrUnsupported("MaskingPolicies.Show")
+	return
+}
+
+func (fakeUnsupportedMaskingPolicies) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *MaskingPolicy, err error) {
+	err = fakeErrUnsupported("MaskingPolicies.ShowByID")
+	return
+}
+
+func (fakeUnsupportedMaskingPolicies) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *MaskingPolicy, err error) {
+	err = fakeErrUnsupported("MaskingPolicies.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedMaterializedViews struct{}
+
+var _ MaterializedViews = fakeUnsupportedMaterializedViews{}
+
+func (fakeUnsupportedMaterializedViews) Alter(_ context.Context, _ *AlterMaterializedViewRequest) (err error) {
+	err = fakeErrUnsupported("MaterializedViews.Alter")
+	return
+}
+
+func (fakeUnsupportedMaterializedViews) Create(_ context.Context, _ *CreateMaterializedViewRequest) (err error) {
+	err = fakeErrUnsupported("MaterializedViews.Create")
+	return
+}
+
+func (fakeUnsupportedMaterializedViews) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ []MaterializedViewDetails, err error) {
+	err = fakeErrUnsupported("MaterializedViews.Describe")
+	return
+}
+
+func (fakeUnsupportedMaterializedViews) Drop(_ context.Context, _ *DropMaterializedViewRequest) (err error) {
+	err = fakeErrUnsupported("MaterializedViews.Drop")
+	return
+}
+
+func (fakeUnsupportedMaterializedViews) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("MaterializedViews.DropSafely")
+	return
+}
+
+func (fakeUnsupportedMaterializedViews) Show(_ context.Context, _ *ShowMaterializedViewRequest) (_ []MaterializedView, err error) {
+	err = fakeErrUnsupported("MaterializedViews.Show")
+	return
+}
+
+func (fakeUnsupportedMaterializedViews) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *MaterializedView, err error) {
+	err = fakeErrUnsupported("MaterializedViews.ShowByID")
+	return
+}
+
+func (fakeUnsupportedMaterializedViews) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *MaterializedView, err error) {
+	err = fakeErrUnsupported("MaterializedViews.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedNetworkPolicies struct{}
+
+var _ NetworkPolicies = fakeUnsupportedNetworkPolicies{}
+
+func (fakeUnsupportedNetworkPolicies) Alter(_ context.Context, _ *AlterNetworkPolicyRequest) (err error) {
+	err = fakeErrUnsupported("NetworkPolicies.Alter")
+	return
+}
+
+func (fakeUnsupportedNetworkPolicies) Create(_ context.Context, _ *CreateNetworkPolicyRequest) (err error) {
+	err = fakeErrUnsupported("NetworkPolicies.Create")
+	return
+}
+
+func (fakeUnsupportedNetworkPolicies) Describe(_ context.Context, _ AccountObjectIdentifier) (_ []NetworkPolicyProperty, err error) {
+	err = fakeErrUnsupported("NetworkPolicies.Describe")
+	return
+}
+
+func (fakeUnsupportedNetworkPolicies) Drop(_ context.Context, _ *DropNetworkPolicyRequest) (err error) {
+	err = fakeErrUnsupported("NetworkPolicies.Drop")
+	return
+}
+
+func (fakeUnsupportedNetworkPolicies) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("NetworkPolicies.DropSafely")
+	return
+}
+
+func (fakeUnsupportedNetworkPolicies) Show(_ context.Context, _ *ShowNetworkPolicyRequest) (_ []NetworkPolicy, err error) {
+	err = fakeErrUnsupported("NetworkPolicies.Show")
+	return
+}
+
+func (fakeUnsupportedNetworkPolicies) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *NetworkPolicy, err error) {
+	err = fakeErrUnsupported("NetworkPolicies.ShowByID")
+	return
+}
+
+func (fakeUnsupportedNetworkPolicies) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *NetworkPolicy, err error) {
+	err = fakeErrUnsupported("NetworkPolicies.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedNetworkRules struct{}
+
+var _ NetworkRules = fakeUnsupportedNetworkRules{}
+
+func (fakeUnsupportedNetworkRules) Alter(_ context.Context, _ *AlterNetworkRuleRequest) (err error) {
+	err = fakeErrUnsupported("NetworkRules.Alter")
+	return
+}
+
+func (fakeUnsupportedNetworkRules) Create(_ context.Context, _ *CreateNetworkRuleRequest) (err error) {
+	err = fakeErrUnsupported("NetworkRules.Create")
+	return
+}
+
+func (fakeUnsupportedNetworkRules) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *NetworkRuleDetails, err error) {
+	err = fakeErrUnsupported("NetworkRules.Describe")
+	return
+}
+
+func (fakeUnsupportedNetworkRules) Drop(_ context.Context, _ *DropNetworkRuleRequest) (err error) {
+	err = fakeErrUnsupported("NetworkRules.Drop")
+	return
+}
+
+func (fakeUnsupportedNetworkRules) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("NetworkRules.DropSafely")
+	return
+}
+
+func (fakeUnsupportedNetworkRules) Show(_ context.Context, _ *ShowNetworkRuleRequest) (_ []NetworkRule, err error) {
+	err = fakeErrUnsupported("NetworkRules.Show")
+	return
+}
+
+func (fakeUnsupportedNetworkRules) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *NetworkRule, err error) {
+	err = fakeErrUnsupported("NetworkRules.ShowByID")
+	return
+}
+
+func (fakeUnsupportedNetworkRules) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *NetworkRule, err error) {
+	err = fakeErrUnsupported("NetworkRules.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedNotebooks struct{}
+
+var _ Notebooks = fakeUnsupportedNotebooks{}
+
+func (fakeUnsupportedNotebooks) Alter(_ context.Context, _ *AlterNotebookRequest) (err error) {
+	err = fakeErrUnsupported("Notebooks.Alter")
+	return
+}
+
+func (fakeUnsupportedNotebooks) Create(_ context.Context, _ *CreateNotebookRequest) (err error) {
+	err = fakeErrUnsupported("Notebooks.Create")
+	return
+}
+
+func (fakeUnsupportedNotebooks) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *NotebookDetails, err error) {
+	err = fakeErrUnsupported("Notebooks.Describe")
+	return
+}
+
+func (fakeUnsupportedNotebooks) Drop(_ context.Context, _ *DropNotebookRequest) (err error) {
+	err = fakeErrUnsupported("Notebooks.Drop")
+	return
+}
+
+func (fakeUnsupportedNotebooks) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Notebooks.DropSafely")
+	return
+}
+
+func (fakeUnsupportedNotebooks) Show(_ context.Context, _ *ShowNotebookRequest) (_ []Notebook, err error) {
+	err = fakeErrUnsupported("Notebooks.Show")
+	return
+}
+
+func (fakeUnsupportedNotebooks) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *Notebook, err error) {
+	err = fakeErrUnsupported("Notebooks.ShowByID")
+	return
+}
+
+func (fakeUnsupportedNotebooks) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *Notebook, err error) {
+	err = fakeErrUnsupported("Notebooks.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedNotificationIntegrations struct{}
+
+var _ NotificationIntegrations = fakeUnsupportedNotificationIntegrations{}
+
+func (fakeUnsupportedNotificationIntegrations) Alter(_ context.Context, _ *AlterNotificationIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("NotificationIntegrations.Alter")
+	return
+}
+
+func (fakeUnsupportedNotificationIntegrations) Create(_ context.Context, _ *CreateNotificationIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("NotificationIntegrations.Create")
+	return
+}
+
+func (fakeUnsupportedNotificationIntegrations) Describe(_ context.Context, _ AccountObjectIdentifier) (_ []NotificationIntegrationProperty, err error) {
+	err = fakeErrUnsupported("NotificationIntegrations.Describe")
+	return
+}
+
+func (fakeUnsupportedNotificationIntegrations) Drop(_ context.Context, _ *DropNotificationIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("NotificationIntegrations.Drop")
+	return
+}
+
+func (fakeUnsupportedNotificationIntegrations) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("NotificationIntegrations.DropSafely")
+	return
+}
+
+func (fakeUnsupportedNotificationIntegrations) Show(_ context.Context, _ *ShowNotificationIntegrationRequest) (_ []NotificationIntegration, err error) {
+	err = fakeErrUnsupported("NotificationIntegrations.Show")
+	return
+}
+
+func (fakeUnsupportedNotificationIntegrations) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *NotificationIntegration, err error) {
+	err = fakeErrUnsupported("NotificationIntegrations.ShowByID")
+	return
+}
+
+func (fakeUnsupportedNotificationIntegrations) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *NotificationIntegration, err error) {
+	err = fakeErrUnsupported("NotificationIntegrations.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedOpenflowConnectors struct{}
+
+var _ OpenflowConnectors = fakeUnsupportedOpenflowConnectors{}
+
+func (fakeUnsupportedOpenflowConnectors) Alter(_ context.Context, _ *AlterOpenflowConnectorRequest) (err error) {
+	err = fakeErrUnsupported("OpenflowConnectors.Alter")
+	return
+}
+
+func (fakeUnsupportedOpenflowConnectors) Create(_ context.Context, _ *CreateOpenflowConnectorRequest) (err error) {
+	err = fakeErrUnsupported("OpenflowConnectors.Create")
+	return
+}
+
+func (fakeUnsupportedOpenflowConnectors) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *OpenflowConnectorDetails, err error) {
+	err = fakeErrUnsupported("OpenflowConnectors.Describe")
+	return
+}
+
+func (fakeUnsupportedOpenflowConnectors) Drop(_ context.Context, _ *DropOpenflowConnectorRequest) (err error) {
+	err = fakeErrUnsupported("OpenflowConnectors.Drop")
+	return
+}
+
+func (fakeUnsupportedOpenflowConnectors) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("OpenflowConnectors.DropSafely")
+	return
+}
+
+func (fakeUnsupportedOpenflowConnectors) Show(_ context.Context, _ *ShowOpenflowConnectorRequest) (_ []OpenflowConnector, err error) {
+	err = fakeErrUnsupported("OpenflowConnectors.Show")
+	return
+}
+
+func (fakeUnsupportedOpenflowConnectors) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *OpenflowConnector, err error) {
+	err = fakeErrUnsupported("OpenflowConnectors.ShowByID")
+	return
+}
+
+func (fakeUnsupportedOpenflowConnectors) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *OpenflowConnector, err error) {
+	err = fakeErrUnsupported("OpenflowConnectors.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedOpenflowDeployments struct{}
+
+var _ OpenflowDeployments = fakeUnsupportedOpenflowDeployments{}
+
+func (fakeUnsupportedOpenflowDeployments) Alter(_ context.Context, _ *AlterOpenflowDeploymentRequest) (err error) {
+	err = fakeErrUnsupported("OpenflowDeployments.Alter")
+	return
+}
+
+func (fakeUnsupportedOpenflowDeployments) Create(_ context.Context, _ *CreateOpenflowDeploymentRequest) (err error) {
+	err = fakeErrUnsupported("OpenflowDeployments.Create")
+	return
+}
+
+func (fakeUnsupportedOpenflowDeployments) Describe(_ context.Context, _ AccountObjectIdentifier) (_ *OpenflowDeploymentDetails, err error) {
+	err = fakeErrUnsupported("OpenflowDeployments.Describe")
+	return
+}
+
+func (fakeUnsupportedOpenflowDeployments) Drop(_ context.Context, _ *DropOpenflowDeploymentRequest) (err error) {
+	err = fakeErrUnsupported("OpenflowDeployments.Drop")
+	return
+}
+
+func (fakeUnsupportedOpenflowDeployments) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("OpenflowDeployments.DropSafely")
+	return
+}
+
+func (fakeUnsupportedOpenflowDeployments) Show(_ context.Context, _ *ShowOpenflowDeploymentRequest) (_ []OpenflowDeployment, err error) {
+	err = fakeErrUnsupported("OpenflowDeployments.Show")
+	return
+}
+
+func (fakeUnsupportedOpenflowDeployments) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *OpenflowDeployment, err error) {
+	err = fakeErrUnsupported("OpenflowDeployments.ShowByID")
+	return
+}
+
+func (fakeUnsupportedOpenflowDeployments) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *OpenflowDeployment, err error) {
+	err = fakeErrUnsupported("OpenflowDeployments.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedOpenflowRuntimes struct{}
+
+var _ OpenflowRuntimes = fakeUnsupportedOpenflowRuntimes{}
+
+func (fakeUnsupportedOpenflowRuntimes) Alter(_ context.Context, _ *AlterOpenflowRuntimeRequest) (err error) {
+	err = fakeErrUnsupported("OpenflowRuntimes.Alter")
+	return
+}
+
+func (fakeUnsupportedOpenflowRuntimes) Create(_ context.Context, _ *CreateOpenflowRuntimeRequest) (err error) {
+	err = fakeErrUnsupported("OpenflowRuntimes.Create")
+	return
+}
+
+func (fakeUnsupportedOpenflowRuntimes) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *OpenflowRuntimeDetails, err error) {
+	err = fakeErrUnsupported("OpenflowRuntimes.Describe")
+	return
+}
+
+func (fakeUnsupportedOpenflowRuntimes) Drop(_ context.Context, _ *DropOpenflowRuntimeRequest) (err error) {
+	err = fakeErrUnsupported("OpenflowRuntimes.Drop")
+	return
+}
+
+func (fakeUnsupportedOpenflowRuntimes) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("OpenflowRuntimes.DropSafely")
+	return
+}
+
+func (fakeUnsupportedOpenflowRuntimes) Show(_ context.Context, _ *ShowOpenflowRuntimeRequest) (_ []OpenflowRuntime, err error) {
+	err = fakeErrUnsupported("OpenflowRuntimes.Show")
+	return
+}
+
+func (fakeUnsupportedOpenflowRuntimes) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *OpenflowRuntime, err error) {
+	err = fakeErrUnsupported("OpenflowRuntimes.ShowByID")
+	return
+}
+
+func (fakeUnsupportedOpenflowRuntimes) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *OpenflowRuntime, err error) {
+	err = fakeErrUnsupported("OpenflowRuntimes.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedOrganizationAccounts struct{}
+
+var _ OrganizationAccounts = fakeUnsupportedOrganizationAccounts{}
+
+func (fakeUnsupportedOrganizationAccounts) Alter(_ context.Context, _ *AlterOrganizationAccountRequest) (err error) {
+	err = fakeErrUnsupported("OrganizationAccounts.Alter")
+	return
+}
+
+func (fakeUnsupportedOrganizationAccounts) Create(_ context.Context, _ *CreateOrganizationAccountRequest) (err error) {
+	err = fakeErrUnsupported("OrganizationAccounts.Create")
+	return
+}
+
+func (fakeUnsupportedOrganizationAccounts) SetPolicySafely(_ context.Context, _ PolicyKind, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("OrganizationAccounts.SetPolicySafely")
+	return
+}
+
+func (fakeUnsupportedOrganizationAccounts) Show(_ context.Context, _ *ShowOrganizationAccountRequest) (_ []OrganizationAccount, err error) {
+	err = fakeErrUnsupported("OrganizationAccounts.Show")
+	return
+}
+
+func (fakeUnsupportedOrganizationAccounts) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *OrganizationAccount, err error) {
+	err = fakeErrUnsupported("OrganizationAccounts.ShowByID")
+	return
+}
+
+func (fakeUnsupportedOrganizationAccounts) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *OrganizationAccount, err error) {
+	err = fakeErrUnsupported("OrganizationAccounts.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedOrganizationAccounts) ShowParameters(_ context.Context) (_ []*Parameter, err error) {
+	err = fakeErrUnsupported("OrganizationAccounts.ShowParameters")
+	return
+}
+
+func (fakeUnsupportedOrganizationAccounts) UnsetAll(_ context.Context) (err error) {
+	err = fakeErrUnsupported("OrganizationAccounts.UnsetAll")
+	return
+}
+
+func (fakeUnsupportedOrganizationAccounts) UnsetAllParameters(_ context.Context) (err error) {
+	err = fakeErrUnsupported("OrganizationAccounts.UnsetAllParameters")
+	return
+}
+
+func (fakeUnsupportedOrganizationAccounts) UnsetPolicySafely(_ context.Context, _ PolicyKind) (err error) {
+	err = fakeErrUnsupported("OrganizationAccounts.UnsetPolicySafely")
+	return
+}
+
+type fakeUnsupportedParameters struct{}
+
+var _ Parameters = fakeUnsupportedParameters{}
+
+func (fakeUnsupportedParameters) SetAccountParameter(_ context.Context, _ AccountParameter, _ string) (err error) {
+	err = fakeErrUnsupported("Parameters.SetAccountParameter")
+	return
+}
+
+func (fakeUnsupportedParameters) SetObjectParameterOnAccount(_ context.Context, _ ObjectParameter, _ string) (err error) {
+	err = fakeErrUnsupported("Parameters.SetObjectParameterOnAccount")
+	return
+}
+
+func (fakeUnsupportedParameters) SetObjectParameterOnObject(_ context.Context, _ Object, _ ObjectParameter, _ string) (err error) {
+	err = fakeErrUnsupported("Parameters.SetObjectParameterOnObject")
+	return
+}
+
+func (fakeUnsupportedParameters) SetSessionParameterOnAccount(_ context.Context, _ SessionParameter, _ string) (err error) {
+	err = fakeErrUnsupported("Parameters.SetSessionParameterOnAccount")
+	return
+}
+
+func (fakeUnsupportedParameters) SetSessionParameterOnUser(_ context.Context, _ AccountObjectIdentifier, _ SessionParameter, _ string) (err error) {
+	err = fakeErrUnsupported("Parameters.SetSessionParameterOnUser")
+	return
+}
+
+func (fakeUnsupportedParameters) ShowAccountParameter(_ context.Context, _ AccountParameter) (_ *Parameter, err error) {
+	err = fakeErrUnsupported("Parameters.ShowAccountParameter")
+	return
+}
+
+func (fakeUnsupportedParameters) ShowObjectParameter(_ context.Context, _ ObjectParameter, _ Object) (_ *Parameter, err error) {
+	err = fakeErrUnsupported("Parameters.ShowObjectParameter")
+	return
+}
+
+func (fakeUnsupportedParameters) ShowParameters(_ context.Context, _ *ShowParametersOptions) (_ []*Parameter, err error) {
+	err = fakeErrUnsupported("Parameters.ShowParameters")
+	return
+}
+
+func (fakeUnsupportedParameters) ShowSessionParameter(_ context.Context, _ SessionParameter) (_ *Parameter, err error) {
+	err = fakeErrUnsupported("Parameters.ShowSessionParameter")
+	return
+}
+
+func (fakeUnsupportedParameters) ShowUserParameter(_ context.Context, _ UserParameter, _ AccountObjectIdentifier) (_ *Parameter, err error) {
+	err = fakeErrUnsupported("Parameters.ShowUserParameter")
+	return
+}
+
+func (fakeUnsupportedParameters) UnsetAccountParameter(_ context.Context, _ AccountParameter) (err error) {
+	err = fakeErrUnsupported("Parameters.UnsetAccountParameter")
+	return
+}
+
+type fakeUnsupportedPackagesPolicies struct{}
+
+var _ PackagesPolicies = fakeUnsupportedPackagesPolicies{}
+
+func (fakeUnsupportedPackagesPolicies) Alter(_ context.Context, _ *AlterPackagesPolicyRequest) (err error) {
+	err = fakeErrUnsupported("PackagesPolicies.Alter")
+	return
+}
+
+func (fakeUnsupportedPackagesPolicies) Create(_ context.Context, _ *CreatePackagesPolicyRequest) (err error) {
+	err = fakeErrUnsupported("PackagesPolicies.Create")
+	return
+}
+
+func (fakeUnsupportedPackagesPolicies) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *PackagesPolicyDescription, err error) {
+	err = fakeErrUnsupported("PackagesPolicies.Describe")
+	return
+}
+
+func (fakeUnsupportedPackagesPolicies) Drop(_ context.Context, _ *DropPackagesPolicyRequest) (err error) {
+	err = fakeErrUnsupported("PackagesPolicies.Drop")
+	return
+}
+
+func (fakeUnsupportedPackagesPolicies) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("PackagesPolicies.DropSafely")
+	return
+}
+
+func (fakeUnsupportedPackagesPolicies) Show(_ context.Context, _ *ShowPackagesPolicyRequest) (_ []PackagesPolicy, err error) {
+	err = fakeErrUnsupported("PackagesPolicies.Show")
+	return
+}
+
+func (fakeUnsupportedPackagesPolicies) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *PackagesPolicy, err error) {
+	err = fakeErrUnsupported("PackagesPolicies.ShowByID")
+	return
+}
+
+func (fakeUnsupportedPackagesPolicies) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *PackagesPolicy, err error) {
+	err = fakeErrUnsupported("PackagesPolicies.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedPasswordPolicies struct{}
+
+var _ PasswordPolicies = fakeUnsupportedPasswordPolicies{}
+
+func (fakeUnsupportedPasswordPolicies) Alter(_ context.Context, _ *AlterPasswordPolicyRequest) (err error) {
+	err = fakeErrUnsupported("PasswordPolicies.Alter")
+	return
+}
+
+func (fakeUnsupportedPasswordPolicies) Create(_ context.Context, _ *CreatePasswordPolicyRequest) (err error) {
+	err = fakeErrUnsupported("PasswordPolicies.Create")
+	return
+}
+
+func (fakeUnsupportedPasswordPolicies) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ []PasswordPolicyProperty, err error) {
+	err = fakeErrUnsupported("PasswordPolicies.Describe")
+	return
+}
+
+func (fakeUnsupportedPasswordPolicies) DescribeDetails(_ context.Context, _ SchemaObjectIdentifier) (_ *PasswordPolicyDetails, err error) {
+	err = fakeErrUnsupported("PasswordPolicies.DescribeDetails")
+	return
+}
+
+func (fakeUnsupportedPasswordPolicies) Drop(_ context.Context, _ *DropPasswordPolicyRequest) (err error) {
+	err = fakeErrUnsupported("PasswordPolicies.Drop")
+	return
+}
+
+func (fakeUnsupportedPasswordPolicies) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("PasswordPolicies.DropSafely")
+	return
+}
+
+func (fakeUnsupportedPasswordPolicies) Show(_ context.Context, _ *ShowPasswordPolicyRequest) (_ []PasswordPolicy, err error) {
+	err = fakeErrUnsupported("PasswordPolicies.Show")
+	return
+}
+
+func (fakeUnsupportedPasswordPolicies) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *PasswordPolicy, err error) {
+	err = fakeErrUnsupported("PasswordPolicies.ShowByID")
+	return
+}
+
+func (fakeUnsupportedPasswordPolicies) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *PasswordPolicy, err error) {
+	err = fakeErrUnsupported("PasswordPolicies.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedPipes struct{}
+
+var _ Pipes = fakeUnsupportedPipes{}
+
+func (fakeUnsupportedPipes) Alter(_ context.Context, _ SchemaObjectIdentifier, _ *AlterPipeOptions) (err error) {
+	err = fakeErrUnsupported("Pipes.Alter")
+	return
+}
+
+func (fakeUnsupportedPipes) Create(_ context.Context, _ SchemaObjectIdentifier, _ string, _ *CreatePipeOptions) (err error) {
+	err = fakeErrUnsupported("Pipes.Create")
+	return
+}
+
+func (fakeUnsupportedPipes) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *Pipe, err error) {
+	err = fakeErrUnsupported("Pipes.Describe")
+	return
+}
+
+func (fakeUnsupportedPipes) Drop(_ context.Context, _ SchemaObjectIdentifier, _ *DropPipeOptions) (err error) {
+	err = fakeErrUnsupported("Pipes.Drop")
+	return
+}
+
+func (fakeUnsupportedPipes) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Pipes.DropSafely")
+	return
+}
+
+func (fakeUnsupportedPipes) Show(_ context.Context, _ *ShowPipeOptions) (_ []Pipe, err error) {
+	err = fakeErrUnsupported("Pipes.Show")
+	return
+}
+
+func (fakeUnsupportedPipes) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *Pipe, err error) {
+	err = fakeErrUnsupported("Pipes.ShowByID")
+	return
+}
+
+func (fakeUnsupportedPipes) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *Pipe, err error) {
+	err = fakeErrUnsupported("Pipes.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedPolicyReferences struct{}
+
+var _ PolicyReferences = fakeUnsupportedPolicyReferences{}
+
+func (fakeUnsupportedPolicyReferences) GetForEntity(_ context.Context, _ *GetForEntityPolicyReferenceRequest) (_ []PolicyReference, err error) {
+	err = fakeErrUnsupported("PolicyReferences.GetForEntity")
+	return
+}
+
+type fakeUnsupportedPostgresInstances struct{}
+
+var _ PostgresInstances = fakeUnsupportedPostgresInstances{}
+
+func (fakeUnsupportedPostgresInstances) Alter(_ context.Context, _ *AlterPostgresInstanceRequest) (err error) {
+	err = fakeErrUnsupported("PostgresInstances.Alter")
+	return
+}
+
+func (fakeUnsupportedPostgresInstances) Create(_ context.Context, _ *CreatePostgresInstanceRequest) (err error) {
+	err = fakeErrUnsupported("PostgresInstances.Create")
+	return
+}
+
+func (fakeUnsupportedPostgresInstances) Describe(_ context.Context, _ AccountObjectIdentifier) (_ []PostgresInstanceProperty, err error) {
+	err = fakeErrUnsupported("PostgresInstances.Describe")
+	return
+}
+
+func (fakeUnsupportedPostgresInstances) Drop(_ context.Context, _ *DropPostgresInstanceRequest) (err error) {
+	err = fakeErrUnsupported("PostgresInstances.Drop")
+	return
+}
+
+func (fakeUnsupportedPostgresInstances) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("PostgresInstances.DropSafely")
+	return
+}
+
+func (fakeUnsupportedPostgresInstances) Fork(_ context.Context, _ *ForkPostgresInstanceRequest) (err error) {
+	err = fakeErrUnsupported("PostgresInstances.Fork")
+	return
+}
+
+func (fakeUnsupportedPostgresInstances) Show(_ context.Context, _ *ShowPostgresInstanceRequest) (_ []PostgresInstance, err error) {
+	err = fakeErrUnsupported("PostgresInstances.Show")
+	return
+}
+
+func (fakeUnsupportedPostgresInstances) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *PostgresInstance, err error) {
+	err = fakeErrUnsupported("PostgresInstances.ShowByID")
+	return
+}
+
+func (fakeUnsupportedPostgresInstances) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *PostgresInstance, err error) {
+	err = fakeErrUnsupported("PostgresInstances.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedPrivacyPolicies struct{}
+
+var _ PrivacyPolicies = fakeUnsupportedPrivacyPolicies{}
+
+func (fakeUnsupportedPrivacyPolicies) Alter(_ context.Context, _ *AlterPrivacyPolicyRequest) (err error) {
+	err = fakeErrUnsupported("PrivacyPolicies.Alter")
+	return
+}
+
+func (fakeUnsupportedPrivacyPolicies) Create(_ context.Context, _ *CreatePrivacyPolicyRequest) (err error) {
+	err = fakeErrUnsupported("PrivacyPolicies.Create")
+	return
+}
+
+func (fakeUnsupportedPrivacyPolicies) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *PrivacyPolicyDescription, err error) {
+	err = fakeErrUnsupported("PrivacyPolicies.Describe")
+	return
+}
+
+func (fakeUnsupportedPrivacyPolicies) Drop(_ context.Context, _ *DropPrivacyPolicyRequest) (err error) {
+	err = fakeErrUnsupported("PrivacyPolicies.Drop")
+	return
+}
+
+func (fakeUnsupportedPrivacyPolicies) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("PrivacyPolicies.DropSafely")
+	return
+}
+
+func (fakeUnsupportedPrivacyPolicies) Show(_ context.Context, _ *ShowPrivacyPolicyRequest) (_ []PrivacyPolicy, err error) {
+	err = fakeErrUnsupported("PrivacyPolicies.Show")
+	return
+}
+
+func (fakeUnsupportedPrivacyPolicies) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *PrivacyPolicy, err error) {
+	err = fakeErrUnsupported("PrivacyPolicies.ShowByID")
+	return
+}
+
+func (fakeUnsupportedPrivacyPolicies) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *PrivacyPolicy, err error) {
+	err = fakeErrUnsupported("PrivacyPolicies.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedProcedures struct{}
+
+var _ Procedures = fakeUnsupportedProcedures{}
+
+func (fakeUnsupportedProcedures) Alter(_ context.Context, _ *AlterProcedureRequest) (err error) {
+	err = fakeErrUnsupported("Procedures.Alter")
+	return
+}
+
+func (fakeUnsupportedProcedures) Call(_ context.Context, _ *CallProcedureRequest) (err error) {
+	err = fakeErrUnsupported("Procedures.Call")
+	return
+}
+
+func (fakeUnsupportedProcedures) CreateAndCallForJava(_ context.Context, _ *CreateAndCallForJavaProcedureRequest) (err error) {
+	err = fakeErrUnsupported("Procedures.CreateAndCallForJava")
+	return
+}
+
+func (fakeUnsupportedProcedures) CreateAndCallForJavaScript(_ context.Context, _ *CreateAndCallForJavaScriptProcedureRequest) (err error) {
+	err = fakeErrUnsupported("Procedures.CreateAndCallForJavaScript")
+	return
+}
+
+func (fakeUnsupportedProcedures) CreateAndCallForPython(_ context.Context, _ *CreateAndCallForPythonProcedureRequest) (err error) {
+	err = fakeErrUnsupported("Procedures.CreateAndCallForPython")
+	return
+}
+
+func (fakeUnsupportedProcedures) CreateAndCallForSQL(_ context.Context, _ *CreateAndCallForSQLProcedureRequest) (err error) {
+	err = fakeErrUnsupported("Procedures.CreateAndCallForSQL")
+	return
+}
+
+func (fakeUnsupportedProcedures) CreateAndCallForScala(_ context.Context, _ *CreateAndCallForScalaProcedureRequest) (err error) {
+	err = fakeErrUnsupported("Procedures.CreateAndCallForScala")
+	return
+}
+
+func (fakeUnsupportedProcedures) CreateForJava(_ context.Context, _ *CreateForJavaProcedureRequest) (err error) {
+	err = fakeErrUnsupported("Procedures.CreateForJava")
+	return
+}
+
+func (fakeUnsupportedProcedures) CreateForJavaScript(_ context.Context, _ *CreateForJavaScriptProcedureRequest) (err error) {
+	err = fakeErrUnsupported("Procedures.CreateForJavaScript")
+	return
+}
+
+func (fakeUnsupportedProcedures) CreateForPython(_ context.Context, _ *CreateForPythonProcedureRequest) (err error) {
+	err = fakeErrUnsupported("Procedures.CreateForPython")
+	return
+}
+
+func (fakeUnsupportedProcedures) CreateForSQL(_ context.Context, _ *CreateForSQLProcedureRequest) (err error) {
+	err = fakeErrUnsupported("Procedures.CreateForSQL")
+	return
+}
+
+func (fakeUnsupportedProcedures) CreateForScala(_ context.Context, _ *CreateForScalaProcedureRequest) (err error) {
+	err = fakeErrUnsupported("Procedures.CreateForScala")
+	return
+}
+
+func (fakeUnsupportedProcedures) Describe(_ context.Context, _ SchemaObjectIdentifierWithArguments) (_ []ProcedureDetail, err error) {
+	err = fakeErrUnsupported("Procedures.Describe")
+	return
+}
+
+func (fakeUnsupportedProcedures) DescribeDetails(_ context.Context, _ SchemaObjectIdentifierWithArguments) (_ *ProcedureDetails, err error) {
+	err = fakeErrUnsupported("Procedures.DescribeDetails")
+	return
+}
+
+func (fakeUnsupportedProcedures) Drop(_ context.Context, _ *DropProcedureRequest) (err error) {
+	err = fakeErrUnsupported("Procedures.Drop")
+	return
+}
+
+func (fakeUnsupportedProcedures) DropSafely(_ context.Context, _ SchemaObjectIdentifierWithArguments) (err error) {
+	err = fakeErrUnsupported("Procedures.DropSafely")
+	return
+}
+
+func (fakeUnsupportedProcedures) Show(_ context.Context, _ *ShowProcedureRequest) (_ []Procedure, err error) {
+	err = fakeErrUnsupported("Procedures.Show")
+	return
+}
+
+func (fakeUnsupportedProcedures) ShowByID(_ context.Context, _ SchemaObjectIdentifierWithArguments) (_ *Procedure, err error) {
+	err = fakeErrUnsupported("Procedures.ShowByID")
+	return
+}
+
+func (fakeUnsupportedProcedures) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifierWithArguments) (_ *Procedure, err error) {
+	err = fakeErrUnsupported("Procedures.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedProcedures) ShowParameters(_ context.Context, _ SchemaObjectIdentifierWithArguments) (_ []*Parameter, err error) {
+	err = fakeErrUnsupported("Procedures.ShowParameters")
+	return
+}
+
+type fakeUnsupportedProjectionPolicies struct{}
+
+var _ ProjectionPolicies = fakeUnsupportedProjectionPolicies{}
+
+func (fakeUnsupportedProjectionPolicies) Alter(_ context.Context, _ *AlterProjectionPolicyRequest) (err error) {
+	err = fakeErrUnsupported("ProjectionPolicies.Alter")
+	return
+}
+
+func (fakeUnsupportedProjectionPolicies) Create(_ context.Context, _ *CreateProjectionPolicyRequest) (err error) {
+	err = fakeErrUnsupported("ProjectionPolicies.Create")
+	return
+}
+
+func (fakeUnsupportedProjectionPolicies) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *ProjectionPolicyDescription, err error) {
+	err = fakeErrUnsupported("ProjectionPolicies.Describe")
+	return
+}
+
+func (fakeUnsupportedProjectionPolicies) Drop(_ context.Context, _ *DropProjectionPolicyRequest) (err error) {
+	err = fakeErrUnsupported("ProjectionPolicies.Drop")
+	return
+}
+
+func (fakeUnsupportedProjectionPolicies) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("ProjectionPolicies.DropSafely")
+	return
+}
+
+func (fakeUnsupportedProjectionPolicies) Show(_ context.Context, _ *ShowProjectionPolicyRequest) (_ []ProjectionPolicy, err error) {
+	err = fakeErrUnsupported("ProjectionPolicies.Show")
+	return
+}
+
+func (fakeUnsupportedProjectionPolicies) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *ProjectionPolicy, err error) {
+	err = fakeErrUnsupported("ProjectionPolicies.ShowByID")
+	return
+}
+
+func (fakeUnsupportedProjectionPolicies) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *ProjectionPolicy, err error) {
+	err = fakeErrUnsupported("ProjectionPolicies.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedResourceMonitors struct{}
+
+var _ ResourceMonitors = fakeUnsupportedResourceMonitors{}
+
+func (fakeUnsupportedResourceMonitors) Alter(_ context.Context, _ AccountObjectIdentifier, _ *AlterResourceMonitorOptions) (err error) {
+	err = fakeErrUnsupported("ResourceMonitors.Alter")
+	return
+}
+
+func (fakeUnsupportedResourceMonitors) Create(_ context.Context, _ AccountObjectIdentifier, _ *CreateResourceMonitorOptions) (err error) {
+	err = fakeErrUnsupported("ResourceMonitors.Create")
+	return
+}
+
+func (fakeUnsupportedResourceMonitors) Drop(_ context.Context, _ AccountObjectIdentifier, _ *DropResourceMonitorOptions) (err error) {
+	err = fakeErrUnsupported("ResourceMonitors.Drop")
+	return
+}
+
+func (fakeUnsupportedResourceMonitors) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("ResourceMonitors.DropSafely")
+	return
+}
+
+func (fakeUnsupportedResourceMonitors) Show(_ context.Context, _ *ShowResourceMonitorOptions) (_ []ResourceMonitor, err error) {
+	err = fakeErrUnsupported("ResourceMonitors.Show")
+	return
+}
+
+func (fakeUnsupportedResourceMonitors) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *ResourceMonitor, err error) {
+	err = fakeErrUnsupported("ResourceMonitors.ShowByID")
+	return
+}
+
+func (fakeUnsupportedResourceMonitors) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *ResourceMonitor, err error) {
+	err = fakeErrUnsupported("ResourceMonitors.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedRoles struct{}
+
+var _ Roles = fakeUnsupportedRoles{}
+
+func (fakeUnsupportedRoles) Alter(_ context.Context, _ *AlterRoleRequest) (err error) {
+	err = fakeErrUnsupported("Roles.Alter")
+	return
+}
+
+func (fakeUnsupportedRoles) Create(_ context.Context, _ *CreateRoleRequest) (err error) {
+	err = fakeErrUnsupported("Roles.Create")
+	return
+}
+
+func (fakeUnsupportedRoles) Drop(_ context.Context, _ *DropRoleRequest) (err error) {
+	err = fakeErrUnsupported("Roles.Drop")
+	return
+}
+
+func (fakeUnsupportedRoles) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Roles.DropSafely")
+	return
+}
+
+func (fakeUnsupportedRoles) Grant(_ context.Context, _ *GrantRoleRequest) (err error) {
+	err = fakeErrUnsupported("Roles.Grant")
+	return
+}
+
+func (fakeUnsupportedRoles) Revoke(_ context.Context, _ *RevokeRoleRequest) (err error) {
+	err = fakeErrUnsupported("Roles.Revoke")
+	return
+}
+
+func (fakeUnsupportedRoles) RevokeSafely(_ context.Context, _ *RevokeRoleRequest) (err error) {
+	err = fakeErrUnsupported("Roles.RevokeSafely")
+	return
+}
+
+func (fakeUnsupportedRoles) Show(_ context.Context, _ *ShowRoleRequest) (_ []Role, err error) {
+	err = fakeErrUnsupported("Roles.Show")
+	return
+}
+
+func (fakeUnsupportedRoles) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *Role, err error) {
+	err = fakeErrUnsupported("Roles.ShowByID")
+	return
+}
+
+func (fakeUnsupportedRoles) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *Role, err error) {
+	err = fakeErrUnsupported("Roles.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedRoles) Use(_ context.Context, _ *UseRoleRequest) (err error) {
+	err = fakeErrUnsupported("Roles.Use")
+	return
+}
+
+func (fakeUnsupportedRoles) UseSecondary(_ context.Context, _ *UseSecondaryRolesRequest) (err error) {
+	err = fakeErrUnsupported("Roles.UseSecondary")
+	return
+}
+
+type fakeUnsupportedRowAccessPolicies struct{}
+
+var _ RowAccessPolicies = fakeUnsupportedRowAccessPolicies{}
+
+func (fakeUnsupportedRowAccessPolicies) Alter(_ context.Context, _ *AlterRowAccessPolicyRequest) (err error) {
+	err = fakeErrUnsupported("RowAccessPolicies.Alter")
+	return
+}
+
+func (fakeUnsupportedRowAccessPolicies) Create(_ context.Context, _ *CreateRowAccessPolicyRequest) (err error) {
+	err = fakeErrUnsupported("RowAccessPolicies.Create")
+	return
+}
+
+func (fakeUnsupportedRowAccessPolicies) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *RowAccessPolicyDescription, err error) {
+	err = fakeErrUnsupported("RowAccessPolicies.Describe")
+	return
+}
+
+func (fakeUnsupportedRowAccessPolicies) Drop(_ context.Context, _ *DropRowAccessPolicyRequest) (err error) {
+	err = fakeErrUnsupported("RowAccessPolicies.Drop")
+	return
+}
+
+func (fakeUnsupportedRowAccessPolicies) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("RowAccessPolicies.DropSafely")
+	return
+}
+
+func (fakeUnsupportedRowAccessPolicies) Show(_ context.Context, _ *ShowRowAccessPolicyRequest) (_ []RowAccessPolicy, err error) {
+	err = fakeErrUnsupported("RowAccessPolicies.Show")
+	return
+}
+
+func (fakeUnsupportedRowAccessPolicies) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *RowAccessPolicy, err error) {
+	err = fakeErrUnsupported("RowAccessPolicies.ShowByID")
+	return
+}
+
+func (fakeUnsupportedRowAccessPolicies) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *RowAccessPolicy, err error) {
+	err = fakeErrUnsupported("RowAccessPolicies.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedSchemas struct{}
+
+var _ Schemas = fakeUnsupportedSchemas{}
+
+func (fakeUnsupportedSchemas) Alter(_ context.Context, _ DatabaseObjectIdentifier, _ *AlterSchemaOptions) (err error) {
+	err = fakeErrUnsupported("Schemas.Alter")
+	return
+}
+
+func (fakeUnsupportedSchemas) Create(_ context.Context, _ DatabaseObjectIdentifier, _ *CreateSchemaOptions) (err error) {
+	err = fakeErrUnsupported("Schemas.Create")
+	return
+}
+
+func (fakeUnsupportedSchemas) Describe(_ context.Context, _ DatabaseObjectIdentifier) (_ []SchemaDetails, err error) {
+	err = fakeErrUnsupported("Schemas.Describe")
+	return
+}
+
+func (fakeUnsupportedSchemas) Drop(_ context.Context, _ DatabaseObjectIdentifier, _ *DropSchemaOptions) (err error) {
+	err = fakeErrUnsupported("Schemas.Drop")
+	return
+}
+
+func (fakeUnsupportedSchemas) DropSafely(_ context.Context, _ DatabaseObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Schemas.DropSafely")
+	return
+}
+
+func (fakeUnsupportedSchemas) Show(_ context.Context, _ *ShowSchemaOptions) (_ []Schema, err error) {
+	err = fakeErrUnsupported("Schemas.Show")
+	return
+}
+
+func (fakeUnsupportedSchemas) ShowByID(_ context.Context, _ DatabaseObjectIdentifier) (_ *Schema, err error) {
+	err = fakeErrUnsupported("Schemas.ShowByID")
+	return
+}
+
+func (fakeUnsupportedSchemas) ShowByIDSafely(_ context.Context, _ DatabaseObjectIdentifier) (_ *Schema, err error) {
+	err = fakeErrUnsupported("Schemas.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedSchemas) ShowParameters(_ context.Context, _ DatabaseObjectIdentifier) (_ []*Parameter, err error) {
+	err = fakeErrUnsupported("Schemas.ShowParameters")
+	return
+}
+
+func (fakeUnsupportedSchemas) Undrop(_ context.Context, _ DatabaseObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Schemas.Undrop")
+	return
+}
+
+func (fakeUnsupportedSchemas) Use(_ context.Context, _ DatabaseObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Schemas.Use")
+	return
+}
+
+type fakeUnsupportedSecrets struct{}
+
+var _ Secrets = fakeUnsupportedSecrets{}
+
+func (fakeUnsupportedSecrets) Alter(_ context.Context, _ *AlterSecretRequest) (err error) {
+	err = fakeErrUnsupported("Secrets.Alter")
+	return
+}
+
+func (fakeUnsupportedSecrets) CreateWithBasicAuthentication(_ context.Context, _ *CreateWithBasicAuthenticationSecretRequest) (err error) {
+	err = fakeErrUnsupported("Secrets.CreateWithBasicAuthentication")
+	return
+}
+
+func (fakeUnsupportedSecrets) CreateWithGenericString(_ context.Context, _ *CreateWithGenericStringSecretRequest) (err error) {
+	err = fakeErrUnsupported("Secrets.CreateWithGenericString")
+	return
+}
+
+func (fakeUnsupportedSecrets) CreateWithOAuthAuthorizationCodeFlow(_ context.Context, _ *CreateWithOAuthAuthorizationCodeFlowSecretRequest) (err error) {
+	err = fakeErrUnsupported("Secrets.CreateWithOAuthAuthorizationCodeFlow")
+	return
+}
+
+func (fakeUnsupportedSecrets) CreateWithOAuthClientCredentialsFlow(_ context.Context, _ *CreateWithOAuthClientCredentialsFlowSecretRequest) (err error) {
+	err = fakeErrUnsupported("Secrets.CreateWithOAuthClientCredentialsFlow")
+	return
+}
+
+func (fakeUnsupportedSecrets) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *SecretDetails, err error) {
+	err = fakeErrUnsupported("Secrets.Describe")
+	return
+}
+
+func (fakeUnsupportedSecrets) Drop(_ context.Context, _ *DropSecretRequest) (err error) {
+	err = fakeErrUnsupported("Secrets.Drop")
+	return
+}
+
+func (fakeUnsupportedSecrets) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Secrets.DropSafely")
+	return
+}
+
+func (fakeUnsupportedSecrets) Show(_ context.Context, _ *ShowSecretRequest) (_ []Secret, err error) {
+	err = fakeErrUnsupported("Secrets.Show")
+	return
+}
+
+func (fakeUnsupportedSecrets) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *Secret, err error) {
+	err = fakeErrUnsupported("Secrets.ShowByID")
+	return
+}
+
+func (fakeUnsupportedSecrets) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *Secret, err error) {
+	err = fakeErrUnsupported("Secrets.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedSecurityIntegrations struct{}
+
+var _ SecurityIntegrations = fakeUnsupportedSecurityIntegrations{}
+
+func (fakeUnsupportedSecurityIntegrations) AlterApiAuthenticationWithAuthorizationCodeGrantFlow(_ context.Context, _ *AlterApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.AlterApiAuthenticationWithAuthorizationCodeGrantFlow")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) AlterApiAuthenticationWithClientCredentialsFlow(_ context.Context, _ *AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.AlterApiAuthenticationWithClientCredentialsFlow")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) AlterApiAuthenticationWithJwtBearerFlow(_ context.Context, _ *AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.AlterApiAuthenticationWithJwtBearerFlow")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) AlterExternalOauth(_ context.Context, _ *AlterExternalOauthSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.AlterExternalOauth")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) AlterOauthForCustomClients(_ context.Context, _ *AlterOauthForCustomClientsSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.AlterOauthForCustomClients")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) AlterOauthForPartnerApplications(_ context.Context, _ *AlterOauthForPartnerApplicationsSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.AlterOauthForPartnerApplications")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) AlterSaml2(_ context.Context, _ *AlterSaml2SecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.AlterSaml2")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) AlterScim(_ context.Context, _ *AlterScimSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.AlterScim")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) CreateApiAuthenticationWithAuthorizationCodeGrantFlow(_ context.Context, _ *CreateApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.CreateApiAuthenticationWithAuthorizationCodeGrantFlow")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) CreateApiAuthenticationWithClientCredentialsFlow(_ context.Context, _ *CreateApiAuthenticationWithClientCredentialsFlowSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.CreateApiAuthenticationWithClientCredentialsFlow")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) CreateApiAuthenticationWithJwtBearerFlow(_ context.Context, _ *CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.CreateApiAuthenticationWithJwtBearerFlow")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) CreateExternalOauth(_ context.Context, _ *CreateExternalOauthSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.CreateExternalOauth")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) CreateOauthForCustomClients(_ context.Context, _ *CreateOauthForCustomClientsSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.CreateOauthForCustomClients")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) CreateOauthForPartnerApplications(_ context.Context, _ *CreateOauthForPartnerApplicationsSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.CreateOauthForPartnerApplications")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) CreateSaml2(_ context.Context, _ *CreateSaml2SecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.CreateSaml2")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) CreateScim(_ context.Context, _ *CreateScimSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.CreateScim")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) Describe(_ context.Context, _ AccountObjectIdentifier) (_ []SecurityIntegrationProperty, err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.Describe")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) Drop(_ context.Context, _ *DropSecurityIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.Drop")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.DropSafely")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) Show(_ context.Context, _ *ShowSecurityIntegrationRequest) (_ []SecurityIntegration, err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.Show")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *SecurityIntegration, err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.ShowByID")
+	return
+}
+
+func (fakeUnsupportedSecurityIntegrations) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *SecurityIntegration, err error) {
+	err = fakeErrUnsupported("SecurityIntegrations.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedSemanticViews struct{}
+
+var _ SemanticViews = fakeUnsupportedSemanticViews{}
+
+func (fakeUnsupportedSemanticViews) Alter(_ context.Context, _ *AlterSemanticViewRequest) (err error) {
+	err = fakeErrUnsupported("SemanticViews.Alter")
+	return
+}
+
+func (fakeUnsupportedSemanticViews) Create(_ context.Context, _ *CreateSemanticViewRequest) (err error) {
+	err = fakeErrUnsupported("SemanticViews.Create")
+	return
+}
+
+func (fakeUnsupportedSemanticViews) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ []SemanticViewDetails, err error) {
+	err = fakeErrUnsupported("SemanticViews.Describe")
+	return
+}
+
+func (fakeUnsupportedSemanticViews) DescribeSemanticViewDetails(_ context.Context, _ SchemaObjectIdentifier) (_ *SemanticViewDescribeDetails, err error) {
+	err = fakeErrUnsupported("SemanticViews.DescribeSemanticViewDetails")
+	return
+}
+
+func (fakeUnsupportedSemanticViews) Drop(_ context.Context, _ *DropSemanticViewRequest) (err error) {
+	err = fakeErrUnsupported("SemanticViews.Drop")
+	return
+}
+
+func (fakeUnsupportedSemanticViews) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("SemanticViews.DropSafely")
+	return
+}
+
+func (fakeUnsupportedSemanticViews) Show(_ context.Context, _ *ShowSemanticViewRequest) (_ []SemanticView, err error) {
+	err = fakeErrUnsupported("SemanticViews.Show")
+	return
+}
+
+func (fakeUnsupportedSemanticViews) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *SemanticView, err error) {
+	err = fakeErrUnsupported("SemanticViews.ShowByID")
+	return
+}
+
+func (fakeUnsupportedSemanticViews) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *SemanticView, err error) {
+	err = fakeErrUnsupported("SemanticViews.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedServices struct{}
+
+var _ Services = fakeUnsupportedServices{}
+
+func (fakeUnsupportedServices) Alter(_ context.Context, _ *AlterServiceRequest) (err error) {
+	err = fakeErrUnsupported("Services.Alter")
+	return
+}
+
+func (fakeUnsupportedServices) Create(_ context.Context, _ *CreateServiceRequest) (err error) {
+	err = fakeErrUnsupported("Services.Create")
+	return
+}
+
+func (fakeUnsupportedServices) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *ServiceDetails, err error) {
+	err = fakeErrUnsupported("Services.Describe")
+	return
+}
+
+func (fakeUnsupportedServices) Drop(_ context.Context, _ *DropServiceRequest) (err error) {
+	err = fakeErrUnsupported("Services.Drop")
+	return
+}
+
+func (fakeUnsupportedServices) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Services.DropSafely")
+	return
+}
+
+func (fakeUnsupportedServices) ExecuteJob(_ context.Context, _ *ExecuteJobServiceRequest) (err error) {
+	err = fakeErrUnsupported("Services.ExecuteJob")
+	return
+}
+
+func (fakeUnsupportedServices) Show(_ context.Context, _ *ShowServiceRequest) (_ []Service, err error) {
+	err = fakeErrUnsupported("Services.Show")
+	return
+}
+
+func (fakeUnsupportedServices) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *Service, err error) {
+	err = fakeErrUnsupported("Services.ShowByID")
+	return
+}
+
+func (fakeUnsupportedServices) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *Service, err error) {
+	err = fakeErrUnsupported("Services.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedSequences struct{}
+
+var _ Sequences = fakeUnsupportedSequences{}
+
+func (fakeUnsupportedSequences) Alter(_ context.Context, _ *AlterSequenceRequest) (err error) {
+	err = fakeErrUnsupported("Sequences.Alter")
+	return
+}
+
+func (fakeUnsupportedSequences) Create(_ context.Context, _ *CreateSequenceRequest) (err error) {
+	err = fakeErrUnsupported("Sequences.Create")
+	return
+}
+
+func (fakeUnsupportedSequences) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *SequenceDetail, err error) {
+	err = fakeErrUnsupported("Sequences.Describe")
+	return
+}
+
+func (fakeUnsupportedSequences) Drop(_ context.Context, _ *DropSequenceRequest) (err error) {
+	err = fakeErrUnsupported("Sequences.Drop")
+	return
+}
+
+func (fakeUnsupportedSequences) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Sequences.DropSafely")
+	return
+}
+
+func (fakeUnsupportedSequences) Show(_ context.Context, _ *ShowSequenceRequest) (_ []Sequence, err error) {
+	err = fakeErrUnsupported("Sequences.Show")
+	return
+}
+
+func (fakeUnsupportedSequences) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *Sequence, err error) {
+	err = fakeErrUnsupported("Sequences.ShowByID")
+	return
+}
+
+func (fakeUnsupportedSequences) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *Sequence, err error) {
+	err = fakeErrUnsupported("Sequences.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedSessionPolicies struct{}
+
+var _ SessionPolicies = fakeUnsupportedSessionPolicies{}
+
+func (fakeUnsupportedSessionPolicies) Alter(_ context.Context, _ *AlterSessionPolicyRequest) (err error) {
+	err = fakeErrUnsupported("SessionPolicies.Alter")
+	return
+}
+
+func (fakeUnsupportedSessionPolicies) Create(_ context.Context, _ *CreateSessionPolicyRequest) (err error) {
+	err = fakeErrUnsupported("SessionPolicies.Create")
+	return
+}
+
+func (fakeUnsupportedSessionPolicies) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ []SessionPolicyProperty, err error) {
+	err = fakeErrUnsupported("SessionPolicies.Describe")
+	return
+}
+
+func (fakeUnsupportedSessionPolicies) DescribeDetails(_ context.Context, _ SchemaObjectIdentifier) (_ *SessionPolicyDetails, err error) {
+	err = fakeErrUnsupported("SessionPolicies.DescribeDetails")
+	return
+}
+
+func (fakeUnsupportedSessionPolicies) Drop(_ context.Context, _ *DropSessionPolicyRequest) (err error) {
+	err = fakeErrUnsupported("SessionPolicies.Drop")
+	return
+}
+
+func (fakeUnsupportedSessionPolicies) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("SessionPolicies.DropSafely")
+	return
+}
+
+func (fakeUnsupportedSessionPolicies) Show(_ context.Context, _ *ShowSessionPolicyRequest) (_ []SessionPolicy, err error) {
+	err = fakeErrUnsupported("SessionPolicies.Show")
+	return
+}
+
+func (fakeUnsupportedSessionPolicies) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *SessionPolicy, err error) {
+	err = fakeErrUnsupported("SessionPolicies.ShowByID")
+	return
+}
+
+func (fakeUnsupportedSessionPolicies) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *SessionPolicy, err error) {
+	err = fakeErrUnsupported("SessionPolicies.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedSessions struct{}
+
+var _ Sessions = fakeUnsupportedSessions{}
+
+func (fakeUnsupportedSessions) AlterSession(_ context.Context, _ *AlterSessionOptions) (err error) {
+	err = fakeErrUnsupported("Sessions.AlterSession")
+	return
+}
+
+func (fakeUnsupportedSessions) ShowParameters(_ context.Context, _ *ShowParametersOptions) (_ []*Parameter, err error) {
+	err = fakeErrUnsupported("Sessions.ShowParameters")
+	return
+}
+
+func (fakeUnsupportedSessions) UseDatabase(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Sessions.UseDatabase")
+	return
+}
+
+func (fakeUnsupportedSessions) UseRole(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Sessions.UseRole")
+	return
+}
+
+func (fakeUnsupportedSessions) UseSchema(_ context.Context, _ DatabaseObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Sessions.UseSchema")
+	return
+}
+
+func (fakeUnsupportedSessions) UseSecondaryRoles(_ context.Context, _ SecondaryRoleOption) (err error) {
+	err = fakeErrUnsupported("Sessions.UseSecondaryRoles")
+	return
+}
+
+func (fakeUnsupportedSessions) UseWarehouse(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Sessions.UseWarehouse")
+	return
+}
+
+type fakeUnsupportedShares struct{}
+
+var _ Shares = fakeUnsupportedShares{}
+
+func (fakeUnsupportedShares) Alter(_ context.Context, _ AccountObjectIdentifier, _ *AlterShareOptions) (err error) {
+	err = fakeErrUnsupported("Shares.Alter")
+	return
+}
+
+func (fakeUnsupportedShares) Create(_ context.Context, _ AccountObjectIdentifier, _ *CreateShareOptions) (err error) {
+	err = fakeErrUnsupported("Shares.Create")
+	return
+}
+
+func (fakeUnsupportedShares) DescribeConsumer(_ context.Context, _ ExternalObjectIdentifier) (_ *ShareDetails, err error) {
+	err = fakeErrUnsupported("Shares.DescribeConsumer")
+	return
+}
+
+func (fakeUnsupportedShares) DescribeProvider(_ context.Context, _ AccountObjectIdentifier) (_ *ShareDetails, err error) {
+	err = fakeErrUnsupported("Shares.DescribeProvider")
+	return
+}
+
+func (fakeUnsupportedShares) Drop(_ context.Context, _ AccountObjectIdentifier, _ *DropShareOptions) (err error) {
+	err = fakeErrUnsupported("Shares.Drop")
+	return
+}
+
+func (fakeUnsupportedShares) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Shares.DropSafely")
+	return
+}
+
+func (fakeUnsupportedShares) Show(_ context.Context, _ *ShowShareOptions) (_ []Share, err error) {
+	err = fakeErrUnsupported("Shares.Show")
+	return
+}
+
+func (fakeUnsupportedShares) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *Share, err error) {
+	err = fakeErrUnsupported("Shares.ShowByID")
+	return
+}
+
+func (fakeUnsupportedShares) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *Share, err error) {
+	err = fakeErrUnsupported("Shares.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedSnapshots struct{}
+
+var _ Snapshots = fakeUnsupportedSnapshots{}
+
+func (fakeUnsupportedSnapshots) Alter(_ context.Context, _ *AlterSnapshotRequest) (err error) {
+	err = fakeErrUnsupported("Snapshots.Alter")
+	return
+}
+
+func (fakeUnsupportedSnapshots) Create(_ context.Context, _ *CreateSnapshotRequest) (err error) {
+	err = fakeErrUnsupported("Snapshots.Create")
+	return
+}
+
+func (fakeUnsupportedSnapshots) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *SnapshotDetails, err error) {
+	err = fakeErrUnsupported("Snapshots.Describe")
+	return
+}
+
+func (fakeUnsupportedSnapshots) Drop(_ context.Context, _ *DropSnapshotRequest) (err error) {
+	err = fakeErrUnsupported("Snapshots.Drop")
+	return
+}
+
+func (fakeUnsupportedSnapshots) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Snapshots.DropSafely")
+	return
+}
+
+func (fakeUnsupportedSnapshots) Show(_ context.Context, _ *ShowSnapshotRequest) (_ []Snapshot, err error) {
+	err = fakeErrUnsupported("Snapshots.Show")
+	return
+}
+
+func (fakeUnsupportedSnapshots) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *Snapshot, err error) {
+	err = fakeErrUnsupported("Snapshots.ShowByID")
+	return
+}
+
+func (fakeUnsupportedSnapshots) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *Snapshot, err error) {
+	err = fakeErrUnsupported("Snapshots.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedStages struct{}
+
+var _ Stages = fakeUnsupportedStages{}
+
+func (fakeUnsupportedStages) Alter(_ context.Context, _ *AlterStageRequest) (err error) {
+	err = fakeErrUnsupported("Stages.Alter")
+	return
+}
+
+func (fakeUnsupportedStages) AlterDirectoryTable(_ context.Context, _ *AlterDirectoryTableStageRequest) (err error) {
+	err = fakeErrUnsupported("Stages.AlterDirectoryTable")
+	return
+}
+
+func (fakeUnsupportedStages) AlterExternalAzureStage(_ context.Context, _ *AlterExternalAzureStageStageRequest) (err error) {
+	err = fakeErrUnsupported("Stages.AlterExternalAzureStage")
+	return
+}
+
+func (fakeUnsupportedStages) AlterExternalGCSStage(_ context.Context, _ *AlterExternalGCSStageStageRequest) (err error) {
+	err = fakeErrUnsupported("Stages.AlterExternalGCSStage")
+	return
+}
+
+func (fakeUnsupportedStages) AlterExternalS3Stage(_ context.Context, _ *AlterExternalS3StageStageRequest) (err error) {
+	err = fakeErrUnsupported("Stages.AlterExternalS3Stage")
+	return
+}
+
+func (fakeUnsupportedStages) AlterInternalStage(_ context.Context, _ *AlterInternalStageStageRequest) (err error) {
+	err = fakeErrUnsupported("Stages.AlterInternalStage")
+	return
+}
+
+func (fakeUnsupportedStages) CreateInternal(_ context.Context, _ *CreateInternalStageRequest) (err error) {
+	err = fakeErrUnsupported("Stages.CreateInternal")
+	return
+}
+
+func (fakeUnsupportedStages) CreateOnAzure(_ context.Context, _ *CreateOnAzureStageRequest) (err error) {
+	err = fakeErrUnsupported("Stages.CreateOnAzure")
+	return
+}
+
+func (fakeUnsupportedStages) CreateOnGCS(_ context.Context, _ *CreateOnGCSStageRequest) (err error) {
+	err = fakeErrUnsupported("Stages.CreateOnGCS")
+	return
+}
+
+func (fakeUnsupportedStages) CreateOnS3(_ context.Context, _ *CreateOnS3StageRequest) (err error) {
+	err = fakeErrUnsupported("Stages.CreateOnS3")
+	return
+}
+
+func (fakeUnsupportedStages) CreateOnS3Compatible(_ context.Context, _ *CreateOnS3CompatibleStageRequest) (err error) {
+	err = fakeErrUnsupported("Stages.CreateOnS3Compatible")
+	return
+}
+
+func (fakeUnsupportedStages) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ []StageProperty, err error) {
+	err = fakeErrUnsupported("Stages.Describe")
+	return
+}
+
+func (fakeUnsupportedStages) Drop(_ context.Context, _ *DropStageRequest) (err error) {
+	err = fakeErrUnsupported("Stages.Drop")
+	return
+}
+
+func (fakeUnsupportedStages) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Stages.DropSafely")
+	return
+}
+
+func (fakeUnsupportedStages) Show(_ context.Context, _ *ShowStageRequest) (_ []Stage, err error) {
+	err = fakeErrUnsupported("Stages.Show")
+	return
+}
+
+func (fakeUnsupportedStages) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *Stage, err error) {
+	err = fakeErrUnsupported("Stages.ShowByID")
+	return
+}
+
+func (fakeUnsupportedStages) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *Stage, err error) {
+	err = fakeErrUnsupported("Stages.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedStorageIntegrations struct{}
+
+var _ StorageIntegrations = fakeUnsupportedStorageIntegrations{}
+
+func (fakeUnsupportedStorageIntegrations) Alter(_ context.Context, _ *AlterStorageIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("StorageIntegrations.Alter")
+	return
+}
+
+func (fakeUnsupportedStorageIntegrations) Create(_ context.Context, _ *CreateStorageIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("StorageIntegrations.Create")
+	return
+}
+
+func (fakeUnsupportedStorageIntegrations) Describe(_ context.Context, _ AccountObjectIdentifier) (_ []StorageIntegrationProperty, err error) {
+	err = fakeErrUnsupported("StorageIntegrations.Describe")
+	return
+}
+
+func (fakeUnsupportedStorageIntegrations) DescribeAwsDetails(_ context.Context, _ AccountObjectIdentifier) (_ *StorageIntegrationAwsDetails, err error) {
+	err = fakeErrUnsupported("StorageIntegrations.DescribeAwsDetails")
+	return
+}
+
+func (fakeUnsupportedStorageIntegrations) DescribeAzureDetails(_ context.Context, _ AccountObjectIdentifier) (_ *StorageIntegrationAzureDetails, err error) {
+	err = fakeErrUnsupported("StorageIntegrations.DescribeAzureDetails")
+	return
+}
+
+func (fakeUnsupportedStorageIntegrations) DescribeDetails(_ context.Context, _ AccountObjectIdentifier) (_ *StorageIntegrationAllDetails, err error) {
+	err = fakeErrUnsupported("StorageIntegrations.DescribeDetails")
+	return
+}
+
+func (fakeUnsupportedStorageIntegrations) DescribeGcsDetails(_ context.Context, _ AccountObjectIdentifier) (_ *StorageIntegrationGcsDetails, err error) {
+	err = fakeErrUnsupported("StorageIntegrations.DescribeGcsDetails")
+	return
+}
+
+func (fakeUnsupportedStorageIntegrations) Drop(_ context.Context, _ *DropStorageIntegrationRequest) (err error) {
+	err = fakeErrUnsupported("StorageIntegrations.Drop")
+	return
+}
+
+func (fakeUnsupportedStorageIntegrations) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("StorageIntegrations.DropSafely")
+	return
+}
+
+func (fakeUnsupportedStorageIntegrations) Show(_ context.Context, _ *ShowStorageIntegrationRequest) (_ []StorageIntegration, err error) {
+	err = fakeErrUnsupported("StorageIntegrations.Show")
+	return
+}
+
+func (fakeUnsupportedStorageIntegrations) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *StorageIntegration, err error) {
+	err = fakeErrUnsupported("StorageIntegrations.ShowByID")
+	return
+}
+
+func (fakeUnsupportedStorageIntegrations) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *StorageIntegration, err error) {
+	err = fakeErrUnsupported("StorageIntegrations.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedStorageLifecyclePolicies struct{}
+
+var _ StorageLifecyclePolicies = fakeUnsupportedStorageLifecyclePolicies{}
+
+func (fakeUnsupportedStorageLifecyclePolicies) Alter(_ context.Context, _ *AlterStorageLifecyclePolicyRequest) (err error) {
+	err = fakeErrUnsupported("StorageLifecyclePolicies.Alter")
+	return
+}
+
+func (fakeUnsupportedStorageLifecyclePolicies) Create(_ context.Context, _ *CreateStorageLifecyclePolicyRequest) (err error) {
+	err = fakeErrUnsupported("StorageLifecyclePolicies.Create")
+	return
+}
+
+func (fakeUnsupportedStorageLifecyclePolicies) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *StorageLifecyclePolicyDescription, err error) {
+	err = fakeErrUnsupported("StorageLifecyclePolicies.Describe")
+	return
+}
+
+func (fakeUnsupportedStorageLifecyclePolicies) Drop(_ context.Context, _ *DropStorageLifecyclePolicyRequest) (err error) {
+	err = fakeErrUnsupported("StorageLifecyclePolicies.Drop")
+	return
+}
+
+func (fakeUnsupportedStorageLifecyclePolicies) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("StorageLifecyclePolicies.DropSafely")
+	return
+}
+
+func (fakeUnsupportedStorageLifecyclePolicies) Show(_ context.Context, _ *ShowStorageLifecyclePolicyRequest) (_ []StorageLifecyclePolicy, err error) {
+	err = fakeErrUnsupported("StorageLifecyclePolicies.Show")
+	return
+}
+
+func (fakeUnsupportedStorageLifecyclePolicies) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *StorageLifecyclePolicy, err error) {
+	err = fakeErrUnsupported("StorageLifecyclePolicies.ShowByID")
+	return
+}
+
+func (fakeUnsupportedStorageLifecyclePolicies) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *StorageLifecyclePolicy, err error) {
+	err = fakeErrUnsupported("StorageLifecyclePolicies.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedStreamlits struct{}
+
+var _ Streamlits = fakeUnsupportedStreamlits{}
+
+func (fakeUnsupportedStreamlits) Alter(_ context.Context, _ *AlterStreamlitRequest) (err error) {
+	err = fakeErrUnsupported("Streamlits.Alter")
+	return
+}
+
+func (fakeUnsupportedStreamlits) Create(_ context.Context, _ *CreateStreamlitRequest) (err error) {
+	err = fakeErrUnsupported("Streamlits.Create")
+	return
+}
+
+func (fakeUnsupportedStreamlits) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *StreamlitDetail, err error) {
+	err = fakeErrUnsupported("Streamlits.Describe")
+	return
+}
+
+func (fakeUnsupportedStreamlits) Drop(_ context.Context, _ *DropStreamlitRequest) (err error) {
+	err = fakeErrUnsupported("Streamlits.Drop")
+	return
+}
+
+func (fakeUnsupportedStreamlits) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Streamlits.DropSafely")
+	return
+}
+
+func (fakeUnsupportedStreamlits) Show(_ context.Context, _ *ShowStreamlitRequest) (_ []Streamlit, err error) {
+	err = fakeErrUnsupported("Streamlits.Show")
+	return
+}
+
+func (fakeUnsupportedStreamlits) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *Streamlit, err error) {
+	err = fakeErrUnsupported("Streamlits.ShowByID")
+	return
+}
+
+func (fakeUnsupportedStreamlits) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *Streamlit, err error) {
+	err = fakeErrUnsupported("Streamlits.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedStreams struct{}
+
+var _ Streams = fakeUnsupportedStreams{}
+
+func (fakeUnsupportedStreams) Alter(_ context.Context, _ *AlterStreamRequest) (err error) {
+	err = fakeErrUnsupported("Streams.Alter")
+	return
+}
+
+func (fakeUnsupportedStreams) Clone(_ context.Context, _ *CloneStreamRequest) (err error) {
+	err = fakeErrUnsupported("Streams.Clone")
+	return
+}
+
+func (fakeUnsupportedStreams) CreateOnDirectoryTable(_ context.Context, _ *CreateOnDirectoryTableStreamRequest) (err error) {
+	err = fakeErrUnsupported("Streams.CreateOnDirectoryTable")
+	return
+}
+
+func (fakeUnsupportedStreams) CreateOnExternalTable(_ context.Context, _ *CreateOnExternalTableStreamRequest) (err error) {
+	err = fakeErrUnsupported("Streams.CreateOnExternalTable")
+	return
+}
+
+func (fakeUnsupportedStreams) CreateOnTable(_ context.Context, _ *CreateOnTableStreamRequest) (err error) {
+	err = fakeErrUnsupported("Streams.CreateOnTable")
+	return
+}
+
+func (fakeUnsupportedStreams) CreateOnView(_ context.Context, _ *CreateOnViewStreamRequest) (err error) {
+	err = fakeErrUnsupported("Streams.CreateOnView")
+	return
+}
+
+func (fakeUnsupportedStreams) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *Stream, err error) {
+	err = fakeErrUnsupported("Streams.Describe")
+	return
+}
+
+func (fakeUnsupportedStreams) Drop(_ context.Context, _ *DropStreamRequest) (err error) {
+	err = fakeErrUnsupported("Streams.Drop")
+	return
+}
+
+func (fakeUnsupportedStreams) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Streams.DropSafely")
+	return
+}
+
+func (fakeUnsupportedStreams) Show(_ context.Context, _ *ShowStreamRequest) (_ []Stream, err error) {
+	err = fakeErrUnsupported("Streams.Show")
+	return
+}
+
+func (fakeUnsupportedStreams) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *Stream, err error) {
+	err = fakeErrUnsupported("Streams.ShowByID")
+	return
+}
+
+func (fakeUnsupportedStreams) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *Stream, err error) {
+	err = fakeErrUnsupported("Streams.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedTables struct{}
+
+var _ Tables = fakeUnsupportedTables{}
+
+func (fakeUnsupportedTables) Alter(_ context.Context, _ *AlterTableRequest) (err error) {
+	err = fakeErrUnsupported("Tables.Alter")
+	return
+}
+
+func (fakeUnsupportedTables) Create(_ context.Context, _ *CreateTableRequest) (err error) {
+	err = fakeErrUnsupported("Tables.Create")
+	return
+}
+
+func (fakeUnsupportedTables) CreateAsSelect(_ context.Context, _ *CreateTableAsSelectRequest) (err error) {
+	err = fakeErrUnsupported("Tables.CreateAsSelect")
+	return
+}
+
+func (fakeUnsupportedTables) CreateClone(_ context.Context, _ *CreateTableCloneRequest) (err error) {
+	err = fakeErrUnsupported("Tables.CreateClone")
+	return
+}
+
+func (fakeUnsupportedTables) CreateLike(_ context.Context, _ *CreateTableLikeRequest) (err error) {
+	err = fakeErrUnsupported("Tables.CreateLike")
+	return
+}
+
+func (fakeUnsupportedTables) CreateUsingTemplate(_ context.Context, _ *CreateTableUsingTemplateRequest) (err error) {
+	err = fakeErrUnsupported("Tables.CreateUsingTemplate")
+	return
+}
+
+func (fakeUnsupportedTables) DescribeColumns(_ context.Context, _ *DescribeTableColumnsRequest) (_ []TableColumnDetails, err error) {
+	err = fakeErrUnsupported("Tables.DescribeColumns")
+	return
+}
+
+func (fakeUnsupportedTables) DescribeStage(_ context.Context, _ *DescribeTableStageRequest) (_ []TableStageDetails, err error) {
+	err = fakeErrUnsupported("Tables.DescribeStage")
+	return
+}
+
+func (fakeUnsupportedTables) Drop(_ context.Context, _ *DropTableRequest) (err error) {
+	err = fakeErrUnsupported("Tables.Drop")
+	return
+}
+
+func (fakeUnsupportedTables) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Tables.DropSafely")
+	return
+}
+
+func (fakeUnsupportedTables) Show(_ context.Context, _ *ShowTableRequest) (_ []Table, err error) {
+	err = fakeErrUnsupported("Tables.Show")
+	return
+}
+
+func (fakeUnsupportedTables) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *Table, err error) {
+	err = fakeErrUnsupported("Tables.ShowByID")
+	return
+}
+
+func (fakeUnsupportedTables) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *Table, err error) {
+	err = fakeErrUnsupported("Tables.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedTables) Undrop(_ context.Context, _ *UndropTableRequest) (err error) {
+	err = fakeErrUnsupported("Tables.Undrop")
+	return
+}
+
+type fakeUnsupportedTagReferences struct{}
+
+var _ TagReferences = fakeUnsupportedTagReferences{}
+
+func (fakeUnsupportedTagReferences) GetForEntity(_ context.Context, _ *GetForEntityTagReferenceRequest) (_ []TagReference, err error) {
+	err = fakeErrUnsupported("TagReferences.GetForEntity")
+	return
+}
+
+type fakeUnsupportedTags struct{}
+
+var _ Tags = fakeUnsupportedTags{}
+
+func (fakeUnsupportedTags) Alter(_ context.Context, _ *AlterTagRequest) (err error) {
+	err = fakeErrUnsupported("Tags.Alter")
+	return
+}
+
+func (fakeUnsupportedTags) Create(_ context.Context, _ *CreateTagRequest) (err error) {
+	err = fakeErrUnsupported("Tags.Create")
+	return
+}
+
+func (fakeUnsupportedTags) Drop(_ context.Context, _ *DropTagRequest) (err error) {
+	err = fakeErrUnsupported("Tags.Drop")
+	return
+}
+
+func (fakeUnsupportedTags) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Tags.DropSafely")
+	return
+}
+
+func (fakeUnsupportedTags) Set(_ context.Context, _ *SetTagRequest) (err error) {
+	err = fakeErrUnsupported("Tags.Set")
+	return
+}
+
+func (fakeUnsupportedTags) SetOnCurrentAccount(_ context.Context, _ *SetTagOnCurrentAccountRequest) (err error) {
+	err = fakeErrUnsupported("Tags.SetOnCurrentAccount")
+	return
+}
+
+func (fakeUnsupportedTags) Show(_ context.Context, _ *ShowTagRequest) (_ []Tag, err error) {
+	err = fakeErrUnsupported("Tags.Show")
+	return
+}
+
+func (fakeUnsupportedTags) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *Tag, err error) {
+	err = fakeErrUnsupported("Tags.ShowByID")
+	return
+}
+
+func (fakeUnsupportedTags) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *Tag, err error) {
+	err = fakeErrUnsupported("Tags.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedTags) Undrop(_ context.Context, _ *UndropTagRequest) (err error) {
+	err = fakeErrUnsupported("Tags.Undrop")
+	return
+}
+
+func (fakeUnsupportedTags) Unset(_ context.Context, _ *UnsetTagRequest) (err error) {
+	err = fakeErrUnsupported("Tags.Unset")
+	return
+}
+
+func (fakeUnsupportedTags) UnsetOnCurrentAccount(_ context.Context, _ *UnsetTagOnCurrentAccountRequest) (err error) {
+	err = fakeErrUnsupported("Tags.UnsetOnCurrentAccount")
+	return
+}
+
+func (fakeUnsupportedTags) UnsetSafely(_ context.Context, _ *UnsetTagRequest) (err error) {
+	err = fakeErrUnsupported("Tags.UnsetSafely")
+	return
+}
+
+type fakeUnsupportedTasks struct{}
+
+var _ Tasks = fakeUnsupportedTasks{}
+
+func (fakeUnsupportedTasks) Alter(_ context.Context, _ *AlterTaskRequest) (err error) {
+	err = fakeErrUnsupported("Tasks.Alter")
+	return
+}
+
+func (fakeUnsupportedTasks) Clone(_ context.Context, _ *CloneTaskRequest) (err error) {
+	err = fakeErrUnsupported("Tasks.Clone")
+	return
+}
+
+func (fakeUnsupportedTasks) Create(_ context.Context, _ *CreateTaskRequest) (err error) {
+	err = fakeErrUnsupported("Tasks.Create")
+	return
+}
+
+func (fakeUnsupportedTasks) CreateOrAlter(_ context.Context, _ *CreateOrAlterTaskRequest) (err error) {
+	err = fakeErrUnsupported("Tasks.CreateOrAlter")
+	return
+}
+
+func (fakeUnsupportedTasks) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ *Task, err error) {
+	err = fakeErrUnsupported("Tasks.Describe")
+	return
+}
+
+func (fakeUnsupportedTasks) Drop(_ context.Context, _ *DropTaskRequest) (err error) {
+	err = fakeErrUnsupported("Tasks.Drop")
+	return
+}
+
+func (fakeUnsupportedTasks) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Tasks.DropSafely")
+	return
+}
+
+func (fakeUnsupportedTasks) Execute(_ context.Context, _ *ExecuteTaskRequest) (err error) {
+	err = fakeErrUnsupported("Tasks.Execute")
+	return
+}
+
+func (fakeUnsupportedTasks) ResumeTasks(_ context.Context, _ []SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Tasks.ResumeTasks")
+	return
+}
+
+func (fakeUnsupportedTasks) Show(_ context.Context, _ *ShowTaskRequest) (_ []Task, err error) {
+	err = fakeErrUnsupported("Tasks.Show")
+	return
+}
+
+func (fakeUnsupportedTasks) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *Task, err error) {
+	err = fakeErrUnsupported("Tasks.ShowByID")
+	return
+}
+
+func (fakeUnsupportedTasks) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *Task, err error) {
+	err = fakeErrUnsupported("Tasks.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedTasks) ShowParameters(_ context.Context, _ SchemaObjectIdentifier) (_ []*Parameter, err error) {
+	err = fakeErrUnsupported("Tasks.ShowParameters")
+	return
+}
+
+func (fakeUnsupportedTasks) SuspendRootTasks(_ context.Context, _ SchemaObjectIdentifier, _ SchemaObjectIdentifier) (_ []SchemaObjectIdentifier, err error) {
+	err = fakeErrUnsupported("Tasks.SuspendRootTasks")
+	return
+}
+
+type fakeUnsupportedUsers struct{}
+
+var _ Users = fakeUnsupportedUsers{}
+
+func (fakeUnsupportedUsers) AddProgrammaticAccessToken(_ context.Context, _ *AddUserProgrammaticAccessTokenRequest) (_ *AddProgrammaticAccessTokenResult, err error) {
+	err = fakeErrUnsupported("Users.AddProgrammaticAccessToken")
+	return
+}
+
+func (fakeUnsupportedUsers) Alter(_ context.Context, _ AccountObjectIdentifier, _ *AlterUserOptions) (err error) {
+	err = fakeErrUnsupported("Users.Alter")
+	return
+}
+
+func (fakeUnsupportedUsers) Create(_ context.Context, _ AccountObjectIdentifier, _ *CreateUserOptions) (err error) {
+	err = fakeErrUnsupported("Users.Create")
+	return
+}
+
+func (fakeUnsupportedUsers) Describe(_ context.Context, _ AccountObjectIdentifier) (_ *UserDetails, err error) {
+	err = fakeErrUnsupported("Users.Describe")
+	return
+}
+
+func (fakeUnsupportedUsers) Drop(_ context.Context, _ AccountObjectIdentifier, _ *DropUserOptions) (err error) {
+	err = fakeErrUnsupported("Users.Drop")
+	return
+}
+
+func (fakeUnsupportedUsers) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Users.DropSafely")
+	return
+}
+
+func (fakeUnsupportedUsers) ModifyProgrammaticAccessToken(_ context.Context, _ *ModifyUserProgrammaticAccessTokenRequest) (err error) {
+	err = fakeErrUnsupported("Users.ModifyProgrammaticAccessToken")
+	return
+}
+
+func (fakeUnsupportedUsers) RemoveProgrammaticAccessToken(_ context.Context, _ *RemoveUserProgrammaticAccessTokenRequest) (err error) {
+	err = fakeErrUnsupported("Users.RemoveProgrammaticAccessToken")
+	return
+}
+
+func (fakeUnsupportedUsers) RemoveProgrammaticAccessTokenSafely(_ context.Context, _ *RemoveUserProgrammaticAccessTokenRequest) (err error) {
+	err = fakeErrUnsupported("Users.RemoveProgrammaticAccessTokenSafely")
+	return
+}
+
+func (fakeUnsupportedUsers) RotateProgrammaticAccessToken(_ context.Context, _ *RotateUserProgrammaticAccessTokenRequest) (_ *RotateProgrammaticAccessTokenResult, err error) {
+	err = fakeErrUnsupported("Users.RotateProgrammaticAccessToken")
+	return
+}
+
+func (fakeUnsupportedUsers) Show(_ context.Context, _ *ShowUserOptions) (_ []User, err error) {
+	err = fakeErrUnsupported("Users.Show")
+	return
+}
+
+func (fakeUnsupportedUsers) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *User, err error) {
+	err = fakeErrUnsupported("Users.ShowByID")
+	return
+}
+
+func (fakeUnsupportedUsers) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *User, err error) {
+	err = fakeErrUnsupported("Users.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedUsers) ShowParameters(_ context.Context, _ AccountObjectIdentifier) (_ []*Parameter, err error) {
+	err = fakeErrUnsupported("Users.ShowParameters")
+	return
+}
+
+func (fakeUnsupportedUsers) ShowProgrammaticAccessTokenByName(_ context.Context, _ AccountObjectIdentifier, _ AccountObjectIdentifier) (_ *ProgrammaticAccessToken, err error) {
+	err = fakeErrUnsupported("Users.ShowProgrammaticAccessTokenByName")
+	return
+}
+
+func (fakeUnsupportedUsers) ShowProgrammaticAccessTokenByNameSafely(_ context.Context, _ AccountObjectIdentifier, _ AccountObjectIdentifier) (_ *ProgrammaticAccessToken, err error) {
+	err = fakeErrUnsupported("Users.ShowProgrammaticAccessTokenByNameSafely")
+	return
+}
+
+func (fakeUnsupportedUsers) ShowProgrammaticAccessTokens(_ context.Context, _ *ShowUserProgrammaticAccessTokenRequest) (_ []ProgrammaticAccessToken, err error) {
+	err = fakeErrUnsupported("Users.ShowProgrammaticAccessTokens")
+	return
+}
+
+func (fakeUnsupportedUsers) ShowUserWorkloadIdentityAuthenticationMethodOptions(_ context.Context, _ AccountObjectIdentifier) (_ []UserWorkloadIdentityAuthenticationMethod, err error) {
+	err = fakeErrUnsupported("Users.ShowUserWorkloadIdentityAuthenticationMethodOptions")
+	return
+}
+
+type fakeUnsupportedUserProgrammaticAccessTokens struct{}
+
+var _ UserProgrammaticAccessTokens = fakeUnsupportedUserProgrammaticAccessTokens{}
+
+func (fakeUnsupportedUserProgrammaticAccessTokens) Add(_ context.Context, _ *AddUserProgrammaticAccessTokenRequest) (_ *AddProgrammaticAccessTokenResult, err error) {
+	err = fakeErrUnsupported("UserProgrammaticAccessTokens.Add")
+	return
+}
+
+func (fakeUnsupportedUserProgrammaticAccessTokens) Modify(_ context.Context, _ *ModifyUserProgrammaticAccessTokenRequest) (err error) {
+	err = fakeErrUnsupported("UserProgrammaticAccessTokens.Modify")
+	return
+}
+
+func (fakeUnsupportedUserProgrammaticAccessTokens) Remove(_ context.Context, _ *RemoveUserProgrammaticAccessTokenRequest) (err error) {
+	err = fakeErrUnsupported("UserProgrammaticAccessTokens.Remove")
+	return
+}
+
+func (fakeUnsupportedUserProgrammaticAccessTokens) RemoveByIDSafely(_ context.Context, _ *RemoveUserProgrammaticAccessTokenRequest) (err error) {
+	err = fakeErrUnsupported("UserProgrammaticAccessTokens.RemoveByIDSafely")
+	return
+}
+
+func (fakeUnsupportedUserProgrammaticAccessTokens) Rotate(_ context.Context, _ *RotateUserProgrammaticAccessTokenRequest) (_ *RotateProgrammaticAccessTokenResult, err error) {
+	err = fakeErrUnsupported("UserProgrammaticAccessTokens.Rotate")
+	return
+}
+
+func (fakeUnsupportedUserProgrammaticAccessTokens) Show(_ context.Context, _ *ShowUserProgrammaticAccessTokenRequest) (_ []ProgrammaticAccessToken, err error) {
+	err = fakeErrUnsupported("UserProgrammaticAccessTokens.Show")
+	return
+}
+
+func (fakeUnsupportedUserProgrammaticAccessTokens) ShowByID(_ context.Context, _ AccountObjectIdentifier, _ AccountObjectIdentifier) (_ *ProgrammaticAccessToken, err error) {
+	err = fakeErrUnsupported("UserProgrammaticAccessTokens.ShowByID")
+	return
+}
+
+func (fakeUnsupportedUserProgrammaticAccessTokens) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier, _ AccountObjectIdentifier) (_ *ProgrammaticAccessToken, err error) {
+	err = fakeErrUnsupported("UserProgrammaticAccessTokens.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedViews struct{}
+
+var _ Views = fakeUnsupportedViews{}
+
+func (fakeUnsupportedViews) Alter(_ context.Context, _ *AlterViewRequest) (err error) {
+	err = fakeErrUnsupported("Views.Alter")
+	return
+}
+
+func (fakeUnsupportedViews) Create(_ context.Context, _ *CreateViewRequest) (err error) {
+	err = fakeErrUnsupported("Views.Create")
+	return
+}
+
+func (fakeUnsupportedViews) Describe(_ context.Context, _ SchemaObjectIdentifier) (_ []ViewDetails, err error) {
+	err = fakeErrUnsupported("Views.Describe")
+	return
+}
+
+func (fakeUnsupportedViews) Drop(_ context.Context, _ *DropViewRequest) (err error) {
+	err = fakeErrUnsupported("Views.Drop")
+	return
+}
+
+func (fakeUnsupportedViews) DropSafely(_ context.Context, _ SchemaObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Views.DropSafely")
+	return
+}
+
+func (fakeUnsupportedViews) Show(_ context.Context, _ *ShowViewRequest) (_ []View, err error) {
+	err = fakeErrUnsupported("Views.Show")
+	return
+}
+
+func (fakeUnsupportedViews) ShowByID(_ context.Context, _ SchemaObjectIdentifier) (_ *View, err error) {
+	err = fakeErrUnsupported("Views.ShowByID")
+	return
+}
+
+func (fakeUnsupportedViews) ShowByIDSafely(_ context.Context, _ SchemaObjectIdentifier) (_ *View, err error) {
+	err = fakeErrUnsupported("Views.ShowByIDSafely")
+	return
+}
+
+type fakeUnsupportedWarehouses struct{}
+
+var _ Warehouses = fakeUnsupportedWarehouses{}
+
+func (fakeUnsupportedWarehouses) Alter(_ context.Context, _ AccountObjectIdentifier, _ *AlterWarehouseOptions) (err error) {
+	err = fakeErrUnsupported("Warehouses.Alter")
+	return
+}
+
+func (fakeUnsupportedWarehouses) Create(_ context.Context, _ AccountObjectIdentifier, _ *CreateWarehouseOptions) (err error) {
+	err = fakeErrUnsupported("Warehouses.Create")
+	return
+}
+
+func (fakeUnsupportedWarehouses) CreateAdaptive(_ context.Context, _ AccountObjectIdentifier, _ *CreateAdaptiveWarehouseOptions) (err error) {
+	err = fakeErrUnsupported("Warehouses.CreateAdaptive")
+	return
+}
+
+func (fakeUnsupportedWarehouses) Describe(_ context.Context, _ AccountObjectIdentifier) (_ *WarehouseDetails, err error) {
+	err = fakeErrUnsupported("Warehouses.Describe")
+	return
+}
+
+func (fakeUnsupportedWarehouses) Drop(_ context.Context, _ AccountObjectIdentifier, _ *DropWarehouseOptions) (err error) {
+	err = fakeErrUnsupported("Warehouses.Drop")
+	return
+}
+
+func (fakeUnsupportedWarehouses) DropSafely(_ context.Context, _ AccountObjectIdentifier) (err error) {
+	err = fakeErrUnsupported("Warehouses.DropSafely")
+	return
+}
+
+func (fakeUnsupportedWarehouses) Show(_ context.Context, _ *ShowWarehouseOptions) (_ []Warehouse, err error) {
+	err = fakeErrUnsupported("Warehouses.Show")
+	return
+}
+
+func (fakeUnsupportedWarehouses) ShowByID(_ context.Context, _ AccountObjectIdentifier) (_ *Warehouse, err error) {
+	err = fakeErrUnsupported("Warehouses.ShowByID")
+	return
+}
+
+func (fakeUnsupportedWarehouses) ShowByIDExperimental(_ context.Context, _ AccountObjectIdentifier) (_ *Warehouse, err error) {
+	err = fakeErrUnsupported("Warehouses.ShowByIDExperimental")
+	return
+}
+
+func (fakeUnsupportedWarehouses) ShowByIDExperimentalSafely(_ context.Context, _ AccountObjectIdentifier) (_ *Warehouse, err error) {
+	err = fakeErrUnsupported("Warehouses.ShowByIDExperimentalSafely")
+	return
+}
+
+func (fakeUnsupportedWarehouses) ShowByIDSafely(_ context.Context, _ AccountObjectIdentifier) (_ *Warehouse, err error) {
+	err = fakeErrUnsupported("Warehouses.ShowByIDSafely")
+	return
+}
+
+func (fakeUnsupportedWarehouses) ShowParameters(_ context.Context, _ AccountObjectIdentifier) (_ []*Parameter, err error) {
+	err = fakeErrUnsupported("Warehouses.ShowParameters")
+	return
+}
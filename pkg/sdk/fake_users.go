@@ -0,0 +1,250 @@
+//go:build fake_client_tests
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ Users = (*fakeUsers)(nil)
+
+type fakeUser struct {
+	fakeObject
+}
+
+type fakeUsers struct {
+	client  *Client
+	catalog *FakeCatalog
+}
+
+func (c *FakeCatalog) user(id AccountObjectIdentifier) (*fakeUser, error) {
+	user, ok := c.users[id.Name()]
+	if !ok {
+		return nil, fakeErrDoesNotExist(ObjectTypeUser, id)
+	}
+	return user, nil
+}
+
+func (v *fakeUsers) Create(_ context.Context, id AccountObjectIdentifier, opts *CreateUserOptions) error {
+	if opts == nil {
+		opts = &CreateUserOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, ok := v.catalog.users[id.Name()]; ok {
+		switch {
+		case opts.IfNotExists != nil && *opts.IfNotExists:
+			return nil
+		case opts.OrReplace == nil || !*opts.OrReplace:
+			return fakeErrAlreadyExists(ObjectTypeUser, id)
+		}
+	}
+	user := &fakeUser{fakeObject: newFakeObject(id.Name(), v.catalog.currentRole)}
+	fakeSetProperties(user.properties, opts)
+	v.catalog.users[id.Name()] = user
+	return nil
+}
+
+func (v *fakeUsers) Alter(_ context.Context, id AccountObjectIdentifier, opts *AlterUserOptions) error {
+	if opts == nil {
+		opts = &AlterUserOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	user, err := v.catalog.user(id)
+	if err != nil {
+		if opts.IfExists != nil && *opts.IfExists {
+			return nil
+		}
+		return err
+	}
+	switch {
+	case opts.NewName.Name() != "":
+		if _, ok := v.catalog.users[opts.NewName.Name()]; ok {
+			return fakeErrAlreadyExists(ObjectTypeUser, opts.NewName)
+		}
+		delete(v.catalog.users, id.Name())
+		user.name = opts.NewName.Name()
+		v.catalog.users[user.name] = user
+		v.catalog.renameInGrants(ObjectTypeUser, id, opts.NewName)
+	case opts.Set != nil:
+		fakeSetProperties(user.properties, opts.Set)
+	case opts.Unset != nil:
+		fakeUnsetProperties(user.properties, opts.Unset)
+	case opts.ResetPassword != nil, opts.AbortAllQueries != nil:
+	default:
+		return fakeErrUnsupported("ALTER USER")
+	}
+	return nil
+}
+
+func (v *fakeUsers) Drop(_ context.Context, id AccountObjectIdentifier, opts *DropUserOptions) error {
+	if opts == nil {
+		opts = &DropUserOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, err := v.catalog.user(id); err != nil {
+		if opts.IfExists != nil && *opts.IfExists {
+			return nil
+		}
+		return err
+	}
+	delete(v.catalog.users, id.Name())
+	v.catalog.dropGrantsFor(ObjectTypeUser, id)
+	return nil
+}
+
+func (v *fakeUsers) DropSafely(ctx context.Context, id AccountObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, id, &DropUserOptions{IfExists: Bool(true)}) }, ctx, id)
+}
+
+// Describe returns only the properties that were set explicitly, the same way it is done for the null values in Snowflake.
+func (v *fakeUsers) Describe(_ context.Context, id AccountObjectIdentifier) (*UserDetails, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	user, err := v.catalog.user(id)
+	if err != nil {
+		return nil, err
+	}
+	rows := []propertyRow{
+		{Property: "NAME", Value: user.name},
+		{Property: "LOGIN_NAME", Value: user.property("LOGIN_NAME", user.name)},
+		{Property: "DISPLAY_NAME", Value: user.property("DISPLAY_NAME", user.name)},
+		{Property: "DISABLED", Value: user.property("DISABLED", "false")},
+		{Property: "MUST_CHANGE_PASSWORD", Value: user.property("MUST_CHANGE_PASSWORD", "false")},
+		{Property: "SNOWFLAKE_LOCK", Value: "false"},
+		{Property: "HAS_MFA", Value: "false"},
+		{Property: "HAS_WORKLOAD_IDENTITY", Value: "false"},
+	}
+	for key, value := range user.properties {
+		switch key {
+		case "LOGIN_NAME", "DISPLAY_NAME", "DISABLED", "MUST_CHANGE_PASSWORD":
+		case "PASSWORD":
+			rows = append(rows, propertyRow{Property: key, Value: "********"})
+		default:
+			rows = append(rows, propertyRow{Property: key, Value: value})
+		}
+	}
+	return userDetailsFromRows(rows), nil
+}
+
+func (v *fakeUsers) Show(_ context.Context, opts *ShowUserOptions) ([]User, error) {
+	opts = createIfNil(opts)
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	names := fakeFilterNames(v.catalog.users, opts.Like, opts.StartsWith, &LimitFrom{Rows: opts.Limit, From: opts.From})
+	return collections.Map(names, func(name string) User {
+		user := v.catalog.users[name]
+		_, hasPassword := user.properties["PASSWORD"]
+		_, hasRsaPublicKey := user.properties["RSA_PUBLIC_KEY"]
+		return User{
+			Name:                  name,
+			CreatedOn:             user.createdOn,
+			LoginName:             user.property("LOGIN_NAME", name),
+			DisplayName:           user.property("DISPLAY_NAME", name),
+			FirstName:             user.property("FIRST_NAME", ""),
+			LastName:              user.property("LAST_NAME", ""),
+			Email:                 user.property("EMAIL", ""),
+			MinsToUnlock:          user.property("MINS_TO_UNLOCK", ""),
+			DaysToExpiry:          user.property("DAYS_TO_EXPIRY", ""),
+			Comment:               user.property("COMMENT", ""),
+			Disabled:              user.boolProperty("DISABLED", false),
+			MustChangePassword:    user.boolProperty("MUST_CHANGE_PASSWORD", false),
+			DefaultWarehouse:      user.property("DEFAULT_WAREHOUSE", ""),
+			DefaultNamespace:      user.property("DEFAULT_NAMESPACE", ""),
+			DefaultRole:           user.property("DEFAULT_ROLE", ""),
+			DefaultSecondaryRoles: user.property("DEFAULT_SECONDARY_ROLES", `["ALL"]`),
+			MinsToBypassMfa:       user.property("MINS_TO_BYPASS_MFA", ""),
+			Owner:                 user.owner,
+			HasPassword:           hasPassword,
+			HasRsaPublicKey:       hasRsaPublicKey,
+			Type:                  user.property("TYPE", ""),
+		}
+	}), nil
+}
+
+func (v *fakeUsers) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*User, error) {
+	users, err := v.Show(ctx, &ShowUserOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(users, func(user User) bool { return user.ID().Name() == id.Name() })
+}
+
+func (v *fakeUsers) ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*User, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *fakeUsers) ShowParameters(_ context.Context, id AccountObjectIdentifier) ([]*Parameter, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	user, err := v.catalog.user(id)
+	if err != nil {
+		return nil, err
+	}
+	return user.parameters(ParameterTypeUser, AsStringList(AllUserParameters)), nil
+}
+
+func (v *fakeUsers) AddProgrammaticAccessToken(_ context.Context, _ *AddUserProgrammaticAccessTokenRequest) (*AddProgrammaticAccessTokenResult, error) {
+	return nil, fakeErrUnsupported("ALTER USER ... ADD PROGRAMMATIC ACCESS TOKEN")
+}
+
+func (v *fakeUsers) ModifyProgrammaticAccessToken(_ context.Context, _ *ModifyUserProgrammaticAccessTokenRequest) error {
+	return fakeErrUnsupported("ALTER USER ... MODIFY PROGRAMMATIC ACCESS TOKEN")
+}
+
+func (v *fakeUsers) RotateProgrammaticAccessToken(_ context.Context, _ *RotateUserProgrammaticAccessTokenRequest) (*RotateProgrammaticAccessTokenResult, error) {
+	return nil, fakeErrUnsupported("ALTER USER ... ROTATE PROGRAMMATIC ACCESS TOKEN")
+}
+
+func (v *fakeUsers) RemoveProgrammaticAccessToken(_ context.Context, _ *RemoveUserProgrammaticAccessTokenRequest) error {
+	return fakeErrUnsupported("ALTER USER ... REMOVE PROGRAMMATIC ACCESS TOKEN")
+}
+
+func (v *fakeUsers) RemoveProgrammaticAccessTokenSafely(_ context.Context, _ *RemoveUserProgrammaticAccessTokenRequest) error {
+	return fakeErrUnsupported("ALTER USER ... REMOVE PROGRAMMATIC ACCESS TOKEN")
+}
+
+func (v *fakeUsers) ShowProgrammaticAccessTokens(_ context.Context, _ *ShowUserProgrammaticAccessTokenRequest) ([]ProgrammaticAccessToken, error) {
+	return nil, fakeErrUnsupported("SHOW USER PROGRAMMATIC ACCESS TOKENS")
+}
+
+func (v *fakeUsers) ShowProgrammaticAccessTokenByName(_ context.Context, _ AccountObjectIdentifier, _ AccountObjectIdentifier) (*ProgrammaticAccessToken, error) {
+	return nil, fakeErrUnsupported("SHOW USER PROGRAMMATIC ACCESS TOKENS")
+}
+
+func (v *fakeUsers) ShowProgrammaticAccessTokenByNameSafely(_ context.Context, _ AccountObjectIdentifier, _ AccountObjectIdentifier) (*ProgrammaticAccessToken, error) {
+	return nil, fakeErrUnsupported("SHOW USER PROGRAMMATIC ACCESS TOKENS")
+}
+
+func (v *fakeUsers) ShowUserWorkloadIdentityAuthenticationMethodOptions(_ context.Context, _ AccountObjectIdentifier) ([]UserWorkloadIdentityAuthenticationMethod, error) {
+	return nil, fakeErrUnsupported("SHOW USER WORKLOAD IDENTITY AUTHENTICATION METHODS")
+}
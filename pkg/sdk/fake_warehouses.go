@@ -0,0 +1,250 @@
+//go:build fake_client_tests
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ Warehouses = (*fakeWarehouses)(nil)
+
+var fakeWarehouseParameters = []string{
+	string(WarehouseParameterMaxConcurrencyLevel),
+	string(WarehouseParameterStatementQueuedTimeoutInSeconds),
+	string(WarehouseParameterStatementTimeoutInSeconds),
+}
+
+type fakeWarehouse struct {
+	fakeObject
+	state WarehouseState
+}
+
+type fakeWarehouses struct {
+	client  *Client
+	catalog *FakeCatalog
+}
+
+func (c *FakeCatalog) warehouse(id AccountObjectIdentifier) (*fakeWarehouse, error) {
+	warehouse, ok := c.warehouses[id.Name()]
+	if !ok {
+		return nil, fakeErrDoesNotExist(ObjectTypeWarehouse, id)
+	}
+	return warehouse, nil
+}
+
+func (v *fakeWarehouses) Create(_ context.Context, id AccountObjectIdentifier, opts *CreateWarehouseOptions) error {
+	if opts == nil {
+		opts = &CreateWarehouseOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, ok := v.catalog.warehouses[id.Name()]; ok {
+		switch {
+		case opts.IfNotExists != nil && *opts.IfNotExists:
+			return nil
+		case opts.OrReplace == nil || !*opts.OrReplace:
+			return fakeErrAlreadyExists(ObjectTypeWarehouse, id)
+		}
+	}
+	warehouse := &fakeWarehouse{
+		fakeObject: newFakeObject(id.Name(), v.catalog.currentRole),
+		state:      WarehouseStateStarted,
+	}
+	if opts.InitiallySuspended != nil && *opts.InitiallySuspended {
+		warehouse.state = WarehouseStateSuspended
+	}
+	fakeSetProperties(warehouse.properties, opts)
+	delete(warehouse.properties, "INITIALLY_SUSPENDED")
+	v.catalog.warehouses[id.Name()] = warehouse
+	v.catalog.currentWarehouse = id.Name()
+	return nil
+}
+
+func (v *fakeWarehouses) CreateAdaptive(_ context.Context, _ AccountObjectIdentifier, _ *CreateAdaptiveWarehouseOptions) error {
+	return fakeErrUnsupported("CREATE ADAPTIVE WAREHOUSE")
+}
+
+func (v *fakeWarehouses) Alter(_ context.Context, id AccountObjectIdentifier, opts *AlterWarehouseOptions) error {
+	if opts == nil {
+		opts = &AlterWarehouseOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	warehouse, err := v.catalog.warehouse(id)
+	if err != nil {
+		if opts.IfExists != nil && *opts.IfExists {
+			return nil
+		}
+		return err
+	}
+	switch {
+	case opts.Suspend != nil && *opts.Suspend:
+		warehouse.state = WarehouseStateSuspended
+	case opts.Resume != nil && *opts.Resume:
+		warehouse.state = WarehouseStateStarted
+	case opts.NewName != nil:
+		if _, ok := v.catalog.warehouses[opts.NewName.Name()]; ok {
+			return fakeErrAlreadyExists(ObjectTypeWarehouse, *opts.NewName)
+		}
+		delete(v.catalog.warehouses, id.Name())
+		warehouse.name = opts.NewName.Name()
+		v.catalog.warehouses[warehouse.name] = warehouse
+	case opts.Set != nil:
+		fakeSetProperties(warehouse.properties, opts.Set)
+		delete(warehouse.properties, "WAIT_FOR_COMPLETION")
+	case opts.Unset != nil:
+		fakeUnsetProperties(warehouse.properties, opts.Unset)
+	}
+	return nil
+}
+
+func (v *fakeWarehouses) Drop(_ context.Context, id AccountObjectIdentifier, opts *DropWarehouseOptions) error {
+	if opts == nil {
+		opts = &DropWarehouseOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	if _, err := v.catalog.warehouse(id); err != nil {
+		if opts.IfExists != nil && *opts.IfExists {
+			return nil
+		}
+		return err
+	}
+	delete(v.catalog.warehouses, id.Name())
+	if v.catalog.currentWarehouse == id.Name() {
+		v.catalog.currentWarehouse = ""
+	}
+	return nil
+}
+
+func (v *fakeWarehouses) DropSafely(ctx context.Context, id AccountObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, id, &DropWarehouseOptions{IfExists: Bool(true)}) }, ctx, id)
+}
+
+func (v *fakeWarehouses) Show(_ context.Context, opts *ShowWarehouseOptions) ([]Warehouse, error) {
+	opts = createIfNil(opts)
+	if err := fakeValidate(opts); err != nil {
+		return nil, err
+	}
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	names := fakeFilterNames(v.catalog.warehouses, opts.Like, opts.StartsWith, opts.LimitFrom)
+	return collections.Map(names, func(name string) Warehouse {
+		return v.catalog.warehouses[name].toWarehouse(name == v.catalog.currentWarehouse)
+	}), nil
+}
+
+// toWarehouse fills the properties that were not set explicitly with Snowflake defaults.
+func (w *fakeWarehouse) toWarehouse(isCurrent bool) Warehouse {
+	warehouse := Warehouse{
+		Name:                            w.name,
+		State:                           w.state,
+		Type:                            WarehouseType(w.property("WAREHOUSE_TYPE", string(WarehouseTypeStandard))),
+		Size:                            Pointer(WarehouseSize(w.property("WAREHOUSE_SIZE", string(WarehouseSizeXSmall)))),
+		MinClusterCount:                 Int(w.intProperty("MIN_CLUSTER_COUNT", 1)),
+		MaxClusterCount:                 Int(w.intProperty("MAX_CLUSTER_COUNT", 1)),
+		StartedClusters:                 Int(0),
+		Running:                         Int(0),
+		Queued:                          Int(0),
+		IsCurrent:                       isCurrent,
+		AutoSuspend:                     Int(w.intProperty("AUTO_SUSPEND", 600)),
+		AutoResume:                      w.boolProperty("AUTO_RESUME", true),
+		CreatedOn:                       w.createdOn,
+		ResumedOn:                       w.createdOn,
+		UpdatedOn:                       w.createdOn,
+		Owner:                           w.owner,
+		Comment:                         w.property("COMMENT", ""),
+		EnableQueryAcceleration:         Bool(w.boolProperty("ENABLE_QUERY_ACCELERATION", false)),
+		QueryAccelerationMaxScaleFactor: Int(w.intProperty("QUERY_ACCELERATION_MAX_SCALE_FACTOR", 8)),
+		ScalingPolicy:                   Pointer(ScalingPolicy(w.property("SCALING_POLICY", string(ScalingPolicyStandard)))),
+		OwnerRoleType:                   "ROLE",
+	}
+	if w.state == WarehouseStateStarted {
+		warehouse.StartedClusters = warehouse.MinClusterCount
+	}
+	if v, ok := w.properties["RESOURCE_MONITOR"]; ok {
+		warehouse.ResourceMonitor = NewAccountObjectIdentifier(v)
+	}
+	if v, ok := w.properties["RESOURCE_CONSTRAINT"]; ok {
+		warehouse.ResourceConstraint = Pointer(WarehouseResourceConstraint(v))
+	}
+	if v, ok := w.properties["GENERATION"]; ok {
+		warehouse.Generation = Pointer(WarehouseGeneration(v))
+	}
+	return warehouse
+}
+
+func (v *fakeWarehouses) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Warehouse, error) {
+	warehouses, err := v.Show(ctx, &ShowWarehouseOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(warehouses, func(r Warehouse) bool { return r.Name == id.Name() })
+}
+
+func (v *fakeWarehouses) ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*Warehouse, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *fakeWarehouses) ShowByIDExperimental(ctx context.Context, id AccountObjectIdentifier) (*Warehouse, error) {
+	warehouses, err := v.Show(ctx, &ShowWarehouseOptions{
+		StartsWith: String(id.Name()),
+		LimitFrom:  &LimitFrom{Rows: Int(1)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(warehouses, func(r Warehouse) bool { return r.Name == id.Name() })
+}
+
+func (v *fakeWarehouses) ShowByIDExperimentalSafely(ctx context.Context, id AccountObjectIdentifier) (*Warehouse, error) {
+	return SafeShowById(v.client, v.ShowByIDExperimental, ctx, id)
+}
+
+func (v *fakeWarehouses) Describe(_ context.Context, id AccountObjectIdentifier) (*WarehouseDetails, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	warehouse, err := v.catalog.warehouse(id)
+	if err != nil {
+		return nil, err
+	}
+	return &WarehouseDetails{
+		CreatedOn: warehouse.createdOn,
+		Name:      warehouse.name,
+		Kind:      string(ObjectTypeWarehouse),
+	}, nil
+}
+
+func (v *fakeWarehouses) ShowParameters(_ context.Context, id AccountObjectIdentifier) ([]*Parameter, error) {
+	v.catalog.mu.Lock()
+	defer v.catalog.mu.Unlock()
+
+	warehouse, err := v.catalog.warehouse(id)
+	if err != nil {
+		return nil, err
+	}
+	return warehouse.parameters(ParameterTypeWarehouse, fakeWarehouseParameters), nil
+}
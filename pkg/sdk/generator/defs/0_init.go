@@ -10,19 +10,25 @@ import (
 
 func init() {
 	gen.AllSdkObjectDefinitions = append(gen.AllSdkObjectDefinitions,
+		aggregationPoliciesDef,
 		apiIntegrationsDef,
 		applicationPackagesDef,
 		applicationRolesDef,
 		applicationsDef,
 		authenticationPoliciesDef,
+		backupPoliciesDef,
+		backupSetsDef,
 		budgetsDef,
 		catalogIntegrationsDef,
+		classificationProfilesDef,
 		computePoolsDef,
 		connectionsDef,
 		cortexAgentsDef,
 		cortexSearchServicesDef,
 		dataMetricFunctionReferencesDef,
+		dbtProjectsDef,
 		eventTablesDef,
+		externalAccessIntegrationsDef,
 		externalFunctionsDef,
 		externalVolumesDef,
 		fileFormatsDef,
@@ -31,6 +37,7 @@ func init() {
 		hybridTablesDef,
 		icebergTablesDef,
 		imageRepositoriesDef,
+		joinPoliciesDef,
 		listingsDef,
 		managedAccountsDef,
 		materializedViewsDef,
@@ -42,9 +49,12 @@ func init() {
 		openflowDeploymentsDef,
 		openflowRuntimesDef,
 		organizationAccountsDef,
+		packagesPoliciesDef,
 		passwordPoliciesDef,
 		postgresInstancesDef,
+		privacyPoliciesDef,
 		proceduresDef,
+		projectionPoliciesDef,
 		rowAccessPoliciesDef,
 		secretsDef,
 		securityIntegrationsDef,
@@ -52,8 +62,10 @@ func init() {
 		sequencesDef,
 		servicesDef,
 		sessionPoliciesDef,
+		snapshotsDef,
 		stagesDef,
 		storageIntegrationsDef,
+		storageLifecyclePoliciesDef,
 		streamlitsDef,
 		streamsDef,
 		tagReferencesDef,
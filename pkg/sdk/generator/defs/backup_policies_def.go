@@ -0,0 +1,93 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var backupPoliciesDef = g.NewInterface(
+	"BackupPolicies",
+	"BackupPolicy",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-backup-policy",
+		g.NewQueryStruct("CreateBackupPolicy").
+			Create().
+			OrReplace().
+			SQL("BACKUP POLICY").
+			IfNotExists().
+			Name().
+			OptionalSQL("WITH RETENTION LOCK").
+			OptionalTextAssignment("SCHEDULE", g.ParameterOptions().SingleQuotes()).
+			OptionalNumberAssignment("EXPIRE_AFTER_DAYS", g.ParameterOptions().NoQuotes()).
+			OptionalComment().
+			OptionalTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-backup-policy",
+		g.NewQueryStruct("AlterBackupPolicy").
+			Alter().
+			SQL("BACKUP POLICY").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("BackupPolicySet").
+					OptionalTextAssignment("SCHEDULE", g.ParameterOptions().SingleQuotes()).
+					OptionalNumberAssignment("EXPIRE_AFTER_DAYS", g.ParameterOptions().NoQuotes()).
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "Schedule", "ExpireAfterDays", "Comment"),
+				g.ListOptions().NoParentheses().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("BackupPolicyUnset").
+					OptionalSQL("SCHEDULE").
+					OptionalSQL("EXPIRE_AFTER_DAYS").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "Schedule", "ExpireAfterDays", "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "Unset", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-backup-policy",
+		g.NewQueryStruct("DropBackupPolicy").
+			Drop().
+			SQL("BACKUP POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperationWithPairedStructs(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-backup-policies",
+		g.StructPair("backupPolicyDBRow", "BackupPolicy").
+			Time("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("owner").
+			OptionalText("comment", g.WithRequiredInPlain()).
+			OptionalText("schedule").
+			OptionalNumber("expire_after_days").
+			Bool("has_retention_lock").
+			Text("owner_role_type").
+			WithConvertGeneration(),
+		g.NewQueryStruct("ShowBackupPolicies").
+			Show().
+			SQL("BACKUP POLICIES").
+			OptionalLike().
+			OptionalIn().
+			OptionalLimitFrom(),
+		g.ShowByIDLikeFiltering,
+		g.ShowByIDInFiltering,
+	)
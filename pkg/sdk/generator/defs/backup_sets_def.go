@@ -0,0 +1,128 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var backupPairs = g.StructPair("backupDBRow", "Backup").
+	Time("created_on").
+	Text("backup_id").
+	Text("backup_set_name").
+	Text("database_name").
+	Text("schema_name").
+	OptionalTime("expire_on").
+	Bool("is_under_legal_hold").
+	WithConvertGeneration()
+
+var backupSetsDef = g.NewInterface(
+	"BackupSets",
+	"BackupSet",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-backup-set",
+		g.NewQueryStruct("CreateBackupSet").
+			Create().
+			OrReplace().
+			SQL("BACKUP SET").
+			IfNotExists().
+			Name().
+			OptionalIdentifier("ForDatabase", g.KindOfT[sdkcommons.AccountObjectIdentifier](), g.IdentifierOptions().SQL("FOR DATABASE")).
+			OptionalIdentifier("ForSchema", g.KindOfT[sdkcommons.DatabaseObjectIdentifier](), g.IdentifierOptions().SQL("FOR SCHEMA")).
+			OptionalIdentifier("ForTable", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("FOR TABLE")).
+			OptionalIdentifier("BackupPolicy", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("WITH BACKUP POLICY")).
+			OptionalComment().
+			OptionalTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "ForDatabase").
+			WithValidation(g.ValidIdentifierIfSet, "ForSchema").
+			WithValidation(g.ValidIdentifierIfSet, "ForTable").
+			WithValidation(g.ValidIdentifierIfSet, "BackupPolicy").
+			WithValidation(g.ExactlyOneValueSet, "ForDatabase", "ForSchema", "ForTable").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-backup-set",
+		g.NewQueryStruct("AlterBackupSet").
+			Alter().
+			SQL("BACKUP SET").
+			IfExists().
+			Name().
+			OptionalSQL("ADD BACKUP").
+			OptionalTextAssignment("DELETE BACKUP IDENTIFIER", g.ParameterOptions().SingleQuotes().NoEquals()).
+			OptionalIdentifier("ApplyBackupPolicy", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("APPLY BACKUP POLICY")).
+			OptionalSQL("SUSPEND BACKUP POLICY").
+			OptionalSQL("RESUME BACKUP POLICY").
+			OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET COMMENT").
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "ApplyBackupPolicy").
+			WithValidation(g.ExactlyOneValueSet, "AddBackup", "DeleteBackupIdentifier", "ApplyBackupPolicy", "SuspendBackupPolicy", "ResumeBackupPolicy", "SetComment", "UnsetComment", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-backup-set",
+		g.NewQueryStruct("DropBackupSet").
+			Drop().
+			SQL("BACKUP SET").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperationWithPairedStructs(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-backup-sets",
+		g.StructPair("backupSetDBRow", "BackupSet").
+			Time("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("object_kind").
+			Text("object_name").
+			OptionalText("object_database_name").
+			OptionalText("object_schema_name").
+			OptionalText("backup_policy_name").
+			OptionalText("backup_policy_database_name").
+			OptionalText("backup_policy_schema_name").
+			OptionalText("comment", g.WithRequiredInPlain()).
+			Text("owner").
+			Text("owner_role_type").
+			WithConvertGeneration(),
+		g.NewQueryStruct("ShowBackupSets").
+			Show().
+			SQL("BACKUP SETS").
+			OptionalLike().
+			OptionalIn().
+			OptionalLimitFrom(),
+		g.ShowByIDLikeFiltering,
+		g.ShowByIDInFiltering,
+	).
+	CustomShowOperationWithPairedStructs(
+		"ShowBackups",
+		g.ShowMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/show-backups",
+		backupPairs,
+		g.NewQueryStruct("ShowBackups").
+			Show().
+			SQL("BACKUPS IN BACKUP SET").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	CustomOperation(
+		"Restore",
+		"https://docs.snowflake.com/en/user-guide/backups",
+		g.NewQueryStruct("RestoreFromBackupSet").
+			Create().
+			OptionalIdentifier("Database", g.KindOfT[sdkcommons.AccountObjectIdentifier](), g.IdentifierOptions().SQL("DATABASE")).
+			OptionalIdentifier("Schema", g.KindOfT[sdkcommons.DatabaseObjectIdentifier](), g.IdentifierOptions().SQL("SCHEMA")).
+			OptionalIdentifier("Table", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("TABLE")).
+			Identifier("BackupSet", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("FROM BACKUP SET").Required()).
+			TextAssignment("IDENTIFIER", g.ParameterOptions().SingleQuotes().NoEquals().Required()).
+			WithValidation(g.ValidIdentifierIfSet, "Database").
+			WithValidation(g.ValidIdentifierIfSet, "Schema").
+			WithValidation(g.ValidIdentifierIfSet, "Table").
+			WithValidation(g.ValidIdentifier, "BackupSet").
+			WithValidation(g.ExactlyOneValueSet, "Database", "Schema", "Table"),
+	)
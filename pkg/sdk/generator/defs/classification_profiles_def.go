@@ -0,0 +1,113 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+// Config, tag map and custom classifiers are OBJECT constants; they are rendered by the helpers in classification_profiles_ext.go.
+var classificationProfileConfig = g.NewQueryStruct("ClassificationProfileConfigObject").
+	PredefinedQueryStructField("Object", "string", g.KeywordOptions().Required())
+
+var setAutoTagArgs = g.NewQueryStruct("SetAutoTagArgs").
+	PredefinedQueryStructField("AutoTag", "bool", g.ParameterOptions().Required().NoEquals())
+
+var setTagMapArgs = g.NewQueryStruct("SetTagMapArgs").
+	PredefinedQueryStructField("TagMap", "string", g.KeywordOptions().Required())
+
+var setCustomClassifiersArgs = g.NewQueryStruct("SetCustomClassifiersArgs").
+	PredefinedQueryStructField("CustomClassifiers", "string", g.KeywordOptions().Required())
+
+var setMaximumClassificationValidityDaysArgs = g.NewQueryStruct("SetMaximumClassificationValidityDaysArgs").
+	PredefinedQueryStructField("Days", "int", g.ParameterOptions().Required().NoEquals())
+
+var setMinimumObjectAgeForClassificationDaysArgs = g.NewQueryStruct("SetMinimumObjectAgeForClassificationDaysArgs").
+	PredefinedQueryStructField("Days", "int", g.ParameterOptions().Required().NoEquals())
+
+var classificationProfilesDef = g.NewInterface(
+	"ClassificationProfiles",
+	"ClassificationProfile",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).CreateOperation(
+	"https://docs.snowflake.com/en/sql-reference/classes/classification_profile/commands/create-classification-profile",
+	g.NewQueryStruct("CreateClassificationProfileOptions").
+		Create().
+		OrReplace().
+		SQLWithCustomFieldName("snowflakeDataPrivacyClassificationProfile", "SNOWFLAKE.DATA_PRIVACY.CLASSIFICATION_PROFILE").
+		IfNotExists().
+		Name().
+		QueryStructField("Config", classificationProfileConfig, g.ListOptions().Required().MustParentheses()).
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+).DropOperation(
+	"https://docs.snowflake.com/en/sql-reference/classes/classification_profile/commands/drop-classification-profile",
+	g.NewQueryStruct("DropClassificationProfileOptions").
+		Drop().
+		SQLWithCustomFieldName("snowflakeDataPrivacyClassificationProfile", "SNOWFLAKE.DATA_PRIVACY.CLASSIFICATION_PROFILE").
+		IfExists().
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+).ShowOperationWithPairedStructs(
+	"https://docs.snowflake.com/en/sql-reference/classes/classification_profile/commands/show-classification-profiles",
+	g.StructPair("classificationProfileDBRow", "ClassificationProfile").
+		Time("created_on").
+		Text("name").
+		Text("database_name").
+		Text("schema_name").
+		OptionalText("current_version", g.WithRequiredInPlain()).
+		OptionalText("comment", g.WithRequiredInPlain()).
+		Text("owner").
+		WithConvertGeneration(),
+	g.NewQueryStruct("ShowClassificationProfileOptions").
+		Show().
+		SQLWithCustomFieldName("snowflakeDataPrivacyClassificationProfile", "SNOWFLAKE.DATA_PRIVACY.CLASSIFICATION_PROFILE").
+		OptionalLike().
+		OptionalIn(),
+	g.ShowByIDLikeFiltering,
+	g.ShowByIDInFiltering,
+).InstanceMethodOperationScalar(
+	"https://docs.snowflake.com/en/sql-reference/classes/classification_profile/methods/describe",
+	"DESCRIBE",
+	nil,
+	"string",
+).InstanceMethodOperationScalar(
+	"https://docs.snowflake.com/en/sql-reference/classes/classification_profile/methods/set_auto_tag",
+	"SET_AUTO_TAG",
+	setAutoTagArgs,
+	"string",
+).InstanceMethodOperationScalar(
+	"https://docs.snowflake.com/en/sql-reference/classes/classification_profile/methods/set_tag_map",
+	"SET_TAG_MAP",
+	setTagMapArgs,
+	"string",
+).InstanceMethodOperationScalar(
+	"https://docs.snowflake.com/en/sql-reference/classes/classification_profile/methods/unset_tag_map",
+	"UNSET_TAG_MAP",
+	nil,
+	"string",
+).InstanceMethodOperationScalar(
+	"https://docs.snowflake.com/en/sql-reference/classes/classification_profile/methods/set_custom_classifiers",
+	"SET_CUSTOM_CLASSIFIERS",
+	setCustomClassifiersArgs,
+	"string",
+).InstanceMethodOperationScalar(
+	"https://docs.snowflake.com/en/sql-reference/classes/classification_profile/methods/unset_custom_classifiers",
+	"UNSET_CUSTOM_CLASSIFIERS",
+	nil,
+	"string",
+).InstanceMethodOperationScalar(
+	"https://docs.snowflake.com/en/sql-reference/classes/classification_profile/methods/set_maximum_classification_validity_days",
+	"SET_MAXIMUM_CLASSIFICATION_VALIDITY_DAYS",
+	setMaximumClassificationValidityDaysArgs,
+	"string",
+).InstanceMethodOperationScalar(
+	"https://docs.snowflake.com/en/sql-reference/classes/classification_profile/methods/set_minimum_object_age_for_classification_days",
+	"SET_MINIMUM_OBJECT_AGE_FOR_CLASSIFICATION_DAYS",
+	setMinimumObjectAgeForClassificationDaysArgs,
+	"string",
+).WithCustomInterfaceMethod(
+	"DescribeDetails",
+	"DescribeDetails returns the parsed output of the DESCRIBE instance method.",
+	[]*g.MethodParameter{g.NewMethodParameter("id", g.KindOfT[sdkcommons.SchemaObjectIdentifier]())},
+	"*ClassificationProfileDetails", "error",
+)
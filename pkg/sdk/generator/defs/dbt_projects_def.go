@@ -0,0 +1,136 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var dbtProjectPairs = g.StructPair("dbtProjectsRow", "DbtProject").
+	Time("created_on").
+	Text("name").
+	Text("database_name").
+	Text("schema_name").
+	OptionalText("source_location").
+	Text("owner").
+	OptionalText("comment").
+	OptionalText("default_args").
+	OptionalText("default_version").
+	OptionalText("default_target").
+	OptionalText("dbt_version").
+	OptionalText("external_access_integrations").
+	Text("owner_role_type").
+	WithConvertGeneration()
+
+var dbtProjectVersionPairs = g.StructPair("dbtProjectVersionsRow", "DbtProjectVersion").
+	Time("created_on").
+	Text("name").
+	OptionalText("alias").
+	Text("location_uri").
+	OptionalText("source_location_uri").
+	OptionalText("git_commit_hash").
+	WithConvertGeneration()
+
+var dbtProjectsDef = g.NewInterface(
+	"DbtProjects",
+	"DbtProject",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).CreateOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/create-dbt-project",
+	g.NewQueryStruct("CreateDbtProject").
+		Create().
+		OrReplace().
+		SQL("DBT PROJECT").
+		IfNotExists().
+		Name().
+		PredefinedQueryStructField("From", "*Location", g.ParameterOptions().SQL("FROM").SingleQuotes().NoEquals()).
+		OptionalTextAssignment("DEFAULT_ARGS", g.ParameterOptions().SingleQuotes()).
+		OptionalTextAssignment("DEFAULT_VERSION", g.ParameterOptions().NoQuotes()).
+		OptionalTextAssignment("DEFAULT_TARGET", g.ParameterOptions().SingleQuotes()).
+		OptionalTextAssignment("DBT_VERSION", g.ParameterOptions().SingleQuotes()).
+		ListAssignment("EXTERNAL_ACCESS_INTEGRATIONS", g.KindOfT[sdkcommons.AccountObjectIdentifier](), g.ParameterOptions().Parentheses()).
+		OptionalComment().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace"),
+).AlterOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/alter-dbt-project",
+	g.NewQueryStruct("AlterDbtProject").
+		Alter().
+		SQL("DBT PROJECT").
+		IfExists().
+		Name().
+		OptionalQueryStructField(
+			"AddVersion",
+			g.NewQueryStruct("AddDbtProjectVersion").
+				IfNotExists().
+				OptionalText("VersionAlias", g.KeywordOptions().DoubleQuotes()).
+				PredefinedQueryStructField("From", "Location", g.ParameterOptions().Required().SQL("FROM").SingleQuotes().NoEquals()),
+			g.KeywordOptions().SQL("ADD VERSION"),
+		).
+		OptionalIdentifier("RenameTo", g.KindOfTPointer[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+		OptionalQueryStructField(
+			"Set",
+			g.NewQueryStruct("DbtProjectSet").
+				OptionalTextAssignment("DEFAULT_ARGS", g.ParameterOptions().SingleQuotes()).
+				OptionalTextAssignment("DEFAULT_VERSION", g.ParameterOptions().NoQuotes()).
+				OptionalTextAssignment("DEFAULT_TARGET", g.ParameterOptions().SingleQuotes()).
+				OptionalTextAssignment("DBT_VERSION", g.ParameterOptions().SingleQuotes()).
+				ListAssignment("EXTERNAL_ACCESS_INTEGRATIONS", g.KindOfT[sdkcommons.AccountObjectIdentifier](), g.ParameterOptions().Parentheses()).
+				OptionalComment().
+				WithValidation(g.AtLeastOneValueSet, "DefaultArgs", "DefaultVersion", "DefaultTarget", "DbtVersion", "ExternalAccessIntegrations", "Comment"),
+			g.ListOptions().NoParentheses().SQL("SET"),
+		).
+		OptionalQueryStructField(
+			"Unset",
+			g.NewQueryStruct("DbtProjectUnset").
+				OptionalSQL("DEFAULT_ARGS").
+				OptionalSQL("DEFAULT_VERSION").
+				OptionalSQL("DEFAULT_TARGET").
+				OptionalSQL("DBT_VERSION").
+				OptionalSQL("EXTERNAL_ACCESS_INTEGRATIONS").
+				OptionalSQL("COMMENT").
+				WithValidation(g.AtLeastOneValueSet, "DefaultArgs", "DefaultVersion", "DefaultTarget", "DbtVersion", "ExternalAccessIntegrations", "Comment"),
+			g.ListOptions().NoParentheses().SQL("UNSET"),
+		).
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+		WithValidation(g.ExactlyOneValueSet, "AddVersion", "RenameTo", "Set", "Unset"),
+).DropOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/drop-dbt-project",
+	g.NewQueryStruct("DropDbtProject").
+		Drop().
+		SQL("DBT PROJECT").
+		IfExists().
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+).ShowOperationWithPairedStructs(
+	"https://docs.snowflake.com/en/sql-reference/sql/show-dbt-projects",
+	dbtProjectPairs,
+	g.NewQueryStruct("ShowDbtProjects").
+		Show().
+		SQL("DBT PROJECTS").
+		OptionalLike().
+		OptionalIn().
+		OptionalLimit(),
+	g.ShowByIDLikeFiltering,
+	g.ShowByIDInFiltering,
+).DescribeOperationWithPairedStructs(
+	g.DescriptionMappingKindSingleValue,
+	"https://docs.snowflake.com/en/sql-reference/sql/describe-dbt-project",
+	dbtProjectPairs,
+	g.NewQueryStruct("DescribeDbtProject").
+		Describe().
+		SQL("DBT PROJECT").
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+).CustomShowOperationWithPairedStructs(
+	"ShowVersions",
+	g.ShowMappingKindSlice,
+	"https://docs.snowflake.com/en/sql-reference/sql/show-versions-in-dbt-project",
+	dbtProjectVersionPairs,
+	g.NewQueryStruct("ShowDbtProjectVersions").
+		Show().
+		SQL("VERSIONS IN DBT PROJECT").
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
+)
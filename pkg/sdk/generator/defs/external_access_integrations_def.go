@@ -0,0 +1,115 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var externalAccessIntegrationsDef = g.NewInterface(
+	"ExternalAccessIntegrations",
+	"ExternalAccessIntegration",
+	g.KindOfT[sdkcommons.AccountObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-external-access-integration",
+		g.NewQueryStruct("CreateExternalAccessIntegration").
+			Create().
+			OrReplace().
+			SQL("EXTERNAL ACCESS INTEGRATION").
+			IfNotExists().
+			Name().
+			ListAssignment("ALLOWED_NETWORK_RULES", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses().Required()).
+			ListAssignment("ALLOWED_API_AUTHENTICATION_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+			ListAssignment("ALLOWED_AUTHENTICATION_SECRETS", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+			BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-external-access-integration",
+		g.NewQueryStruct("AlterExternalAccessIntegration").
+			Alter().
+			SQL("EXTERNAL ACCESS INTEGRATION").
+			IfExists().
+			Name().
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("ExternalAccessIntegrationSet").
+					ListAssignment("ALLOWED_NETWORK_RULES", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+					ListAssignment("ALLOWED_API_AUTHENTICATION_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+					ListAssignment("ALLOWED_AUTHENTICATION_SECRETS", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+					OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "AllowedNetworkRules", "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Enabled", "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("ExternalAccessIntegrationUnset").
+					OptionalSQL("ALLOWED_API_AUTHENTICATION_INTEGRATIONS").
+					OptionalSQL("ALLOWED_AUTHENTICATION_SECRETS").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Comment"),
+				g.ListOptions().SQL("UNSET"),
+			).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "IfExists", "UnsetTags").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-integration",
+		g.NewQueryStruct("DropExternalAccessIntegration").
+			Drop().
+			SQL("EXTERNAL ACCESS INTEGRATION").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperationWithPairedStructs(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-integrations",
+		g.StructPair("showExternalAccessIntegrationsDbRow", "ExternalAccessIntegration").
+			Text("name").
+			Text("type", g.WithPlainFieldName("IntegrationType")).
+			Text("category").
+			Bool("enabled").
+			OptionalText("comment", g.WithRequiredInPlain()).
+			Time("created_on").
+			WithConvertGeneration(),
+		g.NewQueryStruct("ShowExternalAccessIntegrations").
+			Show().
+			SQL("EXTERNAL ACCESS INTEGRATIONS").
+			OptionalLike(),
+		g.ShowByIDLikeFiltering,
+	).
+	DescribeOperationWithPairedStructs(
+		g.DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-integration",
+		g.StructPair("descExternalAccessIntegrationsDbRow", "ExternalAccessIntegrationProperty").
+			Text("property", g.WithPlainFieldName("Name")).
+			Text("property_type", g.WithPlainFieldName("Type")).
+			Text("property_value", g.WithPlainFieldName("Value")).
+			Text("property_default", g.WithPlainFieldName("Default")).
+			WithConvertGeneration(),
+		g.NewQueryStruct("DescribeExternalAccessIntegration").
+			Describe().
+			SQL("EXTERNAL ACCESS INTEGRATION").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+		g.PlainStruct("ExternalAccessIntegrationDetails").
+			AccountObjectIdentifier().
+			Bool("Enabled").
+			Field("AllowedNetworkRules", "[]SchemaObjectIdentifier").
+			Field("AllowedApiAuthenticationIntegrations", "[]AccountObjectIdentifier").
+			Field("AllowedAuthenticationSecrets", "[]SchemaObjectIdentifier").
+			Text("Comment"),
+	).
+	WithCustomInterfaceMethod(
+		"DescribeDetails",
+		"DescribeDetails returns converted describe output for external access integrations.",
+		[]*g.MethodParameter{g.NewMethodParameter("id", g.KindOfT[sdkcommons.AccountObjectIdentifier]())},
+		"*ExternalAccessIntegrationDetails", "error",
+	)
@@ -0,0 +1,90 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var joinPoliciesDef = g.NewInterface(
+	"JoinPolicies",
+	"JoinPolicy",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-join-policy",
+		g.NewQueryStruct("CreateJoinPolicy").
+			Create().
+			OrReplace().
+			SQL("JOIN POLICY").
+			IfNotExists().
+			Name().
+			SQLWithCustomFieldName("as", "AS () RETURNS JOIN_CONSTRAINT").
+			BodyWithPrecedingArrow().
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "body").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-join-policy",
+		g.NewQueryStruct("AlterJoinPolicy").
+			Alter().
+			SQL("JOIN POLICY").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalSetBodyWithPrecedingArrow().
+			OptionalSetTags().
+			OptionalUnsetTags().
+			OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET COMMENT").
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-join-policy",
+		g.NewQueryStruct("DropJoinPolicy").
+			Drop().
+			SQL("JOIN POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperationWithPairedStructs(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-join-policies",
+		g.StructPair("joinPolicyDBRow", "JoinPolicy").
+			Text("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("kind").
+			Text("owner").
+			OptionalText("comment", g.WithRequiredInPlain()).
+			Text("options").
+			Text("owner_role_type").
+			WithConvertGeneration(),
+		g.NewQueryStruct("ShowJoinPolicies").
+			Show().
+			SQL("JOIN POLICIES").
+			OptionalLike().
+			OptionalExtendedIn().
+			OptionalLimitFrom(),
+		g.ShowByIDExtendedInFiltering,
+		g.ShowByIDLikeFiltering,
+	).
+	DescribeOperationWithPairedStructs(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-join-policy",
+		g.StructPair("describeJoinPolicyDBRow", "JoinPolicyDescription").
+			Text("name").
+			Text("signature").
+			Text("return_type").
+			Text("body").
+			WithConvertGeneration(),
+		g.NewQueryStruct("DescribeJoinPolicy").
+			Describe().
+			SQL("JOIN POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
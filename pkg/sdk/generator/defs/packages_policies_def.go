@@ -0,0 +1,112 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var PackagesPolicyLanguageEnumDef = g.NewEnum(
+	"PackagesPolicyLanguage", "PackagesPolicyLanguages",
+	"PYTHON",
+)
+
+var packagesPoliciesDef = g.NewInterface(
+	"PackagesPolicies",
+	"PackagesPolicy",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-packages-policy",
+		g.NewQueryStruct("CreatePackagesPolicy").
+			Create().
+			OrReplace().
+			SQL("PACKAGES POLICY").
+			IfNotExists().
+			Name().
+			EnumAssignment("LANGUAGE", PackagesPolicyLanguageEnumDef, g.ParameterOptions().Required().NoQuotes().NoEquals()).
+			ListAssignment("ALLOWLIST", "StringListItemWrapper", g.ParameterOptions().Parentheses()).
+			ListAssignment("BLOCKLIST", "StringListItemWrapper", g.ParameterOptions().Parentheses()).
+			ListAssignment("ADDITIONAL_CREATION_BLOCKLIST", "StringListItemWrapper", g.ParameterOptions().Parentheses()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-packages-policy",
+		g.NewQueryStruct("AlterPackagesPolicy").
+			Alter().
+			SQL("PACKAGES POLICY").
+			IfExists().
+			Name().
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("PackagesPolicySet").
+					ListAssignment("ALLOWLIST", "StringListItemWrapper", g.ParameterOptions().Parentheses()).
+					ListAssignment("BLOCKLIST", "StringListItemWrapper", g.ParameterOptions().Parentheses()).
+					ListAssignment("ADDITIONAL_CREATION_BLOCKLIST", "StringListItemWrapper", g.ParameterOptions().Parentheses()).
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"),
+				g.ListOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("PackagesPolicyUnset").
+					OptionalSQL("ALLOWLIST").
+					OptionalSQL("BLOCKLIST").
+					OptionalSQL("ADDITIONAL_CREATION_BLOCKLIST").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"),
+				g.ListOptions().SQL("UNSET"),
+			).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-packages-policy",
+		g.NewQueryStruct("DropPackagesPolicy").
+			Drop().
+			SQL("PACKAGES POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperationWithPairedStructs(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-packages-policies",
+		g.StructPair("packagesPolicyDBRow", "PackagesPolicy").
+			Text("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("kind").
+			Text("owner").
+			OptionalText("comment", g.WithRequiredInPlain()).
+			Text("options").
+			Text("owner_role_type").
+			WithConvertGeneration(),
+		g.NewQueryStruct("ShowPackagesPolicies").
+			Show().
+			SQL("PACKAGES POLICIES").
+			OptionalIn(),
+		g.ShowByIDInFiltering,
+	).
+	DescribeOperationWithPairedStructs(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-packages-policy",
+		g.StructPair("describePackagesPolicyDBRow", "PackagesPolicyDescription").
+			Text("name").
+			Text("language").
+			Text("allowlist").
+			Text("blocklist").
+			Text("additional_creation_blocklist").
+			OptionalText("comment", g.WithRequiredInPlain()).
+			WithConvertGeneration(),
+		g.NewQueryStruct("DescribePackagesPolicy").
+			Describe().
+			SQL("PACKAGES POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	WithEnums(
+		PackagesPolicyLanguageEnumDef,
+	)
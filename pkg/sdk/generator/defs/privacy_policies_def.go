@@ -0,0 +1,90 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var privacyPoliciesDef = g.NewInterface(
+	"PrivacyPolicies",
+	"PrivacyPolicy",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-privacy-policy",
+		g.NewQueryStruct("CreatePrivacyPolicy").
+			Create().
+			OrReplace().
+			SQL("PRIVACY POLICY").
+			IfNotExists().
+			Name().
+			SQLWithCustomFieldName("as", "AS () RETURNS PRIVACY_BUDGET").
+			BodyWithPrecedingArrow().
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "body").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-privacy-policy",
+		g.NewQueryStruct("AlterPrivacyPolicy").
+			Alter().
+			SQL("PRIVACY POLICY").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalSetBodyWithPrecedingArrow().
+			OptionalSetTags().
+			OptionalUnsetTags().
+			OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET COMMENT").
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-privacy-policy",
+		g.NewQueryStruct("DropPrivacyPolicy").
+			Drop().
+			SQL("PRIVACY POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperationWithPairedStructs(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-privacy-policies",
+		g.StructPair("privacyPolicyDBRow", "PrivacyPolicy").
+			Text("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("kind").
+			Text("owner").
+			OptionalText("comment", g.WithRequiredInPlain()).
+			Text("options").
+			Text("owner_role_type").
+			WithConvertGeneration(),
+		g.NewQueryStruct("ShowPrivacyPolicies").
+			Show().
+			SQL("PRIVACY POLICIES").
+			OptionalLike().
+			OptionalExtendedIn().
+			OptionalLimitFrom(),
+		g.ShowByIDExtendedInFiltering,
+		g.ShowByIDLikeFiltering,
+	).
+	DescribeOperationWithPairedStructs(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-privacy-policy",
+		g.StructPair("describePrivacyPolicyDBRow", "PrivacyPolicyDescription").
+			Text("name").
+			Text("signature").
+			Text("return_type").
+			Text("body").
+			WithConvertGeneration(),
+		g.NewQueryStruct("DescribePrivacyPolicy").
+			Describe().
+			SQL("PRIVACY POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -0,0 +1,90 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var projectionPoliciesDef = g.NewInterface(
+	"ProjectionPolicies",
+	"ProjectionPolicy",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-projection-policy",
+		g.NewQueryStruct("CreateProjectionPolicy").
+			Create().
+			OrReplace().
+			SQL("PROJECTION POLICY").
+			IfNotExists().
+			Name().
+			SQLWithCustomFieldName("as", "AS () RETURNS PROJECTION_CONSTRAINT").
+			BodyWithPrecedingArrow().
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "body").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-projection-policy",
+		g.NewQueryStruct("AlterProjectionPolicy").
+			Alter().
+			SQL("PROJECTION POLICY").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalSetBodyWithPrecedingArrow().
+			OptionalSetTags().
+			OptionalUnsetTags().
+			OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET COMMENT").
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-projection-policy",
+		g.NewQueryStruct("DropProjectionPolicy").
+			Drop().
+			SQL("PROJECTION POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperationWithPairedStructs(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-projection-policies",
+		g.StructPair("projectionPolicyDBRow", "ProjectionPolicy").
+			Text("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("kind").
+			Text("owner").
+			OptionalText("comment", g.WithRequiredInPlain()).
+			Text("options").
+			Text("owner_role_type").
+			WithConvertGeneration(),
+		g.NewQueryStruct("ShowProjectionPolicies").
+			Show().
+			SQL("PROJECTION POLICIES").
+			OptionalLike().
+			OptionalExtendedIn().
+			OptionalLimitFrom(),
+		g.ShowByIDExtendedInFiltering,
+		g.ShowByIDLikeFiltering,
+	).
+	DescribeOperationWithPairedStructs(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-projection-policy",
+		g.StructPair("describeProjectionPolicyDBRow", "ProjectionPolicyDescription").
+			Text("name").
+			Text("signature").
+			Text("return_type").
+			Text("body").
+			WithConvertGeneration(),
+		g.NewQueryStruct("DescribeProjectionPolicy").
+			Describe().
+			SQL("PROJECTION POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
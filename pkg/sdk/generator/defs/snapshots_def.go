@@ -0,0 +1,104 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var snapshotsDef = g.NewInterface(
+	"Snapshots",
+	"Snapshot",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-snapshot",
+		g.NewQueryStruct("CreateSnapshot").
+			Create().
+			OrReplace().
+			SQL("SNAPSHOT").
+			IfNotExists().
+			Name().
+			Identifier("Service", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("FROM SERVICE").Required()).
+			TextAssignment("VOLUME", g.ParameterOptions().DoubleQuotes().NoEquals().Required()).
+			NumberAssignment("INSTANCE", g.ParameterOptions().NoEquals().Required()).
+			OptionalComment().
+			OptionalTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifier, "Service").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-snapshot",
+		g.NewQueryStruct("AlterSnapshot").
+			Alter().
+			SQL("SNAPSHOT").
+			IfExists().
+			Name().
+			OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET COMMENT").
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "SetComment", "UnsetComment", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-snapshot",
+		g.NewQueryStruct("DropSnapshot").
+			Drop().
+			SQL("SNAPSHOT").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperationWithPairedStructs(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-snapshots",
+		g.StructPair("snapshotDBRow", "Snapshot").
+			Time("created_on").
+			Text("name").
+			Text("state").
+			Text("database_name").
+			Text("schema_name").
+			Text("service_name").
+			Text("volume_name").
+			Number("instance").
+			OptionalNumber("size").
+			OptionalText("comment", g.WithRequiredInPlain()).
+			Text("owner").
+			Text("owner_role_type").
+			Time("updated_on").
+			WithConvertGeneration(),
+		g.NewQueryStruct("ShowSnapshots").
+			Show().
+			SQL("SNAPSHOTS").
+			OptionalLike().
+			OptionalIn().
+			OptionalStartsWith().
+			OptionalLimitFrom(),
+		g.ShowByIDLikeFiltering,
+		g.ShowByIDInFiltering,
+	).
+	DescribeOperationWithPairedStructs(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-snapshot",
+		g.StructPair("snapshotDetailsDBRow", "SnapshotDetails").
+			Time("created_on").
+			Text("name").
+			Text("state").
+			Text("database_name").
+			Text("schema_name").
+			Text("service_name").
+			Text("volume_name").
+			Number("instance").
+			OptionalNumber("size").
+			OptionalText("comment", g.WithRequiredInPlain()).
+			Text("owner").
+			Text("owner_role_type").
+			Time("updated_on").
+			WithConvertGeneration(),
+		g.NewQueryStruct("DescribeSnapshot").
+			Describe().
+			SQL("SNAPSHOT").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -6,7 +6,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
 )
 
-var externalAccessIntegrations = g.NewQueryStruct("ExternalAccessIntegrations").
+var externalAccessIntegrations = g.NewQueryStruct("StreamlitExternalAccessIntegrations").
 	List("ExternalAccessIntegrations", "AccountObjectIdentifier", g.ListOptions().Required().MustParentheses())
 
 var streamlitSet = g.NewQueryStruct("StreamlitSet").
@@ -119,6 +119,7 @@ var tableUnsetAggregationPolicy = g.NewQueryStruct("TableUnsetAggregationPolicy"
 var tableSetJoinPolicy = g.NewQueryStruct("TableSetJoinPolicy").
 	SQL("SET").
 	Identifier("JoinPolicy", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("JOIN POLICY").Required()).
+	ListAssignment("ALLOWED JOIN KEYS", "Column", g.ParameterOptions().NoEquals().Parentheses()).
 	OptionalSQL("FORCE").
 	WithValidation(g.ValidIdentifier, "JoinPolicy")
 
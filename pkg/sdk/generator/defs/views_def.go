@@ -135,6 +135,27 @@ var viewSetAggregationPolicy = g.NewQueryStruct("ViewSetAggregationPolicy").
 var viewUnsetAggregationPolicy = g.NewQueryStruct("ViewUnsetAggregationPolicy").
 	SQL("UNSET AGGREGATION POLICY")
 
+var viewSetJoinPolicy = g.NewQueryStruct("ViewSetJoinPolicy").
+	SQL("SET").
+	Identifier("JoinPolicy", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("JOIN POLICY").Required()).
+	ListAssignment("ALLOWED JOIN KEYS", "Column", g.ParameterOptions().NoEquals().Parentheses()).
+	OptionalSQL("FORCE").
+	WithValidation(g.ValidIdentifier, "JoinPolicy")
+
+var viewUnsetJoinPolicy = g.NewQueryStruct("ViewUnsetJoinPolicy").
+	SQL("UNSET JOIN POLICY")
+
+var viewAddPrivacyPolicy = g.NewQueryStruct("ViewAddPrivacyPolicy").
+	SQL("ADD").
+	Identifier("PrivacyPolicy", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("PRIVACY POLICY").Required()).
+	ListAssignment("ENTITY KEY", "Column", g.ParameterOptions().NoEquals().Parentheses().Required()).
+	WithValidation(g.ValidIdentifier, "PrivacyPolicy")
+
+var viewDropPrivacyPolicy = g.NewQueryStruct("ViewDropPrivacyPolicy").
+	SQL("DROP").
+	Identifier("PrivacyPolicy", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("PRIVACY POLICY").Required()).
+	WithValidation(g.ValidIdentifier, "PrivacyPolicy")
+
 var viewSetColumnMaskingPolicy = g.NewQueryStruct("ViewSetColumnMaskingPolicy").
 	// In the docs there is a MODIFY alternative, but for simplicity only one is supported here.
 	SQL("ALTER").
@@ -243,6 +264,10 @@ var viewsDef = g.NewInterface(
 			OptionalSQL("DROP ALL ROW ACCESS POLICIES").
 			OptionalQueryStructField("SetAggregationPolicy", viewSetAggregationPolicy, g.KeywordOptions()).
 			OptionalQueryStructField("UnsetAggregationPolicy", viewUnsetAggregationPolicy, g.KeywordOptions()).
+			OptionalQueryStructField("SetJoinPolicy", viewSetJoinPolicy, g.KeywordOptions()).
+			OptionalQueryStructField("UnsetJoinPolicy", viewUnsetJoinPolicy, g.KeywordOptions()).
+			OptionalQueryStructField("AddPrivacyPolicy", viewAddPrivacyPolicy, g.KeywordOptions()).
+			OptionalQueryStructField("DropPrivacyPolicy", viewDropPrivacyPolicy, g.KeywordOptions()).
 			OptionalQueryStructField("SetMaskingPolicyOnColumn", viewSetColumnMaskingPolicy, g.KeywordOptions()).
 			OptionalQueryStructField("UnsetMaskingPolicyOnColumn", viewUnsetColumnMaskingPolicy, g.KeywordOptions()).
 			OptionalQueryStructField("SetProjectionPolicyOnColumn", viewSetProjectionPolicy, g.KeywordOptions()).
@@ -253,7 +278,8 @@ var viewsDef = g.NewInterface(
 			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "UnsetComment", "SetSecure", "SetChangeTracking",
 				"UnsetSecure", "SetTags", "UnsetTags", "AddDataMetricFunction", "DropDataMetricFunction", "ModifyDataMetricFunction", "SetDataMetricSchedule", "UnsetDataMetricSchedule",
 				"AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy",
-				"DropAllRowAccessPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetMaskingPolicyOnColumn",
+				"DropAllRowAccessPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetJoinPolicy", "UnsetJoinPolicy",
+				"AddPrivacyPolicy", "DropPrivacyPolicy", "SetMaskingPolicyOnColumn",
 				"UnsetMaskingPolicyOnColumn", "SetProjectionPolicyOnColumn", "UnsetProjectionPolicyOnColumn", "SetTagsOnColumn",
 				"UnsetTagsOnColumn").
 			WithValidation(g.ConflictingFields, "IfExists", "SetSecure").
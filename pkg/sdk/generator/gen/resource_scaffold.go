@@ -0,0 +1,360 @@
+package gen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/genhelpers"
+)
+
+type ResourceScaffoldAttributeKind string
+
+const (
+	ResourceScaffoldAttributeKindString      ResourceScaffoldAttributeKind = "string"
+	ResourceScaffoldAttributeKindBool        ResourceScaffoldAttributeKind = "bool"
+	ResourceScaffoldAttributeKindInt         ResourceScaffoldAttributeKind = "int"
+	ResourceScaffoldAttributeKindEnum        ResourceScaffoldAttributeKind = "enum"
+	ResourceScaffoldAttributeKindIdentifier  ResourceScaffoldAttributeKind = "identifier"
+	ResourceScaffoldAttributeKindUnsupported ResourceScaffoldAttributeKind = "unsupported"
+)
+
+// ResourceScaffoldAttribute is a single resource attribute derived from the field of the create options.
+type ResourceScaffoldAttribute struct {
+	// Name is the attribute name in the resource schema, e.g. "comment"
+	Name string
+	// FieldName is the field name in the create request, e.g. "Comment"
+	FieldName string
+	// FieldKind is the field kind in the create request, e.g. "*string"
+	FieldKind     string
+	FieldIsStruct bool
+	Kind          ResourceScaffoldAttributeKind
+	// TypeName is the enum or identifier type name, e.g. "ImageRepositoryEncryptionType"
+	TypeName string
+	// EnumNamePlural is the name of the variable with all enum values, e.g. "ImageRepositoryEncryptionTypes"
+	EnumNamePlural string
+	Required       bool
+	// SetFieldKind and UnsetFieldKind are the kinds of the matching fields in the alter set and unset requests (empty if not present)
+	SetFieldKind   string
+	UnsetFieldKind string
+}
+
+// Updatable returns true if the attribute can be changed through the alter set request; otherwise, it forces recreation.
+func (a ResourceScaffoldAttribute) Updatable() bool {
+	return a.SetFieldKind != ""
+}
+
+// Supported returns true if the scaffold can map the attribute automatically.
+func (a ResourceScaffoldAttribute) Supported() bool {
+	return a.Kind != ResourceScaffoldAttributeKindUnsupported
+}
+
+// CreateArgument returns the expression passed to the create request constructor for the required attribute.
+func (a ResourceScaffoldAttribute) CreateArgument() string {
+	switch a.Kind {
+	case ResourceScaffoldAttributeKindString:
+		if strings.TrimPrefix(a.FieldKind, "*") == "StringAllowEmpty" {
+			return fmt.Sprintf(`sdk.StringAllowEmpty{Value: d.Get("%s").(string)}`, a.Name)
+		}
+		return fmt.Sprintf(`d.Get("%s").(string)`, a.Name)
+	case ResourceScaffoldAttributeKindInt:
+		return fmt.Sprintf(`d.Get("%s").(int)`, a.Name)
+	case ResourceScaffoldAttributeKindEnum, ResourceScaffoldAttributeKindIdentifier:
+		return genhelpers.FirstLetterLowercase(a.FieldName)
+	default:
+		return fmt.Sprintf("%s /* TODO: map %s */", zeroValueExpression(a.FieldKind, a.FieldIsStruct), a.Name)
+	}
+}
+
+// CreateMapper returns the function converting the attribute value to the request type (only for enums and identifiers).
+func (a ResourceScaffoldAttribute) CreateMapper() string {
+	switch a.Kind {
+	case ResourceScaffoldAttributeKindEnum:
+		return fmt.Sprintf("sdk.To%s", a.TypeName)
+	case ResourceScaffoldAttributeKindIdentifier:
+		return fmt.Sprintf("sdk.Parse%s", a.TypeName)
+	default:
+		return ""
+	}
+}
+
+// CreateBuilder returns the statement setting the optional attribute on the create request; empty when it cannot be mapped automatically.
+func (a ResourceScaffoldAttribute) CreateBuilder() string {
+	switch a.Kind {
+	case ResourceScaffoldAttributeKindString:
+		if strings.TrimPrefix(a.FieldKind, "*") == "StringAllowEmpty" {
+			return ""
+		}
+		return fmt.Sprintf(`stringAttributeCreateBuilder(d, "%s", request.With%s)`, a.Name, a.FieldName)
+	case ResourceScaffoldAttributeKindBool:
+		return fmt.Sprintf(`booleanStringAttributeCreateBuilder(d, "%s", request.With%s)`, a.Name, a.FieldName)
+	case ResourceScaffoldAttributeKindInt:
+		return fmt.Sprintf(`intAttributeCreateBuilder(d, "%s", request.With%s)`, a.Name, a.FieldName)
+	case ResourceScaffoldAttributeKindEnum, ResourceScaffoldAttributeKindIdentifier:
+		return fmt.Sprintf(`attributeMappedValueCreateBuilder[string](d, "%s", request.With%s, %s)`, a.Name, a.FieldName, a.CreateMapper())
+	default:
+		return ""
+	}
+}
+
+// UpdateStatement returns the statement filling the alter set and unset requests; empty when it cannot be mapped automatically.
+func (a ResourceScaffoldAttribute) UpdateStatement() string {
+	withUnset := a.UnsetFieldKind == "*bool"
+	switch {
+	case a.SetFieldKind == "*string" && withUnset:
+		return fmt.Sprintf(`stringAttributeUpdate(d, "%s", &set.%[2]s, &unset.%[2]s)`, a.Name, a.FieldName)
+	case a.SetFieldKind == "*string":
+		return fmt.Sprintf(`stringAttributeUpdateSetOnlyNotEmpty(d, "%s", &set.%s)`, a.Name, a.FieldName)
+	case a.SetFieldKind == "*StringAllowEmpty" && !withUnset:
+		return fmt.Sprintf(`stringAttributeUpdateSetOnly(d, "%s", &set.%s)`, a.Name, a.FieldName)
+	case a.SetFieldKind == "*bool" && withUnset:
+		return fmt.Sprintf(`booleanStringAttributeUpdate(d, "%s", &set.%[2]s, &unset.%[2]s)`, a.Name, a.FieldName)
+	case a.SetFieldKind == "*bool":
+		return fmt.Sprintf(`booleanStringAttributeUpdateSetOnly(d, "%s", &set.%s)`, a.Name, a.FieldName)
+	case a.SetFieldKind == "*int" && withUnset:
+		return fmt.Sprintf(`intAttributeUpdate(d, "%s", &set.%[2]s, &unset.%[2]s)`, a.Name, a.FieldName)
+	case a.SetFieldKind == "*int":
+		return fmt.Sprintf(`intAttributeUpdateSetOnly(d, "%s", &set.%s)`, a.Name, a.FieldName)
+	case a.CreateMapper() != "" && a.SetFieldKind == "*"+a.TypeName && withUnset:
+		return fmt.Sprintf(`attributeMappedValueUpdate(d, "%s", &set.%[2]s, &unset.%[2]s, %s)`, a.Name, a.FieldName, a.CreateMapper())
+	case a.CreateMapper() != "" && a.SetFieldKind == "*"+a.TypeName:
+		return fmt.Sprintf(`attributeMappedValueUpdateSetOnly(d, "%s", &set.%s, %s)`, a.Name, a.FieldName, a.CreateMapper())
+	default:
+		return ""
+	}
+}
+
+// ResourceScaffoldModel is the model for the resource skeleton generated from the SDK object definition.
+type ResourceScaffoldModel struct {
+	// Name is the singular object name, e.g. "ImageRepository"
+	Name string
+	// InterfaceName is the SDK client field name, e.g. "ImageRepositories"
+	InterfaceName string
+	// ObjectSql is the object name used in SQL, e.g. "IMAGE REPOSITORY"
+	ObjectSql string
+	// ShowSql is the show command, e.g. "SHOW IMAGE REPOSITORIES"
+	ShowSql        string
+	IdentifierKind string
+	CreateDoc      string
+	// HasCreate is false when the object is created only through the dedicated operations (e.g. secrets)
+	HasCreate bool
+
+	Attributes []ResourceScaffoldAttribute
+	// CreateRequestArguments keeps the constructor arguments of the create request (in the DTO order); "id" stands for the identifier
+	CreateRequestArguments []string
+
+	// SetRequestName and UnsetRequestName are the alter request struct names, e.g. "ImageRepositorySetRequest" (empty if not present)
+	SetRequestName   string
+	UnsetRequestName string
+
+	ShowObjectName string
+	HasDescribe    bool
+	// DescribeObjectName is the describe result struct name, e.g. "ImageRepositoryDetails" (empty if there is no describe)
+	DescribeObjectName string
+	// DescribeReturnsSlice is true when the describe returns multiple rows (one describe_output element per row)
+	DescribeReturnsSlice bool
+
+	*genhelpers.PreambleModel
+}
+
+func (m ResourceScaffoldModel) NameLowerCased() string {
+	return genhelpers.FirstLetterLowercase(m.Name)
+}
+
+// HumanName returns the object name to be used in descriptions and messages, e.g. "image repository".
+func (m ResourceScaffoldModel) HumanName() string {
+	return strings.ToLower(m.ObjectSql)
+}
+
+// IdentifierParts returns the schema attributes building the identifier (without the name).
+func (m ResourceScaffoldModel) IdentifierParts() []string {
+	switch m.IdentifierKind {
+	case string(DatabaseObjectIdentifier):
+		return []string{"database"}
+	case string(SchemaObjectIdentifier):
+		return []string{"database", "schema"}
+	default:
+		return []string{}
+	}
+}
+
+func (m ResourceScaffoldModel) RequiredAttributes() []ResourceScaffoldAttribute {
+	return filterAttributes(m.Attributes, func(a ResourceScaffoldAttribute) bool { return a.Required })
+}
+
+func (m ResourceScaffoldModel) OptionalAttributes() []ResourceScaffoldAttribute {
+	return filterAttributes(m.Attributes, func(a ResourceScaffoldAttribute) bool { return !a.Required })
+}
+
+// UpdatableAttributeNames returns the names of the attributes changing the show output when updated.
+func (m ResourceScaffoldModel) UpdatableAttributeNames() []string {
+	names := make([]string, 0)
+	for _, a := range m.Attributes {
+		if a.Updatable() {
+			names = append(names, fmt.Sprintf("%q", a.Name))
+		}
+	}
+	return names
+}
+
+func filterAttributes(attributes []ResourceScaffoldAttribute, predicate func(ResourceScaffoldAttribute) bool) []ResourceScaffoldAttribute {
+	result := make([]ResourceScaffoldAttribute, 0)
+	for _, a := range attributes {
+		if predicate(a) {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// ResourceScaffoldModelFromInterface builds the scaffold model; the definition has to be preprocessed (see GetSdkDefinitions).
+func ResourceScaffoldModelFromInterface(i *Interface, preamble *genhelpers.PreambleModel) ResourceScaffoldModel {
+	model := ResourceScaffoldModel{
+		Name:                   i.NameSingular,
+		InterfaceName:          i.Name,
+		IdentifierKind:         i.IdentifierKind,
+		ShowObjectName:         i.ShowObjectName,
+		CreateRequestArguments: make([]string, 0),
+		Attributes:             make([]ResourceScaffoldAttribute, 0),
+		PreambleModel:          preamble,
+	}
+	enums := make(map[string]*Enum)
+	for _, e := range i.Enums {
+		enums[e.Name] = e
+	}
+
+	var setFields, unsetFields []Field
+	for _, o := range i.Operations {
+		switch {
+		case o.Name == string(OperationKindCreate) && o.OptsField != nil:
+			model.HasCreate = true
+			model.CreateDoc = o.Doc
+			model.ObjectSql = staticSql(o.OptsField.Fields, "CREATE")
+			for _, f := range o.OptsField.Fields {
+				if !f.ShouldBeInDto() {
+					continue
+				}
+				if f.Name == "name" {
+					model.CreateRequestArguments = append(model.CreateRequestArguments, "id")
+					continue
+				}
+				if slices.Contains(resourceScaffoldSkippedFields, f.Name) {
+					continue
+				}
+				attribute := newResourceScaffoldAttribute(f, enums)
+				if attribute.Required {
+					model.CreateRequestArguments = append(model.CreateRequestArguments, attribute.CreateArgument())
+				}
+				model.Attributes = append(model.Attributes, attribute)
+			}
+		case o.Name == string(OperationKindAlter) && o.OptsField != nil:
+			for _, f := range o.OptsField.Fields {
+				switch f.Name {
+				case "Set":
+					model.SetRequestName = f.DtoDecl()
+					setFields = f.Fields
+				case "Unset":
+					model.UnsetRequestName = f.DtoDecl()
+					unsetFields = f.Fields
+				}
+			}
+		case o.Name == string(OperationKindShow) && o.OptsField != nil:
+			model.ShowSql = staticSql(o.OptsField.Fields, "")
+		case o.Name == string(OperationKindDescribe) && o.DescribeMapping != nil:
+			model.HasDescribe = true
+			model.DescribeObjectName = o.DescribeMapping.To.Name
+			model.DescribeReturnsSlice = o.DescribeKind != nil && *o.DescribeKind == DescriptionMappingKindSlice
+		}
+	}
+
+	if model.ObjectSql == "" {
+		model.ObjectSql = strings.ToUpper(strings.ReplaceAll(genhelpers.ToSnakeCase(model.Name), "_", " "))
+	}
+
+	for idx, a := range model.Attributes {
+		if f, ok := findField(setFields, a.FieldName); ok {
+			model.Attributes[idx].SetFieldKind = f.Kind
+		}
+		if f, ok := findField(unsetFields, a.FieldName); ok {
+			model.Attributes[idx].UnsetFieldKind = f.Kind
+		}
+	}
+	return model
+}
+
+// resourceScaffoldSkippedFields are the create options not exposed as resource attributes.
+var resourceScaffoldSkippedFields = []string{"OrReplace", "IfNotExists", "Tag", "CopyGrants"}
+
+func newResourceScaffoldAttribute(f Field, enums map[string]*Enum) ResourceScaffoldAttribute {
+	attribute := ResourceScaffoldAttribute{
+		Name:          genhelpers.ToSnakeCase(f.Name),
+		FieldName:     f.Name,
+		FieldKind:     f.Kind,
+		FieldIsStruct: f.IsStruct(),
+		Required:      f.Required,
+	}
+	kind := f.KindNoPtr()
+	switch {
+	case strings.Contains(f.Kind, "[]") || f.IsStruct():
+		attribute.Kind = ResourceScaffoldAttributeKindUnsupported
+	case kind == "string" || kind == "StringAllowEmpty":
+		attribute.Kind = ResourceScaffoldAttributeKindString
+	case kind == "bool":
+		attribute.Kind = ResourceScaffoldAttributeKindBool
+	case kind == "int":
+		attribute.Kind = ResourceScaffoldAttributeKindInt
+	case enums[kind] != nil:
+		attribute.Kind = ResourceScaffoldAttributeKindEnum
+		attribute.TypeName = kind
+		attribute.EnumNamePlural = enums[kind].NamePlural
+	case slices.Contains([]string{string(AccountObjectIdentifier), string(DatabaseObjectIdentifier), string(SchemaObjectIdentifier)}, kind):
+		attribute.Kind = ResourceScaffoldAttributeKindIdentifier
+		attribute.TypeName = kind
+	default:
+		attribute.Kind = ResourceScaffoldAttributeKindUnsupported
+	}
+	// required booleans are passed as plain values which the scaffold does not support
+	if attribute.Required && attribute.Kind == ResourceScaffoldAttributeKindBool {
+		attribute.Kind = ResourceScaffoldAttributeKindUnsupported
+	}
+	return attribute
+}
+
+// staticSql joins the static SQL keywords from the beginning of the options (skipping the given prefix), e.g. "IMAGE REPOSITORY".
+func staticSql(fields []Field, skippedPrefix string) string {
+	parts := make([]string, 0)
+	for _, f := range fields {
+		if !slices.Contains(f.Tags["ddl"], "static") {
+			if f.Name == "OrReplace" {
+				continue
+			}
+			break
+		}
+		if sql := strings.Join(f.Tags["sql"], " "); sql != skippedPrefix {
+			parts = append(parts, sql)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func findField(fields []Field, name string) (Field, bool) {
+	for _, f := range fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+func zeroValueExpression(kind string, isStruct bool) string {
+	switch {
+	case strings.HasPrefix(kind, "[]"), strings.HasPrefix(kind, "*"):
+		return "nil"
+	case kind == "bool":
+		return "false"
+	case isStruct:
+		return fmt.Sprintf("sdk.%sRequest{}", kind)
+	default:
+		return fmt.Sprintf("*new(sdk.%s)", kind)
+	}
+}
@@ -0,0 +1,120 @@
+package gen
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/genhelpers"
+)
+
+// resourceScaffoldListEntry is a single entry of the sorted list in one of the files registering the resources.
+type resourceScaffoldListEntry struct {
+	key   string
+	lines []string
+}
+
+// resourceScaffoldList describes the sorted list in one of the files registering the resources.
+type resourceScaffoldList struct {
+	path string
+	// keyPattern matches the line holding the sort key of the entry (the first group)
+	keyPattern *regexp.Regexp
+	// keyLineOffset is the offset of the key line from the first line of the entry
+	keyLineOffset int
+	entries       func(ResourceScaffoldModel) []resourceScaffoldListEntry
+}
+
+var resourceScaffoldLists = []resourceScaffoldList{
+	{
+		path:       filepath.Join("pkg", "provider", "resources", "resources.go"),
+		keyPattern: regexp.MustCompile(`^\t(\w+)\s+resource = "`),
+		entries: func(m ResourceScaffoldModel) []resourceScaffoldListEntry {
+			return []resourceScaffoldListEntry{
+				{key: m.Name, lines: []string{fmt.Sprintf("\t%s resource = \"snowflake_%s\"", m.Name, genhelpers.ToSnakeCase(m.Name))}},
+			}
+		},
+	},
+	{
+		path:       filepath.Join("pkg", "schemas", "gen", "sdk_show_result_structs.go"),
+		keyPattern: regexp.MustCompile(`^\tsdk\.(\w+)\{},$`),
+		entries: func(m ResourceScaffoldModel) []resourceScaffoldListEntry {
+			entries := []resourceScaffoldListEntry{
+				{key: m.ShowObjectName, lines: []string{fmt.Sprintf("\tsdk.%s{},", m.ShowObjectName)}},
+			}
+			if m.HasDescribe {
+				entries = append(entries, resourceScaffoldListEntry{key: m.DescribeObjectName, lines: []string{fmt.Sprintf("\tsdk.%s{},", m.DescribeObjectName)}})
+			}
+			return entries
+		},
+	},
+	{
+		path:          filepath.Join("pkg", "acceptance", "bettertestspoc", "assert", "resourceassert", "gen", "resource_schema_def.go"),
+		keyPattern:    regexp.MustCompile(`^\t\tname:\s+"(\w+)",$`),
+		keyLineOffset: 1,
+		entries: func(m ResourceScaffoldModel) []resourceScaffoldListEntry {
+			return []resourceScaffoldListEntry{
+				{key: m.Name, lines: []string{"\t{", fmt.Sprintf("\t\tname: %q,", m.Name), fmt.Sprintf("\t\tschema: resources.%s().Schema,", m.Name), "\t},"}},
+			}
+		},
+	},
+}
+
+// RegisterResourceScaffold adds the scaffolded resource to the resource names, to the input of the show output schemas generator,
+// and to the input of the resource model builders and resource assertions generators. The entries already present are skipped.
+func RegisterResourceScaffold(model ResourceScaffoldModel, rootDir string) error {
+	for _, list := range resourceScaffoldLists {
+		path := filepath.Join(rootDir, list.path)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		updated := string(content)
+		for _, entry := range list.entries(model) {
+			if updated, err = list.insert(updated, entry); err != nil {
+				return err
+			}
+		}
+		src, err := format.Source([]byte(updated))
+		if err != nil {
+			return fmt.Errorf("formatting %s: %w", path, err)
+		}
+		if err := os.WriteFile(path, src, 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insert adds the entry before the first entry with a greater key (or after the last one); the content is returned unchanged if the key is already present.
+func (l resourceScaffoldList) insert(content string, entry resourceScaffoldListEntry) (string, error) {
+	lines := strings.Split(content, "\n")
+	position, last := -1, -1
+	for idx, line := range lines {
+		match := l.keyPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		if match[1] == entry.key {
+			return content, nil
+		}
+		if position == -1 && match[1] > entry.key {
+			position = idx - l.keyLineOffset
+		}
+		last = idx
+	}
+	if last == -1 {
+		return "", fmt.Errorf("no entries found in %s", l.path)
+	}
+	if position == -1 {
+		// the existing entries have the same number of lines as the added one
+		position = last - l.keyLineOffset + len(entry.lines)
+	}
+	result := make([]string, 0, len(lines)+len(entry.lines))
+	result = append(result, lines[:position]...)
+	result = append(result, entry.lines...)
+	result = append(result, lines[position:]...)
+	return strings.Join(result, "\n"), nil
+}
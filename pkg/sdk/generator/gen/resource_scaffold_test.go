@@ -0,0 +1,179 @@
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/genhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceScaffoldModelFromInterface(t *testing.T) {
+	widgetTypeDef := NewEnum("WidgetType", "WidgetTypes", "SMALL", "LARGE")
+	definition := NewInterface(
+		"Widgets",
+		"Widget",
+		string(SchemaObjectIdentifier),
+	).WithEnums(widgetTypeDef).CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-widget",
+		NewQueryStruct("CreateWidget").
+			Create().
+			OrReplace().
+			SQL("WIDGET").
+			IfNotExists().
+			Name().
+			TextAssignment("URL", ParameterOptions().SingleQuotes().Required()).
+			Assignment("TYPE", "WidgetType", ParameterOptions().Required()).
+			OptionalNumberAssignment("SIZE", ParameterOptions()).
+			OptionalBooleanAssignment("ENABLED", ParameterOptions()).
+			OptionalAssignment("TAGS_LIST", "[]string", ParameterOptions()).
+			OptionalComment().
+			OptionalTags(),
+	).AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-widget",
+		NewQueryStruct("AlterWidget").
+			Alter().
+			SQL("WIDGET").
+			IfExists().
+			Name().
+			OptionalQueryStructField(
+				"Set",
+				NewQueryStruct("WidgetSet").
+					OptionalNumberAssignment("SIZE", ParameterOptions()).
+					OptionalBooleanAssignment("ENABLED", ParameterOptions()).
+					OptionalComment(),
+				KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				NewQueryStruct("WidgetUnset").
+					OptionalSQL("SIZE").
+					OptionalSQL("COMMENT"),
+				ListOptions().NoParentheses().SQL("UNSET"),
+			),
+	).DescribeOperationWithPairedStructs(
+		DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-widget",
+		StructPair("describeWidgetDBRow", "WidgetDetails").
+			Text("property").
+			Text("value"),
+		NewQueryStruct("DescribeWidget").
+			Describe().
+			SQL("WIDGET").
+			Name(),
+	)
+	preprocessDefinition(definition)
+
+	model := ResourceScaffoldModelFromInterface(definition, genhelpers.NewPreambleModel("test", "0.1.0"))
+
+	require.Equal(t, "Widget", model.Name)
+	require.Equal(t, "Widgets", model.InterfaceName)
+	require.Equal(t, "WIDGET", model.ObjectSql)
+	require.Equal(t, "widget", model.HumanName())
+	require.True(t, model.HasCreate)
+	require.Equal(t, []string{"database", "schema"}, model.IdentifierParts())
+	require.Equal(t, "WidgetSetRequest", model.SetRequestName)
+	require.Equal(t, "WidgetUnsetRequest", model.UnsetRequestName)
+	require.Equal(t, []string{"id", `d.Get("url").(string)`, "type"}, model.CreateRequestArguments)
+	require.True(t, model.HasDescribe)
+	require.Equal(t, "WidgetDetails", model.DescribeObjectName)
+	require.True(t, model.DescribeReturnsSlice)
+
+	attributes := make(map[string]ResourceScaffoldAttribute)
+	for _, a := range model.Attributes {
+		attributes[a.Name] = a
+	}
+	require.NotContains(t, attributes, "or_replace")
+	require.NotContains(t, attributes, "if_not_exists")
+	require.NotContains(t, attributes, "tag")
+
+	require.Equal(t, ResourceScaffoldAttributeKindEnum, attributes["type"].Kind)
+	require.Equal(t, "sdk.ToWidgetType", attributes["type"].CreateMapper())
+	require.False(t, attributes["type"].Updatable())
+
+	require.Equal(t, ResourceScaffoldAttributeKindUnsupported, attributes["tags_list"].Kind)
+	require.Empty(t, attributes["tags_list"].CreateBuilder())
+
+	require.Equal(t, `intAttributeCreateBuilder(d, "size", request.WithSize)`, attributes["size"].CreateBuilder())
+	require.Equal(t, `intAttributeUpdate(d, "size", &set.Size, &unset.Size)`, attributes["size"].UpdateStatement())
+	require.Equal(t, `booleanStringAttributeUpdateSetOnly(d, "enabled", &set.Enabled)`, attributes["enabled"].UpdateStatement())
+	require.Equal(t, `stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment)`, attributes["comment"].UpdateStatement())
+	require.Equal(t, []string{`"size"`, `"enabled"`, `"comment"`}, model.UpdatableAttributeNames())
+}
+
+func TestRegisterResourceScaffold(t *testing.T) {
+	rootDir := t.TempDir()
+	writeFile := func(path string, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(rootDir, path)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(rootDir, path), []byte(content), 0o600))
+	}
+	readFile := func(path string) string {
+		content, err := os.ReadFile(filepath.Join(rootDir, path))
+		require.NoError(t, err)
+		return string(content)
+	}
+	resourcesPath := filepath.Join("pkg", "provider", "resources", "resources.go")
+	showResultStructsPath := filepath.Join("pkg", "schemas", "gen", "sdk_show_result_structs.go")
+	resourceSchemaDefPath := filepath.Join("pkg", "acceptance", "bettertestspoc", "assert", "resourceassert", "gen", "resource_schema_def.go")
+
+	writeFile(resourcesPath, `package resources
+
+const (
+	Alert resource = "snowflake_alert"
+	Zone  resource = "snowflake_zone"
+)
+`)
+	writeFile(showResultStructsPath, `package gen
+
+var SdkShowResultStructs = []any{
+	sdk.Alert{},
+	sdk.Zone{},
+}
+`)
+	writeFile(resourceSchemaDefPath, `package gen
+
+var allResourceSchemaDefs = []ResourceSchemaDef{
+	{
+		name:   "Alert",
+		schema: resources.Alert().Schema,
+	},
+}
+`)
+
+	model := ResourceScaffoldModel{Name: "Widget", ShowObjectName: "Widget", HasDescribe: true, DescribeObjectName: "WidgetDetails"}
+	require.NoError(t, RegisterResourceScaffold(model, rootDir))
+	// the entries already present are not duplicated
+	require.NoError(t, RegisterResourceScaffold(model, rootDir))
+
+	require.Equal(t, `package resources
+
+const (
+	Alert  resource = "snowflake_alert"
+	Widget resource = "snowflake_widget"
+	Zone   resource = "snowflake_zone"
+)
+`, readFile(resourcesPath))
+	require.Equal(t, `package gen
+
+var SdkShowResultStructs = []any{
+	sdk.Alert{},
+	sdk.Widget{},
+	sdk.WidgetDetails{},
+	sdk.Zone{},
+}
+`, readFile(showResultStructsPath))
+	require.Equal(t, `package gen
+
+var allResourceSchemaDefs = []ResourceSchemaDef{
+	{
+		name:   "Alert",
+		schema: resources.Alert().Schema,
+	},
+	{
+		name:   "Widget",
+		schema: resources.Widget().Schema,
+	},
+}
+`, readFile(resourceSchemaDefPath))
+}
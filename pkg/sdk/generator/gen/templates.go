@@ -79,6 +79,12 @@ var (
 	validationTemplateContent string
 	ValidationsTemplate       *template.Template
 
+	//go:embed templates/resource_scaffold.tmpl
+	resourceScaffoldTemplateContent string
+	ResourceScaffoldTemplate, _     = template.New("resourceScaffoldTemplate").Funcs(template.FuncMap{
+		"FirstLetterLowercase": genhelpers.FirstLetterLowercase,
+	}).Parse(resourceScaffoldTemplateContent)
+
 	//go:embed templates/sub_templates/to_opts_mapping.tmpl
 	toOptsMappingTemplateContent string
 
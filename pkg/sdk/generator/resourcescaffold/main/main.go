@@ -0,0 +1,102 @@
+//go:build exclude
+
+package main
+
+import (
+	"errors"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	_ "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/defs"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/genhelpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+)
+
+const (
+	name    = "Resource scaffold"
+	version = "0.1.0"
+)
+
+func main() {
+	// scaffolding all the objects at once is never desired, so the object filter is required
+	if !slices.ContainsFunc(os.Args[1:], func(arg string) bool { return strings.Contains(arg, "--filter-object-names") }) && !slices.Contains(os.Args[1:], "--help") {
+		log.Fatal(errors.New("resource scaffold requires the objects to be chosen, e.g. SF_TF_GENERATOR_ARGS='--filter-object-names=Budgets'"))
+	}
+
+	genhelpers.NewGenerator(
+		genhelpers.NewPreambleModel(name, version),
+		gen.GetSdkDefinitions,
+		gen.ResourceScaffoldModelFromInterface,
+		filename,
+		[]*template.Template{gen.ResourceScaffoldTemplate},
+	).
+		WithAdditionalObjectFilter(func(i *gen.Interface) bool {
+			// the scaffold is edited manually afterward, so the existing files are never overwritten
+			if _, err := os.Stat(filename(i, gen.ResourceScaffoldModel{})); err == nil {
+				log.Printf("[WARN] File %s already exists, skipping %s", filename(i, gen.ResourceScaffoldModel{}), i.Name)
+				return false
+			}
+			return true
+		}).
+		WithAfterSaveHook(register).
+		WithDescription("Generate the resource skeleton based on the SDK object definition.").
+		WithMakefileCommandPart("resource-scaffold").
+		RunAndHandleOsReturn()
+}
+
+func filename(object *gen.Interface, _ gen.ResourceScaffoldModel) string {
+	return genhelpers.ToSnakeCase(object.NameSingular) + ".go"
+}
+
+// register adds the scaffolded resources to the inputs of the follow-up generators and runs them for the scaffolded resources,
+// so that the show output schemas, the resource model builders, and the resource assertions are generated right away.
+func register(objects []*gen.Interface) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	// the generator is run from pkg/resources
+	rootDir := filepath.Join(wd, "..", "..")
+
+	showResultStructs := make([]string, 0)
+	resourceNames := make([]string, 0)
+	for _, object := range objects {
+		model := gen.ResourceScaffoldModelFromInterface(object, genhelpers.NewPreambleModel(name, version))
+		if err := gen.RegisterResourceScaffold(model, rootDir); err != nil {
+			return err
+		}
+		showResultStructs = append(showResultStructs, "sdk."+model.ShowObjectName)
+		if model.HasDescribe {
+			showResultStructs = append(showResultStructs, "sdk."+model.DescribeObjectName)
+		}
+		resourceNames = append(resourceNames, model.Name)
+	}
+	if len(resourceNames) == 0 {
+		return nil
+	}
+
+	// the show output schemas have to be generated first, because the other generators need the compiled resource schema
+	if err := runGenerator(rootDir, "./pkg/schemas/generate.go", showResultStructs); err != nil {
+		return err
+	}
+	if err := runGenerator(rootDir, "./pkg/acceptance/bettertestspoc/config/model/generate.go", resourceNames); err != nil {
+		return err
+	}
+	return runGenerator(rootDir, "./pkg/acceptance/bettertestspoc/assert/resourceassert/generate.go", resourceNames)
+}
+
+func runGenerator(rootDir string, file string, objectNames []string) error {
+	log.Printf("[INFO] Running %s for %s", file, strings.Join(objectNames, ", "))
+	cmd := exec.Command("go", "generate", file)
+	cmd.Dir = rootDir
+	cmd.Env = append(os.Environ(), "SF_TF_GENERATOR_ARGS=--filter-object-names="+strings.Join(objectNames, ","))
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -135,6 +135,8 @@ var validGrantToSchemaObjectTypes = []ObjectType{
 	ObjectTypeAggregationPolicy,
 	ObjectTypeAlert,
 	ObjectTypeAuthenticationPolicy,
+	ObjectTypeBackupPolicy,
+	ObjectTypeBackupSet,
 	ObjectTypeCortexSearchService,
 	ObjectTypeDataMetricFunction,
 	ObjectTypeDataset,
@@ -848,6 +848,11 @@ func NewTableSetJoinPolicyRequest(
 	return &s
 }
 
+func (s *TableSetJoinPolicyRequest) WithAllowedJoinKeys(allowedJoinKeys []Column) *TableSetJoinPolicyRequest {
+	s.AllowedJoinKeys = allowedJoinKeys
+	return s
+}
+
 func (s *TableSetJoinPolicyRequest) WithForce(force bool) *TableSetJoinPolicyRequest {
 	s.Force = &force
 	return s
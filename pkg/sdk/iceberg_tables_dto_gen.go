@@ -261,8 +261,9 @@ type TableSetAggregationPolicyRequest struct {
 type TableUnsetAggregationPolicyRequest struct{}
 
 type TableSetJoinPolicyRequest struct {
-	JoinPolicy SchemaObjectIdentifier // required
-	Force      *bool
+	JoinPolicy      SchemaObjectIdentifier // required
+	AllowedJoinKeys []Column
+	Force           *bool
 }
 
 type TableUnsetJoinPolicyRequest struct{}
@@ -304,9 +304,10 @@ type TableUnsetAggregationPolicy struct {
 }
 
 type TableSetJoinPolicy struct {
-	set        bool                   `ddl:"static" sql:"SET"`
-	JoinPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"JOIN POLICY"`
-	Force      *bool                  `ddl:"keyword" sql:"FORCE"`
+	set             bool                   `ddl:"static" sql:"SET"`
+	JoinPolicy      SchemaObjectIdentifier `ddl:"identifier" sql:"JOIN POLICY"`
+	AllowedJoinKeys []Column               `ddl:"parameter,parentheses,no_equals" sql:"ALLOWED JOIN KEYS"`
+	Force           *bool                  `ddl:"keyword" sql:"FORCE"`
 }
 
 type TableUnsetJoinPolicy struct {
@@ -651,6 +651,15 @@ func TestIcebergTables_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s SET JOIN POLICY %s FORCE`, id.FullyQualifiedName(), joinPolicyId.FullyQualifiedName())
 	})
 
+	t.Run("alter: set join policy with allowed join keys", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetJoinPolicy = &TableSetJoinPolicy{
+			JoinPolicy:      joinPolicyId,
+			AllowedJoinKeys: []Column{{"col1"}, {"col2"}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s SET JOIN POLICY %s ALLOWED JOIN KEYS ("col1", "col2")`, id.FullyQualifiedName(), joinPolicyId.FullyQualifiedName())
+	})
+
 	t.Run("alter: unset join policy", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.UnsetJoinPolicy = &TableUnsetJoinPolicy{}
@@ -319,8 +319,9 @@ func (r *AlterIcebergTableRequest) toOpts() *AlterIcebergTableOptions {
 	}
 	if r.SetJoinPolicy != nil {
 		opts.SetJoinPolicy = &TableSetJoinPolicy{
-			JoinPolicy: r.SetJoinPolicy.JoinPolicy,
-			Force:      r.SetJoinPolicy.Force,
+			JoinPolicy:      r.SetJoinPolicy.JoinPolicy,
+			AllowedJoinKeys: r.SetJoinPolicy.AllowedJoinKeys,
+			Force:           r.SetJoinPolicy.Force,
 		}
 	}
 	if r.UnsetJoinPolicy != nil {
@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateJoinPolicyRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
@@ -48,6 +48,8 @@ const (
 	ObjectTypeSnapshot               ObjectType = "SNAPSHOT"
 	ObjectTypeSnapshotPolicy         ObjectType = "SNAPSHOT POLICY"
 	ObjectTypeSnapshotSet            ObjectType = "SNAPSHOT SET"
+	ObjectTypeBackupPolicy           ObjectType = "BACKUP POLICY"
+	ObjectTypeBackupSet              ObjectType = "BACKUP SET"
 	ObjectTypeFunction               ObjectType = "FUNCTION"
 	ObjectTypeExternalFunction       ObjectType = "EXTERNAL FUNCTION"
 	ObjectTypeProcedure              ObjectType = "PROCEDURE"
@@ -63,6 +65,7 @@ const (
 	ObjectTypeAlert                  ObjectType = "ALERT"
 	ObjectTypeBudget                 ObjectType = "SNOWFLAKE.CORE.BUDGET"
 	ObjectTypeClassification         ObjectType = "SNOWFLAKE.ML.CLASSIFICATION"
+	ObjectTypeClassificationProfile  ObjectType = "SNOWFLAKE.DATA_PRIVACY.CLASSIFICATION_PROFILE"
 	ObjectTypeApplication            ObjectType = "APPLICATION"
 	ObjectTypeApplicationPackage     ObjectType = "APPLICATION PACKAGE"
 	ObjectTypeApplicationRole        ObjectType = "APPLICATION ROLE"
@@ -111,10 +114,11 @@ const (
 	ObjectTypeSecurityIntegration ObjectType = "SECURITY INTEGRATION"
 	// TODO(SNOW-2683939): Remove in the following prs
 	ObjectTypeListingDetails ObjectType = "LISTING DETAILS"
-	// ObjectTypeApiIntegration and ObjectTypeCatalogIntegration are pseudo-objects, only used in object and invoke action assertions.
+	// ObjectTypeApiIntegration, ObjectTypeCatalogIntegration, and ObjectTypeExternalAccessIntegration are pseudo-objects, only used in object and invoke action assertions.
 	// For actual Snowflake operations where object type is needed, ObjectTypeIntegration should be used.
-	ObjectTypeApiIntegration     ObjectType = "API INTEGRATION"
-	ObjectTypeCatalogIntegration ObjectType = "CATALOG INTEGRATION"
+	ObjectTypeApiIntegration            ObjectType = "API INTEGRATION"
+	ObjectTypeCatalogIntegration        ObjectType = "CATALOG INTEGRATION"
+	ObjectTypeExternalAccessIntegration ObjectType = "EXTERNAL ACCESS INTEGRATION"
 )
 
 func (o ObjectType) String() string {
@@ -159,6 +163,8 @@ var allObjectTypes = []ObjectType{
 	ObjectTypeSnapshot,
 	ObjectTypeSnapshotPolicy,
 	ObjectTypeSnapshotSet,
+	ObjectTypeBackupPolicy,
+	ObjectTypeBackupSet,
 	ObjectTypeSemanticView,
 	ObjectTypeFunction,
 	ObjectTypeExternalFunction,
@@ -175,6 +181,7 @@ var allObjectTypes = []ObjectType{
 	ObjectTypeAlert,
 	ObjectTypeBudget,
 	ObjectTypeClassification,
+	ObjectTypeClassificationProfile,
 	ObjectTypeApplication,
 	ObjectTypeApplicationPackage,
 	ObjectTypeApplicationRole,
@@ -258,6 +265,8 @@ func objectTypeSingularToPluralMap() map[ObjectType]PluralObjectType {
 		ObjectTypeSnapshot:                PluralObjectTypeSnapshots,
 		ObjectTypeSnapshotPolicy:          PluralObjectTypeSnapshotPolicies,
 		ObjectTypeSnapshotSet:             PluralObjectTypeSnapshotSets,
+		ObjectTypeBackupPolicy:            PluralObjectTypeBackupPolicies,
+		ObjectTypeBackupSet:               PluralObjectTypeBackupSets,
 		ObjectTypeSemanticView:            PluralObjectTypeSemanticViews,
 		ObjectTypeFunction:                PluralObjectTypeFunctions,
 		ObjectTypeExternalFunction:        PluralObjectTypeExternalFunctions,
@@ -274,6 +283,7 @@ func objectTypeSingularToPluralMap() map[ObjectType]PluralObjectType {
 		ObjectTypeAlert:                   PluralObjectTypeAlerts,
 		ObjectTypeBudget:                  PluralObjectTypeBudgets,
 		ObjectTypeClassification:          PluralObjectTypeClassifications,
+		ObjectTypeClassificationProfile:   PluralObjectTypeClassificationProfiles,
 		ObjectTypeApplication:             PluralObjectTypeApplications,
 		ObjectTypeApplicationPackage:      PluralObjectTypeApplicationPackages,
 		ObjectTypeApplicationRole:         PluralObjectTypeApplicationRoles,
@@ -390,6 +400,8 @@ const (
 	PluralObjectTypeSnapshots                PluralObjectType = "SNAPSHOTS"
 	PluralObjectTypeSnapshotPolicies         PluralObjectType = "SNAPSHOT POLICIES"
 	PluralObjectTypeSnapshotSets             PluralObjectType = "SNAPSHOT SETS"
+	PluralObjectTypeBackupPolicies           PluralObjectType = "BACKUP POLICIES"
+	PluralObjectTypeBackupSets               PluralObjectType = "BACKUP SETS"
 	PluralObjectTypeSemanticViews            PluralObjectType = "SEMANTIC VIEWS"
 	PluralObjectTypeFunctions                PluralObjectType = "FUNCTIONS"
 	PluralObjectTypeExternalFunctions        PluralObjectType = "EXTERNAL FUNCTIONS"
@@ -406,6 +418,7 @@ const (
 	PluralObjectTypeAlerts                   PluralObjectType = "ALERTS"
 	PluralObjectTypeBudgets                  PluralObjectType = "SNOWFLAKE.CORE.BUDGET"
 	PluralObjectTypeClassifications          PluralObjectType = "SNOWFLAKE.ML.CLASSIFICATION"
+	PluralObjectTypeClassificationProfiles   PluralObjectType = "SNOWFLAKE.DATA_PRIVACY.CLASSIFICATION_PROFILE"
 	PluralObjectTypeApplications             PluralObjectType = "APPLICATIONS"
 	PluralObjectTypeApplicationPackages      PluralObjectType = "APPLICATION PACKAGES"
 	PluralObjectTypeApplicationRoles         PluralObjectType = "APPLICATION ROLES"
@@ -61,6 +61,7 @@ func Test_ToObjectType(t *testing.T) {
 		{input: "ALERT", want: ObjectTypeAlert},
 		{input: "SNOWFLAKE.CORE.BUDGET", want: ObjectTypeBudget},
 		{input: "SNOWFLAKE.ML.CLASSIFICATION", want: ObjectTypeClassification},
+		{input: "SNOWFLAKE.DATA_PRIVACY.CLASSIFICATION_PROFILE", want: ObjectTypeClassificationProfile},
 		{input: "APPLICATION", want: ObjectTypeApplication},
 		{input: "APPLICATION PACKAGE", want: ObjectTypeApplicationPackage},
 		{input: "APPLICATION ROLE", want: ObjectTypeApplicationRole},
@@ -1150,6 +1150,18 @@ var AllProcedureParameters = []ProcedureParameter{
 	ProcedureParameterTraceLevel,
 }
 
+type AlertParameter string
+
+const (
+	AlertParameterLogLevel   AlertParameter = "LOG_LEVEL"
+	AlertParameterTraceLevel AlertParameter = "TRACE_LEVEL"
+)
+
+var AllAlertParameters = []AlertParameter{
+	AlertParameterLogLevel,
+	AlertParameterTraceLevel,
+}
+
 // LegacyAccountParameters is based on https://docs.snowflake.com/en/sql-reference/parameters.
 type LegacyAccountParameters struct {
 	// Account Parameters
@@ -2152,11 +2164,12 @@ type ParametersIn struct {
 	Table     SchemaObjectIdentifier              `ddl:"identifier" sql:"TABLE"`
 	Function  SchemaObjectIdentifierWithArguments `ddl:"identifier" sql:"FUNCTION"`
 	Procedure SchemaObjectIdentifierWithArguments `ddl:"identifier" sql:"PROCEDURE"`
+	Alert     SchemaObjectIdentifier              `ddl:"identifier" sql:"ALERT"`
 }
 
 func (v *ParametersIn) validate() error {
-	if !anyValueSet(v.Session, v.Account, v.User, v.Warehouse, v.Database, v.Schema, v.Task, v.Table, v.Function, v.Procedure) {
-		return errors.Join(errAtLeastOneOf("Session", "Account", "User", "Warehouse", "Database", "Schema", "Task", "Table", "Function", "Procedure"))
+	if !anyValueSet(v.Session, v.Account, v.User, v.Warehouse, v.Database, v.Schema, v.Task, v.Table, v.Function, v.Procedure, v.Alert) {
+		return errors.Join(errAtLeastOneOf("Session", "Account", "User", "Warehouse", "Database", "Schema", "Task", "Table", "Function", "Procedure", "Alert"))
 	}
 	return nil
 }
@@ -2176,6 +2189,7 @@ const (
 	ParameterTypeTask             ParameterType = "TASK"
 	ParameterTypeFunction         ParameterType = "FUNCTION"
 	ParameterTypeProcedure        ParameterType = "PROCEDURE"
+	ParameterTypeAlert            ParameterType = "ALERT"
 )
 
 var AllParameterTypes = []ParameterType{
@@ -2190,6 +2204,7 @@ var AllParameterTypes = []ParameterType{
 	ParameterTypeTask,
 	ParameterTypeFunction,
 	ParameterTypeProcedure,
+	ParameterTypeAlert,
 	ParameterTypeSnowflakeDefault,
 }
 
@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateProjectionPolicyRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
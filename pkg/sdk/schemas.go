@@ -254,7 +254,8 @@ type SchemaSet struct {
 	QuotedIdentifiersIgnoreCase             *bool                       `ddl:"parameter" sql:"QUOTED_IDENTIFIERS_IGNORE_CASE"`
 	EnableConsoleOutput                     *bool                       `ddl:"parameter" sql:"ENABLE_CONSOLE_OUTPUT"`
 
-	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	ClassificationProfile *SchemaObjectIdentifier `ddl:"identifier,equals,single_quotes" sql:"CLASSIFICATION_PROFILE"`
+	Comment               *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
 func (v *SchemaSet) validate() error {
@@ -265,6 +266,9 @@ func (v *SchemaSet) validate() error {
 	if v.Catalog != nil && !ValidObjectIdentifier(v.Catalog) {
 		errs = append(errs, errInvalidIdentifier("SchemaSet", "Catalog"))
 	}
+	if v.ClassificationProfile != nil && !ValidObjectIdentifier(v.ClassificationProfile) {
+		errs = append(errs, errInvalidIdentifier("SchemaSet", "ClassificationProfile"))
+	}
 	if !anyValueSet(
 		v.DataRetentionTimeInDays,
 		v.MaxDataExtensionTimeInDays,
@@ -283,6 +287,7 @@ func (v *SchemaSet) validate() error {
 		v.QuotedIdentifiersIgnoreCase,
 		v.EnableConsoleOutput,
 		v.PipeExecutionPaused,
+		v.ClassificationProfile,
 		v.Comment,
 	) {
 		errs = append(errs, errAtLeastOneOf(
@@ -304,6 +309,7 @@ func (v *SchemaSet) validate() error {
 			"QuotedIdentifiersIgnoreCase",
 			"EnableConsoleOutput",
 			"PipeExecutionPaused",
+			"ClassificationProfile",
 			"Comment",
 		))
 	}
@@ -330,7 +336,8 @@ type SchemaUnset struct {
 	QuotedIdentifiersIgnoreCase             *bool `ddl:"keyword" sql:"QUOTED_IDENTIFIERS_IGNORE_CASE"`
 	EnableConsoleOutput                     *bool `ddl:"keyword" sql:"ENABLE_CONSOLE_OUTPUT"`
 
-	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+	ClassificationProfile *bool `ddl:"keyword" sql:"CLASSIFICATION_PROFILE"`
+	Comment               *bool `ddl:"keyword" sql:"COMMENT"`
 }
 
 func (v *SchemaUnset) validate() error {
@@ -352,6 +359,7 @@ func (v *SchemaUnset) validate() error {
 		v.QuotedIdentifiersIgnoreCase,
 		v.EnableConsoleOutput,
 		v.PipeExecutionPaused,
+		v.ClassificationProfile,
 		v.Comment,
 	) {
 		return errAtLeastOneOf(
@@ -373,6 +381,7 @@ func (v *SchemaUnset) validate() error {
 			"QuotedIdentifiersIgnoreCase",
 			"EnableConsoleOutput",
 			"PipeExecutionPaused",
+			"ClassificationProfile",
 			"Comment",
 		)
 	}
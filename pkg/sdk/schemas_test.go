@@ -155,6 +155,7 @@ func TestSchemasAlter(t *testing.T) {
 			"QuotedIdentifiersIgnoreCase",
 			"EnableConsoleOutput",
 			"PipeExecutionPaused",
+			"ClassificationProfile",
 			"Comment",
 		))
 	})
@@ -183,6 +184,7 @@ func TestSchemasAlter(t *testing.T) {
 			"QuotedIdentifiersIgnoreCase",
 			"EnableConsoleOutput",
 			"PipeExecutionPaused",
+			"ClassificationProfile",
 			"Comment",
 		))
 	})
@@ -207,6 +209,16 @@ func TestSchemasAlter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("SchemaSet", "Catalog"))
 	})
 
+	t.Run("validation: invalid classification profile identifier", func(t *testing.T) {
+		opts := &AlterSchemaOptions{
+			name: schemaId,
+			Set: &SchemaSet{
+				ClassificationProfile: Pointer(emptySchemaObjectIdentifier),
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("SchemaSet", "ClassificationProfile"))
+	})
+
 	t.Run("validation: invalid NewName identifier", func(t *testing.T) {
 		opts := &AlterSchemaOptions{
 			name:    schemaId,
@@ -275,6 +287,27 @@ func TestSchemasAlter(t *testing.T) {
 		)
 	})
 
+	t.Run("set classification profile", func(t *testing.T) {
+		classificationProfileId := randomSchemaObjectIdentifier()
+		opts := &AlterSchemaOptions{
+			name: schemaId,
+			Set: &SchemaSet{
+				ClassificationProfile: &classificationProfileId,
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SCHEMA %s SET CLASSIFICATION_PROFILE = '\"%s\".\"%s\".\"%s\"'`, schemaId.FullyQualifiedName(), classificationProfileId.DatabaseName(), classificationProfileId.SchemaName(), classificationProfileId.Name())
+	})
+
+	t.Run("unset classification profile", func(t *testing.T) {
+		opts := &AlterSchemaOptions{
+			name: schemaId,
+			Unset: &SchemaUnset{
+				ClassificationProfile: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SCHEMA %s UNSET CLASSIFICATION_PROFILE`, schemaId.FullyQualifiedName())
+	})
+
 	t.Run("unset", func(t *testing.T) {
 		opts := &AlterSchemaOptions{
 			name: schemaId,
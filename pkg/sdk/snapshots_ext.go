@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateSnapshotRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
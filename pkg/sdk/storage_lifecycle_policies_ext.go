@@ -0,0 +1,5 @@
+package sdk
+
+func (r *CreateStorageLifecyclePolicyRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
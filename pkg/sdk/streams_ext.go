@@ -8,6 +8,11 @@ func (v *Stream) IsInsertOnly() bool {
 	return v != nil && v.Mode != nil && *v.Mode == StreamModeInsertOnly
 }
 
+// IsInvalid returns true when Snowflake reports a reason for the stream being invalid, e.g. when its source object was recreated.
+func (v *Stream) IsInvalid() bool {
+	return v != nil && v.InvalidReason != nil && *v.InvalidReason != "" && *v.InvalidReason != "N/A"
+}
+
 func (r *CreateOnTableStreamRequest) GetName() SchemaObjectIdentifier {
 	return r.name
 }
@@ -0,0 +1,26 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream_IsInvalid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		stream   *Stream
+		expected bool
+	}{
+		{name: "nil stream", stream: nil, expected: false},
+		{name: "no invalid reason", stream: &Stream{}, expected: false},
+		{name: "empty invalid reason", stream: &Stream{InvalidReason: String("")}, expected: false},
+		{name: "not applicable invalid reason", stream: &Stream{InvalidReason: String("N/A")}, expected: false},
+		{name: "invalid reason present", stream: &Stream{InvalidReason: String("Base table has been dropped.")}, expected: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.stream.IsInvalid())
+		})
+	}
+}
@@ -21,6 +21,12 @@ type SystemFunctions interface {
 	DisableBehaviorChangeBundle(ctx context.Context, bundle string) error
 	ShowActiveBehaviorChangeBundles(ctx context.Context) ([]BehaviorChangeBundleInfo, error)
 	BehaviorChangeBundleStatus(ctx context.Context, bundle string) (BehaviorChangeBundleStatus, error)
+	ProvisionPrivatelinkEndpoint(ctx context.Context, request ProvisionPrivatelinkEndpointRequest) error
+	DeprovisionPrivatelinkEndpoint(ctx context.Context, providerResourceId string) error
+	GetPrivatelinkEndpointsInfo(ctx context.Context) ([]PrivatelinkEndpointInfo, error)
+	AuthorizePrivatelink(ctx context.Context, endpointId string, token string) error
+	RevokePrivatelink(ctx context.Context, endpointId string, token string) error
+	GetPrivatelinkAuthorizedEndpoints(ctx context.Context) ([]PrivatelinkAuthorizedEndpoint, error)
 }
 
 var _ SystemFunctions = (*systemFunctions)(nil)
@@ -198,3 +204,101 @@ func (c *systemFunctions) BehaviorChangeBundleStatus(ctx context.Context, bundle
 	}
 	return ToBehaviorChangeBundleStatus(row.StatusRaw)
 }
+
+// ProvisionPrivatelinkEndpointRequest describes an outbound private endpoint provisioned in the Snowflake VNet/VPC.
+// Read more in https://docs.snowflake.com/en/sql-reference/functions/system_provision_privatelink_endpoint.
+type ProvisionPrivatelinkEndpointRequest struct {
+	ProviderResourceId string
+	HostName           string
+	// Subresource is only applicable to Microsoft Azure (e.g. blob, dfs, sqlServer).
+	Subresource *string
+}
+
+func (c *systemFunctions) ProvisionPrivatelinkEndpoint(ctx context.Context, request ProvisionPrivatelinkEndpointRequest) error {
+	args := []string{request.ProviderResourceId, request.HostName}
+	if request.Subresource != nil {
+		args = append(args, *request.Subresource)
+	}
+	quotedArgs := collections.Map(args, func(arg string) string { return fmt.Sprintf("'%s'", arg) })
+	_, err := c.client.exec(ctx, fmt.Sprintf("SELECT SYSTEM$PROVISION_PRIVATELINK_ENDPOINT(%s)", strings.Join(quotedArgs, ", ")))
+	return err
+}
+
+func (c *systemFunctions) DeprovisionPrivatelinkEndpoint(ctx context.Context, providerResourceId string) error {
+	_, err := c.client.exec(ctx, fmt.Sprintf("SELECT SYSTEM$DEPROVISION_PRIVATELINK_ENDPOINT('%s')", providerResourceId))
+	return err
+}
+
+type PrivatelinkEndpointInfo struct {
+	ProviderResourceId  string `json:"provider_resource_id"`
+	SnowflakeResourceId string `json:"snowflake_resource_id"`
+	Host                string `json:"host"`
+	Subresource         string `json:"subresource"`
+	EndpointState       string `json:"endpoint_state"`
+	Status              string `json:"status"`
+}
+
+// Deprovisioned endpoints are still returned by SYSTEM$GET_PRIVATELINK_ENDPOINTS_INFO during the period in which they can be restored.
+var deprovisionedPrivatelinkEndpointStates = []string{"DELETING", "DELETED"}
+
+func IsPrivatelinkEndpointDeprovisioned(endpoint PrivatelinkEndpointInfo) bool {
+	return slices.Contains(deprovisionedPrivatelinkEndpointStates, strings.ToUpper(endpoint.EndpointState))
+}
+
+func (c *systemFunctions) GetPrivatelinkEndpointsInfo(ctx context.Context) ([]PrivatelinkEndpointInfo, error) {
+	row := &struct {
+		EndpointsRaw string `db:"ENDPOINTS"`
+	}{}
+	sql := `SELECT SYSTEM$GET_PRIVATELINK_ENDPOINTS_INFO() AS "ENDPOINTS"`
+	err := c.client.queryOne(ctx, row, sql)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrivatelinkEndpointsInfo(row.EndpointsRaw)
+}
+
+func ParsePrivatelinkEndpointsInfo(raw string) ([]PrivatelinkEndpointInfo, error) {
+	var endpoints []PrivatelinkEndpointInfo
+	if err := json.Unmarshal([]byte(raw), &endpoints); err != nil {
+		return nil, fmt.Errorf("unable to parse privatelink endpoints info: %w", err)
+	}
+	return endpoints, nil
+}
+
+// AuthorizePrivatelink enables inbound private connectivity to the current account for the given endpoint.
+// The endpointId is the AWS account ID or the Azure private endpoint resource ID and the token is the federated token (AWS) or the access token (Azure).
+// Read more in https://docs.snowflake.com/en/sql-reference/functions/system_authorize_privatelink.
+func (c *systemFunctions) AuthorizePrivatelink(ctx context.Context, endpointId string, token string) error {
+	_, err := c.client.exec(ctx, fmt.Sprintf("SELECT SYSTEM$AUTHORIZE_PRIVATELINK('%s', '%s')", endpointId, token))
+	return err
+}
+
+func (c *systemFunctions) RevokePrivatelink(ctx context.Context, endpointId string, token string) error {
+	_, err := c.client.exec(ctx, fmt.Sprintf("SELECT SYSTEM$REVOKE_PRIVATELINK('%s', '%s')", endpointId, token))
+	return err
+}
+
+type PrivatelinkAuthorizedEndpoint struct {
+	EndpointId     string `json:"endpointId"`
+	EndpointIdType string `json:"endpointIdType"`
+}
+
+func (c *systemFunctions) GetPrivatelinkAuthorizedEndpoints(ctx context.Context) ([]PrivatelinkAuthorizedEndpoint, error) {
+	row := &struct {
+		EndpointsRaw string `db:"ENDPOINTS"`
+	}{}
+	sql := `SELECT SYSTEM$GET_PRIVATELINK_AUTHORIZED_ENDPOINTS() AS "ENDPOINTS"`
+	err := c.client.queryOne(ctx, row, sql)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrivatelinkAuthorizedEndpoints(row.EndpointsRaw)
+}
+
+func ParsePrivatelinkAuthorizedEndpoints(raw string) ([]PrivatelinkAuthorizedEndpoint, error) {
+	var endpoints []PrivatelinkAuthorizedEndpoint
+	if err := json.Unmarshal([]byte(raw), &endpoints); err != nil {
+		return nil, fmt.Errorf("unable to parse privatelink authorized endpoints: %w", err)
+	}
+	return endpoints, nil
+}
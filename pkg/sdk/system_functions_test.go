@@ -39,3 +39,60 @@ func Test_ToBehaviorChangeBundleStatus(t *testing.T) {
 		})
 	}
 }
+
+func Test_ParsePrivatelinkEndpointsInfo(t *testing.T) {
+	t.Run("aws and azure endpoints", func(t *testing.T) {
+		endpoints, err := ParsePrivatelinkEndpointsInfo(`[{"provider_resource_id":"com.amazonaws.us-west-2.s3","snowflake_resource_id":"vpce-0123456789abcdef0","host":"*.s3.us-west-2.amazonaws.com","endpoint_state":"CREATED","status":"Available"},{"provider_resource_id":"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/acc","subresource":"blob","snowflake_resource_id":"/subscriptions/sf/privateEndpoints/pe","host":"acc.blob.core.windows.net","endpoint_state":"CREATING","status":"Pending"}]`)
+		require.NoError(t, err)
+		require.Equal(t, []PrivatelinkEndpointInfo{
+			{
+				ProviderResourceId:  "com.amazonaws.us-west-2.s3",
+				SnowflakeResourceId: "vpce-0123456789abcdef0",
+				Host:                "*.s3.us-west-2.amazonaws.com",
+				EndpointState:       "CREATED",
+				Status:              "Available",
+			},
+			{
+				ProviderResourceId:  "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/acc",
+				SnowflakeResourceId: "/subscriptions/sf/privateEndpoints/pe",
+				Host:                "acc.blob.core.windows.net",
+				Subresource:         "blob",
+				EndpointState:       "CREATING",
+				Status:              "Pending",
+			},
+		}, endpoints)
+	})
+
+	t.Run("no endpoints", func(t *testing.T) {
+		endpoints, err := ParsePrivatelinkEndpointsInfo(`[]`)
+		require.NoError(t, err)
+		require.Empty(t, endpoints)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := ParsePrivatelinkEndpointsInfo(`[{"broken"`)
+		require.ErrorContains(t, err, "unable to parse privatelink endpoints info")
+	})
+}
+
+func Test_ParsePrivatelinkAuthorizedEndpoints(t *testing.T) {
+	t.Run("authorized endpoints", func(t *testing.T) {
+		endpoints, err := ParsePrivatelinkAuthorizedEndpoints(`[{"endpointId":"123456789012","endpointIdType":"AWS Account ID"}]`)
+		require.NoError(t, err)
+		require.Equal(t, []PrivatelinkAuthorizedEndpoint{
+			{EndpointId: "123456789012", EndpointIdType: "AWS Account ID"},
+		}, endpoints)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := ParsePrivatelinkAuthorizedEndpoints(`{"broken"`)
+		require.ErrorContains(t, err, "unable to parse privatelink authorized endpoints")
+	})
+}
+
+func Test_IsPrivatelinkEndpointDeprovisioned(t *testing.T) {
+	require.False(t, IsPrivatelinkEndpointDeprovisioned(PrivatelinkEndpointInfo{EndpointState: "CREATED"}))
+	require.False(t, IsPrivatelinkEndpointDeprovisioned(PrivatelinkEndpointInfo{EndpointState: "CREATING"}))
+	require.True(t, IsPrivatelinkEndpointDeprovisioned(PrivatelinkEndpointInfo{EndpointState: "DELETING"}))
+	require.True(t, IsPrivatelinkEndpointDeprovisioned(PrivatelinkEndpointInfo{EndpointState: "deleted"}))
+}
@@ -13,7 +13,6 @@ import (
 // - show primary keys (https://docs.snowflake.com/en/sql-reference/sql/show-primary-keys)
 // - describe search optimization (https://docs.snowflake.com/en/sql-reference/sql/desc-search-optimization)
 // - truncate table (https://docs.snowflake.com/en/sql-reference/sql/truncate-table)
-// - undrop table (https://docs.snowflake.com/en/sql-reference/sql/undrop-table)
 type Tables interface {
 	Create(ctx context.Context, req *CreateTableRequest) error
 	CreateAsSelect(ctx context.Context, req *CreateTableAsSelectRequest) error
@@ -23,6 +22,7 @@ type Tables interface {
 	Alter(ctx context.Context, req *AlterTableRequest) error
 	Drop(ctx context.Context, req *DropTableRequest) error
 	DropSafely(ctx context.Context, id SchemaObjectIdentifier) error
+	Undrop(ctx context.Context, req *UndropTableRequest) error
 	Show(ctx context.Context, req *ShowTableRequest) ([]Table, error)
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Table, error)
 	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*Table, error)
@@ -116,10 +116,18 @@ type createTableOptions struct {
 	DefaultDDLCollation        *string                          `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
 	CopyGrants                 *bool                            `ddl:"keyword" sql:"COPY GRANTS"`
 	RowAccessPolicy            *TableRowAccessPolicyLegacy      `ddl:"keyword"`
+	AggregationPolicy          *TableAggregationPolicyLegacy    `ddl:"keyword"`
 	Tags                       []TagAssociation                 `ddl:"keyword,parentheses" sql:"TAG"`
 	Comment                    *string                          `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
+type TableAggregationPolicyLegacy struct {
+	with              bool                   `ddl:"static" sql:"WITH"`
+	aggregationPolicy bool                   `ddl:"static" sql:"AGGREGATION POLICY"`
+	Name              SchemaObjectIdentifier `ddl:"identifier"`
+	EntityKey         []Column               `ddl:"parameter,parentheses,no_equals" sql:"ENTITY KEY"`
+}
+
 type CreateTableColumnsAndConstraints struct {
 	Columns             []TableColumn         `ddl:"keyword"`
 	OutOfLineConstraint []OutOfLineConstraint `ddl:"list,no_parentheses"`
@@ -148,6 +156,7 @@ type TableColumn struct {
 	Collate          *string                 `ddl:"parameter,no_equals,single_quotes" sql:"COLLATE"`
 	DefaultValue     *ColumnDefaultValue     `ddl:"keyword"`
 	MaskingPolicy    *ColumnMaskingPolicy    `ddl:"keyword"`
+	ProjectionPolicy *ColumnProjectionPolicy `ddl:"keyword"`
 	Tags             []TagAssociation        `ddl:"keyword,parentheses" sql:"TAG"`
 	Comment          *string                 `ddl:"parameter,no_equals,single_quotes" sql:"COMMENT"`
 }
@@ -171,6 +180,12 @@ type ColumnMaskingPolicy struct {
 	Using         []string               `ddl:"keyword,parentheses" sql:"USING"`
 }
 
+type ColumnProjectionPolicy struct {
+	With             *bool                  `ddl:"keyword" sql:"WITH"`
+	projectionPolicy bool                   `ddl:"static" sql:"PROJECTION POLICY"`
+	Name             SchemaObjectIdentifier `ddl:"identifier"`
+}
+
 // OutOfLineConstraint is based on https://docs.snowflake.com/en/sql-reference/sql/create-table-constraint#out-of-line-unique-primary-foreign-key.
 type OutOfLineConstraint struct {
 	Name       *string              `ddl:"parameter,no_equals" sql:"CONSTRAINT"`
@@ -208,21 +223,29 @@ type alterTableOptions struct {
 	name     SchemaObjectIdentifier `ddl:"identifier"`
 
 	// One of
-	NewName                   *SchemaObjectIdentifier              `ddl:"identifier" sql:"RENAME TO"`
-	SwapWith                  *SchemaObjectIdentifier              `ddl:"identifier" sql:"SWAP WITH"`
-	ClusteringAction          *TableClusteringAction               `ddl:"keyword"`
-	ColumnAction              *TableColumnAction                   `ddl:"keyword"`
-	ConstraintAction          *TableConstraintAction               `ddl:"keyword"`
-	ExternalTableAction       *TableExternalTableAction            `ddl:"keyword"`
-	SearchOptimizationAction  *TableSearchOptimizationActionLegacy `ddl:"keyword"`
-	Set                       *TableSet                            `ddl:"keyword" sql:"SET"`
-	SetTags                   []TagAssociation                     `ddl:"parameter,no_equals" sql:"SET TAG"`
-	UnsetTags                 []ObjectIdentifier                   `ddl:"keyword" sql:"UNSET TAG"`
-	Unset                     *TableUnset                          `ddl:"keyword" sql:"UNSET"`
-	AddRowAccessPolicy        *TableAddRowAccessPolicy             `ddl:"keyword"`
-	DropRowAccessPolicy       *TableDropRowAccessPolicy            `ddl:"keyword"`
-	DropAndAddRowAccessPolicy *TableDropAndAddRowAccessPolicy      `ddl:"list,no_parentheses"`
-	DropAllAccessRowPolicies  *bool                                `ddl:"keyword" sql:"DROP ALL ROW ACCESS POLICIES"`
+	NewName                    *SchemaObjectIdentifier              `ddl:"identifier" sql:"RENAME TO"`
+	SwapWith                   *SchemaObjectIdentifier              `ddl:"identifier" sql:"SWAP WITH"`
+	ClusteringAction           *TableClusteringAction               `ddl:"keyword"`
+	ColumnAction               *TableColumnAction                   `ddl:"keyword"`
+	ConstraintAction           *TableConstraintAction               `ddl:"keyword"`
+	ExternalTableAction        *TableExternalTableAction            `ddl:"keyword"`
+	SearchOptimizationAction   *TableSearchOptimizationActionLegacy `ddl:"keyword"`
+	Set                        *TableSet                            `ddl:"keyword" sql:"SET"`
+	SetTags                    []TagAssociation                     `ddl:"parameter,no_equals" sql:"SET TAG"`
+	UnsetTags                  []ObjectIdentifier                   `ddl:"keyword" sql:"UNSET TAG"`
+	Unset                      *TableUnset                          `ddl:"keyword" sql:"UNSET"`
+	AddRowAccessPolicy         *TableAddRowAccessPolicy             `ddl:"keyword"`
+	DropRowAccessPolicy        *TableDropRowAccessPolicy            `ddl:"keyword"`
+	DropAndAddRowAccessPolicy  *TableDropAndAddRowAccessPolicy      `ddl:"list,no_parentheses"`
+	DropAllAccessRowPolicies   *bool                                `ddl:"keyword" sql:"DROP ALL ROW ACCESS POLICIES"`
+	SetAggregationPolicy       *TableSetAggregationPolicy           `ddl:"keyword"`
+	UnsetAggregationPolicy     *TableUnsetAggregationPolicy         `ddl:"keyword"`
+	SetJoinPolicy              *TableSetJoinPolicy                  `ddl:"keyword"`
+	UnsetJoinPolicy            *TableUnsetJoinPolicy                `ddl:"keyword"`
+	AddPrivacyPolicy           *TableAddPrivacyPolicy               `ddl:"keyword"`
+	DropPrivacyPolicy          *TableDropPrivacyPolicy              `ddl:"keyword"`
+	AddStorageLifecyclePolicy  *TableAddStorageLifecyclePolicy      `ddl:"keyword"`
+	DropStorageLifecyclePolicy *TableDropStorageLifecyclePolicy     `ddl:"keyword"`
 }
 
 type TableClusteringAction struct {
@@ -252,14 +275,16 @@ const (
 
 type TableColumnAction struct {
 	// One of
-	Add                *TableColumnAddAction                     `ddl:"keyword" sql:"ADD"`
-	Rename             *TableColumnRenameAction                  `ddl:"keyword"`
-	Alter              []TableColumnAlterAction                  `ddl:"keyword" sql:"ALTER"`
-	SetMaskingPolicy   *TableColumnAlterSetMaskingPolicyAction   `ddl:"keyword"`
-	UnsetMaskingPolicy *TableColumnAlterUnsetMaskingPolicyAction `ddl:"keyword"`
-	SetTags            *TableColumnAlterSetTagsAction            `ddl:"keyword"`
-	UnsetTags          *TableColumnAlterUnsetTagsAction          `ddl:"keyword"`
-	DropColumns        *TableColumnAlterDropColumns              `ddl:"keyword"`
+	Add                   *TableColumnAddAction                        `ddl:"keyword" sql:"ADD"`
+	Rename                *TableColumnRenameAction                     `ddl:"keyword"`
+	Alter                 []TableColumnAlterAction                     `ddl:"keyword" sql:"ALTER"`
+	SetMaskingPolicy      *TableColumnAlterSetMaskingPolicyAction      `ddl:"keyword"`
+	UnsetMaskingPolicy    *TableColumnAlterUnsetMaskingPolicyAction    `ddl:"keyword"`
+	SetProjectionPolicy   *TableColumnAlterSetProjectionPolicyAction   `ddl:"keyword"`
+	UnsetProjectionPolicy *TableColumnAlterUnsetProjectionPolicyAction `ddl:"keyword"`
+	SetTags               *TableColumnAlterSetTagsAction               `ddl:"keyword"`
+	UnsetTags             *TableColumnAlterUnsetTagsAction             `ddl:"keyword"`
+	DropColumns           *TableColumnAlterDropColumns                 `ddl:"keyword"`
 }
 
 type TableColumnAddAction struct {
@@ -271,6 +296,7 @@ type TableColumnAddAction struct {
 	DefaultValue     *ColumnDefaultValue             `ddl:"keyword"`
 	InlineConstraint *TableColumnAddInlineConstraint `ddl:"keyword"`
 	MaskingPolicy    *ColumnMaskingPolicy            `ddl:"keyword"`
+	ProjectionPolicy *ColumnProjectionPolicy         `ddl:"keyword"`
 	Tags             []TagAssociation                `ddl:"keyword,parentheses" sql:"TAG"`
 	Comment          *string                         `ddl:"parameter,no_equals,single_quotes" sql:"COMMENT"`
 }
@@ -321,6 +347,20 @@ type TableColumnAlterUnsetMaskingPolicyAction struct {
 	setMaskingPolicy bool   `ddl:"static" sql:"UNSET MASKING POLICY"`
 }
 
+type TableColumnAlterSetProjectionPolicyAction struct {
+	alter                bool                   `ddl:"static" sql:"ALTER COLUMN"`
+	ColumnName           string                 `ddl:"keyword"`
+	setProjectionPolicy  bool                   `ddl:"static" sql:"SET PROJECTION POLICY"`
+	ProjectionPolicyName SchemaObjectIdentifier `ddl:"identifier"`
+	Force                *bool                  `ddl:"keyword" sql:"FORCE"`
+}
+
+type TableColumnAlterUnsetProjectionPolicyAction struct {
+	alter                 bool   `ddl:"static" sql:"ALTER COLUMN"`
+	ColumnName            string `ddl:"keyword"`
+	unsetProjectionPolicy bool   `ddl:"static" sql:"UNSET PROJECTION POLICY"`
+}
+
 type TableColumnAlterSetTagsAction struct {
 	alter      bool             `ddl:"static" sql:"ALTER COLUMN"`
 	ColumnName string           `ddl:"keyword"`
@@ -479,6 +519,27 @@ type TableDropRowAccessPolicy struct {
 	RowAccessPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"ROW ACCESS POLICY"`
 }
 
+type TableAddPrivacyPolicy struct {
+	add           bool                   `ddl:"static" sql:"ADD"`
+	PrivacyPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"PRIVACY POLICY"`
+	EntityKey     []Column               `ddl:"parameter,parentheses,no_equals" sql:"ENTITY KEY"`
+}
+
+type TableDropPrivacyPolicy struct {
+	drop          bool                   `ddl:"static" sql:"DROP"`
+	PrivacyPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"PRIVACY POLICY"`
+}
+
+type TableAddStorageLifecyclePolicy struct {
+	add                    bool                   `ddl:"static" sql:"ADD"`
+	StorageLifecyclePolicy SchemaObjectIdentifier `ddl:"identifier" sql:"STORAGE LIFECYCLE POLICY"`
+	On                     []Column               `ddl:"parameter,parentheses,no_equals" sql:"ON"`
+}
+
+type TableDropStorageLifecyclePolicy struct {
+	dropStorageLifecyclePolicy bool `ddl:"static" sql:"DROP STORAGE LIFECYCLE POLICY"`
+}
+
 type TableDropAndAddRowAccessPolicy struct {
 	Drop TableDropRowAccessPolicy `ddl:"keyword"`
 	Add  TableAddRowAccessPolicy  `ddl:"keyword"`
@@ -496,6 +557,13 @@ type dropTableOptions struct {
 	Restrict *bool `ddl:"keyword" sql:"RESTRICT"`
 }
 
+// undropTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/undrop-table
+type undropTableOptions struct {
+	undrop bool                   `ddl:"static" sql:"UNDROP"`
+	table  bool                   `ddl:"static" sql:"TABLE"`
+	name   SchemaObjectIdentifier `ddl:"identifier"`
+}
+
 type showTableOptions struct {
 	show       bool        `ddl:"static" sql:"SHOW"`
 	Terse      *bool       `ddl:"keyword" sql:"TERSE"`
@@ -72,6 +72,7 @@ type CreateTableRequest struct {
 	DefaultDDLCollation        *string
 	CopyGrants                 *bool
 	RowAccessPolicy            *RowAccessPolicyRequest
+	AggregationPolicy          *AggregationPolicyRequest
 	Tags                       []TagAssociationRequest
 	Comment                    *string
 }
@@ -85,6 +86,11 @@ type RowAccessPolicyRequest struct {
 	On   []string               // required
 }
 
+type AggregationPolicyRequest struct {
+	Name      SchemaObjectIdentifier // required
+	EntityKey []Column
+}
+
 type TableColumnRequest struct {
 	name             string   // required
 	type_            DataType // required
@@ -93,6 +99,7 @@ type TableColumnRequest struct {
 	defaultValue     *ColumnDefaultValueRequest
 	notNull          *bool
 	maskingPolicy    *ColumnMaskingPolicyRequest
+	projectionPolicy *ColumnProjectionPolicyRequest
 	with             *bool
 	tags             []TagAssociation
 	inlineConstraint *ColumnInlineConstraintRequest
@@ -117,6 +124,11 @@ type ColumnMaskingPolicyRequest struct {
 	using []string
 }
 
+type ColumnProjectionPolicyRequest struct {
+	with *bool
+	name SchemaObjectIdentifier // required
+}
+
 type ColumnInlineConstraintRequest struct {
 	Name               string               // required
 	type_              ColumnConstraintType // required
@@ -171,23 +183,31 @@ type OutOfLineForeignKeyRequest struct {
 }
 
 type AlterTableRequest struct {
-	IfExists                  *bool
-	name                      SchemaObjectIdentifier // required
-	NewName                   *SchemaObjectIdentifier
-	SwapWith                  *SchemaObjectIdentifier
-	ClusteringAction          *TableClusteringActionRequest
-	ColumnAction              *TableColumnActionRequest
-	ConstraintAction          *TableConstraintActionRequest
-	ExternalTableAction       *TableExternalTableActionRequest
-	SearchOptimizationAction  *TableSearchOptimizationActionLegacyRequest
-	Set                       *TableSetRequest
-	SetTags                   []TagAssociationRequest
-	UnsetTags                 []ObjectIdentifier
-	Unset                     *TableUnsetRequest
-	AddRowAccessPolicy        *TableAddRowAccessPolicyRequest
-	DropRowAccessPolicy       *TableDropRowAccessPolicyRequest
-	DropAndAddRowAccessPolicy *TableDropAndAddRowAccessPolicy
-	DropAllAccessRowPolicies  *bool
+	IfExists                   *bool
+	name                       SchemaObjectIdentifier // required
+	NewName                    *SchemaObjectIdentifier
+	SwapWith                   *SchemaObjectIdentifier
+	ClusteringAction           *TableClusteringActionRequest
+	ColumnAction               *TableColumnActionRequest
+	ConstraintAction           *TableConstraintActionRequest
+	ExternalTableAction        *TableExternalTableActionRequest
+	SearchOptimizationAction   *TableSearchOptimizationActionLegacyRequest
+	Set                        *TableSetRequest
+	SetTags                    []TagAssociationRequest
+	UnsetTags                  []ObjectIdentifier
+	Unset                      *TableUnsetRequest
+	AddRowAccessPolicy         *TableAddRowAccessPolicyRequest
+	DropRowAccessPolicy        *TableDropRowAccessPolicyRequest
+	DropAndAddRowAccessPolicy  *TableDropAndAddRowAccessPolicy
+	DropAllAccessRowPolicies   *bool
+	SetAggregationPolicy       *TableSetAggregationPolicyRequest
+	UnsetAggregationPolicy     *TableUnsetAggregationPolicyRequest
+	SetJoinPolicy              *TableSetJoinPolicyRequest
+	UnsetJoinPolicy            *TableUnsetJoinPolicyRequest
+	AddPrivacyPolicy           *TableAddPrivacyPolicyRequest
+	DropPrivacyPolicy          *TableDropPrivacyPolicyRequest
+	AddStorageLifecyclePolicy  *TableAddStorageLifecyclePolicyRequest
+	DropStorageLifecyclePolicy *TableDropStorageLifecyclePolicyRequest
 }
 
 type DropTableRequest struct {
@@ -207,6 +227,16 @@ func (s *DropTableRequest) toOpts() *dropTableOptions {
 	}
 }
 
+type UndropTableRequest struct {
+	Name SchemaObjectIdentifier // required
+}
+
+func (s *UndropTableRequest) toOpts() *undropTableOptions {
+	return &undropTableOptions{
+		name: s.Name,
+	}
+}
+
 func (s *ShowTableRequest) toOpts() *showTableOptions {
 	var like *Like
 	if s.Like != nil {
@@ -240,6 +270,22 @@ type TableDropRowAccessPolicyRequest struct {
 	RowAccessPolicy SchemaObjectIdentifier // required
 }
 
+type TableAddPrivacyPolicyRequest struct {
+	PrivacyPolicy SchemaObjectIdentifier // required
+	EntityKey     []Column               // required
+}
+
+type TableDropPrivacyPolicyRequest struct {
+	PrivacyPolicy SchemaObjectIdentifier // required
+}
+
+type TableAddStorageLifecyclePolicyRequest struct {
+	StorageLifecyclePolicy SchemaObjectIdentifier // required
+	On                     []Column               // required
+}
+
+type TableDropStorageLifecyclePolicyRequest struct{}
+
 type TableDropAndAddRowAccessPolicyRequest struct {
 	Drop TableDropRowAccessPolicyRequest // required
 	Add  TableAddRowAccessPolicyRequest  // required
@@ -354,15 +400,17 @@ type TableReclusterChangeStateRequest struct {
 }
 
 type TableColumnActionRequest struct {
-	Add                 *TableColumnAddActionRequest
-	Rename              *TableColumnRenameActionRequest
-	Alter               []TableColumnAlterActionRequest
-	SetMaskingPolicy    *TableColumnAlterSetMaskingPolicyActionRequest
-	UnsetMaskingPolicy  *TableColumnAlterUnsetMaskingPolicyActionRequest
-	SetTags             *TableColumnAlterSetTagsActionRequest
-	UnsetTags           *TableColumnAlterUnsetTagsActionRequest
-	DropColumnsIfExists *bool
-	DropColumns         []string
+	Add                   *TableColumnAddActionRequest
+	Rename                *TableColumnRenameActionRequest
+	Alter                 []TableColumnAlterActionRequest
+	SetMaskingPolicy      *TableColumnAlterSetMaskingPolicyActionRequest
+	UnsetMaskingPolicy    *TableColumnAlterUnsetMaskingPolicyActionRequest
+	SetProjectionPolicy   *TableColumnAlterSetProjectionPolicyActionRequest
+	UnsetProjectionPolicy *TableColumnAlterUnsetProjectionPolicyActionRequest
+	SetTags               *TableColumnAlterSetTagsActionRequest
+	UnsetTags             *TableColumnAlterUnsetTagsActionRequest
+	DropColumnsIfExists   *bool
+	DropColumns           []string
 }
 
 type TableColumnAddActionRequest struct {
@@ -372,6 +420,7 @@ type TableColumnAddActionRequest struct {
 	DefaultValue     *ColumnDefaultValueRequest
 	InlineConstraint *TableColumnAddInlineConstraintRequest
 	MaskingPolicy    *ColumnMaskingPolicyRequest
+	ProjectionPolicy *ColumnProjectionPolicyRequest
 	With             *bool
 	Tags             []TagAssociation
 	Comment          *string
@@ -419,6 +468,16 @@ type TableColumnAlterUnsetMaskingPolicyActionRequest struct {
 	ColumnName string // required
 }
 
+type TableColumnAlterSetProjectionPolicyActionRequest struct {
+	ColumnName           string                 // required
+	ProjectionPolicyName SchemaObjectIdentifier // required
+	Force                *bool
+}
+
+type TableColumnAlterUnsetProjectionPolicyActionRequest struct {
+	ColumnName string // required
+}
+
 type TableColumnAlterSetTagsActionRequest struct {
 	ColumnName string           // required
 	Tags       []TagAssociation // required
@@ -487,6 +546,8 @@ type TableSearchOptimizationActionLegacyRequest struct {
 	// One of
 	AddSearchOptimizationOn  []string
 	DropSearchOptimizationOn []string
+	AddSearchOptimization    *bool
+	DropSearchOptimization   *bool
 }
 
 type TableSetRequest struct {
@@ -243,6 +243,11 @@ func (s *CreateTableRequest) WithRowAccessPolicy(rowAccessPolicy *RowAccessPolic
 	return s
 }
 
+func (s *CreateTableRequest) WithAggregationPolicy(aggregationPolicy *AggregationPolicyRequest) *CreateTableRequest {
+	s.AggregationPolicy = aggregationPolicy
+	return s
+}
+
 func (s *CreateTableRequest) WithTags(tags []TagAssociationRequest) *CreateTableRequest {
 	s.Tags = tags
 	return s
@@ -288,6 +293,11 @@ func (s *TableColumnRequest) WithMaskingPolicy(maskingPolicy *ColumnMaskingPolic
 	return s
 }
 
+func (s *TableColumnRequest) WithProjectionPolicy(projectionPolicy *ColumnProjectionPolicyRequest) *TableColumnRequest {
+	s.projectionPolicy = projectionPolicy
+	return s
+}
+
 func (s *TableColumnRequest) WithTags(tags []TagAssociation) *TableColumnRequest {
 	s.tags = tags
 	return s
@@ -350,6 +360,19 @@ func (s *ColumnMaskingPolicyRequest) WithUsing(using []string) *ColumnMaskingPol
 	return s
 }
 
+func NewColumnProjectionPolicyRequest(
+	name SchemaObjectIdentifier,
+) *ColumnProjectionPolicyRequest {
+	s := ColumnProjectionPolicyRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *ColumnProjectionPolicyRequest) WithWith(with *bool) *ColumnProjectionPolicyRequest {
+	s.with = with
+	return s
+}
+
 func NewColumnInlineConstraintRequest(
 	name string,
 	type_ ColumnConstraintType,
@@ -654,6 +677,46 @@ func (s *AlterTableRequest) WithDropAllAccessRowPolicies(dropAllAccessRowPolicie
 	return s
 }
 
+func (s *AlterTableRequest) WithSetAggregationPolicy(setAggregationPolicy *TableSetAggregationPolicyRequest) *AlterTableRequest {
+	s.SetAggregationPolicy = setAggregationPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithUnsetAggregationPolicy(unsetAggregationPolicy *TableUnsetAggregationPolicyRequest) *AlterTableRequest {
+	s.UnsetAggregationPolicy = unsetAggregationPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithSetJoinPolicy(setJoinPolicy *TableSetJoinPolicyRequest) *AlterTableRequest {
+	s.SetJoinPolicy = setJoinPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithUnsetJoinPolicy(unsetJoinPolicy *TableUnsetJoinPolicyRequest) *AlterTableRequest {
+	s.UnsetJoinPolicy = unsetJoinPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithAddPrivacyPolicy(addPrivacyPolicy *TableAddPrivacyPolicyRequest) *AlterTableRequest {
+	s.AddPrivacyPolicy = addPrivacyPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithDropPrivacyPolicy(dropPrivacyPolicy *TableDropPrivacyPolicyRequest) *AlterTableRequest {
+	s.DropPrivacyPolicy = dropPrivacyPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithAddStorageLifecyclePolicy(addStorageLifecyclePolicy *TableAddStorageLifecyclePolicyRequest) *AlterTableRequest {
+	s.AddStorageLifecyclePolicy = addStorageLifecyclePolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithDropStorageLifecyclePolicy(dropStorageLifecyclePolicy *TableDropStorageLifecyclePolicyRequest) *AlterTableRequest {
+	s.DropStorageLifecyclePolicy = dropStorageLifecyclePolicy
+	return s
+}
+
 func NewDropTableRequest(
 	name SchemaObjectIdentifier,
 ) *DropTableRequest {
@@ -677,6 +740,14 @@ func (s *DropTableRequest) WithRestrict(restrict *bool) *DropTableRequest {
 	return s
 }
 
+func NewUndropTableRequest(
+	name SchemaObjectIdentifier,
+) *UndropTableRequest {
+	s := UndropTableRequest{}
+	s.Name = name
+	return &s
+}
+
 func NewTableAddRowAccessPolicyRequest(
 	rowAccessPolicy SchemaObjectIdentifier,
 	on []string,
@@ -695,6 +766,38 @@ func NewTableDropRowAccessPolicyRequest(
 	return &s
 }
 
+func NewTableAddPrivacyPolicyRequest(
+	privacyPolicy SchemaObjectIdentifier,
+	entityKey []Column,
+) *TableAddPrivacyPolicyRequest {
+	s := TableAddPrivacyPolicyRequest{}
+	s.PrivacyPolicy = privacyPolicy
+	s.EntityKey = entityKey
+	return &s
+}
+
+func NewTableDropPrivacyPolicyRequest(
+	privacyPolicy SchemaObjectIdentifier,
+) *TableDropPrivacyPolicyRequest {
+	s := TableDropPrivacyPolicyRequest{}
+	s.PrivacyPolicy = privacyPolicy
+	return &s
+}
+
+func NewTableAddStorageLifecyclePolicyRequest(
+	storageLifecyclePolicy SchemaObjectIdentifier,
+	on []Column,
+) *TableAddStorageLifecyclePolicyRequest {
+	s := TableAddStorageLifecyclePolicyRequest{}
+	s.StorageLifecyclePolicy = storageLifecyclePolicy
+	s.On = on
+	return &s
+}
+
+func NewTableDropStorageLifecyclePolicyRequest() *TableDropStorageLifecyclePolicyRequest {
+	return &TableDropStorageLifecyclePolicyRequest{}
+}
+
 func NewTableDropAndAddRowAccessPolicyRequest(
 	drop TableDropRowAccessPolicyRequest,
 	add TableAddRowAccessPolicyRequest,
@@ -1129,6 +1232,16 @@ func (s *TableColumnActionRequest) WithUnsetMaskingPolicy(unsetMaskingPolicy *Ta
 	return s
 }
 
+func (s *TableColumnActionRequest) WithSetProjectionPolicy(setProjectionPolicy *TableColumnAlterSetProjectionPolicyActionRequest) *TableColumnActionRequest {
+	s.SetProjectionPolicy = setProjectionPolicy
+	return s
+}
+
+func (s *TableColumnActionRequest) WithUnsetProjectionPolicy(unsetProjectionPolicy *TableColumnAlterUnsetProjectionPolicyActionRequest) *TableColumnActionRequest {
+	s.UnsetProjectionPolicy = unsetProjectionPolicy
+	return s
+}
+
 func (s *TableColumnActionRequest) WithSetTags(setTags *TableColumnAlterSetTagsActionRequest) *TableColumnActionRequest {
 	s.SetTags = setTags
 	return s
@@ -1179,6 +1292,11 @@ func (s *TableColumnAddActionRequest) WithMaskingPolicy(maskingPolicy *ColumnMas
 	return s
 }
 
+func (s *TableColumnAddActionRequest) WithProjectionPolicy(projectionPolicy *ColumnProjectionPolicyRequest) *TableColumnAddActionRequest {
+	s.ProjectionPolicy = projectionPolicy
+	return s
+}
+
 func (s *TableColumnAddActionRequest) WithWith(with *bool) *TableColumnAddActionRequest {
 	s.With = with
 	return s
@@ -1315,6 +1433,29 @@ func NewTableColumnAlterUnsetMaskingPolicyActionRequest(
 	return &s
 }
 
+func NewTableColumnAlterSetProjectionPolicyActionRequest(
+	columnName string,
+	projectionPolicyName SchemaObjectIdentifier,
+) *TableColumnAlterSetProjectionPolicyActionRequest {
+	s := TableColumnAlterSetProjectionPolicyActionRequest{}
+	s.ColumnName = columnName
+	s.ProjectionPolicyName = projectionPolicyName
+	return &s
+}
+
+func (s *TableColumnAlterSetProjectionPolicyActionRequest) WithForce(force *bool) *TableColumnAlterSetProjectionPolicyActionRequest {
+	s.Force = force
+	return s
+}
+
+func NewTableColumnAlterUnsetProjectionPolicyActionRequest(
+	columnName string,
+) *TableColumnAlterUnsetProjectionPolicyActionRequest {
+	s := TableColumnAlterUnsetProjectionPolicyActionRequest{}
+	s.ColumnName = columnName
+	return &s
+}
+
 func NewTableColumnAlterSetTagsActionRequest(
 	columnName string,
 	tags []TagAssociation,
@@ -1516,6 +1657,16 @@ func (s *TableSearchOptimizationActionLegacyRequest) WithAddSearchOptimizationOn
 }
 
 // Adjusted manually
+func (s *TableSearchOptimizationActionLegacyRequest) WithAddSearchOptimization(addSearchOptimization *bool) *TableSearchOptimizationActionLegacyRequest {
+	s.AddSearchOptimization = addSearchOptimization
+	return s
+}
+
+func (s *TableSearchOptimizationActionLegacyRequest) WithDropSearchOptimization(dropSearchOptimization *bool) *TableSearchOptimizationActionLegacyRequest {
+	s.DropSearchOptimization = dropSearchOptimization
+	return s
+}
+
 func (s *TableSearchOptimizationActionLegacyRequest) WithDropSearchOptimizationOn(dropSearchOptimizationOn []string) *TableSearchOptimizationActionLegacyRequest {
 	s.DropSearchOptimizationOn = dropSearchOptimizationOn
 	return s
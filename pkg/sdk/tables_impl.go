@@ -21,6 +21,7 @@ var (
 	_ optionsProvider[createTableCloneOptions]             = new(CreateTableCloneRequest)
 	_ optionsProvider[alterTableOptions]                   = new(AlterTableRequest)
 	_ optionsProvider[dropTableOptions]                    = new(DropTableRequest)
+	_ optionsProvider[undropTableOptions]                  = new(UndropTableRequest)
 	_ optionsProvider[showTableOptions]                    = new(ShowTableRequest)
 	_ optionsProvider[describeTableColumnsOptions]         = new(DescribeTableColumnsRequest)
 	_ optionsProvider[describeTableStageOptions]           = new(DescribeTableStageRequest)
@@ -74,6 +75,11 @@ func (v *tables) DropSafely(ctx context.Context, id SchemaObjectIdentifier) erro
 	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropTableRequest(id).WithIfExists(Bool(true))) }, ctx, id)
 }
 
+func (v *tables) Undrop(ctx context.Context, request *UndropTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
 func (v *tables) Show(ctx context.Context, request *ShowTableRequest) ([]Table, error) {
 	opts := request.toOpts()
 	dbRows, err := validateAndQuery[tableDBRow](v.client, ctx, opts)
@@ -199,24 +205,84 @@ func (s *AlterTableRequest) toOpts() *alterTableOptions {
 		}
 	}
 
+	var setAggregationPolicy *TableSetAggregationPolicy
+	if s.SetAggregationPolicy != nil {
+		setAggregationPolicy = &TableSetAggregationPolicy{
+			AggregationPolicy: s.SetAggregationPolicy.AggregationPolicy,
+			EntityKey:         s.SetAggregationPolicy.EntityKey,
+			Force:             s.SetAggregationPolicy.Force,
+		}
+	}
+	var unsetAggregationPolicy *TableUnsetAggregationPolicy
+	if s.UnsetAggregationPolicy != nil {
+		unsetAggregationPolicy = &TableUnsetAggregationPolicy{}
+	}
+
+	var setJoinPolicy *TableSetJoinPolicy
+	if s.SetJoinPolicy != nil {
+		setJoinPolicy = &TableSetJoinPolicy{
+			JoinPolicy:      s.SetJoinPolicy.JoinPolicy,
+			AllowedJoinKeys: s.SetJoinPolicy.AllowedJoinKeys,
+			Force:           s.SetJoinPolicy.Force,
+		}
+	}
+	var unsetJoinPolicy *TableUnsetJoinPolicy
+	if s.UnsetJoinPolicy != nil {
+		unsetJoinPolicy = &TableUnsetJoinPolicy{}
+	}
+
+	var addPrivacyPolicy *TableAddPrivacyPolicy
+	if s.AddPrivacyPolicy != nil {
+		addPrivacyPolicy = &TableAddPrivacyPolicy{
+			PrivacyPolicy: s.AddPrivacyPolicy.PrivacyPolicy,
+			EntityKey:     s.AddPrivacyPolicy.EntityKey,
+		}
+	}
+	var dropPrivacyPolicy *TableDropPrivacyPolicy
+	if s.DropPrivacyPolicy != nil {
+		dropPrivacyPolicy = &TableDropPrivacyPolicy{
+			PrivacyPolicy: s.DropPrivacyPolicy.PrivacyPolicy,
+		}
+	}
+
+	var addStorageLifecyclePolicy *TableAddStorageLifecyclePolicy
+	if s.AddStorageLifecyclePolicy != nil {
+		addStorageLifecyclePolicy = &TableAddStorageLifecyclePolicy{
+			StorageLifecyclePolicy: s.AddStorageLifecyclePolicy.StorageLifecyclePolicy,
+			On:                     s.AddStorageLifecyclePolicy.On,
+		}
+	}
+	var dropStorageLifecyclePolicy *TableDropStorageLifecyclePolicy
+	if s.DropStorageLifecyclePolicy != nil {
+		dropStorageLifecyclePolicy = &TableDropStorageLifecyclePolicy{}
+	}
+
 	return &alterTableOptions{
-		IfExists:                  s.IfExists,
-		name:                      s.name,
-		NewName:                   s.NewName,
-		SwapWith:                  s.SwapWith,
-		ClusteringAction:          clusteringAction,
-		ColumnAction:              columnAction,
-		ConstraintAction:          constraintAction,
-		ExternalTableAction:       externalTableAction,
-		SearchOptimizationAction:  searchOptimizationAction,
-		Set:                       tableSet,
-		SetTags:                   tagAssociations,
-		UnsetTags:                 s.UnsetTags,
-		Unset:                     tableUnset,
-		AddRowAccessPolicy:        addRowAccessPolicy,
-		DropRowAccessPolicy:       dropRowAccessPolicy,
-		DropAndAddRowAccessPolicy: dropAndAddRowAccessPolicy,
-		DropAllAccessRowPolicies:  s.DropAllAccessRowPolicies,
+		IfExists:                   s.IfExists,
+		name:                       s.name,
+		NewName:                    s.NewName,
+		SwapWith:                   s.SwapWith,
+		ClusteringAction:           clusteringAction,
+		ColumnAction:               columnAction,
+		ConstraintAction:           constraintAction,
+		ExternalTableAction:        externalTableAction,
+		SearchOptimizationAction:   searchOptimizationAction,
+		Set:                        tableSet,
+		SetTags:                    tagAssociations,
+		UnsetTags:                  s.UnsetTags,
+		Unset:                      tableUnset,
+		AddRowAccessPolicy:         addRowAccessPolicy,
+		DropRowAccessPolicy:        dropRowAccessPolicy,
+		DropAndAddRowAccessPolicy:  dropAndAddRowAccessPolicy,
+		DropAllAccessRowPolicies:   s.DropAllAccessRowPolicies,
+		SetAggregationPolicy:       setAggregationPolicy,
+		UnsetAggregationPolicy:     unsetAggregationPolicy,
+		SetJoinPolicy:              setJoinPolicy,
+		UnsetJoinPolicy:            unsetJoinPolicy,
+		AddPrivacyPolicy:           addPrivacyPolicy,
+		DropPrivacyPolicy:          dropPrivacyPolicy,
+		AddStorageLifecyclePolicy:  addStorageLifecyclePolicy,
+		DropStorageLifecyclePolicy: dropStorageLifecyclePolicy,
 	}
 }
 
@@ -255,6 +321,16 @@ func (s *TableSearchOptimizationActionLegacyRequest) toOpts() *TableSearchOptimi
 			},
 		}
 	}
+	if s.AddSearchOptimization != nil && *s.AddSearchOptimization {
+		return &TableSearchOptimizationActionLegacy{
+			Add: &AddSearchOptimization{},
+		}
+	}
+	if s.DropSearchOptimization != nil && *s.DropSearchOptimization {
+		return &TableSearchOptimizationActionLegacy{
+			Drop: &DropSearchOptimization{},
+		}
+	}
 	return nil
 }
 
@@ -398,6 +474,21 @@ func (r *TableColumnActionRequest) toOpts() *TableColumnAction {
 				ForeignKey: foreignKey,
 			}
 		}
+		var maskingPolicy *ColumnMaskingPolicy
+		if r.Add.MaskingPolicy != nil {
+			maskingPolicy = &ColumnMaskingPolicy{
+				With:  r.Add.MaskingPolicy.with,
+				Name:  r.Add.MaskingPolicy.name,
+				Using: r.Add.MaskingPolicy.using,
+			}
+		}
+		var projectionPolicy *ColumnProjectionPolicy
+		if r.Add.ProjectionPolicy != nil {
+			projectionPolicy = &ColumnProjectionPolicy{
+				With: r.Add.ProjectionPolicy.with,
+				Name: r.Add.ProjectionPolicy.name,
+			}
+		}
 		return &TableColumnAction{
 			Add: &TableColumnAddAction{
 				IfNotExists:      r.Add.IfNotExists,
@@ -405,6 +496,8 @@ func (r *TableColumnActionRequest) toOpts() *TableColumnAction {
 				Type:             r.Add.Type,
 				DefaultValue:     defaultValue,
 				InlineConstraint: inlineConstraint,
+				MaskingPolicy:    maskingPolicy,
+				ProjectionPolicy: projectionPolicy,
 				Comment:          r.Add.Comment,
 				Collate:          r.Add.Collate,
 			},
@@ -460,6 +553,22 @@ func (r *TableColumnActionRequest) toOpts() *TableColumnAction {
 			},
 		}
 	}
+	if r.SetProjectionPolicy != nil {
+		return &TableColumnAction{
+			SetProjectionPolicy: &TableColumnAlterSetProjectionPolicyAction{
+				ColumnName:           r.SetProjectionPolicy.ColumnName,
+				ProjectionPolicyName: r.SetProjectionPolicy.ProjectionPolicyName,
+				Force:                r.SetProjectionPolicy.Force,
+			},
+		}
+	}
+	if r.UnsetProjectionPolicy != nil {
+		return &TableColumnAction{
+			UnsetProjectionPolicy: &TableColumnAlterUnsetProjectionPolicyAction{
+				ColumnName: r.UnsetProjectionPolicy.ColumnName,
+			},
+		}
+	}
 	if r.SetTags != nil {
 		return &TableColumnAction{
 			SetTags: &TableColumnAlterSetTagsAction{
@@ -499,6 +608,13 @@ func (s *CreateTableRequest) toOpts() *createTableOptions {
 			On:   s.RowAccessPolicy.On,
 		}
 	}
+	var aggregationPolicy *TableAggregationPolicyLegacy
+	if s.AggregationPolicy != nil {
+		aggregationPolicy = &TableAggregationPolicyLegacy{
+			Name:      s.AggregationPolicy.Name,
+			EntityKey: s.AggregationPolicy.EntityKey,
+		}
+	}
 	outOfLineConstraints := make([]OutOfLineConstraint, 0, len(s.OutOfLineConstraints))
 	for _, outOfLineConstraintRequest := range s.OutOfLineConstraints {
 		var foreignKey *OutOfLineForeignKey
@@ -555,6 +671,7 @@ func (s *CreateTableRequest) toOpts() *createTableOptions {
 		Tags:                       tagAssociations,
 		Comment:                    s.Comment,
 		RowAccessPolicy:            rowAccessPolicy,
+		AggregationPolicy:          aggregationPolicy,
 	}
 
 	if s.stageCopyOptions != nil {
@@ -805,6 +922,13 @@ func convertColumns(columnRequests []TableColumnRequest) []TableColumn {
 				Using: columnRequest.maskingPolicy.using,
 			}
 		}
+		var projectionPolicy *ColumnProjectionPolicy
+		if columnRequest.projectionPolicy != nil {
+			projectionPolicy = &ColumnProjectionPolicy{
+				With: columnRequest.projectionPolicy.with,
+				Name: columnRequest.projectionPolicy.name,
+			}
+		}
 		columns = append(columns, TableColumn{
 			Name:             columnRequest.name,
 			Type:             columnRequest.type_,
@@ -812,6 +936,7 @@ func convertColumns(columnRequests []TableColumnRequest) []TableColumn {
 			Comment:          columnRequest.comment,
 			DefaultValue:     defaultValue,
 			MaskingPolicy:    maskingPolicy,
+			ProjectionPolicy: projectionPolicy,
 			NotNull:          columnRequest.notNull,
 			Tags:             columnRequest.tags,
 			InlineConstraint: inlineConstraint,
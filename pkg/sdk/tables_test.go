@@ -500,6 +500,26 @@ func TestTableCreate(t *testing.T) {
 		)
 	})
 
+	t.Run("with projection and aggregation policies", func(t *testing.T) {
+		projectionPolicyId := randomSchemaObjectIdentifier()
+		aggregationPolicyId := randomSchemaObjectIdentifier()
+		columns := []TableColumnRequest{
+			*NewTableColumnRequest("FIRST_COLUMN", DataTypeVARCHAR).WithProjectionPolicy(NewColumnProjectionPolicyRequest(projectionPolicyId)),
+		}
+		request := NewCreateTableRequest(id, columns).
+			WithAggregationPolicy(&AggregationPolicyRequest{Name: aggregationPolicyId, EntityKey: []Column{{"FIRST_COLUMN"}, {"SECOND_COLUMN"}}})
+		assertOptsValidAndSQLEquals(t, request.toOpts(), `CREATE TABLE %s (FIRST_COLUMN VARCHAR PROJECTION POLICY %s) WITH AGGREGATION POLICY %s ENTITY KEY ("FIRST_COLUMN", "SECOND_COLUMN")`, id.FullyQualifiedName(), projectionPolicyId.FullyQualifiedName(), aggregationPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("validation: projection and aggregation policies with invalid identifiers", func(t *testing.T) {
+		columns := []TableColumnRequest{
+			*NewTableColumnRequest("FIRST_COLUMN", DataTypeVARCHAR).WithProjectionPolicy(NewColumnProjectionPolicyRequest(emptySchemaObjectIdentifier)),
+		}
+		request := NewCreateTableRequest(id, columns).
+			WithAggregationPolicy(&AggregationPolicyRequest{Name: emptySchemaObjectIdentifier})
+		assertOptsInvalidJoinedErrors(t, request.toOpts(), errInvalidIdentifier("ColumnProjectionPolicy", "Name"), errInvalidIdentifier("TableAggregationPolicy", "Name"))
+	})
+
 	t.Run("with skip file x", func(t *testing.T) {
 		columns := []TableColumnRequest{
 			{name: "FIRST_COLUMN", type_: DataTypeVARCHAR},
@@ -735,7 +755,7 @@ func TestTableAlter(t *testing.T) {
 
 	t.Run("validation: no action", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetJoinPolicy", "UnsetJoinPolicy", "AddPrivacyPolicy", "DropPrivacyPolicy", "AddStorageLifecyclePolicy", "DropStorageLifecyclePolicy"))
 	})
 
 	t.Run("validation: incorrect identifier", func(t *testing.T) {
@@ -749,7 +769,7 @@ func TestTableAlter(t *testing.T) {
 		opts.NewName = Pointer(randomSchemaObjectIdentifier())
 		opts.SwapWith = Pointer(randomSchemaObjectIdentifier())
 
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetJoinPolicy", "UnsetJoinPolicy", "AddPrivacyPolicy", "DropPrivacyPolicy", "AddStorageLifecyclePolicy", "DropStorageLifecyclePolicy"))
 	})
 
 	t.Run("validation: NewName's incorrect identifier", func(t *testing.T) {
@@ -785,7 +805,7 @@ func TestTableAlter(t *testing.T) {
 	t.Run("validation: column action - no option present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.ColumnAction = &TableColumnAction{}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetProjectionPolicy", "UnsetProjectionPolicy", "SetTags", "UnsetTags", "DropColumns"))
 	})
 
 	t.Run("validation: column action - two options present", func(t *testing.T) {
@@ -797,7 +817,7 @@ func TestTableAlter(t *testing.T) {
 				OldName: "old",
 			},
 		}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetProjectionPolicy", "UnsetProjectionPolicy", "SetTags", "UnsetTags", "DropColumns"))
 	})
 
 	t.Run("validation: column action alter - no option present", func(t *testing.T) {
@@ -992,6 +1012,28 @@ func TestTableAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ADD COLUMN IF NOT EXISTS NEXT_COLUMN VARCHAR COLLATE 'utf8' IDENTITY START 10 INCREMENT 1", id.FullyQualifiedName())
 	})
 
+	t.Run("add new column with masking and projection policies", func(t *testing.T) {
+		maskingPolicyId := randomSchemaObjectIdentifier()
+		projectionPolicyId := randomSchemaObjectIdentifier()
+		opts := &alterTableOptions{
+			name: id,
+			ColumnAction: &TableColumnAction{
+				Add: &TableColumnAddAction{
+					Name: "NEXT_COLUMN",
+					Type: DataTypeVARCHAR,
+					MaskingPolicy: &ColumnMaskingPolicy{
+						Name:  maskingPolicyId,
+						Using: []string{"NEXT_COLUMN", "OTHER_COLUMN"},
+					},
+					ProjectionPolicy: &ColumnProjectionPolicy{
+						Name: projectionPolicyId,
+					},
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ADD COLUMN NEXT_COLUMN VARCHAR MASKING POLICY %s USING (NEXT_COLUMN, OTHER_COLUMN) PROJECTION POLICY %s", id.FullyQualifiedName(), maskingPolicyId.FullyQualifiedName(), projectionPolicyId.FullyQualifiedName())
+	})
+
 	t.Run("rename column", func(t *testing.T) {
 		oldColumn := "OLD_NAME"
 		newColumnName := "NEW_NAME"
@@ -1088,6 +1130,33 @@ func TestTableAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN COLUMN_1 UNSET MASKING POLICY", id.FullyQualifiedName())
 	})
 
+	t.Run("alter: set projection policy", func(t *testing.T) {
+		projectionPolicyName := randomSchemaObjectIdentifier()
+		opts := &alterTableOptions{
+			name: id,
+			ColumnAction: &TableColumnAction{
+				SetProjectionPolicy: &TableColumnAlterSetProjectionPolicyAction{
+					ColumnName:           "COLUMN_1",
+					ProjectionPolicyName: projectionPolicyName,
+					Force:                Bool(true),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN COLUMN_1 SET PROJECTION POLICY %s FORCE", id.FullyQualifiedName(), projectionPolicyName.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset projection policy", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name: id,
+			ColumnAction: &TableColumnAction{
+				UnsetProjectionPolicy: &TableColumnAlterUnsetProjectionPolicyAction{
+					ColumnName: "COLUMN_1",
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN COLUMN_1 UNSET PROJECTION POLICY", id.FullyQualifiedName())
+	})
+
 	t.Run("alter: set tags", func(t *testing.T) {
 		tagId1 := randomSchemaObjectIdentifier()
 		tagId2 := randomSchemaObjectIdentifierInSchema(tagId1.SchemaId())
@@ -1329,6 +1398,18 @@ func TestTableAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s DROP SEARCH OPTIMIZATION ON SUBSTRING(*), FOO", id.FullyQualifiedName())
 	})
 
+	t.Run("add search optimization on the whole table", func(t *testing.T) {
+		request := NewAlterTableRequest(id).
+			WithSearchOptimizationAction(NewTableSearchOptimizationActionLegacyRequest().WithAddSearchOptimization(Bool(true)))
+		assertOptsValidAndSQLEquals(t, request.toOpts(), "ALTER TABLE %s ADD SEARCH OPTIMIZATION", id.FullyQualifiedName())
+	})
+
+	t.Run("drop search optimization on the whole table", func(t *testing.T) {
+		request := NewAlterTableRequest(id).
+			WithSearchOptimizationAction(NewTableSearchOptimizationActionLegacyRequest().WithDropSearchOptimization(Bool(true)))
+		assertOptsValidAndSQLEquals(t, request.toOpts(), "ALTER TABLE %s DROP SEARCH OPTIMIZATION", id.FullyQualifiedName())
+	})
+
 	t.Run("set: with complete options", func(t *testing.T) {
 		comment := random.Comment()
 		opts := &alterTableOptions{
@@ -1449,6 +1530,83 @@ func TestTableAlter(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s DROP ALL ROW ACCESS POLICIES`, id.FullyQualifiedName())
 	})
+
+	t.Run("set aggregation policy", func(t *testing.T) {
+		aggregationPolicyId := randomSchemaObjectIdentifier()
+		request := NewAlterTableRequest(id).
+			WithSetAggregationPolicy(NewTableSetAggregationPolicyRequest(aggregationPolicyId).WithEntityKey([]Column{{"COLUMN_1"}, {"COLUMN_2"}}).WithForce(true))
+		assertOptsValidAndSQLEquals(t, request.toOpts(), `ALTER TABLE %s SET AGGREGATION POLICY %s ENTITY KEY ("COLUMN_1", "COLUMN_2") FORCE`, id.FullyQualifiedName(), aggregationPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("validation: set aggregation policy with invalid identifier", func(t *testing.T) {
+		request := NewAlterTableRequest(id).
+			WithSetAggregationPolicy(NewTableSetAggregationPolicyRequest(emptySchemaObjectIdentifier))
+		assertOptsInvalidJoinedErrors(t, request.toOpts(), errInvalidIdentifier("TableSetAggregationPolicy", "AggregationPolicy"))
+	})
+
+	t.Run("unset aggregation policy", func(t *testing.T) {
+		request := NewAlterTableRequest(id).
+			WithUnsetAggregationPolicy(&TableUnsetAggregationPolicyRequest{})
+		assertOptsValidAndSQLEquals(t, request.toOpts(), `ALTER TABLE %s UNSET AGGREGATION POLICY`, id.FullyQualifiedName())
+	})
+
+	t.Run("set join policy", func(t *testing.T) {
+		joinPolicyId := randomSchemaObjectIdentifier()
+		request := NewAlterTableRequest(id).
+			WithSetJoinPolicy(NewTableSetJoinPolicyRequest(joinPolicyId).WithAllowedJoinKeys([]Column{{"COLUMN_1"}, {"COLUMN_2"}}).WithForce(true))
+		assertOptsValidAndSQLEquals(t, request.toOpts(), `ALTER TABLE %s SET JOIN POLICY %s ALLOWED JOIN KEYS ("COLUMN_1", "COLUMN_2") FORCE`, id.FullyQualifiedName(), joinPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("validation: set join policy with invalid identifier", func(t *testing.T) {
+		request := NewAlterTableRequest(id).
+			WithSetJoinPolicy(NewTableSetJoinPolicyRequest(emptySchemaObjectIdentifier))
+		assertOptsInvalidJoinedErrors(t, request.toOpts(), errInvalidIdentifier("TableSetJoinPolicy", "JoinPolicy"))
+	})
+
+	t.Run("unset join policy", func(t *testing.T) {
+		request := NewAlterTableRequest(id).
+			WithUnsetJoinPolicy(NewTableUnsetJoinPolicyRequest())
+		assertOptsValidAndSQLEquals(t, request.toOpts(), `ALTER TABLE %s UNSET JOIN POLICY`, id.FullyQualifiedName())
+	})
+
+	t.Run("add privacy policy", func(t *testing.T) {
+		privacyPolicyId := randomSchemaObjectIdentifier()
+		request := NewAlterTableRequest(id).
+			WithAddPrivacyPolicy(NewTableAddPrivacyPolicyRequest(privacyPolicyId, []Column{{"COLUMN_1"}}))
+		assertOptsValidAndSQLEquals(t, request.toOpts(), `ALTER TABLE %s ADD PRIVACY POLICY %s ENTITY KEY ("COLUMN_1")`, id.FullyQualifiedName(), privacyPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("validation: add privacy policy with invalid identifier and without entity key", func(t *testing.T) {
+		request := NewAlterTableRequest(id).
+			WithAddPrivacyPolicy(NewTableAddPrivacyPolicyRequest(emptySchemaObjectIdentifier, nil))
+		assertOptsInvalidJoinedErrors(t, request.toOpts(), errInvalidIdentifier("TableAddPrivacyPolicy", "PrivacyPolicy"), errNotSet("TableAddPrivacyPolicy", "EntityKey"))
+	})
+
+	t.Run("drop privacy policy", func(t *testing.T) {
+		privacyPolicyId := randomSchemaObjectIdentifier()
+		request := NewAlterTableRequest(id).
+			WithDropPrivacyPolicy(NewTableDropPrivacyPolicyRequest(privacyPolicyId))
+		assertOptsValidAndSQLEquals(t, request.toOpts(), `ALTER TABLE %s DROP PRIVACY POLICY %s`, id.FullyQualifiedName(), privacyPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("add storage lifecycle policy", func(t *testing.T) {
+		storageLifecyclePolicyId := randomSchemaObjectIdentifier()
+		request := NewAlterTableRequest(id).
+			WithAddStorageLifecyclePolicy(NewTableAddStorageLifecyclePolicyRequest(storageLifecyclePolicyId, []Column{{"COLUMN_1"}, {"COLUMN_2"}}))
+		assertOptsValidAndSQLEquals(t, request.toOpts(), `ALTER TABLE %s ADD STORAGE LIFECYCLE POLICY %s ON ("COLUMN_1", "COLUMN_2")`, id.FullyQualifiedName(), storageLifecyclePolicyId.FullyQualifiedName())
+	})
+
+	t.Run("validation: add storage lifecycle policy with invalid identifier and without columns", func(t *testing.T) {
+		request := NewAlterTableRequest(id).
+			WithAddStorageLifecyclePolicy(NewTableAddStorageLifecyclePolicyRequest(emptySchemaObjectIdentifier, nil))
+		assertOptsInvalidJoinedErrors(t, request.toOpts(), errInvalidIdentifier("TableAddStorageLifecyclePolicy", "StorageLifecyclePolicy"), errNotSet("TableAddStorageLifecyclePolicy", "On"))
+	})
+
+	t.Run("drop storage lifecycle policy", func(t *testing.T) {
+		request := NewAlterTableRequest(id).
+			WithDropStorageLifecyclePolicy(NewTableDropStorageLifecyclePolicyRequest())
+		assertOptsValidAndSQLEquals(t, request.toOpts(), `ALTER TABLE %s DROP STORAGE LIFECYCLE POLICY`, id.FullyQualifiedName())
+	})
 }
 
 func TestTableDrop(t *testing.T) {
@@ -1490,6 +1648,32 @@ func TestTableDrop(t *testing.T) {
 	})
 }
 
+func TestTableUndrop(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	defaultOpts := func() *undropTableOptions {
+		return &undropTableOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *undropTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("undropTableOptions", "name"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `UNDROP TABLE %s`, id.FullyQualifiedName())
+	})
+}
+
 func TestTableShow(t *testing.T) {
 	id := randomSchemaObjectIdentifier()
 
@@ -10,6 +10,7 @@ var (
 	_ validatable = new(createTableUsingTemplateOptions)
 	_ validatable = new(alterTableOptions)
 	_ validatable = new(dropTableOptions)
+	_ validatable = new(undropTableOptions)
 	_ validatable = new(showTableOptions)
 	_ validatable = new(describeTableColumnsOptions)
 	_ validatable = new(describeTableStageOptions)
@@ -50,6 +51,11 @@ func (opts *createTableOptions) validate() error {
 				errs = append(errs, errInvalidIdentifier("ColumnMaskingPolicy", "Name"))
 			}
 		}
+		if column.ProjectionPolicy != nil {
+			if !ValidObjectIdentifier(column.ProjectionPolicy.Name) {
+				errs = append(errs, errInvalidIdentifier("ColumnProjectionPolicy", "Name"))
+			}
+		}
 		for _, tag := range column.Tags {
 			if !ValidObjectIdentifier(tag.Name) {
 				errs = append(errs, errInvalidIdentifier("TagAssociation", "Name"))
@@ -75,6 +81,11 @@ func (opts *createTableOptions) validate() error {
 			errs = append(errs, errInvalidIdentifier("TableRowAccessPolicy", "Name"))
 		}
 	}
+	if opts.AggregationPolicy != nil {
+		if !ValidObjectIdentifier(opts.AggregationPolicy.Name) {
+			errs = append(errs, errInvalidIdentifier("TableAggregationPolicy", "Name"))
+		}
+	}
 
 	return errors.Join(errs...)
 }
@@ -159,8 +170,47 @@ func (opts *alterTableOptions) validate() error {
 		opts.DropRowAccessPolicy,
 		opts.DropAndAddRowAccessPolicy,
 		opts.DropAllAccessRowPolicies,
+		opts.SetAggregationPolicy,
+		opts.UnsetAggregationPolicy,
+		opts.SetJoinPolicy,
+		opts.UnsetJoinPolicy,
+		opts.AddPrivacyPolicy,
+		opts.DropPrivacyPolicy,
+		opts.AddStorageLifecyclePolicy,
+		opts.DropStorageLifecyclePolicy,
 	); !ok {
-		errs = append(errs, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies"))
+		errs = append(errs, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetJoinPolicy", "UnsetJoinPolicy", "AddPrivacyPolicy", "DropPrivacyPolicy", "AddStorageLifecyclePolicy", "DropStorageLifecyclePolicy"))
+	}
+	if opts.SetAggregationPolicy != nil {
+		if !ValidObjectIdentifier(opts.SetAggregationPolicy.AggregationPolicy) {
+			errs = append(errs, errInvalidIdentifier("TableSetAggregationPolicy", "AggregationPolicy"))
+		}
+	}
+	if opts.SetJoinPolicy != nil {
+		if !ValidObjectIdentifier(opts.SetJoinPolicy.JoinPolicy) {
+			errs = append(errs, errInvalidIdentifier("TableSetJoinPolicy", "JoinPolicy"))
+		}
+	}
+	if opts.AddPrivacyPolicy != nil {
+		if !ValidObjectIdentifier(opts.AddPrivacyPolicy.PrivacyPolicy) {
+			errs = append(errs, errInvalidIdentifier("TableAddPrivacyPolicy", "PrivacyPolicy"))
+		}
+		if len(opts.AddPrivacyPolicy.EntityKey) == 0 {
+			errs = append(errs, errNotSet("TableAddPrivacyPolicy", "EntityKey"))
+		}
+	}
+	if opts.DropPrivacyPolicy != nil {
+		if !ValidObjectIdentifier(opts.DropPrivacyPolicy.PrivacyPolicy) {
+			errs = append(errs, errInvalidIdentifier("TableDropPrivacyPolicy", "PrivacyPolicy"))
+		}
+	}
+	if opts.AddStorageLifecyclePolicy != nil {
+		if !ValidObjectIdentifier(opts.AddStorageLifecyclePolicy.StorageLifecyclePolicy) {
+			errs = append(errs, errInvalidIdentifier("TableAddStorageLifecyclePolicy", "StorageLifecyclePolicy"))
+		}
+		if len(opts.AddStorageLifecyclePolicy.On) == 0 {
+			errs = append(errs, errNotSet("TableAddStorageLifecyclePolicy", "On"))
+		}
 	}
 	if opts.NewName != nil {
 		if !ValidObjectIdentifier(*opts.NewName) {
@@ -189,11 +239,18 @@ func (opts *alterTableOptions) validate() error {
 			columnAction.Alter,
 			columnAction.SetMaskingPolicy,
 			columnAction.UnsetMaskingPolicy,
+			columnAction.SetProjectionPolicy,
+			columnAction.UnsetProjectionPolicy,
 			columnAction.SetTags,
 			columnAction.UnsetTags,
 			columnAction.DropColumns,
 		); !ok {
-			errs = append(errs, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns"))
+			errs = append(errs, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetProjectionPolicy", "UnsetProjectionPolicy", "SetTags", "UnsetTags", "DropColumns"))
+		}
+		if setProjectionPolicy := columnAction.SetProjectionPolicy; valueSet(setProjectionPolicy) {
+			if !ValidObjectIdentifier(setProjectionPolicy.ProjectionPolicyName) {
+				errs = append(errs, errInvalidIdentifier("TableColumnAlterSetProjectionPolicyAction", "ProjectionPolicyName"))
+			}
 		}
 		for _, alterAction := range columnAction.Alter {
 			if ok := exactlyOneValueSet(
@@ -282,6 +339,17 @@ func (opts *dropTableOptions) validate() error {
 	return errors.Join(errs...)
 }
 
+func (opts *undropTableOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, errInvalidIdentifier("undropTableOptions", "name"))
+	}
+	return errors.Join(errs...)
+}
+
 func (opts *showTableOptions) validate() error {
 	if opts == nil {
 		return errors.Join(ErrNilOptions)
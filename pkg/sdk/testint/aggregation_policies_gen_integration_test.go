@@ -0,0 +1,147 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_AggregationPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	body := "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"
+
+	assertAggregationPolicy := func(t *testing.T, policy *sdk.AggregationPolicy, id sdk.SchemaObjectIdentifier, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, policy.CreatedOn)
+		assert.Equal(t, id.Name(), policy.Name)
+		assert.Equal(t, id.DatabaseName(), policy.DatabaseName)
+		assert.Equal(t, id.SchemaName(), policy.SchemaName)
+		assert.Equal(t, "AGGREGATION_POLICY", policy.Kind)
+		assert.Equal(t, "ACCOUNTADMIN", policy.Owner)
+		assert.Equal(t, comment, policy.Comment)
+		assert.Equal(t, "ROLE", policy.OwnerRoleType)
+	}
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		request := sdk.NewCreateAggregationPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), body)
+
+		policy, cleanup := testClientHelper().AggregationPolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertAggregationPolicy(t, policy, request.GetName(), "")
+	})
+
+	t.Run("create: full", func(t *testing.T) {
+		request := sdk.NewCreateAggregationPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), body).
+			WithOrReplace(true).
+			WithComment("some comment")
+
+		policy, cleanup := testClientHelper().AggregationPolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertAggregationPolicy(t, policy, request.GetName(), "some comment")
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		id, cleanup := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(cleanup)
+
+		err := client.AggregationPolicies.Drop(ctx, sdk.NewDropAggregationPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.AggregationPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.AggregationPolicies.Drop(ctx, sdk.NewDropAggregationPolicyRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id, cleanup := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(cleanup)
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().AggregationPolicy.DropAggregationPolicyFunc(t, newId))
+
+		_, err = client.AggregationPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		policy, err := client.AggregationPolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertAggregationPolicy(t, policy, newId, "")
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		id, cleanup := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(cleanup)
+
+		err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithSetComment("new comment"))
+		require.NoError(t, err)
+
+		policy, err := client.AggregationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", policy.Comment)
+
+		err = client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		policy, err = client.AggregationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, policy.Comment)
+	})
+
+	t.Run("alter: set body", func(t *testing.T) {
+		id, cleanup := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(cleanup)
+
+		err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithSetBody("NO_AGGREGATION_CONSTRAINT()"))
+		require.NoError(t, err)
+
+		description, err := client.AggregationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "NO_AGGREGATION_CONSTRAINT()", description.Body)
+	})
+
+	t.Run("show: with options", func(t *testing.T) {
+		id1, cleanup1 := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(cleanup1)
+		id2, cleanup2 := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(cleanup2)
+
+		policies, err := client.AggregationPolicies.Show(ctx, sdk.NewShowAggregationPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(id1.Name())}).
+			WithIn(sdk.ExtendedIn{In: sdk.In{Schema: testClientHelper().Ids.SchemaId()}}),
+		)
+		require.NoError(t, err)
+		require.Len(t, policies, 1)
+		assert.Equal(t, id1, policies[0].ID())
+		assert.NotEqual(t, id2, policies[0].ID())
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		request := sdk.NewCreateAggregationPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), body)
+		policy, cleanup := testClientHelper().AggregationPolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		description, err := client.AggregationPolicies.Describe(ctx, policy.ID())
+		require.NoError(t, err)
+
+		assert.Equal(t, sdk.AggregationPolicyDescription{
+			Name:       policy.Name,
+			Signature:  "()",
+			ReturnType: "AGGREGATION_CONSTRAINT",
+			Body:       body,
+		}, *description)
+	})
+}
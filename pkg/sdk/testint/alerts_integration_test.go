@@ -4,6 +4,7 @@ package testint
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -226,6 +227,65 @@ func TestInt_AlertCreate(t *testing.T) {
 	})
 }
 
+func TestInt_AlertCreateServerless(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	t.Run("serverless with schedule", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		schedule := "USING CRON * * * * TUE,THU UTC"
+		err := client.Alerts.Create(ctx, id, sdk.AccountObjectIdentifier{}, schedule, "SELECT 1", "SELECT 1", nil)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Alert.DropAlertFunc(t, id))
+
+		alert, err := client.Alerts.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, alert.IsServerless())
+		assert.False(t, alert.IsTriggeredOnNewData())
+		assert.Equal(t, schedule, alert.Schedule)
+	})
+
+	t.Run("serverless on new data", func(t *testing.T) {
+		table, tableCleanup := testClientHelper().Table.Create(t)
+		t.Cleanup(tableCleanup)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		condition := fmt.Sprintf("SELECT * FROM %s", table.ID().FullyQualifiedName())
+		err := client.Alerts.Create(ctx, id, sdk.AccountObjectIdentifier{}, "", condition, "SELECT 1", nil)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Alert.DropAlertFunc(t, id))
+
+		alert, err := client.Alerts.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, alert.IsServerless())
+		assert.True(t, alert.IsTriggeredOnNewData())
+		assert.Equal(t, condition, alert.Condition)
+
+		alertDetails, err := client.Alerts.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, alertDetails.Warehouse)
+		assert.Empty(t, alertDetails.Schedule)
+	})
+}
+
+func TestInt_AlertExecute(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	t.Run("when alert exists", func(t *testing.T) {
+		alert, alertCleanup := testClientHelper().Alert.CreateAlert(t)
+		t.Cleanup(alertCleanup)
+
+		err := client.Alerts.Execute(ctx, alert.ID())
+		require.NoError(t, err)
+	})
+
+	t.Run("when alert does not exist", func(t *testing.T) {
+		err := client.Alerts.Execute(ctx, NonExistingSchemaObjectIdentifier)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
+
 func TestInt_AlertDescribe(t *testing.T) {
 	client := testClient(t)
 	ctx := testContext(t)
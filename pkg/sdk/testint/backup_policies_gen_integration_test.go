@@ -0,0 +1,133 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_BackupPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	schedule := "USING CRON 0 3 * * * UTC"
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		backupPolicy, cleanup := testClientHelper().BackupPolicy.CreateWithRequest(t, sdk.NewCreateBackupPolicyRequest(id))
+		t.Cleanup(cleanup)
+
+		assertThatObject(t, objectassert.BackupPolicyFromObject(t, backupPolicy).
+			HasName(id.Name()).
+			HasDatabaseName(id.DatabaseName()).
+			HasSchemaName(id.SchemaName()).
+			HasComment("").
+			HasHasRetentionLock(false).
+			HasOwner(snowflakeroles.Accountadmin.Name()).
+			HasOwnerRoleType("ROLE"),
+		)
+		assert.NotEmpty(t, backupPolicy.CreatedOn)
+	})
+
+	t.Run("create: full", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		comment := random.Comment()
+		request := sdk.NewCreateBackupPolicyRequest(id).
+			WithIfNotExists(true).
+			WithSchedule(schedule).
+			WithExpireAfterDays(7).
+			WithComment(comment)
+
+		backupPolicy, cleanup := testClientHelper().BackupPolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertThatObject(t, objectassert.BackupPolicyFromObject(t, backupPolicy).
+			HasName(id.Name()).
+			HasSchedule(schedule).
+			HasExpireAfterDays(7).
+			HasComment(comment).
+			HasHasRetentionLock(false),
+		)
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		backupPolicy, cleanup := testClientHelper().BackupPolicy.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.BackupPolicies.Drop(ctx, sdk.NewDropBackupPolicyRequest(backupPolicy.ID()))
+		require.NoError(t, err)
+
+		_, err = client.BackupPolicies.ShowByID(ctx, backupPolicy.ID())
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.BackupPolicies.Drop(ctx, sdk.NewDropBackupPolicyRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		backupPolicy, cleanup := testClientHelper().BackupPolicy.Create(t)
+		t.Cleanup(cleanup)
+		comment := random.Comment()
+
+		err := client.BackupPolicies.Alter(ctx, sdk.NewAlterBackupPolicyRequest(backupPolicy.ID()).WithSet(*sdk.NewBackupPolicySetRequest().
+			WithSchedule(schedule).
+			WithExpireAfterDays(30).
+			WithComment(comment),
+		))
+		require.NoError(t, err)
+
+		assertThatObject(t, objectassert.BackupPolicy(t, backupPolicy.ID()).
+			HasSchedule(schedule).
+			HasExpireAfterDays(30).
+			HasComment(comment),
+		)
+
+		err = client.BackupPolicies.Alter(ctx, sdk.NewAlterBackupPolicyRequest(backupPolicy.ID()).WithUnset(*sdk.NewBackupPolicyUnsetRequest().
+			WithSchedule(true).
+			WithComment(true),
+		))
+		require.NoError(t, err)
+
+		assertThatObject(t, objectassert.BackupPolicy(t, backupPolicy.ID()).
+			HasComment(""),
+		)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		backupPolicy, cleanup := testClientHelper().BackupPolicy.Create(t)
+		t.Cleanup(cleanup)
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.BackupPolicies.Alter(ctx, sdk.NewAlterBackupPolicyRequest(backupPolicy.ID()).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().BackupPolicy.DropFunc(t, newId))
+
+		_, err = client.BackupPolicies.ShowByID(ctx, backupPolicy.ID())
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+
+		assertThatObject(t, objectassert.BackupPolicy(t, newId).HasName(newId.Name()))
+	})
+
+	t.Run("show: with options", func(t *testing.T) {
+		backupPolicy, cleanup := testClientHelper().BackupPolicy.Create(t)
+		t.Cleanup(cleanup)
+
+		backupPolicies, err := client.BackupPolicies.Show(ctx, sdk.NewShowBackupPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(backupPolicy.ID().Name())}).
+			WithIn(sdk.In{Schema: backupPolicy.ID().SchemaId()}),
+		)
+		require.NoError(t, err)
+		require.Len(t, backupPolicies, 1)
+		assert.Equal(t, *backupPolicy, backupPolicies[0])
+	})
+}
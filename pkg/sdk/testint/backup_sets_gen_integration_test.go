@@ -0,0 +1,184 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_BackupSets(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	table, tableCleanup := testClientHelper().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	backupPolicy, backupPolicyCleanup := testClientHelper().BackupPolicy.Create(t)
+	t.Cleanup(backupPolicyCleanup)
+
+	t.Run("create: for table, no optionals", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		backupSet, cleanup := testClientHelper().BackupSet.CreateWithRequest(t, sdk.NewCreateBackupSetRequest(id).WithForTable(table.ID()))
+		t.Cleanup(cleanup)
+
+		assertThatObject(t, objectassert.BackupSetFromObject(t, backupSet).
+			HasName(id.Name()).
+			HasDatabaseName(id.DatabaseName()).
+			HasSchemaName(id.SchemaName()).
+			HasObjectKind(string(sdk.ObjectTypeTable)).
+			HasObjectName(table.ID().Name()).
+			HasObjectDatabaseName(table.ID().DatabaseName()).
+			HasObjectSchemaName(table.ID().SchemaName()).
+			HasComment("").
+			HasOwner(snowflakeroles.Accountadmin.Name()).
+			HasOwnerRoleType("ROLE"),
+		)
+		assert.Nil(t, backupSet.BackupPolicyName)
+		assert.NotEmpty(t, backupSet.CreatedOn)
+	})
+
+	t.Run("create: for schema, full", func(t *testing.T) {
+		schema, schemaCleanup := testClientHelper().Schema.CreateSchema(t)
+		t.Cleanup(schemaCleanup)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		comment := random.Comment()
+		request := sdk.NewCreateBackupSetRequest(id).
+			WithIfNotExists(true).
+			WithForSchema(schema.ID()).
+			WithBackupPolicy(backupPolicy.ID()).
+			WithComment(comment)
+
+		backupSet, cleanup := testClientHelper().BackupSet.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertThatObject(t, objectassert.BackupSetFromObject(t, backupSet).
+			HasName(id.Name()).
+			HasObjectKind(string(sdk.ObjectTypeSchema)).
+			HasObjectName(schema.ID().Name()).
+			HasObjectDatabaseName(schema.ID().DatabaseName()).
+			HasBackupPolicyName(backupPolicy.ID().Name()).
+			HasBackupPolicyDatabaseName(backupPolicy.ID().DatabaseName()).
+			HasBackupPolicySchemaName(backupPolicy.ID().SchemaName()).
+			HasComment(comment),
+		)
+	})
+
+	t.Run("create: for database", func(t *testing.T) {
+		database, databaseCleanup := testClientHelper().Database.CreateDatabase(t)
+		t.Cleanup(databaseCleanup)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		backupSet, cleanup := testClientHelper().BackupSet.CreateWithRequest(t, sdk.NewCreateBackupSetRequest(id).WithForDatabase(database.ID()))
+		t.Cleanup(cleanup)
+
+		assertThatObject(t, objectassert.BackupSetFromObject(t, backupSet).
+			HasObjectKind(string(sdk.ObjectTypeDatabase)).
+			HasObjectName(database.ID().Name()),
+		)
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		backupSet, cleanup := testClientHelper().BackupSet.CreateForTable(t, table.ID())
+		t.Cleanup(cleanup)
+
+		err := client.BackupSets.Drop(ctx, sdk.NewDropBackupSetRequest(backupSet.ID()))
+		require.NoError(t, err)
+
+		_, err = client.BackupSets.ShowByID(ctx, backupSet.ID())
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.BackupSets.Drop(ctx, sdk.NewDropBackupSetRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		backupSet, cleanup := testClientHelper().BackupSet.CreateForTable(t, table.ID())
+		t.Cleanup(cleanup)
+		comment := random.Comment()
+
+		err := client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(backupSet.ID()).WithSetComment(comment))
+		require.NoError(t, err)
+
+		assertThatObject(t, objectassert.BackupSet(t, backupSet.ID()).HasComment(comment))
+
+		err = client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(backupSet.ID()).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		assertThatObject(t, objectassert.BackupSet(t, backupSet.ID()).HasComment(""))
+	})
+
+	t.Run("alter: apply, suspend and resume backup policy", func(t *testing.T) {
+		backupSet, cleanup := testClientHelper().BackupSet.CreateForTable(t, table.ID())
+		t.Cleanup(cleanup)
+
+		err := client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(backupSet.ID()).WithApplyBackupPolicy(backupPolicy.ID()))
+		require.NoError(t, err)
+
+		assertThatObject(t, objectassert.BackupSet(t, backupSet.ID()).HasBackupPolicyName(backupPolicy.ID().Name()))
+
+		err = client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(backupSet.ID()).WithSuspendBackupPolicy(true))
+		require.NoError(t, err)
+
+		err = client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(backupSet.ID()).WithResumeBackupPolicy(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("alter: add and delete backup", func(t *testing.T) {
+		backupSet, cleanup := testClientHelper().BackupSet.CreateForTable(t, table.ID())
+		t.Cleanup(cleanup)
+
+		backup := testClientHelper().BackupSet.AddBackup(t, backupSet.ID())
+		assert.NotEmpty(t, backup.BackupId)
+		assert.Equal(t, backupSet.ID().Name(), backup.BackupSetName)
+		assert.Equal(t, backupSet.ID().DatabaseName(), backup.DatabaseName)
+		assert.Equal(t, backupSet.ID().SchemaName(), backup.SchemaName)
+		assert.False(t, backup.IsUnderLegalHold)
+
+		err := client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(backupSet.ID()).WithDeleteBackupIdentifier(backup.BackupId))
+		require.NoError(t, err)
+
+		backups, err := client.BackupSets.ShowBackups(ctx, sdk.NewShowBackupsBackupSetRequest(backupSet.ID()))
+		require.NoError(t, err)
+		assert.Empty(t, backups)
+	})
+
+	t.Run("show: with options", func(t *testing.T) {
+		backupSet, cleanup := testClientHelper().BackupSet.CreateForTable(t, table.ID())
+		t.Cleanup(cleanup)
+
+		backupSets, err := client.BackupSets.Show(ctx, sdk.NewShowBackupSetRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(backupSet.ID().Name())}).
+			WithIn(sdk.In{Schema: backupSet.ID().SchemaId()}),
+		)
+		require.NoError(t, err)
+		require.Len(t, backupSets, 1)
+		assert.Equal(t, *backupSet, backupSets[0])
+	})
+
+	t.Run("restore table from backup", func(t *testing.T) {
+		backupSet, cleanup := testClientHelper().BackupSet.CreateForTable(t, table.ID())
+		t.Cleanup(cleanup)
+
+		backup := testClientHelper().BackupSet.AddBackup(t, backupSet.ID())
+		restoredTableId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.BackupSets.Restore(ctx, sdk.NewRestoreBackupSetRequest(backupSet.ID(), backup.BackupId).WithTable(restoredTableId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Table.DropFunc(t, restoredTableId))
+
+		_, err = client.Tables.ShowByID(ctx, restoredTableId)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,150 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ClassificationProfiles(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+	t.Cleanup(tagCleanup)
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		classificationProfile, cleanup := testClientHelper().ClassificationProfile.CreateWithRequest(t, sdk.NewCreateClassificationProfileRequestWithConfig(id, sdk.ClassificationProfileConfig{}))
+		t.Cleanup(cleanup)
+
+		assertThatObject(t, objectassert.ClassificationProfileFromObject(t, classificationProfile).
+			HasName(id.Name()).
+			HasDatabaseName(id.DatabaseName()).
+			HasSchemaName(id.SchemaName()),
+		)
+		assert.NotEmpty(t, classificationProfile.CreatedOn)
+	})
+
+	t.Run("create: full", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		request := sdk.NewCreateClassificationProfileRequestWithConfig(id, sdk.ClassificationProfileConfig{
+			MinimumObjectAgeForClassificationDays: sdk.Int(1),
+			MaximumClassificationValidityDays:     sdk.Int(30),
+			AutoTag:                               sdk.Bool(true),
+			TagMap: &sdk.ClassificationProfileTagMap{
+				ColumnTagMap: []sdk.ClassificationProfileColumnTagMapEntry{
+					{TagName: tag.ID(), TagValue: sdk.String("pii"), SemanticCategories: []string{"NAME"}},
+				},
+			},
+		}).WithIfNotExists(true)
+
+		classificationProfile, cleanup := testClientHelper().ClassificationProfile.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertThatObject(t, objectassert.ClassificationProfileFromObject(t, classificationProfile).
+			HasName(id.Name()),
+		)
+
+		details := testClientHelper().ClassificationProfile.DescribeDetails(t, id)
+		assert.Equal(t, sdk.Int(1), details.MinimumObjectAgeForClassificationDays)
+		assert.Equal(t, sdk.Int(30), details.MaximumClassificationValidityDays)
+		assert.Equal(t, sdk.Bool(true), details.AutoTag)
+		require.NotNil(t, details.TagMap)
+		require.Len(t, details.TagMap.ColumnTagMap, 1)
+		assert.Equal(t, sdk.String("pii"), details.TagMap.ColumnTagMap[0].TagValue)
+		assert.Equal(t, []string{"NAME"}, details.TagMap.ColumnTagMap[0].SemanticCategories)
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		classificationProfile, cleanup := testClientHelper().ClassificationProfile.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.ClassificationProfiles.Drop(ctx, sdk.NewDropClassificationProfileRequest(classificationProfile.ID()))
+		require.NoError(t, err)
+
+		_, err = client.ClassificationProfiles.ShowByID(ctx, classificationProfile.ID())
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.ClassificationProfiles.Drop(ctx, sdk.NewDropClassificationProfileRequest(NonExistingSchemaObjectIdentifier))
+		assert.Error(t, err)
+	})
+
+	t.Run("set and unset methods", func(t *testing.T) {
+		classificationProfile, cleanup := testClientHelper().ClassificationProfile.Create(t)
+		t.Cleanup(cleanup)
+		id := classificationProfile.ID()
+
+		_, err := client.ClassificationProfiles.SetMinimumObjectAgeForClassificationDays(ctx, sdk.NewSetMinimumObjectAgeForClassificationDaysClassificationProfileRequest(id, *sdk.NewClassificationProfileSetMinimumObjectAgeForClassificationDaysArgsRequest(2)))
+		require.NoError(t, err)
+		_, err = client.ClassificationProfiles.SetMaximumClassificationValidityDays(ctx, sdk.NewSetMaximumClassificationValidityDaysClassificationProfileRequest(id, *sdk.NewClassificationProfileSetMaximumClassificationValidityDaysArgsRequest(60)))
+		require.NoError(t, err)
+		_, err = client.ClassificationProfiles.SetAutoTag(ctx, sdk.NewSetAutoTagClassificationProfileRequest(id, *sdk.NewClassificationProfileSetAutoTagArgsRequest(true)))
+		require.NoError(t, err)
+		tagMap := sdk.ClassificationProfileTagMap{
+			ColumnTagMap: []sdk.ClassificationProfileColumnTagMapEntry{
+				{TagName: tag.ID(), SemanticCategories: []string{"EMAIL"}},
+			},
+		}
+		_, err = client.ClassificationProfiles.SetTagMap(ctx, sdk.NewSetTagMapClassificationProfileRequest(id, *sdk.NewClassificationProfileSetTagMapArgsRequest(tagMap.ToSql())))
+		require.NoError(t, err)
+
+		details := testClientHelper().ClassificationProfile.DescribeDetails(t, id)
+		assert.Equal(t, sdk.Int(2), details.MinimumObjectAgeForClassificationDays)
+		assert.Equal(t, sdk.Int(60), details.MaximumClassificationValidityDays)
+		assert.Equal(t, sdk.Bool(true), details.AutoTag)
+		require.NotNil(t, details.TagMap)
+		require.Len(t, details.TagMap.ColumnTagMap, 1)
+		assert.Equal(t, []string{"EMAIL"}, details.TagMap.ColumnTagMap[0].SemanticCategories)
+
+		_, err = client.ClassificationProfiles.UnsetTagMap(ctx, sdk.NewUnsetTagMapClassificationProfileRequest(id))
+		require.NoError(t, err)
+		_, err = client.ClassificationProfiles.UnsetCustomClassifiers(ctx, sdk.NewUnsetCustomClassifiersClassificationProfileRequest(id))
+		require.NoError(t, err)
+
+		details = testClientHelper().ClassificationProfile.DescribeDetails(t, id)
+		assert.True(t, details.TagMap == nil || len(details.TagMap.ColumnTagMap) == 0)
+	})
+
+	t.Run("show: with options", func(t *testing.T) {
+		classificationProfile, cleanup := testClientHelper().ClassificationProfile.Create(t)
+		t.Cleanup(cleanup)
+
+		classificationProfiles, err := client.ClassificationProfiles.Show(ctx, sdk.NewShowClassificationProfileRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(classificationProfile.ID().Name())}).
+			WithIn(sdk.In{Schema: classificationProfile.ID().SchemaId()}),
+		)
+		require.NoError(t, err)
+		require.Len(t, classificationProfiles, 1)
+		assert.Equal(t, *classificationProfile, classificationProfiles[0])
+	})
+
+	t.Run("set and unset on database and schema", func(t *testing.T) {
+		classificationProfile, cleanup := testClientHelper().ClassificationProfile.Create(t)
+		t.Cleanup(cleanup)
+		classificationProfileId := classificationProfile.ID()
+
+		database, databaseCleanup := testClientHelper().Database.CreateDatabase(t)
+		t.Cleanup(databaseCleanup)
+		schema, schemaCleanup := testClientHelper().Schema.CreateSchemaInDatabase(t, database.ID())
+		t.Cleanup(schemaCleanup)
+
+		err := client.Databases.Alter(ctx, database.ID(), &sdk.AlterDatabaseOptions{Set: &sdk.DatabaseSet{ClassificationProfile: &classificationProfileId}})
+		require.NoError(t, err)
+		err = client.Schemas.Alter(ctx, schema.ID(), &sdk.AlterSchemaOptions{Set: &sdk.SchemaSet{ClassificationProfile: &classificationProfileId}})
+		require.NoError(t, err)
+
+		err = client.Schemas.Alter(ctx, schema.ID(), &sdk.AlterSchemaOptions{Unset: &sdk.SchemaUnset{ClassificationProfile: sdk.Bool(true)}})
+		require.NoError(t, err)
+		err = client.Databases.Alter(ctx, database.ID(), &sdk.AlterDatabaseOptions{Unset: &sdk.DatabaseUnset{ClassificationProfile: sdk.Bool(true)}})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,177 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_DbtProjects(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	stage, stageCleanup := testClientHelper().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+
+	testClientHelper().DbtProject.PutSampleProjectOnStage(t, stage.Location()+"/v1")
+	testClientHelper().DbtProject.PutSampleProjectOnStage(t, stage.Location()+"/v2")
+
+	locationV1 := sdk.NewStageLocation(stage.ID(), "v1")
+	locationV2 := sdk.NewStageLocation(stage.ID(), "v2")
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		request := sdk.NewCreateDbtProjectRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier()).
+			WithFrom(locationV1)
+
+		dbtProject, cleanup := testClientHelper().DbtProject.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertThatObject(t, objectassert.DbtProjectFromObject(t, dbtProject).
+			HasName(request.GetName().Name()).
+			HasDatabaseName(request.GetName().DatabaseName()).
+			HasSchemaName(request.GetName().SchemaName()).
+			HasOwner("ACCOUNTADMIN").
+			HasComment("").
+			HasDefaultArgs("").
+			HasDefaultTarget("").
+			HasExternalAccessIntegrations("").
+			HasOwnerRoleType("ROLE"),
+		)
+
+		versions := testClientHelper().DbtProject.ShowVersions(t, dbtProject.ID())
+		require.Len(t, versions, 1)
+		assert.Equal(t, "VERSION$1", versions[0].Name)
+	})
+
+	t.Run("create: full", func(t *testing.T) {
+		request := sdk.NewCreateDbtProjectRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier()).
+			WithOrReplace(true).
+			WithFrom(locationV1).
+			WithDefaultArgs("--select sample_model").
+			WithDefaultTarget("prod").
+			WithDefaultVersion("LAST").
+			WithComment("some comment")
+
+		dbtProject, cleanup := testClientHelper().DbtProject.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertThatObject(t, objectassert.DbtProjectFromObject(t, dbtProject).
+			HasName(request.GetName().Name()).
+			HasComment("some comment").
+			HasDefaultArgs("--select sample_model").
+			HasDefaultTarget("prod").
+			HasDefaultVersion("LAST"),
+		)
+
+		description, err := client.DbtProjects.Describe(ctx, dbtProject.ID())
+		require.NoError(t, err)
+		assert.Equal(t, dbtProject.Name, description.Name)
+		assert.Equal(t, dbtProject.Comment, description.Comment)
+	})
+
+	t.Run("alter: add version", func(t *testing.T) {
+		dbtProject, cleanup := testClientHelper().DbtProject.Create(t, locationV1)
+		t.Cleanup(cleanup)
+
+		err := client.DbtProjects.Alter(ctx, sdk.NewAlterDbtProjectRequest(dbtProject.ID()).
+			WithAddVersion(*sdk.NewAddDbtProjectVersionRequest(locationV2).WithVersionAlias("second")),
+		)
+		require.NoError(t, err)
+
+		versions := testClientHelper().DbtProject.ShowVersions(t, dbtProject.ID())
+		require.Len(t, versions, 2)
+		aliases := collections.Map(versions, func(v sdk.DbtProjectVersion) string {
+			if v.Alias == nil {
+				return ""
+			}
+			return *v.Alias
+		})
+		assert.Contains(t, aliases, "second")
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		dbtProject, cleanup := testClientHelper().DbtProject.Create(t, locationV1)
+		t.Cleanup(cleanup)
+
+		err := client.DbtProjects.Alter(ctx, sdk.NewAlterDbtProjectRequest(dbtProject.ID()).WithSet(*sdk.NewDbtProjectSetRequest().
+			WithDefaultArgs("--select sample_model").
+			WithDefaultTarget("prod").
+			WithComment("new comment"),
+		))
+		require.NoError(t, err)
+
+		assertThatObject(t, objectassert.DbtProject(t, dbtProject.ID()).
+			HasDefaultArgs("--select sample_model").
+			HasDefaultTarget("prod").
+			HasComment("new comment"),
+		)
+
+		err = client.DbtProjects.Alter(ctx, sdk.NewAlterDbtProjectRequest(dbtProject.ID()).WithUnset(*sdk.NewDbtProjectUnsetRequest().
+			WithDefaultArgs(true).
+			WithDefaultTarget(true).
+			WithComment(true),
+		))
+		require.NoError(t, err)
+
+		assertThatObject(t, objectassert.DbtProject(t, dbtProject.ID()).
+			HasDefaultArgs("").
+			HasDefaultTarget("").
+			HasComment(""),
+		)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		dbtProject, cleanup := testClientHelper().DbtProject.Create(t, locationV1)
+		t.Cleanup(cleanup)
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		t.Cleanup(testClientHelper().DbtProject.DropFunc(t, newId))
+
+		err := client.DbtProjects.Alter(ctx, sdk.NewAlterDbtProjectRequest(dbtProject.ID()).WithRenameTo(newId))
+		require.NoError(t, err)
+
+		_, err = client.DbtProjects.ShowByID(ctx, dbtProject.ID())
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		renamed, err := client.DbtProjects.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assert.Equal(t, newId.Name(), renamed.Name)
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		dbtProject, cleanup := testClientHelper().DbtProject.Create(t, locationV1)
+		t.Cleanup(cleanup)
+
+		err := client.DbtProjects.Drop(ctx, sdk.NewDropDbtProjectRequest(dbtProject.ID()))
+		require.NoError(t, err)
+
+		_, err = client.DbtProjects.ShowByID(ctx, dbtProject.ID())
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.DbtProjects.Drop(ctx, sdk.NewDropDbtProjectRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("show: with like", func(t *testing.T) {
+		dbtProject1, cleanup1 := testClientHelper().DbtProject.Create(t, locationV1)
+		t.Cleanup(cleanup1)
+		dbtProject2, cleanup2 := testClientHelper().DbtProject.Create(t, locationV1)
+		t.Cleanup(cleanup2)
+
+		dbtProjects, err := client.DbtProjects.Show(ctx, sdk.NewShowDbtProjectRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(dbtProject1.Name)}).
+			WithIn(sdk.In{Schema: dbtProject1.ID().SchemaId()}),
+		)
+		require.NoError(t, err)
+		require.Len(t, dbtProjects, 1)
+		assert.Equal(t, dbtProject1.ID(), dbtProjects[0].ID())
+		assert.NotEqual(t, dbtProject2.ID(), dbtProjects[0].ID())
+	})
+}
@@ -0,0 +1,147 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_JoinPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	body := "JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)"
+
+	assertJoinPolicy := func(t *testing.T, policy *sdk.JoinPolicy, id sdk.SchemaObjectIdentifier, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, policy.CreatedOn)
+		assert.Equal(t, id.Name(), policy.Name)
+		assert.Equal(t, id.DatabaseName(), policy.DatabaseName)
+		assert.Equal(t, id.SchemaName(), policy.SchemaName)
+		assert.Equal(t, "JOIN_POLICY", policy.Kind)
+		assert.Equal(t, "ACCOUNTADMIN", policy.Owner)
+		assert.Equal(t, comment, policy.Comment)
+		assert.Equal(t, "ROLE", policy.OwnerRoleType)
+	}
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		request := sdk.NewCreateJoinPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), body)
+
+		policy, cleanup := testClientHelper().JoinPolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertJoinPolicy(t, policy, request.GetName(), "")
+	})
+
+	t.Run("create: full", func(t *testing.T) {
+		request := sdk.NewCreateJoinPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), body).
+			WithOrReplace(true).
+			WithComment("some comment")
+
+		policy, cleanup := testClientHelper().JoinPolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertJoinPolicy(t, policy, request.GetName(), "some comment")
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		id, cleanup := testClientHelper().JoinPolicy.CreateJoinPolicy(t)
+		t.Cleanup(cleanup)
+
+		err := client.JoinPolicies.Drop(ctx, sdk.NewDropJoinPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.JoinPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.JoinPolicies.Drop(ctx, sdk.NewDropJoinPolicyRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id, cleanup := testClientHelper().JoinPolicy.CreateJoinPolicy(t)
+		t.Cleanup(cleanup)
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().JoinPolicy.DropJoinPolicyFunc(t, newId))
+
+		_, err = client.JoinPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		policy, err := client.JoinPolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertJoinPolicy(t, policy, newId, "")
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		id, cleanup := testClientHelper().JoinPolicy.CreateJoinPolicy(t)
+		t.Cleanup(cleanup)
+
+		err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithSetComment("new comment"))
+		require.NoError(t, err)
+
+		policy, err := client.JoinPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", policy.Comment)
+
+		err = client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		policy, err = client.JoinPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, policy.Comment)
+	})
+
+	t.Run("alter: set body", func(t *testing.T) {
+		id, cleanup := testClientHelper().JoinPolicy.CreateJoinPolicy(t)
+		t.Cleanup(cleanup)
+
+		err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithSetBody("JOIN_CONSTRAINT(JOIN_REQUIRED => FALSE)"))
+		require.NoError(t, err)
+
+		description, err := client.JoinPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "JOIN_CONSTRAINT(JOIN_REQUIRED => FALSE)", description.Body)
+	})
+
+	t.Run("show: with options", func(t *testing.T) {
+		id1, cleanup1 := testClientHelper().JoinPolicy.CreateJoinPolicy(t)
+		t.Cleanup(cleanup1)
+		id2, cleanup2 := testClientHelper().JoinPolicy.CreateJoinPolicy(t)
+		t.Cleanup(cleanup2)
+
+		policies, err := client.JoinPolicies.Show(ctx, sdk.NewShowJoinPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(id1.Name())}).
+			WithIn(sdk.ExtendedIn{In: sdk.In{Schema: testClientHelper().Ids.SchemaId()}}),
+		)
+		require.NoError(t, err)
+		require.Len(t, policies, 1)
+		assert.Equal(t, id1, policies[0].ID())
+		assert.NotEqual(t, id2, policies[0].ID())
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		request := sdk.NewCreateJoinPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), body)
+		policy, cleanup := testClientHelper().JoinPolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		description, err := client.JoinPolicies.Describe(ctx, policy.ID())
+		require.NoError(t, err)
+
+		assert.Equal(t, sdk.JoinPolicyDescription{
+			Name:       policy.Name,
+			Signature:  "()",
+			ReturnType: "JOIN_CONSTRAINT",
+			Body:       body,
+		}, *description)
+	})
+}
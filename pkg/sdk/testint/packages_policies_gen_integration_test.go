@@ -0,0 +1,137 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_PackagesPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	assertPackagesPolicy := func(t *testing.T, policy *sdk.PackagesPolicy, id sdk.SchemaObjectIdentifier, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, policy.CreatedOn)
+		assert.Equal(t, id.Name(), policy.Name)
+		assert.Equal(t, id.DatabaseName(), policy.DatabaseName)
+		assert.Equal(t, id.SchemaName(), policy.SchemaName)
+		assert.Equal(t, "PACKAGES_POLICY", policy.Kind)
+		assert.Equal(t, "ACCOUNTADMIN", policy.Owner)
+		assert.Equal(t, comment, policy.Comment)
+		assert.Equal(t, "ROLE", policy.OwnerRoleType)
+	}
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		request := sdk.NewCreatePackagesPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), sdk.PackagesPolicyLanguagePython)
+
+		policy, cleanup := testClientHelper().PackagesPolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertPackagesPolicy(t, policy, request.GetName(), "")
+
+		description, err := client.PackagesPolicies.Describe(ctx, policy.ID())
+		require.NoError(t, err)
+		assert.Equal(t, string(sdk.PackagesPolicyLanguagePython), description.Language)
+		assert.Equal(t, []string{"*"}, sdk.ParseCommaSeparatedStringArray(description.Allowlist, true))
+		assert.Empty(t, sdk.ParseCommaSeparatedStringArray(description.Blocklist, true))
+		assert.Empty(t, sdk.ParseCommaSeparatedStringArray(description.AdditionalCreationBlocklist, true))
+	})
+
+	t.Run("create: full", func(t *testing.T) {
+		request := sdk.NewCreatePackagesPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), sdk.PackagesPolicyLanguagePython).
+			WithOrReplace(true).
+			WithAllowlist([]sdk.StringListItemWrapper{{Value: "numpy"}, {Value: "pandas"}}).
+			WithBlocklist([]sdk.StringListItemWrapper{{Value: "scipy"}}).
+			WithAdditionalCreationBlocklist([]sdk.StringListItemWrapper{{Value: "requests"}}).
+			WithComment("some comment")
+
+		policy, cleanup := testClientHelper().PackagesPolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertPackagesPolicy(t, policy, request.GetName(), "some comment")
+
+		description, err := client.PackagesPolicies.Describe(ctx, policy.ID())
+		require.NoError(t, err)
+		assert.Equal(t, policy.Name, description.Name)
+		assert.ElementsMatch(t, []string{"numpy", "pandas"}, sdk.ParseCommaSeparatedStringArray(description.Allowlist, true))
+		assert.Equal(t, []string{"scipy"}, sdk.ParseCommaSeparatedStringArray(description.Blocklist, true))
+		assert.Equal(t, []string{"requests"}, sdk.ParseCommaSeparatedStringArray(description.AdditionalCreationBlocklist, true))
+		assert.Equal(t, "some comment", description.Comment)
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		id, cleanup := testClientHelper().PackagesPolicy.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.PackagesPolicies.Drop(ctx, sdk.NewDropPackagesPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.PackagesPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.PackagesPolicies.Drop(ctx, sdk.NewDropPackagesPolicyRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		id, cleanup := testClientHelper().PackagesPolicy.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithSet(*sdk.NewPackagesPolicySetRequest().
+			WithAllowlist([]sdk.StringListItemWrapper{{Value: "numpy"}}).
+			WithBlocklist([]sdk.StringListItemWrapper{{Value: "scipy"}}).
+			WithAdditionalCreationBlocklist([]sdk.StringListItemWrapper{{Value: "requests"}}).
+			WithComment("new comment"),
+		))
+		require.NoError(t, err)
+
+		description, err := client.PackagesPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"numpy"}, sdk.ParseCommaSeparatedStringArray(description.Allowlist, true))
+		assert.Equal(t, []string{"scipy"}, sdk.ParseCommaSeparatedStringArray(description.Blocklist, true))
+		assert.Equal(t, []string{"requests"}, sdk.ParseCommaSeparatedStringArray(description.AdditionalCreationBlocklist, true))
+		assert.Equal(t, "new comment", description.Comment)
+
+		err = client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithUnset(*sdk.NewPackagesPolicyUnsetRequest().
+			WithAllowlist(true).
+			WithBlocklist(true).
+			WithAdditionalCreationBlocklist(true).
+			WithComment(true),
+		))
+		require.NoError(t, err)
+
+		description, err = client.PackagesPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"*"}, sdk.ParseCommaSeparatedStringArray(description.Allowlist, true))
+		assert.Empty(t, sdk.ParseCommaSeparatedStringArray(description.Blocklist, true))
+		assert.Empty(t, sdk.ParseCommaSeparatedStringArray(description.AdditionalCreationBlocklist, true))
+		assert.Empty(t, description.Comment)
+	})
+
+	t.Run("show: in schema", func(t *testing.T) {
+		schema, schemaCleanup := testClientHelper().Schema.CreateSchema(t)
+		t.Cleanup(schemaCleanup)
+
+		id1 := testClientHelper().Ids.RandomSchemaObjectIdentifierInSchema(schema.ID())
+		_, cleanup1 := testClientHelper().PackagesPolicy.CreateWithRequest(t, sdk.NewCreatePackagesPolicyRequest(id1, sdk.PackagesPolicyLanguagePython))
+		t.Cleanup(cleanup1)
+		id2, cleanup2 := testClientHelper().PackagesPolicy.Create(t)
+		t.Cleanup(cleanup2)
+
+		policies, err := client.PackagesPolicies.Show(ctx, sdk.NewShowPackagesPolicyRequest().
+			WithIn(sdk.In{Schema: schema.ID()}),
+		)
+		require.NoError(t, err)
+		require.Len(t, policies, 1)
+		assert.Equal(t, id1, policies[0].ID())
+		assert.NotEqual(t, id2, policies[0].ID())
+	})
+}
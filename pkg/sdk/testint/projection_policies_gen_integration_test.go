@@ -0,0 +1,147 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ProjectionPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	body := "PROJECTION_CONSTRAINT(ALLOW => false)"
+
+	assertProjectionPolicy := func(t *testing.T, policy *sdk.ProjectionPolicy, id sdk.SchemaObjectIdentifier, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, policy.CreatedOn)
+		assert.Equal(t, id.Name(), policy.Name)
+		assert.Equal(t, id.DatabaseName(), policy.DatabaseName)
+		assert.Equal(t, id.SchemaName(), policy.SchemaName)
+		assert.Equal(t, "PROJECTION_POLICY", policy.Kind)
+		assert.Equal(t, "ACCOUNTADMIN", policy.Owner)
+		assert.Equal(t, comment, policy.Comment)
+		assert.Equal(t, "ROLE", policy.OwnerRoleType)
+	}
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		request := sdk.NewCreateProjectionPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), body)
+
+		policy, cleanup := testClientHelper().ProjectionPolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertProjectionPolicy(t, policy, request.GetName(), "")
+	})
+
+	t.Run("create: full", func(t *testing.T) {
+		request := sdk.NewCreateProjectionPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), body).
+			WithOrReplace(true).
+			WithComment("some comment")
+
+		policy, cleanup := testClientHelper().ProjectionPolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertProjectionPolicy(t, policy, request.GetName(), "some comment")
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		id, cleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup)
+
+		err := client.ProjectionPolicies.Drop(ctx, sdk.NewDropProjectionPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.ProjectionPolicies.Drop(ctx, sdk.NewDropProjectionPolicyRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id, cleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup)
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ProjectionPolicy.DropProjectionPolicyFunc(t, newId))
+
+		_, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		policy, err := client.ProjectionPolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertProjectionPolicy(t, policy, newId, "")
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		id, cleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup)
+
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSetComment("new comment"))
+		require.NoError(t, err)
+
+		policy, err := client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", policy.Comment)
+
+		err = client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		policy, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, policy.Comment)
+	})
+
+	t.Run("alter: set body", func(t *testing.T) {
+		id, cleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup)
+
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSetBody("PROJECTION_CONSTRAINT(ALLOW => true)"))
+		require.NoError(t, err)
+
+		description, err := client.ProjectionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "PROJECTION_CONSTRAINT(ALLOW => true)", description.Body)
+	})
+
+	t.Run("show: with options", func(t *testing.T) {
+		id1, cleanup1 := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup1)
+		id2, cleanup2 := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup2)
+
+		policies, err := client.ProjectionPolicies.Show(ctx, sdk.NewShowProjectionPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(id1.Name())}).
+			WithIn(sdk.ExtendedIn{In: sdk.In{Schema: testClientHelper().Ids.SchemaId()}}),
+		)
+		require.NoError(t, err)
+		require.Len(t, policies, 1)
+		assert.Equal(t, id1, policies[0].ID())
+		assert.NotEqual(t, id2, policies[0].ID())
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		request := sdk.NewCreateProjectionPolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), body)
+		policy, cleanup := testClientHelper().ProjectionPolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		description, err := client.ProjectionPolicies.Describe(ctx, policy.ID())
+		require.NoError(t, err)
+
+		assert.Equal(t, sdk.ProjectionPolicyDescription{
+			Name:       policy.Name,
+			Signature:  "()",
+			ReturnType: "PROJECTION_CONSTRAINT",
+			Body:       body,
+		}, *description)
+	})
+}
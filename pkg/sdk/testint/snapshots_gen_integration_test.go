@@ -0,0 +1,138 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Snapshots(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	volume := "block-volume"
+
+	computePool, computePoolCleanup := testClientHelper().ComputePool.Create(t)
+	t.Cleanup(computePoolCleanup)
+
+	service, serviceCleanup := testClientHelper().Service.CreateWithIdWithBlockVolume(t, computePool.ID(), testClientHelper().Ids.RandomSchemaObjectIdentifier())
+	t.Cleanup(serviceCleanup)
+
+	assertSnapshot := func(t *testing.T, snapshot *sdk.Snapshot, id sdk.SchemaObjectIdentifier, comment string) {
+		t.Helper()
+		assertThatObject(t, objectassert.SnapshotFromObject(t, snapshot).
+			HasName(id.Name()).
+			HasDatabaseName(id.DatabaseName()).
+			HasSchemaName(id.SchemaName()).
+			HasServiceName(service.ID().Name()).
+			HasVolumeName(volume).
+			HasInstance(0).
+			HasComment(comment).
+			HasOwner(snowflakeroles.Accountadmin.Name()).
+			HasOwnerRoleType("ROLE"),
+		)
+		assert.NotEmpty(t, snapshot.CreatedOn)
+		assert.NotEmpty(t, snapshot.State)
+	}
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		request := sdk.NewCreateSnapshotRequest(id, service.ID(), volume, 0)
+
+		snapshot, cleanup := testClientHelper().Snapshot.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertSnapshot(t, snapshot, id, "")
+	})
+
+	t.Run("create: full", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		comment := random.Comment()
+		request := sdk.NewCreateSnapshotRequest(id, service.ID(), volume, 0).
+			WithIfNotExists(true).
+			WithComment(comment)
+
+		snapshot, cleanup := testClientHelper().Snapshot.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertSnapshot(t, snapshot, id, comment)
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		snapshot, cleanup := testClientHelper().Snapshot.Create(t, service.ID(), volume)
+		t.Cleanup(cleanup)
+
+		err := client.Snapshots.Drop(ctx, sdk.NewDropSnapshotRequest(snapshot.ID()))
+		require.NoError(t, err)
+
+		_, err = client.Snapshots.ShowByID(ctx, snapshot.ID())
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.Snapshots.Drop(ctx, sdk.NewDropSnapshotRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		snapshot, cleanup := testClientHelper().Snapshot.Create(t, service.ID(), volume)
+		t.Cleanup(cleanup)
+		comment := random.Comment()
+
+		err := client.Snapshots.Alter(ctx, sdk.NewAlterSnapshotRequest(snapshot.ID()).WithSetComment(comment))
+		require.NoError(t, err)
+
+		assertThatObject(t, objectassert.Snapshot(t, snapshot.ID()).HasComment(comment))
+
+		err = client.Snapshots.Alter(ctx, sdk.NewAlterSnapshotRequest(snapshot.ID()).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		assertThatObject(t, objectassert.Snapshot(t, snapshot.ID()).HasComment(""))
+	})
+
+	t.Run("show: with options", func(t *testing.T) {
+		snapshot, cleanup := testClientHelper().Snapshot.Create(t, service.ID(), volume)
+		t.Cleanup(cleanup)
+
+		snapshots, err := client.Snapshots.Show(ctx, sdk.NewShowSnapshotRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(snapshot.ID().Name())}).
+			WithIn(sdk.In{Schema: snapshot.ID().SchemaId()}),
+		)
+		require.NoError(t, err)
+		require.Len(t, snapshots, 1)
+		assert.Equal(t, *snapshot, snapshots[0])
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		snapshot, cleanup := testClientHelper().Snapshot.Create(t, service.ID(), volume)
+		t.Cleanup(cleanup)
+
+		details, err := client.Snapshots.Describe(ctx, snapshot.ID())
+		require.NoError(t, err)
+		assert.Equal(t, snapshot.ID().Name(), details.Name)
+		assert.Equal(t, service.ID().Name(), details.ServiceName)
+		assert.Equal(t, volume, details.VolumeName)
+		assert.Equal(t, 0, details.Instance)
+	})
+
+	t.Run("restore service volume from snapshot", func(t *testing.T) {
+		snapshot, cleanup := testClientHelper().Snapshot.Create(t, service.ID(), volume)
+		t.Cleanup(cleanup)
+
+		testClientHelper().Service.Alter(t, sdk.NewAlterServiceRequest(service.ID()).WithSuspend(true))
+
+		err := client.Services.Alter(ctx, sdk.NewAlterServiceRequest(service.ID()).
+			WithRestore(*sdk.NewRestoreRequest(volume, []int{0}, snapshot.ID())),
+		)
+		require.NoError(t, err)
+
+		testClientHelper().Service.Alter(t, sdk.NewAlterServiceRequest(service.ID()).WithResume(true))
+	})
+}
@@ -0,0 +1,204 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_StorageLifecyclePolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	args := []sdk.CreateStorageLifecyclePolicyArgsRequest{*sdk.NewCreateStorageLifecyclePolicyArgsRequest("A", testdatatypes.DataTypeNumber)}
+	body := "A > 10"
+
+	assertStorageLifecyclePolicy := func(t *testing.T, policy *sdk.StorageLifecyclePolicy, id sdk.SchemaObjectIdentifier, comment string) {
+		t.Helper()
+		assert.NotEmpty(t, policy.CreatedOn)
+		assert.Equal(t, id.Name(), policy.Name)
+		assert.Equal(t, id.DatabaseName(), policy.DatabaseName)
+		assert.Equal(t, id.SchemaName(), policy.SchemaName)
+		assert.Equal(t, "STORAGE_LIFECYCLE_POLICY", policy.Kind)
+		assert.Equal(t, "ACCOUNTADMIN", policy.Owner)
+		assert.Equal(t, comment, policy.Comment)
+		assert.Equal(t, "ROLE", policy.OwnerRoleType)
+	}
+
+	t.Run("create: no optionals", func(t *testing.T) {
+		request := sdk.NewCreateStorageLifecyclePolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), args, body)
+
+		policy, cleanup := testClientHelper().StorageLifecyclePolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertStorageLifecyclePolicy(t, policy, request.GetName(), "")
+
+		description, err := client.StorageLifecyclePolicies.Describe(ctx, policy.ID())
+		require.NoError(t, err)
+		assert.Nil(t, description.ArchiveTier)
+		assert.Nil(t, description.ArchiveForDays)
+	})
+
+	t.Run("create: full", func(t *testing.T) {
+		request := sdk.NewCreateStorageLifecyclePolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), args, body).
+			WithOrReplace(true).
+			WithArchiveTier(sdk.StorageLifecyclePolicyArchiveTierCool).
+			WithArchiveForDays(90).
+			WithComment("some comment")
+
+		policy, cleanup := testClientHelper().StorageLifecyclePolicy.CreateWithRequest(t, request)
+		t.Cleanup(cleanup)
+
+		assertStorageLifecyclePolicy(t, policy, request.GetName(), "some comment")
+
+		description, err := client.StorageLifecyclePolicies.Describe(ctx, policy.ID())
+		require.NoError(t, err)
+		require.NotNil(t, description.ArchiveTier)
+		assert.Equal(t, string(sdk.StorageLifecyclePolicyArchiveTierCool), *description.ArchiveTier)
+		require.NotNil(t, description.ArchiveForDays)
+		assert.Equal(t, 90, *description.ArchiveForDays)
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		policy, cleanup := testClientHelper().StorageLifecyclePolicy.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.StorageLifecyclePolicies.Drop(ctx, sdk.NewDropStorageLifecyclePolicyRequest(policy.ID()))
+		require.NoError(t, err)
+
+		_, err = client.StorageLifecyclePolicies.ShowByID(ctx, policy.ID())
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: non-existing", func(t *testing.T) {
+		err := client.StorageLifecyclePolicies.Drop(ctx, sdk.NewDropStorageLifecyclePolicyRequest(NonExistingSchemaObjectIdentifier))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		policy, cleanup := testClientHelper().StorageLifecyclePolicy.Create(t)
+		t.Cleanup(cleanup)
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(policy.ID()).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().StorageLifecyclePolicy.DropFunc(t, newId))
+
+		_, err = client.StorageLifecyclePolicies.ShowByID(ctx, policy.ID())
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		renamed, err := client.StorageLifecyclePolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertStorageLifecyclePolicy(t, renamed, newId, "")
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		policy, cleanup := testClientHelper().StorageLifecyclePolicy.CreateWithRequest(t, sdk.NewCreateStorageLifecyclePolicyRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), args, body).
+			WithArchiveTier(sdk.StorageLifecyclePolicyArchiveTierCold),
+		)
+		t.Cleanup(cleanup)
+
+		err := client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(policy.ID()).WithSet(*sdk.NewStorageLifecyclePolicySetRequest().
+			WithArchiveForDays(180).
+			WithComment("new comment"),
+		))
+		require.NoError(t, err)
+
+		altered, err := client.StorageLifecyclePolicies.ShowByID(ctx, policy.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", altered.Comment)
+
+		description, err := client.StorageLifecyclePolicies.Describe(ctx, policy.ID())
+		require.NoError(t, err)
+		require.NotNil(t, description.ArchiveForDays)
+		assert.Equal(t, 180, *description.ArchiveForDays)
+
+		err = client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(policy.ID()).WithUnset(*sdk.NewStorageLifecyclePolicyUnsetRequest().
+			WithArchiveForDays(true).
+			WithComment(true),
+		))
+		require.NoError(t, err)
+
+		altered, err = client.StorageLifecyclePolicies.ShowByID(ctx, policy.ID())
+		require.NoError(t, err)
+		assert.Empty(t, altered.Comment)
+
+		description, err = client.StorageLifecyclePolicies.Describe(ctx, policy.ID())
+		require.NoError(t, err)
+		assert.Nil(t, description.ArchiveForDays)
+	})
+
+	t.Run("alter: set body", func(t *testing.T) {
+		policy, cleanup := testClientHelper().StorageLifecyclePolicy.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.StorageLifecyclePolicies.Alter(ctx, sdk.NewAlterStorageLifecyclePolicyRequest(policy.ID()).WithSetBody("A > 100"))
+		require.NoError(t, err)
+
+		description, err := client.StorageLifecyclePolicies.Describe(ctx, policy.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "A > 100", description.Body)
+	})
+
+	t.Run("show: with options", func(t *testing.T) {
+		policy1, cleanup1 := testClientHelper().StorageLifecyclePolicy.Create(t)
+		t.Cleanup(cleanup1)
+		policy2, cleanup2 := testClientHelper().StorageLifecyclePolicy.Create(t)
+		t.Cleanup(cleanup2)
+
+		policies, err := client.StorageLifecyclePolicies.Show(ctx, sdk.NewShowStorageLifecyclePolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(policy1.Name)}).
+			WithIn(sdk.ExtendedIn{In: sdk.In{Schema: testClientHelper().Ids.SchemaId()}}),
+		)
+		require.NoError(t, err)
+		require.Len(t, policies, 1)
+		assert.Equal(t, policy1.ID(), policies[0].ID())
+		assert.NotEqual(t, policy2.ID(), policies[0].ID())
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		policy, cleanup := testClientHelper().StorageLifecyclePolicy.Create(t)
+		t.Cleanup(cleanup)
+
+		description, err := client.StorageLifecyclePolicies.Describe(ctx, policy.ID())
+		require.NoError(t, err)
+
+		assert.Equal(t, policy.Name, description.Name)
+		require.Len(t, description.Signature, 1)
+		assert.Equal(t, "A", description.Signature[0].Name)
+		assert.Equal(t, "BOOLEAN", description.ReturnType)
+		assert.Equal(t, body, description.Body)
+	})
+
+	t.Run("add and drop on table", func(t *testing.T) {
+		policy, cleanup := testClientHelper().StorageLifecyclePolicy.Create(t)
+		t.Cleanup(cleanup)
+
+		table, tableCleanup := testClientHelper().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+			*sdk.NewTableColumnRequest("id", sdk.DataTypeNumber),
+		})
+		t.Cleanup(tableCleanup)
+
+		err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(table.ID()).WithAddStorageLifecyclePolicy(sdk.NewTableAddStorageLifecyclePolicyRequest(policy.ID(), []sdk.Column{{Value: "id"}})))
+		require.NoError(t, err)
+
+		reference, err := testClientHelper().PolicyReferences.GetPolicyReference(t, table.ID(), sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		assert.Equal(t, policy.ID().Name(), reference.PolicyName)
+		assert.Equal(t, sdk.PolicyKindStorageLifecyclePolicy, reference.PolicyKind)
+		assert.Equal(t, table.ID().Name(), reference.RefEntityName)
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(table.ID()).WithDropStorageLifecyclePolicy(sdk.NewTableDropStorageLifecyclePolicyRequest()))
+		require.NoError(t, err)
+
+		references, err := testClientHelper().PolicyReferences.GetPolicyReferences(t, table.ID(), sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		require.Empty(t, references)
+	})
+}
@@ -5,6 +5,7 @@ package testint
 import (
 	"testing"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -53,3 +54,65 @@ func TestInt_BcrBundles_AccountLevel(t *testing.T) {
 		require.Equal(t, sdk.BehaviorChangeBundleStatusDisabled, status)
 	})
 }
+
+// The test requires a Business Critical account and a private endpoint service (e.g. com.amazonaws.us-west-2.s3)
+// available in the cloud region of the secondary account.
+func TestInt_PrivatelinkEndpoints_AccountLevel(t *testing.T) {
+	providerResourceId := testenvs.GetOrSkipTest(t, testenvs.PrivatelinkEndpointProviderResourceId)
+	host := testenvs.GetOrSkipTest(t, testenvs.PrivatelinkEndpointHost)
+
+	client := testSecondaryClient(t)
+	ctx := testContext(t)
+
+	t.Run("provision and deprovision endpoint", func(t *testing.T) {
+		err := client.SystemFunctions.ProvisionPrivatelinkEndpoint(ctx, sdk.ProvisionPrivatelinkEndpointRequest{
+			ProviderResourceId: providerResourceId,
+			HostName:           host,
+		})
+		require.NoError(t, err)
+		t.Cleanup(secondaryTestClientHelper().Privatelink.DeprovisionEndpointFunc(t, providerResourceId))
+
+		endpoint, found := secondaryTestClientHelper().Privatelink.EndpointInfo(t, providerResourceId)
+		require.True(t, found)
+		assert.Equal(t, providerResourceId, endpoint.ProviderResourceId)
+		assert.Equal(t, host, endpoint.Host)
+		assert.NotEmpty(t, endpoint.SnowflakeResourceId)
+		assert.NotEmpty(t, endpoint.EndpointState)
+
+		err = client.SystemFunctions.DeprovisionPrivatelinkEndpoint(ctx, providerResourceId)
+		require.NoError(t, err)
+
+		_, found = secondaryTestClientHelper().Privatelink.EndpointInfo(t, providerResourceId)
+		require.False(t, found)
+	})
+
+	t.Run("deprovision non-existing endpoint", func(t *testing.T) {
+		err := client.SystemFunctions.DeprovisionPrivatelinkEndpoint(ctx, "non-existing-endpoint")
+		require.Error(t, err)
+	})
+}
+
+// The test requires a Business Critical account. The endpoint id and the token have to be generated by the cloud provider
+// (e.g. the AWS account id and the federated token returned by `aws sts get-federation-token`).
+func TestInt_PrivatelinkAuthorization_AccountLevel(t *testing.T) {
+	endpointId := testenvs.GetOrSkipTest(t, testenvs.PrivatelinkAuthorizationEndpointId)
+	token := testenvs.GetOrSkipTest(t, testenvs.PrivatelinkAuthorizationToken)
+
+	client := testSecondaryClient(t)
+	ctx := testContext(t)
+
+	err := client.SystemFunctions.AuthorizePrivatelink(ctx, endpointId, token)
+	require.NoError(t, err)
+	t.Cleanup(secondaryTestClientHelper().Privatelink.RevokeFunc(t, endpointId, token))
+
+	endpoint, found := secondaryTestClientHelper().Privatelink.AuthorizedEndpoint(t, endpointId)
+	require.True(t, found)
+	assert.Equal(t, endpointId, endpoint.EndpointId)
+	assert.NotEmpty(t, endpoint.EndpointIdType)
+
+	err = client.SystemFunctions.RevokePrivatelink(ctx, endpointId, token)
+	require.NoError(t, err)
+
+	_, found = secondaryTestClientHelper().Privatelink.AuthorizedEndpoint(t, endpointId)
+	require.False(t, found)
+}
@@ -918,6 +918,29 @@ func TestInt_Table(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("undrop table", func(t *testing.T) {
+		table, tableCleanup := testClientHelper().Table.Create(t)
+		t.Cleanup(tableCleanup)
+		err := client.Tables.Drop(ctx, sdk.NewDropTableRequest(table.ID()))
+		require.NoError(t, err)
+
+		dropped, err := client.Tables.Show(ctx, sdk.NewShowTableRequest().
+			WithHistory(sdk.Bool(true)).
+			WithLike(sdk.Like{Pattern: sdk.String(table.Name)}).
+			WithIn(sdk.ExtendedIn{In: sdk.In{Schema: table.ID().SchemaId()}}))
+		require.NoError(t, err)
+		require.Len(t, dropped, 1)
+		require.NotNil(t, dropped[0].DroppedOn)
+
+		err = client.Tables.Undrop(ctx, sdk.NewUndropTableRequest(table.ID()))
+		require.NoError(t, err)
+
+		after, err := client.Tables.ShowByID(ctx, table.ID())
+		require.NoError(t, err)
+		assert.Equal(t, table.Name, after.Name)
+		assert.Nil(t, after.DroppedOn)
+	})
+
 	t.Run("show tables", func(t *testing.T) {
 		table, tableCleanup := testClientHelper().Table.Create(t)
 		t.Cleanup(tableCleanup)
@@ -26,6 +26,7 @@ func TestToParameterType(t *testing.T) {
 		{Input: string(ParameterTypeTask), Expected: ParameterTypeTask},
 		{Input: string(ParameterTypeFunction), Expected: ParameterTypeFunction},
 		{Input: string(ParameterTypeProcedure), Expected: ParameterTypeProcedure},
+		{Input: string(ParameterTypeAlert), Expected: ParameterTypeAlert},
 		{Name: "validation: incorrect parameter type", Input: "incorrect", Error: "invalid parameter type: incorrect"},
 		{Name: "validation: lower case input", Input: "account", Expected: ParameterType("account")},
 	}
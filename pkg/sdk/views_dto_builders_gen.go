@@ -247,6 +247,26 @@ func (s *AlterViewRequest) WithUnsetAggregationPolicy(unsetAggregationPolicy Vie
 	return s
 }
 
+func (s *AlterViewRequest) WithSetJoinPolicy(setJoinPolicy ViewSetJoinPolicyRequest) *AlterViewRequest {
+	s.SetJoinPolicy = &setJoinPolicy
+	return s
+}
+
+func (s *AlterViewRequest) WithUnsetJoinPolicy(unsetJoinPolicy ViewUnsetJoinPolicyRequest) *AlterViewRequest {
+	s.UnsetJoinPolicy = &unsetJoinPolicy
+	return s
+}
+
+func (s *AlterViewRequest) WithAddPrivacyPolicy(addPrivacyPolicy ViewAddPrivacyPolicyRequest) *AlterViewRequest {
+	s.AddPrivacyPolicy = &addPrivacyPolicy
+	return s
+}
+
+func (s *AlterViewRequest) WithDropPrivacyPolicy(dropPrivacyPolicy ViewDropPrivacyPolicyRequest) *AlterViewRequest {
+	s.DropPrivacyPolicy = &dropPrivacyPolicy
+	return s
+}
+
 func (s *AlterViewRequest) WithSetMaskingPolicyOnColumn(setMaskingPolicyOnColumn ViewSetColumnMaskingPolicyRequest) *AlterViewRequest {
 	s.SetMaskingPolicyOnColumn = &setMaskingPolicyOnColumn
 	return s
@@ -365,6 +385,47 @@ func NewViewUnsetAggregationPolicyRequest() *ViewUnsetAggregationPolicyRequest {
 	return &s
 }
 
+func NewViewSetJoinPolicyRequest(
+	joinPolicy SchemaObjectIdentifier,
+) *ViewSetJoinPolicyRequest {
+	s := ViewSetJoinPolicyRequest{}
+	s.JoinPolicy = joinPolicy
+	return &s
+}
+
+func (s *ViewSetJoinPolicyRequest) WithAllowedJoinKeys(allowedJoinKeys []Column) *ViewSetJoinPolicyRequest {
+	s.AllowedJoinKeys = allowedJoinKeys
+	return s
+}
+
+func (s *ViewSetJoinPolicyRequest) WithForce(force bool) *ViewSetJoinPolicyRequest {
+	s.Force = &force
+	return s
+}
+
+func NewViewUnsetJoinPolicyRequest() *ViewUnsetJoinPolicyRequest {
+	s := ViewUnsetJoinPolicyRequest{}
+	return &s
+}
+
+func NewViewAddPrivacyPolicyRequest(
+	privacyPolicy SchemaObjectIdentifier,
+	entityKey []Column,
+) *ViewAddPrivacyPolicyRequest {
+	s := ViewAddPrivacyPolicyRequest{}
+	s.PrivacyPolicy = privacyPolicy
+	s.EntityKey = entityKey
+	return &s
+}
+
+func NewViewDropPrivacyPolicyRequest(
+	privacyPolicy SchemaObjectIdentifier,
+) *ViewDropPrivacyPolicyRequest {
+	s := ViewDropPrivacyPolicyRequest{}
+	s.PrivacyPolicy = privacyPolicy
+	return &s
+}
+
 func NewViewSetColumnMaskingPolicyRequest(
 	name string,
 	maskingPolicy SchemaObjectIdentifier,
@@ -75,6 +75,10 @@ type AlterViewRequest struct {
 	DropAllRowAccessPolicies      *bool
 	SetAggregationPolicy          *ViewSetAggregationPolicyRequest
 	UnsetAggregationPolicy        *ViewUnsetAggregationPolicyRequest
+	SetJoinPolicy                 *ViewSetJoinPolicyRequest
+	UnsetJoinPolicy               *ViewUnsetJoinPolicyRequest
+	AddPrivacyPolicy              *ViewAddPrivacyPolicyRequest
+	DropPrivacyPolicy             *ViewDropPrivacyPolicyRequest
 	SetMaskingPolicyOnColumn      *ViewSetColumnMaskingPolicyRequest
 	UnsetMaskingPolicyOnColumn    *ViewUnsetColumnMaskingPolicyRequest
 	SetProjectionPolicyOnColumn   *ViewSetProjectionPolicyRequest
@@ -123,6 +127,23 @@ type ViewSetAggregationPolicyRequest struct {
 
 type ViewUnsetAggregationPolicyRequest struct{}
 
+type ViewSetJoinPolicyRequest struct {
+	JoinPolicy      SchemaObjectIdentifier // required
+	AllowedJoinKeys []Column
+	Force           *bool
+}
+
+type ViewUnsetJoinPolicyRequest struct{}
+
+type ViewAddPrivacyPolicyRequest struct {
+	PrivacyPolicy SchemaObjectIdentifier // required
+	EntityKey     []Column               // required
+}
+
+type ViewDropPrivacyPolicyRequest struct {
+	PrivacyPolicy SchemaObjectIdentifier // required
+}
+
 type ViewSetColumnMaskingPolicyRequest struct {
 	Name          string                 // required
 	MaskingPolicy SchemaObjectIdentifier // required
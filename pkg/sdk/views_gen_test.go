@@ -146,16 +146,16 @@ func TestViews_Alter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
-	t.Run("validation: exactly one field from [opts.RenameTo opts.SetComment opts.UnsetComment opts.SetSecure opts.SetChangeTracking opts.UnsetSecure opts.SetTags opts.UnsetTags opts.AddDataMetricFunction opts.DropDataMetricFunction opts.ModifyDataMetricFunction opts.SetDataMetricSchedule opts.UnsetDataMetricSchedule opts.AddRowAccessPolicy opts.DropRowAccessPolicy opts.DropAndAddRowAccessPolicy opts.DropAllRowAccessPolicies opts.SetAggregationPolicy opts.UnsetAggregationPolicy opts.SetMaskingPolicyOnColumn opts.UnsetMaskingPolicyOnColumn opts.SetProjectionPolicyOnColumn opts.UnsetProjectionPolicyOnColumn opts.SetTagsOnColumn opts.UnsetTagsOnColumn] should be present", func(t *testing.T) {
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetComment opts.UnsetComment opts.SetSecure opts.SetChangeTracking opts.UnsetSecure opts.SetTags opts.UnsetTags opts.AddDataMetricFunction opts.DropDataMetricFunction opts.ModifyDataMetricFunction opts.SetDataMetricSchedule opts.UnsetDataMetricSchedule opts.AddRowAccessPolicy opts.DropRowAccessPolicy opts.DropAndAddRowAccessPolicy opts.DropAllRowAccessPolicies opts.SetAggregationPolicy opts.UnsetAggregationPolicy opts.SetJoinPolicy opts.UnsetJoinPolicy opts.AddPrivacyPolicy opts.DropPrivacyPolicy opts.SetMaskingPolicyOnColumn opts.UnsetMaskingPolicyOnColumn opts.SetProjectionPolicyOnColumn opts.UnsetProjectionPolicyOnColumn opts.SetTagsOnColumn opts.UnsetTagsOnColumn] should be present", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterViewOptions", "RenameTo", "SetComment", "UnsetComment", "SetSecure", "SetChangeTracking", "UnsetSecure", "SetTags", "UnsetTags", "AddDataMetricFunction", "DropDataMetricFunction", "ModifyDataMetricFunction", "SetDataMetricSchedule", "UnsetDataMetricSchedule", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetMaskingPolicyOnColumn", "UnsetMaskingPolicyOnColumn", "SetProjectionPolicyOnColumn", "UnsetProjectionPolicyOnColumn", "SetTagsOnColumn", "UnsetTagsOnColumn"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterViewOptions", "RenameTo", "SetComment", "UnsetComment", "SetSecure", "SetChangeTracking", "UnsetSecure", "SetTags", "UnsetTags", "AddDataMetricFunction", "DropDataMetricFunction", "ModifyDataMetricFunction", "SetDataMetricSchedule", "UnsetDataMetricSchedule", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetJoinPolicy", "UnsetJoinPolicy", "AddPrivacyPolicy", "DropPrivacyPolicy", "SetMaskingPolicyOnColumn", "UnsetMaskingPolicyOnColumn", "SetProjectionPolicyOnColumn", "UnsetProjectionPolicyOnColumn", "SetTagsOnColumn", "UnsetTagsOnColumn"))
 	})
 
-	t.Run("validation: exactly one field from [opts.RenameTo opts.SetComment opts.UnsetComment opts.SetSecure opts.SetChangeTracking opts.UnsetSecure opts.SetTags opts.UnsetTags opts.AddDataMetricFunction opts.DropDataMetricFunction opts.ModifyDataMetricFunction opts.SetDataMetricSchedule opts.UnsetDataMetricSchedule opts.AddRowAccessPolicy opts.DropRowAccessPolicy opts.DropAndAddRowAccessPolicy opts.DropAllRowAccessPolicies opts.SetAggregationPolicy opts.UnsetAggregationPolicy opts.SetMaskingPolicyOnColumn opts.UnsetMaskingPolicyOnColumn opts.SetProjectionPolicyOnColumn opts.UnsetProjectionPolicyOnColumn opts.SetTagsOnColumn opts.UnsetTagsOnColumn] should be present - more present", func(t *testing.T) {
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetComment opts.UnsetComment opts.SetSecure opts.SetChangeTracking opts.UnsetSecure opts.SetTags opts.UnsetTags opts.AddDataMetricFunction opts.DropDataMetricFunction opts.ModifyDataMetricFunction opts.SetDataMetricSchedule opts.UnsetDataMetricSchedule opts.AddRowAccessPolicy opts.DropRowAccessPolicy opts.DropAndAddRowAccessPolicy opts.DropAllRowAccessPolicies opts.SetAggregationPolicy opts.UnsetAggregationPolicy opts.SetJoinPolicy opts.UnsetJoinPolicy opts.AddPrivacyPolicy opts.DropPrivacyPolicy opts.SetMaskingPolicyOnColumn opts.UnsetMaskingPolicyOnColumn opts.SetProjectionPolicyOnColumn opts.UnsetProjectionPolicyOnColumn opts.SetTagsOnColumn opts.UnsetTagsOnColumn] should be present - more present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.SetChangeTracking = Bool(true)
 		opts.DropAllRowAccessPolicies = Bool(true)
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterViewOptions", "RenameTo", "SetComment", "UnsetComment", "SetSecure", "SetChangeTracking", "UnsetSecure", "SetTags", "UnsetTags", "AddDataMetricFunction", "DropDataMetricFunction", "ModifyDataMetricFunction", "SetDataMetricSchedule", "UnsetDataMetricSchedule", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetMaskingPolicyOnColumn", "UnsetMaskingPolicyOnColumn", "SetProjectionPolicyOnColumn", "UnsetProjectionPolicyOnColumn", "SetTagsOnColumn", "UnsetTagsOnColumn"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterViewOptions", "RenameTo", "SetComment", "UnsetComment", "SetSecure", "SetChangeTracking", "UnsetSecure", "SetTags", "UnsetTags", "AddDataMetricFunction", "DropDataMetricFunction", "ModifyDataMetricFunction", "SetDataMetricSchedule", "UnsetDataMetricSchedule", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetJoinPolicy", "UnsetJoinPolicy", "AddPrivacyPolicy", "DropPrivacyPolicy", "SetMaskingPolicyOnColumn", "UnsetMaskingPolicyOnColumn", "SetProjectionPolicyOnColumn", "UnsetProjectionPolicyOnColumn", "SetTagsOnColumn", "UnsetTagsOnColumn"))
 	})
 
 	t.Run("validation: conflicting fields for [opts.IfExists opts.SetSecure]", func(t *testing.T) {
@@ -222,6 +222,30 @@ func TestViews_Alter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
+	t.Run("validation: valid identifier for [opts.SetJoinPolicy.JoinPolicy]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetJoinPolicy = &ViewSetJoinPolicy{
+			JoinPolicy: emptySchemaObjectIdentifier,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.AddPrivacyPolicy.PrivacyPolicy] and required entity key", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AddPrivacyPolicy = &ViewAddPrivacyPolicy{
+			PrivacyPolicy: emptySchemaObjectIdentifier,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier, errNotSet("AlterViewOptions.AddPrivacyPolicy", "EntityKey"))
+	})
+
+	t.Run("validation: valid identifier for [opts.DropPrivacyPolicy.PrivacyPolicy]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropPrivacyPolicy = &ViewDropPrivacyPolicy{
+			PrivacyPolicy: emptySchemaObjectIdentifier,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
 	// validation added manually
 	t.Run("validation: empty columns for row access policy (add)", func(t *testing.T) {
 		opts := defaultOpts()
@@ -407,6 +431,45 @@ func TestViews_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER VIEW %s UNSET AGGREGATION POLICY", id.FullyQualifiedName())
 	})
 
+	t.Run("set join policy", func(t *testing.T) {
+		joinPolicyId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.SetJoinPolicy = &ViewSetJoinPolicy{
+			JoinPolicy:      joinPolicyId,
+			AllowedJoinKeys: []Column{{"a"}, {"b"}},
+			Force:           Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER VIEW %s SET JOIN POLICY %s ALLOWED JOIN KEYS (\"a\", \"b\") FORCE", id.FullyQualifiedName(), joinPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("unset join policy", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetJoinPolicy = &ViewUnsetJoinPolicy{}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER VIEW %s UNSET JOIN POLICY", id.FullyQualifiedName())
+	})
+
+	t.Run("add privacy policy", func(t *testing.T) {
+		privacyPolicyId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.AddPrivacyPolicy = &ViewAddPrivacyPolicy{
+			PrivacyPolicy: privacyPolicyId,
+			EntityKey:     []Column{{"a"}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER VIEW %s ADD PRIVACY POLICY %s ENTITY KEY (\"a\")", id.FullyQualifiedName(), privacyPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("drop privacy policy", func(t *testing.T) {
+		privacyPolicyId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.DropPrivacyPolicy = &ViewDropPrivacyPolicy{
+			PrivacyPolicy: privacyPolicyId,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER VIEW %s DROP PRIVACY POLICY %s", id.FullyQualifiedName(), privacyPolicyId.FullyQualifiedName())
+	})
+
 	t.Run("set masking policy on column", func(t *testing.T) {
 		maskingPolicyId := randomSchemaObjectIdentifier()
 
@@ -194,6 +194,27 @@ func (r *AlterViewRequest) toOpts() *AlterViewOptions {
 	if r.UnsetAggregationPolicy != nil {
 		opts.UnsetAggregationPolicy = &ViewUnsetAggregationPolicy{}
 	}
+	if r.SetJoinPolicy != nil {
+		opts.SetJoinPolicy = &ViewSetJoinPolicy{
+			JoinPolicy:      r.SetJoinPolicy.JoinPolicy,
+			AllowedJoinKeys: r.SetJoinPolicy.AllowedJoinKeys,
+			Force:           r.SetJoinPolicy.Force,
+		}
+	}
+	if r.UnsetJoinPolicy != nil {
+		opts.UnsetJoinPolicy = &ViewUnsetJoinPolicy{}
+	}
+	if r.AddPrivacyPolicy != nil {
+		opts.AddPrivacyPolicy = &ViewAddPrivacyPolicy{
+			PrivacyPolicy: r.AddPrivacyPolicy.PrivacyPolicy,
+			EntityKey:     r.AddPrivacyPolicy.EntityKey,
+		}
+	}
+	if r.DropPrivacyPolicy != nil {
+		opts.DropPrivacyPolicy = &ViewDropPrivacyPolicy{
+			PrivacyPolicy: r.DropPrivacyPolicy.PrivacyPolicy,
+		}
+	}
 	if r.SetMaskingPolicyOnColumn != nil {
 		opts.SetMaskingPolicyOnColumn = &ViewSetColumnMaskingPolicy{
 			Name:          r.SetMaskingPolicyOnColumn.Name,
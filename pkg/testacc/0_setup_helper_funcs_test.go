@@ -32,7 +32,7 @@ func setUpSdkClient(profile string, tests string) (*gosnowflake.Config, *sdk.Cli
 		return nil, nil, fmt.Errorf("%s config is required to run %s tests", profile, tests)
 	}
 
-	c, err := sdk.NewClient(conf)
+	c, err := newSdkClient(profile, conf)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -58,9 +58,17 @@ func TestMain(m *testing.M) {
 
 func execute(m *testing.M) int {
 	defer timer("acceptance tests", accTestLog)()
-	defer cleanup()
-	setup()
-	exitVal := m.Run()
+	exitVal := func() int {
+		defer cleanup()
+		setup()
+		return m.Run()
+	}()
+	if err := finishSqlCassettes(); err != nil {
+		accTestLog.Printf("[ERROR] Finishing SQL cassettes failed with: `%s`", err)
+		if exitVal == 0 {
+			exitVal = 1
+		}
+	}
 	return exitVal
 }
 
@@ -0,0 +1,114 @@
+package testacc
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/sqlcassette"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/snowflakedb/gosnowflake/v2"
+)
+
+// sqlCassettes keeps one cassette per test profile, so that the traffic from different accounts does not interfere.
+// Cassettes are enabled by setting testenvs.SqlCassetteMode to record or replay; they are stored in testenvs.SqlCassetteDir.
+// The replay works only if the tests generate the same statements. The random identifiers (e.g. testClient().Ids.RandomAccountObjectIdentifier())
+// are generated with a fixed seed in this mode, so the replay has to run the same tests, in the same order, as the recording.
+var sqlCassettes = struct {
+	mu        sync.Mutex
+	cassettes map[string]*sqlcassette.Cassette
+}{
+	cassettes: make(map[string]*sqlcassette.Cassette),
+}
+
+func sqlCassetteMode() (sqlcassette.Mode, bool, error) {
+	value := os.Getenv(string(testenvs.SqlCassetteMode))
+	if value == "" {
+		return "", false, nil
+	}
+	mode, err := sqlcassette.ToMode(value)
+	return mode, err == nil, err
+}
+
+func sqlCassettePath(profile string) (string, error) {
+	dir := os.Getenv(string(testenvs.SqlCassetteDir))
+	if dir == "" {
+		return "", fmt.Errorf("cassette directory is required when %s is set; set %s env", testenvs.SqlCassetteMode, testenvs.SqlCassetteDir)
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s.json", profile)), nil
+}
+
+// newSdkClient creates the client for the given profile; when the cassettes are enabled, the client records or replays the SQL traffic.
+func newSdkClient(profile string, conf *gosnowflake.Config) (*sdk.Client, error) {
+	mode, enabled, err := sqlCassetteMode()
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return sdk.NewClient(conf)
+	}
+
+	sqlCassettes.mu.Lock()
+	defer sqlCassettes.mu.Unlock()
+
+	cassette, ok := sqlCassettes.cassettes[profile]
+	switch {
+	case !ok && mode == sqlcassette.ModeRecord:
+		cassette = sqlcassette.NewCassette()
+	case !ok && mode == sqlcassette.ModeReplay:
+		path, err := sqlCassettePath(profile)
+		if err != nil {
+			return nil, err
+		}
+		if cassette, err = sqlcassette.Load(path); err != nil {
+			return nil, err
+		}
+	}
+	sqlCassettes.cassettes[profile] = cassette
+
+	if mode == sqlcassette.ModeReplay {
+		return sdk.NewClientWithConnector(conf, sqlcassette.NewReplayingConnector(cassette))
+	}
+	dsn, err := gosnowflake.DSN(conf)
+	if err != nil {
+		return nil, err
+	}
+	connector, err := gosnowflake.SnowflakeDriver{}.OpenConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewClientWithConnector(conf, sqlcassette.NewRecordingConnector(connector, cassette))
+}
+
+// finishSqlCassettes saves the recorded cassettes or returns an error listing the interactions that were not replayed.
+func finishSqlCassettes() error {
+	mode, enabled, err := sqlCassetteMode()
+	if err != nil || !enabled {
+		return err
+	}
+
+	sqlCassettes.mu.Lock()
+	defer sqlCassettes.mu.Unlock()
+
+	errs := make([]error, 0)
+	for profile, cassette := range sqlCassettes.cassettes {
+		switch mode {
+		case sqlcassette.ModeRecord:
+			path, err := sqlCassettePath(profile)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			accTestLog.Printf("[INFO] Saving %d SQL interactions for profile %s to %s", len(cassette.Interactions()), profile, path)
+			errs = append(errs, cassette.Save(path))
+		case sqlcassette.ModeReplay:
+			if unused := cassette.Unused(); len(unused) > 0 {
+				errs = append(errs, fmt.Errorf("%d recorded SQL interactions for profile %s were not replayed, the first one: %s %s", len(unused), profile, unused[0].Kind, unused[0].Query))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -7,13 +7,16 @@ import (
 	internalprovider "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testprofiles"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/oswrapper"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/snowflakedb/gosnowflake/v2"
 )
 
 type ProviderFactory = map[string]func() (tfprotov6.ProviderServer, error)
@@ -126,7 +129,17 @@ func configureAcceptanceTestProviderWithCacheFunc(key string) func(ctx context.C
 func configureAcceptanceTestProvider(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
 	accTestLog.Printf("[DEBUG] Initializing acceptance test provider")
 
-	providerCtx, clientErrorDiag := provider.ConfigureProvider(ctx, d)
+	var providerCtx any
+	var clientErrorDiag diag.Diagnostics
+	_, cassettesEnabled, err := sqlCassetteMode()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if cassettesEnabled {
+		providerCtx, clientErrorDiag = configureSqlCassetteProvider(d)
+	} else {
+		providerCtx, clientErrorDiag = provider.ConfigureProvider(ctx, d)
+	}
 
 	if providerCtx != nil && oswrapper.Getenv(fmt.Sprintf("%v", testenvs.EnableAllPreviewFeatures)) == "true" {
 		providerCtx.(*internalprovider.Context).EnabledFeatures = previewfeatures.AllPreviewFeatures
@@ -134,3 +147,24 @@ func configureAcceptanceTestProvider(ctx context.Context, d *schema.ResourceData
 
 	return providerCtx, clientErrorDiag
 }
+
+// configureSqlCassetteProvider creates the provider context with the client recording or replaying the SQL traffic.
+// Only the profile is taken from the provider configuration; the other connection settings come from the profile itself.
+func configureSqlCassetteProvider(d *schema.ResourceData) (any, diag.Diagnostics) {
+	profile := testprofiles.Default
+	if v, ok := d.GetOk("profile"); ok && v.(string) != "" {
+		profile = v.(string)
+	}
+	conf, err := sdk.ProfileConfig(profile)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if conf == nil {
+		conf = &gosnowflake.Config{}
+	}
+	client, err := newSdkClient(profile, conf)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &internalprovider.Context{Client: client}, nil
+}
@@ -0,0 +1,309 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// The constraints below are satisfied by the generated models and assertions of the policies consisting only of a body and a comment
+// (aggregation, join, privacy, and projection policies), so that their common use cases can be tested once.
+
+type bodyPolicyModel[T any] interface {
+	accconfig.ResourceModel
+	WithComment(comment string) T
+}
+
+type bodyPoliciesDatasourceModel[T any] interface {
+	accconfig.DatasourceModel
+	WithWithDescribe(withDescribe bool) T
+	WithLike(like string) T
+	WithInDatabase(databaseId sdk.AccountObjectIdentifier) T
+	WithInSchema(schemaId sdk.DatabaseObjectIdentifier) T
+	WithDependsOn(values ...string) T
+}
+
+type bodyPolicyResourceAssert[T any] interface {
+	assert.TestCheckFuncProvider
+	HasDatabaseString(expected string) T
+	HasSchemaString(expected string) T
+	HasNameString(expected string) T
+	HasFullyQualifiedNameString(expected string) T
+	HasBodyString(expected string) T
+	HasCommentString(expected string) T
+}
+
+type bodyPolicyShowOutputAssert[T any] interface {
+	assert.TestCheckFuncProvider
+	HasName(expected string) T
+	HasDatabaseName(expected string) T
+	HasSchemaName(expected string) T
+	HasKind(expected string) T
+	HasComment(expected string) T
+}
+
+// bodyPolicyTestDefinition holds everything that differs between the tests of the policies consisting only of a body and a comment.
+type bodyPolicyTestDefinition[M bodyPolicyModel[M], D bodyPoliciesDatasourceModel[D], R bodyPolicyResourceAssert[R], S bodyPolicyShowOutputAssert[S]] struct {
+	resource   resources.Resource
+	kind       string
+	returnType string
+	// body and newBody are two different valid bodies of the policy
+	body    string
+	newBody string
+
+	model                      func(resourceName string, id sdk.SchemaObjectIdentifier, body string) M
+	datasourceModel            func(datasourceName string) D
+	resourceAssert             func(t *testing.T, name string) R
+	showOutputAssert           func(t *testing.T, name string) S
+	datasourceShowOutputAssert func(t *testing.T, name string) S
+	// setBody alters the body of the policy outside of Terraform
+	setBody func(t *testing.T, id sdk.SchemaObjectIdentifier, body string)
+}
+
+func (def bodyPolicyTestDefinition[M, D, R, S]) testBasicUseCase(t *testing.T) {
+	t.Helper()
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	basic := def.model("test", id, def.body)
+
+	complete := def.model("test", newId, def.newBody).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, def.resource),
+		Steps: []resource.TestStep{
+			// Create - without optionals
+			{
+				Config: accconfig.FromModels(t, basic),
+				Check: assertThat(t,
+					def.resourceAssert(t, basic.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasBodyString(def.body).
+						HasCommentString(""),
+					def.showOutputAssert(t, basic.ResourceReference()).
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasKind(def.kind).
+						HasComment(""),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.signature", "()")),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.return_type", def.returnType)),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.body", def.body)),
+				),
+			},
+			// Import - without optionals
+			{
+				Config:            accconfig.FromModels(t, basic),
+				ResourceName:      basic.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update - rename and set optionals
+			{
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					def.resourceAssert(t, complete.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()).
+						HasBodyString(def.newBody).
+						HasCommentString(comment),
+					def.showOutputAssert(t, complete.ResourceReference()).
+						HasName(newId.Name()).
+						HasComment(comment),
+					assert.Check(resource.TestCheckResourceAttr(complete.ResourceReference(), "describe_output.0.body", def.newBody)),
+				),
+			},
+			// Import - with optionals
+			{
+				Config:            accconfig.FromModels(t, complete),
+				ResourceName:      complete.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update - external change
+			{
+				PreConfig: func() {
+					def.setBody(t, newId, def.body)
+				},
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					def.resourceAssert(t, complete.ResourceReference()).
+						HasBodyString(def.newBody),
+					def.showOutputAssert(t, complete.ResourceReference()).
+						HasComment(comment),
+					assert.Check(resource.TestCheckResourceAttr(complete.ResourceReference(), "describe_output.0.body", def.newBody)),
+				),
+			},
+			// Update - unset optionals
+			{
+				Config: accconfig.FromModels(t, def.model("test", newId, def.newBody)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					def.resourceAssert(t, complete.ResourceReference()).
+						HasCommentString(""),
+					def.showOutputAssert(t, complete.ResourceReference()).
+						HasComment(""),
+				),
+			},
+		},
+	})
+}
+
+func (def bodyPolicyTestDefinition[M, D, R, S]) testDatasourceDifferentFiltering(t *testing.T) {
+	t.Helper()
+
+	prefix := random.AlphaN(4)
+	idOne := testClient().Ids.RandomSchemaObjectIdentifierWithPrefix(prefix)
+	idTwo := testClient().Ids.RandomSchemaObjectIdentifierWithPrefix(prefix)
+	idThree := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	model1 := def.model("test1", idOne, def.body)
+	model2 := def.model("test2", idTwo, def.body)
+	model3 := def.model("test3", idThree, def.body)
+
+	likeFirst := def.datasourceModel("test").
+		WithWithDescribe(false).
+		WithLike(idOne.Name()).
+		WithInDatabase(idOne.DatabaseId()).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	likePrefixInSchema := def.datasourceModel("test").
+		WithWithDescribe(false).
+		WithLike(prefix+"%").
+		WithInSchema(idOne.SchemaId()).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	inSchema := def.datasourceModel("test").
+		WithWithDescribe(false).
+		WithInSchema(idOne.SchemaId()).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	outputAttribute := def.datasourceOutputAttribute(likeFirst)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, def.resource),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, likeFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(likeFirst.DatasourceReference(), outputAttribute+".#", "1"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, likePrefixInSchema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(likePrefixInSchema.DatasourceReference(), outputAttribute+".#", "2"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, inSchema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(inSchema.DatasourceReference(), outputAttribute+".#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func (def bodyPolicyTestDefinition[M, D, R, S]) testDatasourceCompleteUseCase(t *testing.T) {
+	t.Helper()
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	policyModel := def.model("test", id, def.body).
+		WithComment(comment)
+
+	withoutDescribe := def.datasourceModel("test").
+		WithWithDescribe(false).
+		WithLike(id.Name()).
+		WithInSchema(id.SchemaId()).
+		WithDependsOn(policyModel.ResourceReference())
+
+	withDescribe := def.datasourceModel("test").
+		WithLike(id.Name()).
+		WithInSchema(id.SchemaId()).
+		WithDependsOn(policyModel.ResourceReference())
+
+	outputAttribute := def.datasourceOutputAttribute(withDescribe)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, def.resource),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, policyModel, withoutDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(withoutDescribe.DatasourceReference(), outputAttribute+".#", "1")),
+					def.datasourceShowOutputAssert(t, fmt.Sprintf("%s.%s", withoutDescribe.Datasource(), withoutDescribe.DatasourceName())).
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasKind(def.kind).
+						HasComment(comment),
+					assert.Check(resource.TestCheckResourceAttr(withoutDescribe.DatasourceReference(), outputAttribute+".0.describe_output.#", "0")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, policyModel, withDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), outputAttribute+".#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), outputAttribute+".0.describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), outputAttribute+".0.describe_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), outputAttribute+".0.describe_output.0.signature", "()")),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), outputAttribute+".0.describe_output.0.return_type", def.returnType)),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), outputAttribute+".0.describe_output.0.body", def.body)),
+				),
+			},
+		},
+	})
+}
+
+// datasourceOutputAttribute returns the name of the attribute holding the results of the data source, e.g. "aggregation_policies".
+func (def bodyPolicyTestDefinition[M, D, R, S]) datasourceOutputAttribute(datasourceModel D) string {
+	return strings.TrimPrefix(datasourceModel.Datasource().String(), "snowflake_")
+}
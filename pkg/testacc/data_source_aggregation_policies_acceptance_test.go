@@ -0,0 +1,15 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+)
+
+func TestAcc_AggregationPolicies_BasicUseCase_DifferentFiltering(t *testing.T) {
+	aggregationPolicyTestDefinition.testDatasourceDifferentFiltering(t)
+}
+
+func TestAcc_AggregationPolicies_CompleteUseCase(t *testing.T) {
+	aggregationPolicyTestDefinition.testDatasourceCompleteUseCase(t)
+}
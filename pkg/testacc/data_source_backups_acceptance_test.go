@@ -0,0 +1,56 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Backups_BasicUseCase(t *testing.T) {
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	backupSet, backupSetCleanup := testClient().BackupSet.CreateForTable(t, table.ID())
+	t.Cleanup(backupSetCleanup)
+
+	emptyBackupSet, emptyBackupSetCleanup := testClient().BackupSet.CreateForTable(t, table.ID())
+	t.Cleanup(emptyBackupSetCleanup)
+
+	backup := testClient().BackupSet.AddBackup(t, backupSet.ID())
+
+	backups := datasourcemodel.Backups("test", backupSet.ID().FullyQualifiedName())
+	noBackups := datasourcemodel.Backups("test", emptyBackupSet.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, backups),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(backups.DatasourceReference(), "backups.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(backups.DatasourceReference(), "backups.0.show_output.0.backup_id", backup.BackupId)),
+					assert.Check(resource.TestCheckResourceAttr(backups.DatasourceReference(), "backups.0.show_output.0.backup_set_name", backupSet.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(backups.DatasourceReference(), "backups.0.show_output.0.database_name", backupSet.ID().DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(backups.DatasourceReference(), "backups.0.show_output.0.schema_name", backupSet.ID().SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(backups.DatasourceReference(), "backups.0.show_output.0.is_under_legal_hold", "false")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, noBackups),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(noBackups.DatasourceReference(), "backups.#", "0")),
+				),
+			},
+		},
+	})
+}
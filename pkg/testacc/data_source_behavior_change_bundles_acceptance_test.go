@@ -0,0 +1,38 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcc_BehaviorChangeBundles_BasicUseCase(t *testing.T) {
+	bundles := testClient().BcrBundles.ShowActiveBundles(t)
+	require.NotEmpty(t, bundles)
+
+	bundlesModel := datasourcemodel.BehaviorChangeBundles("test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, bundlesModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(bundlesModel.DatasourceReference(), "behavior_change_bundles.#"),
+					resource.TestCheckResourceAttr(bundlesModel.DatasourceReference(), "behavior_change_bundles.0.name", bundles[0].Name),
+					resource.TestCheckResourceAttrSet(bundlesModel.DatasourceReference(), "behavior_change_bundles.0.is_default"),
+					resource.TestCheckResourceAttrSet(bundlesModel.DatasourceReference(), "behavior_change_bundles.0.is_enabled"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,136 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EffectivePrivileges_BasicUseCase(t *testing.T) {
+	databaseId := testClient().Ids.DatabaseId()
+
+	user, userCleanup := testClient().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+	parentRole, parentRoleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(parentRoleCleanup)
+	childRole, childRoleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(childRoleCleanup)
+
+	// user -> parent role -> child role
+	testClient().Role.GrantRoleToUser(t, parentRole.ID(), user.ID())
+	testClient().Role.GrantRoleToRole(t, childRole.ID(), parentRole.ID())
+	testClient().Grant.GrantPrivilegesOnDatabaseToAccountRole(t, childRole.ID(), databaseId, []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeMonitor}, false)
+	testClient().Grant.GrantFutureSchemaObjectPrivilegesInDatabaseToAccountRole(t, databaseId, sdk.PluralObjectTypeTables, childRole.ID(), sdk.SchemaObjectPrivilegeSelect)
+
+	userModel := datasourcemodel.EffectivePrivileges("test").
+		WithUser(user.ID().Name()).
+		WithOnValue(tfconfig.ListVariable(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"object_type": tfconfig.StringVariable("DATABASE"),
+			"object_name": tfconfig.StringVariable(databaseId.Name()),
+		})))
+	roleModel := datasourcemodel.EffectivePrivileges("test").
+		WithAccountRole(parentRole.ID().Name()).
+		WithOnValue(tfconfig.ListVariable(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"object_type": tfconfig.StringVariable("TABLE"),
+		})))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, userModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_roles.#", "2"),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_roles.0", parentRole.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_roles.1", childRole.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.#", "1"),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.privilege", "MONITOR"),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.granted_on", "DATABASE"),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.name", databaseId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.is_future", "false"),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.grantee_type", "ROLE"),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.grantee_name", childRole.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.path.#", "3"),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.path.0", user.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.path.1", parentRole.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.path.2", childRole.ID().FullyQualifiedName()),
+				),
+			},
+			{
+				Config: config.FromModels(t, roleModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(roleModel.DatasourceReference(), "effective_privileges.#", "1"),
+					resource.TestCheckResourceAttr(roleModel.DatasourceReference(), "effective_privileges.0.privilege", "SELECT"),
+					resource.TestCheckResourceAttr(roleModel.DatasourceReference(), "effective_privileges.0.granted_on", "TABLE"),
+					resource.TestCheckResourceAttr(roleModel.DatasourceReference(), "effective_privileges.0.is_future", "true"),
+					resource.TestCheckResourceAttr(roleModel.DatasourceReference(), "effective_privileges.0.path.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+// SHOW GRANTS TO USER does not return the granted roles in the columns read by the provider,
+// so this test proves that the roles granted to the user are resolved and only they are expanded.
+func TestAcc_EffectivePrivileges_User(t *testing.T) {
+	databaseId := testClient().Ids.DatabaseId()
+
+	user, userCleanup := testClient().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+	otherUser, otherUserCleanup := testClient().User.CreateUser(t)
+	t.Cleanup(otherUserCleanup)
+	role, roleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+	inheritedRole, inheritedRoleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(inheritedRoleCleanup)
+	otherRole, otherRoleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(otherRoleCleanup)
+
+	// user -> role -> inherited role; other user -> other role
+	testClient().Role.GrantRoleToUser(t, role.ID(), user.ID())
+	testClient().Role.GrantRoleToRole(t, inheritedRole.ID(), role.ID())
+	testClient().Role.GrantRoleToUser(t, otherRole.ID(), otherUser.ID())
+	testClient().Grant.GrantPrivilegesOnDatabaseToAccountRole(t, inheritedRole.ID(), databaseId, []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeUsage}, false)
+	testClient().Grant.GrantPrivilegesOnDatabaseToAccountRole(t, otherRole.ID(), databaseId, []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeMonitor}, false)
+
+	userModel := datasourcemodel.EffectivePrivileges("test").
+		WithUser(user.ID().Name()).
+		WithOnValue(tfconfig.ListVariable(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"object_type": tfconfig.StringVariable("DATABASE"),
+			"object_name": tfconfig.StringVariable(databaseId.Name()),
+		})))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, userModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_roles.#", "2"),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_roles.0", role.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_roles.1", inheritedRole.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.#", "1"),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.privilege", "USAGE"),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.grantee_name", inheritedRole.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.path.#", "3"),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.path.0", user.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.path.1", role.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(userModel.DatasourceReference(), "effective_privileges.0.path.2", inheritedRole.ID().FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
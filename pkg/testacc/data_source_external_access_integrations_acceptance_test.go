@@ -0,0 +1,122 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExternalAccessIntegrations_BasicUseCase_DifferentFiltering(t *testing.T) {
+	prefix := random.AlphaN(4)
+	idOne := testClient().Ids.RandomAccountObjectIdentifierWithPrefix(prefix)
+	idTwo := testClient().Ids.RandomAccountObjectIdentifierWithPrefix(prefix)
+	idThree := testClient().Ids.RandomAccountObjectIdentifier()
+
+	networkRule, networkRuleCleanup := testClient().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+	networkRules := []string{networkRule.ID().FullyQualifiedName()}
+
+	model1 := model.ExternalAccessIntegration("test1", idOne.Name(), networkRules, true)
+	model2 := model.ExternalAccessIntegration("test2", idTwo.Name(), networkRules, false)
+	model3 := model.ExternalAccessIntegration("test3", idThree.Name(), networkRules, true)
+
+	likeFirst := datasourcemodel.ExternalAccessIntegrations("test").
+		WithWithDescribe(false).
+		WithLike(idOne.Name()).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	likePrefix := datasourcemodel.ExternalAccessIntegrations("test").
+		WithWithDescribe(false).
+		WithLike(prefix+"%").
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ExternalAccessIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, likeFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(likeFirst.DatasourceReference(), "external_access_integrations.#", "1"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, likePrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(likePrefix.DatasourceReference(), "external_access_integrations.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ExternalAccessIntegrations_CompleteUseCase(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+
+	networkRule, networkRuleCleanup := testClient().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	secretId, secretCleanup := testClient().Secret.CreateRandomPasswordSecret(t)
+	t.Cleanup(secretCleanup)
+
+	integrationModel := model.ExternalAccessIntegration("test", id.Name(), []string{networkRule.ID().FullyQualifiedName()}, true).
+		WithAllowedAuthenticationSecrets(secretId).
+		WithComment(comment)
+
+	withoutDescribe := datasourcemodel.ExternalAccessIntegrations("test").
+		WithWithDescribe(false).
+		WithLike(id.Name()).
+		WithDependsOn(integrationModel.ResourceReference())
+
+	withDescribe := datasourcemodel.ExternalAccessIntegrations("test").
+		WithLike(id.Name()).
+		WithDependsOn(integrationModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ExternalAccessIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, integrationModel, withoutDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(withoutDescribe.DatasourceReference(), "external_access_integrations.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(withoutDescribe.DatasourceReference(), "external_access_integrations.0.show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(withoutDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(withoutDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.integration_type", "EXTERNAL_ACCESS")),
+					assert.Check(resource.TestCheckResourceAttr(withoutDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.category", "SECURITY")),
+					assert.Check(resource.TestCheckResourceAttr(withoutDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.enabled", "true")),
+					assert.Check(resource.TestCheckResourceAttr(withoutDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.comment", comment)),
+					assert.Check(resource.TestCheckResourceAttr(withoutDescribe.DatasourceReference(), "external_access_integrations.0.describe_output.#", "0")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, integrationModel, withDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), "external_access_integrations.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), "external_access_integrations.0.describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), "external_access_integrations.0.describe_output.0.enabled", "true")),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), "external_access_integrations.0.describe_output.0.allowed_network_rules.0", networkRule.ID().FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), "external_access_integrations.0.describe_output.0.allowed_api_authentication_integrations.#", "0")),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), "external_access_integrations.0.describe_output.0.allowed_authentication_secrets.0", secretId.FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), "external_access_integrations.0.describe_output.0.comment", comment)),
+				),
+			},
+		},
+	})
+}
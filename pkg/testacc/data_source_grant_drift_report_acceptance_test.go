@@ -0,0 +1,73 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GrantDriftReport_BasicUseCase(t *testing.T) {
+	role, roleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+	database, databaseCleanup := testClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := testClient().Schema.CreateSchemaInDatabase(t, database.ID())
+	t.Cleanup(schemaCleanup)
+
+	testClient().Grant.GrantPrivilegesOnDatabaseToAccountRole(t, role.ID(), database.ID(), []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeUsage}, false)
+	testClient().Grant.GrantPrivilegesOnSchemaToAccountRole(t, role.ID(), schema.ID(), []sdk.SchemaPrivilege{sdk.SchemaPrivilegeUsage}, false)
+
+	managedId := resources.NewGrantPrivilegesToAccountRoleIdOnAccountObject(role.ID(), false, false, false, sdk.ObjectTypeDatabase, database.ID(), "USAGE")
+	unmanagedId := resources.NewGrantPrivilegesToAccountRoleIdOnSchemaOnSchema(role.ID(), false, false, false, schema.ID(), "USAGE")
+
+	databaseModel := datasourcemodel.GrantDriftReport("test").
+		WithDatabase(database.ID().Name()).
+		WithManagedGrantIdsValue(tfconfig.SetVariable(tfconfig.StringVariable(managedId.String())))
+	roleModel := datasourcemodel.GrantDriftReport("test").
+		WithAccountRole(role.ID().Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, databaseModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(databaseModel.DatasourceReference(), "managed_grants.#", "1"),
+					resource.TestCheckResourceAttr(databaseModel.DatasourceReference(), "managed_grants.0.privilege", "USAGE"),
+					resource.TestCheckResourceAttr(databaseModel.DatasourceReference(), "managed_grants.0.granted_on", "DATABASE"),
+					resource.TestCheckResourceAttr(databaseModel.DatasourceReference(), "managed_grants.0.grantee_name", role.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(databaseModel.DatasourceReference(), "managed_grants.0.grant_id", managedId.String()),
+					resource.TestCheckTypeSetElemNestedAttrs(databaseModel.DatasourceReference(), "unmanaged_grants.*", map[string]string{
+						"privilege":    "USAGE",
+						"granted_on":   "SCHEMA",
+						"name":         schema.ID().FullyQualifiedName(),
+						"grantee_name": role.ID().FullyQualifiedName(),
+						"is_future":    "false",
+						"grant_id":     unmanagedId.String(),
+					}),
+				),
+			},
+			{
+				Config: config.FromModels(t, roleModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(roleModel.DatasourceReference(), "managed_grants.#", "0"),
+					resource.TestCheckResourceAttr(roleModel.DatasourceReference(), "unmanaged_grants.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(roleModel.DatasourceReference(), "unmanaged_grants.*", map[string]string{
+						"grant_id": managedId.String(),
+					}),
+				),
+			},
+		},
+	})
+}
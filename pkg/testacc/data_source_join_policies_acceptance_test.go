@@ -0,0 +1,15 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+)
+
+func TestAcc_JoinPolicies_BasicUseCase_DifferentFiltering(t *testing.T) {
+	joinPolicyTestDefinition.testDatasourceDifferentFiltering(t)
+}
+
+func TestAcc_JoinPolicies_CompleteUseCase(t *testing.T) {
+	joinPolicyTestDefinition.testDatasourceCompleteUseCase(t)
+}
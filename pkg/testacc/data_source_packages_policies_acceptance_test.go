@@ -0,0 +1,117 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_PackagesPolicies_BasicUseCase_DifferentFiltering(t *testing.T) {
+	schema, schemaCleanup := testClient().Schema.CreateSchema(t)
+	t.Cleanup(schemaCleanup)
+
+	idOne := testClient().Ids.RandomSchemaObjectIdentifierInSchema(schema.ID())
+	idTwo := testClient().Ids.RandomSchemaObjectIdentifierInSchema(schema.ID())
+	idThree := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	model1 := model.PackagesPolicyFromId("test1", idOne)
+	model2 := model.PackagesPolicyFromId("test2", idTwo)
+	model3 := model.PackagesPolicyFromId("test3", idThree)
+
+	inSchema := datasourcemodel.PackagesPolicies("test").
+		WithWithDescribe(false).
+		WithInSchema(schema.ID()).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	inDatabase := datasourcemodel.PackagesPolicies("test").
+		WithWithDescribe(false).
+		WithInDatabase(idOne.DatabaseId()).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.PackagesPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, inSchema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(inSchema.DatasourceReference(), "packages_policies.#", "2"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, inDatabase),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(inDatabase.DatasourceReference(), "packages_policies.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_PackagesPolicies_CompleteUseCase(t *testing.T) {
+	schema, schemaCleanup := testClient().Schema.CreateSchema(t)
+	t.Cleanup(schemaCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifierInSchema(schema.ID())
+	comment := random.Comment()
+
+	policyModel := model.PackagesPolicyFromId("test", id).
+		WithBlocklist("scipy").
+		WithComment(comment)
+
+	withoutDescribe := datasourcemodel.PackagesPolicies("test").
+		WithWithDescribe(false).
+		WithInSchema(schema.ID()).
+		WithDependsOn(policyModel.ResourceReference())
+
+	withDescribe := datasourcemodel.PackagesPolicies("test").
+		WithInSchema(schema.ID()).
+		WithDependsOn(policyModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.PackagesPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, policyModel, withoutDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(withoutDescribe.DatasourceReference(), "packages_policies.#", "1")),
+					resourceshowoutputassert.PackagesPoliciesDatasourceShowOutput(t, "snowflake_packages_policies.test").
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasKind("PACKAGES_POLICY").
+						HasComment(comment),
+					assert.Check(resource.TestCheckResourceAttr(withoutDescribe.DatasourceReference(), "packages_policies.0.describe_output.#", "0")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, policyModel, withDescribe),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), "packages_policies.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), "packages_policies.0.describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), "packages_policies.0.describe_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), "packages_policies.0.describe_output.0.language", string(sdk.PackagesPolicyLanguagePython))),
+					assert.Check(resource.TestCheckResourceAttr(withDescribe.DatasourceReference(), "packages_policies.0.describe_output.0.comment", comment)),
+				),
+			},
+		},
+	})
+}
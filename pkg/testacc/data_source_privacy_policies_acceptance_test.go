@@ -0,0 +1,15 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+)
+
+func TestAcc_PrivacyPolicies_BasicUseCase_DifferentFiltering(t *testing.T) {
+	privacyPolicyTestDefinition.testDatasourceDifferentFiltering(t)
+}
+
+func TestAcc_PrivacyPolicies_CompleteUseCase(t *testing.T) {
+	privacyPolicyTestDefinition.testDatasourceCompleteUseCase(t)
+}
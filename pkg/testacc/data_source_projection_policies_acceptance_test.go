@@ -0,0 +1,15 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+)
+
+func TestAcc_ProjectionPolicies_BasicUseCase_DifferentFiltering(t *testing.T) {
+	projectionPolicyTestDefinition.testDatasourceDifferentFiltering(t)
+}
+
+func TestAcc_ProjectionPolicies_CompleteUseCase(t *testing.T) {
+	projectionPolicyTestDefinition.testDatasourceCompleteUseCase(t)
+}
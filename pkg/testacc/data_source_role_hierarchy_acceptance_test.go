@@ -0,0 +1,101 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_RoleHierarchy_BasicUseCase(t *testing.T) {
+	user, userCleanup := testClient().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+	rootRole, rootRoleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(rootRoleCleanup)
+	functionalRole, functionalRoleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(functionalRoleCleanup)
+	accessRole, accessRoleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(accessRoleCleanup)
+	orphanRole, orphanRoleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(orphanRoleCleanup)
+
+	// root role -> functional role -> access role; user -> functional role, user -> orphan role
+	testClient().Role.GrantRoleToRole(t, functionalRole.ID(), rootRole.ID())
+	testClient().Role.GrantRoleToRole(t, accessRole.ID(), functionalRole.ID())
+	testClient().Role.GrantRoleToUser(t, functionalRole.ID(), user.ID())
+	testClient().Role.GrantRoleToUser(t, orphanRole.ID(), user.ID())
+
+	model := datasourcemodel.RoleHierarchy("test").
+		WithRootRole(rootRole.ID().Name()).
+		WithFunctionalRolesValue(tfconfig.SetVariable(tfconfig.StringVariable(functionalRole.ID().Name())))
+	failingModel := datasourcemodel.RoleHierarchy("test").
+		WithRootRole(rootRole.ID().Name()).
+		WithFunctionalRolesValue(tfconfig.SetVariable(tfconfig.StringVariable(functionalRole.ID().Name()))).
+		WithFailOnDirectUserGrants(true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, model),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(model.DatasourceReference(), "nodes.*", map[string]string{
+						"type":                         "ROLE",
+						"name":                         accessRole.ID().FullyQualifiedName(),
+						"is_reachable_from_root":       "true",
+						"is_orphan":                    "false",
+						"is_granted_directly_to_users": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(model.DatasourceReference(), "nodes.*", map[string]string{
+						"type":                         "ROLE",
+						"name":                         orphanRole.ID().FullyQualifiedName(),
+						"is_reachable_from_root":       "false",
+						"is_orphan":                    "true",
+						"is_granted_directly_to_users": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(model.DatasourceReference(), "edges.*", map[string]string{
+						"from_type": "ROLE",
+						"from":      rootRole.ID().FullyQualifiedName(),
+						"to_type":   "ROLE",
+						"to":        functionalRole.ID().FullyQualifiedName(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(model.DatasourceReference(), "edges.*", map[string]string{
+						"from_type": "USER",
+						"from":      user.ID().FullyQualifiedName(),
+						"to_type":   "ROLE",
+						"to":        functionalRole.ID().FullyQualifiedName(),
+					}),
+					resource.TestCheckTypeSetElemAttr(model.DatasourceReference(), "orphan_roles.*", orphanRole.ID().FullyQualifiedName()),
+					resource.TestCheckTypeSetElemAttr(model.DatasourceReference(), "direct_user_grants.*", fmt.Sprintf("%s -> %s", user.ID().FullyQualifiedName(), orphanRole.ID().FullyQualifiedName())),
+					resource.TestCheckResourceAttrWith(model.DatasourceReference(), "dot", func(value string) error {
+						if !strings.HasPrefix(value, "digraph role_hierarchy {") {
+							return fmt.Errorf("expected a DOT digraph, got: %s", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith(model.DatasourceReference(), "json", func(value string) error {
+						if !strings.Contains(value, fmt.Sprintf(`"name":%q`, accessRole.ID().FullyQualifiedName())) {
+							return fmt.Errorf("expected %s in the JSON output, got: %s", accessRole.ID().FullyQualifiedName(), value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config:      config.FromModels(t, failingModel),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta(fmt.Sprintf("%s -> %s", user.ID().FullyQualifiedName(), orphanRole.ID().FullyQualifiedName()))),
+			},
+		},
+	})
+}
@@ -0,0 +1,88 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AccessProfile_BasicUseCase(t *testing.T) {
+	role, roleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+	database, databaseCleanup := testClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := testClient().Schema.CreateSchemaInDatabase(t, database.ID())
+	t.Cleanup(schemaCleanup)
+
+	privilegeSets := []model.AccessProfilePrivilegeSet{
+		{ObjectType: "DATABASE", Privileges: []string{"USAGE"}},
+		{ObjectType: "SCHEMA", Privileges: []string{"USAGE"}},
+		{ObjectType: "TABLE", Privileges: []string{"SELECT"}},
+	}
+	databaseModel := model.AccessProfile("test", role.ID().Name(),
+		[]model.AccessProfileObjectSelector{{Database: database.ID().Name()}},
+		privilegeSets,
+	)
+	schemaModel := model.AccessProfile("test", role.ID().Name(),
+		[]model.AccessProfileObjectSelector{{Database: database.ID().Name(), Schema: schema.ID().Name()}},
+		privilegeSets,
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			// create with the whole database selected
+			{
+				Config: config.FromModels(t, databaseModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(databaseModel.ResourceReference(), "id", role.ID().Name()),
+					resource.TestCheckTypeSetElemAttr(databaseModel.ResourceReference(), "expanded_grants.*", fmt.Sprintf("USAGE ON DATABASE %s", database.ID().FullyQualifiedName())),
+					resource.TestCheckTypeSetElemAttr(databaseModel.ResourceReference(), "expanded_grants.*", fmt.Sprintf("USAGE ON SCHEMA %s", schema.ID().FullyQualifiedName())),
+					resource.TestCheckTypeSetElemAttr(databaseModel.ResourceReference(), "expanded_grants.*", fmt.Sprintf("SELECT ON ALL TABLES IN DATABASE %s", database.ID().FullyQualifiedName())),
+					resource.TestCheckTypeSetElemAttr(databaseModel.ResourceReference(), "expanded_grants.*", fmt.Sprintf("SELECT ON FUTURE TABLES IN DATABASE %s", database.ID().FullyQualifiedName())),
+				),
+			},
+			// import reconstructs the selector of the whole database from the grants
+			{
+				Config:            config.FromModels(t, databaseModel),
+				ResourceName:      databaseModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// revoke a privilege externally and expect the drift to be reconciled
+			{
+				PreConfig: func() {
+					testClient().Grant.RevokePrivilegesOnDatabaseFromAccountRole(t, role.ID(), database.ID(), []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeUsage})
+				},
+				Config: config.FromModels(t, databaseModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(databaseModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(databaseModel.ResourceReference(), "expanded_grants.*", fmt.Sprintf("USAGE ON DATABASE %s", database.ID().FullyQualifiedName())),
+				),
+			},
+			// narrow the selector to a single schema
+			{
+				Config: config.FromModels(t, schemaModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(schemaModel.ResourceReference(), "expanded_grants.*", fmt.Sprintf("SELECT ON FUTURE TABLES IN SCHEMA %s", schema.ID().FullyQualifiedName())),
+					resource.TestCheckTypeSetElemAttr(schemaModel.ResourceReference(), "expanded_grants.*", fmt.Sprintf("SELECT ON ALL TABLES IN SCHEMA %s", schema.ID().FullyQualifiedName())),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,86 @@
+//go:build account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testprofiles"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// The test requires a Business Critical account. The endpoint id and the token have to be generated by the cloud provider
+// (e.g. the AWS account id and the federated token returned by `aws sts get-federation-token`).
+func TestAcc_AccountPrivatelinkAuthorization_BasicUseCase(t *testing.T) {
+	endpointId := testenvs.GetOrSkipTest(t, testenvs.PrivatelinkAuthorizationEndpointId)
+	token := testenvs.GetOrSkipTest(t, testenvs.PrivatelinkAuthorizationToken)
+
+	providerModel := providermodel.SnowflakeProvider().WithProfile(testprofiles.Secondary)
+	authorizationModel := model.AccountPrivatelinkAuthorization("test", endpointId, token)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: checkPrivatelinkAuthorizationRevoked(t, endpointId),
+		Steps: []resource.TestStep{
+			// create
+			{
+				ProtoV6ProviderFactories: secondaryAccountProviderFactory,
+				Config:                   config.FromModels(t, providerModel, authorizationModel),
+				Check: assertThat(t,
+					resourceassert.AccountPrivatelinkAuthorizationResource(t, authorizationModel.ResourceReference()).
+						HasEndpointIdString(endpointId).
+						HasTokenString(token).
+						HasEndpointIdTypeNotEmpty(),
+				),
+			},
+			// import
+			{
+				ProtoV6ProviderFactories: secondaryAccountProviderFactory,
+				Config:                   config.FromModels(t, providerModel, authorizationModel),
+				ResourceName:             authorizationModel.ResourceReference(),
+				ImportState:              true,
+				ImportStateVerify:        true,
+				ImportStateVerifyIgnore:  []string{"token"},
+			},
+			// revoke externally
+			{
+				ProtoV6ProviderFactories: secondaryAccountProviderFactory,
+				PreConfig: func() {
+					secondaryTestClient().Privatelink.RevokeFunc(t, endpointId, token)()
+				},
+				Config: config.FromModels(t, providerModel, authorizationModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(authorizationModel.ResourceReference(), plancheck.ResourceActionCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.AccountPrivatelinkAuthorizationResource(t, authorizationModel.ResourceReference()).
+						HasEndpointIdString(endpointId).
+						HasEndpointIdTypeNotEmpty(),
+				),
+			},
+		},
+	})
+}
+
+func checkPrivatelinkAuthorizationRevoked(t *testing.T, endpointId string) func(*terraform.State) error {
+	t.Helper()
+	return func(_ *terraform.State) error {
+		if _, found := secondaryTestClient().Privatelink.AuthorizedEndpoint(t, endpointId); found {
+			return fmt.Errorf("privatelink authorization for endpoint %s was not revoked", endpointId)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,74 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var aggregationPolicyTestDefinition = bodyPolicyTestDefinition[*model.AggregationPolicyModel, *datasourcemodel.AggregationPoliciesModel, *resourceassert.AggregationPolicyResourceAssert, *resourceshowoutputassert.AggregationPolicyShowOutputAssert]{
+	resource:   resources.AggregationPolicy,
+	kind:       "AGGREGATION_POLICY",
+	returnType: "AGGREGATION_CONSTRAINT",
+	body:       "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)",
+	newBody:    "NO_AGGREGATION_CONSTRAINT()",
+
+	model:                      model.AggregationPolicyFromId,
+	datasourceModel:            datasourcemodel.AggregationPolicies,
+	resourceAssert:             resourceassert.AggregationPolicyResource,
+	showOutputAssert:           resourceshowoutputassert.AggregationPolicyShowOutput,
+	datasourceShowOutputAssert: resourceshowoutputassert.AggregationPoliciesDatasourceShowOutput,
+	setBody: func(t *testing.T, id sdk.SchemaObjectIdentifier, body string) {
+		t.Helper()
+		testClient().AggregationPolicy.Alter(t, sdk.NewAlterAggregationPolicyRequest(id).WithSetBody(body))
+	},
+}
+
+func TestAcc_AggregationPolicy_BasicUseCase(t *testing.T) {
+	aggregationPolicyTestDefinition.testBasicUseCase(t)
+}
+
+func TestAcc_AggregationPolicy_SetOnView(t *testing.T) {
+	table, tableCleanup := testClient().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("id", sdk.DataTypeNumber),
+	})
+	t.Cleanup(tableCleanup)
+
+	policyId := testClient().Ids.RandomSchemaObjectIdentifier()
+	viewId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	policyModel := model.AggregationPolicyFromId("test", policyId, "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)")
+	viewModel := model.View("test", viewId.DatabaseName(), viewId.SchemaName(), viewId.Name(), fmt.Sprintf("SELECT id FROM %s", table.ID().FullyQualifiedName())).
+		WithAggregationPolicy(policyId, "ID").
+		WithDependsOn(policyModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.AggregationPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, policyModel, viewModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(viewModel.ResourceReference(), "aggregation_policy.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(viewModel.ResourceReference(), "aggregation_policy.0.policy_name", policyId.FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(viewModel.ResourceReference(), "aggregation_policy.0.entity_key.#", "1")),
+				),
+			},
+		},
+	})
+}
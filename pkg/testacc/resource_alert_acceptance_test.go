@@ -13,6 +13,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
@@ -204,6 +205,174 @@ resource "snowflake_alert" "test_alert" {
 	return result.String()
 }
 
+func TestAcc_Alert_ServerlessOnNewData(t *testing.T) {
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	condition := fmt.Sprintf("select * from %s", table.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Alert),
+		Steps: []resource.TestStep{
+			{
+				Config: alertServerlessOnNewDataConfig(id, condition, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "warehouse", ""),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "condition", condition),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "execute_trigger", "1"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "show_output.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "show_output.0.warehouse", ""),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "show_output.0.schedule", ""),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "show_output.0.state", string(sdk.AlertStateStarted)),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "describe_output.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "describe_output.0.condition", condition),
+				),
+			},
+			// change the trigger to execute the alert again and switch to a scheduled alert using a warehouse
+			{
+				Config: alertScheduledConfig(id, condition, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "warehouse", TestWarehouseName),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.0.interval", "5"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "execute_trigger", "2"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "show_output.0.warehouse", TestWarehouseName),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "show_output.0.schedule", "5 MINUTE"),
+				),
+			},
+			// unset both the warehouse and the schedule in a single ALTER ALERT ... UNSET
+			{
+				Config: alertServerlessOnNewDataConfig(id, condition, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_alert.test_alert", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "warehouse", ""),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "show_output.0.warehouse", ""),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "show_output.0.schedule", ""),
+				),
+			},
+		},
+	})
+}
+
+func alertServerlessOnNewDataConfig(id sdk.SchemaObjectIdentifier, condition string, executeTrigger string) string {
+	return fmt.Sprintf(`
+resource "snowflake_alert" "test_alert" {
+	database        = "%[1]s"
+	schema          = "%[2]s"
+	name            = "%[3]s"
+	condition       = %[4]s
+	action          = "select 1 as c"
+	enabled         = true
+	execute_trigger = "%[5]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), strconv.Quote(condition), executeTrigger)
+}
+
+func alertScheduledConfig(id sdk.SchemaObjectIdentifier, condition string, executeTrigger string) string {
+	return fmt.Sprintf(`
+resource "snowflake_alert" "test_alert" {
+	database        = "%[1]s"
+	schema          = "%[2]s"
+	name            = "%[3]s"
+	warehouse       = "%[6]s"
+	alert_schedule {
+		interval = 5
+	}
+	condition       = %[4]s
+	action          = "select 1 as c"
+	enabled         = true
+	execute_trigger = "%[5]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), strconv.Quote(condition), executeTrigger, TestWarehouseName)
+}
+
+func TestAcc_Alert_Parameters(t *testing.T) {
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	condition := fmt.Sprintf("select * from %s", table.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Alert),
+		Steps: []resource.TestStep{
+			{
+				Config: alertWithParametersConfig(id, condition, sdk.LogLevelInfo, sdk.TraceLevelAlways),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "log_level", string(sdk.LogLevelInfo)),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "trace_level", string(sdk.TraceLevelAlways)),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "parameters.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "parameters.0.log_level.0.value", string(sdk.LogLevelInfo)),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "parameters.0.log_level.0.level", string(sdk.ParameterTypeAlert)),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "parameters.0.trace_level.0.value", string(sdk.TraceLevelAlways)),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "parameters.0.trace_level.0.level", string(sdk.ParameterTypeAlert)),
+				),
+			},
+			{
+				Config: alertWithParametersConfig(id, condition, sdk.LogLevelWarn, sdk.TraceLevelOnEvent),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_alert.test_alert", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "log_level", string(sdk.LogLevelWarn)),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "trace_level", string(sdk.TraceLevelOnEvent)),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "parameters.0.log_level.0.value", string(sdk.LogLevelWarn)),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "parameters.0.trace_level.0.value", string(sdk.TraceLevelOnEvent)),
+				),
+			},
+			// unset both parameters in a single ALTER ALERT ... UNSET
+			{
+				Config: alertServerlessOnNewDataConfig(id, condition, "1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_alert.test_alert", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "parameters.0.log_level.0.level", ""),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "parameters.0.trace_level.0.level", ""),
+				),
+			},
+		},
+	})
+}
+
+func alertWithParametersConfig(id sdk.SchemaObjectIdentifier, condition string, logLevel sdk.LogLevel, traceLevel sdk.TraceLevel) string {
+	return fmt.Sprintf(`
+resource "snowflake_alert" "test_alert" {
+	database        = "%[1]s"
+	schema          = "%[2]s"
+	name            = "%[3]s"
+	condition       = %[4]s
+	action          = "select 1 as c"
+	enabled         = true
+	execute_trigger = "1"
+	log_level       = "%[5]s"
+	trace_level     = "%[6]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), strconv.Quote(condition), logLevel, traceLevel)
+}
+
 // Can't reproduce the issue, leaving the test for now.
 func TestAcc_Alert_Issue3117(t *testing.T) {
 	id := testClient().Ids.RandomSchemaObjectIdentifierWithPrefix("small caps with spaces")
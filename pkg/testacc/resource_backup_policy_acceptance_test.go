@@ -0,0 +1,183 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_BackupPolicy_BasicUseCase(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+	schedule := "USING CRON 0 3 * * * UTC"
+	comment := random.Comment()
+	newComment := random.Comment()
+
+	basic := model.BackupPolicyFromId("test", id)
+	complete := model.BackupPolicyFromId("test", id).
+		WithSchedule(schedule).
+		WithExpireAfterDays(7).
+		WithComment(comment)
+	renamed := model.BackupPolicyFromId("test", newId).
+		WithSchedule(schedule).
+		WithExpireAfterDays(7).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.BackupPolicy),
+		Steps: []resource.TestStep{
+			// Create - without optionals
+			{
+				Config: accconfig.FromModels(t, basic),
+				Check: assertThat(t,
+					resourceassert.BackupPolicyResource(t, basic.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasWithRetentionLockString("false").
+						HasScheduleString("").
+						HasExpireAfterDaysString("-1").
+						HasCommentString(""),
+					resourceshowoutputassert.BackupPolicyShowOutput(t, basic.ResourceReference()).
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasHasRetentionLock(false).
+						HasComment(""),
+				),
+			},
+			// Import - without optionals
+			{
+				Config:            accconfig.FromModels(t, basic),
+				ResourceName:      basic.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update - set optionals
+			{
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.BackupPolicyResource(t, complete.ResourceReference()).
+						HasScheduleString(schedule).
+						HasExpireAfterDaysString("7").
+						HasCommentString(comment),
+					resourceshowoutputassert.BackupPolicyShowOutput(t, complete.ResourceReference()).
+						HasSchedule(schedule).
+						HasExpireAfterDays(7).
+						HasComment(comment),
+				),
+			},
+			// Import - with optionals
+			{
+				Config:            accconfig.FromModels(t, complete),
+				ResourceName:      complete.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update - external change
+			{
+				PreConfig: func() {
+					testClient().BackupPolicy.Alter(t, sdk.NewAlterBackupPolicyRequest(id).WithSet(*sdk.NewBackupPolicySetRequest().WithComment(newComment)))
+				},
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					objectassert.BackupPolicy(t, id).
+						HasComment(comment),
+					resourceassert.BackupPolicyResource(t, complete.ResourceReference()).
+						HasCommentString(comment),
+				),
+			},
+			// Update - rename
+			{
+				Config: accconfig.FromModels(t, renamed),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(renamed.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.BackupPolicyResource(t, renamed.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+				),
+			},
+			// Update - unset optionals
+			{
+				Config: accconfig.FromModels(t, model.BackupPolicyFromId("test", newId)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(renamed.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.BackupPolicyResource(t, renamed.ResourceReference()).
+						HasScheduleString("").
+						HasExpireAfterDaysString("-1").
+						HasCommentString(""),
+					resourceshowoutputassert.BackupPolicyShowOutput(t, renamed.ResourceReference()).
+						HasComment(""),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_BackupPolicy_WithRetentionLock(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	withLock := model.BackupPolicyFromId("test", id).
+		WithWithRetentionLock(true).
+		WithExpireAfterDays(1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.BackupPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, withLock),
+				Check: assertThat(t,
+					resourceassert.BackupPolicyResource(t, withLock.ResourceReference()).
+						HasWithRetentionLockString("true"),
+					resourceshowoutputassert.BackupPolicyShowOutput(t, withLock.ResourceReference()).
+						HasHasRetentionLock(true),
+				),
+			},
+			{
+				Config:            accconfig.FromModels(t, withLock),
+				ResourceName:      withLock.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,97 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcc_BackupRestore_Table(t *testing.T) {
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	backupSet, backupSetCleanup := testClient().BackupSet.CreateForTable(t, table.ID())
+	t.Cleanup(backupSetCleanup)
+
+	backup := testClient().BackupSet.AddBackup(t, backupSet.ID())
+	restoredTableId := testClient().Ids.RandomSchemaObjectIdentifier()
+	newRestoredTableId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	restore := model.BackupRestore("test", backup.BackupId, backupSet.ID().FullyQualifiedName()).
+		WithRestoredTable(restoredTableId.FullyQualifiedName())
+	restoreWithNewName := model.BackupRestore("test", backup.BackupId, backupSet.ID().FullyQualifiedName()).
+		WithRestoredTable(newRestoredTableId.FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, id := range []sdk.SchemaObjectIdentifier{restoredTableId, newRestoredTableId} {
+				if _, err := testClient().Table.Show(t, id); err == nil {
+					return fmt.Errorf("restored table %s still exists", id.FullyQualifiedName())
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, restore),
+				Check: assertThat(t,
+					resourceassert.BackupRestoreResource(t, restore.ResourceReference()).
+						HasBackupSetString(backupSet.ID().FullyQualifiedName()).
+						HasBackupIdString(backup.BackupId).
+						HasRestoredTableString(restoredTableId.FullyQualifiedName()).
+						HasRestoredDatabaseString("").
+						HasRestoredSchemaString(""),
+					assert.Check(resource.TestCheckResourceAttr(restore.ResourceReference(), "id", helpers.EncodeResourceIdentifier(sdk.ObjectTypeTable.String(), restoredTableId.FullyQualifiedName()))),
+				),
+			},
+			// Restore to a different object
+			{
+				Config: accconfig.FromModels(t, restoreWithNewName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(restoreWithNewName.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.BackupRestoreResource(t, restoreWithNewName.ResourceReference()).
+						HasRestoredTableString(newRestoredTableId.FullyQualifiedName()),
+				),
+			},
+			// Restored object dropped externally
+			{
+				PreConfig: func() {
+					testClient().Table.DropFunc(t, newRestoredTableId)()
+					_, err := testClient().Table.Show(t, newRestoredTableId)
+					require.Error(t, err)
+				},
+				Config: accconfig.FromModels(t, restoreWithNewName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(restoreWithNewName.ResourceReference(), plancheck.ResourceActionCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.BackupRestoreResource(t, restoreWithNewName.ResourceReference()).
+						HasRestoredTableString(newRestoredTableId.FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,195 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_BackupSet_BasicUseCase(t *testing.T) {
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	backupPolicy, backupPolicyCleanup := testClient().BackupPolicy.Create(t)
+	t.Cleanup(backupPolicyCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	newComment := random.Comment()
+
+	basic := model.BackupSetFromId("test", id).
+		WithForTable(table.ID().FullyQualifiedName())
+	complete := model.BackupSetFromId("test", id).
+		WithForTable(table.ID().FullyQualifiedName()).
+		WithBackupPolicy(backupPolicy.ID().FullyQualifiedName()).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.BackupSet),
+		Steps: []resource.TestStep{
+			// Create - without optionals
+			{
+				Config: accconfig.FromModels(t, basic),
+				Check: assertThat(t,
+					resourceassert.BackupSetResource(t, basic.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasForTableString(table.ID().FullyQualifiedName()).
+						HasBackupPolicyString("").
+						HasCommentString(""),
+					resourceshowoutputassert.BackupSetShowOutput(t, basic.ResourceReference()).
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasObjectKind(string(sdk.ObjectTypeTable)).
+						HasObjectName(table.ID().Name()).
+						HasComment(""),
+				),
+			},
+			// Import - without optionals
+			{
+				Config:            accconfig.FromModels(t, basic),
+				ResourceName:      basic.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update - set optionals
+			{
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.BackupSetResource(t, complete.ResourceReference()).
+						HasBackupPolicyString(backupPolicy.ID().FullyQualifiedName()).
+						HasCommentString(comment),
+					resourceshowoutputassert.BackupSetShowOutput(t, complete.ResourceReference()).
+						HasBackupPolicyName(backupPolicy.ID().Name()).
+						HasComment(comment),
+				),
+			},
+			// Import - with optionals
+			{
+				Config:            accconfig.FromModels(t, complete),
+				ResourceName:      complete.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update - external change
+			{
+				PreConfig: func() {
+					testClient().BackupSet.Alter(t, sdk.NewAlterBackupSetRequest(id).WithSetComment(newComment))
+				},
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					objectassert.BackupSet(t, id).
+						HasComment(comment),
+					resourceassert.BackupSetResource(t, complete.ResourceReference()).
+						HasCommentString(comment),
+				),
+			},
+			// Update - unset optionals (removing the backup policy recreates the backup set)
+			{
+				Config: accconfig.FromModels(t, basic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(basic.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.BackupSetResource(t, basic.ResourceReference()).
+						HasBackupPolicyString("").
+						HasCommentString(""),
+					resourceshowoutputassert.BackupSetShowOutput(t, basic.ResourceReference()).
+						HasComment(""),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_BackupSet_ForDatabaseAndSchema(t *testing.T) {
+	database, databaseCleanup := testClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+
+	schema, schemaCleanup := testClient().Schema.CreateSchema(t)
+	t.Cleanup(schemaCleanup)
+
+	forDatabase := model.BackupSetFromId("test", testClient().Ids.RandomSchemaObjectIdentifier()).
+		WithForDatabase(database.ID().FullyQualifiedName())
+	forSchema := model.BackupSetFromId("test", testClient().Ids.RandomSchemaObjectIdentifier()).
+		WithForSchema(schema.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.BackupSet),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, forDatabase),
+				Check: assertThat(t,
+					resourceassert.BackupSetResource(t, forDatabase.ResourceReference()).
+						HasForDatabaseString(database.ID().FullyQualifiedName()),
+					resourceshowoutputassert.BackupSetShowOutput(t, forDatabase.ResourceReference()).
+						HasObjectKind(string(sdk.ObjectTypeDatabase)).
+						HasObjectName(database.ID().Name()),
+				),
+			},
+			{
+				Config:            accconfig.FromModels(t, forDatabase),
+				ResourceName:      forDatabase.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: accconfig.FromModels(t, forSchema),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(forSchema.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.BackupSetResource(t, forSchema.ResourceReference()).
+						HasForSchemaString(schema.ID().FullyQualifiedName()),
+					resourceshowoutputassert.BackupSetShowOutput(t, forSchema.ResourceReference()).
+						HasObjectKind(string(sdk.ObjectTypeSchema)).
+						HasObjectName(schema.ID().Name()).
+						HasObjectDatabaseName(schema.ID().DatabaseName()),
+				),
+			},
+			{
+				Config:            accconfig.FromModels(t, forSchema),
+				ResourceName:      forSchema.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
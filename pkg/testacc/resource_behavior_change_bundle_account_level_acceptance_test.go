@@ -0,0 +1,116 @@
+//go:build account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testprofiles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+// The test does not assume which bundle is enabled by default. It toggles the last active bundle and expects
+// the default state to be restored after the resource is destroyed.
+func TestAcc_BehaviorChangeBundle_BasicUseCase(t *testing.T) {
+	bundles := secondaryTestClient().BcrBundles.ShowActiveBundles(t)
+	require.NotEmpty(t, bundles)
+	bundle := bundles[len(bundles)-1]
+
+	statusFromBool := func(enabled bool) string {
+		if enabled {
+			return string(sdk.BehaviorChangeBundleStatusEnabled)
+		}
+		return string(sdk.BehaviorChangeBundleStatusDisabled)
+	}
+
+	providerModel := providermodel.SnowflakeProvider().WithProfile(testprofiles.Secondary)
+	nonDefaultModel := model.BehaviorChangeBundle("test", bundle.Name, !bundle.IsDefault)
+	defaultModel := model.BehaviorChangeBundle("test", bundle.Name, bundle.IsDefault)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: checkBehaviorChangeBundleRestored(t, bundle),
+		Steps: []resource.TestStep{
+			// create with the non-default state
+			{
+				ProtoV6ProviderFactories: secondaryAccountProviderFactory,
+				Config:                   config.FromModels(t, providerModel, nonDefaultModel),
+				Check: assertThat(t,
+					resourceassert.BehaviorChangeBundleResource(t, nonDefaultModel.ResourceReference()).
+						HasNameString(bundle.Name).
+						HasEnabledString(fmt.Sprintf("%t", !bundle.IsDefault)).
+						HasStatusString(statusFromBool(!bundle.IsDefault)).
+						HasIsDefaultString(fmt.Sprintf("%t", bundle.IsDefault)),
+				),
+			},
+			// import
+			{
+				ProtoV6ProviderFactories: secondaryAccountProviderFactory,
+				Config:                   config.FromModels(t, providerModel, nonDefaultModel),
+				ResourceName:             nonDefaultModel.ResourceReference(),
+				ImportState:              true,
+				ImportStateVerify:        true,
+			},
+			// change externally
+			{
+				ProtoV6ProviderFactories: secondaryAccountProviderFactory,
+				PreConfig: func() {
+					if bundle.IsDefault {
+						secondaryTestClient().BcrBundles.EnableBcrBundle(t, bundle.Name)
+					} else {
+						secondaryTestClient().BcrBundles.DisableBcrBundle(t, bundle.Name)
+					}
+				},
+				Config: config.FromModels(t, providerModel, nonDefaultModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(nonDefaultModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.BehaviorChangeBundleResource(t, nonDefaultModel.ResourceReference()).
+						HasEnabledString(fmt.Sprintf("%t", !bundle.IsDefault)).
+						HasStatusString(statusFromBool(!bundle.IsDefault)),
+				),
+			},
+			// update to the default state
+			{
+				ProtoV6ProviderFactories: secondaryAccountProviderFactory,
+				Config:                   config.FromModels(t, providerModel, defaultModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(defaultModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.BehaviorChangeBundleResource(t, defaultModel.ResourceReference()).
+						HasEnabledString(fmt.Sprintf("%t", bundle.IsDefault)).
+						HasStatusString(statusFromBool(bundle.IsDefault)),
+				),
+			},
+		},
+	})
+}
+
+func checkBehaviorChangeBundleRestored(t *testing.T, bundle sdk.BehaviorChangeBundleInfo) func(*terraform.State) error {
+	t.Helper()
+	return func(_ *terraform.State) error {
+		status := secondaryTestClient().BcrBundles.BehaviorChangeBundleStatus(t, bundle.Name)
+		if (status == sdk.BehaviorChangeBundleStatusEnabled) != bundle.IsDefault {
+			return fmt.Errorf("behavior change bundle %s was not restored to its default state, current status: %s", bundle.Name, status)
+		}
+		return nil
+	}
+}
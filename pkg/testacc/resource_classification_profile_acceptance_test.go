@@ -0,0 +1,186 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ClassificationProfile_BasicUseCase(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	tag, tagCleanup := testClient().Tag.CreateTag(t)
+	t.Cleanup(tagCleanup)
+
+	basic := model.ClassificationProfileFromId("test", id)
+	complete := model.ClassificationProfileFromId("test", id).
+		WithMinimumObjectAgeForClassificationDays(1).
+		WithMaximumClassificationValidityDays(30).
+		WithAutoTag(true).
+		WithTagMap(sdk.ClassificationProfileColumnTagMapEntry{
+			TagName:            tag.ID(),
+			TagValue:           sdk.String("pii"),
+			SemanticCategories: []string{"NAME", "EMAIL"},
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ClassificationProfile),
+		Steps: []resource.TestStep{
+			// Create - without optionals
+			{
+				Config: accconfig.FromModels(t, basic),
+				Check: assertThat(t,
+					resourceassert.ClassificationProfileResource(t, basic.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasMinimumObjectAgeForClassificationDaysString("-1").
+						HasMaximumClassificationValidityDaysString("-1").
+						HasAutoTagString("false").
+						HasTagMapEmpty().
+						HasCustomClassifiersEmpty(),
+					resourceshowoutputassert.ClassificationProfileShowOutput(t, basic.ResourceReference()).
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()),
+				),
+			},
+			// Import - without optionals
+			{
+				Config:            accconfig.FromModels(t, basic),
+				ResourceName:      basic.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update - set optionals
+			{
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ClassificationProfileResource(t, complete.ResourceReference()).
+						HasMinimumObjectAgeForClassificationDaysString("1").
+						HasMaximumClassificationValidityDaysString("30").
+						HasAutoTagString("true"),
+					assert.Check(resource.TestCheckResourceAttr(complete.ResourceReference(), "tag_map.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(complete.ResourceReference(), "tag_map.0.column_tag_map.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(complete.ResourceReference(), "tag_map.0.column_tag_map.0.tag_name", tag.ID().FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(complete.ResourceReference(), "tag_map.0.column_tag_map.0.tag_value", "pii")),
+					assert.Check(resource.TestCheckResourceAttr(complete.ResourceReference(), "tag_map.0.column_tag_map.0.semantic_categories.#", "2")),
+				),
+			},
+			// Import - with optionals
+			{
+				Config:                  accconfig.FromModels(t, complete),
+				ResourceName:            complete.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"minimum_object_age_for_classification_days", "maximum_classification_validity_days"},
+			},
+			// Update - external change
+			{
+				PreConfig: func() {
+					testClient().ClassificationProfile.SetAutoTag(t, id, false)
+				},
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ClassificationProfileResource(t, complete.ResourceReference()).
+						HasAutoTagString("true"),
+				),
+			},
+			// Update - unset optionals (validity settings can't be unset, so the resource is recreated)
+			{
+				Config: accconfig.FromModels(t, basic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(basic.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ClassificationProfileResource(t, basic.ResourceReference()).
+						HasMinimumObjectAgeForClassificationDaysString("-1").
+						HasMaximumClassificationValidityDaysString("-1").
+						HasAutoTagString("false").
+						HasTagMapEmpty(),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ClassificationProfile_SetOnDatabaseAndSchema(t *testing.T) {
+	classificationProfileId := testClient().Ids.RandomSchemaObjectIdentifier()
+	databaseId := testClient().Ids.RandomAccountObjectIdentifier()
+	schemaId := testClient().Ids.RandomDatabaseObjectIdentifierInDatabase(databaseId)
+
+	classificationProfileModel := model.ClassificationProfileFromId("test", classificationProfileId).
+		WithMaximumClassificationValidityDays(30)
+	databaseModel := model.Database("test", databaseId.Name()).
+		WithClassificationProfile(classificationProfileId.FullyQualifiedName()).
+		WithDependsOn(classificationProfileModel.ResourceReference())
+	schemaModel := model.Schema("test", databaseId.Name(), schemaId.Name()).
+		WithClassificationProfile(classificationProfileId.FullyQualifiedName()).
+		WithDependsOn(databaseModel.ResourceReference())
+	databaseModelWithoutProfile := model.Database("test", databaseId.Name()).
+		WithDependsOn(classificationProfileModel.ResourceReference())
+	schemaModelWithoutProfile := model.Schema("test", databaseId.Name(), schemaId.Name()).
+		WithDependsOn(databaseModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ClassificationProfile),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, classificationProfileModel, databaseModel, schemaModel),
+				Check: assertThat(t,
+					resourceassert.DatabaseResource(t, databaseModel.ResourceReference()).
+						HasClassificationProfileString(classificationProfileId.FullyQualifiedName()),
+					resourceassert.SchemaResource(t, schemaModel.ResourceReference()).
+						HasClassificationProfileString(classificationProfileId.FullyQualifiedName()),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, classificationProfileModel, databaseModelWithoutProfile, schemaModelWithoutProfile),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(databaseModel.ResourceReference(), plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(schemaModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DatabaseResource(t, databaseModel.ResourceReference()).
+						HasClassificationProfileString(""),
+					resourceassert.SchemaResource(t, schemaModel.ResourceReference()).
+						HasClassificationProfileString(""),
+				),
+			},
+		},
+	})
+}
@@ -43,6 +43,12 @@ func TestAcc_Database_BasicUseCase(t *testing.T) {
 	catalogId, catalogCleanup := testClient().CatalogIntegration.Create(t)
 	t.Cleanup(catalogCleanup)
 
+	classificationProfile, classificationProfileCleanup := testClient().ClassificationProfile.Create(t)
+	t.Cleanup(classificationProfileCleanup)
+
+	newClassificationProfile, newClassificationProfileCleanup := testClient().ClassificationProfile.Create(t)
+	t.Cleanup(newClassificationProfileCleanup)
+
 	basic := model.Database("test", id.Name())
 
 	assertBasic := []assert.TestCheckFuncProvider{
@@ -78,6 +84,7 @@ func TestAcc_Database_BasicUseCase(t *testing.T) {
 			HasIsTransientString("false").
 			HasReplicationEmpty().
 			HasCommentEmpty().
+			HasClassificationProfileEmpty().
 			HasAllDefaultParameters(),
 	}
 
@@ -85,6 +92,7 @@ func TestAcc_Database_BasicUseCase(t *testing.T) {
 		WithDropPublicSchemaOnCreation(true).
 		WithReplication(secondaryAccountId, true, true).
 		WithComment(comment).
+		WithClassificationProfile(classificationProfile.ID().FullyQualifiedName()).
 		WithDataRetentionTimeInDays(2).
 		WithMaxDataExtensionTimeInDays(15).
 		WithExternalVolume(externalVolumeId.Name()).
@@ -135,6 +143,7 @@ func TestAcc_Database_BasicUseCase(t *testing.T) {
 			HasIsTransientString("false").
 			HasReplication(secondaryAccountId, true, true).
 			HasCommentString(comment).
+			HasClassificationProfileString(classificationProfile.ID().FullyQualifiedName()).
 			HasDataRetentionTimeInDaysString("2").
 			HasMaxDataExtensionTimeInDaysString("15").
 			HasExternalVolumeString(externalVolumeId.Name()).
@@ -153,6 +162,9 @@ func TestAcc_Database_BasicUseCase(t *testing.T) {
 			HasEnableConsoleOutputString("true"),
 	}
 
+	withNewClassificationProfile := model.Database("test", newId.Name()).
+		WithClassificationProfile(newClassificationProfile.ID().FullyQualifiedName())
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
 		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
@@ -194,7 +206,22 @@ func TestAcc_Database_BasicUseCase(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"drop_public_schema_on_creation",
 					"replication.0.ignore_edition_check",
+					// Snowflake does not return the classification profile set on the database
+					"classification_profile",
+				},
+			},
+			// Update - change classification profile
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(withNewClassificationProfile.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
 				},
+				Config: accconfig.FromModels(t, withNewClassificationProfile),
+				Check: assertThat(t,
+					resourceassert.DatabaseResource(t, withNewClassificationProfile.ResourceReference()).
+						HasClassificationProfileString(newClassificationProfile.ID().FullyQualifiedName()),
+				),
 			},
 			// Update - unset optionals
 			{
@@ -1211,6 +1238,54 @@ func TestAcc_Database_WithPublicSchema(t *testing.T) {
 	})
 }
 
+func TestAcc_Database_RecoverIfDropped(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+
+	_, databaseCleanup := testClient().Database.CreateDatabaseWithIdentifier(t, id)
+	t.Cleanup(databaseCleanup)
+	schema, _ := testClient().Schema.CreateSchemaInDatabase(t, id)
+	require.NoError(t, testClient().Database.DropDatabase(t, id))
+
+	databaseModel := model.Database("test", id.Name()).
+		WithRecoverIfDropped(true).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Database),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, databaseModel),
+				Check: assertThat(t,
+					resourceassert.DatabaseResource(t, databaseModel.ResourceReference()).
+						HasNameString(id.Name()).
+						HasRecoverIfDroppedString("true").
+						HasCommentString(comment),
+					objectassert.Database(t, id).
+						HasName(id.Name()).
+						HasComment(comment),
+					// the schema created before the drop is recovered together with the database
+					objectassert.Schema(t, schema.ID()).
+						HasName(schema.ID().Name()),
+				),
+			},
+			// change in the parameter shouldn't change the state Snowflake
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(databaseModel.ResourceReference(), plancheck.ResourceActionNoop),
+					},
+				},
+				Config: accconfig.FromModels(t, databaseModel.WithRecoverIfDropped(false)),
+			},
+		},
+	})
+}
+
 func databaseWithDropPublicSchemaConfig(id sdk.AccountObjectIdentifier, withDropPublicSchema bool) string {
 	return fmt.Sprintf(`
 resource "snowflake_database" "test" {
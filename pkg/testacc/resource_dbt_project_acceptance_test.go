@@ -0,0 +1,242 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/importchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_DbtProject_BasicUseCase(t *testing.T) {
+	stage, stageCleanup := testClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+
+	testClient().DbtProject.PutSampleProjectOnStage(t, stage.Location()+"/v1")
+	testClient().DbtProject.PutSampleProjectOnStage(t, stage.Location()+"/v2")
+
+	locationV1 := sdk.NewStageLocation(stage.ID(), "v1")
+	locationV2 := sdk.NewStageLocation(stage.ID(), "v2")
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	basic := model.DbtProjectFromId("test", id, locationV1)
+
+	complete := model.DbtProjectFromId("test", id, locationV1).
+		WithDefaultArgs("--select sample_model").
+		WithDefaultTarget("prod").
+		WithComment(comment)
+
+	withNewVersion := model.DbtProjectFromId("test", id, locationV1).
+		WithFromWithVersionAlias(locationV2, "second").
+		WithDefaultArgs("--select sample_model").
+		WithDefaultTarget("prod").
+		WithComment(comment)
+
+	withChangedSources := model.DbtProjectFromId("test", id, locationV1).
+		WithFromWithVersionTrigger(locationV2, "sources-changed").
+		WithDefaultArgs("--select sample_model").
+		WithDefaultTarget("prod").
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.DbtProject),
+		Steps: []resource.TestStep{
+			// Create - without optionals
+			{
+				Config: accconfig.FromModels(t, basic),
+				Check: assertThat(t,
+					resourceassert.DbtProjectResource(t, basic.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasDefaultArgsString("").
+						HasDefaultTargetString("").
+						HasExternalAccessIntegrationsEmpty().
+						HasCommentString(""),
+					resourceshowoutputassert.DbtProjectShowOutput(t, basic.ResourceReference()).
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasComment(""),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "versions.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "versions.0.name", "VERSION$1")),
+				),
+			},
+			// Import - without optionals
+			{
+				Config:                  accconfig.FromModels(t, basic),
+				ResourceName:            basic.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"from"},
+			},
+			// Update - set optionals
+			{
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DbtProjectResource(t, complete.ResourceReference()).
+						HasDefaultArgsString("--select sample_model").
+						HasDefaultTargetString("prod").
+						HasCommentString(comment),
+					resourceshowoutputassert.DbtProjectShowOutput(t, complete.ResourceReference()).
+						HasDefaultArgs("--select sample_model").
+						HasDefaultTarget("prod").
+						HasComment(comment),
+				),
+			},
+			// Update - external change
+			{
+				PreConfig: func() {
+					testClient().DbtProject.Alter(t, sdk.NewAlterDbtProjectRequest(id).WithSet(*sdk.NewDbtProjectSetRequest().
+						WithDefaultTarget("dev"),
+					))
+				},
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DbtProjectResource(t, complete.ResourceReference()).
+						HasDefaultTargetString("prod"),
+				),
+			},
+			// Update - new version from a different location
+			{
+				Config: accconfig.FromModels(t, withNewVersion),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(withNewVersion.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(withNewVersion.ResourceReference(), "versions.#", "2")),
+					assert.Check(resource.TestCheckTypeSetElemNestedAttrs(withNewVersion.ResourceReference(), "versions.*", map[string]string{
+						"alias": "second",
+					})),
+				),
+			},
+			// Update - new version from the same location after the sources changed
+			{
+				PreConfig: func() {
+					testClient().DbtProject.PutSampleProjectOnStage(t, stage.Location()+"/v2")
+				},
+				Config: accconfig.FromModels(t, withChangedSources),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(withChangedSources.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(withChangedSources.ResourceReference(), "from.0.version_trigger", "sources-changed")),
+					assert.Check(resource.TestCheckResourceAttr(withChangedSources.ResourceReference(), "versions.#", "3")),
+				),
+			},
+			// Update - no changes
+			{
+				Config: accconfig.FromModels(t, withChangedSources),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(withChangedSources.ResourceReference(), "versions.#", "3")),
+				),
+			},
+			// Update - unset optionals
+			{
+				Config: accconfig.FromModels(t, model.DbtProjectFromId("test", id, locationV2)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(basic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.DbtProjectResource(t, basic.ResourceReference()).
+						HasDefaultArgsString("").
+						HasDefaultTargetString("").
+						HasCommentString(""),
+					resourceshowoutputassert.DbtProjectShowOutput(t, basic.ResourceReference()).
+						HasComment(""),
+					// only the version trigger changed, so a new version from the same location is added
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "versions.#", "4")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DbtProject_Import(t *testing.T) {
+	stage, stageCleanup := testClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+
+	testClient().DbtProject.PutSampleProjectOnStage(t, stage.Location()+"/v1")
+	location := sdk.NewStageLocation(stage.ID(), "v1")
+
+	dbtProject, dbtProjectCleanup := testClient().DbtProject.Create(t, location)
+	t.Cleanup(dbtProjectCleanup)
+	id := dbtProject.ID()
+
+	basic := model.DbtProjectFromId("test", id, location)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.DbtProject),
+		Steps: []resource.TestStep{
+			{
+				Config:             accconfig.FromModels(t, basic),
+				ResourceName:       basic.ResourceReference(),
+				ImportState:        true,
+				ImportStateId:      id.FullyQualifiedName(),
+				ImportStatePersist: true,
+				ImportStateCheck: assertThatImport(t,
+					resourceassert.ImportedDbtProjectResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()),
+					assert.CheckImport(importchecks.TestCheckResourceAttrNotInInstanceState(helpers.EncodeResourceIdentifier(id), "from.0.stage")),
+				),
+			},
+			// the from field is only saved in the state, without adding a new version
+			{
+				Config: accconfig.FromModels(t, basic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(basic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "from.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "versions.#", "1")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,162 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExternalAccessIntegration_BasicUseCase(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+
+	networkRule, networkRuleCleanup := testClient().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	otherNetworkRule, otherNetworkRuleCleanup := testClient().NetworkRule.Create(t)
+	t.Cleanup(otherNetworkRuleCleanup)
+
+	apiAuthenticationIntegration, apiAuthenticationIntegrationCleanup := testClient().SecurityIntegration.CreateApiAuthenticationWithClientCredentialsFlow(t)
+	t.Cleanup(apiAuthenticationIntegrationCleanup)
+
+	secretId, secretCleanup := testClient().Secret.CreateRandomPasswordSecret(t)
+	t.Cleanup(secretCleanup)
+
+	basic := model.ExternalAccessIntegrationFromId(id, []sdk.SchemaObjectIdentifier{networkRule.ID()}, true)
+
+	complete := model.ExternalAccessIntegrationFromId(id, []sdk.SchemaObjectIdentifier{networkRule.ID(), otherNetworkRule.ID()}, false).
+		WithAllowedApiAuthenticationIntegrations(apiAuthenticationIntegration.ID()).
+		WithAllowedAuthenticationSecrets(secretId).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ExternalAccessIntegration),
+		Steps: []resource.TestStep{
+			// Create - without optionals
+			{
+				Config: accconfig.FromModels(t, basic),
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, basic.ResourceReference()).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasEnabledString("true").
+						HasCommentString("").
+						HasAllowedNetworkRules(networkRule.ID().FullyQualifiedName()).
+						HasAllowedApiAuthenticationIntegrationsEmpty().
+						HasAllowedAuthenticationSecretsEmpty(),
+					resourceshowoutputassert.ExternalAccessIntegrationShowOutput(t, basic.ResourceReference()).
+						HasName(id.Name()).
+						HasIntegrationType("EXTERNAL_ACCESS").
+						HasCategory("SECURITY").
+						HasEnabled(true).
+						HasComment(""),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.enabled", "true")),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.allowed_network_rules.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.allowed_network_rules.0", networkRule.ID().FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.allowed_api_authentication_integrations.#", "0")),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.allowed_authentication_secrets.#", "0")),
+				),
+			},
+			// Import - without optionals
+			{
+				Config:            accconfig.FromModels(t, basic),
+				ResourceName:      basic.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update - set optionals
+			{
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, complete.ResourceReference()).
+						HasNameString(id.Name()).
+						HasEnabledString("false").
+						HasCommentString(comment).
+						HasAllowedNetworkRules(networkRule.ID().FullyQualifiedName(), otherNetworkRule.ID().FullyQualifiedName()).
+						HasAllowedApiAuthenticationIntegrations(apiAuthenticationIntegration.ID().Name()).
+						HasAllowedAuthenticationSecrets(secretId.FullyQualifiedName()),
+					resourceshowoutputassert.ExternalAccessIntegrationShowOutput(t, complete.ResourceReference()).
+						HasEnabled(false).
+						HasComment(comment),
+					assert.Check(resource.TestCheckResourceAttr(complete.ResourceReference(), "describe_output.0.allowed_network_rules.#", "2")),
+					assert.Check(resource.TestCheckResourceAttr(complete.ResourceReference(), "describe_output.0.allowed_api_authentication_integrations.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(complete.ResourceReference(), "describe_output.0.allowed_authentication_secrets.#", "1")),
+				),
+			},
+			// Import - with optionals
+			{
+				Config:            accconfig.FromModels(t, complete),
+				ResourceName:      complete.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update - external change
+			{
+				PreConfig: func() {
+					testClient().ExternalAccessIntegration.Alter(t, sdk.NewAlterExternalAccessIntegrationRequest(id).
+						WithSet(*sdk.NewExternalAccessIntegrationSetRequest().
+							WithEnabled(true).
+							WithAllowedNetworkRules([]sdk.SchemaObjectIdentifier{networkRule.ID()}),
+						),
+					)
+				},
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					objectassert.ExternalAccessIntegration(t, id).
+						HasEnabled(false).
+						HasComment(comment),
+					resourceassert.ExternalAccessIntegrationResource(t, complete.ResourceReference()).
+						HasAllowedNetworkRules(networkRule.ID().FullyQualifiedName(), otherNetworkRule.ID().FullyQualifiedName()),
+				),
+			},
+			// Update - unset optionals
+			{
+				Config: accconfig.FromModels(t, basic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(basic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ExternalAccessIntegrationResource(t, basic.ResourceReference()).
+						HasEnabledString("true").
+						HasCommentString("").
+						HasAllowedNetworkRules(networkRule.ID().FullyQualifiedName()).
+						HasAllowedApiAuthenticationIntegrationsEmpty().
+						HasAllowedAuthenticationSecretsEmpty(),
+					resourceshowoutputassert.ExternalAccessIntegrationShowOutput(t, basic.ResourceReference()).
+						HasEnabled(true).
+						HasComment(""),
+				),
+			},
+		},
+	})
+}
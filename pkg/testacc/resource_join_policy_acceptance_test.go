@@ -0,0 +1,181 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/planchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var joinPolicyTestDefinition = bodyPolicyTestDefinition[*model.JoinPolicyModel, *datasourcemodel.JoinPoliciesModel, *resourceassert.JoinPolicyResourceAssert, *resourceshowoutputassert.JoinPolicyShowOutputAssert]{
+	resource:   resources.JoinPolicy,
+	kind:       "JOIN_POLICY",
+	returnType: "JOIN_CONSTRAINT",
+	body:       "JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)",
+	newBody:    "JOIN_CONSTRAINT(JOIN_REQUIRED => FALSE)",
+
+	model:                      model.JoinPolicyFromId,
+	datasourceModel:            datasourcemodel.JoinPolicies,
+	resourceAssert:             resourceassert.JoinPolicyResource,
+	showOutputAssert:           resourceshowoutputassert.JoinPolicyShowOutput,
+	datasourceShowOutputAssert: resourceshowoutputassert.JoinPoliciesDatasourceShowOutput,
+	setBody: func(t *testing.T, id sdk.SchemaObjectIdentifier, body string) {
+		t.Helper()
+		testClient().JoinPolicy.Alter(t, sdk.NewAlterJoinPolicyRequest(id).WithSetBody(body))
+	},
+}
+
+func TestAcc_JoinPolicy_BasicUseCase(t *testing.T) {
+	joinPolicyTestDefinition.testBasicUseCase(t)
+}
+
+func TestAcc_JoinPolicy_SetOnView(t *testing.T) {
+	table, tableCleanup := testClient().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("id", sdk.DataTypeNumber),
+	})
+	t.Cleanup(tableCleanup)
+
+	policyId := testClient().Ids.RandomSchemaObjectIdentifier()
+	viewId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	policyModel := model.JoinPolicyFromId("test", policyId, "JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)")
+	viewModel := model.View("test", viewId.DatabaseName(), viewId.SchemaName(), viewId.Name(), fmt.Sprintf("SELECT id FROM %s", table.ID().FullyQualifiedName())).
+		WithJoinPolicy(policyId, "ID").
+		WithDependsOn(policyModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.JoinPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, policyModel, viewModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(viewModel.ResourceReference(), "join_policy.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(viewModel.ResourceReference(), "join_policy.0.policy_name", policyId.FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(viewModel.ResourceReference(), "join_policy.0.allowed_join_keys.#", "1")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_JoinPolicy_SetOnTable(t *testing.T) {
+	policyId := testClient().Ids.RandomSchemaObjectIdentifier()
+	otherPolicyId, otherPolicyCleanup := testClient().JoinPolicy.CreateJoinPolicy(t)
+	t.Cleanup(otherPolicyCleanup)
+	tableId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	policyModel := model.JoinPolicyFromId("test", policyId, "JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)")
+	columns := []sdk.TableColumnSignature{
+		{
+			Name: "ID",
+			Type: testdatatypes.DataTypeNumber,
+		},
+	}
+	tableModel := model.TableWithId("test", tableId, columns).
+		WithJoinPolicy(policyId, "ID").
+		WithDependsOn(policyModel.ResourceReference())
+	tableModelWithoutPolicy := model.TableWithId("test", tableId, columns).
+		WithDependsOn(policyModel.ResourceReference())
+
+	hasJoinPolicyReference := func(expectedPolicyId sdk.SchemaObjectIdentifier) assert.TestCheckFuncProvider {
+		return assert.Check(func(_ *terraform.State) error {
+			policyReference, err := testClient().PolicyReferences.GetPolicyReference(t, tableId, sdk.PolicyEntityDomainTable)
+			if err != nil {
+				return err
+			}
+			if policyReference.PolicyKind != sdk.PolicyKindJoinPolicy || policyReference.PolicyName != expectedPolicyId.Name() {
+				return fmt.Errorf("expected join policy %s on the table, got %s %s", expectedPolicyId.FullyQualifiedName(), policyReference.PolicyKind, policyReference.PolicyName)
+			}
+			return nil
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.JoinPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, policyModel, tableModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "join_policy.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "join_policy.0.policy_name", policyId.FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "join_policy.0.allowed_join_keys.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "join_policy.0.allowed_join_keys.0", "ID")),
+					hasJoinPolicyReference(policyId),
+				),
+			},
+			// external policy change is detected
+			{
+				PreConfig: func() {
+					testClient().Table.AlterWithRequest(t, sdk.NewAlterTableRequest(tableId).WithSetJoinPolicy(sdk.NewTableSetJoinPolicyRequest(otherPolicyId).WithForce(true)))
+				},
+				Config: accconfig.FromModels(t, policyModel, tableModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(tableModel.ResourceReference(), plancheck.ResourceActionUpdate),
+						planchecks.ExpectChange(tableModel.ResourceReference(), "join_policy.0.policy_name", tfjson.ActionUpdate, sdk.String(otherPolicyId.FullyQualifiedName()), sdk.String(policyId.FullyQualifiedName())),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "join_policy.0.policy_name", policyId.FullyQualifiedName())),
+					hasJoinPolicyReference(policyId),
+				),
+			},
+			// external unset is detected
+			{
+				PreConfig: func() {
+					testClient().Table.AlterWithRequest(t, sdk.NewAlterTableRequest(tableId).WithUnsetJoinPolicy(sdk.NewTableUnsetJoinPolicyRequest()))
+				},
+				Config: accconfig.FromModels(t, policyModel, tableModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(tableModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "join_policy.#", "1")),
+					hasJoinPolicyReference(policyId),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, policyModel, tableModelWithoutPolicy),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "join_policy.#", "0")),
+					assert.Check(func(_ *terraform.State) error {
+						policyReferences, err := testClient().PolicyReferences.GetPolicyReferences(t, tableId, sdk.PolicyEntityDomainTable)
+						if err != nil {
+							return err
+						}
+						if len(policyReferences) != 0 {
+							return fmt.Errorf("expected no policies on the table, got %v", policyReferences)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
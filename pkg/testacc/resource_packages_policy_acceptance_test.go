@@ -0,0 +1,136 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_PackagesPolicy_BasicUseCase(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	basic := model.PackagesPolicyFromId("test", id)
+
+	complete := model.PackagesPolicyFromId("test", id).
+		WithLanguage(string(sdk.PackagesPolicyLanguagePython)).
+		WithAllowlist("numpy", "pandas").
+		WithBlocklist("scipy").
+		WithAdditionalCreationBlocklist("requests").
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.PackagesPolicy),
+		Steps: []resource.TestStep{
+			// Create - without optionals
+			{
+				Config: accconfig.FromModels(t, basic),
+				Check: assertThat(t,
+					resourceassert.PackagesPolicyResource(t, basic.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasLanguageString(string(sdk.PackagesPolicyLanguagePython)).
+						HasAllowlistEmpty().
+						HasBlocklistEmpty().
+						HasAdditionalCreationBlocklistEmpty().
+						HasCommentString(""),
+					resourceshowoutputassert.PackagesPolicyShowOutput(t, basic.ResourceReference()).
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasKind("PACKAGES_POLICY").
+						HasComment(""),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.language", string(sdk.PackagesPolicyLanguagePython))),
+				),
+			},
+			// Import - without optionals
+			{
+				Config:            accconfig.FromModels(t, basic),
+				ResourceName:      basic.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update - set optionals
+			{
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PackagesPolicyResource(t, complete.ResourceReference()).
+						HasAllowlist("numpy", "pandas").
+						HasBlocklist("scipy").
+						HasAdditionalCreationBlocklist("requests").
+						HasCommentString(comment),
+					resourceshowoutputassert.PackagesPolicyShowOutput(t, complete.ResourceReference()).
+						HasComment(comment),
+				),
+			},
+			// Import - with optionals
+			{
+				Config:            accconfig.FromModels(t, complete),
+				ResourceName:      complete.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update - external change
+			{
+				PreConfig: func() {
+					testClient().PackagesPolicy.Alter(t, sdk.NewAlterPackagesPolicyRequest(id).WithSet(*sdk.NewPackagesPolicySetRequest().
+						WithBlocklist([]sdk.StringListItemWrapper{{Value: "numpy"}}),
+					))
+				},
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PackagesPolicyResource(t, complete.ResourceReference()).
+						HasBlocklist("scipy"),
+				),
+			},
+			// Update - unset optionals
+			{
+				Config: accconfig.FromModels(t, basic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(basic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PackagesPolicyResource(t, basic.ResourceReference()).
+						HasAllowlistEmpty().
+						HasBlocklistEmpty().
+						HasAdditionalCreationBlocklistEmpty().
+						HasCommentString(""),
+					resourceshowoutputassert.PackagesPolicyShowOutput(t, basic.ResourceReference()).
+						HasComment(""),
+				),
+			},
+		},
+	})
+}
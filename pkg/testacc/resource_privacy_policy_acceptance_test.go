@@ -0,0 +1,182 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/planchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var privacyPolicyTestDefinition = bodyPolicyTestDefinition[*model.PrivacyPolicyModel, *datasourcemodel.PrivacyPoliciesModel, *resourceassert.PrivacyPolicyResourceAssert, *resourceshowoutputassert.PrivacyPolicyShowOutputAssert]{
+	resource:   resources.PrivacyPolicy,
+	kind:       "PRIVACY_POLICY",
+	returnType: "PRIVACY_BUDGET",
+	body:       "PRIVACY_BUDGET(BUDGET_NAME => 'analysts')",
+	newBody:    "NO_PRIVACY_POLICY()",
+
+	model:                      model.PrivacyPolicyFromId,
+	datasourceModel:            datasourcemodel.PrivacyPolicies,
+	resourceAssert:             resourceassert.PrivacyPolicyResource,
+	showOutputAssert:           resourceshowoutputassert.PrivacyPolicyShowOutput,
+	datasourceShowOutputAssert: resourceshowoutputassert.PrivacyPoliciesDatasourceShowOutput,
+	setBody: func(t *testing.T, id sdk.SchemaObjectIdentifier, body string) {
+		t.Helper()
+		testClient().PrivacyPolicy.Alter(t, sdk.NewAlterPrivacyPolicyRequest(id).WithSetBody(body))
+	},
+}
+
+func TestAcc_PrivacyPolicy_BasicUseCase(t *testing.T) {
+	privacyPolicyTestDefinition.testBasicUseCase(t)
+}
+
+func TestAcc_PrivacyPolicy_SetOnView(t *testing.T) {
+	table, tableCleanup := testClient().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("id", sdk.DataTypeNumber),
+	})
+	t.Cleanup(tableCleanup)
+
+	policyId := testClient().Ids.RandomSchemaObjectIdentifier()
+	viewId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	policyModel := model.PrivacyPolicyFromId("test", policyId, "PRIVACY_BUDGET(BUDGET_NAME => 'analysts')")
+	viewModel := model.View("test", viewId.DatabaseName(), viewId.SchemaName(), viewId.Name(), fmt.Sprintf("SELECT id FROM %s", table.ID().FullyQualifiedName())).
+		WithPrivacyPolicy(policyId, "ID").
+		WithDependsOn(policyModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.PrivacyPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, policyModel, viewModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(viewModel.ResourceReference(), "privacy_policy.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(viewModel.ResourceReference(), "privacy_policy.0.policy_name", policyId.FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(viewModel.ResourceReference(), "privacy_policy.0.entity_key.#", "1")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_PrivacyPolicy_SetOnTable(t *testing.T) {
+	policyId := testClient().Ids.RandomSchemaObjectIdentifier()
+	otherPolicyId, otherPolicyCleanup := testClient().PrivacyPolicy.CreatePrivacyPolicy(t)
+	t.Cleanup(otherPolicyCleanup)
+	tableId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	policyModel := model.PrivacyPolicyFromId("test", policyId, "PRIVACY_BUDGET(BUDGET_NAME => 'analysts')")
+	columns := []sdk.TableColumnSignature{
+		{
+			Name: "ID",
+			Type: testdatatypes.DataTypeNumber,
+		},
+	}
+	tableModel := model.TableWithId("test", tableId, columns).
+		WithPrivacyPolicy(policyId, "ID").
+		WithDependsOn(policyModel.ResourceReference())
+	tableModelWithoutPolicy := model.TableWithId("test", tableId, columns).
+		WithDependsOn(policyModel.ResourceReference())
+
+	hasPrivacyPolicyReference := func(expectedPolicyId sdk.SchemaObjectIdentifier) assert.TestCheckFuncProvider {
+		return assert.Check(func(_ *terraform.State) error {
+			policyReference, err := testClient().PolicyReferences.GetPolicyReference(t, tableId, sdk.PolicyEntityDomainTable)
+			if err != nil {
+				return err
+			}
+			if policyReference.PolicyKind != sdk.PolicyKindPrivacyPolicy || policyReference.PolicyName != expectedPolicyId.Name() {
+				return fmt.Errorf("expected privacy policy %s on the table, got %s %s", expectedPolicyId.FullyQualifiedName(), policyReference.PolicyKind, policyReference.PolicyName)
+			}
+			return nil
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.PrivacyPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, policyModel, tableModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "privacy_policy.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "privacy_policy.0.policy_name", policyId.FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "privacy_policy.0.entity_key.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "privacy_policy.0.entity_key.0", "ID")),
+					hasPrivacyPolicyReference(policyId),
+				),
+			},
+			// external policy change is detected
+			{
+				PreConfig: func() {
+					testClient().Table.AlterWithRequest(t, sdk.NewAlterTableRequest(tableId).WithDropPrivacyPolicy(sdk.NewTableDropPrivacyPolicyRequest(policyId)))
+					testClient().Table.AlterWithRequest(t, sdk.NewAlterTableRequest(tableId).WithAddPrivacyPolicy(sdk.NewTableAddPrivacyPolicyRequest(otherPolicyId, []sdk.Column{{Value: "ID"}})))
+				},
+				Config: accconfig.FromModels(t, policyModel, tableModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(tableModel.ResourceReference(), plancheck.ResourceActionUpdate),
+						planchecks.ExpectChange(tableModel.ResourceReference(), "privacy_policy.0.policy_name", tfjson.ActionUpdate, sdk.String(otherPolicyId.FullyQualifiedName()), sdk.String(policyId.FullyQualifiedName())),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "privacy_policy.0.policy_name", policyId.FullyQualifiedName())),
+					hasPrivacyPolicyReference(policyId),
+				),
+			},
+			// external drop is detected
+			{
+				PreConfig: func() {
+					testClient().Table.AlterWithRequest(t, sdk.NewAlterTableRequest(tableId).WithDropPrivacyPolicy(sdk.NewTableDropPrivacyPolicyRequest(policyId)))
+				},
+				Config: accconfig.FromModels(t, policyModel, tableModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(tableModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "privacy_policy.#", "1")),
+					hasPrivacyPolicyReference(policyId),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, policyModel, tableModelWithoutPolicy),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "privacy_policy.#", "0")),
+					assert.Check(func(_ *terraform.State) error {
+						policyReferences, err := testClient().PolicyReferences.GetPolicyReferences(t, tableId, sdk.PolicyEntityDomainTable)
+						if err != nil {
+							return err
+						}
+						if len(policyReferences) != 0 {
+							return fmt.Errorf("expected no policies on the table, got %v", policyReferences)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
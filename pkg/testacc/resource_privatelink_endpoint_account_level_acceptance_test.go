@@ -0,0 +1,88 @@
+//go:build account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testprofiles"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// The test requires a Business Critical account and a private endpoint service (e.g. com.amazonaws.us-west-2.s3)
+// available in the cloud region of the secondary account.
+func TestAcc_PrivatelinkEndpoint_BasicUseCase(t *testing.T) {
+	providerResourceId := testenvs.GetOrSkipTest(t, testenvs.PrivatelinkEndpointProviderResourceId)
+	host := testenvs.GetOrSkipTest(t, testenvs.PrivatelinkEndpointHost)
+
+	providerModel := providermodel.SnowflakeProvider().WithProfile(testprofiles.Secondary)
+	endpointModel := model.PrivatelinkEndpoint("test", host, providerResourceId)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: checkPrivatelinkEndpointDeprovisioned(t, providerResourceId),
+		Steps: []resource.TestStep{
+			// create
+			{
+				ProtoV6ProviderFactories: secondaryAccountProviderFactory,
+				Config:                   config.FromModels(t, providerModel, endpointModel),
+				Check: assertThat(t,
+					resourceassert.PrivatelinkEndpointResource(t, endpointModel.ResourceReference()).
+						HasProviderResourceIdString(providerResourceId).
+						HasHostString(host).
+						HasSubresourceEmpty().
+						HasSnowflakeResourceIdNotEmpty().
+						HasEndpointStateNotEmpty(),
+				),
+			},
+			// import
+			{
+				ProtoV6ProviderFactories: secondaryAccountProviderFactory,
+				Config:                   config.FromModels(t, providerModel, endpointModel),
+				ResourceName:             endpointModel.ResourceReference(),
+				ImportState:              true,
+				ImportStateVerify:        true,
+				ImportStateVerifyIgnore:  []string{"endpoint_state", "status"},
+			},
+			// deprovision externally
+			{
+				ProtoV6ProviderFactories: secondaryAccountProviderFactory,
+				PreConfig: func() {
+					secondaryTestClient().Privatelink.DeprovisionEndpointFunc(t, providerResourceId)()
+				},
+				Config: config.FromModels(t, providerModel, endpointModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(endpointModel.ResourceReference(), plancheck.ResourceActionCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.PrivatelinkEndpointResource(t, endpointModel.ResourceReference()).
+						HasProviderResourceIdString(providerResourceId).
+						HasSnowflakeResourceIdNotEmpty(),
+				),
+			},
+		},
+	})
+}
+
+func checkPrivatelinkEndpointDeprovisioned(t *testing.T, providerResourceId string) func(*terraform.State) error {
+	t.Helper()
+	return func(_ *terraform.State) error {
+		if _, found := secondaryTestClient().Privatelink.EndpointInfo(t, providerResourceId); found {
+			return fmt.Errorf("privatelink endpoint for %s was not deprovisioned", providerResourceId)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,36 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var projectionPolicyTestDefinition = bodyPolicyTestDefinition[*model.ProjectionPolicyModel, *datasourcemodel.ProjectionPoliciesModel, *resourceassert.ProjectionPolicyResourceAssert, *resourceshowoutputassert.ProjectionPolicyShowOutputAssert]{
+	resource:   resources.ProjectionPolicy,
+	kind:       "PROJECTION_POLICY",
+	returnType: "PROJECTION_CONSTRAINT",
+	body:       "PROJECTION_CONSTRAINT(ALLOW => false)",
+	newBody:    "PROJECTION_CONSTRAINT(ALLOW => true)",
+
+	model:                      model.ProjectionPolicyFromId,
+	datasourceModel:            datasourcemodel.ProjectionPolicies,
+	resourceAssert:             resourceassert.ProjectionPolicyResource,
+	showOutputAssert:           resourceshowoutputassert.ProjectionPolicyShowOutput,
+	datasourceShowOutputAssert: resourceshowoutputassert.ProjectionPoliciesDatasourceShowOutput,
+	setBody: func(t *testing.T, id sdk.SchemaObjectIdentifier, body string) {
+		t.Helper()
+		testClient().ProjectionPolicy.Alter(t, sdk.NewAlterProjectionPolicyRequest(id).WithSetBody(body))
+	},
+}
+
+func TestAcc_ProjectionPolicy_BasicUseCase(t *testing.T) {
+	projectionPolicyTestDefinition.testBasicUseCase(t)
+}
@@ -47,6 +47,12 @@ func TestAcc_Schema_BasicUseCase(t *testing.T) {
 	catalogId, catalogCleanup := testClient().CatalogIntegration.Create(t)
 	t.Cleanup(catalogCleanup)
 
+	classificationProfile, classificationProfileCleanup := testClient().ClassificationProfile.Create(t)
+	t.Cleanup(classificationProfileCleanup)
+
+	newClassificationProfile, newClassificationProfileCleanup := testClient().ClassificationProfile.Create(t)
+	t.Cleanup(newClassificationProfileCleanup)
+
 	basic := model.Schema("test", id.DatabaseName(), id.Name())
 
 	assertBasic := []assert.TestCheckFuncProvider{
@@ -70,7 +76,8 @@ func TestAcc_Schema_BasicUseCase(t *testing.T) {
 			HasFullyQualifiedNameString(id.FullyQualifiedName()).
 			HasWithManagedAccessString(r.BooleanDefault).
 			HasIsTransientString(r.BooleanDefault).
-			HasCommentString(""),
+			HasCommentString("").
+			HasClassificationProfileEmpty(),
 
 		resourceshowoutputassert.SchemaShowOutput(t, basic.ResourceReference()).
 			HasCreatedOnNotEmpty().
@@ -87,6 +94,7 @@ func TestAcc_Schema_BasicUseCase(t *testing.T) {
 
 	complete := model.Schema("test", newId.DatabaseName(), newId.Name()).
 		WithComment(comment).
+		WithClassificationProfile(classificationProfile.ID().FullyQualifiedName()).
 		WithWithManagedAccess(r.BooleanTrue).
 		WithDataRetentionTimeInDays(15).
 		WithMaxDataExtensionTimeInDays(3).
@@ -129,6 +137,7 @@ func TestAcc_Schema_BasicUseCase(t *testing.T) {
 			HasWithManagedAccessString(r.BooleanTrue).
 			HasIsTransientString(r.BooleanDefault).
 			HasCommentString(comment).
+			HasClassificationProfileString(classificationProfile.ID().FullyQualifiedName()).
 			HasDataRetentionTimeInDaysString("15").
 			HasMaxDataExtensionTimeInDaysString("3").
 			HasExternalVolumeString(externalVolumeId.Name()).
@@ -160,6 +169,9 @@ func TestAcc_Schema_BasicUseCase(t *testing.T) {
 		assert.Check(resource.TestCheckResourceAttr(complete.ResourceReference(), "describe_output.#", "0")),
 	}
 
+	withNewClassificationProfile := model.Schema("test", newId.DatabaseName(), newId.Name()).
+		WithClassificationProfile(newClassificationProfile.ID().FullyQualifiedName())
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
 		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
@@ -204,8 +216,23 @@ func TestAcc_Schema_BasicUseCase(t *testing.T) {
 					"is_transient",
 					"show_output.0.is_current",
 					"with_managed_access",
+					// Snowflake does not return the classification profile set on the schema
+					"classification_profile",
 				},
 			},
+			// Update - change classification profile
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(withNewClassificationProfile.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, withNewClassificationProfile),
+				Check: assertThat(t,
+					resourceassert.SchemaResource(t, withNewClassificationProfile.ResourceReference()).
+						HasClassificationProfileString(newClassificationProfile.ID().FullyQualifiedName()),
+				),
+			},
 			// Update - unset optionals (back to basic, with rename back)
 			{
 				ConfigPlanChecks: resource.ConfigPlanChecks{
@@ -1001,6 +1028,56 @@ resource "snowflake_schema" "test" {
 `, schemaId.DatabaseName(), schemaId.Name(), pipeExecutionPaused)
 }
 
+func TestAcc_Schema_RecoverIfDropped(t *testing.T) {
+	id := testClient().Ids.RandomDatabaseObjectIdentifier()
+	comment := random.Comment()
+
+	_, schemaCleanup := testClient().Schema.CreateSchemaWithIdentifier(t, id)
+	t.Cleanup(schemaCleanup)
+	table, _ := testClient().Table.CreateInSchema(t, id)
+	schemaCleanup()
+
+	schemaModel := model.Schema("test", id.DatabaseName(), id.Name()).
+		WithRecoverIfDropped(true).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Schema),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, schemaModel),
+				Check: assertThat(t,
+					resourceassert.SchemaResource(t, schemaModel.ResourceReference()).
+						HasNameString(id.Name()).
+						HasRecoverIfDroppedString("true").
+						HasCommentString(comment),
+					objectassert.Schema(t, id).
+						HasName(id.Name()).
+						HasComment(comment),
+					// the table created before the drop is recovered together with the schema
+					assert.Check(func(_ *terraform.State) error {
+						_, err := testClient().Table.Show(t, table.ID())
+						return err
+					}),
+				),
+			},
+			// change in the parameter shouldn't change the state Snowflake
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(schemaModel.ResourceReference(), plancheck.ResourceActionNoop),
+					},
+				},
+				Config: accconfig.FromModels(t, schemaModel.WithRecoverIfDropped(false)),
+			},
+		},
+	})
+}
+
 func TestAcc_Schema_migrateFromV0941_ensureSmoothUpgradeWithNewResourceId(t *testing.T) {
 	id := testClient().Ids.RandomDatabaseObjectIdentifier()
 
@@ -1418,3 +1418,88 @@ func TestAcc_Service_Validations(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_Service_RestoreFromSnapshot(t *testing.T) {
+	volume := "block-volume"
+
+	computePool, computePoolCleanup := testClient().ComputePool.Create(t)
+	t.Cleanup(computePoolCleanup)
+
+	sourceService, sourceServiceCleanup := testClient().Service.CreateWithIdWithBlockVolume(t, computePool.ID(), testClient().Ids.RandomSchemaObjectIdentifier())
+	t.Cleanup(sourceServiceCleanup)
+
+	snapshot, snapshotCleanup := testClient().Snapshot.Create(t, sourceService.ID(), volume)
+	t.Cleanup(snapshotCleanup)
+
+	newSnapshot, newSnapshotCleanup := testClient().Snapshot.Create(t, sourceService.ID(), volume)
+	t.Cleanup(newSnapshotCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	spec := testClient().Service.SampleSpecWithBlockVolume(t)
+
+	modelBasic := model.ServiceWithSpec("test", id.DatabaseName(), id.SchemaName(), id.Name(), computePool.ID().FullyQualifiedName(), spec)
+	modelWithRestore := model.ServiceWithSpec("test", id.DatabaseName(), id.SchemaName(), id.Name(), computePool.ID().FullyQualifiedName(), spec).
+		WithRestoreFromSnapshot(volume, snapshot.ID(), 0)
+	modelWithChangedRestore := model.ServiceWithSpec("test", id.DatabaseName(), id.SchemaName(), id.Name(), computePool.ID().FullyQualifiedName(), spec).
+		WithRestoreFromSnapshot(volume, newSnapshot.ID(), 0)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: servicesProviderFactory,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Service),
+		Steps: []resource.TestStep{
+			// create with restore
+			{
+				Config: accconfig.FromModels(t, modelWithRestore),
+				Check: assertThat(t,
+					resourceassert.ServiceResource(t, modelWithRestore.ResourceReference()).
+						HasNameString(id.Name()),
+					assert.Check(resource.TestCheckResourceAttr(modelWithRestore.ResourceReference(), "restore_from_snapshot.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithRestore.ResourceReference(), "restore_from_snapshot.0.volume", volume)),
+					assert.Check(resource.TestCheckResourceAttr(modelWithRestore.ResourceReference(), "restore_from_snapshot.0.instances.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithRestore.ResourceReference(), "restore_from_snapshot.0.instances.0", "0")),
+					assert.Check(resource.TestCheckResourceAttr(modelWithRestore.ResourceReference(), "restore_from_snapshot.0.snapshot", snapshot.ID().FullyQualifiedName())),
+					resourceshowoutputassert.ServiceShowOutput(t, modelWithRestore.ResourceReference()).
+						HasName(id.Name()),
+				),
+			},
+			// import - restore_from_snapshot is not read from Snowflake
+			{
+				Config:                  accconfig.FromModels(t, modelWithRestore),
+				ResourceName:            modelWithRestore.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"from_specification", "restore_from_snapshot", "show_output.0.current_instances", "show_output.0.target_instances", "describe_output.0.current_instances", "describe_output.0.target_instances"},
+			},
+			// restore from a different snapshot
+			{
+				Config: accconfig.FromModels(t, modelWithChangedRestore),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithChangedRestore.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(modelWithChangedRestore.ResourceReference(), "restore_from_snapshot.0.snapshot", newSnapshot.ID().FullyQualifiedName())),
+					resourceshowoutputassert.ServiceShowOutput(t, modelWithChangedRestore.ResourceReference()).
+						HasName(id.Name()),
+				),
+			},
+			// remove restore - nothing is reverted
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ServiceResource(t, modelBasic.ResourceReference()).
+						HasRestoreFromSnapshotEmpty(),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+//go:build account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/experimentalfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Experimental_SqlBodyStructureCheck(t *testing.T) {
+	table, tableCleanup := testClient().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("id", sdk.DataTypeNumber),
+	})
+	t.Cleanup(tableCleanup)
+
+	viewId := testClient().Ids.RandomSchemaObjectIdentifier()
+	procedureId := testClient().Ids.RandomSchemaObjectIdentifierWithArguments()
+
+	validViewModel := model.View("test", viewId.DatabaseName(), viewId.SchemaName(), viewId.Name(), fmt.Sprintf("SELECT id FROM %s", table.ID().FullyQualifiedName()))
+	invalidViewModel := model.View("test", viewId.DatabaseName(), viewId.SchemaName(), viewId.Name(), fmt.Sprintf("SELECT id FROM %s WHERE", table.ID().FullyQualifiedName()))
+	invalidProcedureModel := model.ProcedureSql("test", procedureId.DatabaseName(), procedureId.SchemaName(), procedureId.Name(), "BEGIN RETURN 1; END; SELECT 1", "NUMBER(38, 0)")
+
+	providerModel := providermodel.SnowflakeProvider()
+	providerModelWithExperimentEnabled := providermodel.SnowflakeProvider().
+		WithExperimentalFeaturesEnabled(experimentalfeatures.SqlBodyStructureCheck)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.View),
+		Steps: []resource.TestStep{
+			// without the experiment, the invalid statement is planned (and it would fail during the apply)
+			{
+				ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+				Config:                   config.FromModels(t, providerModel, invalidViewModel),
+				PlanOnly:                 true,
+				ExpectNonEmptyPlan:       true,
+			},
+			// with the experiment, the invalid statement fails the plan
+			{
+				ProtoV6ProviderFactories: providerFactoryUsingCache("TestAcc_Experimental_SqlBodyStructureCheck"),
+				Config:                   config.FromModels(t, providerModelWithExperimentEnabled, invalidViewModel),
+				PlanOnly:                 true,
+				ExpectError:              regexp.MustCompile(`invalid SQL query in the statement field: line 1, column \d+: unexpected end of the body after WHERE`),
+			},
+			{
+				ProtoV6ProviderFactories: providerFactoryUsingCache("TestAcc_Experimental_SqlBodyStructureCheck"),
+				Config:                   config.FromModels(t, providerModelWithExperimentEnabled, invalidProcedureModel),
+				PlanOnly:                 true,
+				ExpectError:              regexp.MustCompile(`invalid SQL scripting block in the procedure_definition field: line 1, column 22: unexpected SELECT after the end of the block`),
+			},
+			// the valid statement is created with the experiment enabled
+			{
+				ProtoV6ProviderFactories: providerFactoryUsingCache("TestAcc_Experimental_SqlBodyStructureCheck"),
+				Config:                   config.FromModels(t, providerModelWithExperimentEnabled, validViewModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(validViewModel.ResourceReference(), plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,234 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_StorageLifecyclePolicy_BasicUseCase(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	argument := []sdk.TableColumnSignature{
+		{
+			Name: "EVENT_DAYS",
+			Type: testdatatypes.DataTypeNumber,
+		},
+	}
+	body := "EVENT_DAYS > 365"
+	newBody := "EVENT_DAYS > 730"
+
+	basic := model.StorageLifecyclePolicyFromId("test", id, argument, body).
+		WithArchiveTier(string(sdk.StorageLifecyclePolicyArchiveTierCool))
+
+	complete := model.StorageLifecyclePolicyFromId("test", newId, argument, newBody).
+		WithArchiveTier(string(sdk.StorageLifecyclePolicyArchiveTierCool)).
+		WithArchiveForDays(90).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.StorageLifecyclePolicy),
+		Steps: []resource.TestStep{
+			// Create - without optionals
+			{
+				Config: accconfig.FromModels(t, basic),
+				Check: assertThat(t,
+					resourceassert.StorageLifecyclePolicyResource(t, basic.ResourceReference()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasBodyString(body).
+						HasArchiveTierString(string(sdk.StorageLifecyclePolicyArchiveTierCool)).
+						HasArchiveForDaysString(r.IntDefaultString).
+						HasCommentString(""),
+					resourceshowoutputassert.StorageLifecyclePolicyShowOutput(t, basic.ResourceReference()).
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasKind("STORAGE_LIFECYCLE_POLICY").
+						HasComment(""),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "argument.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "argument.0.name", "EVENT_DAYS")),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.return_type", "BOOLEAN")),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.body", body)),
+					assert.Check(resource.TestCheckResourceAttr(basic.ResourceReference(), "describe_output.0.archive_tier", string(sdk.StorageLifecyclePolicyArchiveTierCool))),
+				),
+			},
+			// Import - without optionals
+			{
+				Config:            accconfig.FromModels(t, basic),
+				ResourceName:      basic.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update - rename and set optionals
+			{
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StorageLifecyclePolicyResource(t, complete.ResourceReference()).
+						HasNameString(newId.Name()).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()).
+						HasBodyString(newBody).
+						HasArchiveForDaysString("90").
+						HasCommentString(comment),
+					resourceshowoutputassert.StorageLifecyclePolicyShowOutput(t, complete.ResourceReference()).
+						HasName(newId.Name()).
+						HasComment(comment),
+					assert.Check(resource.TestCheckResourceAttr(complete.ResourceReference(), "describe_output.0.body", newBody)),
+					assert.Check(resource.TestCheckResourceAttr(complete.ResourceReference(), "describe_output.0.archive_for_days", "90")),
+				),
+			},
+			// Import - with optionals
+			{
+				Config:            accconfig.FromModels(t, complete),
+				ResourceName:      complete.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update - external change
+			{
+				PreConfig: func() {
+					testClient().StorageLifecyclePolicy.Alter(t, sdk.NewAlterStorageLifecyclePolicyRequest(newId).WithSet(*sdk.NewStorageLifecyclePolicySetRequest().
+						WithArchiveForDays(30),
+					))
+				},
+				Config: accconfig.FromModels(t, complete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					objectassert.StorageLifecyclePolicy(t, newId).
+						HasComment(comment),
+					resourceassert.StorageLifecyclePolicyResource(t, complete.ResourceReference()).
+						HasArchiveForDaysString("90"),
+				),
+			},
+			// Update - unset optionals
+			{
+				Config: accconfig.FromModels(t, model.StorageLifecyclePolicyFromId("test", newId, argument, newBody).
+					WithArchiveTier(string(sdk.StorageLifecyclePolicyArchiveTierCool)),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StorageLifecyclePolicyResource(t, complete.ResourceReference()).
+						HasArchiveForDaysString(r.IntDefaultString).
+						HasCommentString(""),
+					resourceshowoutputassert.StorageLifecyclePolicyShowOutput(t, complete.ResourceReference()).
+						HasComment(""),
+				),
+			},
+			// Update - archive tier change forces recreation
+			{
+				Config: accconfig.FromModels(t, model.StorageLifecyclePolicyFromId("test", newId, argument, newBody).
+					WithArchiveTier(string(sdk.StorageLifecyclePolicyArchiveTierCold)),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(complete.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.StorageLifecyclePolicyResource(t, complete.ResourceReference()).
+						HasArchiveTierString(string(sdk.StorageLifecyclePolicyArchiveTierCold)),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_StorageLifecyclePolicy_SetOnTable(t *testing.T) {
+	policyId := testClient().Ids.RandomSchemaObjectIdentifier()
+	tableId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	policyModel := model.StorageLifecyclePolicyFromId("test", policyId, []sdk.TableColumnSignature{
+		{
+			Name: "A",
+			Type: testdatatypes.DataTypeNumber,
+		},
+	}, "A > 10")
+	columns := []sdk.TableColumnSignature{
+		{
+			Name: "ID",
+			Type: testdatatypes.DataTypeNumber,
+		},
+	}
+	tableModel := model.TableWithId("test", tableId, columns).
+		WithStorageLifecyclePolicy(policyId, "ID").
+		WithDependsOn(policyModel.ResourceReference())
+	tableModelWithoutPolicy := model.TableWithId("test", tableId, columns).
+		WithDependsOn(policyModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.StorageLifecyclePolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, policyModel, tableModel),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "storage_lifecycle_policy.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "storage_lifecycle_policy.0.policy_name", policyId.FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "storage_lifecycle_policy.0.on.#", "1")),
+				),
+			},
+			// external detach is detected
+			{
+				PreConfig: func() {
+					testClient().Table.AlterWithRequest(t, sdk.NewAlterTableRequest(tableId).WithDropStorageLifecyclePolicy(sdk.NewTableDropStorageLifecyclePolicyRequest()))
+				},
+				Config: accconfig.FromModels(t, policyModel, tableModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(tableModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "storage_lifecycle_policy.#", "1")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, policyModel, tableModelWithoutPolicy),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "storage_lifecycle_policy.#", "0")),
+				),
+			},
+		},
+	})
+}
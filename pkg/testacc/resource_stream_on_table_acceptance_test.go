@@ -407,6 +407,67 @@ func TestAcc_StreamOnTable_PermadiffWhenIsStaleAndHasNoRetentionTime(t *testing.
 	})
 }
 
+func TestAcc_StreamOnTable_StaleWithoutRecreation(t *testing.T) {
+	schema, cleanupSchema := testClient().Schema.CreateSchemaWithOpts(t,
+		testClient().Ids.RandomDatabaseObjectIdentifierInDatabase(testClient().Ids.DatabaseId()),
+		&sdk.CreateSchemaOptions{
+			DataRetentionTimeInDays:    sdk.Pointer(0),
+			MaxDataExtensionTimeInDays: sdk.Pointer(0),
+		},
+	)
+	t.Cleanup(cleanupSchema)
+
+	table, cleanupTable := testClient().Table.CreateWithChangeTrackingInSchema(t, schema.ID())
+	t.Cleanup(cleanupTable)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifierInSchema(schema.ID())
+
+	streamModel := model.StreamOnTable("test", id.DatabaseName(), id.SchemaName(), id.Name(), table.ID().FullyQualifiedName()).
+		WithRecreateWhenStale(false)
+	streamModelWithRecreation := model.StreamOnTable("test", id.DatabaseName(), id.SchemaName(), id.Name(), table.ID().FullyQualifiedName()).
+		WithRecreateWhenStale(true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.StreamOnTable),
+		Steps: []resource.TestStep{
+			// stale stream is not recreated when recreate_when_stale is disabled
+			{
+				Config: config.FromModels(t, streamModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: assertThat(t, resourceassert.StreamOnTableResource(t, streamModel.ResourceReference()).
+					HasNameString(id.Name()).
+					HasRecreateWhenStaleString(r.BooleanFalse).
+					HasStaleString(r.BooleanTrue),
+					assert.Check(resource.TestCheckResourceAttr(streamModel.ResourceReference(), "show_output.0.stale", "true")),
+				),
+			},
+			// enabling recreate_when_stale forces the recreation
+			{
+				Config: config.FromModels(t, streamModelWithRecreation),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(streamModelWithRecreation.ResourceReference(), plancheck.ResourceActionUpdate),
+						planchecks.ExpectChange(streamModelWithRecreation.ResourceReference(), "stale", tfjson.ActionUpdate, sdk.String(r.BooleanTrue), sdk.String(r.BooleanFalse)),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+				Check: assertThat(t, resourceassert.StreamOnTableResource(t, streamModelWithRecreation.ResourceReference()).
+					HasNameString(id.Name()).
+					HasRecreateWhenStaleString(r.BooleanTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_StreamOnTable_StaleWithExternalChanges(t *testing.T) {
 	schema, cleanupSchema := testClient().Schema.CreateSchemaWithOpts(t,
 		testClient().Ids.RandomDatabaseObjectIdentifierInDatabase(testClient().Ids.DatabaseId()),
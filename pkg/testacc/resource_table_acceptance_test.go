@@ -12,8 +12,13 @@ import (
 	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	tfjson "github.com/hashicorp/terraform-json"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/importchecks"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/planchecks"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -1466,6 +1471,176 @@ resource "snowflake_table" "test_table" {
 `, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), maskingPolicyId.DatabaseName(), maskingPolicyId.SchemaName(), maskingPolicyId.Name())
 }
 
+func TestAcc_Table_Policies(t *testing.T) {
+	maskingPolicy, maskingPolicyCleanup := testClient().MaskingPolicy.CreateMaskingPolicyWithOptions(t,
+		[]sdk.TableColumnSignature{
+			{
+				Name: "One",
+				Type: testdatatypes.DataTypeNumber,
+			},
+			{
+				Name: "Two",
+				Type: testdatatypes.DataTypeNumber,
+			},
+		},
+		testdatatypes.DataTypeNumber,
+		`
+case
+	when One > 0 then One
+	else Two
+end;;
+`,
+		new(sdk.CreateMaskingPolicyOptions),
+	)
+	t.Cleanup(maskingPolicyCleanup)
+
+	projectionPolicy, projectionPolicyCleanup := testClient().ProjectionPolicy.CreateProjectionPolicy(t)
+	t.Cleanup(projectionPolicyCleanup)
+
+	rowAccessPolicy, rowAccessPolicyCleanup := testClient().RowAccessPolicy.CreateRowAccessPolicyWithDataType(t, testdatatypes.DataTypeNumber)
+	t.Cleanup(rowAccessPolicyCleanup)
+
+	aggregationPolicy, aggregationPolicyCleanup := testClient().AggregationPolicy.CreateAggregationPolicy(t)
+	t.Cleanup(aggregationPolicyCleanup)
+
+	tableId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Table),
+		Steps: []resource.TestStep{
+			{
+				Config: tableWithPolicies(tableId, maskingPolicy.ID(), projectionPolicy, rowAccessPolicy.ID(), aggregationPolicy, "TWO"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "name", tableId.Name()),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "is_transient", "true"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "enable_schema_evolution", "true"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "search_optimization", "true"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.masking_policy", maskingPolicy.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.masking_policy_using.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.masking_policy_using.0", "ONE"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.masking_policy_using.1", "TWO"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.projection_policy", projectionPolicy.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.0.policy_name", rowAccessPolicy.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.0.on.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.0.on.0", "ONE"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.0.policy_name", aggregationPolicy.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.0.entity_key.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.0.entity_key.0", "TWO"),
+				),
+			},
+			// import - the policies are read even though they are not in the state yet
+			{
+				Config:       tableWithPolicies(tableId, maskingPolicy.ID(), projectionPolicy, rowAccessPolicy.ID(), aggregationPolicy, "TWO"),
+				ResourceName: "snowflake_table.test_table",
+				ImportState:  true,
+				ImportStateCheck: importchecks.ComposeImportStateCheck(
+					importchecks.TestCheckResourceAttrInstanceState(helpers.EncodeSnowflakeID(tableId), "column.0.masking_policy_using.#", "2"),
+					importchecks.TestCheckResourceAttrInstanceState(helpers.EncodeSnowflakeID(tableId), "column.1.projection_policy", projectionPolicy.FullyQualifiedName()),
+					importchecks.TestCheckResourceAttrInstanceState(helpers.EncodeSnowflakeID(tableId), "row_access_policy.0.policy_name", rowAccessPolicy.ID().FullyQualifiedName()),
+					importchecks.TestCheckResourceAttrInstanceState(helpers.EncodeSnowflakeID(tableId), "aggregation_policy.0.policy_name", aggregationPolicy.FullyQualifiedName()),
+					importchecks.TestCheckResourceAttrInstanceState(helpers.EncodeSnowflakeID(tableId), "aggregation_policy.0.entity_key.0", "TWO"),
+				),
+			},
+			// change the entity key only
+			{
+				Config: tableWithPolicies(tableId, maskingPolicy.ID(), projectionPolicy, rowAccessPolicy.ID(), aggregationPolicy, "ONE"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_table.test_table", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.0.policy_name", aggregationPolicy.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.0.entity_key.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.0.entity_key.0", "ONE"),
+				),
+			},
+			{
+				Config: tableWithoutPolicies(tableId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_table.test_table", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "is_transient", "true"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "enable_schema_evolution", "false"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "search_optimization", "false"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.masking_policy", ""),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.masking_policy_using.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.projection_policy", ""),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func tableWithPolicies(tableId sdk.SchemaObjectIdentifier, maskingPolicyId sdk.SchemaObjectIdentifier, projectionPolicyId sdk.SchemaObjectIdentifier, rowAccessPolicyId sdk.SchemaObjectIdentifier, aggregationPolicyId sdk.SchemaObjectIdentifier, entityKey string) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "test_table" {
+	database                = "%[1]s"
+	schema                  = "%[2]s"
+	name                    = "%[3]s"
+	is_transient            = true
+	enable_schema_evolution = true
+	search_optimization     = true
+
+	column {
+		name                 = "ONE"
+		type                 = "NUMBER(38,0)"
+		masking_policy       = %[4]s
+		masking_policy_using = ["ONE", "TWO"]
+	}
+
+	column {
+		name              = "TWO"
+		type              = "NUMBER(38,0)"
+		projection_policy = %[5]s
+	}
+
+	row_access_policy {
+		policy_name = %[6]s
+		on          = ["ONE"]
+	}
+
+	aggregation_policy {
+		policy_name = %[7]s
+		entity_key  = [%[8]s]
+	}
+}
+`, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), strconv.Quote(maskingPolicyId.FullyQualifiedName()), strconv.Quote(projectionPolicyId.FullyQualifiedName()), strconv.Quote(rowAccessPolicyId.FullyQualifiedName()), strconv.Quote(aggregationPolicyId.FullyQualifiedName()), strconv.Quote(entityKey))
+}
+
+func tableWithoutPolicies(tableId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "test_table" {
+	database     = "%[1]s"
+	schema       = "%[2]s"
+	name         = "%[3]s"
+	is_transient = true
+
+	column {
+		name = "ONE"
+		type = "NUMBER(38,0)"
+	}
+
+	column {
+		name = "TWO"
+		type = "NUMBER(38,0)"
+	}
+}
+`, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name())
+}
+
 // proves https://github.com/Snowflake-Labs/terraform-provider-snowflake/issues/2356 issue is fixed.
 func TestAcc_Table_DefaultDataRetentionTime(t *testing.T) {
 	database, databaseCleanup := testClient().Database.CreateDatabaseWithParametersSet(t)
@@ -2269,3 +2444,111 @@ resource "snowflake_table" "test_table" {
 }
 `, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), argName)
 }
+
+func TestAcc_Table_RecoverIfDropped(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	table, tableCleanup := testClient().Table.CreateWithRequest(t, sdk.NewCreateTableRequest(id, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("ID", sdk.DataType(testdatatypes.DataTypeNumber.ToSql())),
+	}))
+	t.Cleanup(tableCleanup)
+	testClient().Table.InsertInt(t, id)
+	tableCleanup()
+
+	columns := []sdk.TableColumnSignature{
+		{Name: "ID", Type: testdatatypes.DataTypeNumber},
+	}
+
+	tableModel := model.TableWithId("test", id, columns).
+		WithRecoverIfDropped(true).
+		WithComment(comment).
+		WithChangeTracking(true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Table),
+		Steps: []resource.TestStep{
+			// the table-level properties are applied after the recovery
+			{
+				Config: accconfig.FromModels(t, tableModel),
+				Check: assertThat(t,
+					resourceassert.TableResource(t, tableModel.ResourceReference()).
+						HasNameString(id.Name()).
+						HasRecoverIfDroppedString("true").
+						HasCommentString(comment).
+						HasChangeTrackingString("true"),
+					// the recovered table is the one created (and filled) before the drop
+					assert.Check(func(_ *terraform.State) error {
+						recovered, err := testClient().Table.Show(t, id)
+						if err != nil {
+							return err
+						}
+						if recovered.CreatedOn != table.CreatedOn || recovered.Rows != 1 {
+							return fmt.Errorf("expected the table created on %s with 1 row to be recovered, got the table created on %s with %d rows", table.CreatedOn, recovered.CreatedOn, recovered.Rows)
+						}
+						return nil
+					}),
+				),
+			},
+			// change in the parameter shouldn't change the state Snowflake
+			{
+				Config: accconfig.FromModels(t, tableModel.WithRecoverIfDropped(false)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(tableModel.ResourceReference(), plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+// Columns are not altered after the recovery, so the differences between the recovered table and the configuration are shown in the next plan.
+func TestAcc_Table_RecoverIfDropped_ColumnsNotAltered(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	_, tableCleanup := testClient().Table.CreateWithRequest(t, sdk.NewCreateTableRequest(id, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("ID", sdk.DataType(testdatatypes.DataTypeNumber.ToSql())),
+	}))
+	t.Cleanup(tableCleanup)
+	tableCleanup()
+
+	tableModel := model.TableWithId("test", id, []sdk.TableColumnSignature{
+		{Name: "ID", Type: testdatatypes.DataTypeNumber},
+		{Name: "NAME", Type: testdatatypes.DataTypeVarchar},
+	}).WithRecoverIfDropped(true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Table),
+		Steps: []resource.TestStep{
+			{
+				Config:             accconfig.FromModels(t, tableModel),
+				ExpectNonEmptyPlan: true,
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "column.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "column.0.name", "ID")),
+				),
+			},
+			// the missing column is added with the regular update
+			{
+				Config: accconfig.FromModels(t, tableModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(tableModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr(tableModel.ResourceReference(), "column.#", "2")),
+				),
+			},
+		},
+	})
+}
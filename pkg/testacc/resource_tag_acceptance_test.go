@@ -1451,3 +1451,78 @@ func TestAcc_Tag_Validations(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_Tag_RecoverIfDropped(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	tag, tagCleanup := testClient().Tag.CreateTagWithIdentifier(t, id)
+	t.Cleanup(tagCleanup)
+	tagCleanup()
+
+	tagModel := model.TagBase("test", id).
+		WithRecoverIfDropped(true).
+		WithComment(comment).
+		WithAllowedValues("value1", "value2")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Tag),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, tagModel),
+				Check: assertThat(t,
+					resourceassert.TagResource(t, tagModel.ResourceReference()).
+						HasNameString(id.Name()).
+						HasRecoverIfDroppedString("true").
+						HasCommentString(comment),
+					// the recovered tag is the one created before the drop, with the configuration applied
+					objectassert.Tag(t, id).
+						HasCreatedOn(tag.CreatedOn).
+						HasComment(comment).
+						HasAllowedValuesUnordered("value1", "value2"),
+				),
+			},
+			// change in the parameter shouldn't change the state Snowflake
+			{
+				Config: config.FromModels(t, tagModel.WithRecoverIfDropped(false)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(tagModel.ResourceReference(), plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+// Without a dropped tag, the UNDROP error is not treated as a failure and the tag is created.
+func TestAcc_Tag_RecoverIfDropped_NothingToRecover(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	tagModel := model.TagBase("test", id).
+		WithRecoverIfDropped(true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Tag),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, tagModel),
+				Check: assertThat(t,
+					resourceassert.TagResource(t, tagModel.ResourceReference()).
+						HasNameString(id.Name()).
+						HasRecoverIfDroppedString("true"),
+					objectassert.Tag(t, id).
+						HasName(id.Name()),
+				),
+			},
+		},
+	})
+}